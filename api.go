@@ -2,7 +2,10 @@ package vegeta
 
 import (
 	"crypto/subtle"
+	"encoding/json"
+	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/Code-Hex/vegeta/internal/common"
 	"github.com/Code-Hex/vegeta/internal/model"
@@ -10,7 +13,6 @@ import (
 	jwt "github.com/dgrijalva/jwt-go"
 	"github.com/labstack/echo"
 	"github.com/pkg/errors"
-	"go.uber.org/zap"
 )
 
 const (
@@ -28,10 +30,9 @@ func DeleteTag() echo.HandlerFunc {
 		}
 		tag := c.Param("name")
 		if err := user.RemoveTag(c.DB, tag); err != nil {
-			return c.JSON(http.StatusBadRequest, &common.ResultJSON{
-				Reason: err.Error(),
-			})
+			return apiError(err, "")
 		}
+		c.audit(user, model.AuditTagDelete, tag, "")
 		return c.JSON(http.StatusOK, &common.ResultJSON{
 			IsSuccess: true,
 		})
@@ -50,9 +51,7 @@ func PostTag() echo.HandlerFunc {
 		}
 		tag := param.TagName
 		if err := user.AddTag(c.DB, tag); err != nil {
-			return c.JSON(http.StatusBadRequest, &common.ResultJSON{
-				Reason: err.Error(),
-			})
+			return apiError(err, "")
 		}
 		return c.JSON(http.StatusOK, &common.ResultJSON{
 			IsSuccess: true,
@@ -72,26 +71,89 @@ func PostData() echo.HandlerFunc {
 		}
 		tag, err := user.FindByTagName(c.DB, param.TagName)
 		if err != nil {
-			return c.JSON(http.StatusBadRequest, &common.ResultJSON{
-				Reason: err.Error(),
-			})
+			return apiError(err, "")
 		}
 		data := model.Data{
 			RemoteAddr: param.RemoteAddr,
+			PeerAddr:   c.PeerAddr(),
 			Payload:    param.Payload,
 			Hostname:   param.Hostname,
 		}
 		if err := tag.AddData(c.DB, data); err != nil {
-			return c.JSON(http.StatusBadRequest, &common.ResultJSON{
-				Reason: err.Error(),
-			})
+			return apiError(err, "")
 		}
+		c.Broker.Publish(tag.ID, data)
+		c.metrics.observeIngestion(tag.ID, 1)
 		return c.JSON(http.StatusOK, &common.ResultJSON{
 			IsSuccess: true,
 		})
 	})
 }
 
+func PostDataBatch() echo.HandlerFunc {
+	return call(func(c *Context) error {
+		param := new(common.PostDataBatchJSON)
+		if err := c.BindValidate(param); err != nil {
+			return err
+		}
+		if err := c.addDataBatch(param.Data); err != nil {
+			return err
+		}
+		return c.JSON(http.StatusOK, &common.ResultJSON{
+			IsSuccess: true,
+		})
+	})
+}
+
+// addDataBatch stores data to tags of the user at once.
+func (c *Context) addDataBatch(batch []common.PostDataJSON) error {
+	if len(batch) > common.MaxBatchSize {
+		return newAPIError(
+			http.StatusRequestEntityTooLarge,
+			common.CodeValidationFailed,
+			"error.batch_too_large",
+			common.MaxBatchSize,
+		)
+	}
+	user, ok := c.Get("user").(*model.User)
+	if !ok {
+		return errors.New("Failed to get user info via context")
+	}
+	peer := c.PeerAddr()
+	tags := make(map[string]*model.Tag)
+	someData := make([]model.Data, len(batch))
+	for i, d := range batch {
+		tag, ok := tags[d.TagName]
+		if !ok {
+			var err error
+			tag, err = user.FindByTagName(c.DB, d.TagName)
+			if err != nil {
+				return apiError(err, "")
+			}
+			tags[d.TagName] = tag
+		}
+		someData[i] = model.Data{
+			TagID:      tag.ID,
+			RemoteAddr: d.RemoteAddr,
+			PeerAddr:   peer,
+			Payload:    d.Payload,
+			Hostname:   d.Hostname,
+		}
+	}
+	if err := model.AddDataList(c.DB, someData); err != nil {
+		return apiError(err, "")
+	}
+	counts := make(map[uint]int, len(tags))
+	for _, data := range someData {
+		c.Broker.Publish(data.TagID, data)
+		counts[data.TagID]++
+	}
+	for tagID, n := range counts {
+		c.metrics.observeIngestion(tagID, n)
+	}
+	return nil
+}
+
 type resultGetTagList struct {
 	Tags []string `json:"tags"`
 }
@@ -121,6 +183,39 @@ type getDataList struct {
 	EndAt   string `query:"end_at"`
 }
 
+// dateLayout is the format of dates which the calendar of the pages sends.
+const dateLayout = "2006-01-02"
+
+// parseDate parses a time in RFC 3339 or a date in the local time.
+// Empty is the zero time.
+func parseDate(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+	t, err := time.ParseInLocation(dateLayout, s, time.Local)
+	if err != nil {
+		return time.Time{}, newAPIError(
+			http.StatusBadRequest,
+			common.CodeInvalidRequest,
+			"error.invalid_request_detail",
+			err.Error(),
+		).wrap(err)
+	}
+	return t, nil
+}
+
+// dateRange parses start_at and end_at of the pages.
+func dateRange(startAt, endAt string) (start, end time.Time, err error) {
+	if start, err = parseDate(startAt); err != nil {
+		return
+	}
+	end, err = parseDate(endAt)
+	return
+}
+
 type resultGetDataList struct {
 	Data []model.Data `json:"data"`
 }
@@ -137,21 +232,25 @@ func GetDataList() echo.HandlerFunc {
 		}
 		tag, err := user.FindByTagName(c.DB, param.Tag)
 		if err != nil {
-			return errors.Wrap(err, "Failed to get tag")
+			return apiError(err, "")
 		}
 
+		start, end, err := dateRange(param.StartAt, param.EndAt)
+		if err != nil {
+			return err
+		}
 		p := model.FindDataParam{
 			ID:      tag.ID,
 			Page:    param.Page,
 			Limit:   param.Limit,
 			Span:    param.Span,
-			StartAt: param.StartAt,
-			EndAt:   param.EndAt,
+			StartAt: start,
+			EndAt:   end,
 		}
 
 		data, err := model.FindDataByTagID(c.DB, p)
 		if err != nil {
-			return errors.Wrap(err, "Failed to find data")
+			return apiError(err, "")
 		}
 		return c.JSON(http.StatusOK, &resultGetDataList{
 			Data: data,
@@ -159,35 +258,106 @@ func GetDataList() echo.HandlerFunc {
 	})
 }
 
+// keepAliveInterval is the interval to send a comment line so that
+// proxies do not close an idle stream.
+const keepAliveInterval = 30 * time.Second
+
+type streamData struct {
+	Tag      string `query:"tag" validate:"required"`
+	Hostname string `query:"hostname"`
+}
+
+// StreamData pushes data of the tag as server-sent events
+// when they are ingested.
+func StreamData() echo.HandlerFunc {
+	return call(func(c *Context) error {
+		param := new(streamData)
+		if err := c.BindValidate(param); err != nil {
+			return err
+		}
+		return c.stream(param.Tag, param.Hostname)
+	})
+}
+
+func (c *Context) stream(tagName, hostname string) error {
+	user, ok := c.Get("user").(*model.User)
+	if !ok {
+		return errors.New("Failed to get user info via context")
+	}
+	tag, err := user.FindByTagName(c.DB, tagName)
+	if err != nil {
+		return apiError(err, "")
+	}
+	ch, cancel := c.Broker.Subscribe(tag.ID)
+	defer cancel()
+
+	w := c.Response()
+	w.Header().Set(echo.HeaderContentType, "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	w.Flush()
+
+	ticker := time.NewTicker(keepAliveInterval)
+	defer ticker.Stop()
+	done := c.Request().Context().Done()
+	for {
+		select {
+		case <-done:
+			return nil
+		case <-ticker.C:
+			fmt.Fprint(w, ": keep-alive\n\n")
+		case data, ok := <-ch:
+			if !ok { // server is shutting down
+				return nil
+			}
+			if hostname != "" && hostname != data.Hostname {
+				continue
+			}
+			b, err := json.Marshal(&data)
+			if err != nil {
+				return errors.Wrap(err, "Failed to encode data")
+			}
+			fmt.Fprintf(w, "event: data\ndata: %s\n\n", b)
+		}
+		w.Flush()
+	}
+}
+
 /* JSON API for settings */
 type apiVegetaClaims struct {
 	Name string `json:"name"`
 	jwt.StandardClaims
 }
 
+var (
+	errNoClaims = newAPIError(
+		http.StatusUnauthorized,
+		common.CodeUnauthorized,
+		"error.no_claims",
+	)
+	errPasswordMismatch = newAPIError(
+		http.StatusBadRequest,
+		common.CodePasswordMismatch,
+		"error.password_mismatch",
+	)
+)
+
 func RegenerateToken() echo.HandlerFunc {
 	return call(func(c *Context) error {
 		token, ok := c.Get("auth_api").(*jwt.Token)
 		if !ok {
-			c.Zap.Info("Failed to check user has a permission")
-			return c.JSON(http.StatusOK, &common.ResultJSON{
-				Reason: "APIトークンにユーザーの情報がありませんでした",
-			})
+			return errNoClaims
 		}
 		claim := token.Claims.(*apiVegetaClaims)
 		user, err := model.FindUserByName(c.DB, claim.Name)
 		if err != nil {
-			c.Zap.Info("Failed to get user at /regenerate")
-			return c.JSON(http.StatusOK, &common.ResultJSON{
-				Reason: "トークンの更新に失敗しました",
-			})
+			return apiError(err, "error.regenerate_token")
 		}
 		if _, err := user.ReGenerateUserToken(c.DB); err != nil {
-			c.Zap.Info("Failed to regenerate token at /regenerate", zap.Error(err))
-			return c.JSON(http.StatusOK, &common.ResultJSON{
-				Reason: "トークンの更新に失敗しました",
-			})
+			return apiError(err, "error.regenerate_token")
 		}
+		c.audit(user, model.AuditTokenRegenerate, user.Name, "")
 		return c.JSON(http.StatusOK, &common.ResultJSON{
 			IsSuccess: true,
 		})
@@ -208,31 +378,21 @@ func ReRegisterPassword() echo.HandlerFunc {
 		password := param.Password
 		verifyPassword := param.VerifyPassword
 		if subtle.ConstantTimeCompare([]byte(password), []byte(verifyPassword)) != 1 {
-			return c.JSON(http.StatusOK, &common.ResultJSON{
-				Reason: "入力したパスワードと確認用のパスワードが一致しませんでした。",
-			})
+			return errPasswordMismatch
 		}
 		token, ok := c.Get("auth_api").(*jwt.Token)
 		if !ok {
-			c.Zap.Info("Failed to check user has a permission")
-			return c.JSON(http.StatusOK, &common.ResultJSON{
-				Reason: "ユーザーの情報がありませんでした",
-			})
+			return errNoClaims
 		}
 		claim := token.Claims.(*apiVegetaClaims)
 		user, err := model.FindUserByName(c.DB, claim.Name)
 		if err != nil {
-			c.Zap.Info("Failed to get user at /reregister_password", zap.Error(err))
-			return c.JSON(http.StatusOK, &common.ResultJSON{
-				Reason: err.Error(),
-			})
+			return apiError(err, "")
 		}
 		if _, err := user.UpdatePassword(c.DB, password); err != nil {
-			c.Zap.Info("Failed to get user at /reregister_password", zap.Error(err))
-			return c.JSON(http.StatusOK, &common.ResultJSON{
-				Reason: err.Error(),
-			})
+			return apiError(err, "error.update_password")
 		}
+		c.audit(user, model.AuditPasswordChange, user.Name, "")
 		return c.JSON(http.StatusOK, &common.ResultJSON{
 			IsSuccess: true,
 		})
@@ -252,24 +412,16 @@ func AddTag() echo.HandlerFunc {
 		}
 		token, ok := c.Get("auth_api").(*jwt.Token)
 		if !ok {
-			c.Zap.Info("Failed to check user has a permission")
-			return c.JSON(http.StatusOK, &common.ResultJSON{
-				Reason: "APIトークンにユーザーの情報がありませんでした",
-			})
+			return errNoClaims
 		}
 		claim := token.Claims.(*apiVegetaClaims)
 		user, err := model.FindUserByName(c.DB, claim.Name)
 		if err != nil {
-			c.Zap.Info("Failed to get user at /regenerate")
-			return c.JSON(http.StatusOK, &common.ResultJSON{
-				Reason: "トークンの更新に失敗しました",
-			})
+			return apiError(err, "")
 		}
 
 		if err := user.AddTag(c.DB, param.Name); err != nil {
-			return c.JSON(http.StatusOK, &common.ResultJSON{
-				Reason: err.Error(),
-			})
+			return apiError(err, "")
 		}
 		return c.JSON(http.StatusOK, &common.ResultJSON{
 			IsSuccess: true,
@@ -298,24 +450,22 @@ func JSONTagsData() echo.HandlerFunc {
 			return err
 		}
 
+		start, end, err := dateRange(param.StartAt, param.EndAt)
+		if err != nil {
+			return err
+		}
 		p := model.FindDataParam{
 			ID:      param.TagID,
 			Page:    param.Page,
 			Span:    param.Span,
 			Limit:   param.Limit,
-			StartAt: param.StartAt,
-			EndAt:   param.EndAt,
+			StartAt: start,
+			EndAt:   end,
 		}
 
 		data, err := model.FindDataByTagID(c.DB, p)
 		if err != nil {
-			c.Zap.Info("Failed to get tag",
-				zap.Error(err),
-				zap.Uint("tag_id", param.TagID),
-			)
-			return c.JSON(http.StatusOK, &common.ResultJSON{
-				Reason: "データを取得するときにエラーが発生しました",
-			})
+			return apiError(err, "error.fetch_data")
 		}
 
 		return c.JSON(http.StatusOK, &resultGetTagsJSON{
@@ -343,18 +493,15 @@ func JSONCreateUser() echo.HandlerFunc {
 		password := param.Password
 		verifyPassword := param.VerifyPassword
 		if subtle.ConstantTimeCompare([]byte(password), []byte(verifyPassword)) != 1 {
-			return c.JSON(http.StatusOK, &common.ResultJSON{
-				Reason: "入力したパスワードと確認用のパスワードが一致しませんでした。",
-			})
+			return errPasswordMismatch
 		}
 		username := param.Name
 		isAdmin := param.IsAdmin
-		if _, err := model.CreateUser(c.DB, username, password, isAdmin); err != nil {
-			c.Zap.Error("Failed to create user", zap.Error(err))
-			return c.JSON(http.StatusOK, &common.ResultJSON{
-				Reason: "ユーザー作成時にエラーが発生しました。",
-			})
+		user, err := model.CreateUser(c.DB, username, password, isAdmin)
+		if err != nil {
+			return apiError(err, "error.create_user")
 		}
+		c.audit(c.SessionUser(), model.AuditUserCreate, user.Name, adminDetail(user.Admin))
 		return c.JSON(http.StatusOK, &common.ResultJSON{
 			IsSuccess: true,
 		})
@@ -383,13 +530,13 @@ func JSONEditUser() echo.HandlerFunc {
 			str = utils.RandomString()
 		}
 
-		if _, err := model.EditUser(c.DB, userID, isAdmin, str); err != nil {
-			c.Zap.Error("Failed to edit user", zap.Error(err))
-			return c.JSON(http.StatusOK, &common.ResultJSON{
-				Reason: "ユーザー編集時にエラーが発生しました。",
-			})
+		user, err := model.EditUser(c.DB, userID, isAdmin, str)
+		if err != nil {
+			return apiError(err, "error.edit_user")
 		}
+		c.audit(c.SessionUser(), model.AuditUserEdit, user.Name, adminDetail(user.Admin))
 		if isResetPassword {
+			c.audit(c.SessionUser(), model.AuditPasswordReset, user.Name, "")
 			return c.JSON(http.StatusOK, &common.ResultJSON{
 				IsSuccess: true,
 				Reason:    str,
@@ -403,6 +550,8 @@ func JSONEditUser() echo.HandlerFunc {
 
 type deleteUser struct {
 	ID string `json:"id" validate:"required"`
+	// Confirm must be the name of the user.
+	Confirm string `json:"confirm" validate:"required"`
 }
 
 func JSONDeleteUser() echo.HandlerFunc {
@@ -413,12 +562,11 @@ func JSONDeleteUser() echo.HandlerFunc {
 		}
 
 		userID := deleteUser.ID
-		if _, err := model.DeleteUser(c.DB, userID); err != nil {
-			c.Zap.Error("Failed to delete user", zap.Error(err))
-			return c.JSON(http.StatusOK, &common.ResultJSON{
-				Reason: "ユーザー削除時にエラーが発生しました。",
-			})
+		user, err := model.DeleteUser(c.DB, userID, deleteUser.Confirm)
+		if err != nil {
+			return apiError(err, "error.delete_user")
 		}
+		c.audit(c.SessionUser(), model.AuditUserDelete, user.Name, "")
 		return c.JSON(http.StatusOK, &common.ResultJSON{
 			IsSuccess: true,
 		})
@@ -0,0 +1,120 @@
+package vegeta
+
+import (
+	"bufio"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Code-Hex/vegeta/internal/common"
+	"github.com/Code-Hex/vegeta/internal/model"
+)
+
+// openStream subscribes the path, and returns the reader of events. The
+// subscription is made before the headers are written.
+func (c *testClient) openStream(path, token string) *bufio.Reader {
+	c.srv.t.Helper()
+	req := c.newRequest(http.MethodGet, path, nil)
+	req.Header.Set("Authorization", authScheme+" "+token)
+	resp, err := c.Do(req)
+	if err != nil {
+		c.srv.t.Fatal(err)
+	}
+	c.srv.t.Cleanup(func() { resp.Body.Close() })
+	if resp.StatusCode != http.StatusOK || resp.Header.Get("Content-Type") != "text/event-stream" {
+		c.srv.t.Fatalf("%s: status = %d, content type = %q", path, resp.StatusCode, resp.Header.Get("Content-Type"))
+	}
+	return bufio.NewReader(resp.Body)
+}
+
+// nextEvent returns the data of the next event, and fails if no event
+// comes in a while.
+func nextEvent(t *testing.T, r *bufio.Reader) *model.Data {
+	t.Helper()
+	lines := make(chan string)
+	go func() {
+		defer close(lines)
+		for {
+			line, err := r.ReadString('\n')
+			if err != nil {
+				return
+			}
+			if strings.HasPrefix(line, "data: ") {
+				lines <- strings.TrimPrefix(line, "data: ")
+				return
+			}
+		}
+	}()
+	select {
+	case line, ok := <-lines:
+		if !ok {
+			t.Fatal("stream is closed")
+		}
+		data := new(model.Data)
+		if err := json.Unmarshal([]byte(line), data); err != nil {
+			t.Fatal(err)
+		}
+		return data
+	case <-time.After(5 * time.Second):
+		t.Fatal("no event")
+	}
+	return nil
+}
+
+func TestDeprecatedAPI(t *testing.T) {
+	s := newTestServer(t)
+	alice := s.createUser("alice", "alice-password1", false)
+	c := s.client()
+
+	resp, body := c.api(http.MethodPost, "/api/tag", alice.Token, "", &common.TagJSON{TagName: "room"})
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("post tag: status = %d: %s", resp.StatusCode, body)
+	}
+	if resp.Header.Get("Deprecation") != "true" || !strings.Contains(resp.Header.Get("Link"), apiV1Prefix+"/openapi.json") {
+		t.Errorf("deprecated route has no successor: %v", resp.Header)
+	}
+	if resp, _ := c.api(http.MethodGet, "/api/tags", "", "", nil); resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("tags without token: status = %d, want 401", resp.StatusCode)
+	}
+
+	r := c.openStream("/api/data/stream?tag=room&hostname=b", alice.Token)
+	resp, body = c.api(http.MethodPost, "/api/data/batch", alice.Token, "", &common.PostDataBatchJSON{
+		Data: []common.PostDataJSON{
+			{TagName: "room", Hostname: "a", RemoteAddr: "127.0.0.1", Payload: `{"n":1}`},
+			{TagName: "room", Hostname: "b", RemoteAddr: "127.0.0.1", Payload: `{"n":2}`},
+		},
+	})
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("post batch: status = %d: %s", resp.StatusCode, body)
+	}
+	if data := nextEvent(t, r); data.Hostname != "b" || data.Payload != `{"n":2}` {
+		t.Errorf("stream of host b got %+v", data)
+	}
+}
+
+func TestStreamTagData(t *testing.T) {
+	s := newTestServer(t)
+	alice := s.createUser("alice", "alice-password1", false)
+	if err := alice.AddTag(s.DB, "room"); err != nil {
+		t.Fatal(err)
+	}
+	c := s.client()
+	if resp, _ := c.api(http.MethodGet, "/api/v1/tags/unknown/data/stream", alice.Token, "", nil); resp.StatusCode != http.StatusNotFound {
+		t.Errorf("stream of unknown tag: status = %d, want 404", resp.StatusCode)
+	}
+
+	r := c.openStream("/api/v1/tags/room/data/stream", alice.Token)
+	resp, body := c.api(http.MethodPost, "/api/v1/tags/room/data", alice.Token, "", &postData{
+		Payload:    `{"n":1}`,
+		Hostname:   "a",
+		RemoteAddr: "127.0.0.1",
+	})
+	if resp.StatusCode != http.StatusNoContent {
+		t.Fatalf("post data: status = %d: %s", resp.StatusCode, body)
+	}
+	if data := nextEvent(t, r); data.Payload != `{"n":1}` {
+		t.Errorf("stream got %+v", data)
+	}
+}
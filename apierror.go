@@ -0,0 +1,185 @@
+package vegeta
+
+import (
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/Code-Hex/vegeta/internal/common"
+	"github.com/Code-Hex/vegeta/internal/i18n"
+	"github.com/Code-Hex/vegeta/internal/model"
+	"github.com/Code-Hex/vegeta/internal/password"
+	"github.com/labstack/echo"
+	"github.com/pkg/errors"
+	validator "gopkg.in/go-playground/validator.v9"
+)
+
+// APIError is returned from handlers. ErrorHandler writes it
+// as a ResultJSON with the status.
+type APIError struct {
+	Status int
+	Code   string
+	// Message is in English. It is translated by the key of the
+	// message catalog when it is written.
+	Message string
+	Details interface{}
+	key     string
+	args    []interface{}
+	err     error
+}
+
+// newAPIError makes an APIError whose message is key in the catalog.
+func newAPIError(status int, code, key string, args ...interface{}) *APIError {
+	return &APIError{
+		Status:  status,
+		Code:    code,
+		Message: i18n.T(i18n.English, key, args...),
+		key:     key,
+		args:    args,
+	}
+}
+
+func (e *APIError) Error() string {
+	if e.err != nil {
+		return e.Message + ": " + e.err.Error()
+	}
+	return e.Message
+}
+
+func (e *APIError) wrap(err error) *APIError {
+	e.err = err
+	return e
+}
+
+// localize returns the message in lang.
+func (e *APIError) localize(lang string) string {
+	if e.key == "" {
+		return e.Message
+	}
+	return i18n.T(lang, e.key, e.args...)
+}
+
+func (e *APIError) result(lang string) *common.ResultJSON {
+	return &common.ResultJSON{
+		Code:    e.Code,
+		Reason:  e.localize(lang),
+		Details: e.Details,
+	}
+}
+
+// apiError makes an APIError from the error which is returned from the model.
+// The message of err is shown if key is empty, except for internal errors.
+func apiError(err error, key string) *APIError {
+	if e, ok := err.(*APIError); ok {
+		return e
+	}
+	if pe, ok := errors.Cause(err).(*password.PolicyError); ok {
+		return policyError(pe).wrap(err)
+	}
+	status, code := http.StatusInternalServerError, common.CodeInternal
+	switch errors.Cause(err) {
+	case model.ErrInvalid:
+		status, code = http.StatusBadRequest, common.CodeInvalidRequest
+	case model.ErrUnauthorized:
+		status, code = http.StatusUnauthorized, common.CodeUnauthorized
+	case model.ErrForbidden:
+		status, code = http.StatusForbidden, common.CodeForbidden
+	case model.ErrNotFound:
+		status, code = http.StatusNotFound, common.CodeNotFound
+	case model.ErrAlreadyExists:
+		status, code = http.StatusConflict, common.CodeAlreadyExists
+	case model.ErrLocked:
+		status, code = http.StatusTooManyRequests, common.CodeLocked
+	case model.ErrSuspended:
+		status, code = http.StatusForbidden, common.CodeSuspended
+	}
+	if key == "" {
+		if status != http.StatusInternalServerError {
+			return &APIError{Status: status, Code: code, Message: err.Error(), err: err}
+		}
+		key = "error.internal"
+	}
+	return newAPIError(status, code, key).wrap(err)
+}
+
+// httpError converts errors of echo such as 404 and errors from the jwt middleware.
+func httpError(he *echo.HTTPError) *APIError {
+	code := common.CodeInvalidRequest
+	switch he.Code {
+	case http.StatusUnauthorized:
+		code = common.CodeUnauthorized
+	case http.StatusForbidden:
+		code = common.CodeForbidden
+	case http.StatusNotFound:
+		code = common.CodeNotFound
+	case http.StatusMethodNotAllowed:
+		code = common.CodeMethodNotAllowed
+	case http.StatusServiceUnavailable:
+		code = common.CodeUnavailable
+	default:
+		if he.Code >= http.StatusInternalServerError {
+			code = common.CodeInternal
+		}
+	}
+	if m, ok := he.Message.(string); ok && m != "" && m != http.StatusText(he.Code) {
+		return &APIError{Status: he.Code, Code: code, Message: m, err: he}
+	}
+	return newAPIError(he.Code, code, "error."+code).wrap(he)
+}
+
+// validationError lists fields which are failed to validate in details.
+func validationError(err error) *APIError {
+	verrs, ok := err.(validator.ValidationErrors)
+	if !ok {
+		return newAPIError(
+			http.StatusBadRequest,
+			common.CodeValidationFailed,
+			"error.validation_failed_detail",
+			err.Error(),
+		).wrap(err)
+	}
+	e := newAPIError(
+		http.StatusBadRequest,
+		common.CodeValidationFailed,
+		"error.validation_failed",
+	).wrap(err)
+	details := make([]common.FieldError, len(verrs))
+	for i, fe := range verrs {
+		details[i] = common.FieldError{
+			Field: fe.Field(),
+			Rule:  fe.Tag(),
+		}
+	}
+	e.Details = details
+	return e
+}
+
+// policyError tells the rule of the password policy in details.
+func policyError(pe *password.PolicyError) *APIError {
+	var args []interface{}
+	if pe.Param != 0 {
+		args = append(args, pe.Param)
+	}
+	e := newAPIError(
+		http.StatusBadRequest,
+		common.CodePasswordPolicy,
+		"error.password_policy."+pe.Rule,
+		args...,
+	)
+	e.Details = []common.FieldError{{Field: "password", Rule: pe.Rule}}
+	return e
+}
+
+// fieldName reports fields by the name in json or query parameters.
+func fieldName(f reflect.StructField) string {
+	for _, key := range []string{"json", "query", "form"} {
+		name := strings.SplitN(f.Tag.Get(key), ",", 2)[0]
+		if name == "-" {
+			return ""
+		}
+		if name != "" {
+			return name
+		}
+	}
+	return f.Name
+}
@@ -0,0 +1,1107 @@
+package vegeta
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/Code-Hex/vegeta/internal/common"
+	"github.com/Code-Hex/vegeta/internal/i18n"
+	"github.com/Code-Hex/vegeta/internal/model"
+	"github.com/Code-Hex/vegeta/internal/session"
+	"github.com/Code-Hex/vegeta/internal/utils"
+	"github.com/labstack/echo"
+	"github.com/pkg/errors"
+)
+
+const apiV1Prefix = "/api/v1"
+
+// apiV1Routes is used both to register handlers and to generate
+// the OpenAPI document, so that they are kept in sync.
+func (v *Vegeta) apiV1Routes() []apiRoute {
+	routes := []apiRoute{
+		{
+			Method: echo.POST, Path: "/token/refresh", Group: "tokens", Public: true,
+			Summary: "Get a new access token for pages by the refresh token in the cookie, which is replaced",
+			Status:  http.StatusOK, Result: accessTokenJSON{},
+			Handler: RefreshAPIToken(),
+		},
+		{
+			Method: echo.GET, Path: "/users/me", Group: "users",
+			Summary: "Get the current user",
+			Status:  http.StatusOK, Result: userJSON{},
+			Handler: GetMe(),
+		},
+		{
+			Method: echo.PATCH, Path: "/users/me", Group: "users",
+			Summary: "Edit preferences of the current user. An empty locale follows Accept-Language",
+			Body:    patchMe{},
+			Status:  http.StatusOK, Result: userJSON{},
+			Handler: PatchMe(),
+		},
+		{
+			Method: echo.PUT, Path: "/users/me/password", Group: "users",
+			Summary: "Change the password of the current user",
+			Body:    reregisterPassword{},
+			Status:  http.StatusNoContent,
+			Handler: PutMyPassword(),
+		},
+		{
+			Method: echo.POST, Path: "/users/me/token", Group: "tokens",
+			Summary: "Regenerate the API token of the current user",
+			Status:  http.StatusOK, Result: tokenJSON{},
+			Handler: PostMyToken(),
+		},
+		{
+			Method: echo.GET, Path: "/users/me/sessions", Group: "sessions",
+			Summary: "List active login sessions of the current user",
+			Status:  http.StatusOK, Result: sessionsJSON{},
+			Handler: GetMySessions(),
+		},
+		{
+			Method: echo.DELETE, Path: "/users/me/sessions/:id", Group: "sessions",
+			Summary: "Revoke a login session of the current user",
+			Status:  http.StatusNoContent,
+			Handler: DeleteMySession(),
+		},
+		{
+			Method: echo.GET, Path: "/users/me/logins", Group: "sessions",
+			Summary: "List recent logins of the current user, including failed ones",
+			Status:  http.StatusOK, Result: loginsJSON{},
+			Handler: GetMyLogins(),
+		},
+		{
+			Method: echo.POST, Path: "/users/me/totp", Group: "totp",
+			Summary: "Start enrollment of TOTP. The QR code is a data URL of PNG",
+			Status:  http.StatusOK, Result: totpKeyJSON{},
+			Handler: PostMyTOTP(),
+		},
+		{
+			Method: echo.PUT, Path: "/users/me/totp", Group: "totp",
+			Summary: "Enable TOTP by a code from the authenticator, and get recovery codes",
+			Body:    totpCode{},
+			Status:  http.StatusOK, Result: recoveryCodesJSON{},
+			Handler: PutMyTOTP(),
+		},
+		{
+			Method: echo.DELETE, Path: "/users/me/totp", Group: "totp",
+			Summary: "Disable TOTP by a code from the authenticator or a recovery code",
+			Body:    totpCode{},
+			Status:  http.StatusNoContent,
+			Handler: DeleteMyTOTP(),
+		},
+		{
+			Method: echo.POST, Path: "/users/me/totp/recovery-codes", Group: "totp",
+			Summary: "Replace the recovery codes",
+			Body:    totpCode{},
+			Status:  http.StatusOK, Result: recoveryCodesJSON{},
+			Handler: PostMyRecoveryCodes(),
+		},
+		{
+			Method: echo.GET, Path: "/users", Group: "users", Admin: true,
+			Summary: "List users",
+			Status:  http.StatusOK, Result: usersJSON{},
+			Handler: GetUsers(),
+		},
+		{
+			Method: echo.POST, Path: "/users", Group: "users", Admin: true,
+			Summary: "Create a user",
+			Body:    createUser{},
+			Status:  http.StatusCreated, Result: userJSON{},
+			Handler: PostUser(),
+		},
+		{
+			Method: echo.GET, Path: "/users/:id", Group: "users", Admin: true,
+			Summary: "Get a user",
+			Status:  http.StatusOK, Result: userJSON{},
+			Handler: GetUser(),
+		},
+		{
+			Method: echo.PATCH, Path: "/users/:id", Group: "users", Admin: true,
+			Summary: "Edit a user. A new password is returned if reset_password is true",
+			Body:    patchUser{},
+			Status:  http.StatusOK, Result: patchedUserJSON{},
+			Handler: PatchUser(),
+		},
+		{
+			Method: echo.DELETE, Path: "/users/:id", Group: "users", Admin: true,
+			Summary: "Delete a user with tags and data, which can not be restored",
+			Query:   deleteUserQuery{},
+			Status:  http.StatusNoContent,
+			Handler: DeleteUser(),
+		},
+		{
+			Method: echo.GET, Path: "/users/:id/archive", Group: "users", Admin: true,
+			Summary: "Download a zip of a user with tags and data, so that they are kept before the user is deleted",
+			Status:  http.StatusOK, Download: "application/zip",
+			Handler: GetUserArchive(),
+		},
+		{
+			Method: echo.POST, Path: "/users/:id/suspend", Group: "users", Admin: true,
+			Summary: "Suspend a user, who can not log in or use tokens until resumed. The data is kept",
+			Status:  http.StatusOK, Result: userJSON{},
+			Handler: SuspendUser(),
+		},
+		{
+			Method: echo.POST, Path: "/users/:id/resume", Group: "users", Admin: true,
+			Summary: "Resume a suspended user",
+			Status:  http.StatusOK, Result: userJSON{},
+			Handler: ResumeUser(),
+		},
+		{
+			Method: echo.POST, Path: "/users/:id/token", Group: "tokens", Admin: true,
+			Summary: "Regenerate the API token of a user",
+			Status:  http.StatusOK, Result: tokenJSON{},
+			Handler: PostUserToken(),
+		},
+		{
+			Method: echo.DELETE, Path: "/users/:id/refresh-tokens", Group: "tokens", Admin: true,
+			Summary: "Revoke refresh tokens of a user, so that pages of the user stop when their access tokens expire",
+			Status:  http.StatusNoContent,
+			Handler: DeleteUserRefreshTokens(),
+		},
+		{
+			Method: echo.POST, Path: "/users/:id/unlock", Group: "users", Admin: true,
+			Summary: "Unlock a user who is locked by failed logins",
+			Status:  http.StatusOK, Result: userJSON{},
+			Handler: UnlockUser(),
+		},
+		{
+			Method: echo.DELETE, Path: "/users/:id/totp", Group: "totp", Admin: true,
+			Summary: "Disable TOTP of a user who lost the authenticator",
+			Status:  http.StatusNoContent,
+			Handler: DeleteUserTOTP(),
+		},
+		{
+			Method: echo.GET, Path: "/invites", Group: "invites", Admin: true,
+			Summary: "List invites and users who registered by them",
+			Status:  http.StatusOK, Result: invitesJSON{},
+			Handler: GetInvites(),
+		},
+		{
+			Method: echo.POST, Path: "/invites", Group: "invites", Admin: true,
+			Summary: "Create an invite. The url to register by it is returned only here",
+			Body:    createInvite{},
+			Status:  http.StatusCreated, Result: inviteJSON{},
+			Handler: PostInvite(),
+		},
+		{
+			Method: echo.DELETE, Path: "/invites/:id", Group: "invites", Admin: true,
+			Summary: "Revoke an invite",
+			Status:  http.StatusNoContent,
+			Handler: DeleteInvite(),
+		},
+		{
+			Method: echo.GET, Path: "/audit-logs", Group: "audit", Admin: true,
+			Summary: "List audit logs from the newest. Pass next of the result as before to get the next page",
+			Query:   auditQuery{},
+			Status:  http.StatusOK, Result: auditLogsJSON{},
+			Handler: GetAuditLogs(),
+		},
+		{
+			Method: echo.GET, Path: "/tags", Group: "tags", APIToken: true,
+			Summary: "List tags of the current user",
+			Status:  http.StatusOK, Result: resultGetTagList{},
+			Handler: GetTagList(),
+		},
+		{
+			Method: echo.POST, Path: "/tags", Group: "tags", APIToken: true,
+			Summary: "Add a tag",
+			Body:    addTag{},
+			Status:  http.StatusCreated, Result: tagJSON{},
+			Handler: PostTagV1(),
+		},
+		{
+			Method: echo.DELETE, Path: "/tags/:name", Group: "tags", APIToken: true,
+			Summary: "Delete a tag and its data",
+			Status:  http.StatusNoContent,
+			Handler: DeleteTagV1(),
+		},
+		{
+			Method: echo.GET, Path: "/tags/:name/data", Group: "data", APIToken: true,
+			Summary: "List data of a tag from the newest",
+			Query:   dataQuery{},
+			Status:  http.StatusOK, Result: resultGetDataList{},
+			Handler: GetTagData(),
+		},
+		{
+			Method: echo.POST, Path: "/tags/:name/data", Group: "data", APIToken: true,
+			Summary: "Post data to a tag",
+			Body:    postData{},
+			Status:  http.StatusNoContent,
+			Handler: PostTagData(),
+		},
+		{
+			Method: echo.POST, Path: "/data", Group: "data", APIToken: true,
+			Summary: "Post at most 1000 data to tags at once. Nothing is stored if any of them is invalid",
+			Body:    common.PostDataBatchJSON{},
+			Status:  http.StatusNoContent,
+			Handler: PostDataBatchV1(),
+		},
+	}
+	if v.config.Features.Stream {
+		routes = append(routes, apiRoute{
+			Method: echo.GET, Path: "/tags/:name/data/stream", Group: "data", APIToken: true,
+			Summary: "Receive data of a tag as server-sent events when they are posted",
+			Query:   streamQuery{},
+			Status:  http.StatusOK, Stream: true,
+			Handler: StreamTagData(),
+		})
+	}
+	return routes
+}
+
+func (v *Vegeta) registerAPIv1() {
+	routes := v.apiV1Routes()
+	spec := newOpenAPI(apiV1Prefix, routes)
+	v.GET(apiV1Prefix+"/openapi.json", OpenAPI(spec))
+
+	g := v.Group(apiV1Prefix)
+	for _, r := range routes {
+		var m []echo.MiddlewareFunc
+		switch {
+		case r.Public:
+		case r.APIToken:
+			m = append(m, APIAuth())
+		default:
+			m = append(m, PageAPIAuth())
+		}
+		if r.Admin {
+			m = append(m, AdminOnly())
+		}
+		g.Add(r.Method, r.Path, r.Handler, m...)
+	}
+}
+
+// APIAuth authenticates by a client certificate, an API token or
+// a token which is issued for pages.
+func APIAuth() echo.MiddlewareFunc {
+	return apiAuth(true)
+}
+
+// PageAPIAuth authenticates only by a token which is issued for pages,
+// and the session of the same user. API tokens and client certificates
+// are refused, so that they can not manage users.
+func PageAPIAuth() echo.MiddlewareFunc {
+	return apiAuth(false)
+}
+
+func apiAuth(apiToken bool) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return call(func(c *Context) error {
+			user, err := c.authenticate(apiToken)
+			if err != nil {
+				return err
+			}
+			if user.IsSuspended() {
+				return newAPIError(http.StatusForbidden, common.CodeSuspended, "error.suspended")
+			}
+			c.Set("user", user)
+			return next(c)
+		})
+	}
+}
+
+func (c *Context) authenticate(apiToken bool) (*model.User, error) {
+	if name, ok := c.clientCertUser(); ok && apiToken {
+		user, err := model.FindUserByName(c.DB, name)
+		if err != nil {
+			return nil, newAPIError(
+				http.StatusUnauthorized,
+				common.CodeUnauthorized,
+				"error.auth_client_cert",
+			).wrap(err)
+		}
+		return user, nil
+	}
+	auth := c.Request().Header.Get(echo.HeaderAuthorization)
+	l := len(authScheme)
+	if len(auth) <= l+1 || auth[:l] != authScheme {
+		return nil, newAPIError(
+			http.StatusUnauthorized,
+			common.CodeUnauthorized,
+			"error.auth_header",
+		)
+	}
+	token := auth[l+1:]
+	// API tokens are UUIDs, and tokens for pages are JWTs.
+	if strings.Count(token, ".") != 2 {
+		if !apiToken {
+			return nil, newAPIError(
+				http.StatusUnauthorized,
+				common.CodeUnauthorized,
+				"error.auth_page_token",
+			)
+		}
+		user, err := model.TokenAuth(c.DB, token)
+		if err != nil {
+			return nil, newAPIError(
+				http.StatusUnauthorized,
+				common.CodeUnauthorized,
+				"error.auth_token",
+			).wrap(err)
+		}
+		return user, nil
+	}
+	t, err := c.parseAPIToken(token)
+	if err != nil {
+		return nil, newAPIError(
+			http.StatusUnauthorized,
+			common.CodeUnauthorized,
+			"error.auth_token",
+		).wrap(err)
+	}
+	// Tokens for pages are used by scripts along with the cookie.
+	if err := c.checkCSRF(); err != nil {
+		return nil, err
+	}
+	name := t.Claims.(*apiVegetaClaims).Name
+	if !apiToken {
+		if u := c.SessionUser(); u == nil || u.Name != name {
+			return nil, newAPIError(
+				http.StatusUnauthorized,
+				common.CodeUnauthorized,
+				"error.login_required",
+			)
+		}
+	}
+	user, err := model.FindUserByName(c.DB, name)
+	if err != nil {
+		return nil, newAPIError(
+			http.StatusUnauthorized,
+			common.CodeUnauthorized,
+			"error.auth_token",
+		).wrap(err)
+	}
+	return user, nil
+}
+
+// AdminOnly rejects users who are not admin.
+func AdminOnly() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return call(func(c *Context) error {
+			user, err := c.user()
+			if err != nil {
+				return err
+			}
+			if !user.Admin {
+				return newAPIError(http.StatusForbidden, common.CodeForbidden, "error.admin_required")
+			}
+			if c.needsTOTP(user) {
+				return newAPIError(http.StatusForbidden, common.CodeTOTPRequired, "error.totp_required")
+			}
+			return next(c)
+		})
+	}
+}
+
+// Deprecated marks the routes which are replaced by /api/v1.
+func Deprecated() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			h := c.Response().Header()
+			h.Set("Deprecation", "true")
+			h.Set("Link", `<`+apiV1Prefix+`/openapi.json>; rel="successor-version"`)
+			return next(c)
+		}
+	}
+}
+
+func (c *Context) user() (*model.User, error) {
+	user, ok := c.Get("user").(*model.User)
+	if !ok {
+		return nil, errors.New("Failed to get user info via context")
+	}
+	return user, nil
+}
+
+type userJSON struct {
+	ID        uint      `json:"id"`
+	Name      string    `json:"name"`
+	Admin     bool      `json:"admin"`
+	Locale    string    `json:"locale"`
+	Tags      []string  `json:"tags,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	// LockedUntil is when the lock by failed logins ends.
+	LockedUntil *time.Time `json:"locked_until,omitempty"`
+	SuspendedAt *time.Time `json:"suspended_at,omitempty"`
+	TOTPEnabled bool       `json:"totp_enabled"`
+}
+
+func newUserJSON(u *model.User) *userJSON {
+	tags := make([]string, len(u.Tags))
+	for i, t := range u.Tags {
+		tags[i] = t.Name
+	}
+	return &userJSON{
+		ID:          u.ID,
+		Name:        u.Name,
+		Admin:       u.Admin,
+		Locale:      u.Locale,
+		Tags:        tags,
+		CreatedAt:   u.CreatedAt,
+		LockedUntil: u.LockedUntil,
+		SuspendedAt: u.SuspendedAt,
+		TOTPEnabled: u.TOTPEnabled,
+	}
+}
+
+type usersJSON struct {
+	Users []*userJSON `json:"users"`
+}
+
+type patchMe struct {
+	Locale *string `json:"locale"`
+}
+
+type sessionJSON struct {
+	ID         uint      `json:"id"`
+	UserAgent  string    `json:"user_agent"`
+	IPAddress  string    `json:"ip_address"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastSeenAt time.Time `json:"last_seen_at"`
+	ExpiresAt  time.Time `json:"expires_at"`
+}
+
+type sessionsJSON struct {
+	Sessions []*sessionJSON `json:"sessions"`
+}
+
+type loginJSON struct {
+	IPAddress string    `json:"ip_address"`
+	UserAgent string    `json:"user_agent"`
+	Success   bool      `json:"success"`
+	Reason    string    `json:"reason,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type loginsJSON struct {
+	Logins []*loginJSON `json:"logins"`
+}
+
+type totpKeyJSON struct {
+	Secret string `json:"secret"`
+	URL    string `json:"url"`
+	QRCode string `json:"qr_code"`
+}
+
+type totpCode struct {
+	Code string `json:"code" validate:"required"`
+}
+
+type recoveryCodesJSON struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+type tokenJSON struct {
+	Token string `json:"token"`
+}
+
+type tagJSON struct {
+	Name string `json:"name"`
+}
+
+func GetMe() echo.HandlerFunc {
+	return call(func(c *Context) error {
+		user, err := c.user()
+		if err != nil {
+			return err
+		}
+		return c.JSON(http.StatusOK, newUserJSON(user))
+	})
+}
+
+func PatchMe() echo.HandlerFunc {
+	return call(func(c *Context) error {
+		param := new(patchMe)
+		if err := c.BindValidate(param); err != nil {
+			return err
+		}
+		user, err := c.user()
+		if err != nil {
+			return err
+		}
+		if param.Locale != nil {
+			locale := *param.Locale
+			if locale != "" && !i18n.Supported(locale) {
+				return newAPIError(
+					http.StatusBadRequest,
+					common.CodeInvalidRequest,
+					"error.invalid_locale",
+					locale,
+				)
+			}
+			if _, err := user.UpdateLocale(c.DB, locale); err != nil {
+				return apiError(err, "")
+			}
+		}
+		return c.JSON(http.StatusOK, newUserJSON(user))
+	})
+}
+
+func GetMySessions() echo.HandlerFunc {
+	return call(func(c *Context) error {
+		user, err := c.user()
+		if err != nil {
+			return err
+		}
+		sessions, err := model.GetSessions(c.DB, user.ID, c.Now())
+		if err != nil {
+			return apiError(err, "")
+		}
+		result := &sessionsJSON{Sessions: make([]*sessionJSON, len(sessions))}
+		for i, s := range sessions {
+			result.Sessions[i] = &sessionJSON{
+				ID:         s.ID,
+				UserAgent:  s.UserAgent,
+				IPAddress:  s.IPAddress,
+				CreatedAt:  s.CreatedAt,
+				LastSeenAt: s.LastSeenAt,
+				ExpiresAt:  s.ExpiresAt,
+			}
+		}
+		return c.JSON(http.StatusOK, result)
+	})
+}
+
+func DeleteMySession() echo.HandlerFunc {
+	return call(func(c *Context) error {
+		user, err := c.user()
+		if err != nil {
+			return err
+		}
+		if err := model.RevokeSession(c.DB, user.ID, c.Param("id")); err != nil {
+			return apiError(err, "error.revoke_session")
+		}
+		return c.NoContent(http.StatusNoContent)
+	})
+}
+
+func GetMyLogins() echo.HandlerFunc {
+	return call(func(c *Context) error {
+		user, err := c.user()
+		if err != nil {
+			return err
+		}
+		attempts, err := model.GetLoginAttempts(c.DB, user.ID, loginHistorySize)
+		if err != nil {
+			return apiError(err, "")
+		}
+		result := &loginsJSON{Logins: make([]*loginJSON, len(attempts))}
+		for i, a := range attempts {
+			result.Logins[i] = &loginJSON{
+				IPAddress: a.IPAddress,
+				UserAgent: a.UserAgent,
+				Success:   a.Success,
+				Reason:    a.Reason,
+				CreatedAt: a.CreatedAt,
+			}
+		}
+		return c.JSON(http.StatusOK, result)
+	})
+}
+
+func PostMyTOTP() echo.HandlerFunc {
+	return call(func(c *Context) error {
+		user, err := c.user()
+		if err != nil {
+			return err
+		}
+		key, err := user.StartTOTP(c.DB, totpIssuer)
+		if err != nil {
+			return apiError(err, "")
+		}
+		qr, err := qrCode(key)
+		if err != nil {
+			return apiError(err, "")
+		}
+		return c.JSON(http.StatusOK, &totpKeyJSON{
+			Secret: key.Secret(),
+			URL:    key.URL(),
+			QRCode: qr,
+		})
+	})
+}
+
+func PutMyTOTP() echo.HandlerFunc {
+	return call(func(c *Context) error {
+		param := new(totpCode)
+		if err := c.BindValidate(param); err != nil {
+			return err
+		}
+		user, err := c.user()
+		if err != nil {
+			return err
+		}
+		codes, err := user.EnableTOTP(c.DB, param.Code, c.Now())
+		if err != nil {
+			return totpError(err)
+		}
+		// The code has been verified in this session.
+		if err := session.Get(c).VerifySecondFactor(); err != nil {
+			return errors.Wrap(err, "Failed to update session")
+		}
+		c.audit(user, model.AuditTOTPEnable, user.Name, "")
+		return c.JSON(http.StatusOK, &recoveryCodesJSON{RecoveryCodes: codes})
+	})
+}
+
+func DeleteMyTOTP() echo.HandlerFunc {
+	return call(func(c *Context) error {
+		param := new(totpCode)
+		if err := c.BindValidate(param); err != nil {
+			return err
+		}
+		user, err := c.user()
+		if err != nil {
+			return err
+		}
+		if err := user.ConfirmSecondFactor(c.DB, c.login.throttle, param.Code, c.Now()); err != nil {
+			return totpError(err)
+		}
+		if err := user.DisableTOTP(c.DB); err != nil {
+			return apiError(err, "")
+		}
+		c.audit(user, model.AuditTOTPDisable, user.Name, "")
+		return c.NoContent(http.StatusNoContent)
+	})
+}
+
+func PostMyRecoveryCodes() echo.HandlerFunc {
+	return call(func(c *Context) error {
+		param := new(totpCode)
+		if err := c.BindValidate(param); err != nil {
+			return err
+		}
+		user, err := c.user()
+		if err != nil {
+			return err
+		}
+		if err := user.ConfirmSecondFactor(c.DB, c.login.throttle, param.Code, c.Now()); err != nil {
+			return totpError(err)
+		}
+		codes, err := user.RegenerateRecoveryCodes(c.DB)
+		if err != nil {
+			return apiError(err, "")
+		}
+		return c.JSON(http.StatusOK, &recoveryCodesJSON{RecoveryCodes: codes})
+	})
+}
+
+// totpError does not use 401 for a wrong code, which is not a failure
+// of the authentication of the request.
+func totpError(err error) *APIError {
+	if errors.Cause(err) == model.ErrUnauthorized {
+		return newAPIError(http.StatusBadRequest, common.CodeInvalidRequest, "error.totp_invalid_code").wrap(err)
+	}
+	return apiError(err, "")
+}
+
+func PutMyPassword() echo.HandlerFunc {
+	return call(func(c *Context) error {
+		param := new(reregisterPassword)
+		if err := c.BindValidate(param); err != nil {
+			return err
+		}
+		if param.Password != param.VerifyPassword {
+			return errPasswordMismatch
+		}
+		user, err := c.user()
+		if err != nil {
+			return err
+		}
+		if _, err := user.UpdatePassword(c.DB, param.Password); err != nil {
+			return apiError(err, "error.update_password")
+		}
+		c.audit(user, model.AuditPasswordChange, user.Name, "")
+		return c.NoContent(http.StatusNoContent)
+	})
+}
+
+func PostMyToken() echo.HandlerFunc {
+	return call(func(c *Context) error {
+		user, err := c.user()
+		if err != nil {
+			return err
+		}
+		if _, err := user.ReGenerateUserToken(c.DB); err != nil {
+			return apiError(err, "error.regenerate_token")
+		}
+		c.audit(user, model.AuditTokenRegenerate, user.Name, "")
+		return c.JSON(http.StatusOK, &tokenJSON{Token: user.Token})
+	})
+}
+
+func GetUsers() echo.HandlerFunc {
+	return call(func(c *Context) error {
+		users, err := model.GetUsers(c.DB)
+		if err != nil {
+			return apiError(err, "")
+		}
+		result := &usersJSON{Users: make([]*userJSON, len(users))}
+		for i, u := range users {
+			result.Users[i] = newUserJSON(u)
+		}
+		return c.JSON(http.StatusOK, result)
+	})
+}
+
+func PostUser() echo.HandlerFunc {
+	return call(func(c *Context) error {
+		param := new(createUser)
+		if err := c.BindValidate(param); err != nil {
+			return err
+		}
+		if param.Password != param.VerifyPassword {
+			return errPasswordMismatch
+		}
+		actor, err := c.user()
+		if err != nil {
+			return err
+		}
+		user, err := model.CreateUser(c.DB, param.Name, param.Password, param.IsAdmin)
+		if err != nil {
+			return apiError(err, "")
+		}
+		c.audit(actor, model.AuditUserCreate, user.Name, adminDetail(user.Admin))
+		return c.JSON(http.StatusCreated, newUserJSON(user))
+	})
+}
+
+func GetUser() echo.HandlerFunc {
+	return call(func(c *Context) error {
+		user, err := model.FindUserByID(c.DB, c.Param("id"))
+		if err != nil {
+			return apiError(err, "")
+		}
+		return c.JSON(http.StatusOK, newUserJSON(user))
+	})
+}
+
+type patchUser struct {
+	// IsAdmin is not changed if it is omitted.
+	IsAdmin       *bool `json:"is_admin"`
+	ResetPassword bool  `json:"reset_password"`
+}
+
+type patchedUserJSON struct {
+	User *userJSON `json:"user"`
+	// Password is the new password if it is reset.
+	Password string `json:"password,omitempty"`
+}
+
+func PatchUser() echo.HandlerFunc {
+	return call(func(c *Context) error {
+		param := new(patchUser)
+		if err := c.BindValidate(param); err != nil {
+			return err
+		}
+		actor, err := c.user()
+		if err != nil {
+			return err
+		}
+		user, err := model.FindUserByID(c.DB, c.Param("id"))
+		if err != nil {
+			return apiError(err, "")
+		}
+		isAdmin := user.Admin
+		if param.IsAdmin != nil {
+			isAdmin = *param.IsAdmin
+		}
+		var password string
+		if param.ResetPassword {
+			password = utils.RandomString()
+		}
+		user, err = model.EditUser(c.DB, c.Param("id"), isAdmin, password)
+		if err != nil {
+			return apiError(err, "")
+		}
+		if param.IsAdmin != nil {
+			c.audit(actor, model.AuditUserEdit, user.Name, adminDetail(user.Admin))
+		}
+		if param.ResetPassword {
+			c.audit(actor, model.AuditPasswordReset, user.Name, "")
+		}
+		return c.JSON(http.StatusOK, &patchedUserJSON{
+			User:     newUserJSON(user),
+			Password: password,
+		})
+	})
+}
+
+type deleteUserQuery struct {
+	// Confirm must be the name of the user.
+	Confirm string `query:"confirm" validate:"required"`
+}
+
+func DeleteUser() echo.HandlerFunc {
+	return call(func(c *Context) error {
+		q := new(deleteUserQuery)
+		if err := c.BindValidate(q); err != nil {
+			return err
+		}
+		actor, err := c.user()
+		if err != nil {
+			return err
+		}
+		user, err := model.DeleteUser(c.DB, c.Param("id"), q.Confirm)
+		if err != nil {
+			return apiError(err, "")
+		}
+		c.audit(actor, model.AuditUserDelete, user.Name, "")
+		return c.NoContent(http.StatusNoContent)
+	})
+}
+
+// DeleteUserRefreshTokens stops pages of the user from renewing their access
+// tokens. They stop working when the access tokens expire.
+func DeleteUserRefreshTokens() echo.HandlerFunc {
+	return call(func(c *Context) error {
+		actor, err := c.user()
+		if err != nil {
+			return err
+		}
+		user, err := model.FindUserByID(c.DB, c.Param("id"))
+		if err != nil {
+			return apiError(err, "")
+		}
+		if err := model.RevokeRefreshTokens(c.DB, user.ID, c.Now()); err != nil {
+			return apiError(err, "")
+		}
+		c.audit(actor, model.AuditRefreshRevoke, user.Name, "")
+		return c.NoContent(http.StatusNoContent)
+	})
+}
+
+func PostUserToken() echo.HandlerFunc {
+	return call(func(c *Context) error {
+		actor, err := c.user()
+		if err != nil {
+			return err
+		}
+		user, err := model.FindUserByID(c.DB, c.Param("id"))
+		if err != nil {
+			return apiError(err, "")
+		}
+		if _, err := user.ReGenerateUserToken(c.DB); err != nil {
+			return apiError(err, "")
+		}
+		c.audit(actor, model.AuditTokenRegenerate, user.Name, "")
+		return c.JSON(http.StatusOK, &tokenJSON{Token: user.Token})
+	})
+}
+
+func UnlockUser() echo.HandlerFunc {
+	return call(func(c *Context) error {
+		actor, err := c.user()
+		if err != nil {
+			return err
+		}
+		user, err := model.UnlockUser(c.DB, c.Param("id"))
+		if err != nil {
+			return apiError(err, "")
+		}
+		c.audit(actor, model.AuditUserUnlock, user.Name, "")
+		return c.JSON(http.StatusOK, newUserJSON(user))
+	})
+}
+
+func SuspendUser() echo.HandlerFunc {
+	return call(func(c *Context) error {
+		actor, err := c.user()
+		if err != nil {
+			return err
+		}
+		user, err := model.SuspendUser(c.DB, c.Param("id"), c.Now())
+		if err != nil {
+			return apiError(err, "")
+		}
+		c.audit(actor, model.AuditUserSuspend, user.Name, "")
+		return c.JSON(http.StatusOK, newUserJSON(user))
+	})
+}
+
+func ResumeUser() echo.HandlerFunc {
+	return call(func(c *Context) error {
+		actor, err := c.user()
+		if err != nil {
+			return err
+		}
+		user, err := model.ResumeUser(c.DB, c.Param("id"))
+		if err != nil {
+			return apiError(err, "")
+		}
+		c.audit(actor, model.AuditUserResume, user.Name, "")
+		return c.JSON(http.StatusOK, newUserJSON(user))
+	})
+}
+
+func DeleteUserTOTP() echo.HandlerFunc {
+	return call(func(c *Context) error {
+		actor, err := c.user()
+		if err != nil {
+			return err
+		}
+		user, err := model.FindUserByID(c.DB, c.Param("id"))
+		if err != nil {
+			return apiError(err, "")
+		}
+		if err := user.DisableTOTP(c.DB); err != nil {
+			return apiError(err, "")
+		}
+		c.audit(actor, model.AuditTOTPReset, user.Name, "")
+		return c.NoContent(http.StatusNoContent)
+	})
+}
+
+func PostTagV1() echo.HandlerFunc {
+	return call(func(c *Context) error {
+		param := new(addTag)
+		if err := c.BindValidate(param); err != nil {
+			return err
+		}
+		user, err := c.user()
+		if err != nil {
+			return err
+		}
+		if err := user.AddTag(c.DB, param.Name); err != nil {
+			return apiError(err, "")
+		}
+		return c.JSON(http.StatusCreated, &tagJSON{Name: param.Name})
+	})
+}
+
+func DeleteTagV1() echo.HandlerFunc {
+	return call(func(c *Context) error {
+		user, err := c.user()
+		if err != nil {
+			return err
+		}
+		if err := user.RemoveTag(c.DB, c.Param("name")); err != nil {
+			return apiError(err, "")
+		}
+		c.audit(user, model.AuditTagDelete, c.Param("name"), "")
+		return c.NoContent(http.StatusNoContent)
+	})
+}
+
+// queryTime is a time in RFC 3339 in the query.
+type queryTime struct {
+	time.Time
+}
+
+func (t *queryTime) UnmarshalParam(s string) error {
+	v, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return err
+	}
+	t.Time = v
+	return nil
+}
+
+type dataQuery struct {
+	Span    string    `query:"span" validate:"required"`
+	Limit   uint      `query:"limit" validate:"required"`
+	Page    uint      `query:"page"`
+	StartAt queryTime `query:"start_at"`
+	EndAt   queryTime `query:"end_at"`
+}
+
+func GetTagData() echo.HandlerFunc {
+	return call(func(c *Context) error {
+		param := new(dataQuery)
+		if err := c.BindValidate(param); err != nil {
+			return err
+		}
+		user, err := c.user()
+		if err != nil {
+			return err
+		}
+		tag, err := user.FindByTagName(c.DB, c.Param("name"))
+		if err != nil {
+			return apiError(err, "")
+		}
+		data, err := model.FindDataByTagID(c.DB, model.FindDataParam{
+			ID:      tag.ID,
+			Page:    param.Page,
+			Limit:   param.Limit,
+			Span:    param.Span,
+			StartAt: param.StartAt.Time,
+			EndAt:   param.EndAt.Time,
+		})
+		if err != nil {
+			return apiError(err, "")
+		}
+		return c.JSON(http.StatusOK, &resultGetDataList{
+			Data: data,
+		})
+	})
+}
+
+type postData struct {
+	Payload    string `json:"payload" validate:"required"`
+	Hostname   string `json:"hostname" validate:"required"`
+	RemoteAddr string `json:"remote_addr" validate:"required"`
+}
+
+func PostTagData() echo.HandlerFunc {
+	return call(func(c *Context) error {
+		param := new(postData)
+		if err := c.BindValidate(param); err != nil {
+			return err
+		}
+		user, err := c.user()
+		if err != nil {
+			return err
+		}
+		tag, err := user.FindByTagName(c.DB, c.Param("name"))
+		if err != nil {
+			return apiError(err, "")
+		}
+		data := model.Data{
+			RemoteAddr: param.RemoteAddr,
+			PeerAddr:   c.PeerAddr(),
+			Payload:    param.Payload,
+			Hostname:   param.Hostname,
+		}
+		if err := tag.AddData(c.DB, data); err != nil {
+			return apiError(err, "")
+		}
+		c.Broker.Publish(tag.ID, data)
+		c.metrics.observeIngestion(tag.ID, 1)
+		return c.NoContent(http.StatusNoContent)
+	})
+}
+
+func PostDataBatchV1() echo.HandlerFunc {
+	return call(func(c *Context) error {
+		param := new(common.PostDataBatchJSON)
+		if err := c.BindValidate(param); err != nil {
+			return err
+		}
+		if err := c.addDataBatch(param.Data); err != nil {
+			return err
+		}
+		return c.NoContent(http.StatusNoContent)
+	})
+}
+
+type streamQuery struct {
+	Hostname string `query:"hostname"`
+}
+
+func StreamTagData() echo.HandlerFunc {
+	return call(func(c *Context) error {
+		param := new(streamQuery)
+		if err := c.BindValidate(param); err != nil {
+			return err
+		}
+		return c.stream(c.Param("name"), param.Hostname)
+	})
+}
+
+func OpenAPI(spec interface{}) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		return c.JSON(http.StatusOK, spec)
+	}
+}
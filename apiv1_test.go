@@ -0,0 +1,227 @@
+package vegeta
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/Code-Hex/vegeta/internal/common"
+	"github.com/Code-Hex/vegeta/internal/model"
+)
+
+func TestAPIAuthScope(t *testing.T) {
+	s := newTestServer(t)
+	alice := s.createUser("alice", "alice-password1", false)
+	root := s.createUser("root", "root-password1", true)
+
+	c := s.client()
+	c.login("alice", "alice-password1", "/mypage")
+	page := c.pageToken("/mypage/settings")
+	// The page token is stolen and used without the session.
+	other := s.client()
+
+	tests := []struct {
+		name   string
+		c      *testClient
+		method string
+		path   string
+		token  string
+		want   int
+	}{
+		{"api token to tags", other, http.MethodGet, "/api/v1/tags", alice.Token, http.StatusOK},
+		{"api token to users/me", other, http.MethodGet, "/api/v1/users/me", alice.Token, http.StatusUnauthorized},
+		{"api token to sessions", other, http.MethodGet, "/api/v1/users/me/sessions", alice.Token, http.StatusUnauthorized},
+		{"admin api token to users", other, http.MethodGet, "/api/v1/users", root.Token, http.StatusUnauthorized},
+		{"admin api token to invites", other, http.MethodGet, "/api/v1/invites", root.Token, http.StatusUnauthorized},
+		{"admin api token to audit logs", other, http.MethodGet, "/api/v1/audit-logs", root.Token, http.StatusUnauthorized},
+		{"page token to users/me", c, http.MethodGet, "/api/v1/users/me", page, http.StatusOK},
+		{"page token to tags", c, http.MethodGet, "/api/v1/tags", page, http.StatusOK},
+		{"page token without session to users/me", other, http.MethodGet, "/api/v1/users/me", page, http.StatusUnauthorized},
+		{"page token without session to tags", other, http.MethodGet, "/api/v1/tags", page, http.StatusOK},
+		{"page token of another user", s.loggedIn("root", "root-password1"), http.MethodGet, "/api/v1/users/me", page, http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		resp, body := tt.c.api(tt.method, tt.path, tt.token, "", nil)
+		if resp.StatusCode != tt.want {
+			t.Errorf("%s: status = %d, want %d: %s", tt.name, resp.StatusCode, tt.want, body)
+		}
+	}
+}
+
+func TestTagDataTimeRange(t *testing.T) {
+	s := newTestServer(t)
+	alice := s.createUser("alice", "alice-password1", false)
+	if err := alice.AddTag(s.DB, "room"); err != nil {
+		t.Fatal(err)
+	}
+	tag, err := alice.FindByTagName(s.DB, "room")
+	if err != nil {
+		t.Fatal(err)
+	}
+	base := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 5; i++ {
+		at := base.Add(time.Duration(i) * time.Hour)
+		err := s.DB.Create(&model.Data{
+			TagID:      tag.ID,
+			RemoteAddr: "127.0.0.1",
+			Hostname:   "host",
+			Payload:    "{}",
+			CreatedAt:  at,
+			UpdatedAt:  at,
+		}).Error
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		name           string
+		startAt, endAt string
+		status, n      int
+	}{
+		{"all", "", "", http.StatusOK, 5},
+		{"range", "2020-01-01T01:00:00Z", "2020-01-01T03:00:00Z", http.StatusOK, 3},
+		{"only start", "2020-01-01T03:00:00Z", "", http.StatusOK, 2},
+		{"date only", "2020-01-01", "", http.StatusBadRequest, 0},
+		{"injection", "2020-01-01' or '1'='1", "2020-01-02", http.StatusBadRequest, 0},
+	}
+	c := s.client()
+	for _, tt := range tests {
+		q := url.Values{"span": {"all"}, "limit": {"10"}}
+		if tt.startAt != "" {
+			q.Set("start_at", tt.startAt)
+		}
+		if tt.endAt != "" {
+			q.Set("end_at", tt.endAt)
+		}
+		resp, body := c.api(http.MethodGet, "/api/v1/tags/room/data?"+q.Encode(), alice.Token, "", nil)
+		if resp.StatusCode != tt.status {
+			t.Errorf("%s: status = %d, want %d: %s", tt.name, resp.StatusCode, tt.status, body)
+			continue
+		}
+		if tt.status != http.StatusOK {
+			continue
+		}
+		var result resultGetDataList
+		if err := json.Unmarshal([]byte(body), &result); err != nil {
+			t.Fatal(err)
+		}
+		if len(result.Data) != tt.n {
+			t.Errorf("%s: got %d data, want %d", tt.name, len(result.Data), tt.n)
+		}
+	}
+}
+
+func TestPostDataBatchLimit(t *testing.T) {
+	s := newTestServer(t)
+	alice := s.createUser("alice", "alice-password1", false)
+	if err := alice.AddTag(s.DB, "room"); err != nil {
+		t.Fatal(err)
+	}
+	batch := func(n int) *common.PostDataBatchJSON {
+		b := &common.PostDataBatchJSON{Data: make([]common.PostDataJSON, n)}
+		for i := range b.Data {
+			b.Data[i] = common.PostDataJSON{
+				Payload:    "{}",
+				Hostname:   "host",
+				RemoteAddr: "127.0.0.1",
+				TagName:    "room",
+			}
+		}
+		return b
+	}
+	c := s.client()
+	if resp, body := c.api(http.MethodPost, "/api/v1/data", alice.Token, "", batch(common.MaxBatchSize+1)); resp.StatusCode != http.StatusRequestEntityTooLarge {
+		t.Errorf("too large batch: status = %d, want %d: %s", resp.StatusCode, http.StatusRequestEntityTooLarge, body)
+	}
+	if resp, body := c.api(http.MethodPost, "/api/v1/data", alice.Token, "", batch(common.MaxBatchSize)); resp.StatusCode != http.StatusNoContent {
+		t.Errorf("batch of the max size: status = %d, want %d: %s", resp.StatusCode, http.StatusNoContent, body)
+	}
+}
+
+func TestMySessions(t *testing.T) {
+	s := newTestServer(t)
+	s.createUser("alice", "alice-password1", false)
+	c := s.loggedIn("alice", "alice-password1")
+	other := s.loggedIn("alice", "alice-password1")
+	token := c.pageToken("/mypage/settings")
+
+	resp, body := c.api(http.MethodGet, "/api/v1/users/me/sessions", token, "", nil)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("sessions: status = %d: %s", resp.StatusCode, body)
+	}
+	var result sessionsJSON
+	if err := json.Unmarshal([]byte(body), &result); err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Sessions) != 2 {
+		t.Fatalf("got %d sessions, want 2", len(result.Sessions))
+	}
+
+	// The session of other is the newer one.
+	id := strconv.FormatUint(uint64(result.Sessions[0].ID), 10)
+	if result.Sessions[1].ID > result.Sessions[0].ID {
+		id = strconv.FormatUint(uint64(result.Sessions[1].ID), 10)
+	}
+	if resp, body := c.api(http.MethodDelete, "/api/v1/users/me/sessions/"+id, token, "", nil); resp.StatusCode != http.StatusForbidden {
+		t.Errorf("revoke without CSRF token: status = %d, want 403: %s", resp.StatusCode, body)
+	}
+	if resp, body := c.api(http.MethodDelete, "/api/v1/users/me/sessions/"+id, token, c.csrfCookie(), nil); resp.StatusCode != http.StatusNoContent {
+		t.Fatalf("revoke: status = %d: %s", resp.StatusCode, body)
+	}
+	if resp, _ := other.get("/mypage"); resp.Header.Get("Location") != "/login" {
+		t.Errorf("revoked session goes to %q, want /login", resp.Header.Get("Location"))
+	}
+	if resp, _ := c.get("/mypage"); resp.StatusCode != http.StatusOK {
+		t.Errorf("current session: status = %d", resp.StatusCode)
+	}
+	if resp, _ := c.api(http.MethodDelete, "/api/v1/users/me/sessions/"+id, token, c.csrfCookie(), nil); resp.StatusCode != http.StatusNotFound {
+		t.Errorf("revoked again: status = %d, want 404", resp.StatusCode)
+	}
+}
+
+func TestSuspendUser(t *testing.T) {
+	s := newTestServer(t)
+	s.createUser("root", "root-password1", true)
+	alice := s.createUser("alice", "alice-password1", false)
+	id := strconv.FormatUint(uint64(alice.ID), 10)
+
+	c := s.loggedIn("alice", "alice-password1")
+	if resp, _ := c.api(http.MethodPost, "/api/v1/users/"+id+"/suspend", c.pageToken("/mypage/settings"), c.csrfCookie(), nil); resp.StatusCode != http.StatusForbidden {
+		t.Errorf("suspend by a user: status = %d, want 403", resp.StatusCode)
+	}
+
+	root := s.loggedIn("root", "root-password1")
+	token := root.pageToken("/mypage/settings")
+	resp, body := root.api(http.MethodPost, "/api/v1/users/"+id+"/suspend", token, root.csrfCookie(), nil)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("suspend: status = %d: %s", resp.StatusCode, body)
+	}
+	var user userJSON
+	if err := json.Unmarshal([]byte(body), &user); err != nil {
+		t.Fatal(err)
+	}
+	if user.SuspendedAt == nil {
+		t.Error("suspended user has no suspended_at")
+	}
+	if resp, _ := c.get("/mypage"); resp.Header.Get("Location") != "/login" {
+		t.Errorf("session of suspended user goes to %q, want /login", resp.Header.Get("Location"))
+	}
+	if resp, _ := s.client().api(http.MethodGet, "/api/v1/tags", alice.Token, "", nil); resp.StatusCode != http.StatusForbidden {
+		t.Errorf("api token of suspended user: status = %d, want 403", resp.StatusCode)
+	}
+	if resp, _ := root.api(http.MethodPost, "/api/v1/users/1/suspend", token, root.csrfCookie(), nil); resp.StatusCode != http.StatusForbidden {
+		t.Errorf("suspend user 1: status = %d, want 403", resp.StatusCode)
+	}
+
+	if resp, body := root.api(http.MethodPost, "/api/v1/users/"+id+"/resume", token, root.csrfCookie(), nil); resp.StatusCode != http.StatusOK {
+		t.Fatalf("resume: status = %d: %s", resp.StatusCode, body)
+	}
+	if resp, _ := s.client().api(http.MethodGet, "/api/v1/tags", alice.Token, "", nil); resp.StatusCode != http.StatusOK {
+		t.Errorf("api token of resumed user: status = %d, want 200", resp.StatusCode)
+	}
+	s.loggedIn("alice", "alice-password1")
+}
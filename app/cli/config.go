@@ -0,0 +1,143 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/BurntSushi/toml"
+	"github.com/Code-Hex/vegeta/internal/utils"
+	"github.com/pkg/errors"
+)
+
+const defaultProfile = "default"
+
+// Environment variables which override the config file.
+const (
+	envConfig  = "VEGETA_CONFIG"
+	envProfile = "VEGETA_PROFILE"
+	envURL     = "VEGETA_URL"
+	envToken   = "VEGETA_TOKEN"
+)
+
+// Profile is a set of credentials for a vegeta server.
+type Profile struct {
+	URL   string `toml:"url"`
+	Token string `toml:"token"`
+}
+
+// Config is stored in ~/.config/vegeta/config.toml
+//
+//	default = "lab"
+//
+//	[profiles.lab]
+//	url = "https://vegeta.example.com"
+//	token = "..."
+type Config struct {
+	Default  string              `toml:"default"`
+	Profiles map[string]*Profile `toml:"profiles"`
+}
+
+func configPath() (string, error) {
+	if p := os.Getenv(envConfig); p != "" {
+		return p, nil
+	}
+	dir := os.Getenv("XDG_CONFIG_HOME")
+	if dir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", errors.Wrap(err, "Failed to get home directory")
+		}
+		dir = filepath.Join(home, ".config")
+	}
+	return filepath.Join(dir, "vegeta", "config.toml"), nil
+}
+
+// loadConfig returns an empty config if the file does not exist.
+func loadConfig(path string) (*Config, error) {
+	cfg := &Config{
+		Profiles: make(map[string]*Profile),
+	}
+	ok, err := utils.Exists(path)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return cfg, nil
+	}
+	if _, err := toml.DecodeFile(path, cfg); err != nil {
+		return nil, errors.Wrapf(err, "Failed to read config file: %s", path)
+	}
+	if cfg.Profiles == nil {
+		cfg.Profiles = make(map[string]*Profile)
+	}
+	return cfg, nil
+}
+
+// save writes the config file which is readable only by the owner
+// because it contains tokens.
+func (cfg *Config) save(path string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return errors.Wrap(err, "Failed to create config directory")
+	}
+	buf := new(bytes.Buffer)
+	if err := toml.NewEncoder(buf).Encode(cfg); err != nil {
+		return errors.Wrap(err, "Failed to encode config")
+	}
+	// The token is never readable by others, even for a moment. TempFile
+	// creates the file with 0600, and it replaces the old one at once.
+	f, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return errors.Wrap(err, "Failed to create config file")
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.Write(buf.Bytes()); err != nil {
+		f.Close()
+		return errors.Wrapf(err, "Failed to write config file: %s", path)
+	}
+	if err := f.Close(); err != nil {
+		return errors.Wrapf(err, "Failed to write config file: %s", path)
+	}
+	if err := os.Rename(f.Name(), path); err != nil {
+		return errors.Wrapf(err, "Failed to write config file: %s", path)
+	}
+	return nil
+}
+
+// profileName decides the profile to use in order of
+// the --profile flag, $VEGETA_PROFILE and the default in config file.
+func (cfg *Config) profileName(flag string) string {
+	if flag != "" {
+		return flag
+	}
+	if env := os.Getenv(envProfile); env != "" {
+		return env
+	}
+	if cfg.Default != "" {
+		return cfg.Default
+	}
+	return defaultProfile
+}
+
+// resolve returns credentials which are overridden by the environment
+// variables and the command line flags.
+func (cfg *Config) resolve(opts *Options) *Profile {
+	p := &Profile{}
+	if prof, ok := cfg.Profiles[cfg.profileName(opts.Profile)]; ok {
+		*p = *prof
+	}
+	if env := os.Getenv(envURL); env != "" {
+		p.URL = env
+	}
+	if env := os.Getenv(envToken); env != "" {
+		p.Token = env
+	}
+	if opts.URL != "" {
+		p.URL = opts.URL
+	}
+	if opts.Token != "" {
+		p.Token = opts.Token
+	}
+	return p
+}
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/Code-Hex/exit"
+	"github.com/Code-Hex/vegeta/client"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+// login asks credentials, checks them against the server
+// and stores them into the profile.
+func (c *CLI) login() error {
+	path, err := configPath()
+	if err != nil {
+		return err
+	}
+	cfg, err := loadConfig(path)
+	if err != nil {
+		return err
+	}
+	name := cfg.profileName(c.Profile)
+	prof, ok := cfg.Profiles[name]
+	if !ok {
+		prof = &Profile{URL: targetHost}
+	}
+
+	url := c.URL
+	if url == "" {
+		fmt.Printf("URL [%s]: ", prof.URL)
+		line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+		if err != nil {
+			return errors.Wrap(err, "Failed to read url")
+		}
+		url = strings.TrimSpace(line)
+		if url == "" {
+			url = prof.URL
+		}
+	}
+	token := c.Token
+	if token == "" {
+		fmt.Print("Token: ")
+		b, err := terminal.ReadPassword(int(os.Stdin.Fd()))
+		if err != nil {
+			return errors.Wrap(err, "Failed to read token")
+		}
+		fmt.Print("\n")
+		token = strings.TrimSpace(string(b))
+	}
+
+	cli, err := client.New(url, token)
+	if err != nil {
+		return err
+	}
+	if _, err := cli.Tags(context.Background()); err != nil {
+		if client.IsUnauthorized(err) {
+			return exit.MakeNoPerm(errors.New("The token is not accepted by the server"))
+		}
+		return errors.Wrap(err, "Failed to verify the token")
+	}
+
+	cfg.Profiles[name] = &Profile{URL: url, Token: token}
+	if cfg.Default == "" {
+		cfg.Default = name
+	}
+	if err := cfg.save(path); err != nil {
+		return err
+	}
+	fmt.Printf("Saved profile %q to %s\n", name, path)
+	return nil
+}
@@ -1,16 +1,14 @@
 package main
 
 import (
-	"bytes"
-	"encoding/json"
+	"context"
 	"fmt"
 	"io/ioutil"
-	"net/http"
-	"net/url"
 	"os"
 	"strings"
 
-	"github.com/Code-Hex/vegeta/internal/common"
+	"github.com/Code-Hex/exit"
+	"github.com/Code-Hex/vegeta/client"
 	"github.com/Code-Hex/vegeta/internal/utils"
 
 	"github.com/pkg/errors"
@@ -18,6 +16,7 @@ import (
 
 type CLI struct {
 	Options
+	client *client.Client
 }
 
 const (
@@ -52,9 +51,26 @@ func (c *CLI) Run() int {
 }
 
 func (c *CLI) run() error {
-	if err := c.prepare(); err != nil {
+	args, err := c.prepare()
+	if err != nil {
+		return errors.Wrap(err, "Failed to prepare")
+	}
+	if 0 < len(args) && args[0] == "login" {
+		return c.login()
+	}
+	if err := c.setupClient(); err != nil {
 		return errors.Wrap(err, "Failed to prepare")
 	}
+	if 0 < len(args) {
+		switch args[0] {
+		case "tail":
+			return c.tail()
+		case "simulate":
+			return c.simulate()
+		default:
+			return exit.MakeUsage(errors.Errorf("Unknown command: %s", args[0]))
+		}
+	}
 	if err := c.exec(); err != nil {
 		return errors.Wrap(err, "Failed to exec")
 	}
@@ -63,11 +79,18 @@ func (c *CLI) run() error {
 }
 
 func (c *CLI) exec() error {
+	if c.Tag == "" {
+		return exit.MakeUsage(errors.New("the required flag `-t, --tag' was not specified"))
+	}
+	ctx := context.Background()
+
 	// Add tag mode
 	if c.Add {
-		err := c.postRequest("/api/tag", &common.TagJSON{
-			TagName: c.Tag,
-		})
+		err := c.client.AddTag(ctx, c.Tag)
+		if client.IsAlreadyExists(err) {
+			fmt.Fprintf(os.Stderr, "Tag %s already exists\n", c.Tag)
+			return nil
+		}
 		if err != nil {
 			return errors.Wrap(err, "Failed to add tag")
 		}
@@ -76,8 +99,7 @@ func (c *CLI) exec() error {
 
 	// Remove tag mode
 	if c.Remove {
-		err := c.deleteRequest("/api/tag/" + c.Tag)
-		if err != nil {
+		if err := c.client.DeleteTag(ctx, c.Tag); err != nil {
 			return errors.Wrap(err, "Failed to remove tag")
 		}
 		return nil
@@ -96,7 +118,7 @@ func (c *CLI) exec() error {
 	if !utils.IsValidJSON(jsonStr) {
 		return errors.New("Invalid json format")
 	}
-	addr, err := utils.GetIPAddress()
+	addr, err := c.remoteAddr()
 	if err != nil {
 		return errors.Wrap(err, "Failed to get ip address")
 	}
@@ -104,8 +126,7 @@ func (c *CLI) exec() error {
 	if err != nil {
 		return errors.Wrap(err, "Failed to get hostname")
 	}
-	err = c.postRequest("/api/data", &common.PostDataJSON{
-		TagName:    c.Tag,
+	err = c.client.PostData(ctx, c.Tag, &client.Data{
 		Payload:    jsonStr,
 		RemoteAddr: addr,
 		Hostname:   host,
@@ -116,89 +137,59 @@ func (c *CLI) exec() error {
 	return nil
 }
 
-func (c *CLI) prepare() error {
-	_, err := parseOptions(&c.Options, os.Args[1:])
-	if err != nil {
-		return errors.Wrap(err, "Failed to parse command line args")
+// remoteAddr returns the address which is reported as the device address.
+func (c *CLI) remoteAddr() (string, error) {
+	if c.RemoteAddr != "" {
+		if !utils.IsValidIPAddress(c.RemoteAddr) {
+			return "", exit.MakeDataErr(errors.Errorf("Invalid ip address format: %s", c.RemoteAddr))
+		}
+		return c.RemoteAddr, nil
 	}
-	return nil
+	return utils.FindIPAddress(c.Interface, c.IPv6)
 }
 
-func parseOptions(opts *Options, argv []string) ([]string, error) {
-	o, err := opts.parse(argv)
+func (c *CLI) prepare() ([]string, error) {
+	args, err := parseOptions(&c.Options, os.Args[1:])
 	if err != nil {
-		os.Stdout.Write(opts.usage())
-		return nil, errors.Wrap(err, "invalid command line options")
-	}
-	if opts.Version {
-		fmt.Printf("%s: %s\n", version, msg)
-		return nil, makeIgnore()
+		return nil, errors.Wrap(err, "Failed to parse command line args")
 	}
-	if opts.Help {
-		os.Stdout.Write(opts.usage())
-		return nil, makeIgnore()
-	}
-	return o, nil
+	return args, nil
 }
 
-func (c *CLI) postRequest(path string, v interface{}) error {
-	url, err := c.makeURL(path)
-	if err != nil {
-		return errors.Wrap(err, "Failed to make URL")
-	}
-	body := new(bytes.Buffer)
-	if err := json.NewEncoder(body).Encode(v); err != nil {
-		return err
-	}
-	req, err := http.NewRequest("POST", url, body)
+func (c *CLI) setupClient() error {
+	path, err := configPath()
 	if err != nil {
 		return err
 	}
-	return c.sendRequest(req)
-}
-
-func (c *CLI) deleteRequest(path string) error {
-	url, err := c.makeURL(path)
-	if err != nil {
-		return errors.Wrap(err, "Failed to make URL")
-	}
-	req, err := http.NewRequest("DELETE", url, nil)
+	cfg, err := loadConfig(path)
 	if err != nil {
 		return err
 	}
-	return c.sendRequest(req)
-}
-
-func (c *CLI) makeURL(path string) (string, error) {
-	u, err := url.Parse(path)
-	if err != nil {
-		return "", err
+	prof := cfg.resolve(&c.Options)
+	if prof.URL == "" || prof.Token == "" {
+		return exit.MakeConfig(errors.New("url and token are not configured, run `vegeta-cli login` first"))
 	}
-	base, err := url.Parse(c.URL)
+	cli, err := client.New(prof.URL, prof.Token)
 	if err != nil {
-		return "", err
+		return errors.Wrap(err, "Failed to create api client")
 	}
-	url := base.ResolveReference(u).String()
-	fmt.Println("Request to " + url)
-	return url, nil
+	c.client = cli
+	return nil
 }
-func (c *CLI) sendRequest(req *http.Request) error {
-	req.Header.Set("Content-Type", "application/json; charset=utf-8")
-	req.Header.Add("Authorization", "Bearer "+c.Token)
 
-	resp, err := http.DefaultClient.Do(req)
+func parseOptions(opts *Options, argv []string) ([]string, error) {
+	o, err := opts.parse(argv)
 	if err != nil {
-		return err
+		os.Stdout.Write(opts.usage())
+		return nil, errors.Wrap(err, "invalid command line options")
 	}
-	defer resp.Body.Close()
-
-	d := &common.ResultJSON{}
-	if err := json.NewDecoder(resp.Body).Decode(d); err != nil {
-		return err
+	if opts.Version {
+		fmt.Printf("%s: %s\n", version, msg)
+		return nil, makeIgnore()
 	}
-
-	if !d.IsSuccess {
-		return errors.New(d.Reason)
+	if opts.Help {
+		os.Stdout.Write(opts.usage())
+		return nil, makeIgnore()
 	}
-	return nil
+	return o, nil
 }
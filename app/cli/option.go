@@ -3,6 +3,7 @@ package main
 import (
 	"bytes"
 	"fmt"
+	"time"
 
 	"reflect"
 
@@ -18,10 +19,25 @@ type Options struct {
 	Version    bool   `short:"v" long:"version" description:"print the version"`
 	Add        bool   `short:"a" long:"add" description:"add tag mode"`
 	Remove     bool   `short:"r" long:"remove" description:"remove tag mode"`
-	Tag        string `short:"t" long:"tag" description:"specify the tag name to manage data" required:"true"`
-	URL        string `long:"url" description:"specify the base url of request destination" required:"true"`
-	Token      string `long:"token" description:"specify the registerd user token" required:"true"`
-	StackTrace bool   `long:"trace" description:"display detail error messages"`
+	Tag        string `short:"t" long:"tag" description:"specify the tag name to manage data"`
+	Hostname   string `long:"hostname" description:"show only data sent from the host in tail mode"`
+	Profile    string `long:"profile" description:"specify the profile name in config file"`
+	URL        string `long:"url" description:"specify the base url of request destination"`
+	Token      string `long:"token" description:"specify the registerd user token"`
+	Interface  string `short:"i" long:"interface" description:"specify the network interface to get the device address"`
+	IPv6       bool   `short:"6" long:"ipv6" description:"prefer an ipv6 address as the device address"`
+	RemoteAddr string `long:"remote-addr" description:"specify the device address explicitly"`
+
+	Devices     int           `long:"devices" description:"number of virtual devices in simulate mode" default:"100"`
+	TagCount    int           `long:"tag-count" description:"number of tags in simulate mode" default:"10"`
+	Rate        float64       `long:"rate" description:"requests per second in simulate mode" default:"50"`
+	Duration    time.Duration `long:"duration" description:"how long to run in simulate mode" default:"1m"`
+	Concurrency int           `long:"concurrency" description:"number of concurrent requests in simulate mode" default:"16"`
+	QueryRatio  float64       `long:"query-ratio" description:"ratio of queries to all requests in simulate mode" default:"0.1"`
+	TimeScale   float64       `long:"time-scale" description:"speed of simulated clock for payloads" default:"1"`
+	KeepTags    bool          `long:"keep-tags" description:"keep tags which simulate mode created, with their data"`
+
+	StackTrace bool `long:"trace" description:"display detail error messages"`
 }
 
 func (opts *Options) parse(argv []string) ([]string, error) {
@@ -36,9 +52,12 @@ func (opts *Options) parse(argv []string) ([]string, error) {
 func (opts Options) usage() []byte {
 	buf := bytes.Buffer{}
 	fmt.Fprintf(&buf, `%s: %s
-Usage: %s [options]
+Usage: %s [options] < data.json
+       %s login [--profile name]
+       %s tail --tag name [--hostname host]
+       %s simulate [--devices n] [--tag-count n] [--rate n] [--duration d] [--keep-tags]
 Options:
-`, version, msg, name)
+`, version, msg, name, name, name, name)
 
 	t := reflect.TypeOf(opts)
 	for i := 0; i < t.NumField(); i++ {
@@ -0,0 +1,348 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"math/rand"
+	"os"
+	"os/signal"
+	"sort"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/Code-Hex/exit"
+	"github.com/Code-Hex/vegeta/client"
+	"github.com/pkg/errors"
+)
+
+// simTagPrefix is the prefix of tags created by simulate command.
+// Tag names can contain only letters.
+const simTagPrefix = "simulate"
+
+// cleanupTimeout is how long to wait for deleting tags after simulation.
+const cleanupTimeout = 30 * time.Second
+
+type device struct {
+	hostname string
+	addr     string
+	tag      string
+	// offset shifts the diurnal cycle so that devices are not in lockstep.
+	offset float64
+}
+
+type job struct {
+	dev   *device
+	query bool
+	// scheduled is when the request should be sent by the rate. Latency
+	// is measured from it, so that a slow server which delays later
+	// requests is not hidden (coordinated omission).
+	scheduled time.Time
+}
+
+type simulator struct {
+	client   *client.Client
+	opts     *Options
+	devices  []*device
+	start    time.Time
+	interval time.Duration
+
+	post, find *opStats
+}
+
+// simulate sends data of virtual devices at the configured rate
+// and reports how the server behaves.
+func (c *CLI) simulate() error {
+	opts := &c.Options
+	if opts.Devices < 1 || opts.TagCount < 1 || opts.Rate <= 0 || opts.Concurrency < 1 {
+		return exit.MakeUsage(errors.New("--devices, --tag-count, --rate and --concurrency must be positive"))
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), opts.Duration)
+	defer cancel()
+
+	sigch := make(chan os.Signal, 1)
+	signal.Notify(sigch, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(sigch)
+	go func() {
+		select {
+		case <-sigch:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	s := &simulator{
+		client: c.client,
+		opts:   opts,
+		post:   newOpStats("post data"),
+		find:   newOpStats("find data"),
+	}
+	tags, created, err := s.prepareTags(ctx)
+	if !opts.KeepTags {
+		defer s.deleteTags(created)
+	}
+	if err != nil {
+		return err
+	}
+	s.prepareDevices(tags)
+
+	fmt.Fprintf(os.Stderr, "Simulating %d devices across %d tags at %.1f req/s for %s...\n",
+		len(s.devices), len(tags), opts.Rate, opts.Duration)
+	s.run(ctx)
+	s.report(os.Stdout)
+	return nil
+}
+
+// prepareTags creates tags for simulation which do not exist yet.
+// created are the tags which are made by this run.
+func (s *simulator) prepareTags(ctx context.Context) (tags, created []string, err error) {
+	exists, err := s.client.Tags(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "Failed to get tags")
+	}
+	found := make(map[string]bool, len(exists))
+	for _, t := range exists {
+		found[t] = true
+	}
+	tags = make([]string, s.opts.TagCount)
+	for i := range tags {
+		tags[i] = simTagPrefix + letters(i)
+		if found[tags[i]] {
+			continue
+		}
+		// the tag may be added by another simulator after listing
+		err := s.client.AddTag(ctx, tags[i])
+		if client.IsAlreadyExists(err) {
+			continue
+		}
+		if err != nil {
+			return nil, created, errors.Wrapf(err, "Failed to add tag %s", tags[i])
+		}
+		created = append(created, tags[i])
+	}
+	return tags, created, nil
+}
+
+// deleteTags removes tags with the data which are sent in simulation.
+// Tags which existed before are kept, because they may be used by
+// another simulator.
+func (s *simulator) deleteTags(tags []string) {
+	ctx, cancel := context.WithTimeout(context.Background(), cleanupTimeout)
+	defer cancel()
+	for _, tag := range tags {
+		if err := s.client.DeleteTag(ctx, tag); err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to delete tag %s: %s\n", tag, err)
+		}
+	}
+}
+
+func (s *simulator) prepareDevices(tags []string) {
+	s.devices = make([]*device, s.opts.Devices)
+	for i := range s.devices {
+		s.devices[i] = &device{
+			hostname: fmt.Sprintf("sim-device-%05d", i),
+			addr:     fmt.Sprintf("10.%d.%d.%d", (i>>16)&0xff, (i>>8)&0xff, i&0xff),
+			tag:      tags[i%len(tags)],
+			offset:   rand.Float64() * 2,
+		}
+	}
+}
+
+func (s *simulator) run(ctx context.Context) {
+	jobs := make(chan job, s.opts.Concurrency)
+	var wg sync.WaitGroup
+	for i := 0; i < s.opts.Concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := range jobs {
+				s.do(ctx, j)
+			}
+		}()
+	}
+
+	// Requests are scheduled by the rate from the start, not by the
+	// previous send, so that the rate does not fall behind.
+	s.start = time.Now()
+	s.interval = time.Duration(float64(time.Second) / s.opts.Rate)
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for i := 0; ; i++ {
+		scheduled := s.start.Add(time.Duration(i) * s.interval)
+		select {
+		case <-ctx.Done():
+			close(jobs)
+			wg.Wait()
+			return
+		case <-timer.C:
+		}
+		j := job{
+			dev:       s.devices[i%len(s.devices)],
+			query:     rand.Float64() < s.opts.QueryRatio,
+			scheduled: scheduled,
+		}
+		select {
+		case jobs <- j:
+		default:
+			// All workers are busy. Waiting for them would lower the
+			// rate silently, so the request is reported as dropped.
+			s.stats(j).drop()
+		}
+		timer.Reset(time.Until(s.start.Add(time.Duration(i+1) * s.interval)))
+	}
+}
+
+func (s *simulator) stats(j job) *opStats {
+	if j.query {
+		return s.find
+	}
+	return s.post
+}
+
+func (s *simulator) do(ctx context.Context, j job) {
+	// The request is late if it waited for a worker longer than the
+	// interval.
+	late := time.Since(j.scheduled) > s.interval
+	var err error
+	if j.query {
+		_, err = s.client.FindData(ctx, &client.Query{
+			Tag:   j.dev.tag,
+			Span:  client.SpanWeek,
+			Limit: 100,
+		})
+	} else {
+		err = s.client.PostData(ctx, j.dev.tag, &client.Data{
+			Hostname:   j.dev.hostname,
+			RemoteAddr: j.dev.addr,
+			Payload:    j.dev.payload(s.simulatedTime()),
+		})
+	}
+	if ctx.Err() != nil {
+		return // canceled by the end of simulation
+	}
+	s.stats(j).record(time.Since(j.scheduled), late, err)
+}
+
+// simulatedTime returns the time in simulation. It runs faster
+// than the wall clock by --time-scale.
+func (s *simulator) simulatedTime() time.Time {
+	elapsed := time.Since(s.start)
+	return s.start.Add(time.Duration(float64(elapsed) * s.opts.TimeScale))
+}
+
+// payload returns the temperature which peaks in the afternoon and
+// the humidity which moves in the opposite direction.
+func (d *device) payload(t time.Time) string {
+	hour := float64(t.Hour()) + float64(t.Minute())/60 + d.offset
+	cycle := math.Sin(2 * math.Pi * (hour - 9) / 24)
+	temperature := 20 + 6*cycle + rand.NormFloat64()*0.3
+	humidity := 60 - 15*cycle + rand.NormFloat64()*1.5
+	humidity = math.Max(0, math.Min(100, humidity))
+	b, _ := json.Marshal(map[string]float64{
+		"temperature": math.Round(temperature*10) / 10,
+		"humidity":    math.Round(humidity*10) / 10,
+	})
+	return string(b)
+}
+
+func (s *simulator) report(w io.Writer) {
+	elapsed := time.Since(s.start)
+	total := s.post.count() + s.find.count()
+	fmt.Fprintf(w, "Duration: %s\n", elapsed.Round(time.Millisecond))
+	fmt.Fprintf(w, "Requests: %d (%.1f req/s)\n", total, float64(total)/elapsed.Seconds())
+	fmt.Fprintf(w, "Latency is measured from the scheduled time. Late requests waited for a worker,\n")
+	fmt.Fprintf(w, "and dropped ones were not sent because all workers were busy.\n\n")
+	fmt.Fprintf(w, "%-10s %8s %16s %8s %8s %10s %10s %10s %10s\n", "", "count", "errors", "late", "dropped", "p50", "p90", "p99", "max")
+	s.post.report(w)
+	s.find.report(w)
+	s.post.reportErrors(w)
+	s.find.reportErrors(w)
+}
+
+// letters converts n into a name which consists of only letters:
+// 0 -> "A", 25 -> "Z", 26 -> "AA"...
+func letters(n int) string {
+	var b []byte
+	for n++; n > 0; n = (n - 1) / 26 {
+		b = append([]byte{byte('A' + (n-1)%26)}, b...)
+	}
+	return string(b)
+}
+
+type opStats struct {
+	mu        sync.Mutex
+	name      string
+	latencies []time.Duration
+	errors    int
+	late      int
+	dropped   int
+	reasons   map[string]int
+}
+
+func newOpStats(name string) *opStats {
+	return &opStats{
+		name:    name,
+		reasons: make(map[string]int),
+	}
+}
+
+func (o *opStats) record(d time.Duration, late bool, err error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.latencies = append(o.latencies, d)
+	if late {
+		o.late++
+	}
+	if err != nil {
+		o.errors++
+		o.reasons[err.Error()]++
+	}
+}
+
+func (o *opStats) drop() {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.dropped++
+}
+
+func (o *opStats) count() int {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return len(o.latencies)
+}
+
+func (o *opStats) report(w io.Writer) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	n := len(o.latencies)
+	if n == 0 {
+		fmt.Fprintf(w, "%-10s %8d %16s %8d %8d\n", o.name, 0, "", 0, o.dropped)
+		return
+	}
+	sorted := make([]time.Duration, n)
+	copy(sorted, o.latencies)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	percentile := func(p float64) time.Duration {
+		return sorted[int(math.Ceil(p*float64(n)))-1].Round(time.Microsecond)
+	}
+	errs := fmt.Sprintf("%d (%.1f%%)", o.errors, float64(o.errors)/float64(n)*100)
+	fmt.Fprintf(w, "%-10s %8d %16s %8d %8d %10s %10s %10s %10s\n",
+		o.name, n, errs, o.late, o.dropped,
+		percentile(0.50), percentile(0.90), percentile(0.99), sorted[n-1].Round(time.Microsecond),
+	)
+}
+
+func (o *opStats) reportErrors(w io.Writer) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if len(o.reasons) == 0 {
+		return
+	}
+	fmt.Fprintf(w, "\nErrors of %s:\n", o.name)
+	for reason, n := range o.reasons {
+		fmt.Fprintf(w, "  %6d x %s\n", n, reason)
+	}
+}
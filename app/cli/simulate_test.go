@@ -0,0 +1,114 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Code-Hex/vegeta/client"
+)
+
+// fakeServer answers the routes which simulate mode uses. Posting data
+// takes delay.
+type fakeServer struct {
+	mu      sync.Mutex
+	delay   time.Duration
+	deleted []string
+}
+
+func (f *fakeServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	const tags = "/api/v1/tags"
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == tags:
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"tags":["simulateA"]}`))
+	case r.Method == http.MethodPost && r.URL.Path == tags:
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, tags+"/"):
+		f.mu.Lock()
+		f.deleted = append(f.deleted, strings.TrimPrefix(r.URL.Path, tags+"/"))
+		f.mu.Unlock()
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodPost:
+		time.Sleep(f.delay)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func newSimulator(t *testing.T, f *fakeServer, opts Options) *simulator {
+	t.Helper()
+	ts := httptest.NewServer(f)
+	t.Cleanup(ts.Close)
+	cl, err := client.New(ts.URL, "token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if opts.Devices == 0 {
+		opts.Devices = 4
+	}
+	if opts.TagCount == 0 {
+		opts.TagCount = 2
+	}
+	s := &simulator{
+		client: cl,
+		opts:   &opts,
+		post:   newOpStats("post data"),
+		find:   newOpStats("find data"),
+	}
+	s.prepareDevices([]string{"simulateA"})
+	return s
+}
+
+func TestSimulateReportsDroppedSends(t *testing.T) {
+	f := &fakeServer{delay: 100 * time.Millisecond}
+	s := newSimulator(t, f, Options{Rate: 100, Concurrency: 1})
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	s.run(ctx)
+
+	// The single worker can send only a few of 50 requests, and the
+	// schedule is kept instead of waiting for it.
+	if s.post.dropped == 0 {
+		t.Error("no sends are dropped")
+	}
+	if s.post.late == 0 {
+		t.Error("no sends are late")
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("run took %s", elapsed)
+	}
+	// Sends wait for the worker in the queue, and the wait is included.
+	var max time.Duration
+	for _, d := range s.post.latencies {
+		if d > max {
+			max = d
+		}
+	}
+	if max < 150*time.Millisecond {
+		t.Errorf("max latency is %s, want the wait in the queue included", max)
+	}
+}
+
+func TestSimulateDeletesCreatedTags(t *testing.T) {
+	f := &fakeServer{}
+	s := newSimulator(t, f, Options{TagCount: 3})
+	tags, created, err := s.prepareTags(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tags) != 3 {
+		t.Fatalf("tags = %v", tags)
+	}
+	s.deleteTags(created)
+	// simulateA existed before the run.
+	if got := strings.Join(f.deleted, ","); got != "simulateB,simulateC" {
+		t.Errorf("deleted %s, want simulateB,simulateC", got)
+	}
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"sort"
+	"syscall"
+
+	"github.com/Code-Hex/exit"
+	"github.com/Code-Hex/vegeta/client"
+	"github.com/pkg/errors"
+)
+
+// tail prints data of the tag until it is interrupted.
+func (c *CLI) tail() error {
+	if c.Tag == "" {
+		return exit.MakeUsage(errors.New("the required flag `-t, --tag' was not specified"))
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sigch := make(chan os.Signal, 1)
+	signal.Notify(sigch, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(sigch)
+	go func() {
+		select {
+		case <-sigch:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	fmt.Fprintf(os.Stderr, "Waiting for data of %s...\n", c.Tag)
+	err := c.client.Tail(ctx, c.Tag, c.Hostname, func(data *client.Data) error {
+		printData(os.Stdout, data)
+		return nil
+	})
+	if err != nil && ctx.Err() == nil {
+		return errors.Wrap(err, "Failed to tail data")
+	}
+	return nil
+}
+
+func printData(w io.Writer, data *client.Data) {
+	fmt.Fprintf(w, "%s  %s (%s)\n",
+		data.UpdatedAt.Local().Format("2006-01-02 15:04:05"),
+		data.Hostname,
+		data.RemoteAddr,
+	)
+	var payload map[string]interface{}
+	if err := json.Unmarshal([]byte(data.Payload), &payload); err != nil {
+		fmt.Fprintf(w, "  %s\n", data.Payload)
+		return
+	}
+	keys := make([]string, 0, len(payload))
+	width := 0
+	for k := range payload {
+		keys = append(keys, k)
+		if len(k) > width {
+			width = len(k)
+		}
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		v, _ := json.Marshal(payload[k])
+		fmt.Fprintf(w, "  %-*s %s\n", width+1, k+":", v)
+	}
+}
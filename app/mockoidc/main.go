@@ -0,0 +1,50 @@
+// mockoidc serves a mock OpenID Connect provider to try the single
+// sign-on of vegeta locally. Configure vegeta with
+//
+//	[oidc]
+//	issuer = "http://localhost:9000"
+//	client_id = "vegeta"
+//	client_secret = "secret"
+//	redirect_url = "http://localhost:3000/auth/oidc/callback"
+//	admin_group = "admin"
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/Code-Hex/vegeta/internal/mockoidc"
+	flags "github.com/jessevdk/go-flags"
+)
+
+type Options struct {
+	Addr         string `long:"addr" default:"localhost:9000" description:"address to listen on"`
+	Issuer       string `long:"issuer" default:"http://localhost:9000" description:"issuer url which vegeta uses"`
+	ClientID     string `long:"client-id" default:"vegeta" description:"client id of vegeta"`
+	ClientSecret string `long:"client-secret" default:"secret" description:"client secret of vegeta"`
+	User         string `long:"user" description:"log in the user without the form"`
+	Groups       string `long:"groups" description:"comma separated groups of --user"`
+}
+
+func main() {
+	var opts Options
+	if _, err := flags.Parse(&opts); err != nil {
+		os.Exit(2)
+	}
+	p, err := mockoidc.New(opts.Issuer, opts.ClientID, opts.ClientSecret)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error:\n  %v\n", err)
+		os.Exit(1)
+	}
+	p.User = opts.User
+	if opts.Groups != "" {
+		p.Groups = strings.Split(opts.Groups, ",")
+	}
+	fmt.Printf("Mock OpenID Connect provider is serving %s on %s\n", opts.Issuer, opts.Addr)
+	if err := http.ListenAndServe(opts.Addr, p); err != nil {
+		fmt.Fprintf(os.Stderr, "Error:\n  %v\n", err)
+		os.Exit(1)
+	}
+}
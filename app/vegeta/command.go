@@ -0,0 +1,314 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"os/user"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/Code-Hex/exit"
+	"github.com/Code-Hex/vegeta/internal/model"
+	"github.com/Code-Hex/vegeta/internal/utils"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+// command runs a management subcommand against the database
+// without starting the HTTP server.
+func (c *CLI) command(args []string) error {
+	cmd, args := args[0], args[1:]
+	var sub string
+	if 0 < len(args) {
+		sub, args = args[0], args[1:]
+	}
+	switch {
+	case cmd == "migrate":
+		return c.migrate()
+	case cmd == "user" && sub == "list":
+		return c.listUsers()
+	case cmd == "user" && sub == "create":
+		return c.createUser(args)
+	case cmd == "user" && sub == "reset-password":
+		return c.resetPassword(args)
+	case cmd == "user" && sub == "suspend":
+		return c.suspendUser(args)
+	case cmd == "user" && sub == "resume":
+		return c.resumeUser(args)
+	case cmd == "user" && sub == "delete":
+		return c.deleteUser(args)
+	case cmd == "tag" && sub == "list":
+		return c.listTags(args)
+	case cmd == "token" && sub == "rotate":
+		return c.rotateToken(args)
+	}
+	stdout.Write(c.Options.usage())
+	return exit.MakeUsage(errors.Errorf("Unknown command: %s", strings.TrimSpace(cmd+" "+sub)))
+}
+
+// migrate creates tables and the first admin user. The admin is taken from
+// --admin-name and --admin-password, $VEGETA_ADMIN_NAME and $VEGETA_ADMIN_PASSWORD,
+// or asked on the terminal.
+func (c *CLI) migrate() error {
+	if err := model.Migrate(c.db); err != nil {
+		return errors.Wrap(err, "Failed to migrate")
+	}
+	users, err := model.GetUsers(c.db)
+	if err == nil && len(users) > 0 {
+		return nil
+	}
+	name := firstNonEmpty(c.AdminName, os.Getenv("VEGETA_ADMIN_NAME"))
+	password := firstNonEmpty(c.AdminPassword, os.Getenv("VEGETA_ADMIN_PASSWORD"))
+	if name == "" || password == "" {
+		if !isTerminal() {
+			return exit.MakeConfig(errors.New("admin name and password are required, set --admin-name and --admin-password"))
+		}
+		if name == "" {
+			name, err = readLine("Admin name: ")
+			if err != nil {
+				return err
+			}
+		}
+		if password == "" {
+			password, err = readPassword("Admin password: ")
+			if err != nil {
+				return err
+			}
+		}
+	}
+	if _, err := model.CreateUser(c.db, name, password, true); err != nil {
+		return errors.Wrap(err, "Failed to create admin user")
+	}
+	c.audit(model.AuditUserCreate, name, "admin=true")
+	fmt.Fprintf(stdout, "Created admin user %s\n", name)
+	return nil
+}
+
+func (c *CLI) listUsers() error {
+	users, err := model.GetUsers(c.db)
+	if err != nil {
+		return errors.Wrap(err, "Failed to get users")
+	}
+	w := tabwriter.NewWriter(stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tNAME\tADMIN\tCREATED")
+	for _, u := range users {
+		fmt.Fprintf(w, "%d\t%s\t%t\t%s\n", u.ID, u.Name, u.Admin, u.CreatedAt.Format("2006-01-02 15:04:05"))
+	}
+	return w.Flush()
+}
+
+func (c *CLI) createUser(args []string) error {
+	name, err := nameArg(args, "user create <name>")
+	if err != nil {
+		return err
+	}
+	password, err := readPassword("Password: ")
+	if err != nil {
+		return err
+	}
+	if password == "" {
+		return exit.MakeDataErr(errors.New("password is empty"))
+	}
+	user, err := model.CreateUser(c.db, name, password, c.Admin)
+	if err != nil {
+		return errors.Wrap(err, "Failed to create user")
+	}
+	c.audit(model.AuditUserCreate, user.Name, "admin="+strconv.FormatBool(user.Admin))
+	fmt.Fprintf(stdout, "Created user %s (id: %d)\n", user.Name, user.ID)
+	return nil
+}
+
+func (c *CLI) resetPassword(args []string) error {
+	user, err := c.findUser(args, "user reset-password <name>")
+	if err != nil {
+		return err
+	}
+	password, err := readPassword("New password: ")
+	if err != nil {
+		return err
+	}
+	generated := password == ""
+	if generated {
+		password = utils.RandomString()
+	}
+	if _, err := user.UpdatePassword(c.db, password); err != nil {
+		return errors.Wrap(err, "Failed to update password")
+	}
+	c.audit(model.AuditPasswordReset, user.Name, "")
+	if generated {
+		fmt.Fprintf(stdout, "Password of %s is reset to %s\n", user.Name, password)
+	} else {
+		fmt.Fprintf(stdout, "Password of %s is updated\n", user.Name)
+	}
+	return nil
+}
+
+func (c *CLI) suspendUser(args []string) error {
+	user, err := c.findUser(args, "user suspend <name>")
+	if err != nil {
+		return err
+	}
+	if _, err := model.SuspendUser(c.db, strconv.FormatUint(uint64(user.ID), 10), time.Now()); err != nil {
+		return errors.Wrap(err, "Failed to suspend user")
+	}
+	c.audit(model.AuditUserSuspend, user.Name, "")
+	fmt.Fprintf(stdout, "Suspended user %s\n", user.Name)
+	return nil
+}
+
+func (c *CLI) resumeUser(args []string) error {
+	user, err := c.findUser(args, "user resume <name>")
+	if err != nil {
+		return err
+	}
+	if _, err := model.ResumeUser(c.db, strconv.FormatUint(uint64(user.ID), 10)); err != nil {
+		return errors.Wrap(err, "Failed to resume user")
+	}
+	c.audit(model.AuditUserResume, user.Name, "")
+	fmt.Fprintf(stdout, "Resumed user %s\n", user.Name)
+	return nil
+}
+
+// deleteUser asks the name again to confirm, because tags and data
+// of the user are removed too.
+func (c *CLI) deleteUser(args []string) error {
+	user, err := c.findUser(args, "user delete <name>")
+	if err != nil {
+		return err
+	}
+	confirm, err := readLine("Tags and data of the user are deleted too. Type the name to confirm: ")
+	if err != nil {
+		return err
+	}
+	if _, err := model.DeleteUser(c.db, strconv.FormatUint(uint64(user.ID), 10), confirm); err != nil {
+		return errors.Wrap(err, "Failed to delete user")
+	}
+	c.audit(model.AuditUserDelete, user.Name, "")
+	fmt.Fprintf(stdout, "Deleted user %s\n", user.Name)
+	return nil
+}
+
+func (c *CLI) listTags(args []string) error {
+	users, err := model.GetUsers(c.db)
+	if err != nil {
+		return errors.Wrap(err, "Failed to get users")
+	}
+	names := make(map[uint]string, len(users))
+	for _, u := range users {
+		names[u.ID] = u.Name
+	}
+	tags, err := model.GetTags(c.db)
+	if err != nil {
+		return errors.Wrap(err, "Failed to get tags")
+	}
+	w := tabwriter.NewWriter(stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tNAME\tUSER")
+	for _, t := range tags {
+		owner := names[t.UserID]
+		if 0 < len(args) && owner != args[0] {
+			continue
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\n", t.ID, t.Name, owner)
+	}
+	return w.Flush()
+}
+
+func (c *CLI) rotateToken(args []string) error {
+	user, err := c.findUser(args, "token rotate <user>")
+	if err != nil {
+		return err
+	}
+	if _, err := user.ReGenerateUserToken(c.db); err != nil {
+		return errors.Wrap(err, "Failed to regenerate token")
+	}
+	c.audit(model.AuditTokenRegenerate, user.Name, "")
+	fmt.Fprintln(stdout, user.Token)
+	return nil
+}
+
+func (c *CLI) findUser(args []string, usage string) (*model.User, error) {
+	name, err := nameArg(args, usage)
+	if err != nil {
+		return nil, err
+	}
+	user, err := model.FindUserByName(c.db, name)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to find user")
+	}
+	return user, nil
+}
+
+// audit records the command. The change is already done,
+// so a failure is only reported.
+func (c *CLI) audit(action, target, detail string) {
+	actor := "cli"
+	if u, err := user.Current(); err == nil {
+		actor += ":" + u.Username
+	}
+	err := model.Audit(c.db, &model.AuditLog{
+		Actor:     actor,
+		Action:    action,
+		Target:    target,
+		Detail:    detail,
+		CreatedAt: time.Now(),
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to write audit log: %v\n", err)
+	}
+}
+
+func nameArg(args []string, usage string) (string, error) {
+	if len(args) != 1 || args[0] == "" {
+		return "", exit.MakeUsage(errors.Errorf("Usage: %s %s", name, usage))
+	}
+	return args[0], nil
+}
+
+func isTerminal() bool {
+	return terminal.IsTerminal(int(os.Stdin.Fd()))
+}
+
+// readPassword asks a password on the terminal, otherwise
+// reads the first line from stdin so that it can be piped.
+func readPassword(prompt string) (string, error) {
+	if isTerminal() {
+		fmt.Fprint(stdout, prompt)
+		b, err := terminal.ReadPassword(int(os.Stdin.Fd()))
+		fmt.Fprint(stdout, "\n")
+		if err != nil {
+			return "", errors.Wrap(err, "Failed to read password")
+		}
+		return string(b), nil
+	}
+	line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && err != io.EOF {
+		return "", errors.Wrap(err, "Failed to read password")
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
+
+// readLine asks a line on the terminal, otherwise reads the first
+// line from stdin.
+func readLine(prompt string) (string, error) {
+	if isTerminal() {
+		fmt.Fprint(stdout, prompt)
+	}
+	line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && err != io.EOF {
+		return "", errors.Wrap(err, "Failed to read line")
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
+
+func firstNonEmpty(s ...string) string {
+	for _, v := range s {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,177 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Code-Hex/exit"
+	"github.com/Code-Hex/vegeta/internal/model"
+	"github.com/jinzhu/gorm"
+	_ "github.com/jinzhu/gorm/dialects/sqlite"
+)
+
+// newTestCLI returns a CLI with an in-memory database, and captures
+// what commands print.
+func newTestCLI(t *testing.T) (*CLI, *bytes.Buffer) {
+	t.Helper()
+	db, err := gorm.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Each connection of :memory: has its own database.
+	db.DB().SetMaxOpenConns(1)
+	db.LogMode(false)
+	// Names of indexes are shared by all tables in SQLite, so idx_name of
+	// tags is made by another name. gorm finds it by the comment, which is
+	// kept in the schema, and does not create it again.
+	if err := db.AutoMigrate(&model.Tag{}).Error; err != nil {
+		t.Fatal(err)
+	}
+	for _, q := range []string{
+		"DROP INDEX idx_name",
+		"CREATE INDEX idx_tags_name /* INDEX idx_name ON */ ON tags(name)",
+	} {
+		if err := db.Exec(q).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+	t.Setenv("VEGETA_ADMIN_NAME", "")
+	t.Setenv("VEGETA_ADMIN_PASSWORD", "")
+
+	out := new(bytes.Buffer)
+	orig := stdout
+	stdout = out
+	t.Cleanup(func() {
+		stdout = orig
+		db.Close()
+	})
+	c := New()
+	c.db = db
+	return c, out
+}
+
+// setStdin replaces stdin with a file which is not a terminal.
+func setStdin(t *testing.T, input string) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "stdin")
+	if err := ioutil.WriteFile(path, []byte(input), 0600); err != nil {
+		t.Fatal(err)
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	orig := os.Stdin
+	os.Stdin = f
+	t.Cleanup(func() {
+		os.Stdin = orig
+		f.Close()
+	})
+}
+
+func TestMigrateCreatesAdmin(t *testing.T) {
+	tests := []struct {
+		name           string
+		flagName       string
+		flagPassword   string
+		envPassword    string
+		wantAdmin      string
+		wantConfigFail bool
+	}{
+		{name: "flags", flagName: "root", flagPassword: "rootpass1234", wantAdmin: "root"},
+		{name: "password by env", flagName: "root", envPassword: "rootpass1234", wantAdmin: "root"},
+		{name: "no password", flagName: "root", wantConfigFail: true},
+		{name: "no name", flagPassword: "rootpass1234", wantConfigFail: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, out := newTestCLI(t)
+			t.Setenv("VEGETA_ADMIN_PASSWORD", tt.envPassword)
+			// Nothing is asked on stdin which is not a terminal.
+			setStdin(t, "typed\ntyped\n")
+			c.AdminName = tt.flagName
+			c.AdminPassword = tt.flagPassword
+
+			err := c.command([]string{"migrate"})
+			users, uerr := model.GetUsers(c.db)
+			if uerr != nil {
+				t.Fatal(uerr)
+			}
+			if tt.wantConfigFail {
+				if code, _ := UnwrapErrors(err); code != exit.CONFIG {
+					t.Errorf("exit code is %d by %v, want %d", code, err, exit.CONFIG)
+				}
+				if len(users) != 0 {
+					t.Errorf("%d users are created", len(users))
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(users) != 1 || users[0].Name != tt.wantAdmin || !users[0].Admin {
+				t.Fatalf("users are %+v", users)
+			}
+			if _, err := model.BasicAuth(c.db, tt.wantAdmin, "rootpass1234"); err != nil {
+				t.Errorf("admin can not log in: %v", err)
+			}
+			if !strings.Contains(out.String(), "Created admin user "+tt.wantAdmin) {
+				t.Errorf("output is %q", out.String())
+			}
+
+			// The admin is created only once.
+			c.AdminName = "other"
+			if err := c.command([]string{"migrate"}); err != nil {
+				t.Fatal(err)
+			}
+			if users, _ := model.GetUsers(c.db); len(users) != 1 {
+				t.Errorf("%d users after migrating again", len(users))
+			}
+		})
+	}
+}
+
+func TestDeleteUserConfirmation(t *testing.T) {
+	c, out := newTestCLI(t)
+	c.AdminName, c.AdminPassword = "root", "rootpass1234"
+	setStdin(t, "")
+	if err := c.command([]string{"migrate"}); err != nil {
+		t.Fatal(err)
+	}
+	alice, err := model.CreateUser(c.db, "alice", "password1234", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := alice.AddTag(c.db, "room"); err != nil {
+		t.Fatal(err)
+	}
+
+	setStdin(t, "alic\n")
+	if err := c.command([]string{"user", "delete", "alice"}); err == nil {
+		t.Fatal("deleted by a wrong confirmation")
+	}
+	if _, err := model.FindUserByName(c.db, "alice"); err != nil {
+		t.Fatalf("alice is deleted by a wrong confirmation: %v", err)
+	}
+	if tags, _ := model.GetTags(c.db); len(tags) != 1 {
+		t.Errorf("%d tags are left, want 1", len(tags))
+	}
+
+	setStdin(t, "alice\n")
+	if err := c.command([]string{"user", "delete", "alice"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := model.FindUserByName(c.db, "alice"); err == nil {
+		t.Error("alice is not deleted")
+	}
+	if tags, _ := model.GetTags(c.db); len(tags) != 0 {
+		t.Errorf("%d tags are left, want 0", len(tags))
+	}
+	if !strings.Contains(out.String(), "Deleted user alice") {
+		t.Errorf("output is %q", out.String())
+	}
+}
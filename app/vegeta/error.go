@@ -1,4 +1,4 @@
-package vegeta
+package main
 
 import "github.com/Code-Hex/exit"
 
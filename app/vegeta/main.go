@@ -1,12 +1,194 @@
 package main
 
 import (
+	"context"
+	"fmt"
+	"io"
+	"net"
 	"os"
+	"os/signal"
+	"syscall"
 
+	"github.com/Code-Hex/exit"
 	"github.com/Code-Hex/vegeta"
 	_ "github.com/go-sql-driver/mysql"
+	"github.com/jinzhu/gorm"
+	"github.com/lestrrat/go-server-starter/listener"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
 )
 
+const (
+	version = vegeta.Version
+	name    = "vegeta"
+	msg     = name + " project to collect large amounts of vegetable data using IoT"
+)
+
+var stdout io.Writer = os.Stdout
+
+type CLI struct {
+	Options
+	config *vegeta.Config
+	// db is used by subcommands and --migrate.
+	db *gorm.DB
+}
+
 func main() {
-	os.Exit(vegeta.New().Run())
+	os.Exit(New().Run())
+}
+
+func New() *CLI {
+	return &CLI{}
+}
+
+func (c *CLI) Run() int {
+	defer c.close()
+	if e := c.run(); e != nil {
+		exitCode, err := UnwrapErrors(e)
+		if c.StackTrace {
+			fmt.Fprintf(os.Stderr, "Error:\n  %+v\n", e)
+		} else {
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error:\n  %v\n", err)
+			}
+		}
+		return exitCode
+	}
+	return 0
+}
+
+func (c *CLI) run() error {
+	args, err := c.prepare()
+	if err != nil {
+		return errors.Wrap(err, "Failed to prepare")
+	}
+	if 0 < len(args) {
+		return c.command(args)
+	}
+	if c.Migrate {
+		if err := c.migrate(); err != nil {
+			return err
+		}
+		return makeIgnore()
+	}
+	return c.serve()
+}
+
+func (c *CLI) close() {
+	if c.db != nil {
+		c.db.Close()
+	}
+}
+
+func (c *CLI) prepare() ([]string, error) {
+	args, err := parseOptions(&c.Options, os.Args[1:])
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to parse command line args")
+	}
+	c.config, err = c.loadConfig()
+	if err != nil {
+		return nil, err
+	}
+	if err := c.config.Password.Apply(); err != nil {
+		return nil, exit.MakeConfig(err)
+	}
+	// Subcommands need only the database.
+	if 0 < len(args) || c.Migrate {
+		c.db, err = gorm.Open("mysql", c.config.Database.DSN())
+		if err != nil {
+			return nil, exit.MakeUnAvailable(errors.Wrap(err, "Failed to open database"))
+		}
+	}
+	return args, nil
+}
+
+// loadConfig reads the config and overrides it by command line options.
+func (c *CLI) loadConfig() (*vegeta.Config, error) {
+	config, err := vegeta.LoadConfig(c.Config)
+	if err != nil {
+		return nil, err
+	}
+	if c.Port != 0 {
+		config.Server.Port = c.Port
+	}
+	if len(c.TrustedProxies) > 0 {
+		config.Server.TrustedProxies = c.TrustedProxies
+	}
+	if err := config.Validate(); err != nil {
+		return nil, exit.MakeConfig(err)
+	}
+	return config, nil
+}
+
+func (c *CLI) serve() error {
+	sigch := make(chan os.Signal, 1)
+	signal.Notify(
+		sigch,
+		syscall.SIGINT,
+		syscall.SIGTERM,
+		syscall.SIGHUP,
+	)
+	li, err := c.listen()
+	if err != nil {
+		return err
+	}
+	server, err := vegeta.New(
+		vegeta.WithConfig(c.config),
+		vegeta.WithListener(li),
+	)
+	if err != nil {
+		li.Close()
+		return err
+	}
+	defer server.Close()
+	go func() {
+		if err := server.Serve(); err != nil {
+			server.Error("Server is stopped", zap.Error(err))
+		}
+	}()
+	return c.wait(server, sigch)
+}
+
+// listen uses the listener of server-starter if it runs under it.
+func (c *CLI) listen() (net.Listener, error) {
+	if os.Getenv("SERVER_STARTER_PORT") != "" {
+		listeners, err := listener.ListenAll()
+		if err != nil {
+			return nil, errors.Wrap(err, "server-starter error")
+		}
+		if 0 < len(listeners) {
+			return listeners[0], nil
+		}
+	}
+	li, err := net.Listen("tcp", fmt.Sprintf(":%d", c.config.Server.Port))
+	if err != nil {
+		return nil, errors.Wrap(err, "listen error")
+	}
+	fmt.Println("Start Server at", li.Addr().String())
+	return li, nil
+}
+
+// wait reloads the config on SIGHUP, and shuts down the server
+// on the other signals.
+func (c *CLI) wait(server *vegeta.Vegeta, sigch <-chan os.Signal) error {
+	for {
+		sig := <-sigch
+		if sig != syscall.SIGHUP {
+			server.Info("Received signal", zap.String("signal", sig.String()))
+			break
+		}
+		config, err := c.loadConfig()
+		if err == nil {
+			err = server.Reload(config)
+		}
+		if err != nil {
+			server.Error("Failed to reload", zap.Error(err))
+			continue
+		}
+		c.config = config
+		server.Info("Reloaded config")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), c.config.Server.ShutdownTimeout.Duration)
+	defer cancel()
+	return server.Shutdown(ctx)
 }
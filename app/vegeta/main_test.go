@@ -0,0 +1,58 @@
+package main
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+)
+
+func writeConfig(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "vegeta.toml")
+	if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadConfigFlagsOverride(t *testing.T) {
+	t.Setenv("VEGETA_CONFIG", "")
+	path := writeConfig(t, `
+secret = "secret"
+
+[server]
+port = 4000
+trusted_proxies = ["10.0.0.1"]
+
+[database]
+username = "vegeta"
+database = "vegeta"
+`)
+	t.Setenv("VEGETA_PORT", "5000")
+
+	c := New()
+	c.Config = path
+	config, err := c.loadConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if config.Server.Port != 5000 || len(config.Server.TrustedProxies) != 1 {
+		t.Errorf("without flags: port = %d, proxies = %v", config.Server.Port, config.Server.TrustedProxies)
+	}
+
+	c.Port = 6000
+	c.TrustedProxies = []string{"127.0.0.1", "::1"}
+	config, err = c.loadConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if config.Server.Port != 6000 || len(config.Server.TrustedProxies) != 2 {
+		t.Errorf("with flags: port = %d, proxies = %v", config.Server.Port, config.Server.TrustedProxies)
+	}
+
+	// Flags are validated with the rest.
+	c.Port = 70000
+	if _, err := c.loadConfig(); err == nil {
+		t.Error("invalid port by the flag is accepted")
+	}
+}
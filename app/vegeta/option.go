@@ -0,0 +1,94 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+
+	"reflect"
+
+	"github.com/Code-Hex/exit"
+	flags "github.com/jessevdk/go-flags"
+	"github.com/pkg/errors"
+)
+
+const indent = "        "
+
+// Options struct for parse command line arguments
+type Options struct {
+	Help    bool `short:"h" long:"help" description:"show this message"`
+	Version bool `short:"v" long:"version" description:"print the version"`
+
+	Config     string `short:"c" long:"config" description:"specify the config file"`
+	Port       int    `short:"p" long:"port" description:"specify the port number (default: 3000)"`
+	Migrate    bool   `long:"migrate" description:"migrate mysql for this program"`
+	StackTrace bool   `long:"trace" description:"display detail error messages"`
+
+	AdminName     string `long:"admin-name" description:"name of the first admin user created by migrate"`
+	AdminPassword string `long:"admin-password" description:"password of the first admin user created by migrate"`
+	Admin         bool   `long:"admin" description:"create the user as an admin in user create"`
+
+	TrustedProxies []string `long:"trusted-proxy" description:"trust forwarded headers from the address or cidr (repeatable)"`
+}
+
+func (opts *Options) parse(argv []string) ([]string, error) {
+	p := flags.NewParser(opts, flags.None)
+	args, err := p.ParseArgs(argv)
+	if err != nil {
+		return nil, exit.MakeDataErr(err)
+	}
+	return args, nil
+}
+
+func (opts Options) usage() []byte {
+	buf := bytes.Buffer{}
+	fmt.Fprintf(&buf, `%s: %s
+Usage: %s [options] [command]
+Commands:
+  migrate                       migrate mysql and create the first admin user
+  user list                     list users
+  user create <name>            create a user, password is read from stdin
+  user reset-password <name>    reset password, a random one is generated if stdin is empty
+  user suspend <name>           suspend a user, who can not log in or use tokens
+  user resume <name>            resume a suspended user
+  user delete <name>            delete a user with tags and data, the name is read from stdin to confirm
+  tag list [<user>]             list tags
+  token rotate <user>           regenerate the api token of the user
+Options:
+`, version, msg, name)
+
+	t := reflect.TypeOf(opts)
+	for i := 0; i < t.NumField(); i++ {
+		tag := t.Field(i).Tag
+		desc := tag.Get("description")
+		var o string
+		if s := tag.Get("short"); s != "" {
+			o = fmt.Sprintf("-%s, --%s", tag.Get("short"), tag.Get("long"))
+		} else {
+			o = fmt.Sprintf("--%s", tag.Get("long"))
+		}
+		fmt.Fprintf(&buf, "  %-21s %s\n", o, desc)
+
+		if deflt := tag.Get("default"); deflt != "" {
+			fmt.Fprintf(&buf, "  %-21s   default: --%s='%s'\n", indent, tag.Get("long"), deflt)
+		}
+	}
+
+	return buf.Bytes()
+}
+
+func parseOptions(opts *Options, argv []string) ([]string, error) {
+	o, err := opts.parse(argv)
+	if err != nil {
+		stdout.Write(opts.usage())
+		return nil, errors.Wrap(err, "invalid command line options")
+	}
+	if opts.Version {
+		fmt.Fprintf(stdout, "%s: %s\n", version, msg)
+		return nil, makeIgnore()
+	}
+	if opts.Help {
+		stdout.Write(opts.usage())
+		return nil, makeIgnore()
+	}
+	return o, nil
+}
@@ -0,0 +1,159 @@
+package vegeta
+
+import (
+	"archive/zip"
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/Code-Hex/vegeta/internal/model"
+	"github.com/jinzhu/gorm"
+	"github.com/labstack/echo"
+	"github.com/pkg/errors"
+)
+
+// userArchiveJSON is user.json in the archive of a user.
+type userArchiveJSON struct {
+	User       *userJSON `json:"user"`
+	ExportedAt time.Time `json:"exported_at"`
+}
+
+// tagArchiveJSON is a file in tags/ of the archive. It is written by
+// writeTagArchive.
+type tagArchiveJSON struct {
+	Name      string       `json:"name"`
+	CreatedAt time.Time    `json:"created_at"`
+	Data      []model.Data `json:"data"`
+}
+
+// writeUserArchive writes a zip of the user and the tags with their data,
+// so that they can be kept before the user is deleted. Data are read by
+// a cursor and encoded one by one, because data of a tag may be large.
+// The export is audited only when the whole archive is written.
+func (c *Context) writeUserArchive(actor, user *model.User) error {
+	now := c.Now()
+	h := c.Response().Header()
+	h.Set(echo.HeaderContentType, "application/zip")
+	h.Set(echo.HeaderContentDisposition,
+		`attachment; filename="user-`+strconv.FormatUint(uint64(user.ID), 10)+`-`+now.Format("20060102")+`.zip"`,
+	)
+	c.Response().WriteHeader(http.StatusOK)
+
+	zw := zip.NewWriter(c.Response())
+	err := writeZipJSON(zw, "user.json", now, &userArchiveJSON{
+		User:       newUserJSON(user),
+		ExportedAt: now,
+	})
+	if err != nil {
+		return err
+	}
+	for i := range user.Tags {
+		tag := &user.Tags[i]
+		w, err := createZipFile(zw, "tags/"+url.PathEscape(tag.Name)+".json", now)
+		if err != nil {
+			return err
+		}
+		if err := writeTagArchive(w, c.DB, tag); err != nil {
+			return errors.Wrapf(err, "Failed to write tag: %s", tag.Name)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return errors.Wrap(err, "Failed to write archive")
+	}
+	c.audit(actor, model.AuditUserExport, user.Name, "tags="+strconv.Itoa(len(user.Tags)))
+	return nil
+}
+
+// writeTagArchive writes tagArchiveJSON of the tag. Data are encoded as
+// they are read from the database.
+func writeTagArchive(w io.Writer, db *gorm.DB, tag *model.Tag) error {
+	name, err := json.Marshal(tag.Name)
+	if err != nil {
+		return err
+	}
+	createdAt, err := json.Marshal(tag.CreatedAt)
+	if err != nil {
+		return err
+	}
+	// bufio.Writer keeps the first error of writes, and Flush returns it.
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "{\n  \"name\": %s,\n  \"created_at\": %s,\n  \"data\": [", name, createdAt)
+	n := 0
+	err = tag.EachData(db, func(data *model.Data) error {
+		b, err := json.MarshalIndent(data, "    ", "  ")
+		if err != nil {
+			return err
+		}
+		if n > 0 {
+			bw.WriteByte(',')
+		}
+		bw.WriteString("\n    ")
+		bw.Write(b)
+		n++
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if n > 0 {
+		bw.WriteString("\n  ")
+	}
+	bw.WriteString("]\n}\n")
+	return bw.Flush()
+}
+
+func createZipFile(zw *zip.Writer, name string, modified time.Time) (io.Writer, error) {
+	w, err := zw.CreateHeader(&zip.FileHeader{
+		Name:     name,
+		Method:   zip.Deflate,
+		Modified: modified,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "Failed to add %s", name)
+	}
+	return w, nil
+}
+
+func writeZipJSON(zw *zip.Writer, name string, modified time.Time, v interface{}) error {
+	w, err := createZipFile(zw, name, modified)
+	if err != nil {
+		return err
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+// ExportUser downloads the archive of a user from the admin page.
+func ExportUser() echo.HandlerFunc {
+	return call(func(c *Context) error {
+		user, err := model.FindUserByID(c.DB, c.Param("id"))
+		if err != nil {
+			switch errors.Cause(err) {
+			case model.ErrNotFound, model.ErrInvalid:
+				return echo.ErrNotFound
+			}
+			return errors.Wrap(err, "Failed to find user")
+		}
+		return c.writeUserArchive(c.SessionUser(), user)
+	})
+}
+
+func GetUserArchive() echo.HandlerFunc {
+	return call(func(c *Context) error {
+		actor, err := c.user()
+		if err != nil {
+			return err
+		}
+		user, err := model.FindUserByID(c.DB, c.Param("id"))
+		if err != nil {
+			return apiError(err, "")
+		}
+		return c.writeUserArchive(actor, user)
+	})
+}
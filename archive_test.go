@@ -0,0 +1,178 @@
+package vegeta
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/Code-Hex/vegeta/internal/model"
+)
+
+// readArchive returns the files in the zip by their names.
+func readArchive(t *testing.T, body string) map[string][]byte {
+	t.Helper()
+	zr, err := zip.NewReader(strings.NewReader(body), int64(len(body)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	files := make(map[string][]byte)
+	for _, f := range zr.File {
+		r, err := f.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var b json.RawMessage
+		if err := json.NewDecoder(r).Decode(&b); err != nil {
+			t.Fatalf("%s: %v", f.Name, err)
+		}
+		r.Close()
+		files[f.Name] = b
+	}
+	return files
+}
+
+func TestExportUser(t *testing.T) {
+	s := newTestServer(t)
+	s.createUser("root", "root-password1", true)
+	alice := s.createUser("alice", "alice-password1", false)
+	if err := alice.AddTag(s.DB, "room"); err != nil {
+		t.Fatal(err)
+	}
+	tag, err := alice.FindByTagName(s.DB, "room")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = tag.AddData(s.DB, model.Data{
+		RemoteAddr: "127.0.0.1",
+		Hostname:   "host",
+		Payload:    `{"t":1}`,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	id := strconv.FormatUint(uint64(alice.ID), 10)
+
+	if resp, _ := s.loggedIn("alice", "alice-password1").get("/mypage/admin/users/" + id + "/archive"); resp.Header.Get("Location") != "/login" {
+		t.Errorf("archive by a user goes to %q, want /login", resp.Header.Get("Location"))
+	}
+
+	root := s.loggedIn("root", "root-password1")
+	if resp, _ := root.get("/mypage/admin/users/100/archive"); resp.StatusCode != http.StatusNotFound {
+		t.Errorf("archive of unknown user: status = %d, want 404", resp.StatusCode)
+	}
+	for _, get := range []func() (*http.Response, string){
+		func() (*http.Response, string) { return root.get("/mypage/admin/users/" + id + "/archive") },
+		func() (*http.Response, string) {
+			return root.api(http.MethodGet, "/api/v1/users/"+id+"/archive", root.pageToken("/mypage/settings"), "", nil)
+		},
+	} {
+		resp, body := get()
+		if resp.StatusCode != http.StatusOK || resp.Header.Get("Content-Type") != "application/zip" {
+			t.Fatalf("archive: status = %d, content type = %q", resp.StatusCode, resp.Header.Get("Content-Type"))
+		}
+		files := readArchive(t, body)
+		var user userArchiveJSON
+		if err := json.Unmarshal(files["user.json"], &user); err != nil {
+			t.Fatal(err)
+		}
+		if user.User.Name != "alice" {
+			t.Errorf("user.json is of %q", user.User.Name)
+		}
+		var tag tagArchiveJSON
+		if err := json.Unmarshal(files["tags/room.json"], &tag); err != nil {
+			t.Fatal(err)
+		}
+		if len(tag.Data) != 1 || tag.Data[0].Payload != `{"t":1}` {
+			t.Errorf("tags/room.json has %+v", tag.Data)
+		}
+	}
+	if n := countAudit(t, s, model.AuditUserExport); n != 2 {
+		t.Errorf("%d exports are audited, want 2", n)
+	}
+}
+
+func countAudit(t *testing.T, s *testServer, action string) int {
+	t.Helper()
+	var n int
+	if err := s.DB.Model(&model.AuditLog{}).Where("action = ?", action).Count(&n).Error; err != nil {
+		t.Fatal(err)
+	}
+	return n
+}
+
+func TestWriteTagArchive(t *testing.T) {
+	s := newTestServer(t)
+	alice := s.createUser("alice", "alice-password1", false)
+	for _, name := range []string{"room", "empty"} {
+		if err := alice.AddTag(s.DB, name); err != nil {
+			t.Fatal(err)
+		}
+	}
+	room, err := alice.FindByTagName(s.DB, "room")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, payload := range []string{`{"t":1}`, `{"t":2}`, `{"t":3}`} {
+		err := room.AddData(s.DB, model.Data{RemoteAddr: "127.0.0.1", Hostname: "host", Payload: payload})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, name := range []string{"room", "empty"} {
+		tag, err := model.FindTagByID(s.DB, mustTagID(t, s, alice, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got bytes.Buffer
+		if err := writeTagArchive(&got, s.DB, tag); err != nil {
+			t.Fatal(err)
+		}
+		// It is the same as encoding all data at once.
+		data := tag.SomeData
+		if data == nil {
+			data = []model.Data{}
+		}
+		var want bytes.Buffer
+		enc := json.NewEncoder(&want)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(&tagArchiveJSON{Name: tag.Name, CreatedAt: tag.CreatedAt, Data: data}); err != nil {
+			t.Fatal(err)
+		}
+		if got.String() != want.String() {
+			t.Errorf("%s:\n%s\nwant:\n%s", name, got.String(), want.String())
+		}
+	}
+}
+
+func mustTagID(t *testing.T, s *testServer, u *model.User, name string) uint {
+	t.Helper()
+	tag, err := u.FindByTagName(s.DB, name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tag.ID
+}
+
+func TestExportUserFailureIsNotAudited(t *testing.T) {
+	s := newTestServer(t)
+	s.createUser("root", "root-password1", true)
+	alice := s.createUser("alice", "alice-password1", false)
+	if err := alice.AddTag(s.DB, "room"); err != nil {
+		t.Fatal(err)
+	}
+	// Data can not be read, so the archive is broken after the header.
+	if err := s.DB.DropTable(&model.Data{}).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	root := s.loggedIn("root", "root-password1")
+	root.get("/mypage/admin/users/" + strconv.FormatUint(uint64(alice.ID), 10) + "/archive")
+	if n := countAudit(t, s, model.AuditUserExport); n != 0 {
+		t.Errorf("%d failed exports are audited", n)
+	}
+}
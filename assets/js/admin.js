@@ -1 +1,3 @@
-!function t(e,r,s){function i(o,a){if(!r[o]){if(!e[o]){var u="function"==typeof require&&require;if(!a&&u)return u(o,!0);if(n)return n(o,!0);throw new Error("Cannot find module '"+o+"'")}var h=r[o]={exports:{}};e[o][0].call(h.exports,function(t){var r=e[o][1][t];return i(r||t)},h,h.exports,t,e,r,s)}return r[o].exports}for(var n="function"==typeof require&&require,o=0;o<s.length;o++)i(s[o]);return i}({1:[function(t,e,r){function s(t){if(t)return function(t){for(var e in s.prototype)t[e]=s.prototype[e];return t}(t)}void 0!==e&&(e.exports=s),s.prototype.on=s.prototype.addEventListener=function(t,e){return this._callbacks=this._callbacks||{},(this._callbacks["$"+t]=this._callbacks["$"+t]||[]).push(e),this},s.prototype.once=function(t,e){function r(){this.off(t,r),e.apply(this,arguments)}return r.fn=e,this.on(t,r),this},s.prototype.off=s.prototype.removeListener=s.prototype.removeAllListeners=s.prototype.removeEventListener=function(t,e){if(this._callbacks=this._callbacks||{},0==arguments.length)return this._callbacks={},this;var r=this._callbacks["$"+t];if(!r)return this;if(1==arguments.length)return delete this._callbacks["$"+t],this;for(var s,i=0;i<r.length;i++)if((s=r[i])===e||s.fn===e){r.splice(i,1);break}return this},s.prototype.emit=function(t){this._callbacks=this._callbacks||{};var e=[].slice.call(arguments,1),r=this._callbacks["$"+t];if(r)for(var s=0,i=(r=r.slice(0)).length;s<i;++s)r[s].apply(this,e);return this},s.prototype.listeners=function(t){return this._callbacks=this._callbacks||{},this._callbacks["$"+t]||[]},s.prototype.hasListeners=function(t){return!!this.listeners(t).length}},{}],2:[function(t,e,r){function s(t){if(!d(t))return t;var e=[];for(var r in t)i(e,r,t[r]);return e.join("&")}function i(t,e,r){if(null!=r)if(Array.isArray(r))r.forEach(function(r){i(t,e,r)});else if(d(r))for(var s in r)i(t,e+"["+s+"]",r[s]);else t.push(encodeURIComponent(e)+"="+encodeURIComponent(r));else null===r&&t.push(encodeURIComponent(e))}function n(t){for(var e,r,s={},i=t.split("&"),n=0,o=i.length;n<o;++n)-1==(r=(e=i[n]).indexOf("="))?s[decodeURIComponent(e)]="":s[decodeURIComponent(e.slice(0,r))]=decodeURIComponent(e.slice(r+1));return s}function o(t){return/[\/+]json\b/.test(t)}function a(t){this.req=t,this.xhr=this.req.xhr,this.text="HEAD"!=this.req.method&&(""===this.xhr.responseType||"text"===this.xhr.responseType)||void 0===this.xhr.responseType?this.xhr.responseText:null,this.statusText=this.req.xhr.statusText;var e=this.xhr.status;1223===e&&(e=204),this._setStatusProperties(e),this.header=this.headers=function(t){var e,r,s,i,n=t.split(/\r?\n/),o={};n.pop();for(var a=0,u=n.length;a<u;++a)e=(r=n[a]).indexOf(":"),s=r.slice(0,e).toLowerCase(),i=_(r.slice(e+1)),o[s]=i;return o}(this.xhr.getAllResponseHeaders()),this.header["content-type"]=this.xhr.getResponseHeader("content-type"),this._setHeaderProperties(this.header),null===this.text&&t._responseType?this.body=this.xhr.response:this.body="HEAD"!=this.req.method?this._parseBody(this.text?this.text:this.xhr.response):null}function u(t,e){var r=this;this._query=this._query||[],this.method=t,this.url=e,this.header={},this._header={},this.on("end",function(){var t=null,e=null;try{e=new a(r)}catch(e){return t=new Error("Parser is unable to parse the response"),t.parse=!0,t.original=e,r.xhr?(t.rawResponse=void 0===r.xhr.responseType?r.xhr.responseText:r.xhr.response,t.status=r.xhr.status?r.xhr.status:null,t.statusCode=t.status):(t.rawResponse=null,t.status=null),r.callback(t)}r.emit("response",e);var s;try{r._isResponseOK(e)||((s=new Error(e.statusText||"Unsuccessful HTTP response")).original=t,s.response=e,s.status=e.status)}catch(t){s=t}s?r.callback(s,e):r.callback(null,e)})}function h(t,e,r){var s=m("DELETE",t);return"function"==typeof e&&(r=e,e=null),e&&s.send(e),r&&s.end(r),s}var p;"undefined"!=typeof window?p=window:"undefined"!=typeof self?p=self:(console.warn("Using browser-only version of superagent in non-browser environment"),p=this);var l=t("component-emitter"),c=t("./request-base"),d=t("./is-object"),f=t("./response-base"),y=t("./should-retry"),m=r=e.exports=function(t,e){return"function"==typeof e?new r.Request("GET",t).end(e):1==arguments.length?new r.Request("GET",t):new r.Request(t,e)};r.Request=u,m.getXHR=function(){if(!(!p.XMLHttpRequest||p.location&&"file:"==p.location.protocol&&p.ActiveXObject))return new XMLHttpRequest;try{return new ActiveXObject("Microsoft.XMLHTTP")}catch(t){}try{return new ActiveXObject("Msxml2.XMLHTTP.6.0")}catch(t){}try{return new ActiveXObject("Msxml2.XMLHTTP.3.0")}catch(t){}try{return new ActiveXObject("Msxml2.XMLHTTP")}catch(t){}throw Error("Browser-only version of superagent could not find XHR")};var _="".trim?function(t){return t.trim()}:function(t){return t.replace(/(^\s*|\s*$)/g,"")};m.serializeObject=s,m.parseString=n,m.types={html:"text/html",json:"application/json",xml:"text/xml",urlencoded:"application/x-www-form-urlencoded",form:"application/x-www-form-urlencoded","form-data":"application/x-www-form-urlencoded"},m.serialize={"application/x-www-form-urlencoded":s,"application/json":JSON.stringify},m.parse={"application/x-www-form-urlencoded":n,"application/json":JSON.parse},f(a.prototype),a.prototype._parseBody=function(t){var e=m.parse[this.type];return this.req._parser?this.req._parser(this,t):(!e&&o(this.type)&&(e=m.parse["application/json"]),e&&t&&(t.length||t instanceof Object)?e(t):null)},a.prototype.toError=function(){var t=this.req,e=t.method,r=t.url,s="cannot "+e+" "+r+" ("+this.status+")",i=new Error(s);return i.status=this.status,i.method=e,i.url=r,i},m.Response=a,l(u.prototype),c(u.prototype),u.prototype.type=function(t){return this.set("Content-Type",m.types[t]||t),this},u.prototype.accept=function(t){return this.set("Accept",m.types[t]||t),this},u.prototype.auth=function(t,e,r){switch("object"==typeof e&&null!==e&&(r=e),r||(r={type:"function"==typeof btoa?"basic":"auto"}),r.type){case"basic":this.set("Authorization","Basic "+btoa(t+":"+e));break;case"auto":this.username=t,this.password=e;break;case"bearer":this.set("Authorization","Bearer "+t)}return this},u.prototype.query=function(t){return"string"!=typeof t&&(t=s(t)),t&&this._query.push(t),this},u.prototype.attach=function(t,e,r){if(e){if(this._data)throw Error("superagent can't mix .send() and .attach()");this._getFormData().append(t,e,r||e.name)}return this},u.prototype._getFormData=function(){return this._formData||(this._formData=new p.FormData),this._formData},u.prototype.callback=function(t,e){if(this._maxRetries&&this._retries++<this._maxRetries&&y(t,e))return this._retry();var r=this._callback;this.clearTimeout(),t&&(this._maxRetries&&(t.retries=this._retries-1),this.emit("error",t)),r(t,e)},u.prototype.crossDomainError=function(){var t=new Error("Request has been terminated\nPossible causes: the network is offline, Origin is not allowed by Access-Control-Allow-Origin, the page is being unloaded, etc.");t.crossDomain=!0,t.status=this.status,t.method=this.method,t.url=this.url,this.callback(t)},u.prototype.buffer=u.prototype.ca=u.prototype.agent=function(){return console.warn("This is not supported in browser version of superagent"),this},u.prototype.pipe=u.prototype.write=function(){throw Error("Streaming is not supported in browser version of superagent")},u.prototype._isHost=function(t){return t&&"object"==typeof t&&!Array.isArray(t)&&"[object Object]"!==Object.prototype.toString.call(t)},u.prototype.end=function(t){return this._endCalled&&console.warn("Warning: .end() was called twice. This is not supported in superagent"),this._endCalled=!0,this._callback=t||function(){},this._finalizeQueryString(),this._end()},u.prototype._end=function(){var t=this,e=this.xhr=m.getXHR(),r=this._formData||this._data;this._setTimeouts(),e.onreadystatechange=function(){var r=e.readyState;if(r>=2&&t._responseTimeoutTimer&&clearTimeout(t._responseTimeoutTimer),4==r){var s;try{s=e.status}catch(t){s=0}if(!s){if(t.timedout||t._aborted)return;return t.crossDomainError()}t.emit("end")}};var s=function(e,r){r.total>0&&(r.percent=r.loaded/r.total*100),r.direction=e,t.emit("progress",r)};if(this.hasListeners("progress"))try{e.onprogress=s.bind(null,"download"),e.upload&&(e.upload.onprogress=s.bind(null,"upload"))}catch(t){}try{this.username&&this.password?e.open(this.method,this.url,!0,this.username,this.password):e.open(this.method,this.url,!0)}catch(t){return this.callback(t)}if(this._withCredentials&&(e.withCredentials=!0),!this._formData&&"GET"!=this.method&&"HEAD"!=this.method&&"string"!=typeof r&&!this._isHost(r)){var i=this._header["content-type"],n=this._serializer||m.serialize[i?i.split(";")[0]:""];!n&&o(i)&&(n=m.serialize["application/json"]),n&&(r=n(r))}for(var a in this.header)null!=this.header[a]&&this.header.hasOwnProperty(a)&&e.setRequestHeader(a,this.header[a]);return this._responseType&&(e.responseType=this._responseType),this.emit("request",this),e.send(void 0!==r?r:null),this},m.get=function(t,e,r){var s=m("GET",t);return"function"==typeof e&&(r=e,e=null),e&&s.query(e),r&&s.end(r),s},m.head=function(t,e,r){var s=m("HEAD",t);return"function"==typeof e&&(r=e,e=null),e&&s.query(e),r&&s.end(r),s},m.options=function(t,e,r){var s=m("OPTIONS",t);return"function"==typeof e&&(r=e,e=null),e&&s.send(e),r&&s.end(r),s},m.del=h,m.delete=h,m.patch=function(t,e,r){var s=m("PATCH",t);return"function"==typeof e&&(r=e,e=null),e&&s.send(e),r&&s.end(r),s},m.post=function(t,e,r){var s=m("POST",t);return"function"==typeof e&&(r=e,e=null),e&&s.send(e),r&&s.end(r),s},m.put=function(t,e,r){var s=m("PUT",t);return"function"==typeof e&&(r=e,e=null),e&&s.send(e),r&&s.end(r),s}},{"./is-object":3,"./request-base":4,"./response-base":5,"./should-retry":6,"component-emitter":1}],3:[function(t,e,r){e.exports=function(t){return null!==t&&"object"==typeof t}},{}],4:[function(t,e,r){function s(t){if(t)return function(t){for(var e in s.prototype)t[e]=s.prototype[e];return t}(t)}var i=t("./is-object");e.exports=s,s.prototype.clearTimeout=function(){return clearTimeout(this._timer),clearTimeout(this._responseTimeoutTimer),delete this._timer,delete this._responseTimeoutTimer,this},s.prototype.parse=function(t){return this._parser=t,this},s.prototype.responseType=function(t){return this._responseType=t,this},s.prototype.serialize=function(t){return this._serializer=t,this},s.prototype.timeout=function(t){if(!t||"object"!=typeof t)return this._timeout=t,this._responseTimeout=0,this;for(var e in t)switch(e){case"deadline":this._timeout=t.deadline;break;case"response":this._responseTimeout=t.response;break;default:console.warn("Unknown timeout option",e)}return this},s.prototype.retry=function(t){return 0!==arguments.length&&!0!==t||(t=1),t<=0&&(t=0),this._maxRetries=t,this._retries=0,this},s.prototype._retry=function(){return this.clearTimeout(),this.req&&(this.req=null,this.req=this.request()),this._aborted=!1,this.timedout=!1,this._end()},s.prototype.then=function(t,e){if(!this._fullfilledPromise){var r=this;this._endCalled&&console.warn("Warning: superagent request was sent twice, because both .end() and .then() were called. Never call .end() if you use promises"),this._fullfilledPromise=new Promise(function(t,e){r.end(function(r,s){r?e(r):t(s)})})}return this._fullfilledPromise.then(t,e)},s.prototype.catch=function(t){return this.then(void 0,t)},s.prototype.use=function(t){return t(this),this},s.prototype.ok=function(t){if("function"!=typeof t)throw Error("Callback required");return this._okCallback=t,this},s.prototype._isResponseOK=function(t){return!!t&&(this._okCallback?this._okCallback(t):t.status>=200&&t.status<300)},s.prototype.get=function(t){return this._header[t.toLowerCase()]},s.prototype.getHeader=s.prototype.get,s.prototype.set=function(t,e){if(i(t)){for(var r in t)this.set(r,t[r]);return this}return this._header[t.toLowerCase()]=e,this.header[t]=e,this},s.prototype.unset=function(t){return delete this._header[t.toLowerCase()],delete this.header[t],this},s.prototype.field=function(t,e){if(null===t||void 0===t)throw new Error(".field(name, val) name can not be empty");if(this._data&&console.error(".field() can't be used if .send() is used. Please use only .send() or only .field() & .attach()"),i(t)){for(var r in t)this.field(r,t[r]);return this}if(Array.isArray(e)){for(var s in e)this.field(t,e[s]);return this}if(null===e||void 0===e)throw new Error(".field(name, val) val can not be empty");return"boolean"==typeof e&&(e=""+e),this._getFormData().append(t,e),this},s.prototype.abort=function(){return this._aborted?this:(this._aborted=!0,this.xhr&&this.xhr.abort(),this.req&&this.req.abort(),this.clearTimeout(),this.emit("abort"),this)},s.prototype.withCredentials=function(t){return void 0==t&&(t=!0),this._withCredentials=t,this},s.prototype.redirects=function(t){return this._maxRedirects=t,this},s.prototype.toJSON=function(){return{method:this.method,url:this.url,data:this._data,headers:this._header}},s.prototype.send=function(t){var e=i(t),r=this._header["content-type"];if(this._formData&&console.error(".send() can't be used if .attach() or .field() is used. Please use only .send() or only .field() & .attach()"),e&&!this._data)Array.isArray(t)?this._data=[]:this._isHost(t)||(this._data={});else if(t&&this._data&&this._isHost(this._data))throw Error("Can't merge these send calls");if(e&&i(this._data))for(var s in t)this._data[s]=t[s];else"string"==typeof t?(r||this.type("form"),r=this._header["content-type"],this._data="application/x-www-form-urlencoded"==r?this._data?this._data+"&"+t:t:(this._data||"")+t):this._data=t;return!e||this._isHost(t)?this:(r||this.type("json"),this)},s.prototype.sortQuery=function(t){return this._sort=void 0===t||t,this},s.prototype._finalizeQueryString=function(){var t=this._query.join("&");if(t&&(this.url+=(this.url.indexOf("?")>=0?"&":"?")+t),this._query.length=0,this._sort){var e=this.url.indexOf("?");if(e>=0){var r=this.url.substring(e+1).split("&");"function"==typeof this._sort?r.sort(this._sort):r.sort(),this.url=this.url.substring(0,e)+"?"+r.join("&")}}},s.prototype._appendQueryString=function(){console.trace("Unsupported")},s.prototype._timeoutError=function(t,e,r){if(!this._aborted){var s=new Error(t+e+"ms exceeded");s.timeout=e,s.code="ECONNABORTED",s.errno=r,this.timedout=!0,this.abort(),this.callback(s)}},s.prototype._setTimeouts=function(){var t=this;this._timeout&&!this._timer&&(this._timer=setTimeout(function(){t._timeoutError("Timeout of ",t._timeout,"ETIME")},this._timeout)),this._responseTimeout&&!this._responseTimeoutTimer&&(this._responseTimeoutTimer=setTimeout(function(){t._timeoutError("Response timeout of ",t._responseTimeout,"ETIMEDOUT")},this._responseTimeout))}},{"./is-object":3}],5:[function(t,e,r){function s(t){if(t)return function(t){for(var e in s.prototype)t[e]=s.prototype[e];return t}(t)}var i=t("./utils");e.exports=s,s.prototype.get=function(t){return this.header[t.toLowerCase()]},s.prototype._setHeaderProperties=function(t){var e=t["content-type"]||"";this.type=i.type(e);var r=i.params(e);for(var s in r)this[s]=r[s];this.links={};try{t.link&&(this.links=i.parseLinks(t.link))}catch(t){}},s.prototype._setStatusProperties=function(t){var e=t/100|0;this.status=this.statusCode=t,this.statusType=e,this.info=1==e,this.ok=2==e,this.redirect=3==e,this.clientError=4==e,this.serverError=5==e,this.error=(4==e||5==e)&&this.toError(),this.accepted=202==t,this.noContent=204==t,this.badRequest=400==t,this.unauthorized=401==t,this.notAcceptable=406==t,this.forbidden=403==t,this.notFound=404==t}},{"./utils":7}],6:[function(t,e,r){var s=["ECONNRESET","ETIMEDOUT","EADDRINFO","ESOCKETTIMEDOUT"];e.exports=function(t,e){return!!(t&&t.code&&~s.indexOf(t.code))||(!!(e&&e.status&&e.status>=500)||(!!(t&&"timeout"in t&&"ECONNABORTED"==t.code)||!!(t&&"crossDomain"in t)))}},{}],7:[function(t,e,r){r.type=function(t){return t.split(/ *; */).shift()},r.params=function(t){return t.split(/ *; */).reduce(function(t,e){var r=e.split(/ *= */),s=r.shift(),i=r.shift();return s&&i&&(t[s]=i),t},{})},r.parseLinks=function(t){return t.split(/ *, */).reduce(function(t,e){var r=e.split(/ *; */),s=r[0].slice(1,-1);return t[r[1].split(/ *= */)[1].slice(1,-1)]=s,t},{})},r.cleanHeader=function(t,e){return delete t["content-type"],delete t["content-length"],delete t["transfer-encoding"],delete t.host,e&&delete t.cookie,t}},{}],8:[function(t,e,r){"use strict";Object.defineProperty(r,"__esModule",{value:!0});const s=t("superagent");class i{static CheckPassword(){var t=document.getElementById("password"),e=document.getElementById("verify-password");t.value!=e.value?e.setCustomValidity("一致するパスワードを入力してください。"):e.setCustomValidity("")}}$(document).on("submit",function(t){$("form").find(":submit").prop("disabled",!0)}),$(document).ready(function(){$("#password").keyup(function(){i.CheckPassword()}),$("#verify-password").keyup(function(){i.CheckPassword()})}),$("#editModal").on("show.bs.modal",function(t){let e=$(t.relatedTarget),r=e.data("id"),s=e.data("name"),i=e.data("is-admin"),n=$(this);n.find("#username").val(s),n.find("#user-id").val(r),n.find("#is-admin").prop("checked",i),n.find("#is-admin").prop("disabled",1==r),n.find("#is-reset-password").prop("checked",!1)}),$("#deleteModal").on("show.bs.modal",function(t){let e=$(t.relatedTarget),r=e.data("id"),s=e.data("name"),i=$(this);i.find("#username").val(s),i.find("#user-id").val(r)});var n=new class{constructor(){this._token="";let t=document.getElementById("api-token");this._token=t.value}get token(){return this._token}DeleteUser(t){let e=t.find("#user-id").val();s.post("/mypage/admin/api/delete").set("Content-Type","application/json").set("Authorization",`Bearer ${this._token}`).send({id:e}).end(function(t,e){if(t||!e.ok)alert("http error: "+t);else{let t=e.body;t.is_success?(alert("ユーザーを削除しました。"),window.location.reload(!0)):(alert(`ユーザーの削除に失敗しました: ${t.reason}`),window.location.reload(!0))}})}EditUser(t){let e=t.find("#user-id").val(),r=t.find("#is-admin").is(":checked"),i=t.find("#is-reset-password").is(":checked");s.post("/mypage/admin/api/edit").set("Content-Type","application/json").set("Authorization",`Bearer ${this._token}`).send({id:e,is_admin:r,is_reset_password:i}).end(function(t,e){if(t||!e.ok)alert("http error: "+t);else{let t=e.body;if(t.is_success){let e="ユーザーを編集しました。";i&&(e+=`\nリセット後のパスワードは ${t.reason} です。`),alert(e),window.location.reload(!0)}else alert(`ユーザーの編集に失敗しました: ${t.reason}`),window.location.reload(!0)}})}CreateUser(t){let e=t.find("#username").val(),r=t.find("#password").val(),i=t.find("#verify-password").val(),n=t.find("#is-admin").is(":checked");s.post("/mypage/admin/api/create").set("Content-Type","application/json").set("Authorization",`Bearer ${this._token}`).send({name:e,password:r,verify_password:i,is_admin:n}).end(function(t,e){if(t||!e.ok)alert("http error: "+t);else{let t=e.body;t.is_success?(alert("ユーザーを作成しました。"),window.location.reload(!0)):(alert(`ユーザーの作成に失敗しました: ${t.reason}`),window.location.reload(!0))}})}};document.getElementById("create-user-validation").addEventListener("submit",t=>{t.preventDefault(),n.CreateUser($("#create-user-validation"))});document.getElementById("edit-user-validation").addEventListener("submit",t=>{t.preventDefault(),n.EditUser($("#edit-user-validation"))});document.getElementById("delete-user-validation").addEventListener("submit",t=>{t.preventDefault(),n.DeleteUser($("#delete-user-validation"))})},{superagent:2}]},{},[8]);
\ No newline at end of file
+(function m(_,p,n){function o(u,l){if(!p[u]){if(!_[u]){var y=typeof require=="function"&&require;if(!l&&y)return y(u,!0);if(e)return e(u,!0);throw new Error("Cannot find module '"+u+"'")}var v=p[u]={exports:{}};_[u][0].call(v.exports,function(T){var x=_[u][1][T];return o(x||T)},v,v.exports,m,_,p,n)}return p[u].exports}for(var e=typeof require=="function"&&require,i=0;i<n.length;i++)o(n[i]);return o})({1:[function(m,_,p){function n(o){if(o)return(function(e){for(var i in n.prototype)e[i]=n.prototype[i];return e})(o)}_!==void 0&&(_.exports=n),n.prototype.on=n.prototype.addEventListener=function(o,e){return this._callbacks=this._callbacks||{},(this._callbacks["$"+o]=this._callbacks["$"+o]||[]).push(e),this},n.prototype.once=function(o,e){function i(){this.off(o,i),e.apply(this,arguments)}return i.fn=e,this.on(o,i),this},n.prototype.off=n.prototype.removeListener=n.prototype.removeAllListeners=n.prototype.removeEventListener=function(o,e){if(this._callbacks=this._callbacks||{},arguments.length==0)return this._callbacks={},this;var i=this._callbacks["$"+o];if(!i)return this;if(arguments.length==1)return delete this._callbacks["$"+o],this;for(var u,l=0;l<i.length;l++)if((u=i[l])===e||u.fn===e){i.splice(l,1);break}return this},n.prototype.emit=function(o){this._callbacks=this._callbacks||{};var e=[].slice.call(arguments,1),i=this._callbacks["$"+o];if(i)for(var u=0,l=(i=i.slice(0)).length;u<l;++u)i[u].apply(this,e);return this},n.prototype.listeners=function(o){return this._callbacks=this._callbacks||{},this._callbacks["$"+o]||[]},n.prototype.hasListeners=function(o){return!!this.listeners(o).length}},{}],2:[function(m,_,p){function n(t){if(!k(t))return t;var s=[];for(var r in t)o(s,r,t[r]);return s.join("&")}function o(t,s,r){if(r!=null)if(Array.isArray(r))r.forEach(function(d){o(t,s,d)});else if(k(r))for(var a in r)o(t,s+"["+a+"]",r[a]);else t.push(encodeURIComponent(s)+"="+encodeURIComponent(r));else r===null&&t.push(encodeURIComponent(s))}function e(t){for(var s,r,a={},d=t.split("&"),f=0,w=d.length;f<w;++f)(r=(s=d[f]).indexOf("="))==-1?a[decodeURIComponent(s)]="":a[decodeURIComponent(s.slice(0,r))]=decodeURIComponent(s.slice(r+1));return a}function i(t){return/[\/+]json\b/.test(t)}function u(t){this.req=t,this.xhr=this.req.xhr,this.text=this.req.method!="HEAD"&&(this.xhr.responseType===""||this.xhr.responseType==="text")||this.xhr.responseType===void 0?this.xhr.responseText:null,this.statusText=this.req.xhr.statusText;var s=this.xhr.status;s===1223&&(s=204),this._setStatusProperties(s),this.header=this.headers=(function(r){var a,d,f,w,g=r.split(/\r?\n/),b={};g.pop();for(var C=0,R=g.length;C<R;++C)a=(d=g[C]).indexOf(":"),f=d.slice(0,a).toLowerCase(),w=h(d.slice(a+1)),b[f]=w;return b})(this.xhr.getAllResponseHeaders()),this.header["content-type"]=this.xhr.getResponseHeader("content-type"),this._setHeaderProperties(this.header),this.text===null&&t._responseType?this.body=this.xhr.response:this.body=this.req.method!="HEAD"?this._parseBody(this.text?this.text:this.xhr.response):null}function l(t,s){var r=this;this._query=this._query||[],this.method=t,this.url=s,this.header={},this._header={},this.on("end",function(){var a=null,d=null;try{d=new u(r)}catch(w){return a=new Error("Parser is unable to parse the response"),a.parse=!0,a.original=w,r.xhr?(a.rawResponse=r.xhr.responseType===void 0?r.xhr.responseText:r.xhr.response,a.status=r.xhr.status?r.xhr.status:null,a.statusCode=a.status):(a.rawResponse=null,a.status=null),r.callback(a)}r.emit("response",d);var f;try{r._isResponseOK(d)||((f=new Error(d.statusText||"Unsuccessful HTTP response")).original=a,f.response=d,f.status=d.status)}catch(w){f=w}f?r.callback(f,d):r.callback(null,d)})}function y(t,s,r){var a=c("DELETE",t);return typeof s=="function"&&(r=s,s=null),s&&a.send(s),r&&a.end(r),a}var v;typeof window!="undefined"?v=window:typeof self!="undefined"?v=self:(console.warn("Using browser-only version of superagent in non-browser environment"),v=this);var T=m("component-emitter"),x=m("./request-base"),k=m("./is-object"),j=m("./response-base"),E=m("./should-retry"),c=p=_.exports=function(t,s){return typeof s=="function"?new p.Request("GET",t).end(s):arguments.length==1?new p.Request("GET",t):new p.Request(t,s)};p.Request=l,c.getXHR=function(){if(!(!v.XMLHttpRequest||v.location&&v.location.protocol=="file:"&&v.ActiveXObject))return new XMLHttpRequest;try{return new ActiveXObject("Microsoft.XMLHTTP")}catch(t){}try{return new ActiveXObject("Msxml2.XMLHTTP.6.0")}catch(t){}try{return new ActiveXObject("Msxml2.XMLHTTP.3.0")}catch(t){}try{return new ActiveXObject("Msxml2.XMLHTTP")}catch(t){}throw Error("Browser-only version of superagent could not find XHR")};var h="".trim?function(t){return t.trim()}:function(t){return t.replace(/(^\s*|\s*$)/g,"")};c.serializeObject=n,c.parseString=e,c.types={html:"text/html",json:"application/json",xml:"text/xml",urlencoded:"application/x-www-form-urlencoded",form:"application/x-www-form-urlencoded","form-data":"application/x-www-form-urlencoded"},c.serialize={"application/x-www-form-urlencoded":n,"application/json":JSON.stringify},c.parse={"application/x-www-form-urlencoded":e,"application/json":JSON.parse},j(u.prototype),u.prototype._parseBody=function(t){var s=c.parse[this.type];return this.req._parser?this.req._parser(this,t):(!s&&i(this.type)&&(s=c.parse["application/json"]),s&&t&&(t.length||t instanceof Object)?s(t):null)},u.prototype.toError=function(){var t=this.req,s=t.method,r=t.url,a="cannot "+s+" "+r+" ("+this.status+")",d=new Error(a);return d.status=this.status,d.method=s,d.url=r,d},c.Response=u,T(l.prototype),x(l.prototype),l.prototype.type=function(t){return this.set("Content-Type",c.types[t]||t),this},l.prototype.accept=function(t){return this.set("Accept",c.types[t]||t),this},l.prototype.auth=function(t,s,r){switch(typeof s=="object"&&s!==null&&(r=s),r||(r={type:typeof btoa=="function"?"basic":"auto"}),r.type){case"basic":this.set("Authorization","Basic "+btoa(t+":"+s));break;case"auto":this.username=t,this.password=s;break;case"bearer":this.set("Authorization","Bearer "+t)}return this},l.prototype.query=function(t){return typeof t!="string"&&(t=n(t)),t&&this._query.push(t),this},l.prototype.attach=function(t,s,r){if(s){if(this._data)throw Error("superagent can't mix .send() and .attach()");this._getFormData().append(t,s,r||s.name)}return this},l.prototype._getFormData=function(){return this._formData||(this._formData=new v.FormData),this._formData},l.prototype.callback=function(t,s){if(this._maxRetries&&this._retries++<this._maxRetries&&E(t,s))return this._retry();var r=this._callback;this.clearTimeout(),t&&(this._maxRetries&&(t.retries=this._retries-1),this.emit("error",t)),r(t,s)},l.prototype.crossDomainError=function(){var t=new Error(`Request has been terminated
+Possible causes: the network is offline, Origin is not allowed by Access-Control-Allow-Origin, the page is being unloaded, etc.`);t.crossDomain=!0,t.status=this.status,t.method=this.method,t.url=this.url,this.callback(t)},l.prototype.buffer=l.prototype.ca=l.prototype.agent=function(){return console.warn("This is not supported in browser version of superagent"),this},l.prototype.pipe=l.prototype.write=function(){throw Error("Streaming is not supported in browser version of superagent")},l.prototype._isHost=function(t){return t&&typeof t=="object"&&!Array.isArray(t)&&Object.prototype.toString.call(t)!=="[object Object]"},l.prototype.end=function(t){return this._endCalled&&console.warn("Warning: .end() was called twice. This is not supported in superagent"),this._endCalled=!0,this._callback=t||function(){},this._finalizeQueryString(),this._end()},l.prototype._end=function(){var t=this,s=this.xhr=c.getXHR(),r=this._formData||this._data;this._setTimeouts(),s.onreadystatechange=function(){var g=s.readyState;if(g>=2&&t._responseTimeoutTimer&&clearTimeout(t._responseTimeoutTimer),g==4){var b;try{b=s.status}catch(C){b=0}if(!b)return t.timedout||t._aborted?void 0:t.crossDomainError();t.emit("end")}};var a=function(g,b){b.total>0&&(b.percent=b.loaded/b.total*100),b.direction=g,t.emit("progress",b)};if(this.hasListeners("progress"))try{s.onprogress=a.bind(null,"download"),s.upload&&(s.upload.onprogress=a.bind(null,"upload"))}catch(g){}try{this.username&&this.password?s.open(this.method,this.url,!0,this.username,this.password):s.open(this.method,this.url,!0)}catch(g){return this.callback(g)}if(this._withCredentials&&(s.withCredentials=!0),!this._formData&&this.method!="GET"&&this.method!="HEAD"&&typeof r!="string"&&!this._isHost(r)){var d=this._header["content-type"],f=this._serializer||c.serialize[d?d.split(";")[0]:""];!f&&i(d)&&(f=c.serialize["application/json"]),f&&(r=f(r))}for(var w in this.header)this.header[w]!=null&&this.header.hasOwnProperty(w)&&s.setRequestHeader(w,this.header[w]);return this._responseType&&(s.responseType=this._responseType),this.emit("request",this),s.send(r!==void 0?r:null),this},c.get=function(t,s,r){var a=c("GET",t);return typeof s=="function"&&(r=s,s=null),s&&a.query(s),r&&a.end(r),a},c.head=function(t,s,r){var a=c("HEAD",t);return typeof s=="function"&&(r=s,s=null),s&&a.query(s),r&&a.end(r),a},c.options=function(t,s,r){var a=c("OPTIONS",t);return typeof s=="function"&&(r=s,s=null),s&&a.send(s),r&&a.end(r),a},c.del=y,c.delete=y,c.patch=function(t,s,r){var a=c("PATCH",t);return typeof s=="function"&&(r=s,s=null),s&&a.send(s),r&&a.end(r),a},c.post=function(t,s,r){var a=c("POST",t);return typeof s=="function"&&(r=s,s=null),s&&a.send(s),r&&a.end(r),a},c.put=function(t,s,r){var a=c("PUT",t);return typeof s=="function"&&(r=s,s=null),s&&a.send(s),r&&a.end(r),a}},{"./is-object":3,"./request-base":4,"./response-base":5,"./should-retry":6,"component-emitter":1}],3:[function(m,_,p){_.exports=function(n){return n!==null&&typeof n=="object"}},{}],4:[function(m,_,p){function n(e){if(e)return(function(i){for(var u in n.prototype)i[u]=n.prototype[u];return i})(e)}var o=m("./is-object");_.exports=n,n.prototype.clearTimeout=function(){return clearTimeout(this._timer),clearTimeout(this._responseTimeoutTimer),delete this._timer,delete this._responseTimeoutTimer,this},n.prototype.parse=function(e){return this._parser=e,this},n.prototype.responseType=function(e){return this._responseType=e,this},n.prototype.serialize=function(e){return this._serializer=e,this},n.prototype.timeout=function(e){if(!e||typeof e!="object")return this._timeout=e,this._responseTimeout=0,this;for(var i in e)switch(i){case"deadline":this._timeout=e.deadline;break;case"response":this._responseTimeout=e.response;break;default:console.warn("Unknown timeout option",i)}return this},n.prototype.retry=function(e){return arguments.length!==0&&e!==!0||(e=1),e<=0&&(e=0),this._maxRetries=e,this._retries=0,this},n.prototype._retry=function(){return this.clearTimeout(),this.req&&(this.req=null,this.req=this.request()),this._aborted=!1,this.timedout=!1,this._end()},n.prototype.then=function(e,i){if(!this._fullfilledPromise){var u=this;this._endCalled&&console.warn("Warning: superagent request was sent twice, because both .end() and .then() were called. Never call .end() if you use promises"),this._fullfilledPromise=new Promise(function(l,y){u.end(function(v,T){v?y(v):l(T)})})}return this._fullfilledPromise.then(e,i)},n.prototype.catch=function(e){return this.then(void 0,e)},n.prototype.use=function(e){return e(this),this},n.prototype.ok=function(e){if(typeof e!="function")throw Error("Callback required");return this._okCallback=e,this},n.prototype._isResponseOK=function(e){return!!e&&(this._okCallback?this._okCallback(e):e.status>=200&&e.status<300)},n.prototype.get=function(e){return this._header[e.toLowerCase()]},n.prototype.getHeader=n.prototype.get,n.prototype.set=function(e,i){if(o(e)){for(var u in e)this.set(u,e[u]);return this}return this._header[e.toLowerCase()]=i,this.header[e]=i,this},n.prototype.unset=function(e){return delete this._header[e.toLowerCase()],delete this.header[e],this},n.prototype.field=function(e,i){if(e==null)throw new Error(".field(name, val) name can not be empty");if(this._data&&console.error(".field() can't be used if .send() is used. Please use only .send() or only .field() & .attach()"),o(e)){for(var u in e)this.field(u,e[u]);return this}if(Array.isArray(i)){for(var l in i)this.field(e,i[l]);return this}if(i==null)throw new Error(".field(name, val) val can not be empty");return typeof i=="boolean"&&(i=""+i),this._getFormData().append(e,i),this},n.prototype.abort=function(){return this._aborted?this:(this._aborted=!0,this.xhr&&this.xhr.abort(),this.req&&this.req.abort(),this.clearTimeout(),this.emit("abort"),this)},n.prototype.withCredentials=function(e){return e==null&&(e=!0),this._withCredentials=e,this},n.prototype.redirects=function(e){return this._maxRedirects=e,this},n.prototype.toJSON=function(){return{method:this.method,url:this.url,data:this._data,headers:this._header}},n.prototype.send=function(e){var i=o(e),u=this._header["content-type"];if(this._formData&&console.error(".send() can't be used if .attach() or .field() is used. Please use only .send() or only .field() & .attach()"),i&&!this._data)Array.isArray(e)?this._data=[]:this._isHost(e)||(this._data={});else if(e&&this._data&&this._isHost(this._data))throw Error("Can't merge these send calls");if(i&&o(this._data))for(var l in e)this._data[l]=e[l];else typeof e=="string"?(u||this.type("form"),u=this._header["content-type"],this._data=u=="application/x-www-form-urlencoded"?this._data?this._data+"&"+e:e:(this._data||"")+e):this._data=e;return!i||this._isHost(e)?this:(u||this.type("json"),this)},n.prototype.sortQuery=function(e){return this._sort=e===void 0||e,this},n.prototype._finalizeQueryString=function(){var e=this._query.join("&");if(e&&(this.url+=(this.url.indexOf("?")>=0?"&":"?")+e),this._query.length=0,this._sort){var i=this.url.indexOf("?");if(i>=0){var u=this.url.substring(i+1).split("&");typeof this._sort=="function"?u.sort(this._sort):u.sort(),this.url=this.url.substring(0,i)+"?"+u.join("&")}}},n.prototype._appendQueryString=function(){console.trace("Unsupported")},n.prototype._timeoutError=function(e,i,u){if(!this._aborted){var l=new Error(e+i+"ms exceeded");l.timeout=i,l.code="ECONNABORTED",l.errno=u,this.timedout=!0,this.abort(),this.callback(l)}},n.prototype._setTimeouts=function(){var e=this;this._timeout&&!this._timer&&(this._timer=setTimeout(function(){e._timeoutError("Timeout of ",e._timeout,"ETIME")},this._timeout)),this._responseTimeout&&!this._responseTimeoutTimer&&(this._responseTimeoutTimer=setTimeout(function(){e._timeoutError("Response timeout of ",e._responseTimeout,"ETIMEDOUT")},this._responseTimeout))}},{"./is-object":3}],5:[function(m,_,p){function n(e){if(e)return(function(i){for(var u in n.prototype)i[u]=n.prototype[u];return i})(e)}var o=m("./utils");_.exports=n,n.prototype.get=function(e){return this.header[e.toLowerCase()]},n.prototype._setHeaderProperties=function(e){var i=e["content-type"]||"";this.type=o.type(i);var u=o.params(i);for(var l in u)this[l]=u[l];this.links={};try{e.link&&(this.links=o.parseLinks(e.link))}catch(y){}},n.prototype._setStatusProperties=function(e){var i=e/100|0;this.status=this.statusCode=e,this.statusType=i,this.info=i==1,this.ok=i==2,this.redirect=i==3,this.clientError=i==4,this.serverError=i==5,this.error=(i==4||i==5)&&this.toError(),this.accepted=e==202,this.noContent=e==204,this.badRequest=e==400,this.unauthorized=e==401,this.notAcceptable=e==406,this.forbidden=e==403,this.notFound=e==404}},{"./utils":7}],6:[function(m,_,p){var n=["ECONNRESET","ETIMEDOUT","EADDRINFO","ESOCKETTIMEDOUT"];_.exports=function(o,e){return!!(o&&o.code&&~n.indexOf(o.code))||!!(e&&e.status&&e.status>=500)||!!(o&&"timeout"in o&&o.code=="ECONNABORTED")||!!(o&&"crossDomain"in o)}},{}],7:[function(m,_,p){p.type=function(n){return n.split(/ *; */).shift()},p.params=function(n){return n.split(/ *; */).reduce(function(o,e){var i=e.split(/ *= */),u=i.shift(),l=i.shift();return u&&l&&(o[u]=l),o},{})},p.parseLinks=function(n){return n.split(/ *, */).reduce(function(o,e){var i=e.split(/ *; */),u=i[0].slice(1,-1);return o[i[1].split(/ *= */)[1].slice(1,-1)]=u,o},{})},p.cleanHeader=function(n,o){return delete n["content-type"],delete n["content-length"],delete n["transfer-encoding"],delete n.host,o&&delete n.cookie,n}},{}],8:[function(m,_,p){"use strict";Object.defineProperty(p,"__esModule",{value:!0});const n=m("superagent"),{t:o}=m("./i18n"),{csrfToken:e}=m("./csrf"),{apiToken:i}=m("./token");class u{static CheckPassword(){var t=document.getElementById("password"),s=document.getElementById("verify-password");t.value!=s.value?s.setCustomValidity(o("js.password_validity")):s.setCustomValidity("")}static CheckDeleteName(){var t=$("#deleteModal").find("#username").get(0),s=document.getElementById("confirm-name");t.value!=s.value?s.setCustomValidity(o("js.delete_mismatch")):s.setCustomValidity("")}}class l{get token(){return i()}DeleteUser(t){let s=t.find("#user-id").val(),r=t.find("#confirm-name").val();n.delete(`/api/v1/users/${s}`).query({confirm:r}).set("Authorization",`Bearer ${i()}`).set("X-CSRF-Token",e()).end(function(a,d){a?d&&d.body&&d.body.reason?(alert(o("js.user_delete_failed",d.body.reason)),window.location.reload(!0)):alert(o("js.http_error",a)):(alert(o("js.user_deleted")),window.location.reload(!0))})}UnlockUser(t){n.post(`/api/v1/users/${t}/unlock`).set("Authorization",`Bearer ${i()}`).set("X-CSRF-Token",e()).end(function(s,r){s?r&&r.body&&r.body.reason?alert(o("js.user_unlock_failed",r.body.reason)):alert(o("js.http_error",s)):(alert(o("js.user_unlocked")),window.location.reload(!0))})}SuspendUser(t,s){confirm(o("js.user_suspend_confirm",s))&&n.post(`/api/v1/users/${t}/suspend`).set("Authorization",`Bearer ${i()}`).set("X-CSRF-Token",e()).end(function(r,a){r?a&&a.body&&a.body.reason?alert(o("js.user_suspend_failed",a.body.reason)):alert(o("js.http_error",r)):(alert(o("js.user_suspended")),window.location.reload(!0))})}ResumeUser(t){n.post(`/api/v1/users/${t}/resume`).set("Authorization",`Bearer ${i()}`).set("X-CSRF-Token",e()).end(function(s,r){s?r&&r.body&&r.body.reason?alert(o("js.user_resume_failed",r.body.reason)):alert(o("js.http_error",s)):(alert(o("js.user_resumed")),window.location.reload(!0))})}ResetTOTP(t,s){confirm(o("js.totp_reset_confirm",s))&&n.delete(`/api/v1/users/${t}/totp`).set("Authorization",`Bearer ${i()}`).set("X-CSRF-Token",e()).end(function(r,a){r?a&&a.body&&a.body.reason?alert(o("js.totp_reset_failed",a.body.reason)):alert(o("js.http_error",r)):(alert(o("js.totp_reset")),window.location.reload(!0))})}EditUser(t){let s=t.find("#user-id").val(),r=t.find("#is-admin").is(":checked"),a=t.find("#is-reset-password").is(":checked");n.patch(`/api/v1/users/${s}`).set("Content-Type","application/json").set("Authorization",`Bearer ${i()}`).set("X-CSRF-Token",e()).send({is_admin:r,reset_password:a}).end(function(d,f){if(d)f&&f.body&&f.body.reason?(alert(o("js.user_edit_failed",f.body.reason)),window.location.reload(!0)):alert(o("js.http_error",d));else{let w=o("js.user_edited");a&&(w+=`
+`+o("js.password_reset",f.body.password)),alert(w),window.location.reload(!0)}})}CreateUser(t){let s=t.find("#username").val(),r=t.find("#password").val(),a=t.find("#verify-password").val(),d=t.find("#is-admin").is(":checked");n.post("/api/v1/users").set("Content-Type","application/json").set("Authorization",`Bearer ${i()}`).set("X-CSRF-Token",e()).send({name:s,password:r,verify_password:a,is_admin:d}).end(function(f,w){f?w&&w.body&&w.body.reason?(alert(o("js.user_create_failed",w.body.reason)),window.location.reload(!0)):alert(o("js.http_error",f)):(alert(o("js.user_created")),window.location.reload(!0))})}}$(document).on("submit",function(h){$("form").find(":submit").prop("disabled",!0)}),$(document).ready(function(){$("#password").keyup(function(){u.CheckPassword()}),$("#verify-password").keyup(function(){u.CheckPassword()}),$("#confirm-name").keyup(function(){u.CheckDeleteName()})}),$("#editModal").on("show.bs.modal",function(h){let t=$(h.relatedTarget),s=t.data("id"),r=t.data("name"),a=t.data("is-admin"),d=$(this);d.find("#username").val(r),d.find("#user-id").val(s),d.find("#is-admin").prop("checked",a),d.find("#is-admin").prop("disabled",s==1),d.find("#is-reset-password").prop("checked",!1)}),$("#deleteModal").on("show.bs.modal",function(h){let t=$(h.relatedTarget),s=t.data("id"),r=t.data("name"),a=$(this);a.find("#username").val(r),a.find("#user-id").val(s),a.find("#confirm-name").val(""),u.CheckDeleteName()});var y=new l,v=document.getElementById("create-user-validation");v.addEventListener("submit",h=>{h.preventDefault(),y.CreateUser($("#create-user-validation"))});var T=document.getElementById("edit-user-validation");T.addEventListener("submit",h=>{h.preventDefault(),y.EditUser($("#edit-user-validation"))});var x=document.getElementById("delete-user-validation");x.addEventListener("submit",h=>{h.preventDefault(),y.DeleteUser($("#delete-user-validation"))});var k=document.getElementsByClassName("unlock-user");for(let h=0;h<k.length;h++){let t=k[h];t.addEventListener("click",s=>{s.preventDefault(),y.UnlockUser(t.dataset.id)})}var j=document.getElementsByClassName("suspend-user");for(let h=0;h<j.length;h++){let t=j[h];t.addEventListener("click",s=>{s.preventDefault(),y.SuspendUser(t.dataset.id,t.dataset.name)})}var E=document.getElementsByClassName("resume-user");for(let h=0;h<E.length;h++){let t=E[h];t.addEventListener("click",s=>{s.preventDefault(),y.ResumeUser(t.dataset.id)})}var c=document.getElementsByClassName("reset-totp");for(let h=0;h<c.length;h++){let t=c[h];t.addEventListener("click",s=>{s.preventDefault(),y.ResetTOTP(t.dataset.id,t.dataset.name)})}},{superagent:2,"./i18n":9,"./csrf":10,"./token":11}],9:[function(m,_,p){"use strict";Object.defineProperty(p,"__esModule",{value:!0}),p.t=e;let n={};const o=document.getElementById("messages");if(o&&o.textContent)try{n=JSON.parse(o.textContent)}catch(i){n={}}function e(i,...u){let l=n[i]||i;for(const y of u)l=l.replace(/%[sv]/,String(y));return l}},{}],10:[function(m,_,p){"use strict";Object.defineProperty(p,"__esModule",{value:!0}),p.csrfToken=n;function n(){const o=document.getElementById("csrf-token");return o?o.value:""}},{}],11:[function(m,_,p){"use strict";Object.defineProperty(p,"__esModule",{value:!0}),p.apiToken=x;const n=m("superagent"),{t:o}=m("./i18n"),{csrfToken:e}=m("./csrf"),i=60*1e3;let u="",l=0,y,v=!1,T=!1;function x(){return u}function k(c){y!==void 0&&window.clearTimeout(y),y=window.setTimeout(j,Math.max(c,0))}function j(){y!==void 0&&(window.clearTimeout(y),y=void 0),!(v||T)&&(v=!0,n.post("/api/v1/token/refresh").set("X-CSRF-Token",e()).send().end(function(c,h){v=!1,c?h&&h.status==401?(T=!0,alert(o("js.login_expired"))):k(10*1e3):(u=h.body.token,l=Date.parse(h.body.expires_at),k(l-i-Date.now()))}))}const E=document.getElementById("api-token");E&&(u=E.value,l=Number(E.dataset.expiresAt)*1e3,k(l-i-Date.now()),document.addEventListener("visibilitychange",()=>{!document.hidden&&Date.now()>l-i&&j()}))},{superagent:2,"./i18n":9,"./csrf":10}]},{},[8]);
@@ -1 +1,207 @@
-!function t(e,n,i){function r(o,s){if(!n[o]){if(!e[o]){var u="function"==typeof require&&require;if(!s&&u)return u(o,!0);if(a)return a(o,!0);throw new Error("Cannot find module '"+o+"'")}var c=n[o]={exports:{}};e[o][0].call(c.exports,function(t){var n=e[o][1][t];return r(n||t)},c,c.exports,t,e,n,i)}return n[o].exports}for(var a="function"==typeof require&&require,o=0;o<i.length;o++)r(i[o]);return r}({1:[function(t,e,n){!function(t,i){"object"==typeof n&&void 0!==e?e.exports=i():"function"==typeof define&&define.amd?define(i):t.c3=i()}(this,function(){"use strict";function e(t,e){this.component=t,this.params=e||{},this.d3=t.d3,this.scale=this.d3.scale.linear(),this.range,this.orient="bottom",this.innerTickSize=6,this.outerTickSize=this.params.withOuterTick?6:0,this.tickPadding=3,this.tickValues=null,this.tickFormat,this.tickArguments,this.tickOffset=0,this.tickCulling=!0,this.tickCentered,this.tickTextCharSize,this.tickTextRotate=this.params.tickTextRotate,this.tickLength,this.axis=this.generateAxis()}function n(t,e,n){this.owner=t,E.chart.internal[e]=n}function i(t){var e=this.internal=new r(this);e.loadConfig(t),e.beforeInit(t),e.init(),e.afterInit(t),function t(e,n,i){Object.keys(e).forEach(function(r){n[r]=e[r].bind(i),Object.keys(e[r]).length>0&&t(e[r],n[r],i)})}(M,this,this)}function r(e){this.d3=window.d3?window.d3:void 0!==t?t("d3"):void 0,this.api=e,this.config=this.getDefaultConfig(),this.data={},this.cache={},this.axes={}}var a,o,s={target:"c3-target",chart:"c3-chart",chartLine:"c3-chart-line",chartLines:"c3-chart-lines",chartBar:"c3-chart-bar",chartBars:"c3-chart-bars",chartText:"c3-chart-text",chartTexts:"c3-chart-texts",chartArc:"c3-chart-arc",chartArcs:"c3-chart-arcs",chartArcsTitle:"c3-chart-arcs-title",chartArcsBackground:"c3-chart-arcs-background",chartArcsGaugeUnit:"c3-chart-arcs-gauge-unit",chartArcsGaugeMax:"c3-chart-arcs-gauge-max",chartArcsGaugeMin:"c3-chart-arcs-gauge-min",selectedCircle:"c3-selected-circle",selectedCircles:"c3-selected-circles",eventRect:"c3-event-rect",eventRects:"c3-event-rects",eventRectsSingle:"c3-event-rects-single",eventRectsMultiple:"c3-event-rects-multiple",zoomRect:"c3-zoom-rect",brush:"c3-brush",focused:"c3-focused",defocused:"c3-defocused",region:"c3-region",regions:"c3-regions",title:"c3-title",tooltipContainer:"c3-tooltip-container",tooltip:"c3-tooltip",tooltipName:"c3-tooltip-name",shape:"c3-shape",shapes:"c3-shapes",line:"c3-line",lines:"c3-lines",bar:"c3-bar",bars:"c3-bars",circle:"c3-circle",circles:"c3-circles",arc:"c3-arc",arcs:"c3-arcs",area:"c3-area",areas:"c3-areas",empty:"c3-empty",text:"c3-text",texts:"c3-texts",gaugeValue:"c3-gauge-value",grid:"c3-grid",gridLines:"c3-grid-lines",xgrid:"c3-xgrid",xgrids:"c3-xgrids",xgridLine:"c3-xgrid-line",xgridLines:"c3-xgrid-lines",xgridFocus:"c3-xgrid-focus",ygrid:"c3-ygrid",ygrids:"c3-ygrids",ygridLine:"c3-ygrid-line",ygridLines:"c3-ygrid-lines",axis:"c3-axis",axisX:"c3-axis-x",axisXLabel:"c3-axis-x-label",axisY:"c3-axis-y",axisYLabel:"c3-axis-y-label",axisY2:"c3-axis-y2",axisY2Label:"c3-axis-y2-label",legendBackground:"c3-legend-background",legendItem:"c3-legend-item",legendItemEvent:"c3-legend-item-event",legendItemTile:"c3-legend-item-tile",legendItemHidden:"c3-legend-item-hidden",legendItemFocused:"c3-legend-item-focused",dragarea:"c3-dragarea",EXPANDED:"_expanded_",SELECTED:"_selected_",INCLUDED:"_included_"},u="function"==typeof Symbol&&"symbol"==typeof Symbol.iterator?function(t){return typeof t}:function(t){return t&&"function"==typeof Symbol&&t.constructor===Symbol&&t!==Symbol.prototype?"symbol":typeof t},c=function(t,e){if(!(t instanceof e))throw new TypeError("Cannot call a class as a function")},l=function(t,e){if(!t)throw new ReferenceError("this hasn't been initialised - super() hasn't been called");return!e||"object"!=typeof e&&"function"!=typeof e?t:e},h=function(t){return t||0===t},d=function(t){return"function"==typeof t},f=function(t){return Array.isArray(t)},g=function(t){return"string"==typeof t},p=function(t){return void 0===t},m=function(t){return void 0!==t},v=function(t){return 10*Math.ceil(t/10)},y=function(t){return Math.ceil(t)+.5},x=function(t){return t[1]-t[0]},_=function(t){return void 0===t||null===t||g(t)&&0===t.length||"object"===(void 0===t?"undefined":u(t))&&0===Object.keys(t).length},w=function(t){return!P.isEmpty(t)},b=function(t,e,n){return m(t[e])?t[e]:n},S=function(t,e){var n=!1;return Object.keys(t).forEach(function(i){t[i]===e&&(n=!0)}),n},T=function(t){return"string"==typeof t?t.replace(/</g,"&lt;").replace(/>/g,"&gt;"):t},A=function(t){var e=t.getBoundingClientRect(),n=[t.pathSegList.getItem(0),t.pathSegList.getItem(1)];return{x:n[0].x,y:Math.min(n[0].y,n[1].y),width:e.width,height:e.height}};(o=e.prototype).axisX=function(t,e,n){t.attr("transform",function(t){return"translate("+Math.ceil(e(t)+n)+", 0)"})},o.axisY=function(t,e){t.attr("transform",function(t){return"translate(0,"+Math.ceil(e(t))+")"})},o.scaleExtent=function(t){var e=t[0],n=t[t.length-1];return e<n?[e,n]:[n,e]},o.generateTicks=function(t){var e,n,i=[];if(t.ticks)return t.ticks.apply(t,this.tickArguments);for(n=t.domain(),e=Math.ceil(n[0]);e<n[1];e++)i.push(e);return i.length>0&&i[0]>0&&i.unshift(i[0]-(i[1]-i[0])),i},o.copyScale=function(){var t,e=this.scale.copy();return this.params.isCategory&&(t=this.scale.domain(),e.domain([t[0],t[1]-1])),e},o.textFormatted=function(t){var e=this.tickFormat?this.tickFormat(t):t;return void 0!==e?e:""},o.updateRange=function(){return this.range=this.scale.rangeExtent?this.scale.rangeExtent():this.scaleExtent(this.scale.range()),this.range},o.updateTickTextCharSize=function(t){var e=this;if(e.tickTextCharSize)return e.tickTextCharSize;var n={h:11.5,w:5.5};return t.select("text").text(function(t){return e.textFormatted(t)}).each(function(t){var i=this.getBoundingClientRect(),r=e.textFormatted(t),a=i.height,o=r?i.width/r.length:void 0;a&&o&&(n.h=a,n.w=o)}).text(""),e.tickTextCharSize=n,n},o.transitionise=function(t){return this.params.withoutTransition?t:this.d3.transition(t)},o.isVertical=function(){return"left"===this.orient||"right"===this.orient},o.tspanData=function(t,e,n,i){var r=this.params.tickMultiline?this.splitTickText(t,n,i):[].concat(this.textFormatted(t));return r.map(function(t){return{index:e,splitted:t,length:r.length}})},o.splitTickText=function(t,e,n){function i(t,e){a=void 0;for(var n=1;n<e.length;n++)if(" "===e.charAt(n)&&(a=n),r=e.substr(0,n+1),o=s.tickTextCharSize.w*r.length,c<o)return i(t.concat(e.substr(0,a||n)),e.slice(a?a+1:n));return t.concat(e)}var r,a,o,s=this,u=s.textFormatted(t),c=s.params.tickWidth;return"[object Array]"===Object.prototype.toString.call(u)?u:((!c||c<=0)&&(c=s.isVertical()?95:s.params.isCategory?Math.ceil(n(e[1])-n(e[0]))-12:110),i([],u+""))},o.updateTickLength=function(){this.tickLength=Math.max(this.innerTickSize,0)+this.tickPadding},o.lineY2=function(t){var e=this.scale(t)+(this.tickCentered?0:this.tickOffset);return this.range[0]<e&&e<this.range[1]?this.innerTickSize:0},o.textY=function(){var t=this.tickTextRotate;return t?11.5-t/15*2.5*(t>0?1:-1):this.tickLength},o.textTransform=function(){var t=this.tickTextRotate;return t?"rotate("+t+")":""},o.textTextAnchor=function(){var t=this.tickTextRotate;return t?t>0?"start":"end":"middle"},o.tspanDx=function(){var t=this.tickTextRotate;return t?8*Math.sin(Math.PI*(t/180)):0},o.tspanDy=function(t,e){var n=this.tickTextCharSize.h;return 0===e&&(n=this.isVertical()?-((t.length-1)*(this.tickTextCharSize.h/2)-3):".71em"),n},o.generateAxis=function(){function t(r){r.each(function(){var r,a,o,s=t.g=n.select(this),u=this.__chart__||e.scale,c=this.__chart__=e.copyScale(),l=e.tickValues?e.tickValues:e.generateTicks(c),h=s.selectAll(".tick").data(l,c),d=h.enter().insert("g",".domain").attr("class","tick").style("opacity",1e-6),f=h.exit().remove(),g=e.transitionise(h).style("opacity",1);i.isCategory?(e.tickOffset=Math.ceil((c(1)-c(0))/2),a=e.tickCentered?0:e.tickOffset,o=e.tickCentered?e.tickOffset:0):e.tickOffset=a=0,d.append("line"),d.append("text"),e.updateRange(),e.updateTickLength(),e.updateTickTextCharSize(s.select(".tick"));var p=g.select("line"),m=g.select("text"),v=h.select("text").selectAll("tspan").data(function(t,n){return e.tspanData(t,n,l,c)});v.enter().append("tspan"),v.exit().remove(),v.text(function(t){return t.splitted});var y=s.selectAll(".domain").data([0]),x=(y.enter().append("path").attr("class","domain"),e.transitionise(y));switch(e.orient){case"bottom":r=e.axisX,p.attr("x1",a).attr("x2",a).attr("y2",function(t,n){return e.lineY2(t,n)}),m.attr("x",0).attr("y",function(t,n){return e.textY(t,n)}).attr("transform",function(t,n){return e.textTransform(t,n)}).style("text-anchor",function(t,n){return e.textTextAnchor(t,n)}),v.attr("x",0).attr("dy",function(t,n){return e.tspanDy(t,n)}).attr("dx",function(t,n){return e.tspanDx(t,n)}),x.attr("d","M"+e.range[0]+","+e.outerTickSize+"V0H"+e.range[1]+"V"+e.outerTickSize);break;case"top":r=e.axisX,p.attr("x2",0).attr("y2",-e.innerTickSize),m.attr("x",0).attr("y",-e.tickLength).style("text-anchor","middle"),v.attr("x",0).attr("dy","0em"),x.attr("d","M"+e.range[0]+","+-e.outerTickSize+"V0H"+e.range[1]+"V"+-e.outerTickSize);break;case"left":r=e.axisY,p.attr("x2",-e.innerTickSize).attr("y1",o).attr("y2",o),m.attr("x",-e.tickLength).attr("y",e.tickOffset).style("text-anchor","end"),v.attr("x",-e.tickLength).attr("dy",function(t,n){return e.tspanDy(t,n)}),x.attr("d","M"+-e.outerTickSize+","+e.range[0]+"H0V"+e.range[1]+"H"+-e.outerTickSize);break;case"right":r=e.axisY,p.attr("x2",e.innerTickSize).attr("y2",0),m.attr("x",e.tickLength).attr("y",0).style("text-anchor","start"),v.attr("x",e.tickLength).attr("dy",function(t,n){return e.tspanDy(t,n)}),x.attr("d","M"+e.outerTickSize+","+e.range[0]+"H0V"+e.range[1]+"H"+e.outerTickSize)}if(c.rangeBand){var _=c,w=_.rangeBand()/2;u=c=function(t){return _(t)+w}}else u.rangeBand?u=c:f.call(r,c,e.tickOffset);d.call(r,u,e.tickOffset),g.call(r,c,e.tickOffset)})}var e=this,n=e.d3,i=e.params;return t.scale=function(n){return arguments.length?(e.scale=n,t):e.scale},t.orient=function(n){return arguments.length?(e.orient=n in{top:1,right:1,bottom:1,left:1}?n+"":"bottom",t):e.orient},t.tickFormat=function(n){return arguments.length?(e.tickFormat=n,t):e.tickFormat},t.tickCentered=function(n){return arguments.length?(e.tickCentered=n,t):e.tickCentered},t.tickOffset=function(){return e.tickOffset},t.tickInterval=function(){var n;return(n=i.isCategory?2*e.tickOffset:(t.g.select("path.domain").node().getTotalLength()-2*e.outerTickSize)/t.g.selectAll("line").size())===1/0?0:n},t.ticks=function(){return arguments.length?(e.tickArguments=arguments,t):e.tickArguments},t.tickCulling=function(n){return arguments.length?(e.tickCulling=n,t):e.tickCulling},t.tickValues=function(n){if("function"==typeof n)e.tickValues=function(){return n(e.scale.domain())};else{if(!arguments.length)return e.tickValues;e.tickValues=n}return t},t};var C=function(t){function i(t){c(this,i);var n={fn:a,internal:{fn:o}},r=l(this,(i.__proto__||Object.getPrototypeOf(i)).call(this,t,"axis",n));return r.d3=t.d3,r.internal=e,r}return function(t,e){if("function"!=typeof e&&null!==e)throw new TypeError("Super expression must either be null or a function, not "+typeof e);t.prototype=Object.create(e&&e.prototype,{constructor:{value:t,enumerable:!1,writable:!0,configurable:!0}}),e&&(Object.setPrototypeOf?Object.setPrototypeOf(t,e):t.__proto__=e)}(i,n),i}();(a=C.prototype).init=function(){var t=this.owner,e=t.config,n=t.main;t.axes.x=n.append("g").attr("class",s.axis+" "+s.axisX).attr("clip-path",t.clipPathForXAxis).attr("transform",t.getTranslate("x")).style("visibility",e.axis_x_show?"visible":"hidden"),t.axes.x.append("text").attr("class",s.axisXLabel).attr("transform",e.axis_rotated?"rotate(-90)":"").style("text-anchor",this.textAnchorForXAxisLabel.bind(this)),t.axes.y=n.append("g").attr("class",s.axis+" "+s.axisY).attr("clip-path",e.axis_y_inner?"":t.clipPathForYAxis).attr("transform",t.getTranslate("y")).style("visibility",e.axis_y_show?"visible":"hidden"),t.axes.y.append("text").attr("class",s.axisYLabel).attr("transform",e.axis_rotated?"":"rotate(-90)").style("text-anchor",this.textAnchorForYAxisLabel.bind(this)),t.axes.y2=n.append("g").attr("class",s.axis+" "+s.axisY2).attr("transform",t.getTranslate("y2")).style("visibility",e.axis_y2_show?"visible":"hidden"),t.axes.y2.append("text").attr("class",s.axisY2Label).attr("transform",e.axis_rotated?"":"rotate(-90)").style("text-anchor",this.textAnchorForY2AxisLabel.bind(this))},a.getXAxis=function(t,e,n,i,r,a,o){var s=this.owner,u=s.config,c={isCategory:s.isCategorized(),withOuterTick:r,tickMultiline:u.axis_x_tick_multiline,tickWidth:u.axis_x_tick_width,tickTextRotate:o?0:u.axis_x_tick_rotate,withoutTransition:a},l=new this.internal(this,c).axis.scale(t).orient(e);return s.isTimeSeries()&&i&&"function"!=typeof i&&(i=i.map(function(t){return s.parseDate(t)})),l.tickFormat(n).tickValues(i),s.isCategorized()&&(l.tickCentered(u.axis_x_tick_centered),_(u.axis_x_tick_culling)&&(u.axis_x_tick_culling=!1)),l},a.updateXAxisTickValues=function(t,e){var n,i=this.owner,r=i.config;return(r.axis_x_tick_fit||r.axis_x_tick_count)&&(n=this.generateTickValues(i.mapTargetsToUniqueXs(t),r.axis_x_tick_count,i.isTimeSeries())),e?e.tickValues(n):(i.xAxis.tickValues(n),i.subXAxis.tickValues(n)),n},a.getYAxis=function(t,e,n,i,r,a,o){var s=this.owner,u=s.config,c={withOuterTick:r,withoutTransition:a,tickTextRotate:o?0:u.axis_y_tick_rotate},l=new this.internal(this,c).axis.scale(t).orient(e).tickFormat(n);return s.isTimeSeriesY()?l.ticks(s.d3.time[u.axis_y_tick_time_value],u.axis_y_tick_time_interval):l.tickValues(i),l},a.getId=function(t){var e=this.owner.config;return t in e.data_axes?e.data_axes[t]:"y"},a.getXAxisTickFormat=function(){var t=this.owner,e=t.config,n=t.isTimeSeries()?t.defaultAxisTimeFormat:t.isCategorized()?t.categoryName:function(t){return t<0?t.toFixed(0):t};return e.axis_x_tick_format&&(d(e.axis_x_tick_format)?n=e.axis_x_tick_format:t.isTimeSeries()&&(n=function(n){return n?t.axisTimeFormat(e.axis_x_tick_format)(n):""})),d(n)?function(e){return n.call(t,e)}:n},a.getTickValues=function(t,e){return t||(e?e.tickValues():void 0)},a.getXAxisTickValues=function(){return this.getTickValues(this.owner.config.axis_x_tick_values,this.owner.xAxis)},a.getYAxisTickValues=function(){return this.getTickValues(this.owner.config.axis_y_tick_values,this.owner.yAxis)},a.getY2AxisTickValues=function(){return this.getTickValues(this.owner.config.axis_y2_tick_values,this.owner.y2Axis)},a.getLabelOptionByAxisId=function(t){var e,n=this.owner.config;return"y"===t?e=n.axis_y_label:"y2"===t?e=n.axis_y2_label:"x"===t&&(e=n.axis_x_label),e},a.getLabelText=function(t){var e=this.getLabelOptionByAxisId(t);return g(e)?e:e?e.text:null},a.setLabelText=function(t,e){var n=this.owner.config,i=this.getLabelOptionByAxisId(t);g(i)?"y"===t?n.axis_y_label=e:"y2"===t?n.axis_y2_label=e:"x"===t&&(n.axis_x_label=e):i&&(i.text=e)},a.getLabelPosition=function(t,e){var n=this.getLabelOptionByAxisId(t),i=n&&"object"===(void 0===n?"undefined":u(n))&&n.position?n.position:e;return{isInner:i.indexOf("inner")>=0,isOuter:i.indexOf("outer")>=0,isLeft:i.indexOf("left")>=0,isCenter:i.indexOf("center")>=0,isRight:i.indexOf("right")>=0,isTop:i.indexOf("top")>=0,isMiddle:i.indexOf("middle")>=0,isBottom:i.indexOf("bottom")>=0}},a.getXAxisLabelPosition=function(){return this.getLabelPosition("x",this.owner.config.axis_rotated?"inner-top":"inner-right")},a.getYAxisLabelPosition=function(){return this.getLabelPosition("y",this.owner.config.axis_rotated?"inner-right":"inner-top")},a.getY2AxisLabelPosition=function(){return this.getLabelPosition("y2",this.owner.config.axis_rotated?"inner-right":"inner-top")},a.getLabelPositionById=function(t){return"y2"===t?this.getY2AxisLabelPosition():"y"===t?this.getYAxisLabelPosition():this.getXAxisLabelPosition()},a.textForXAxisLabel=function(){return this.getLabelText("x")},a.textForYAxisLabel=function(){return this.getLabelText("y")},a.textForY2AxisLabel=function(){return this.getLabelText("y2")},a.xForAxisLabel=function(t,e){var n=this.owner;return t?e.isLeft?0:e.isCenter?n.width/2:n.width:e.isBottom?-n.height:e.isMiddle?-n.height/2:0},a.dxForAxisLabel=function(t,e){return t?e.isLeft?"0.5em":e.isRight?"-0.5em":"0":e.isTop?"-0.5em":e.isBottom?"0.5em":"0"},a.textAnchorForAxisLabel=function(t,e){return t?e.isLeft?"start":e.isCenter?"middle":"end":e.isBottom?"start":e.isMiddle?"middle":"end"},a.xForXAxisLabel=function(){return this.xForAxisLabel(!this.owner.config.axis_rotated,this.getXAxisLabelPosition())},a.xForYAxisLabel=function(){return this.xForAxisLabel(this.owner.config.axis_rotated,this.getYAxisLabelPosition())},a.xForY2AxisLabel=function(){return this.xForAxisLabel(this.owner.config.axis_rotated,this.getY2AxisLabelPosition())},a.dxForXAxisLabel=function(){return this.dxForAxisLabel(!this.owner.config.axis_rotated,this.getXAxisLabelPosition())},a.dxForYAxisLabel=function(){return this.dxForAxisLabel(this.owner.config.axis_rotated,this.getYAxisLabelPosition())},a.dxForY2AxisLabel=function(){return this.dxForAxisLabel(this.owner.config.axis_rotated,this.getY2AxisLabelPosition())},a.dyForXAxisLabel=function(){var t=this.owner.config,e=this.getXAxisLabelPosition();return t.axis_rotated?e.isInner?"1.2em":-25-this.getMaxTickWidth("x"):e.isInner?"-0.5em":t.axis_x_height?t.axis_x_height-10:"3em"},a.dyForYAxisLabel=function(){var t=this.owner,e=this.getYAxisLabelPosition();return t.config.axis_rotated?e.isInner?"-0.5em":"3em":e.isInner?"1.2em":-10-(t.config.axis_y_inner?0:this.getMaxTickWidth("y")+10)},a.dyForY2AxisLabel=function(){var t=this.owner,e=this.getY2AxisLabelPosition();return t.config.axis_rotated?e.isInner?"1.2em":"-2.2em":e.isInner?"-0.5em":15+(t.config.axis_y2_inner?0:this.getMaxTickWidth("y2")+15)},a.textAnchorForXAxisLabel=function(){var t=this.owner;return this.textAnchorForAxisLabel(!t.config.axis_rotated,this.getXAxisLabelPosition())},a.textAnchorForYAxisLabel=function(){var t=this.owner;return this.textAnchorForAxisLabel(t.config.axis_rotated,this.getYAxisLabelPosition())},a.textAnchorForY2AxisLabel=function(){var t=this.owner;return this.textAnchorForAxisLabel(t.config.axis_rotated,this.getY2AxisLabelPosition())},a.getMaxTickWidth=function(t,e){var n,i,r,a,o=this.owner,s=o.config,u=0;return e&&o.currentMaxTickWidths[t]?o.currentMaxTickWidths[t]:(o.svg&&(n=o.filterTargetsToShow(o.data.targets),"y"===t?(i=o.y.copy().domain(o.getYDomain(n,"y")),r=this.getYAxis(i,o.yOrient,s.axis_y_tick_format,o.yAxisTickValues,!1,!0,!0)):"y2"===t?(i=o.y2.copy().domain(o.getYDomain(n,"y2")),r=this.getYAxis(i,o.y2Orient,s.axis_y2_tick_format,o.y2AxisTickValues,!1,!0,!0)):(i=o.x.copy().domain(o.getXDomain(n)),r=this.getXAxis(i,o.xOrient,o.xAxisTickFormat,o.xAxisTickValues,!1,!0,!0),this.updateXAxisTickValues(n,r)),(a=o.d3.select("body").append("div").classed("c3",!0)).append("svg").style("visibility","hidden").style("position","fixed").style("top",0).style("left",0).append("g").call(r).each(function(){o.d3.select(this).selectAll("text").each(function(){var t=this.getBoundingClientRect();u<t.width&&(u=t.width)}),a.remove()})),o.currentMaxTickWidths[t]=u<=0?o.currentMaxTickWidths[t]:u,o.currentMaxTickWidths[t])},a.updateLabels=function(t){var e=this.owner,n=e.main.select("."+s.axisX+" ."+s.axisXLabel),i=e.main.select("."+s.axisY+" ."+s.axisYLabel),r=e.main.select("."+s.axisY2+" ."+s.axisY2Label);(t?n.transition():n).attr("x",this.xForXAxisLabel.bind(this)).attr("dx",this.dxForXAxisLabel.bind(this)).attr("dy",this.dyForXAxisLabel.bind(this)).text(this.textForXAxisLabel.bind(this)),(t?i.transition():i).attr("x",this.xForYAxisLabel.bind(this)).attr("dx",this.dxForYAxisLabel.bind(this)).attr("dy",this.dyForYAxisLabel.bind(this)).text(this.textForYAxisLabel.bind(this)),(t?r.transition():r).attr("x",this.xForY2AxisLabel.bind(this)).attr("dx",this.dxForY2AxisLabel.bind(this)).attr("dy",this.dyForY2AxisLabel.bind(this)).text(this.textForY2AxisLabel.bind(this))},a.getPadding=function(t,e,n,i){var r="number"==typeof t?t:t[e];return h(r)?"ratio"===t.unit?t[e]*i:this.convertPixelsToAxisPadding(r,i):n},a.convertPixelsToAxisPadding=function(t,e){var n=this.owner;return e*(t/(n.config.axis_rotated?n.width:n.height))},a.generateTickValues=function(t,e,n){var i,r,a,o,s,u,c,l=t;if(e)if(1===(i=d(e)?e():e))l=[t[0]];else if(2===i)l=[t[0],t[t.length-1]];else if(i>2){for(o=i-2,r=t[0],s=((a=t[t.length-1])-r)/(o+1),l=[r],u=0;u<o;u++)c=+r+s*(u+1),l.push(n?new Date(c):c);l.push(a)}return n||(l=l.sort(function(t,e){return t-e})),l},a.generateTransitions=function(t){var e=this.owner.axes;return{axisX:t?e.x.transition().duration(t):e.x,axisY:t?e.y.transition().duration(t):e.y,axisY2:t?e.y2.transition().duration(t):e.y2,axisSubX:t?e.subx.transition().duration(t):e.subx}},a.redraw=function(t,e){var n=this.owner;n.axes.x.style("opacity",e?0:1),n.axes.y.style("opacity",e?0:1),n.axes.y2.style("opacity",e?0:1),n.axes.subx.style("opacity",e?0:1),t.axisX.call(n.xAxis),t.axisY.call(n.yAxis),t.axisY2.call(n.y2Axis),t.axisSubX.call(n.subXAxis)};var M,P,E={version:"0.4.18"};return E.generate=function(t){return new i(t)},E.chart={fn:i.prototype,internal:{fn:r.prototype}},M=E.chart.fn,P=E.chart.internal.fn,P.beforeInit=function(){},P.afterInit=function(){},P.init=function(){var t=this.config;if(this.initParams(),t.data_url)this.convertUrlToData(t.data_url,t.data_mimeType,t.data_headers,t.data_keys,this.initWithData);else if(t.data_json)this.initWithData(this.convertJsonToData(t.data_json,t.data_keys));else if(t.data_rows)this.initWithData(this.convertRowsToData(t.data_rows));else{if(!t.data_columns)throw Error("url or json or rows or columns is required.");this.initWithData(this.convertColumnsToData(t.data_columns))}},P.initParams=function(){var t=this.d3,e=this.config;this.clipId="c3-"+ +new Date+"-clip",this.clipIdForXAxis=this.clipId+"-xaxis",this.clipIdForYAxis=this.clipId+"-yaxis",this.clipIdForGrid=this.clipId+"-grid",this.clipIdForSubchart=this.clipId+"-subchart",this.clipPath=this.getClipPath(this.clipId),this.clipPathForXAxis=this.getClipPath(this.clipIdForXAxis),this.clipPathForYAxis=this.getClipPath(this.clipIdForYAxis),this.clipPathForGrid=this.getClipPath(this.clipIdForGrid),this.clipPathForSubchart=this.getClipPath(this.clipIdForSubchart),this.dragStart=null,this.dragging=!1,this.flowing=!1,this.cancelClick=!1,this.mouseover=!1,this.transiting=!1,this.color=this.generateColor(),this.levelColor=this.generateLevelColor(),this.dataTimeFormat=e.data_xLocaltime?t.time.format:t.time.format.utc,this.axisTimeFormat=e.axis_x_localtime?t.time.format:t.time.format.utc,this.defaultAxisTimeFormat=this.axisTimeFormat.multi([[".%L",function(t){return t.getMilliseconds()}],[":%S",function(t){return t.getSeconds()}],["%I:%M",function(t){return t.getMinutes()}],["%I %p",function(t){return t.getHours()}],["%-m/%-d",function(t){return t.getDay()&&1!==t.getDate()}],["%-m/%-d",function(t){return 1!==t.getDate()}],["%-m/%-d",function(t){return t.getMonth()}],["%Y/%-m/%-d",function(){return!0}]]),this.hiddenTargetIds=[],this.hiddenLegendIds=[],this.focusedTargetIds=[],this.defocusedTargetIds=[],this.xOrient=e.axis_rotated?"left":"bottom",this.yOrient=e.axis_rotated?e.axis_y_inner?"top":"bottom":e.axis_y_inner?"right":"left",this.y2Orient=e.axis_rotated?e.axis_y2_inner?"bottom":"top":e.axis_y2_inner?"left":"right",this.subXOrient=e.axis_rotated?"left":"bottom",this.isLegendRight="right"===e.legend_position,this.isLegendInset="inset"===e.legend_position,this.isLegendTop="top-left"===e.legend_inset_anchor||"top-right"===e.legend_inset_anchor,this.isLegendLeft="top-left"===e.legend_inset_anchor||"bottom-left"===e.legend_inset_anchor,this.legendStep=0,this.legendItemWidth=0,this.legendItemHeight=0,this.currentMaxTickWidths={x:0,y:0,y2:0},this.rotated_padding_left=30,this.rotated_padding_right=e.axis_rotated&&!e.axis_x_show?0:30,this.rotated_padding_top=5,this.withoutFadeIn={},this.intervalForObserveInserted=void 0,this.axes.subx=t.selectAll([])},P.initChartElements=function(){this.initBar&&this.initBar(),this.initLine&&this.initLine(),this.initArc&&this.initArc(),this.initGauge&&this.initGauge(),this.initText&&this.initText()},P.initWithData=function(t){var e,n,i=this,r=i.d3,a=i.config,o=!0;i.axis=new C(i),i.initPie&&i.initPie(),i.initBrush&&i.initBrush(),i.initZoom&&i.initZoom(),a.bindto?"function"==typeof a.bindto.node?i.selectChart=a.bindto:i.selectChart=r.select(a.bindto):i.selectChart=r.selectAll([]),i.selectChart.empty()&&(i.selectChart=r.select(document.createElement("div")).style("opacity",0),i.observeInserted(i.selectChart),o=!1),i.selectChart.html("").classed("c3",!0),i.data.xs={},i.data.targets=i.convertDataToTargets(t),a.data_filter&&(i.data.targets=i.data.targets.filter(a.data_filter)),a.data_hide&&i.addHiddenTargetIds(!0===a.data_hide?i.mapToIds(i.data.targets):a.data_hide),a.legend_hide&&i.addHiddenLegendIds(!0===a.legend_hide?i.mapToIds(i.data.targets):a.legend_hide),i.hasType("gauge")&&(a.legend_show=!1),i.updateSizes(),i.updateScales(),i.x.domain(r.extent(i.getXDomain(i.data.targets))),i.y.domain(i.getYDomain(i.data.targets,"y")),i.y2.domain(i.getYDomain(i.data.targets,"y2")),i.subX.domain(i.x.domain()),i.subY.domain(i.y.domain()),i.subY2.domain(i.y2.domain()),i.orgXDomain=i.x.domain(),i.brush&&i.brush.scale(i.subX),a.zoom_enabled&&i.zoom.scale(i.x),i.svg=i.selectChart.append("svg").style("overflow","hidden").on("mouseenter",function(){return a.onmouseover.call(i)}).on("mouseleave",function(){return a.onmouseout.call(i)}),i.config.svg_classname&&i.svg.attr("class",i.config.svg_classname),e=i.svg.append("defs"),i.clipChart=i.appendClip(e,i.clipId),i.clipXAxis=i.appendClip(e,i.clipIdForXAxis),i.clipYAxis=i.appendClip(e,i.clipIdForYAxis),i.clipGrid=i.appendClip(e,i.clipIdForGrid),i.clipSubchart=i.appendClip(e,i.clipIdForSubchart),i.updateSvgSize(),n=i.main=i.svg.append("g").attr("transform",i.getTranslate("main")),i.initSubchart&&i.initSubchart(),i.initTooltip&&i.initTooltip(),i.initLegend&&i.initLegend(),i.initTitle&&i.initTitle(),n.append("text").attr("class",s.text+" "+s.empty).attr("text-anchor","middle").attr("dominant-baseline","middle"),i.initRegion(),i.initGrid(),n.append("g").attr("clip-path",i.clipPath).attr("class",s.chart),a.grid_lines_front&&i.initGridLines(),i.initEventRect(),i.initChartElements(),n.insert("rect",a.zoom_privileged?null:"g."+s.regions).attr("class",s.zoomRect).attr("width",i.width).attr("height",i.height).style("opacity",0).on("dblclick.zoom",null),a.axis_x_extent&&i.brush.extent(i.getDefaultExtent()),i.axis.init(),i.updateTargets(i.data.targets),o&&(i.updateDimension(),i.config.oninit.call(i),i.redraw({withTransition:!1,withTransform:!0,withUpdateXDomain:!0,withUpdateOrgXDomain:!0,withTransitionForAxis:!1})),i.bindResize(),i.api.element=i.selectChart.node()},P.smoothLines=function(t,e){var n=this;"grid"===e&&t.each(function(){var t=n.d3.select(this),e=t.attr("x1"),i=t.attr("x2"),r=t.attr("y1"),a=t.attr("y2");t.attr({x1:Math.ceil(e),x2:Math.ceil(i),y1:Math.ceil(r),y2:Math.ceil(a)})})},P.updateSizes=function(){var t=this.config,e=this.legend?this.getLegendHeight():0,n=this.legend?this.getLegendWidth():0,i=this.isLegendRight||this.isLegendInset?0:e,r=this.hasArcType(),a=t.axis_rotated||r?0:this.getHorizontalAxisHeight("x"),o=t.subchart_show&&!r?t.subchart_size_height+a:0;this.currentWidth=this.getCurrentWidth(),this.currentHeight=this.getCurrentHeight(),this.margin=t.axis_rotated?{top:this.getHorizontalAxisHeight("y2")+this.getCurrentPaddingTop(),right:r?0:this.getCurrentPaddingRight(),bottom:this.getHorizontalAxisHeight("y")+i+this.getCurrentPaddingBottom(),left:o+(r?0:this.getCurrentPaddingLeft())}:{top:4+this.getCurrentPaddingTop(),right:r?0:this.getCurrentPaddingRight(),bottom:a+o+i+this.getCurrentPaddingBottom(),left:r?0:this.getCurrentPaddingLeft()},this.margin2=t.axis_rotated?{top:this.margin.top,right:NaN,bottom:20+i,left:this.rotated_padding_left}:{top:this.currentHeight-o-i,right:NaN,bottom:a+i,left:this.margin.left},this.margin3={top:0,right:NaN,bottom:0,left:0},this.updateSizeForLegend&&this.updateSizeForLegend(e,n),this.width=this.currentWidth-this.margin.left-this.margin.right,this.height=this.currentHeight-this.margin.top-this.margin.bottom,this.width<0&&(this.width=0),this.height<0&&(this.height=0),this.width2=t.axis_rotated?this.margin.left-this.rotated_padding_left-this.rotated_padding_right:this.width,this.height2=t.axis_rotated?this.height:this.currentHeight-this.margin2.top-this.margin2.bottom,this.width2<0&&(this.width2=0),this.height2<0&&(this.height2=0),this.arcWidth=this.width-(this.isLegendRight?n+10:0),this.arcHeight=this.height-(this.isLegendRight?0:10),this.hasType("gauge")&&!t.gauge_fullCircle&&(this.arcHeight+=this.height-this.getGaugeLabelHeight()),this.updateRadius&&this.updateRadius(),this.isLegendRight&&r&&(this.margin3.left=this.arcWidth/2+1.1*this.radiusExpanded)},P.updateTargets=function(t){this.updateTargetsForText(t),this.updateTargetsForBar(t),this.updateTargetsForLine(t),this.hasArcType()&&this.updateTargetsForArc&&this.updateTargetsForArc(t),this.updateTargetsForSubchart&&this.updateTargetsForSubchart(t),this.showTargets()},P.showTargets=function(){var t=this;t.svg.selectAll("."+s.target).filter(function(e){return t.isTargetToShow(e.id)}).transition().duration(t.config.transition_duration).style("opacity",1)},P.redraw=function(t,e){var n,i,r,a,o,u,c,l,h,d,f,g,p,m,v,y,x,_,w,S,T,A,C,M,P,E,L,k,D,O=this,I=O.main,V=O.d3,G=O.config,R=O.getShapeIndices(O.isAreaType),N=O.getShapeIndices(O.isBarType),j=O.getShapeIndices(O.isLineType),F=O.hasArcType(),z=O.filterTargetsToShow(O.data.targets),H=O.xv.bind(O);if(t=t||{},n=b(t,"withY",!0),i=b(t,"withSubchart",!0),r=b(t,"withTransition",!0),u=b(t,"withTransform",!1),c=b(t,"withUpdateXDomain",!1),l=b(t,"withUpdateOrgXDomain",!1),h=b(t,"withTrimXDomain",!0),p=b(t,"withUpdateXAxis",c),d=b(t,"withLegend",!1),f=b(t,"withEventRect",!0),g=b(t,"withDimension",!0),a=b(t,"withTransitionForExit",r),o=b(t,"withTransitionForAxis",r),w=r?G.transition_duration:0,S=a?w:0,T=o?w:0,e=e||O.axis.generateTransitions(T),d&&G.legend_show?O.updateLegend(O.mapToIds(O.data.targets),t,e):g&&O.updateDimension(!0),O.isCategorized()&&0===z.length&&O.x.domain([0,O.axes.x.selectAll(".tick").size()]),z.length?(O.updateXDomain(z,c,l,h),G.axis_x_tick_values||(M=O.axis.updateXAxisTickValues(z))):(O.xAxis.tickValues([]),O.subXAxis.tickValues([])),G.zoom_rescale&&!t.flow&&(L=O.x.orgDomain()),O.y.domain(O.getYDomain(z,"y",L)),O.y2.domain(O.getYDomain(z,"y2",L)),!G.axis_y_tick_values&&G.axis_y_tick_count&&O.yAxis.tickValues(O.axis.generateTickValues(O.y.domain(),G.axis_y_tick_count)),!G.axis_y2_tick_values&&G.axis_y2_tick_count&&O.y2Axis.tickValues(O.axis.generateTickValues(O.y2.domain(),G.axis_y2_tick_count)),O.axis.redraw(e,F),O.axis.updateLabels(r),(c||p)&&z.length)if(G.axis_x_tick_culling&&M){for(P=1;P<M.length;P++)if(M.length/P<G.axis_x_tick_culling_max){E=P;break}O.svg.selectAll("."+s.axisX+" .tick text").each(function(t){var e=M.indexOf(t);e>=0&&V.select(this).style("display",e%E?"none":"block")})}else O.svg.selectAll("."+s.axisX+" .tick text").style("display","block");m=O.generateDrawArea?O.generateDrawArea(R,!1):void 0,v=O.generateDrawBar?O.generateDrawBar(N):void 0,y=O.generateDrawLine?O.generateDrawLine(j,!1):void 0,x=O.generateXYForText(R,N,j,!0),_=O.generateXYForText(R,N,j,!1),n&&(O.subY.domain(O.getYDomain(z,"y")),O.subY2.domain(O.getYDomain(z,"y2"))),O.updateXgridFocus(),I.select("text."+s.text+"."+s.empty).attr("x",O.width/2).attr("y",O.height/2).text(G.data_empty_label_text).transition().style("opacity",z.length?0:1),O.updateGrid(w),O.updateRegion(w),O.updateBar(S),O.updateLine(S),O.updateArea(S),O.updateCircle(),O.hasDataLabel()&&O.updateText(S),O.redrawTitle&&O.redrawTitle(),O.redrawArc&&O.redrawArc(w,S,u),O.redrawSubchart&&O.redrawSubchart(i,e,w,S,R,N,j),I.selectAll("."+s.selectedCircles).filter(O.isBarType.bind(O)).selectAll("circle").remove(),G.interaction_enabled&&!t.flow&&f&&(O.redrawEventRect(),O.updateZoom&&O.updateZoom()),O.updateCircleY(),k=(O.config.axis_rotated?O.circleY:O.circleX).bind(O),D=(O.config.axis_rotated?O.circleX:O.circleY).bind(O),t.flow&&(C=O.generateFlow({targets:z,flow:t.flow,duration:t.flow.duration,drawBar:v,drawLine:y,drawArea:m,cx:k,cy:D,xv:H,xForText:x,yForText:_})),(w||C)&&O.isTabVisible()?V.transition().duration(w).each(function(){var e=[];[O.redrawBar(v,!0),O.redrawLine(y,!0),O.redrawArea(m,!0),O.redrawCircle(k,D,!0),O.redrawText(x,_,t.flow,!0),O.redrawRegion(!0),O.redrawGrid(!0)].forEach(function(t){t.forEach(function(t){e.push(t)})}),A=O.generateWait(),e.forEach(function(t){A.add(t)})}).call(A,function(){C&&C(),G.onrendered&&G.onrendered.call(O)}):(O.redrawBar(v),O.redrawLine(y),O.redrawArea(m),O.redrawCircle(k,D),O.redrawText(x,_,t.flow),O.redrawRegion(),O.redrawGrid(),G.onrendered&&G.onrendered.call(O)),O.mapToIds(O.data.targets).forEach(function(t){O.withoutFadeIn[t]=!0})},P.updateAndRedraw=function(t){var e,n=this.config;(t=t||{}).withTransition=b(t,"withTransition",!0),t.withTransform=b(t,"withTransform",!1),t.withLegend=b(t,"withLegend",!1),t.withUpdateXDomain=!0,t.withUpdateOrgXDomain=!0,t.withTransitionForExit=!1,t.withTransitionForTransform=b(t,"withTransitionForTransform",t.withTransition),this.updateSizes(),t.withLegend&&n.legend_show||(e=this.axis.generateTransitions(t.withTransitionForAxis?n.transition_duration:0),this.updateScales(),this.updateSvgSize(),this.transformAll(t.withTransitionForTransform,e)),this.redraw(t,e)},P.redrawWithoutRescale=function(){this.redraw({withY:!1,withSubchart:!1,withEventRect:!1,withTransitionForAxis:!1})},P.isTimeSeries=function(){return"timeseries"===this.config.axis_x_type},P.isCategorized=function(){return this.config.axis_x_type.indexOf("categor")>=0},P.isCustomX=function(){var t=this.config;return!this.isTimeSeries()&&(t.data_x||w(t.data_xs))},P.isTimeSeriesY=function(){return"timeseries"===this.config.axis_y_type},P.getTranslate=function(t){var e,n,i=this.config;return"main"===t?(e=y(this.margin.left),n=y(this.margin.top)):"context"===t?(e=y(this.margin2.left),n=y(this.margin2.top)):"legend"===t?(e=this.margin3.left,n=this.margin3.top):"x"===t?(e=0,n=i.axis_rotated?0:this.height):"y"===t?(e=0,n=i.axis_rotated?this.height:0):"y2"===t?(e=i.axis_rotated?0:this.width,n=i.axis_rotated?1:0):"subx"===t?(e=0,n=i.axis_rotated?0:this.height2):"arc"===t&&(e=this.arcWidth/2,n=this.arcHeight/2),"translate("+e+","+n+")"},P.initialOpacity=function(t){return null!==t.value&&this.withoutFadeIn[t.id]?1:0},P.initialOpacityForCircle=function(t){return null!==t.value&&this.withoutFadeIn[t.id]?this.opacityForCircle(t):0},P.opacityForCircle=function(t){var e=(d(this.config.point_show)?this.config.point_show(t):this.config.point_show)?1:0;return h(t.value)?this.isScatterType(t)?.5:e:0},P.opacityForText=function(){return this.hasDataLabel()?1:0},P.xx=function(t){return t?this.x(t.x):null},P.xv=function(t){var e=t.value;return this.isTimeSeries()?e=this.parseDate(t.value):this.isCategorized()&&"string"==typeof t.value&&(e=this.config.axis_x_categories.indexOf(t.value)),Math.ceil(this.x(e))},P.yv=function(t){var e=t.axis&&"y2"===t.axis?this.y2:this.y;return Math.ceil(e(t.value))},P.subxx=function(t){return t?this.subX(t.x):null},P.transformMain=function(t,e){var n,i,r;e&&e.axisX?n=e.axisX:(n=this.main.select("."+s.axisX),t&&(n=n.transition())),e&&e.axisY?i=e.axisY:(i=this.main.select("."+s.axisY),t&&(i=i.transition())),e&&e.axisY2?r=e.axisY2:(r=this.main.select("."+s.axisY2),t&&(r=r.transition())),(t?this.main.transition():this.main).attr("transform",this.getTranslate("main")),n.attr("transform",this.getTranslate("x")),i.attr("transform",this.getTranslate("y")),r.attr("transform",this.getTranslate("y2")),this.main.select("."+s.chartArcs).attr("transform",this.getTranslate("arc"))},P.transformAll=function(t,e){this.transformMain(t,e),this.config.subchart_show&&this.transformContext(t,e),this.legend&&this.transformLegend(t)},P.updateSvgSize=function(){var t=this.svg.select(".c3-brush .background");this.svg.attr("width",this.currentWidth).attr("height",this.currentHeight),this.svg.selectAll(["#"+this.clipId,"#"+this.clipIdForGrid]).select("rect").attr("width",this.width).attr("height",this.height),this.svg.select("#"+this.clipIdForXAxis).select("rect").attr("x",this.getXAxisClipX.bind(this)).attr("y",this.getXAxisClipY.bind(this)).attr("width",this.getXAxisClipWidth.bind(this)).attr("height",this.getXAxisClipHeight.bind(this)),this.svg.select("#"+this.clipIdForYAxis).select("rect").attr("x",this.getYAxisClipX.bind(this)).attr("y",this.getYAxisClipY.bind(this)).attr("width",this.getYAxisClipWidth.bind(this)).attr("height",this.getYAxisClipHeight.bind(this)),this.svg.select("#"+this.clipIdForSubchart).select("rect").attr("width",this.width).attr("height",t.size()?t.attr("height"):0),this.svg.select("."+s.zoomRect).attr("width",this.width).attr("height",this.height),this.selectChart.style("max-height",this.currentHeight+"px")},P.updateDimension=function(t){t||(this.config.axis_rotated?(this.axes.x.call(this.xAxis),this.axes.subx.call(this.subXAxis)):(this.axes.y.call(this.yAxis),this.axes.y2.call(this.y2Axis))),this.updateSizes(),this.updateScales(),this.updateSvgSize(),this.transformAll(!1)},P.observeInserted=function(t){var e,n=this;"undefined"!=typeof MutationObserver?(e=new MutationObserver(function(i){i.forEach(function(i){"childList"===i.type&&i.previousSibling&&(e.disconnect(),n.intervalForObserveInserted=window.setInterval(function(){t.node().parentNode&&(window.clearInterval(n.intervalForObserveInserted),n.updateDimension(),n.brush&&n.brush.update(),n.config.oninit.call(n),n.redraw({withTransform:!0,withUpdateXDomain:!0,withUpdateOrgXDomain:!0,withTransition:!1,withTransitionForTransform:!1,withLegend:!0}),t.transition().style("opacity",1))},10))})})).observe(t.node(),{attributes:!0,childList:!0,characterData:!0}):window.console.error("MutationObserver not defined.")},P.bindResize=function(){var t=this,e=t.config;if(t.resizeFunction=t.generateResize(),t.resizeFunction.add(function(){e.onresize.call(t)}),e.resize_auto&&t.resizeFunction.add(function(){void 0!==t.resizeTimeout&&window.clearTimeout(t.resizeTimeout),t.resizeTimeout=window.setTimeout(function(){delete t.resizeTimeout,t.api.flush()},100)}),t.resizeFunction.add(function(){e.onresized.call(t)}),window.attachEvent)window.attachEvent("onresize",t.resizeFunction);else if(window.addEventListener)window.addEventListener("resize",t.resizeFunction,!1);else{var n=window.onresize;n?n.add&&n.remove||(n=t.generateResize()).add(window.onresize):n=t.generateResize(),n.add(t.resizeFunction),window.onresize=n}},P.generateResize=function(){function t(){e.forEach(function(t){t()})}var e=[];return t.add=function(t){e.push(t)},t.remove=function(t){for(var n=0;n<e.length;n++)if(e[n]===t){e.splice(n,1);break}},t},P.endall=function(t,e){var n=0;t.each(function(){++n}).each("end",function(){--n||e.apply(this,arguments)})},P.generateWait=function(){var t=[],e=function(e,n){var i=setInterval(function(){var e=0;t.forEach(function(t){if(t.empty())e+=1;else try{t.transition()}catch(t){e+=1}}),e===t.length&&(clearInterval(i),n&&n())},10)};return e.add=function(e){t.push(e)},e},P.parseDate=function(t){var e;return t instanceof Date?e=t:"string"==typeof t?e=this.dataTimeFormat(this.config.data_xFormat).parse(t):"object"===(void 0===t?"undefined":u(t))?e=new Date(+t):"number"!=typeof t||isNaN(t)||(e=new Date(+t)),e&&!isNaN(+e)||window.console.error("Failed to parse x '"+t+"' to Date object"),e},P.isTabVisible=function(){var t;return void 0!==document.hidden?t="hidden":void 0!==document.mozHidden?t="mozHidden":void 0!==document.msHidden?t="msHidden":void 0!==document.webkitHidden&&(t="webkitHidden"),!document[t]},P.isValue=h,P.isFunction=d,P.isString=g,P.isUndefined=p,P.isDefined=m,P.ceil10=v,P.asHalfPixel=y,P.diffDomain=x,P.isEmpty=_,P.notEmpty=w,P.notEmpty=w,P.getOption=b,P.hasValue=S,P.sanitise=T,P.getPathBox=A,P.CLASS=s,Function.prototype.bind||(Function.prototype.bind=function(t){if("function"!=typeof this)throw new TypeError("Function.prototype.bind - what is trying to be bound is not callable");var e=Array.prototype.slice.call(arguments,1),n=this,i=function(){},r=function(){return n.apply(this instanceof i?this:t,e.concat(Array.prototype.slice.call(arguments)))};return i.prototype=this.prototype,r.prototype=new i,r}),"SVGPathSeg"in window||(window.SVGPathSeg=function(t,e,n){this.pathSegType=t,this.pathSegTypeAsLetter=e,this._owningPathSegList=n},window.SVGPathSeg.prototype.classname="SVGPathSeg",window.SVGPathSeg.PATHSEG_UNKNOWN=0,window.SVGPathSeg.PATHSEG_CLOSEPATH=1,window.SVGPathSeg.PATHSEG_MOVETO_ABS=2,window.SVGPathSeg.PATHSEG_MOVETO_REL=3,window.SVGPathSeg.PATHSEG_LINETO_ABS=4,window.SVGPathSeg.PATHSEG_LINETO_REL=5,window.SVGPathSeg.PATHSEG_CURVETO_CUBIC_ABS=6,window.SVGPathSeg.PATHSEG_CURVETO_CUBIC_REL=7,window.SVGPathSeg.PATHSEG_CURVETO_QUADRATIC_ABS=8,window.SVGPathSeg.PATHSEG_CURVETO_QUADRATIC_REL=9,window.SVGPathSeg.PATHSEG_ARC_ABS=10,window.SVGPathSeg.PATHSEG_ARC_REL=11,window.SVGPathSeg.PATHSEG_LINETO_HORIZONTAL_ABS=12,window.SVGPathSeg.PATHSEG_LINETO_HORIZONTAL_REL=13,window.SVGPathSeg.PATHSEG_LINETO_VERTICAL_ABS=14,window.SVGPathSeg.PATHSEG_LINETO_VERTICAL_REL=15,window.SVGPathSeg.PATHSEG_CURVETO_CUBIC_SMOOTH_ABS=16,window.SVGPathSeg.PATHSEG_CURVETO_CUBIC_SMOOTH_REL=17,window.SVGPathSeg.PATHSEG_CURVETO_QUADRATIC_SMOOTH_ABS=18,window.SVGPathSeg.PATHSEG_CURVETO_QUADRATIC_SMOOTH_REL=19,window.SVGPathSeg.prototype._segmentChanged=function(){this._owningPathSegList&&this._owningPathSegList.segmentChanged(this)},window.SVGPathSegClosePath=function(t){window.SVGPathSeg.call(this,window.SVGPathSeg.PATHSEG_CLOSEPATH,"z",t)},window.SVGPathSegClosePath.prototype=Object.create(window.SVGPathSeg.prototype),window.SVGPathSegClosePath.prototype.toString=function(){return"[object SVGPathSegClosePath]"},window.SVGPathSegClosePath.prototype._asPathString=function(){return this.pathSegTypeAsLetter},window.SVGPathSegClosePath.prototype.clone=function(){return new window.SVGPathSegClosePath(void 0)},window.SVGPathSegMovetoAbs=function(t,e,n){window.SVGPathSeg.call(this,window.SVGPathSeg.PATHSEG_MOVETO_ABS,"M",t),this._x=e,this._y=n},window.SVGPathSegMovetoAbs.prototype=Object.create(window.SVGPathSeg.prototype),window.SVGPathSegMovetoAbs.prototype.toString=function(){return"[object SVGPathSegMovetoAbs]"},window.SVGPathSegMovetoAbs.prototype._asPathString=function(){return this.pathSegTypeAsLetter+" "+this._x+" "+this._y},window.SVGPathSegMovetoAbs.prototype.clone=function(){return new window.SVGPathSegMovetoAbs(void 0,this._x,this._y)},Object.defineProperty(window.SVGPathSegMovetoAbs.prototype,"x",{get:function(){return this._x},set:function(t){this._x=t,this._segmentChanged()},enumerable:!0}),Object.defineProperty(window.SVGPathSegMovetoAbs.prototype,"y",{get:function(){return this._y},set:function(t){this._y=t,this._segmentChanged()},enumerable:!0}),window.SVGPathSegMovetoRel=function(t,e,n){window.SVGPathSeg.call(this,window.SVGPathSeg.PATHSEG_MOVETO_REL,"m",t),this._x=e,this._y=n},window.SVGPathSegMovetoRel.prototype=Object.create(window.SVGPathSeg.prototype),window.SVGPathSegMovetoRel.prototype.toString=function(){return"[object SVGPathSegMovetoRel]"},window.SVGPathSegMovetoRel.prototype._asPathString=function(){return this.pathSegTypeAsLetter+" "+this._x+" "+this._y},window.SVGPathSegMovetoRel.prototype.clone=function(){return new window.SVGPathSegMovetoRel(void 0,this._x,this._y)},Object.defineProperty(window.SVGPathSegMovetoRel.prototype,"x",{get:function(){return this._x},set:function(t){this._x=t,this._segmentChanged()},enumerable:!0}),Object.defineProperty(window.SVGPathSegMovetoRel.prototype,"y",{get:function(){return this._y},set:function(t){this._y=t,this._segmentChanged()},enumerable:!0}),window.SVGPathSegLinetoAbs=function(t,e,n){window.SVGPathSeg.call(this,window.SVGPathSeg.PATHSEG_LINETO_ABS,"L",t),this._x=e,this._y=n},window.SVGPathSegLinetoAbs.prototype=Object.create(window.SVGPathSeg.prototype),window.SVGPathSegLinetoAbs.prototype.toString=function(){return"[object SVGPathSegLinetoAbs]"},window.SVGPathSegLinetoAbs.prototype._asPathString=function(){return this.pathSegTypeAsLetter+" "+this._x+" "+this._y},window.SVGPathSegLinetoAbs.prototype.clone=function(){return new window.SVGPathSegLinetoAbs(void 0,this._x,this._y)},Object.defineProperty(window.SVGPathSegLinetoAbs.prototype,"x",{get:function(){return this._x},set:function(t){this._x=t,this._segmentChanged()},enumerable:!0}),Object.defineProperty(window.SVGPathSegLinetoAbs.prototype,"y",{get:function(){return this._y},set:function(t){this._y=t,this._segmentChanged()},enumerable:!0}),window.SVGPathSegLinetoRel=function(t,e,n){window.SVGPathSeg.call(this,window.SVGPathSeg.PATHSEG_LINETO_REL,"l",t),this._x=e,this._y=n},window.SVGPathSegLinetoRel.prototype=Object.create(window.SVGPathSeg.prototype),window.SVGPathSegLinetoRel.prototype.toString=function(){return"[object SVGPathSegLinetoRel]"},window.SVGPathSegLinetoRel.prototype._asPathString=function(){return this.pathSegTypeAsLetter+" "+this._x+" "+this._y},window.SVGPathSegLinetoRel.prototype.clone=function(){return new window.SVGPathSegLinetoRel(void 0,this._x,this._y)},Object.defineProperty(window.SVGPathSegLinetoRel.prototype,"x",{get:function(){return this._x},set:function(t){this._x=t,this._segmentChanged()},enumerable:!0}),Object.defineProperty(window.SVGPathSegLinetoRel.prototype,"y",{get:function(){return this._y},set:function(t){this._y=t,this._segmentChanged()},enumerable:!0}),window.SVGPathSegCurvetoCubicAbs=function(t,e,n,i,r,a,o){window.SVGPathSeg.call(this,window.SVGPathSeg.PATHSEG_CURVETO_CUBIC_ABS,"C",t),this._x=e,this._y=n,this._x1=i,this._y1=r,this._x2=a,this._y2=o},window.SVGPathSegCurvetoCubicAbs.prototype=Object.create(window.SVGPathSeg.prototype),window.SVGPathSegCurvetoCubicAbs.prototype.toString=function(){return"[object SVGPathSegCurvetoCubicAbs]"},window.SVGPathSegCurvetoCubicAbs.prototype._asPathString=function(){return this.pathSegTypeAsLetter+" "+this._x1+" "+this._y1+" "+this._x2+" "+this._y2+" "+this._x+" "+this._y},window.SVGPathSegCurvetoCubicAbs.prototype.clone=function(){return new window.SVGPathSegCurvetoCubicAbs(void 0,this._x,this._y,this._x1,this._y1,this._x2,this._y2)},Object.defineProperty(window.SVGPathSegCurvetoCubicAbs.prototype,"x",{get:function(){return this._x},set:function(t){this._x=t,this._segmentChanged()},enumerable:!0}),Object.defineProperty(window.SVGPathSegCurvetoCubicAbs.prototype,"y",{get:function(){return this._y},set:function(t){this._y=t,this._segmentChanged()},enumerable:!0}),Object.defineProperty(window.SVGPathSegCurvetoCubicAbs.prototype,"x1",{get:function(){return this._x1},set:function(t){this._x1=t,this._segmentChanged()},enumerable:!0}),Object.defineProperty(window.SVGPathSegCurvetoCubicAbs.prototype,"y1",{get:function(){return this._y1},set:function(t){this._y1=t,this._segmentChanged()},enumerable:!0}),Object.defineProperty(window.SVGPathSegCurvetoCubicAbs.prototype,"x2",{get:function(){return this._x2},set:function(t){this._x2=t,this._segmentChanged()},enumerable:!0}),Object.defineProperty(window.SVGPathSegCurvetoCubicAbs.prototype,"y2",{get:function(){return this._y2},set:function(t){this._y2=t,this._segmentChanged()},enumerable:!0}),window.SVGPathSegCurvetoCubicRel=function(t,e,n,i,r,a,o){window.SVGPathSeg.call(this,window.SVGPathSeg.PATHSEG_CURVETO_CUBIC_REL,"c",t),this._x=e,this._y=n,this._x1=i,this._y1=r,this._x2=a,this._y2=o},window.SVGPathSegCurvetoCubicRel.prototype=Object.create(window.SVGPathSeg.prototype),window.SVGPathSegCurvetoCubicRel.prototype.toString=function(){return"[object SVGPathSegCurvetoCubicRel]"},window.SVGPathSegCurvetoCubicRel.prototype._asPathString=function(){return this.pathSegTypeAsLetter+" "+this._x1+" "+this._y1+" "+this._x2+" "+this._y2+" "+this._x+" "+this._y},window.SVGPathSegCurvetoCubicRel.prototype.clone=function(){return new window.SVGPathSegCurvetoCubicRel(void 0,this._x,this._y,this._x1,this._y1,this._x2,this._y2)},Object.defineProperty(window.SVGPathSegCurvetoCubicRel.prototype,"x",{get:function(){return this._x},set:function(t){this._x=t,this._segmentChanged()},enumerable:!0}),Object.defineProperty(window.SVGPathSegCurvetoCubicRel.prototype,"y",{get:function(){return this._y},set:function(t){this._y=t,this._segmentChanged()},enumerable:!0}),Object.defineProperty(window.SVGPathSegCurvetoCubicRel.prototype,"x1",{get:function(){return this._x1},set:function(t){this._x1=t,this._segmentChanged()},enumerable:!0}),Object.defineProperty(window.SVGPathSegCurvetoCubicRel.prototype,"y1",{get:function(){return this._y1},set:function(t){this._y1=t,this._segmentChanged()},enumerable:!0}),Object.defineProperty(window.SVGPathSegCurvetoCubicRel.prototype,"x2",{get:function(){return this._x2},set:function(t){this._x2=t,this._segmentChanged()},enumerable:!0}),Object.defineProperty(window.SVGPathSegCurvetoCubicRel.prototype,"y2",{get:function(){return this._y2},set:function(t){this._y2=t,this._segmentChanged()},enumerable:!0}),window.SVGPathSegCurvetoQuadraticAbs=function(t,e,n,i,r){window.SVGPathSeg.call(this,window.SVGPathSeg.PATHSEG_CURVETO_QUADRATIC_ABS,"Q",t),this._x=e,this._y=n,this._x1=i,this._y1=r},window.SVGPathSegCurvetoQuadraticAbs.prototype=Object.create(window.SVGPathSeg.prototype),window.SVGPathSegCurvetoQuadraticAbs.prototype.toString=function(){return"[object SVGPathSegCurvetoQuadraticAbs]"},window.SVGPathSegCurvetoQuadraticAbs.prototype._asPathString=function(){return this.pathSegTypeAsLetter+" "+this._x1+" "+this._y1+" "+this._x+" "+this._y},window.SVGPathSegCurvetoQuadraticAbs.prototype.clone=function(){return new window.SVGPathSegCurvetoQuadraticAbs(void 0,this._x,this._y,this._x1,this._y1)},Object.defineProperty(window.SVGPathSegCurvetoQuadraticAbs.prototype,"x",{get:function(){return this._x},set:function(t){this._x=t,this._segmentChanged()},enumerable:!0}),Object.defineProperty(window.SVGPathSegCurvetoQuadraticAbs.prototype,"y",{get:function(){return this._y},set:function(t){this._y=t,this._segmentChanged()},enumerable:!0}),Object.defineProperty(window.SVGPathSegCurvetoQuadraticAbs.prototype,"x1",{get:function(){return this._x1},set:function(t){this._x1=t,this._segmentChanged()},enumerable:!0}),Object.defineProperty(window.SVGPathSegCurvetoQuadraticAbs.prototype,"y1",{get:function(){return this._y1},set:function(t){this._y1=t,this._segmentChanged()},enumerable:!0}),window.SVGPathSegCurvetoQuadraticRel=function(t,e,n,i,r){window.SVGPathSeg.call(this,window.SVGPathSeg.PATHSEG_CURVETO_QUADRATIC_REL,"q",t),this._x=e,this._y=n,this._x1=i,this._y1=r},window.SVGPathSegCurvetoQuadraticRel.prototype=Object.create(window.SVGPathSeg.prototype),window.SVGPathSegCurvetoQuadraticRel.prototype.toString=function(){return"[object SVGPathSegCurvetoQuadraticRel]"},window.SVGPathSegCurvetoQuadraticRel.prototype._asPathString=function(){return this.pathSegTypeAsLetter+" "+this._x1+" "+this._y1+" "+this._x+" "+this._y},window.SVGPathSegCurvetoQuadraticRel.prototype.clone=function(){return new window.SVGPathSegCurvetoQuadraticRel(void 0,this._x,this._y,this._x1,this._y1)},Object.defineProperty(window.SVGPathSegCurvetoQuadraticRel.prototype,"x",{get:function(){return this._x},set:function(t){this._x=t,this._segmentChanged()},enumerable:!0}),Object.defineProperty(window.SVGPathSegCurvetoQuadraticRel.prototype,"y",{get:function(){return this._y},set:function(t){this._y=t,this._segmentChanged()},enumerable:!0}),Object.defineProperty(window.SVGPathSegCurvetoQuadraticRel.prototype,"x1",{get:function(){return this._x1},set:function(t){this._x1=t,this._segmentChanged()},enumerable:!0}),Object.defineProperty(window.SVGPathSegCurvetoQuadraticRel.prototype,"y1",{get:function(){return this._y1},set:function(t){this._y1=t,this._segmentChanged()},enumerable:!0}),window.SVGPathSegArcAbs=function(t,e,n,i,r,a,o,s){window.SVGPathSeg.call(this,window.SVGPathSeg.PATHSEG_ARC_ABS,"A",t),this._x=e,this._y=n,this._r1=i,this._r2=r,this._angle=a,this._largeArcFlag=o,this._sweepFlag=s},window.SVGPathSegArcAbs.prototype=Object.create(window.SVGPathSeg.prototype),window.SVGPathSegArcAbs.prototype.toString=function(){return"[object SVGPathSegArcAbs]"},window.SVGPathSegArcAbs.prototype._asPathString=function(){return this.pathSegTypeAsLetter+" "+this._r1+" "+this._r2+" "+this._angle+" "+(this._largeArcFlag?"1":"0")+" "+(this._sweepFlag?"1":"0")+" "+this._x+" "+this._y},window.SVGPathSegArcAbs.prototype.clone=function(){return new window.SVGPathSegArcAbs(void 0,this._x,this._y,this._r1,this._r2,this._angle,this._largeArcFlag,this._sweepFlag)},Object.defineProperty(window.SVGPathSegArcAbs.prototype,"x",{get:function(){return this._x},set:function(t){this._x=t,this._segmentChanged()},enumerable:!0}),Object.defineProperty(window.SVGPathSegArcAbs.prototype,"y",{get:function(){return this._y},set:function(t){this._y=t,this._segmentChanged()},enumerable:!0}),Object.defineProperty(window.SVGPathSegArcAbs.prototype,"r1",{get:function(){return this._r1},set:function(t){this._r1=t,this._segmentChanged()},enumerable:!0}),Object.defineProperty(window.SVGPathSegArcAbs.prototype,"r2",{get:function(){return this._r2},set:function(t){this._r2=t,this._segmentChanged()},enumerable:!0}),Object.defineProperty(window.SVGPathSegArcAbs.prototype,"angle",{get:function(){return this._angle},set:function(t){this._angle=t,this._segmentChanged()},enumerable:!0}),Object.defineProperty(window.SVGPathSegArcAbs.prototype,"largeArcFlag",{get:function(){return this._largeArcFlag},set:function(t){this._largeArcFlag=t,this._segmentChanged()},enumerable:!0}),Object.defineProperty(window.SVGPathSegArcAbs.prototype,"sweepFlag",{get:function(){return this._sweepFlag},set:function(t){this._sweepFlag=t,this._segmentChanged()},enumerable:!0}),window.SVGPathSegArcRel=function(t,e,n,i,r,a,o,s){window.SVGPathSeg.call(this,window.SVGPathSeg.PATHSEG_ARC_REL,"a",t),this._x=e,this._y=n,this._r1=i,this._r2=r,this._angle=a,this._largeArcFlag=o,this._sweepFlag=s},window.SVGPathSegArcRel.prototype=Object.create(window.SVGPathSeg.prototype),window.SVGPathSegArcRel.prototype.toString=function(){return"[object SVGPathSegArcRel]"},window.SVGPathSegArcRel.prototype._asPathString=function(){return this.pathSegTypeAsLetter+" "+this._r1+" "+this._r2+" "+this._angle+" "+(this._largeArcFlag?"1":"0")+" "+(this._sweepFlag?"1":"0")+" "+this._x+" "+this._y},window.SVGPathSegArcRel.prototype.clone=function(){return new window.SVGPathSegArcRel(void 0,this._x,this._y,this._r1,this._r2,this._angle,this._largeArcFlag,this._sweepFlag)},Object.defineProperty(window.SVGPathSegArcRel.prototype,"x",{get:function(){return this._x},set:function(t){this._x=t,this._segmentChanged()},enumerable:!0}),Object.defineProperty(window.SVGPathSegArcRel.prototype,"y",{get:function(){return this._y},set:function(t){this._y=t,this._segmentChanged()},enumerable:!0}),Object.defineProperty(window.SVGPathSegArcRel.prototype,"r1",{get:function(){return this._r1},set:function(t){this._r1=t,this._segmentChanged()},enumerable:!0}),Object.defineProperty(window.SVGPathSegArcRel.prototype,"r2",{get:function(){return this._r2},set:function(t){this._r2=t,this._segmentChanged()},enumerable:!0}),Object.defineProperty(window.SVGPathSegArcRel.prototype,"angle",{get:function(){return this._angle},set:function(t){this._angle=t,this._segmentChanged()},enumerable:!0}),Object.defineProperty(window.SVGPathSegArcRel.prototype,"largeArcFlag",{get:function(){return this._largeArcFlag},set:function(t){this._largeArcFlag=t,this._segmentChanged()},enumerable:!0}),Object.defineProperty(window.SVGPathSegArcRel.prototype,"sweepFlag",{get:function(){return this._sweepFlag},set:function(t){this._sweepFlag=t,this._segmentChanged()},enumerable:!0}),window.SVGPathSegLinetoHorizontalAbs=function(t,e){window.SVGPathSeg.call(this,window.SVGPathSeg.PATHSEG_LINETO_HORIZONTAL_ABS,"H",t),this._x=e},window.SVGPathSegLinetoHorizontalAbs.prototype=Object.create(window.SVGPathSeg.prototype),window.SVGPathSegLinetoHorizontalAbs.prototype.toString=function(){return"[object SVGPathSegLinetoHorizontalAbs]"},window.SVGPathSegLinetoHorizontalAbs.prototype._asPathString=function(){return this.pathSegTypeAsLetter+" "+this._x},window.SVGPathSegLinetoHorizontalAbs.prototype.clone=function(){return new window.SVGPathSegLinetoHorizontalAbs(void 0,this._x)},Object.defineProperty(window.SVGPathSegLinetoHorizontalAbs.prototype,"x",{get:function(){return this._x},set:function(t){this._x=t,this._segmentChanged()},enumerable:!0}),window.SVGPathSegLinetoHorizontalRel=function(t,e){window.SVGPathSeg.call(this,window.SVGPathSeg.PATHSEG_LINETO_HORIZONTAL_REL,"h",t),this._x=e},window.SVGPathSegLinetoHorizontalRel.prototype=Object.create(window.SVGPathSeg.prototype),window.SVGPathSegLinetoHorizontalRel.prototype.toString=function(){return"[object SVGPathSegLinetoHorizontalRel]"},window.SVGPathSegLinetoHorizontalRel.prototype._asPathString=function(){return this.pathSegTypeAsLetter+" "+this._x},window.SVGPathSegLinetoHorizontalRel.prototype.clone=function(){return new window.SVGPathSegLinetoHorizontalRel(void 0,this._x)},Object.defineProperty(window.SVGPathSegLinetoHorizontalRel.prototype,"x",{get:function(){return this._x},set:function(t){this._x=t,this._segmentChanged()},enumerable:!0}),window.SVGPathSegLinetoVerticalAbs=function(t,e){window.SVGPathSeg.call(this,window.SVGPathSeg.PATHSEG_LINETO_VERTICAL_ABS,"V",t),this._y=e},window.SVGPathSegLinetoVerticalAbs.prototype=Object.create(window.SVGPathSeg.prototype),window.SVGPathSegLinetoVerticalAbs.prototype.toString=function(){return"[object SVGPathSegLinetoVerticalAbs]"},window.SVGPathSegLinetoVerticalAbs.prototype._asPathString=function(){return this.pathSegTypeAsLetter+" "+this._y},window.SVGPathSegLinetoVerticalAbs.prototype.clone=function(){return new window.SVGPathSegLinetoVerticalAbs(void 0,this._y)},Object.defineProperty(window.SVGPathSegLinetoVerticalAbs.prototype,"y",{get:function(){return this._y},set:function(t){this._y=t,this._segmentChanged()},enumerable:!0}),window.SVGPathSegLinetoVerticalRel=function(t,e){window.SVGPathSeg.call(this,window.SVGPathSeg.PATHSEG_LINETO_VERTICAL_REL,"v",t),this._y=e},window.SVGPathSegLinetoVerticalRel.prototype=Object.create(window.SVGPathSeg.prototype),window.SVGPathSegLinetoVerticalRel.prototype.toString=function(){return"[object SVGPathSegLinetoVerticalRel]"},window.SVGPathSegLinetoVerticalRel.prototype._asPathString=function(){return this.pathSegTypeAsLetter+" "+this._y},window.SVGPathSegLinetoVerticalRel.prototype.clone=function(){return new window.SVGPathSegLinetoVerticalRel(void 0,this._y)},Object.defineProperty(window.SVGPathSegLinetoVerticalRel.prototype,"y",{get:function(){return this._y},set:function(t){this._y=t,this._segmentChanged()},enumerable:!0}),window.SVGPathSegCurvetoCubicSmoothAbs=function(t,e,n,i,r){window.SVGPathSeg.call(this,window.SVGPathSeg.PATHSEG_CURVETO_CUBIC_SMOOTH_ABS,"S",t),this._x=e,this._y=n,this._x2=i,this._y2=r},window.SVGPathSegCurvetoCubicSmoothAbs.prototype=Object.create(window.SVGPathSeg.prototype),window.SVGPathSegCurvetoCubicSmoothAbs.prototype.toString=function(){return"[object SVGPathSegCurvetoCubicSmoothAbs]"},window.SVGPathSegCurvetoCubicSmoothAbs.prototype._asPathString=function(){return this.pathSegTypeAsLetter+" "+this._x2+" "+this._y2+" "+this._x+" "+this._y},window.SVGPathSegCurvetoCubicSmoothAbs.prototype.clone=function(){return new window.SVGPathSegCurvetoCubicSmoothAbs(void 0,this._x,this._y,this._x2,this._y2)},Object.defineProperty(window.SVGPathSegCurvetoCubicSmoothAbs.prototype,"x",{get:function(){return this._x},set:function(t){this._x=t,this._segmentChanged()},enumerable:!0}),Object.defineProperty(window.SVGPathSegCurvetoCubicSmoothAbs.prototype,"y",{get:function(){return this._y},set:function(t){this._y=t,this._segmentChanged()},enumerable:!0}),Object.defineProperty(window.SVGPathSegCurvetoCubicSmoothAbs.prototype,"x2",{get:function(){return this._x2},set:function(t){this._x2=t,this._segmentChanged()},enumerable:!0}),Object.defineProperty(window.SVGPathSegCurvetoCubicSmoothAbs.prototype,"y2",{get:function(){return this._y2},set:function(t){this._y2=t,this._segmentChanged()},enumerable:!0}),window.SVGPathSegCurvetoCubicSmoothRel=function(t,e,n,i,r){window.SVGPathSeg.call(this,window.SVGPathSeg.PATHSEG_CURVETO_CUBIC_SMOOTH_REL,"s",t),this._x=e,this._y=n,this._x2=i,this._y2=r},window.SVGPathSegCurvetoCubicSmoothRel.prototype=Object.create(window.SVGPathSeg.prototype),window.SVGPathSegCurvetoCubicSmoothRel.prototype.toString=function(){return"[object SVGPathSegCurvetoCubicSmoothRel]"},window.SVGPathSegCurvetoCubicSmoothRel.prototype._asPathString=function(){return this.pathSegTypeAsLetter+" "+this._x2+" "+this._y2+" "+this._x+" "+this._y},window.SVGPathSegCurvetoCubicSmoothRel.prototype.clone=function(){return new window.SVGPathSegCurvetoCubicSmoothRel(void 0,this._x,this._y,this._x2,this._y2)},Object.defineProperty(window.SVGPathSegCurvetoCubicSmoothRel.prototype,"x",{get:function(){return this._x},set:function(t){this._x=t,this._segmentChanged()},enumerable:!0}),Object.defineProperty(window.SVGPathSegCurvetoCubicSmoothRel.prototype,"y",{get:function(){return this._y},set:function(t){this._y=t,this._segmentChanged()},enumerable:!0}),Object.defineProperty(window.SVGPathSegCurvetoCubicSmoothRel.prototype,"x2",{get:function(){return this._x2},set:function(t){this._x2=t,this._segmentChanged()},enumerable:!0}),Object.defineProperty(window.SVGPathSegCurvetoCubicSmoothRel.prototype,"y2",{get:function(){return this._y2},set:function(t){this._y2=t,this._segmentChanged()},enumerable:!0}),window.SVGPathSegCurvetoQuadraticSmoothAbs=function(t,e,n){window.SVGPathSeg.call(this,window.SVGPathSeg.PATHSEG_CURVETO_QUADRATIC_SMOOTH_ABS,"T",t),this._x=e,this._y=n},window.SVGPathSegCurvetoQuadraticSmoothAbs.prototype=Object.create(window.SVGPathSeg.prototype),window.SVGPathSegCurvetoQuadraticSmoothAbs.prototype.toString=function(){return"[object SVGPathSegCurvetoQuadraticSmoothAbs]"},window.SVGPathSegCurvetoQuadraticSmoothAbs.prototype._asPathString=function(){return this.pathSegTypeAsLetter+" "+this._x+" "+this._y},window.SVGPathSegCurvetoQuadraticSmoothAbs.prototype.clone=function(){return new window.SVGPathSegCurvetoQuadraticSmoothAbs(void 0,this._x,this._y)},Object.defineProperty(window.SVGPathSegCurvetoQuadraticSmoothAbs.prototype,"x",{get:function(){return this._x},set:function(t){this._x=t,this._segmentChanged()},enumerable:!0}),Object.defineProperty(window.SVGPathSegCurvetoQuadraticSmoothAbs.prototype,"y",{get:function(){return this._y},set:function(t){this._y=t,this._segmentChanged()},enumerable:!0}),window.SVGPathSegCurvetoQuadraticSmoothRel=function(t,e,n){window.SVGPathSeg.call(this,window.SVGPathSeg.PATHSEG_CURVETO_QUADRATIC_SMOOTH_REL,"t",t),this._x=e,this._y=n},window.SVGPathSegCurvetoQuadraticSmoothRel.prototype=Object.create(window.SVGPathSeg.prototype),window.SVGPathSegCurvetoQuadraticSmoothRel.prototype.toString=function(){return"[object SVGPathSegCurvetoQuadraticSmoothRel]"},window.SVGPathSegCurvetoQuadraticSmoothRel.prototype._asPathString=function(){return this.pathSegTypeAsLetter+" "+this._x+" "+this._y},window.SVGPathSegCurvetoQuadraticSmoothRel.prototype.clone=function(){return new window.SVGPathSegCurvetoQuadraticSmoothRel(void 0,this._x,this._y)},Object.defineProperty(window.SVGPathSegCurvetoQuadraticSmoothRel.prototype,"x",{get:function(){return this._x},set:function(t){this._x=t,this._segmentChanged()},enumerable:!0}),Object.defineProperty(window.SVGPathSegCurvetoQuadraticSmoothRel.prototype,"y",{get:function(){return this._y},set:function(t){this._y=t,this._segmentChanged()},enumerable:!0}),window.SVGPathElement.prototype.createSVGPathSegClosePath=function(){return new window.SVGPathSegClosePath(void 0)},window.SVGPathElement.prototype.createSVGPathSegMovetoAbs=function(t,e){return new window.SVGPathSegMovetoAbs(void 0,t,e)},window.SVGPathElement.prototype.createSVGPathSegMovetoRel=function(t,e){return new window.SVGPathSegMovetoRel(void 0,t,e)},window.SVGPathElement.prototype.createSVGPathSegLinetoAbs=function(t,e){return new window.SVGPathSegLinetoAbs(void 0,t,e)},window.SVGPathElement.prototype.createSVGPathSegLinetoRel=function(t,e){return new window.SVGPathSegLinetoRel(void 0,t,e)},window.SVGPathElement.prototype.createSVGPathSegCurvetoCubicAbs=function(t,e,n,i,r,a){return new window.SVGPathSegCurvetoCubicAbs(void 0,t,e,n,i,r,a)},window.SVGPathElement.prototype.createSVGPathSegCurvetoCubicRel=function(t,e,n,i,r,a){return new window.SVGPathSegCurvetoCubicRel(void 0,t,e,n,i,r,a)},window.SVGPathElement.prototype.createSVGPathSegCurvetoQuadraticAbs=function(t,e,n,i){return new window.SVGPathSegCurvetoQuadraticAbs(void 0,t,e,n,i)},window.SVGPathElement.prototype.createSVGPathSegCurvetoQuadraticRel=function(t,e,n,i){return new window.SVGPathSegCurvetoQuadraticRel(void 0,t,e,n,i)},window.SVGPathElement.prototype.createSVGPathSegArcAbs=function(t,e,n,i,r,a,o){return new window.SVGPathSegArcAbs(void 0,t,e,n,i,r,a,o)},window.SVGPathElement.prototype.createSVGPathSegArcRel=function(t,e,n,i,r,a,o){return new window.SVGPathSegArcRel(void 0,t,e,n,i,r,a,o)},window.SVGPathElement.prototype.createSVGPathSegLinetoHorizontalAbs=function(t){return new window.SVGPathSegLinetoHorizontalAbs(void 0,t)},window.SVGPathElement.prototype.createSVGPathSegLinetoHorizontalRel=function(t){return new window.SVGPathSegLinetoHorizontalRel(void 0,t)},window.SVGPathElement.prototype.createSVGPathSegLinetoVerticalAbs=function(t){return new window.SVGPathSegLinetoVerticalAbs(void 0,t)},window.SVGPathElement.prototype.createSVGPathSegLinetoVerticalRel=function(t){return new window.SVGPathSegLinetoVerticalRel(void 0,t)},window.SVGPathElement.prototype.createSVGPathSegCurvetoCubicSmoothAbs=function(t,e,n,i){return new window.SVGPathSegCurvetoCubicSmoothAbs(void 0,t,e,n,i)},window.SVGPathElement.prototype.createSVGPathSegCurvetoCubicSmoothRel=function(t,e,n,i){return new window.SVGPathSegCurvetoCubicSmoothRel(void 0,t,e,n,i)},window.SVGPathElement.prototype.createSVGPathSegCurvetoQuadraticSmoothAbs=function(t,e){return new window.SVGPathSegCurvetoQuadraticSmoothAbs(void 0,t,e)},window.SVGPathElement.prototype.createSVGPathSegCurvetoQuadraticSmoothRel=function(t,e){return new window.SVGPathSegCurvetoQuadraticSmoothRel(void 0,t,e)},"getPathSegAtLength"in window.SVGPathElement.prototype||(window.SVGPathElement.prototype.getPathSegAtLength=function(t){if(void 0===t||!isFinite(t))throw"Invalid arguments.";var e=document.createElementNS("http://www.w3.org/2000/svg","path");e.setAttribute("d",this.getAttribute("d"));var n=e.pathSegList.numberOfItems-1;if(n<=0)return 0;do{if(e.pathSegList.removeItem(n),t>e.getTotalLength())break;n--}while(n>0);return n})),"SVGPathSegList"in window||(window.SVGPathSegList=function(t){this._pathElement=t,this._list=this._parsePath(this._pathElement.getAttribute("d")),this._mutationObserverConfig={attributes:!0,attributeFilter:["d"]},this._pathElementMutationObserver=new MutationObserver(this._updateListFromPathMutations.bind(this)),this._pathElementMutationObserver.observe(this._pathElement,this._mutationObserverConfig)},window.SVGPathSegList.prototype.classname="SVGPathSegList",Object.defineProperty(window.SVGPathSegList.prototype,"numberOfItems",{get:function(){return this._checkPathSynchronizedToList(),this._list.length},enumerable:!0}),Object.defineProperty(window.SVGPathElement.prototype,"pathSegList",{get:function(){return this._pathSegList||(this._pathSegList=new window.SVGPathSegList(this)),this._pathSegList},enumerable:!0}),Object.defineProperty(window.SVGPathElement.prototype,"normalizedPathSegList",{get:function(){return this.pathSegList},enumerable:!0}),Object.defineProperty(window.SVGPathElement.prototype,"animatedPathSegList",{get:function(){return this.pathSegList},enumerable:!0}),Object.defineProperty(window.SVGPathElement.prototype,"animatedNormalizedPathSegList",{get:function(){return this.pathSegList},enumerable:!0}),window.SVGPathSegList.prototype._checkPathSynchronizedToList=function(){this._updateListFromPathMutations(this._pathElementMutationObserver.takeRecords())},window.SVGPathSegList.prototype._updateListFromPathMutations=function(t){if(this._pathElement){var e=!1;t.forEach(function(t){"d"==t.attributeName&&(e=!0)}),e&&(this._list=this._parsePath(this._pathElement.getAttribute("d")))}},window.SVGPathSegList.prototype._writeListToPath=function(){this._pathElementMutationObserver.disconnect(),this._pathElement.setAttribute("d",window.SVGPathSegList._pathSegArrayAsString(this._list)),this._pathElementMutationObserver.observe(this._pathElement,this._mutationObserverConfig)},window.SVGPathSegList.prototype.segmentChanged=function(t){this._writeListToPath()},window.SVGPathSegList.prototype.clear=function(){this._checkPathSynchronizedToList(),this._list.forEach(function(t){t._owningPathSegList=null}),this._list=[],this._writeListToPath()},window.SVGPathSegList.prototype.initialize=function(t){return this._checkPathSynchronizedToList(),this._list=[t],t._owningPathSegList=this,this._writeListToPath(),t},window.SVGPathSegList.prototype._checkValidIndex=function(t){if(isNaN(t)||t<0||t>=this.numberOfItems)throw"INDEX_SIZE_ERR"},window.SVGPathSegList.prototype.getItem=function(t){return this._checkPathSynchronizedToList(),this._checkValidIndex(t),this._list[t]},window.SVGPathSegList.prototype.insertItemBefore=function(t,e){return this._checkPathSynchronizedToList(),e>this.numberOfItems&&(e=this.numberOfItems),t._owningPathSegList&&(t=t.clone()),this._list.splice(e,0,t),t._owningPathSegList=this,this._writeListToPath(),t},window.SVGPathSegList.prototype.replaceItem=function(t,e){return this._checkPathSynchronizedToList(),t._owningPathSegList&&(t=t.clone()),this._checkValidIndex(e),this._list[e]=t,t._owningPathSegList=this,this._writeListToPath(),t},window.SVGPathSegList.prototype.removeItem=function(t){this._checkPathSynchronizedToList(),this._checkValidIndex(t);var e=this._list[t];return this._list.splice(t,1),this._writeListToPath(),e},window.SVGPathSegList.prototype.appendItem=function(t){return this._checkPathSynchronizedToList(),t._owningPathSegList&&(t=t.clone()),this._list.push(t),t._owningPathSegList=this,this._writeListToPath(),t},window.SVGPathSegList._pathSegArrayAsString=function(t){var e="",n=!0;return t.forEach(function(t){n?(n=!1,e+=t._asPathString()):e+=" "+t._asPathString()}),e},window.SVGPathSegList.prototype._parsePath=function(t){if(!t||0==t.length)return[];var e=this,n=function(){this.pathSegList=[]};n.prototype.appendSegment=function(t){this.pathSegList.push(t)};var i=function(t){this._string=t,this._currentIndex=0,this._endIndex=this._string.length,this._previousCommand=window.SVGPathSeg.PATHSEG_UNKNOWN,this._skipOptionalSpaces()};i.prototype._isCurrentSpace=function(){var t=this._string[this._currentIndex];return t<=" "&&(" "==t||"\n"==t||"\t"==t||"\r"==t||"\f"==t)},i.prototype._skipOptionalSpaces=function(){for(;this._currentIndex<this._endIndex&&this._isCurrentSpace();)this._currentIndex++;return this._currentIndex<this._endIndex},i.prototype._skipOptionalSpacesOrDelimiter=function(){return!(this._currentIndex<this._endIndex&&!this._isCurrentSpace()&&","!=this._string.charAt(this._currentIndex))&&(this._skipOptionalSpaces()&&this._currentIndex<this._endIndex&&","==this._string.charAt(this._currentIndex)&&(this._currentIndex++,this._skipOptionalSpaces()),this._currentIndex<this._endIndex)},i.prototype.hasMoreData=function(){return this._currentIndex<this._endIndex},i.prototype.peekSegmentType=function(){var t=this._string[this._currentIndex];return this._pathSegTypeFromChar(t)},i.prototype._pathSegTypeFromChar=function(t){switch(t){case"Z":case"z":return window.SVGPathSeg.PATHSEG_CLOSEPATH;case"M":return window.SVGPathSeg.PATHSEG_MOVETO_ABS;case"m":return window.SVGPathSeg.PATHSEG_MOVETO_REL;case"L":return window.SVGPathSeg.PATHSEG_LINETO_ABS;case"l":return window.SVGPathSeg.PATHSEG_LINETO_REL;case"C":return window.SVGPathSeg.PATHSEG_CURVETO_CUBIC_ABS;case"c":return window.SVGPathSeg.PATHSEG_CURVETO_CUBIC_REL;case"Q":return window.SVGPathSeg.PATHSEG_CURVETO_QUADRATIC_ABS;case"q":return window.SVGPathSeg.PATHSEG_CURVETO_QUADRATIC_REL;case"A":return window.SVGPathSeg.PATHSEG_ARC_ABS;case"a":return window.SVGPathSeg.PATHSEG_ARC_REL;case"H":return window.SVGPathSeg.PATHSEG_LINETO_HORIZONTAL_ABS;case"h":return window.SVGPathSeg.PATHSEG_LINETO_HORIZONTAL_REL;case"V":return window.SVGPathSeg.PATHSEG_LINETO_VERTICAL_ABS;case"v":return window.SVGPathSeg.PATHSEG_LINETO_VERTICAL_REL;case"S":return window.SVGPathSeg.PATHSEG_CURVETO_CUBIC_SMOOTH_ABS;case"s":return window.SVGPathSeg.PATHSEG_CURVETO_CUBIC_SMOOTH_REL;case"T":return window.SVGPathSeg.PATHSEG_CURVETO_QUADRATIC_SMOOTH_ABS;case"t":return window.SVGPathSeg.PATHSEG_CURVETO_QUADRATIC_SMOOTH_REL;default:return window.SVGPathSeg.PATHSEG_UNKNOWN}},i.prototype._nextCommandHelper=function(t,e){return("+"==t||"-"==t||"."==t||t>="0"&&t<="9")&&e!=window.SVGPathSeg.PATHSEG_CLOSEPATH?e==window.SVGPathSeg.PATHSEG_MOVETO_ABS?window.SVGPathSeg.PATHSEG_LINETO_ABS:e==window.SVGPathSeg.PATHSEG_MOVETO_REL?window.SVGPathSeg.PATHSEG_LINETO_REL:e:window.SVGPathSeg.PATHSEG_UNKNOWN},i.prototype.initialCommandIsMoveTo=function(){if(!this.hasMoreData())return!0;var t=this.peekSegmentType();return t==window.SVGPathSeg.PATHSEG_MOVETO_ABS||t==window.SVGPathSeg.PATHSEG_MOVETO_REL},i.prototype._parseNumber=function(){var t=0,e=0,n=1,i=0,r=1,a=1,o=this._currentIndex;if(this._skipOptionalSpaces(),this._currentIndex<this._endIndex&&"+"==this._string.charAt(this._currentIndex)?this._currentIndex++:this._currentIndex<this._endIndex&&"-"==this._string.charAt(this._currentIndex)&&(this._currentIndex++,r=-1),!(this._currentIndex==this._endIndex||(this._string.charAt(this._currentIndex)<"0"||this._string.charAt(this._currentIndex)>"9")&&"."!=this._string.charAt(this._currentIndex))){for(var s=this._currentIndex;this._currentIndex<this._endIndex&&this._string.charAt(this._currentIndex)>="0"&&this._string.charAt(this._currentIndex)<="9";)this._currentIndex++;if(this._currentIndex!=s)for(var u=this._currentIndex-1,c=1;u>=s;)e+=c*(this._string.charAt(u--)-"0"),c*=10;if(this._currentIndex<this._endIndex&&"."==this._string.charAt(this._currentIndex)){if(this._currentIndex++,this._currentIndex>=this._endIndex||this._string.charAt(this._currentIndex)<"0"||this._string.charAt(this._currentIndex)>"9")return;for(;this._currentIndex<this._endIndex&&this._string.charAt(this._currentIndex)>="0"&&this._string.charAt(this._currentIndex)<="9";)n*=10,i+=(this._string.charAt(this._currentIndex)-"0")/n,this._currentIndex+=1}if(this._currentIndex!=o&&this._currentIndex+1<this._endIndex&&("e"==this._string.charAt(this._currentIndex)||"E"==this._string.charAt(this._currentIndex))&&"x"!=this._string.charAt(this._currentIndex+1)&&"m"!=this._string.charAt(this._currentIndex+1)){if(this._currentIndex++,"+"==this._string.charAt(this._currentIndex)?this._currentIndex++:"-"==this._string.charAt(this._currentIndex)&&(this._currentIndex++,a=-1),this._currentIndex>=this._endIndex||this._string.charAt(this._currentIndex)<"0"||this._string.charAt(this._currentIndex)>"9")return;for(;this._currentIndex<this._endIndex&&this._string.charAt(this._currentIndex)>="0"&&this._string.charAt(this._currentIndex)<="9";)t*=10,t+=this._string.charAt(this._currentIndex)-"0",this._currentIndex++}var l=e+i;if(l*=r,t&&(l*=Math.pow(10,a*t)),o!=this._currentIndex)return this._skipOptionalSpacesOrDelimiter(),l}},i.prototype._parseArcFlag=function(){if(!(this._currentIndex>=this._endIndex)){var t=!1,e=this._string.charAt(this._currentIndex++);if("0"==e)t=!1;else{if("1"!=e)return;t=!0}return this._skipOptionalSpacesOrDelimiter(),t}},i.prototype.parseSegment=function(){var t=this._string[this._currentIndex],n=this._pathSegTypeFromChar(t);if(n==window.SVGPathSeg.PATHSEG_UNKNOWN){if(this._previousCommand==window.SVGPathSeg.PATHSEG_UNKNOWN)return null;if((n=this._nextCommandHelper(t,this._previousCommand))==window.SVGPathSeg.PATHSEG_UNKNOWN)return null}else this._currentIndex++;switch(this._previousCommand=n,n){case window.SVGPathSeg.PATHSEG_MOVETO_REL:return new window.SVGPathSegMovetoRel(e,this._parseNumber(),this._parseNumber());case window.SVGPathSeg.PATHSEG_MOVETO_ABS:return new window.SVGPathSegMovetoAbs(e,this._parseNumber(),this._parseNumber());case window.SVGPathSeg.PATHSEG_LINETO_REL:return new window.SVGPathSegLinetoRel(e,this._parseNumber(),this._parseNumber());case window.SVGPathSeg.PATHSEG_LINETO_ABS:return new window.SVGPathSegLinetoAbs(e,this._parseNumber(),this._parseNumber());case window.SVGPathSeg.PATHSEG_LINETO_HORIZONTAL_REL:return new window.SVGPathSegLinetoHorizontalRel(e,this._parseNumber());case window.SVGPathSeg.PATHSEG_LINETO_HORIZONTAL_ABS:return new window.SVGPathSegLinetoHorizontalAbs(e,this._parseNumber());case window.SVGPathSeg.PATHSEG_LINETO_VERTICAL_REL:return new window.SVGPathSegLinetoVerticalRel(e,this._parseNumber());case window.SVGPathSeg.PATHSEG_LINETO_VERTICAL_ABS:return new window.SVGPathSegLinetoVerticalAbs(e,this._parseNumber());case window.SVGPathSeg.PATHSEG_CLOSEPATH:return this._skipOptionalSpaces(),new window.SVGPathSegClosePath(e);case window.SVGPathSeg.PATHSEG_CURVETO_CUBIC_REL:var i={x1:this._parseNumber(),y1:this._parseNumber(),x2:this._parseNumber(),y2:this._parseNumber(),x:this._parseNumber(),y:this._parseNumber()};return new window.SVGPathSegCurvetoCubicRel(e,i.x,i.y,i.x1,i.y1,i.x2,i.y2);case window.SVGPathSeg.PATHSEG_CURVETO_CUBIC_ABS:i={x1:this._parseNumber(),y1:this._parseNumber(),x2:this._parseNumber(),y2:this._parseNumber(),x:this._parseNumber(),y:this._parseNumber()};return new window.SVGPathSegCurvetoCubicAbs(e,i.x,i.y,i.x1,i.y1,i.x2,i.y2);case window.SVGPathSeg.PATHSEG_CURVETO_CUBIC_SMOOTH_REL:i={x2:this._parseNumber(),y2:this._parseNumber(),x:this._parseNumber(),y:this._parseNumber()};return new window.SVGPathSegCurvetoCubicSmoothRel(e,i.x,i.y,i.x2,i.y2);case window.SVGPathSeg.PATHSEG_CURVETO_CUBIC_SMOOTH_ABS:i={x2:this._parseNumber(),y2:this._parseNumber(),x:this._parseNumber(),y:this._parseNumber()};return new window.SVGPathSegCurvetoCubicSmoothAbs(e,i.x,i.y,i.x2,i.y2);case window.SVGPathSeg.PATHSEG_CURVETO_QUADRATIC_REL:i={x1:this._parseNumber(),y1:this._parseNumber(),x:this._parseNumber(),y:this._parseNumber()};return new window.SVGPathSegCurvetoQuadraticRel(e,i.x,i.y,i.x1,i.y1);case window.SVGPathSeg.PATHSEG_CURVETO_QUADRATIC_ABS:i={x1:this._parseNumber(),y1:this._parseNumber(),x:this._parseNumber(),y:this._parseNumber()};return new window.SVGPathSegCurvetoQuadraticAbs(e,i.x,i.y,i.x1,i.y1);case window.SVGPathSeg.PATHSEG_CURVETO_QUADRATIC_SMOOTH_REL:return new window.SVGPathSegCurvetoQuadraticSmoothRel(e,this._parseNumber(),this._parseNumber());case window.SVGPathSeg.PATHSEG_CURVETO_QUADRATIC_SMOOTH_ABS:return new window.SVGPathSegCurvetoQuadraticSmoothAbs(e,this._parseNumber(),this._parseNumber());case window.SVGPathSeg.PATHSEG_ARC_REL:i={x1:this._parseNumber(),y1:this._parseNumber(),arcAngle:this._parseNumber(),arcLarge:this._parseArcFlag(),arcSweep:this._parseArcFlag(),x:this._parseNumber(),y:this._parseNumber()};return new window.SVGPathSegArcRel(e,i.x,i.y,i.x1,i.y1,i.arcAngle,i.arcLarge,i.arcSweep);case window.SVGPathSeg.PATHSEG_ARC_ABS:i={x1:this._parseNumber(),y1:this._parseNumber(),arcAngle:this._parseNumber(),arcLarge:this._parseArcFlag(),arcSweep:this._parseArcFlag(),x:this._parseNumber(),y:this._parseNumber()};return new window.SVGPathSegArcAbs(e,i.x,i.y,i.x1,i.y1,i.arcAngle,i.arcLarge,i.arcSweep);default:throw"Unknown path seg type."}};var r=new n,a=new i(t);if(!a.initialCommandIsMoveTo())return[];for(;a.hasMoreData();){var o=a.parseSegment();if(!o)return[];r.appendSegment(o)}return r.pathSegList}),M.axis=function(){},M.axis.labels=function(t){var e=this.internal;arguments.length&&(Object.keys(t).forEach(function(n){e.axis.setLabelText(n,t[n])}),e.axis.updateLabels())},M.axis.max=function(t){var e=this.internal,n=e.config;if(!arguments.length)return{x:n.axis_x_max,y:n.axis_y_max,y2:n.axis_y2_max};"object"===(void 0===t?"undefined":u(t))?(h(t.x)&&(n.axis_x_max=t.x),h(t.y)&&(n.axis_y_max=t.y),h(t.y2)&&(n.axis_y2_max=t.y2)):n.axis_y_max=n.axis_y2_max=t,e.redraw({withUpdateOrgXDomain:!0,withUpdateXDomain:!0})},M.axis.min=function(t){var e=this.internal,n=e.config;if(!arguments.length)return{x:n.axis_x_min,y:n.axis_y_min,y2:n.axis_y2_min};"object"===(void 0===t?"undefined":u(t))?(h(t.x)&&(n.axis_x_min=t.x),h(t.y)&&(n.axis_y_min=t.y),h(t.y2)&&(n.axis_y2_min=t.y2)):n.axis_y_min=n.axis_y2_min=t,e.redraw({withUpdateOrgXDomain:!0,withUpdateXDomain:!0})},M.axis.range=function(t){if(!arguments.length)return{max:this.axis.max(),min:this.axis.min()};m(t.max)&&this.axis.max(t.max),m(t.min)&&this.axis.min(t.min)},M.category=function(t,e){var n=this.internal,i=n.config;return arguments.length>1&&(i.axis_x_categories[t]=e,n.redraw()),i.axis_x_categories[t]},M.categories=function(t){var e=this.internal,n=e.config;return arguments.length?(n.axis_x_categories=t,e.redraw(),n.axis_x_categories):n.axis_x_categories},M.resize=function(t){var e=this.internal.config;e.size_width=t?t.width:null,e.size_height=t?t.height:null,this.flush()},M.flush=function(){this.internal.updateAndRedraw({withLegend:!0,withTransition:!1,withTransitionForTransform:!1})},M.destroy=function(){var t=this.internal;if(window.clearInterval(t.intervalForObserveInserted),void 0!==t.resizeTimeout&&window.clearTimeout(t.resizeTimeout),window.detachEvent)window.detachEvent("onresize",t.resizeFunction);else if(window.removeEventListener)window.removeEventListener("resize",t.resizeFunction);else{var e=window.onresize;e&&e.add&&e.remove&&e.remove(t.resizeFunction)}return t.selectChart.classed("c3",!1).html(""),Object.keys(t).forEach(function(e){t[e]=null}),null},M.color=function(t){return this.internal.color(t)},M.data=function(t){var e=this.internal.data.targets;return void 0===t?e:e.filter(function(e){return[].concat(t).indexOf(e.id)>=0})},M.data.shown=function(t){return this.internal.filterTargetsToShow(this.data(t))},M.data.values=function(t){var e,n=null;return t&&(n=(e=this.data(t))[0]?e[0].values.map(function(t){return t.value}):null),n},M.data.names=function(t){return this.internal.clearLegendItemTextBoxCache(),this.internal.updateDataAttributes("names",t)},M.data.colors=function(t){return this.internal.updateDataAttributes("colors",t)},M.data.axes=function(t){return this.internal.updateDataAttributes("axes",t)},M.flow=function(t){var e,n,i,r,a,o,s,u=this.internal,c=[],l=u.getMaxDataCount(),d=0,f=0;if(t.json)n=u.convertJsonToData(t.json,t.keys);else if(t.rows)n=u.convertRowsToData(t.rows);else{if(!t.columns)return;n=u.convertColumnsToData(t.columns)}e=u.convertDataToTargets(n,!0),u.data.targets.forEach(function(t){var n,i,r=!1;for(n=0;n<e.length;n++)if(t.id===e[n].id){for(r=!0,t.values[t.values.length-1]&&(f=t.values[t.values.length-1].index+1),d=e[n].values.length,i=0;i<d;i++)e[n].values[i].index=f+i,u.isTimeSeries()||(e[n].values[i].x=f+i);t.values=t.values.concat(e[n].values),e.splice(n,1);break}r||c.push(t.id)}),u.data.targets.forEach(function(t){var e,n;for(e=0;e<c.length;e++)if(t.id===c[e])for(f=t.values[t.values.length-1].index+1,n=0;n<d;n++)t.values.push({id:t.id,index:f+n,x:u.isTimeSeries()?u.getOtherTargetX(f+n):f+n,value:null})}),u.data.targets.length&&e.forEach(function(t){var e,n=[];for(e=u.data.targets[0].values[0].index;e<f;e++)n.push({id:t.id,index:e,x:u.isTimeSeries()?u.getOtherTargetX(e):e,value:null});t.values.forEach(function(t){t.index+=f,u.isTimeSeries()||(t.x+=f)}),t.values=n.concat(t.values)}),u.data.targets=u.data.targets.concat(e),u.getMaxDataCount(),a=(r=u.data.targets[0]).values[0],m(t.to)?(d=0,s=u.isTimeSeries()?u.parseDate(t.to):t.to,r.values.forEach(function(t){t.x<s&&d++})):m(t.length)&&(d=t.length),l?1===l&&u.isTimeSeries()&&(o=(r.values[r.values.length-1].x-a.x)/2,i=[new Date(+a.x-o),new Date(+a.x+o)],u.updateXDomain(null,!0,!0,!1,i)):(o=u.isTimeSeries()?r.values.length>1?r.values[r.values.length-1].x-a.x:a.x-u.getXDomain(u.data.targets)[0]:1,i=[a.x-o,a.x],u.updateXDomain(null,!0,!0,!1,i)),u.updateTargets(u.data.targets),u.redraw({flow:{index:a.index,length:d,duration:h(t.duration)?t.duration:u.config.transition_duration,done:t.done,orgDataCount:l},withLegend:!0,withTransition:l>1,withTrimXDomain:!1,withUpdateXAxis:!0})},P.generateFlow=function(t){var e=this,n=e.config,i=e.d3;return function(){var r,a,o,u=t.targets,c=t.flow,l=t.drawBar,h=t.drawLine,d=t.drawArea,f=t.cx,g=t.cy,p=t.xv,m=t.xForText,v=t.yForText,y=t.duration,_=1,w=c.index,b=c.length,S=e.getValueOnIndex(e.data.targets[0].values,w),T=e.getValueOnIndex(e.data.targets[0].values,w+b),A=e.x.domain(),C=c.duration||y,M=c.done||function(){},P=e.generateWait(),E=e.xgrid||i.selectAll([]),L=e.xgridLines||i.selectAll([]),k=e.mainRegion||i.selectAll([]),D=e.mainText||i.selectAll([]),O=e.mainBar||i.selectAll([]),I=e.mainLine||i.selectAll([]),V=e.mainArea||i.selectAll([]),G=e.mainCircle||i.selectAll([]);e.flowing=!0,e.data.targets.forEach(function(t){t.values.splice(0,b)}),o=e.updateXDomain(u,!0,!0),e.updateXGrid&&e.updateXGrid(!0),c.orgDataCount?r=1===c.orgDataCount||(S&&S.x)===(T&&T.x)?e.x(A[0])-e.x(o[0]):e.isTimeSeries()?e.x(A[0])-e.x(o[0]):e.x(S.x)-e.x(T.x):1!==e.data.targets[0].values.length?r=e.x(A[0])-e.x(o[0]):e.isTimeSeries()?(S=e.getValueOnIndex(e.data.targets[0].values,0),T=e.getValueOnIndex(e.data.targets[0].values,e.data.targets[0].values.length-1),r=e.x(S.x)-e.x(T.x)):r=x(o)/2,_=x(A)/x(o),a="translate("+r+",0) scale("+_+",1)",e.hideXGridFocus(),i.transition().ease("linear").duration(C).each(function(){P.add(e.axes.x.transition().call(e.xAxis)),P.add(O.transition().attr("transform",a)),P.add(I.transition().attr("transform",a)),P.add(V.transition().attr("transform",a)),P.add(G.transition().attr("transform",a)),P.add(D.transition().attr("transform",a)),P.add(k.filter(e.isRegionOnX).transition().attr("transform",a)),P.add(E.transition().attr("transform",a)),P.add(L.transition().attr("transform",a))}).call(P,function(){var t,i=[],r=[],a=[];if(b){for(t=0;t<b;t++)i.push("."+s.shape+"-"+(w+t)),r.push("."+s.text+"-"+(w+t)),a.push("."+s.eventRect+"-"+(w+t));e.svg.selectAll("."+s.shapes).selectAll(i).remove(),e.svg.selectAll("."+s.texts).selectAll(r).remove(),e.svg.selectAll("."+s.eventRects).selectAll(a).remove(),e.svg.select("."+s.xgrid).remove()}E.attr("transform",null).attr(e.xgridAttr),L.attr("transform",null),L.select("line").attr("x1",n.axis_rotated?0:p).attr("x2",n.axis_rotated?e.width:p),L.select("text").attr("x",n.axis_rotated?e.width:0).attr("y",p),O.attr("transform",null).attr("d",l),I.attr("transform",null).attr("d",h),V.attr("transform",null).attr("d",d),G.attr("transform",null).attr("cx",f).attr("cy",g),D.attr("transform",null).attr("x",m).attr("y",v).style("fill-opacity",e.opacityForText.bind(e)),k.attr("transform",null),k.select("rect").filter(e.isRegionOnX).attr("x",e.regionX.bind(e)).attr("width",e.regionWidth.bind(e)),n.interaction_enabled&&e.redrawEventRect(),M(),e.flowing=!1})}},M.focus=function(t){var e,n=this.internal;t=n.mapToTargetIds(t),e=n.svg.selectAll(n.selectorTargets(t.filter(n.isTargetToShow,n))),this.revert(),this.defocus(),e.classed(s.focused,!0).classed(s.defocused,!1),n.hasArcType()&&n.expandArc(t),n.toggleFocusLegend(t,!0),n.focusedTargetIds=t,n.defocusedTargetIds=n.defocusedTargetIds.filter(function(e){return t.indexOf(e)<0})},M.defocus=function(t){var e=this.internal;t=e.mapToTargetIds(t),e.svg.selectAll(e.selectorTargets(t.filter(e.isTargetToShow,e))).classed(s.focused,!1).classed(s.defocused,!0),e.hasArcType()&&e.unexpandArc(t),e.toggleFocusLegend(t,!1),e.focusedTargetIds=e.focusedTargetIds.filter(function(e){return t.indexOf(e)<0}),e.defocusedTargetIds=t},M.revert=function(t){var e=this.internal;t=e.mapToTargetIds(t),e.svg.selectAll(e.selectorTargets(t)).classed(s.focused,!1).classed(s.defocused,!1),e.hasArcType()&&e.unexpandArc(t),e.config.legend_show&&(e.showLegend(t.filter(e.isLegendToShow.bind(e))),e.legend.selectAll(e.selectorLegends(t)).filter(function(){return e.d3.select(this).classed(s.legendItemFocused)}).classed(s.legendItemFocused,!1)),e.focusedTargetIds=[],e.defocusedTargetIds=[]},M.xgrids=function(t){var e=this.internal,n=e.config;return t?(n.grid_x_lines=t,e.redrawWithoutRescale(),n.grid_x_lines):n.grid_x_lines},M.xgrids.add=function(t){var e=this.internal;return this.xgrids(e.config.grid_x_lines.concat(t||[]))},M.xgrids.remove=function(t){this.internal.removeGridLines(t,!0)},M.ygrids=function(t){var e=this.internal,n=e.config;return t?(n.grid_y_lines=t,e.redrawWithoutRescale(),n.grid_y_lines):n.grid_y_lines},M.ygrids.add=function(t){var e=this.internal;return this.ygrids(e.config.grid_y_lines.concat(t||[]))},M.ygrids.remove=function(t){this.internal.removeGridLines(t,!1)},M.groups=function(t){var e=this.internal,n=e.config;return p(t)?n.data_groups:(n.data_groups=t,e.redraw(),n.data_groups)},M.legend=function(){},M.legend.show=function(t){var e=this.internal;e.showLegend(e.mapToTargetIds(t)),e.updateAndRedraw({withLegend:!0})},M.legend.hide=function(t){var e=this.internal;e.hideLegend(e.mapToTargetIds(t)),e.updateAndRedraw({withLegend:!0})},M.load=function(t){var e=this.internal,n=e.config;t.xs&&e.addXs(t.xs),"names"in t&&M.data.names.bind(this)(t.names),"classes"in t&&Object.keys(t.classes).forEach(function(e){n.data_classes[e]=t.classes[e]}),"categories"in t&&e.isCategorized()&&(n.axis_x_categories=t.categories),"axes"in t&&Object.keys(t.axes).forEach(function(e){n.data_axes[e]=t.axes[e]}),"colors"in t&&Object.keys(t.colors).forEach(function(e){n.data_colors[e]=t.colors[e]}),"cacheIds"in t&&e.hasCaches(t.cacheIds)?e.load(e.getCaches(t.cacheIds),t.done):"unload"in t?e.unload(e.mapToTargetIds("boolean"==typeof t.unload&&t.unload?null:t.unload),function(){e.loadFromArgs(t)}):e.loadFromArgs(t)},M.unload=function(t){var e=this.internal;(t=t||{})instanceof Array?t={ids:t}:"string"==typeof t&&(t={ids:[t]}),e.unload(e.mapToTargetIds(t.ids),function(){e.redraw({withUpdateOrgXDomain:!0,withUpdateXDomain:!0,withLegend:!0}),t.done&&t.done()})},M.regions=function(t){var e=this.internal,n=e.config;return t?(n.regions=t,e.redrawWithoutRescale(),n.regions):n.regions},M.regions.add=function(t){var e=this.internal,n=e.config;return t?(n.regions=n.regions.concat(t),e.redrawWithoutRescale(),n.regions):n.regions},M.regions.remove=function(t){var e,n,i,r=this.internal,a=r.config;return t=t||{},e=r.getOption(t,"duration",a.transition_duration),n=r.getOption(t,"classes",[s.region]),i=r.main.select("."+s.regions).selectAll(n.map(function(t){return"."+t})),(e?i.transition().duration(e):i).style("opacity",0).remove(),a.regions=a.regions.filter(function(t){var e=!1;return!t.class||(t.class.split(" ").forEach(function(t){n.indexOf(t)>=0&&(e=!0)}),!e)}),a.regions},M.selected=function(t){var e=this.internal,n=e.d3;return n.merge(e.main.selectAll("."+s.shapes+e.getTargetSelectorSuffix(t)).selectAll("."+s.shape).filter(function(){return n.select(this).classed(s.SELECTED)}).map(function(t){return t.map(function(t){var e=t.__data__;return e.data?e.data:e})}))},M.select=function(t,e,n){var i=this.internal,r=i.d3,a=i.config;a.data_selection_enabled&&i.main.selectAll("."+s.shapes).selectAll("."+s.shape).each(function(o,u){var c=r.select(this),l=o.data?o.data.id:o.id,h=i.getToggle(this,o).bind(i),d=a.data_selection_grouped||!t||t.indexOf(l)>=0,f=!e||e.indexOf(u)>=0,g=c.classed(s.SELECTED);c.classed(s.line)||c.classed(s.area)||(d&&f?a.data_selection_isselectable(o)&&!g&&h(!0,c.classed(s.SELECTED,!0),o,u):m(n)&&n&&g&&h(!1,c.classed(s.SELECTED,!1),o,u))})},M.unselect=function(t,e){var n=this.internal,i=n.d3,r=n.config;r.data_selection_enabled&&n.main.selectAll("."+s.shapes).selectAll("."+s.shape).each(function(a,o){var u=i.select(this),c=a.data?a.data.id:a.id,l=n.getToggle(this,a).bind(n),h=r.data_selection_grouped||!t||t.indexOf(c)>=0,d=!e||e.indexOf(o)>=0,f=u.classed(s.SELECTED);u.classed(s.line)||u.classed(s.area)||h&&d&&r.data_selection_isselectable(a)&&f&&l(!1,u.classed(s.SELECTED,!1),a,o)})},M.show=function(t,e){var n,i=this.internal;t=i.mapToTargetIds(t),e=e||{},i.removeHiddenTargetIds(t),(n=i.svg.selectAll(i.selectorTargets(t))).transition().style("opacity",1,"important").call(i.endall,function(){n.style("opacity",null).style("opacity",1)}),e.withLegend&&i.showLegend(t),i.redraw({withUpdateOrgXDomain:!0,withUpdateXDomain:!0,withLegend:!0})},M.hide=function(t,e){var n,i=this.internal;t=i.mapToTargetIds(t),e=e||{},i.addHiddenTargetIds(t),(n=i.svg.selectAll(i.selectorTargets(t))).transition().style("opacity",0,"important").call(i.endall,function(){n.style("opacity",null).style("opacity",0)}),e.withLegend&&i.hideLegend(t),i.redraw({withUpdateOrgXDomain:!0,withUpdateXDomain:!0,withLegend:!0})},M.toggle=function(t,e){var n=this,i=this.internal;i.mapToTargetIds(t).forEach(function(t){i.isTargetToShow(t)?n.hide(t,e):n.show(t,e)})},M.tooltip=function(){},M.tooltip.show=function(t){var e,n,i=this.internal;t.mouse&&(n=t.mouse),t.data?i.isMultipleX()?(n=[i.x(t.data.x),i.getYScale(t.data.id)(t.data.value)],e=null):e=h(t.data.index)?t.data.index:i.getIndexByX(t.data.x):void 0!==t.x?e=i.getIndexByX(t.x):void 0!==t.index&&(e=t.index),i.dispatchEvent("mouseover",e,n),i.dispatchEvent("mousemove",e,n),i.config.tooltip_onshow.call(i,t.data)},M.tooltip.hide=function(){this.internal.dispatchEvent("mouseout",0),this.internal.config.tooltip_onhide.call(this)},M.transform=function(t,e){var n=this.internal,i=["pie","donut"].indexOf(t)>=0?{withTransform:!0}:null;n.transformTo(e,t,i)},P.transformTo=function(t,e,n){var i=!this.hasArcType(),r=n||{withTransitionForAxis:i};r.withTransitionForTransform=!1,this.transiting=!1,this.setTargetType(t,e),this.updateTargets(this.data.targets),this.updateAndRedraw(r)},M.x=function(t){var e=this.internal;return arguments.length&&(e.updateTargetX(e.data.targets,t),e.redraw({withUpdateOrgXDomain:!0,withUpdateXDomain:!0})),e.data.xs},M.xs=function(t){var e=this.internal;return arguments.length&&(e.updateTargetXs(e.data.targets,t),e.redraw({withUpdateOrgXDomain:!0,withUpdateXDomain:!0})),e.data.xs},M.zoom=function(t){var e=this.internal;return t&&(e.isTimeSeries()&&(t=t.map(function(t){return e.parseDate(t)})),e.brush.extent(t),e.redraw({withUpdateXDomain:!0,withY:e.config.zoom_rescale}),e.config.zoom_onzoom.call(this,e.x.orgDomain())),e.brush.extent()},M.zoom.enable=function(t){var e=this.internal;e.config.zoom_enabled=t,e.updateAndRedraw()},M.unzoom=function(){var t=this.internal;t.brush.clear().update(),t.redraw({withUpdateXDomain:!0})},M.zoom.max=function(t){var e=this.internal,n=e.config,i=e.d3;if(0!==t&&!t)return n.zoom_x_max;n.zoom_x_max=i.max([e.orgXDomain[1],t])},M.zoom.min=function(t){var e=this.internal,n=e.config,i=e.d3;if(0!==t&&!t)return n.zoom_x_min;n.zoom_x_min=i.min([e.orgXDomain[0],t])},M.zoom.range=function(t){if(!arguments.length)return{max:this.domain.max(),min:this.domain.min()};m(t.max)&&this.domain.max(t.max),m(t.min)&&this.domain.min(t.min)},P.initPie=function(){var t=this.d3;this.pie=t.layout.pie().value(function(t){return t.values.reduce(function(t,e){return t+e.value},0)}),this.pie.sort(this.getOrderFunction()||null)},P.updateRadius=function(){var t=this.config,e=t.gauge_width||t.donut_width;this.radiusExpanded=Math.min(this.arcWidth,this.arcHeight)/2,this.radius=.95*this.radiusExpanded,this.innerRadiusRatio=e?(this.radius-e)/this.radius:.6,this.innerRadius=this.hasType("donut")||this.hasType("gauge")?this.radius*this.innerRadiusRatio:0},P.updateArc=function(){this.svgArc=this.getSvgArc(),this.svgArcExpanded=this.getSvgArcExpanded(),this.svgArcExpandedSub=this.getSvgArcExpanded(.98)},P.updateAngle=function(t){var e,n,i,r,a=this.config,o=!1,s=0;return a?(this.pie(this.filterTargetsToShow(this.data.targets)).forEach(function(e){o||e.data.id!==t.data.id||(o=!0,(t=e).index=s),s++}),isNaN(t.startAngle)&&(t.startAngle=0),isNaN(t.endAngle)&&(t.endAngle=t.startAngle),this.isGaugeType(t.data)&&(e=a.gauge_min,n=a.gauge_max,i=Math.PI*(a.gauge_fullCircle?2:1)/(n-e),r=t.value<e?0:t.value<n?t.value-e:n-e,t.startAngle=a.gauge_startingAngle,t.endAngle=t.startAngle+i*r),o?t:null):null},P.getSvgArc=function(){var t=this,e=t.d3.svg.arc().outerRadius(t.radius).innerRadius(t.innerRadius),n=function(n,i){var r;return i?e(n):(r=t.updateAngle(n))?e(r):"M 0 0"};return n.centroid=e.centroid,n},P.getSvgArcExpanded=function(t){var e=this,n=e.d3.svg.arc().outerRadius(e.radiusExpanded*(t||1)).innerRadius(e.innerRadius);return function(t){var i=e.updateAngle(t);return i?n(i):"M 0 0"}},P.getArc=function(t,e,n){return n||this.isArcType(t.data)?this.svgArc(t,e):"M 0 0"},P.transformForArcLabel=function(t){var e,n,i,r,a,o=this.config,s=this.updateAngle(t),u="";return s&&!this.hasType("gauge")&&(e=this.svgArc.centroid(s),n=isNaN(e[0])?0:e[0],i=isNaN(e[1])?0:e[1],r=Math.sqrt(n*n+i*i),u="translate("+n*(a=this.hasType("donut")&&o.donut_label_ratio?d(o.donut_label_ratio)?o.donut_label_ratio(t,this.radius,r):o.donut_label_ratio:this.hasType("pie")&&o.pie_label_ratio?d(o.pie_label_ratio)?o.pie_label_ratio(t,this.radius,r):o.pie_label_ratio:this.radius&&r?(36/this.radius>.375?1.175-36/this.radius:.8)*this.radius/r:0)+","+i*a+")"),u},P.getArcRatio=function(t){var e=this.config,n=Math.PI*(this.hasType("gauge")&&!e.gauge_fullCircle?1:2);return t?(t.endAngle-t.startAngle)/n:null},P.convertToArcData=function(t){return this.addName({id:t.data.id,value:t.value,ratio:this.getArcRatio(t),index:t.index})},P.textForArcLabel=function(t){var e,n,i,r,a;return this.shouldShowArcLabel()?(e=this.updateAngle(t),n=e?e.value:null,i=this.getArcRatio(e),r=t.data.id,this.hasType("gauge")||this.meetsArcLabelThreshold(i)?(a=this.getArcLabelFormat())?a(n,i,r):this.defaultArcValueFormat(n,i):""):""},P.textForGaugeMinMax=function(t,e){var n=this.getGaugeLabelExtents();return n?n(t,e):t},P.expandArc=function(t){var e,n=this;n.transiting?e=window.setInterval(function(){n.transiting||(window.clearInterval(e),n.legend.selectAll(".c3-legend-item-focused").size()>0&&n.expandArc(t))},10):(t=n.mapToTargetIds(t),n.svg.selectAll(n.selectorTargets(t,"."+s.chartArc)).each(function(t){n.shouldExpand(t.data.id)&&n.d3.select(this).selectAll("path").transition().duration(n.expandDuration(t.data.id)).attr("d",n.svgArcExpanded).transition().duration(2*n.expandDuration(t.data.id)).attr("d",n.svgArcExpandedSub).each(function(t){n.isDonutType(t.data)})}))},P.unexpandArc=function(t){var e=this;e.transiting||(t=e.mapToTargetIds(t),e.svg.selectAll(e.selectorTargets(t,"."+s.chartArc)).selectAll("path").transition().duration(function(t){return e.expandDuration(t.data.id)}).attr("d",e.svgArc),e.svg.selectAll("."+s.arc))},P.expandDuration=function(t){var e=this.config;return this.isDonutType(t)?e.donut_expand_duration:this.isGaugeType(t)?e.gauge_expand_duration:this.isPieType(t)?e.pie_expand_duration:50},P.shouldExpand=function(t){var e=this.config;return this.isDonutType(t)&&e.donut_expand||this.isGaugeType(t)&&e.gauge_expand||this.isPieType(t)&&e.pie_expand},P.shouldShowArcLabel=function(){var t=this.config,e=!0;return this.hasType("donut")?e=t.donut_label_show:this.hasType("pie")&&(e=t.pie_label_show),e},P.meetsArcLabelThreshold=function(t){var e=this.config;return t>=(this.hasType("donut")?e.donut_label_threshold:e.pie_label_threshold)},P.getArcLabelFormat=function(){var t=this.config,e=t.pie_label_format;return this.hasType("gauge")?e=t.gauge_label_format:this.hasType("donut")&&(e=t.donut_label_format),e},P.getGaugeLabelExtents=function(){return this.config.gauge_label_extents},P.getArcTitle=function(){return this.hasType("donut")?this.config.donut_title:""},P.updateTargetsForArc=function(t){var e,n=this.main,i=this.classChartArc.bind(this),r=this.classArcs.bind(this),a=this.classFocus.bind(this);(e=n.select("."+s.chartArcs).selectAll("."+s.chartArc).data(this.pie(t)).attr("class",function(t){return i(t)+a(t.data)}).enter().append("g").attr("class",i)).append("g").attr("class",r),e.append("text").attr("dy",this.hasType("gauge")?"-.1em":".35em").style("opacity",0).style("text-anchor","middle").style("pointer-events","none")},P.initArc=function(){this.arcs=this.main.select("."+s.chart).append("g").attr("class",s.chartArcs).attr("transform",this.getTranslate("arc")),this.arcs.append("text").attr("class",s.chartArcsTitle).style("text-anchor","middle").text(this.getArcTitle())},P.redrawArc=function(t,e,n){var i,r=this,a=r.d3,o=r.config,u=r.main;(i=u.selectAll("."+s.arcs).selectAll("."+s.arc).data(r.arcData.bind(r))).enter().append("path").attr("class",r.classArc.bind(r)).style("fill",function(t){return r.color(t.data)}).style("cursor",function(t){return o.interaction_enabled&&o.data_selection_isselectable(t)?"pointer":null}).each(function(t){r.isGaugeType(t.data)&&(t.startAngle=t.endAngle=o.gauge_startingAngle),this._current=t}),i.attr("transform",function(t){return!r.isGaugeType(t.data)&&n?"scale(0)":""}).on("mouseover",o.interaction_enabled?function(t){var e,n;r.transiting||(e=r.updateAngle(t))&&(n=r.convertToArcData(e),r.expandArc(e.data.id),r.api.focus(e.data.id),r.toggleFocusLegend(e.data.id,!0),r.config.data_onmouseover(n,this))}:null).on("mousemove",o.interaction_enabled?function(t){var e,n=r.updateAngle(t);n&&(e=[r.convertToArcData(n)],r.showTooltip(e,this))}:null).on("mouseout",o.interaction_enabled?function(t){var e,n;r.transiting||(e=r.updateAngle(t))&&(n=r.convertToArcData(e),r.unexpandArc(e.data.id),r.api.revert(),r.revertLegend(),r.hideTooltip(),r.config.data_onmouseout(n,this))}:null).on("click",o.interaction_enabled?function(t,e){var n,i=r.updateAngle(t);i&&(n=r.convertToArcData(i),r.toggleShape&&r.toggleShape(this,n,e),r.config.data_onclick.call(r.api,n,this))}:null).each(function(){r.transiting=!0}).transition().duration(t).attrTween("d",function(t){var e,n=r.updateAngle(t);return n?(isNaN(this._current.startAngle)&&(this._current.startAngle=0),isNaN(this._current.endAngle)&&(this._current.endAngle=this._current.startAngle),e=a.interpolate(this._current,n),this._current=e(0),function(n){var i=e(n);return i.data=t.data,r.getArc(i,!0)}):function(){return"M 0 0"}}).attr("transform",n?"scale(1)":"").style("fill",function(t){return r.levelColor?r.levelColor(t.data.values[0].value):r.color(t.data.id)}).call(r.endall,function(){r.transiting=!1}),i.exit().transition().duration(e).style("opacity",0).remove(),u.selectAll("."+s.chartArc).select("text").style("opacity",0).attr("class",function(t){return r.isGaugeType(t.data)?s.gaugeValue:""}).text(r.textForArcLabel.bind(r)).attr("transform",r.transformForArcLabel.bind(r)).style("font-size",function(t){return r.isGaugeType(t.data)?Math.round(r.radius/5)+"px":""}).transition().duration(t).style("opacity",function(t){return r.isTargetToShow(t.data.id)&&r.isArcType(t.data)?1:0}),u.select("."+s.chartArcsTitle).style("opacity",r.hasType("donut")||r.hasType("gauge")?1:0),r.hasType("gauge")&&(r.arcs.select("."+s.chartArcsBackground).attr("d",function(){var t={data:[{value:o.gauge_max}],startAngle:o.gauge_startingAngle,endAngle:-1*o.gauge_startingAngle};return r.getArc(t,!0,!0)}),r.arcs.select("."+s.chartArcsGaugeUnit).attr("dy",".75em").text(o.gauge_label_show?o.gauge_units:""),r.arcs.select("."+s.chartArcsGaugeMin).attr("dx",-1*(r.innerRadius+(r.radius-r.innerRadius)/(o.gauge_fullCircle?1:2))+"px").attr("dy","1.2em").text(o.gauge_label_show?r.textForGaugeMinMax(o.gauge_min,!1):""),r.arcs.select("."+s.chartArcsGaugeMax).attr("dx",r.innerRadius+(r.radius-r.innerRadius)/(o.gauge_fullCircle?1:2)+"px").attr("dy","1.2em").text(o.gauge_label_show?r.textForGaugeMinMax(o.gauge_max,!0):""))},P.initGauge=function(){var t=this.arcs;this.hasType("gauge")&&(t.append("path").attr("class",s.chartArcsBackground),t.append("text").attr("class",s.chartArcsGaugeUnit).style("text-anchor","middle").style("pointer-events","none"),t.append("text").attr("class",s.chartArcsGaugeMin).style("text-anchor","middle").style("pointer-events","none"),t.append("text").attr("class",s.chartArcsGaugeMax).style("text-anchor","middle").style("pointer-events","none"))},P.getGaugeLabelHeight=function(){return this.config.gauge_label_show?20:0},P.hasCaches=function(t){for(var e=0;e<t.length;e++)if(!(t[e]in this.cache))return!1;return!0},P.addCache=function(t,e){this.cache[t]=this.cloneTarget(e)},P.getCaches=function(t){var e,n=[];for(e=0;e<t.length;e++)t[e]in this.cache&&n.push(this.cloneTarget(this.cache[t[e]]));return n},P.categoryName=function(t){var e=this.config;return t<e.axis_x_categories.length?e.axis_x_categories[t]:t},P.generateClass=function(t,e){return" "+t+" "+t+this.getTargetSelectorSuffix(e)},P.classText=function(t){return this.generateClass(s.text,t.index)},P.classTexts=function(t){return this.generateClass(s.texts,t.id)},P.classShape=function(t){return this.generateClass(s.shape,t.index)},P.classShapes=function(t){return this.generateClass(s.shapes,t.id)},P.classLine=function(t){return this.classShape(t)+this.generateClass(s.line,t.id)},P.classLines=function(t){return this.classShapes(t)+this.generateClass(s.lines,t.id)},P.classCircle=function(t){return this.classShape(t)+this.generateClass(s.circle,t.index)},P.classCircles=function(t){return this.classShapes(t)+this.generateClass(s.circles,t.id)},P.classBar=function(t){return this.classShape(t)+this.generateClass(s.bar,t.index)},P.classBars=function(t){return this.classShapes(t)+this.generateClass(s.bars,t.id)},P.classArc=function(t){return this.classShape(t.data)+this.generateClass(s.arc,t.data.id)},P.classArcs=function(t){return this.classShapes(t.data)+this.generateClass(s.arcs,t.data.id)},P.classArea=function(t){return this.classShape(t)+this.generateClass(s.area,t.id)},P.classAreas=function(t){return this.classShapes(t)+this.generateClass(s.areas,t.id)},P.classRegion=function(t,e){return this.generateClass(s.region,e)+" "+("class"in t?t.class:"")},P.classEvent=function(t){return this.generateClass(s.eventRect,t.index)},P.classTarget=function(t){var e=this.config.data_classes[t],n="";return e&&(n=" "+s.target+"-"+e),this.generateClass(s.target,t)+n},P.classFocus=function(t){return this.classFocused(t)+this.classDefocused(t)},P.classFocused=function(t){return" "+(this.focusedTargetIds.indexOf(t.id)>=0?s.focused:"")},P.classDefocused=function(t){return" "+(this.defocusedTargetIds.indexOf(t.id)>=0?s.defocused:"")},P.classChartText=function(t){return s.chartText+this.classTarget(t.id)},P.classChartLine=function(t){return s.chartLine+this.classTarget(t.id)},P.classChartBar=function(t){return s.chartBar+this.classTarget(t.id)},P.classChartArc=function(t){return s.chartArc+this.classTarget(t.data.id)},P.getTargetSelectorSuffix=function(t){return t||0===t?("-"+t).replace(/[\s?!@#$%^&*()_=+,.<>'":;\[\]\/|~`{}\\]/g,"-"):""},P.selectorTarget=function(t,e){return(e||"")+"."+s.target+this.getTargetSelectorSuffix(t)},P.selectorTargets=function(t,e){var n=this;return(t=t||[]).length?t.map(function(t){return n.selectorTarget(t,e)}):null},P.selectorLegend=function(t){return"."+s.legendItem+this.getTargetSelectorSuffix(t)},P.selectorLegends=function(t){var e=this;return t&&t.length?t.map(function(t){return e.selectorLegend(t)}):null},P.getClipPath=function(t){return"url("+(window.navigator.appVersion.toLowerCase().indexOf("msie 9.")>=0?"":document.URL.split("#")[0])+"#"+t+")"},P.appendClip=function(t,e){return t.append("clipPath").attr("id",e).append("rect")},P.getAxisClipX=function(t){var e=Math.max(30,this.margin.left);return t?-(1+e):-(e-1)},P.getAxisClipY=function(t){return t?-20:-this.margin.top},P.getXAxisClipX=function(){return this.getAxisClipX(!this.config.axis_rotated)},P.getXAxisClipY=function(){return this.getAxisClipY(!this.config.axis_rotated)},P.getYAxisClipX=function(){return this.config.axis_y_inner?-1:this.getAxisClipX(this.config.axis_rotated)},P.getYAxisClipY=function(){return this.getAxisClipY(this.config.axis_rotated)},P.getAxisClipWidth=function(t){var e=Math.max(30,this.margin.left),n=Math.max(30,this.margin.right);return t?this.width+2+e+n:this.margin.left+20},P.getAxisClipHeight=function(t){return(t?this.margin.bottom:this.margin.top+this.height)+20},P.getXAxisClipWidth=function(){return this.getAxisClipWidth(!this.config.axis_rotated)},P.getXAxisClipHeight=function(){return this.getAxisClipHeight(!this.config.axis_rotated)},P.getYAxisClipWidth=function(){return this.getAxisClipWidth(this.config.axis_rotated)+(this.config.axis_y_inner?20:0)},P.getYAxisClipHeight=function(){return this.getAxisClipHeight(this.config.axis_rotated)},P.generateColor=function(){var t=this.config,e=this.d3,n=t.data_colors,i=w(t.color_pattern)?t.color_pattern:e.scale.category10().range(),r=t.data_color,a=[];return function(t){var e,o=t.id||t.data&&t.data.id||t;return n[o]instanceof Function?e=n[o](t):n[o]?e=n[o]:(a.indexOf(o)<0&&a.push(o),e=i[a.indexOf(o)%i.length],n[o]=e),r instanceof Function?r(e,t):e}},P.generateLevelColor=function(){var t=this.config,e=t.color_pattern,n=t.color_threshold,i="value"===n.unit,r=n.values&&n.values.length?n.values:[],a=n.max||100;return w(t.color_threshold)?function(t){var n,o=e[e.length-1];for(n=0;n<r.length;n++)if((i?t:100*t/a)<r[n]){o=e[n];break}return o}:null},P.getDefaultConfig=function(){var t={bindto:"#chart",svg_classname:void 0,size_width:void 0,size_height:void 0,padding_left:void 0,padding_right:void 0,padding_top:void 0,padding_bottom:void 0,resize_auto:!0,zoom_enabled:!1,zoom_extent:void 0,zoom_privileged:!1,zoom_rescale:!1,zoom_onzoom:function(){},zoom_onzoomstart:function(){},zoom_onzoomend:function(){},zoom_x_min:void 0,zoom_x_max:void 0,interaction_brighten:!0,interaction_enabled:!0,onmouseover:function(){},onmouseout:function(){},onresize:function(){},onresized:function(){},oninit:function(){},onrendered:function(){},transition_duration:350,data_x:void 0,data_xs:{},data_xFormat:"%Y-%m-%d",data_xLocaltime:!0,data_xSort:!0,data_idConverter:function(t){return t},data_names:{},data_classes:{},data_groups:[],data_axes:{},data_type:void 0,data_types:{},data_labels:{},data_order:"desc",data_regions:{},data_color:void 0,data_colors:{},data_hide:!1,data_filter:void 0,data_selection_enabled:!1,data_selection_grouped:!1,data_selection_isselectable:function(){return!0},data_selection_multiple:!0,data_selection_draggable:!1,data_onclick:function(){},data_onmouseover:function(){},data_onmouseout:function(){},data_onselected:function(){},data_onunselected:function(){},data_url:void 0,data_headers:void 0,data_json:void 0,data_rows:void 0,data_columns:void 0,data_mimeType:void 0,data_keys:void 0,data_empty_label_text:"",subchart_show:!1,subchart_size_height:60,subchart_axis_x_show:!0,subchart_onbrush:function(){},color_pattern:[],color_threshold:{},legend_show:!0,legend_hide:!1,legend_position:"bottom",legend_inset_anchor:"top-left",legend_inset_x:10,legend_inset_y:0,legend_inset_step:void 0,legend_item_onclick:void 0,legend_item_onmouseover:void 0,legend_item_onmouseout:void 0,legend_equally:!1,legend_padding:0,legend_item_tile_width:10,legend_item_tile_height:10,axis_rotated:!1,axis_x_show:!0,axis_x_type:"indexed",axis_x_localtime:!0,axis_x_categories:[],axis_x_tick_centered:!1,axis_x_tick_format:void 0,axis_x_tick_culling:{},axis_x_tick_culling_max:10,axis_x_tick_count:void 0,axis_x_tick_fit:!0,axis_x_tick_values:null,axis_x_tick_rotate:0,axis_x_tick_outer:!0,axis_x_tick_multiline:!0,axis_x_tick_width:null,axis_x_max:void 0,axis_x_min:void 0,axis_x_padding:{},axis_x_height:void 0,axis_x_extent:void 0,axis_x_label:{},axis_y_show:!0,axis_y_type:void 0,axis_y_max:void 0,axis_y_min:void 0,axis_y_inverted:!1,axis_y_center:void 0,axis_y_inner:void 0,axis_y_label:{},axis_y_tick_format:void 0,axis_y_tick_outer:!0,axis_y_tick_values:null,axis_y_tick_rotate:0,axis_y_tick_count:void 0,axis_y_tick_time_value:void 0,axis_y_tick_time_interval:void 0,axis_y_padding:{},axis_y_default:void 0,axis_y2_show:!1,axis_y2_max:void 0,axis_y2_min:void 0,axis_y2_inverted:!1,axis_y2_center:void 0,axis_y2_inner:void 0,axis_y2_label:{},axis_y2_tick_format:void 0,axis_y2_tick_outer:!0,axis_y2_tick_values:null,axis_y2_tick_count:void 0,axis_y2_padding:{},axis_y2_default:void 0,grid_x_show:!1,grid_x_type:"tick",grid_x_lines:[],grid_y_show:!1,grid_y_lines:[],grid_y_ticks:10,grid_focus_show:!0,grid_lines_front:!0,point_show:!0,point_r:2.5,point_sensitivity:10,point_focus_expand_enabled:!0,point_focus_expand_r:void 0,point_select_r:void 0,line_connectNull:!1,line_step_type:"step",bar_width:void 0,bar_width_ratio:.6,bar_width_max:void 0,bar_zerobased:!0,bar_space:0,area_zerobased:!0,area_above:!1,pie_label_show:!0,pie_label_format:void 0,pie_label_threshold:.05,pie_label_ratio:void 0,pie_expand:{},pie_expand_duration:50,gauge_fullCircle:!1,gauge_label_show:!0,gauge_label_format:void 0,gauge_min:0,gauge_max:100,gauge_startingAngle:-1*Math.PI/2,gauge_label_extents:void 0,gauge_units:void 0,gauge_width:void 0,gauge_expand:{},gauge_expand_duration:50,donut_label_show:!0,donut_label_format:void 0,donut_label_threshold:.05,donut_label_ratio:void 0,donut_width:void 0,donut_title:"",donut_expand:{},donut_expand_duration:50,spline_interpolation_type:"cardinal",regions:[],tooltip_show:!0,tooltip_grouped:!0,tooltip_order:void 0,tooltip_format_title:void 0,tooltip_format_name:void 0,tooltip_format_value:void 0,tooltip_position:void 0,tooltip_contents:function(t,e,n,i){return this.getTooltipContent?this.getTooltipContent(t,e,n,i):""},tooltip_init_show:!1,tooltip_init_x:0,tooltip_init_position:{top:"0px",left:"50px"},tooltip_onshow:function(){},tooltip_onhide:function(){},title_text:void 0,title_padding:{top:0,right:0,bottom:0,left:0},title_position:"top-center"};return Object.keys(this.additionalConfig).forEach(function(e){t[e]=this.additionalConfig[e]},this),t},P.additionalConfig={},P.loadConfig=function(t){function e(){var t=i.shift();return t&&n&&"object"===(void 0===n?"undefined":u(n))&&t in n?(n=n[t],e()):t?void 0:n}var n,i,r,a=this.config;Object.keys(a).forEach(function(o){n=t,i=o.split("_"),r=e(),m(r)&&(a[o]=r)})},P.convertUrlToData=function(t,e,n,i,r){var a=this,o=e||"csv",s=a.d3.xhr(t);n&&Object.keys(n).forEach(function(t){s.header(t,n[t])}),s.get(function(t,e){var n,s=e.response||e.responseText;if(!e)throw new Error(t.responseURL+" "+t.status+" ("+t.statusText+")");n="json"===o?a.convertJsonToData(JSON.parse(s),i):"tsv"===o?a.convertTsvToData(s):a.convertCsvToData(s),r.call(a,n)})},P.convertXsvToData=function(t,e){var n,i=e.parseRows(t);return 1===i.length?(n=[{}],i[0].forEach(function(t){n[0][t]=null})):n=e.parse(t),n},P.convertCsvToData=function(t){return this.convertXsvToData(t,this.d3.csv)},P.convertTsvToData=function(t){return this.convertXsvToData(t,this.d3.tsv)},P.convertJsonToData=function(t,e){var n,i,r=this,a=[];return e?(e.x?(n=e.value.concat(e.x),r.config.data_x=e.x):n=e.value,a.push(n),t.forEach(function(t){var e=[];n.forEach(function(n){var i=r.findValueInJson(t,n);p(i)&&(i=null),e.push(i)}),a.push(e)}),i=r.convertRowsToData(a)):(Object.keys(t).forEach(function(e){a.push([e].concat(t[e]))}),i=r.convertColumnsToData(a)),i},P.findValueInJson=function(t,e){for(var n=(e=(e=e.replace(/\[(\w+)\]/g,".$1")).replace(/^\./,"")).split("."),i=0;i<n.length;++i){var r=n[i];if(!(r in t))return;t=t[r]}return t},P.convertRowsToData=function(t){for(var e=[],n=t[0],i=1;i<t.length;i++){for(var r={},a=0;a<t[i].length;a++){if(p(t[i][a]))throw new Error("Source data is missing a component at ("+i+","+a+")!");r[n[a]]=t[i][a]}e.push(r)}return e},P.convertColumnsToData=function(t){for(var e=[],n=0;n<t.length;n++)for(var i=t[n][0],r=1;r<t[n].length;r++){if(p(e[r-1])&&(e[r-1]={}),p(t[n][r]))throw new Error("Source data is missing a component at ("+n+","+r+")!");e[r-1][i]=t[n][r]}return e},P.convertDataToTargets=function(t,e){var n,i=this,r=i.config,a=i.d3.keys(t[0]).filter(i.isNotX,i),o=i.d3.keys(t[0]).filter(i.isX,i);return a.forEach(function(n){var a=i.getXKey(n);i.isCustomX()||i.isTimeSeries()?o.indexOf(a)>=0?i.data.xs[n]=(e&&i.data.xs[n]?i.data.xs[n]:[]).concat(t.map(function(t){return t[a]}).filter(h).map(function(t,e){return i.generateTargetX(t,n,e)})):r.data_x?i.data.xs[n]=i.getOtherTargetXs():w(r.data_xs)&&(i.data.xs[n]=i.getXValuesOfXKey(a,i.data.targets)):i.data.xs[n]=t.map(function(t,e){return e})}),a.forEach(function(t){if(!i.data.xs[t])throw new Error('x is not defined for id = "'+t+'".')}),(n=a.map(function(e,n){var a=r.data_idConverter(e);return{id:a,id_org:e,values:t.map(function(t,o){var s,u=t[i.getXKey(e)],c=null===t[e]||isNaN(t[e])?null:+t[e];return i.isCustomX()&&i.isCategorized()&&!p(u)?(0===n&&0===o&&(r.axis_x_categories=[]),-1===(s=r.axis_x_categories.indexOf(u))&&(s=r.axis_x_categories.length,r.axis_x_categories.push(u))):s=i.generateTargetX(u,e,o),(p(t[e])||i.data.xs[e].length<=o)&&(s=void 0),{x:s,value:c,id:a}}).filter(function(t){return m(t.x)})}})).forEach(function(t){var e;r.data_xSort&&(t.values=t.values.sort(function(t,e){return(t.x||0===t.x?t.x:1/0)-(e.x||0===e.x?e.x:1/0)})),e=0,t.values.forEach(function(t){t.index=e++}),i.data.xs[t.id].sort(function(t,e){return t-e})}),i.hasNegativeValue=i.hasNegativeValueInTargets(n),i.hasPositiveValue=i.hasPositiveValueInTargets(n),r.data_type&&i.setTargetType(i.mapToIds(n).filter(function(t){return!(t in r.data_types)}),r.data_type),n.forEach(function(t){i.addCache(t.id_org,t)}),n},P.isX=function(t){var e=this.config;return e.data_x&&t===e.data_x||w(e.data_xs)&&S(e.data_xs,t)},P.isNotX=function(t){return!this.isX(t)},P.getXKey=function(t){var e=this.config;return e.data_x?e.data_x:w(e.data_xs)?e.data_xs[t]:null},P.getXValuesOfXKey=function(t,e){var n,i=this;return(e&&w(e)?i.mapToIds(e):[]).forEach(function(e){i.getXKey(e)===t&&(n=i.data.xs[e])}),n},P.getIndexByX=function(t){var e=this.filterByX(this.data.targets,t);return e.length?e[0].index:null},P.getXValue=function(t,e){return t in this.data.xs&&this.data.xs[t]&&h(this.data.xs[t][e])?this.data.xs[t][e]:e},P.getOtherTargetXs=function(){var t=Object.keys(this.data.xs);return t.length?this.data.xs[t[0]]:null},P.getOtherTargetX=function(t){var e=this.getOtherTargetXs();return e&&t<e.length?e[t]:null},P.addXs=function(t){var e=this;Object.keys(t).forEach(function(n){e.config.data_xs[n]=t[n]})},P.hasMultipleX=function(t){return this.d3.set(Object.keys(t).map(function(e){return t[e]})).size()>1},P.isMultipleX=function(){return w(this.config.data_xs)||!this.config.data_xSort||this.hasType("scatter")},P.addName=function(t){var e;return t&&(e=this.config.data_names[t.id],t.name=void 0!==e?e:t.id),t},P.getValueOnIndex=function(t,e){var n=t.filter(function(t){return t.index===e});return n.length?n[0]:null},P.updateTargetX=function(t,e){var n=this;t.forEach(function(t){t.values.forEach(function(i,r){i.x=n.generateTargetX(e[r],t.id,r)}),n.data.xs[t.id]=e})},P.updateTargetXs=function(t,e){var n=this;t.forEach(function(t){e[t.id]&&n.updateTargetX([t],e[t.id])})},P.generateTargetX=function(t,e,n){return this.isTimeSeries()?t?this.parseDate(t):this.parseDate(this.getXValue(e,n)):this.isCustomX()&&!this.isCategorized()?h(t)?+t:this.getXValue(e,n):n},P.cloneTarget=function(t){return{id:t.id,id_org:t.id_org,values:t.values.map(function(t){return{x:t.x,value:t.value,id:t.id}})}},P.updateXs=function(){var t=this;t.data.targets.length&&(t.xs=[],t.data.targets[0].values.forEach(function(e){t.xs[e.index]=e.x}))},P.getPrevX=function(t){var e=this.xs[t-1];return void 0!==e?e:null},P.getNextX=function(t){var e=this.xs[t+1];return void 0!==e?e:null},P.getMaxDataCount=function(){return this.d3.max(this.data.targets,function(t){return t.values.length})},P.getMaxDataCountTarget=function(t){var e,n=t.length,i=0;return n>1?t.forEach(function(t){t.values.length>i&&(e=t,i=t.values.length)}):e=n?t[0]:null,e},P.getEdgeX=function(t){return t.length?[this.d3.min(t,function(t){return t.values[0].x}),this.d3.max(t,function(t){return t.values[t.values.length-1].x})]:[0,0]},P.mapToIds=function(t){return t.map(function(t){return t.id})},P.mapToTargetIds=function(t){return t?[].concat(t):this.mapToIds(this.data.targets)},P.hasTarget=function(t,e){var n,i=this.mapToIds(t);for(n=0;n<i.length;n++)if(i[n]===e)return!0;return!1},P.isTargetToShow=function(t){return this.hiddenTargetIds.indexOf(t)<0},P.isLegendToShow=function(t){return this.hiddenLegendIds.indexOf(t)<0},P.filterTargetsToShow=function(t){var e=this;return t.filter(function(t){return e.isTargetToShow(t.id)})},P.mapTargetsToUniqueXs=function(t){var e=this.d3.set(this.d3.merge(t.map(function(t){return t.values.map(function(t){return+t.x})}))).values();return(e=this.isTimeSeries()?e.map(function(t){return new Date(+t)}):e.map(function(t){return+t})).sort(function(t,e){return t<e?-1:t>e?1:t>=e?0:NaN})},P.addHiddenTargetIds=function(t){t=t instanceof Array?t:new Array(t);for(var e=0;e<t.length;e++)this.hiddenTargetIds.indexOf(t[e])<0&&(this.hiddenTargetIds=this.hiddenTargetIds.concat(t[e]))},P.removeHiddenTargetIds=function(t){this.hiddenTargetIds=this.hiddenTargetIds.filter(function(e){return t.indexOf(e)<0})},P.addHiddenLegendIds=function(t){t=t instanceof Array?t:new Array(t);for(var e=0;e<t.length;e++)this.hiddenLegendIds.indexOf(t[e])<0&&(this.hiddenLegendIds=this.hiddenLegendIds.concat(t[e]))},P.removeHiddenLegendIds=function(t){this.hiddenLegendIds=this.hiddenLegendIds.filter(function(e){return t.indexOf(e)<0})},P.getValuesAsIdKeyed=function(t){var e={};return t.forEach(function(t){e[t.id]=[],t.values.forEach(function(n){e[t.id].push(n.value)})}),e},P.checkValueInTargets=function(t,e){var n,i,r,a=Object.keys(t);for(n=0;n<a.length;n++)for(r=t[a[n]].values,i=0;i<r.length;i++)if(e(r[i].value))return!0;return!1},P.hasNegativeValueInTargets=function(t){return this.checkValueInTargets(t,function(t){return t<0})},P.hasPositiveValueInTargets=function(t){return this.checkValueInTargets(t,function(t){return t>0})},P.isOrderDesc=function(){var t=this.config;return"string"==typeof t.data_order&&"desc"===t.data_order.toLowerCase()},P.isOrderAsc=function(){var t=this.config;return"string"==typeof t.data_order&&"asc"===t.data_order.toLowerCase()},P.getOrderFunction=function(){var t=this.config,e=this.isOrderAsc(),n=this.isOrderDesc();if(e||n)return function(t,e){var i=function(t,e){return t+Math.abs(e.value)},r=t.values.reduce(i,0),a=e.values.reduce(i,0);return n?a-r:r-a};if(d(t.data_order))return t.data_order;if(f(t.data_order)){var i=t.data_order;return function(t,e){return i.indexOf(t.id)-i.indexOf(e.id)}}},P.orderTargets=function(t){var e=this.getOrderFunction();return e&&(t.sort(e),(this.isOrderAsc()||this.isOrderDesc())&&t.reverse()),t},P.filterByX=function(t,e){return this.d3.merge(t.map(function(t){return t.values})).filter(function(t){return t.x-e==0})},P.filterRemoveNull=function(t){return t.filter(function(t){return h(t.value)})},P.filterByXDomain=function(t,e){return t.map(function(t){return{id:t.id,id_org:t.id_org,values:t.values.filter(function(t){return e[0]<=t.x&&t.x<=e[1]})}})},P.hasDataLabel=function(){var t=this.config;return!("boolean"!=typeof t.data_labels||!t.data_labels)||!("object"!==u(t.data_labels)||!w(t.data_labels))},P.getDataLabelLength=function(t,e,n){var i=this,r=[0,0];return i.selectChart.select("svg").selectAll(".dummy").data([t,e]).enter().append("text").text(function(t){return i.dataLabelFormat(t.id)(t)}).each(function(t,e){r[e]=1.3*this.getBoundingClientRect()[n]}).remove(),r},P.isNoneArc=function(t){return this.hasTarget(this.data.targets,t.id)},P.isArc=function(t){return"data"in t&&this.hasTarget(this.data.targets,t.data.id)},P.findSameXOfValues=function(t,e){var n,i=t[e].x,r=[];for(n=e-1;n>=0&&i===t[n].x;n--)r.push(t[n]);for(n=e;n<t.length&&i===t[n].x;n++)r.push(t[n]);return r},P.findClosestFromTargets=function(t,e){var n,i=this;return n=t.map(function(t){return i.findClosest(t.values,e)}),i.findClosest(n,e)},P.findClosest=function(t,e){var n,i=this,r=i.config.point_sensitivity;return t.filter(function(t){return t&&i.isBarType(t.id)}).forEach(function(t){var e=i.main.select("."+s.bars+i.getTargetSelectorSuffix(t.id)+" ."+s.bar+"-"+t.index).node();!n&&i.isWithinBar(e)&&(n=t)}),t.filter(function(t){return t&&!i.isBarType(t.id)}).forEach(function(t){var a=i.dist(t,e);a<r&&(r=a,n=t)}),n},P.dist=function(t,e){var n=this.config,i=n.axis_rotated?1:0,r=n.axis_rotated?0:1,a=this.circleY(t,t.index),o=this.x(t.x);return Math.sqrt(Math.pow(o-e[i],2)+Math.pow(a-e[r],2))},P.convertValuesToStep=function(t){var e,n=[].concat(t);if(!this.isCategorized())return t;for(e=t.length+1;0<e;e--)n[e]=n[e-1];return n[0]={x:n[0].x-1,value:n[0].value,id:n[0].id},n[t.length+1]={x:n[t.length].x+1,value:n[t.length].value,id:n[t.length].id},n},P.updateDataAttributes=function(t,e){var n=this.config["data_"+t];return void 0===e?n:(Object.keys(e).forEach(function(t){n[t]=e[t]}),this.redraw({withLegend:!0}),n)},P.load=function(t,e){var n=this;t&&(e.filter&&(t=t.filter(e.filter)),(e.type||e.types)&&t.forEach(function(t){var i=e.types&&e.types[t.id]?e.types[t.id]:e.type;n.setTargetType(t.id,i)}),n.data.targets.forEach(function(e){for(var n=0;n<t.length;n++)if(e.id===t[n].id){e.values=t[n].values,t.splice(n,1);break}}),n.data.targets=n.data.targets.concat(t)),n.updateTargets(n.data.targets),n.redraw({withUpdateOrgXDomain:!0,withUpdateXDomain:!0,withLegend:!0}),e.done&&e.done()},P.loadFromArgs=function(t){var e=this;t.data?e.load(e.convertDataToTargets(t.data),t):t.url?e.convertUrlToData(t.url,t.mimeType,t.headers,t.keys,function(n){e.load(e.convertDataToTargets(n),t)}):t.json?e.load(e.convertDataToTargets(e.convertJsonToData(t.json,t.keys)),t):t.rows?e.load(e.convertDataToTargets(e.convertRowsToData(t.rows)),t):t.columns?e.load(e.convertDataToTargets(e.convertColumnsToData(t.columns)),t):e.load(null,t)},P.unload=function(t,e){var n=this;e||(e=function(){}),(t=t.filter(function(t){return n.hasTarget(n.data.targets,t)}))&&0!==t.length?(n.svg.selectAll(t.map(function(t){return n.selectorTarget(t)})).transition().style("opacity",0).remove().call(n.endall,e),t.forEach(function(t){n.withoutFadeIn[t]=!1,n.legend&&n.legend.selectAll("."+s.legendItem+n.getTargetSelectorSuffix(t)).remove(),n.data.targets=n.data.targets.filter(function(e){return e.id!==t})})):e()},P.getYDomainMin=function(t){var e,n,i,r,a,o,s=this,u=s.config,c=s.mapToIds(t),l=s.getValuesAsIdKeyed(t);if(u.data_groups.length>0)for(o=s.hasNegativeValueInTargets(t),e=0;e<u.data_groups.length;e++)if(0!==(r=u.data_groups[e].filter(function(t){return c.indexOf(t)>=0})).length)for(i=r[0],o&&l[i]&&l[i].forEach(function(t,e){l[i][e]=t<0?t:0}),n=1;n<r.length;n++)a=r[n],l[a]&&l[a].forEach(function(t,e){s.axis.getId(a)!==s.axis.getId(i)||!l[i]||o&&+t>0||(l[i][e]+=+t)});return s.d3.min(Object.keys(l).map(function(t){return s.d3.min(l[t])}))},P.getYDomainMax=function(t){var e,n,i,r,a,o,s=this,u=s.config,c=s.mapToIds(t),l=s.getValuesAsIdKeyed(t);if(u.data_groups.length>0)for(o=s.hasPositiveValueInTargets(t),e=0;e<u.data_groups.length;e++)if(0!==(r=u.data_groups[e].filter(function(t){return c.indexOf(t)>=0})).length)for(i=r[0],o&&l[i]&&l[i].forEach(function(t,e){l[i][e]=t>0?t:0}),n=1;n<r.length;n++)a=r[n],l[a]&&l[a].forEach(function(t,e){s.axis.getId(a)!==s.axis.getId(i)||!l[i]||o&&+t<0||(l[i][e]+=+t)});return s.d3.max(Object.keys(l).map(function(t){return s.d3.max(l[t])}))},P.getYDomain=function(t,e,n){var i,r,a,o,s,u,c,l,d,f,g=this,p=g.config,m=t.filter(function(t){return g.axis.getId(t.id)===e}),v=n?g.filterByXDomain(m,n):m,y="y2"===e?p.axis_y2_min:p.axis_y_min,_="y2"===e?p.axis_y2_max:p.axis_y_max,b=g.getYDomainMin(v),S=g.getYDomainMax(v),T="y2"===e?p.axis_y2_center:p.axis_y_center,A=g.hasType("bar",v)&&p.bar_zerobased||g.hasType("area",v)&&p.area_zerobased,C="y2"===e?p.axis_y2_inverted:p.axis_y_inverted,M=g.hasDataLabel()&&p.axis_rotated,P=g.hasDataLabel()&&!p.axis_rotated;return b=h(y)?y:h(_)?b<_?b:_-10:b,S=h(_)?_:h(y)?y<S?S:y+10:S,0===v.length?"y2"===e?g.y2.domain():g.y.domain():(isNaN(b)&&(b=0),isNaN(S)&&(S=b),b===S&&(b<0?S=0:b=0),d=b>=0&&S>=0,f=b<=0&&S<=0,(h(y)&&d||h(_)&&f)&&(A=!1),A&&(d&&(b=0),f&&(S=0)),r=Math.abs(S-b),a=o=.1*r,void 0!==T&&(S=T+(s=Math.max(Math.abs(b),Math.abs(S))),b=T-s),M?(u=g.getDataLabelLength(b,S,"width"),c=x(g.y.range()),a+=r*((l=[u[0]/c,u[1]/c])[1]/(1-l[0]-l[1])),o+=r*(l[0]/(1-l[0]-l[1]))):P&&(u=g.getDataLabelLength(b,S,"height"),a+=g.axis.convertPixelsToAxisPadding(u[1],r),o+=g.axis.convertPixelsToAxisPadding(u[0],r)),"y"===e&&w(p.axis_y_padding)&&(a=g.axis.getPadding(p.axis_y_padding,"top",a,r),o=g.axis.getPadding(p.axis_y_padding,"bottom",o,r)),"y2"===e&&w(p.axis_y2_padding)&&(a=g.axis.getPadding(p.axis_y2_padding,"top",a,r),o=g.axis.getPadding(p.axis_y2_padding,"bottom",o,r)),A&&(d&&(o=b),f&&(a=-S)),i=[b-o,S+a],C?i.reverse():i)},P.getXDomainMin=function(t){var e=this,n=e.config;return m(n.axis_x_min)?e.isTimeSeries()?this.parseDate(n.axis_x_min):n.axis_x_min:e.d3.min(t,function(t){return e.d3.min(t.values,function(t){return t.x})})},P.getXDomainMax=function(t){var e=this,n=e.config;return m(n.axis_x_max)?e.isTimeSeries()?this.parseDate(n.axis_x_max):n.axis_x_max:e.d3.max(t,function(t){return e.d3.max(t.values,function(t){return t.x})})},P.getXDomainPadding=function(t){var e,n,i,r,a=this.config,o=t[1]-t[0];return n=this.isCategorized()?0:this.hasType("bar")?(e=this.getMaxDataCount())>1?o/(e-1)/2:.5:.01*o,"object"===u(a.axis_x_padding)&&w(a.axis_x_padding)?(i=h(a.axis_x_padding.left)?a.axis_x_padding.left:n,r=h(a.axis_x_padding.right)?a.axis_x_padding.right:n):i=r="number"==typeof a.axis_x_padding?a.axis_x_padding:n,{left:i,right:r}},P.getXDomain=function(t){var e=[this.getXDomainMin(t),this.getXDomainMax(t)],n=e[0],i=e[1],r=this.getXDomainPadding(e),a=0,o=0;return n-i!=0||this.isCategorized()||(this.isTimeSeries()?(n=new Date(.5*n.getTime()),i=new Date(1.5*i.getTime())):(n=0===n?1:.5*n,i=0===i?-1:1.5*i)),(n||0===n)&&(a=this.isTimeSeries()?new Date(n.getTime()-r.left):n-r.left),(i||0===i)&&(o=this.isTimeSeries()?new Date(i.getTime()+r.right):i+r.right),[a,o]},P.updateXDomain=function(t,e,n,i,r){var a=this.config;return n&&(this.x.domain(r||this.d3.extent(this.getXDomain(t))),this.orgXDomain=this.x.domain(),a.zoom_enabled&&this.zoom.scale(this.x).updateScaleExtent(),this.subX.domain(this.x.domain()),this.brush&&this.brush.scale(this.subX)),e&&(this.x.domain(r||(!this.brush||this.brush.empty()?this.orgXDomain:this.brush.extent())),a.zoom_enabled&&this.zoom.scale(this.x).updateScaleExtent()),i&&this.x.domain(this.trimXDomain(this.x.orgDomain())),this.x.domain()},P.trimXDomain=function(t){var e=this.getZoomDomain(),n=e[0],i=e[1];return t[0]<=n&&(t[1]=+t[1]+(n-t[0]),t[0]=n),i<=t[1]&&(t[0]=+t[0]-(t[1]-i),t[1]=i),t},P.drag=function(t){var e,n,i,r,a,o,u,c,l=this,h=l.config,d=l.main,f=l.d3;l.hasArcType()||h.data_selection_enabled&&(h.zoom_enabled&&!l.zoom.altDomain||h.data_selection_multiple&&(e=l.dragStart[0],n=l.dragStart[1],i=t[0],r=t[1],a=Math.min(e,i),o=Math.max(e,i),u=h.data_selection_grouped?l.margin.top:Math.min(n,r),c=h.data_selection_grouped?l.height:Math.max(n,r),d.select("."+s.dragarea).attr("x",a).attr("y",u).attr("width",o-a).attr("height",c-u),d.selectAll("."+s.shapes).selectAll("."+s.shape).filter(function(t){return h.data_selection_isselectable(t)}).each(function(t,e){var n,i,r,h,d,g,p=f.select(this),m=p.classed(s.SELECTED),v=p.classed(s.INCLUDED),y=!1;if(p.classed(s.circle))n=1*p.attr("cx"),i=1*p.attr("cy"),d=l.togglePoint,y=a<n&&n<o&&u<i&&i<c;else{if(!p.classed(s.bar))return;n=(g=A(this)).x,i=g.y,r=g.width,h=g.height,d=l.togglePath,y=!(o<n||n+r<a||c<i||i+h<u)}y^v&&(p.classed(s.INCLUDED,!v),p.classed(s.SELECTED,!m),d.call(l,!m,p,t,e))})))},P.dragstart=function(t){var e=this.config;this.hasArcType()||e.data_selection_enabled&&(this.dragStart=t,this.main.select("."+s.chart).append("rect").attr("class",s.dragarea).style("opacity",.1),this.dragging=!0)},P.dragend=function(){var t=this.config;this.hasArcType()||t.data_selection_enabled&&(this.main.select("."+s.dragarea).transition().duration(100).style("opacity",0).remove(),this.main.selectAll("."+s.shape).classed(s.INCLUDED,!1),this.dragging=!1)},P.getYFormat=function(t){var e=this,n=t&&!e.hasType("gauge")?e.defaultArcValueFormat:e.yFormat,i=t&&!e.hasType("gauge")?e.defaultArcValueFormat:e.y2Format;return function(t,r,a){return("y2"===e.axis.getId(a)?i:n).call(e,t,r)}},P.yFormat=function(t){var e=this.config;return(e.axis_y_tick_format?e.axis_y_tick_format:this.defaultValueFormat)(t)},P.y2Format=function(t){var e=this.config;return(e.axis_y2_tick_format?e.axis_y2_tick_format:this.defaultValueFormat)(t)},P.defaultValueFormat=function(t){return h(t)?+t:""},P.defaultArcValueFormat=function(t,e){return(100*e).toFixed(1)+"%"},P.dataLabelFormat=function(t){var e=this.config.data_labels,n=function(t){return h(t)?+t:""};return"function"==typeof e.format?e.format:"object"===u(e.format)?e.format[t]?!0===e.format[t]?n:e.format[t]:function(){return""}:n},P.initGrid=function(){var t=this.config,e=this.d3;this.grid=this.main.append("g").attr("clip-path",this.clipPathForGrid).attr("class",s.grid),t.grid_x_show&&this.grid.append("g").attr("class",s.xgrids),t.grid_y_show&&this.grid.append("g").attr("class",s.ygrids),t.grid_focus_show&&this.grid.append("g").attr("class",s.xgridFocus).append("line").attr("class",s.xgridFocus),this.xgrid=e.selectAll([]),t.grid_lines_front||this.initGridLines()},P.initGridLines=function(){var t=this.d3;this.gridLines=this.main.append("g").attr("clip-path",this.clipPathForGrid).attr("class",s.grid+" "+s.gridLines),this.gridLines.append("g").attr("class",s.xgridLines),this.gridLines.append("g").attr("class",s.ygridLines),this.xgridLines=t.selectAll([])},P.updateXGrid=function(t){var e=this,n=e.config,i=e.d3,r=e.generateGridData(n.grid_x_type,e.x),a=e.isCategorized()?e.xAxis.tickOffset():0;e.xgridAttr=n.axis_rotated?{x1:0,x2:e.width,y1:function(t){return e.x(t)-a},y2:function(t){return e.x(t)-a}}:{x1:function(t){return e.x(t)+a},x2:function(t){return e.x(t)+a},y1:0,y2:e.height},e.xgrid=e.main.select("."+s.xgrids).selectAll("."+s.xgrid).data(r),e.xgrid.enter().append("line").attr("class",s.xgrid),t||e.xgrid.attr(e.xgridAttr).style("opacity",function(){return+i.select(this).attr(n.axis_rotated?"y1":"x1")===(n.axis_rotated?e.height:0)?0:1}),e.xgrid.exit().remove()},P.updateYGrid=function(){var t=this.config,e=this.yAxis.tickValues()||this.y.ticks(t.grid_y_ticks);this.ygrid=this.main.select("."+s.ygrids).selectAll("."+s.ygrid).data(e),this.ygrid.enter().append("line").attr("class",s.ygrid),this.ygrid.attr("x1",t.axis_rotated?this.y:0).attr("x2",t.axis_rotated?this.y:this.width).attr("y1",t.axis_rotated?0:this.y).attr("y2",t.axis_rotated?this.height:this.y),this.ygrid.exit().remove(),this.smoothLines(this.ygrid,"grid")},P.gridTextAnchor=function(t){return t.position?t.position:"end"},P.gridTextDx=function(t){return"start"===t.position?4:"middle"===t.position?0:-4},P.xGridTextX=function(t){return"start"===t.position?-this.height:"middle"===t.position?-this.height/2:0},P.yGridTextX=function(t){return"start"===t.position?0:"middle"===t.position?this.width/2:this.width},P.updateGrid=function(t){var e,n,i,r=this.main,a=this.config;this.grid.style("visibility",this.hasArcType()?"hidden":"visible"),r.select("line."+s.xgridFocus).style("visibility","hidden"),a.grid_x_show&&this.updateXGrid(),this.xgridLines=r.select("."+s.xgridLines).selectAll("."+s.xgridLine).data(a.grid_x_lines),(e=this.xgridLines.enter().append("g").attr("class",function(t){return s.xgridLine+(t.class?" "+t.class:"")})).append("line").style("opacity",0),e.append("text").attr("text-anchor",this.gridTextAnchor).attr("transform",a.axis_rotated?"":"rotate(-90)").attr("dx",this.gridTextDx).attr("dy",-5).style("opacity",0),this.xgridLines.exit().transition().duration(t).style("opacity",0).remove(),a.grid_y_show&&this.updateYGrid(),this.ygridLines=r.select("."+s.ygridLines).selectAll("."+s.ygridLine).data(a.grid_y_lines),(n=this.ygridLines.enter().append("g").attr("class",function(t){return s.ygridLine+(t.class?" "+t.class:"")})).append("line").style("opacity",0),n.append("text").attr("text-anchor",this.gridTextAnchor).attr("transform",a.axis_rotated?"rotate(-90)":"").attr("dx",this.gridTextDx).attr("dy",-5).style("opacity",0),i=this.yv.bind(this),this.ygridLines.select("line").transition().duration(t).attr("x1",a.axis_rotated?i:0).attr("x2",a.axis_rotated?i:this.width).attr("y1",a.axis_rotated?0:i).attr("y2",a.axis_rotated?this.height:i).style("opacity",1),this.ygridLines.select("text").transition().duration(t).attr("x",a.axis_rotated?this.xGridTextX.bind(this):this.yGridTextX.bind(this)).attr("y",i).text(function(t){return t.text}).style("opacity",1),this.ygridLines.exit().transition().duration(t).style("opacity",0).remove()},P.redrawGrid=function(t){var e=this.config,n=this.xv.bind(this),i=this.xgridLines.select("line"),r=this.xgridLines.select("text");return[(t?i.transition():i).attr("x1",e.axis_rotated?0:n).attr("x2",e.axis_rotated?this.width:n).attr("y1",e.axis_rotated?n:0).attr("y2",e.axis_rotated?n:this.height).style("opacity",1),(t?r.transition():r).attr("x",e.axis_rotated?this.yGridTextX.bind(this):this.xGridTextX.bind(this)).attr("y",n).text(function(t){return t.text}).style("opacity",1)]},P.showXGridFocus=function(t){var e=this.config,n=t.filter(function(t){return t&&h(t.value)}),i=this.main.selectAll("line."+s.xgridFocus),r=this.xx.bind(this);e.tooltip_show&&(this.hasType("scatter")||this.hasArcType()||(i.style("visibility","visible").data([n[0]]).attr(e.axis_rotated?"y1":"x1",r).attr(e.axis_rotated?"y2":"x2",r),this.smoothLines(i,"grid")))},P.hideXGridFocus=function(){this.main.select("line."+s.xgridFocus).style("visibility","hidden")},P.updateXgridFocus=function(){var t=this.config;this.main.select("line."+s.xgridFocus).attr("x1",t.axis_rotated?0:-10).attr("x2",t.axis_rotated?this.width:-10).attr("y1",t.axis_rotated?-10:0).attr("y2",t.axis_rotated?-10:this.height)},P.generateGridData=function(t,e){var n,i,r,a,o=[],u=this.main.select("."+s.axisX).selectAll(".tick").size();if("year"===t)for(i=(n=this.getXDomain())[0].getFullYear(),r=n[1].getFullYear(),a=i;a<=r;a++)o.push(new Date(a+"-01-01 00:00:00"));else(o=e.ticks(10)).length>u&&(o=o.filter(function(t){return(""+t).indexOf(".")<0}));return o},P.getGridFilterToRemove=function(t){return t?function(e){var n=!1;return[].concat(t).forEach(function(t){("value"in t&&e.value===t.value||"class"in t&&e.class===t.class)&&(n=!0)}),n}:function(){return!0}},P.removeGridLines=function(t,e){var n=this.config,i=this.getGridFilterToRemove(t),r=function(t){return!i(t)},a=e?s.xgridLines:s.ygridLines,o=e?s.xgridLine:s.ygridLine;this.main.select("."+a).selectAll("."+o).filter(i).transition().duration(n.transition_duration).style("opacity",0).remove(),e?n.grid_x_lines=n.grid_x_lines.filter(r):n.grid_y_lines=n.grid_y_lines.filter(r)},P.initEventRect=function(){this.main.select("."+s.chart).append("g").attr("class",s.eventRects).style("fill-opacity",0)},P.redrawEventRect=function(){var t,e,n=this.config,i=this.isMultipleX(),r=this.main.select("."+s.eventRects).style("cursor",n.zoom_enabled?n.axis_rotated?"ns-resize":"ew-resize":null).classed(s.eventRectsMultiple,i).classed(s.eventRectsSingle,!i);r.selectAll("."+s.eventRect).remove(),this.eventRect=r.selectAll("."+s.eventRect),i?(t=this.eventRect.data([0]),this.generateEventRectsForMultipleXs(t.enter()),this.updateEventRect(t)):(e=this.getMaxDataCountTarget(this.data.targets),r.datum(e?e.values:[]),this.eventRect=r.selectAll("."+s.eventRect),t=this.eventRect.data(function(t){return t}),this.generateEventRectsForSingleX(t.enter()),this.updateEventRect(t),t.exit().remove())},P.updateEventRect=function(t){var e,n,i,r,a,o,s=this,u=s.config;t=t||s.eventRect.data(function(t){return t}),s.isMultipleX()?(e=0,n=0,i=s.width,r=s.height):(!s.isCustomX()&&!s.isTimeSeries()||s.isCategorized()?(a=s.getEventRectWidth(),o=function(t){return s.x(t.x)-a/2}):(s.updateXs(),a=function(t){var e=s.getPrevX(t.index),n=s.getNextX(t.index);return null===e&&null===n?u.axis_rotated?s.height:s.width:(null===e&&(e=s.x.domain()[0]),null===n&&(n=s.x.domain()[1]),Math.max(0,(s.x(n)-s.x(e))/2))},o=function(t){var e=s.getPrevX(t.index),n=s.getNextX(t.index),i=s.data.xs[t.id][t.index];return null===e&&null===n?0:(null===e&&(e=s.x.domain()[0]),(s.x(i)+s.x(e))/2)}),e=u.axis_rotated?0:o,n=u.axis_rotated?o:0,i=u.axis_rotated?s.width:a,r=u.axis_rotated?a:s.height),t.attr("class",s.classEvent.bind(s)).attr("x",e).attr("y",n).attr("width",i).attr("height",r)},P.generateEventRectsForSingleX=function(t){var e=this,n=e.d3,i=e.config;t.append("rect").attr("class",e.classEvent.bind(e)).style("cursor",i.data_selection_enabled&&i.data_selection_grouped?"pointer":null).on("mouseover",function(t){var n=t.index;e.dragging||e.flowing||e.hasArcType()||(i.point_focus_expand_enabled&&e.expandCircles(n,null,!0),e.expandBars(n,null,!0),e.main.selectAll("."+s.shape+"-"+n).each(function(t){i.data_onmouseover.call(e.api,t)}))}).on("mouseout",function(t){var n=t.index;e.config&&(e.hasArcType()||(e.hideXGridFocus(),e.hideTooltip(),e.unexpandCircles(),e.unexpandBars(),e.main.selectAll("."+s.shape+"-"+n).each(function(t){i.data_onmouseout.call(e.api,t)})))}).on("mousemove",function(t){var r,a=t.index,o=e.svg.select("."+s.eventRect+"-"+a);e.dragging||e.flowing||e.hasArcType()||(e.isStepType(t)&&"step-after"===e.config.line_step_type&&n.mouse(this)[0]<e.x(e.getXValue(t.id,a))&&(a-=1),r=e.filterTargetsToShow(e.data.targets).map(function(t){return e.addName(e.getValueOnIndex(t.values,a))}),i.tooltip_grouped&&(e.showTooltip(r,this),e.showXGridFocus(r)),(!i.tooltip_grouped||i.data_selection_enabled&&!i.data_selection_grouped)&&e.main.selectAll("."+s.shape+"-"+a).each(function(){n.select(this).classed(s.EXPANDED,!0),i.data_selection_enabled&&o.style("cursor",i.data_selection_grouped?"pointer":null),i.tooltip_grouped||(e.hideXGridFocus(),e.hideTooltip(),i.data_selection_grouped||(e.unexpandCircles(a),e.unexpandBars(a)))}).filter(function(t){return e.isWithinShape(this,t)}).each(function(t){i.data_selection_enabled&&(i.data_selection_grouped||i.data_selection_isselectable(t))&&o.style("cursor","pointer"),i.tooltip_grouped||(e.showTooltip([t],this),e.showXGridFocus([t]),i.point_focus_expand_enabled&&e.expandCircles(a,t.id,!0),e.expandBars(a,t.id,!0))}))}).on("click",function(t){var r=t.index;!e.hasArcType()&&e.toggleShape&&(e.cancelClick?e.cancelClick=!1:(e.isStepType(t)&&"step-after"===i.line_step_type&&n.mouse(this)[0]<e.x(e.getXValue(t.id,r))&&(r-=1),e.main.selectAll("."+s.shape+"-"+r).each(function(t){(i.data_selection_grouped||e.isWithinShape(this,t))&&(e.toggleShape(this,t,r),e.config.data_onclick.call(e.api,t,this))})))}).call(i.data_selection_draggable&&e.drag?n.behavior.drag().origin(Object).on("drag",function(){e.drag(n.mouse(this))}).on("dragstart",function(){e.dragstart(n.mouse(this))}).on("dragend",function(){e.dragend()}):function(){})},P.generateEventRectsForMultipleXs=function(t){function e(){n.svg.select("."+s.eventRect).style("cursor",null),n.hideXGridFocus(),n.hideTooltip(),n.unexpandCircles(),n.unexpandBars()}var n=this,i=n.d3,r=n.config;t.append("rect").attr("x",0).attr("y",0).attr("width",n.width).attr("height",n.height).attr("class",s.eventRect).on("mouseout",function(){n.config&&(n.hasArcType()||e())}).on("mousemove",function(){var t,a,o,u=n.filterTargetsToShow(n.data.targets);n.dragging||n.hasArcType(u)||(t=i.mouse(this),a=n.findClosestFromTargets(u,t),!n.mouseover||a&&a.id===n.mouseover.id||(r.data_onmouseout.call(n.api,n.mouseover),n.mouseover=void 0),a?(o=(n.isScatterType(a)||!r.tooltip_grouped?[a]:n.filterByX(u,a.x)).map(function(t){return n.addName(t)}),n.showTooltip(o,this),r.point_focus_expand_enabled&&n.expandCircles(a.index,a.id,!0),n.expandBars(a.index,a.id,!0),n.showXGridFocus(o),(n.isBarType(a.id)||n.dist(a,t)<r.point_sensitivity)&&(n.svg.select("."+s.eventRect).style("cursor","pointer"),n.mouseover||(r.data_onmouseover.call(n.api,a),n.mouseover=a))):e())}).on("click",function(){var t,e,a=n.filterTargetsToShow(n.data.targets);n.hasArcType(a)||(t=i.mouse(this),(e=n.findClosestFromTargets(a,t))&&(n.isBarType(e.id)||n.dist(e,t)<r.point_sensitivity)&&n.main.selectAll("."+s.shapes+n.getTargetSelectorSuffix(e.id)).selectAll("."+s.shape+"-"+e.index).each(function(){(r.data_selection_grouped||n.isWithinShape(this,e))&&(n.toggleShape(this,e,e.index),n.config.data_onclick.call(n.api,e,this))}))}).call(r.data_selection_draggable&&n.drag?i.behavior.drag().origin(Object).on("drag",function(){n.drag(i.mouse(this))}).on("dragstart",function(){n.dragstart(i.mouse(this))}).on("dragend",function(){n.dragend()}):function(){})},P.dispatchEvent=function(t,e,n){var i="."+s.eventRect+(this.isMultipleX()?"":"-"+e),r=this.main.select(i).node(),a=r.getBoundingClientRect(),o=a.left+(n?n[0]:0),u=a.top+(n?n[1]:0),c=document.createEvent("MouseEvents");c.initMouseEvent(t,!0,!0,window,0,o,u,o,u,!1,!1,!1,!1,0,null),r.dispatchEvent(c)},P.initLegend=function(){if(this.legendItemTextBox={},this.legendHasRendered=!1,this.legend=this.svg.append("g").attr("transform",this.getTranslate("legend")),!this.config.legend_show)return this.legend.style("visibility","hidden"),void(this.hiddenLegendIds=this.mapToIds(this.data.targets));this.updateLegendWithDefaults()},P.updateLegendWithDefaults=function(){this.updateLegend(this.mapToIds(this.data.targets),{withTransform:!1,withTransitionForTransform:!1,withTransition:!1})},P.updateSizeForLegend=function(t,e){var n=this.config,i=this.isLegendTop?this.getCurrentPaddingTop()+n.legend_inset_y+5.5:this.currentHeight-t-this.getCurrentPaddingBottom()-n.legend_inset_y,r=this.isLegendLeft?this.getCurrentPaddingLeft()+n.legend_inset_x+.5:this.currentWidth-e-this.getCurrentPaddingRight()-n.legend_inset_x+.5;this.margin3={top:this.isLegendRight?0:this.isLegendInset?i:this.currentHeight-t,right:NaN,bottom:0,left:this.isLegendRight?this.currentWidth-e:this.isLegendInset?r:0}},P.transformLegend=function(t){(t?this.legend.transition():this.legend).attr("transform",this.getTranslate("legend"))},P.updateLegendStep=function(t){this.legendStep=t},P.updateLegendItemWidth=function(t){this.legendItemWidth=t},P.updateLegendItemHeight=function(t){this.legendItemHeight=t},P.getLegendWidth=function(){return this.config.legend_show?this.isLegendRight||this.isLegendInset?this.legendItemWidth*(this.legendStep+1):this.currentWidth:0},P.getLegendHeight=function(){var t=0;return this.config.legend_show&&(t=this.isLegendRight?this.currentHeight:Math.max(20,this.legendItemHeight)*(this.legendStep+1)),t},P.opacityForLegend=function(t){return t.classed(s.legendItemHidden)?null:1},P.opacityForUnfocusedLegend=function(t){return t.classed(s.legendItemHidden)?null:.3},P.toggleFocusLegend=function(t,e){var n=this;t=n.mapToTargetIds(t),n.legend.selectAll("."+s.legendItem).filter(function(e){return t.indexOf(e)>=0}).classed(s.legendItemFocused,e).transition().duration(100).style("opacity",function(){return(e?n.opacityForLegend:n.opacityForUnfocusedLegend).call(n,n.d3.select(this))})},P.revertLegend=function(){var t=this,e=t.d3;t.legend.selectAll("."+s.legendItem).classed(s.legendItemFocused,!1).transition().duration(100).style("opacity",function(){return t.opacityForLegend(e.select(this))})},P.showLegend=function(t){var e=this,n=e.config;n.legend_show||(n.legend_show=!0,e.legend.style("visibility","visible"),e.legendHasRendered||e.updateLegendWithDefaults()),e.removeHiddenLegendIds(t),e.legend.selectAll(e.selectorLegends(t)).style("visibility","visible").transition().style("opacity",function(){return e.opacityForLegend(e.d3.select(this))})},P.hideLegend=function(t){var e=this.config;e.legend_show&&_(t)&&(e.legend_show=!1,this.legend.style("visibility","hidden")),this.addHiddenLegendIds(t),this.legend.selectAll(this.selectorLegends(t)).style("opacity",0).style("visibility","hidden")},P.clearLegendItemTextBoxCache=function(){this.legendItemTextBox={}},P.updateLegend=function(t,e,n){function i(e,n,i){function r(t,e){e||(a=(g-k-f)/2)<E&&(a=(g-f)/2,k=0,R++),G[t]=R,V[R]=S.isLegendInset?10:a,D[t]=k,k+=f}var a,o,u=0===i,c=i===t.length-1,l=function(t,e){return S.legendItemTextBox[e]||(S.legendItemTextBox[e]=S.getTextRect(t.textContent,s.legendItem,t)),S.legendItemTextBox[e]}(e,n),h=l.width+L+(!c||S.isLegendRight||S.isLegendInset?C:0)+T.legend_padding,d=l.height+A,f=S.isLegendRight||S.isLegendInset?d:h,g=S.isLegendRight||S.isLegendInset?S.getLegendHeight():S.getLegendWidth();u&&(k=0,R=0,M=0,P=0),!T.legend_show||S.isLegendToShow(n)?(O[n]=h,I[n]=d,(!M||h>=M)&&(M=h),(!P||d>=P)&&(P=d),o=S.isLegendRight||S.isLegendInset?P:M,T.legend_equally?(Object.keys(O).forEach(function(t){O[t]=M}),Object.keys(I).forEach(function(t){I[t]=P}),(a=(g-o*t.length)/2)<E?(k=0,R=0,t.forEach(function(t){r(t)})):r(n,!0)):r(n)):O[n]=I[n]=G[n]=D[n]=0}var r,a,o,u,c,l,h,d,f,g,p,v,y,x,_,w,S=this,T=S.config,A=4,C=10,M=0,P=0,E=10,L=T.legend_item_tile_width+5,k=0,D={},O={},I={},V=[0],G={},R=0;t=t.filter(function(t){return!m(T.data_names[t])||null!==T.data_names[t]}),p=b(e=e||{},"withTransition",!0),v=b(e,"withTransitionForTransform",!0),S.isLegendInset&&(R=T.legend_inset_step?T.legend_inset_step:t.length,S.updateLegendStep(R)),S.isLegendRight?(r=function(t){return M*G[t]},u=function(t){return V[G[t]]+D[t]}):S.isLegendInset?(r=function(t){return M*G[t]+10},u=function(t){return V[G[t]]+D[t]}):(r=function(t){return V[G[t]]+D[t]},u=function(t){return P*G[t]}),a=function(t,e){return r(t,e)+4+T.legend_item_tile_width},c=function(t,e){return u(t,e)+9},o=function(t,e){return r(t,e)},l=function(t,e){return u(t,e)-5},h=function(t,e){return r(t,e)-2},d=function(t,e){return r(t,e)-2+T.legend_item_tile_width},f=function(t,e){return u(t,e)+4},(g=S.legend.selectAll("."+s.legendItem).data(t).enter().append("g").attr("class",function(t){return S.generateClass(s.legendItem,t)}).style("visibility",function(t){return S.isLegendToShow(t)?"visible":"hidden"}).style("cursor","pointer").on("click",function(t){T.legend_item_onclick?T.legend_item_onclick.call(S,t):S.d3.event.altKey?(S.api.hide(),S.api.show(t)):(S.api.toggle(t),S.isTargetToShow(t)?S.api.focus(t):S.api.revert())}).on("mouseover",function(t){T.legend_item_onmouseover?T.legend_item_onmouseover.call(S,t):(S.d3.select(this).classed(s.legendItemFocused,!0),!S.transiting&&S.isTargetToShow(t)&&S.api.focus(t))}).on("mouseout",function(t){T.legend_item_onmouseout?T.legend_item_onmouseout.call(S,t):(S.d3.select(this).classed(s.legendItemFocused,!1),S.api.revert())})).append("text").text(function(t){return m(T.data_names[t])?T.data_names[t]:t}).each(function(t,e){i(this,t,e)}).style("pointer-events","none").attr("x",S.isLegendRight||S.isLegendInset?a:-200).attr("y",S.isLegendRight||S.isLegendInset?-200:c),g.append("rect").attr("class",s.legendItemEvent).style("fill-opacity",0).attr("x",S.isLegendRight||S.isLegendInset?o:-200).attr("y",S.isLegendRight||S.isLegendInset?-200:l),g.append("line").attr("class",s.legendItemTile).style("stroke",S.color).style("pointer-events","none").attr("x1",S.isLegendRight||S.isLegendInset?h:-200).attr("y1",S.isLegendRight||S.isLegendInset?-200:f).attr("x2",S.isLegendRight||S.isLegendInset?d:-200).attr("y2",S.isLegendRight||S.isLegendInset?-200:f).attr("stroke-width",T.legend_item_tile_height),w=S.legend.select("."+s.legendBackground+" rect"),S.isLegendInset&&M>0&&0===w.size()&&(w=S.legend.insert("g","."+s.legendItem).attr("class",s.legendBackground).append("rect")),y=S.legend.selectAll("text").data(t).text(function(t){return m(T.data_names[t])?T.data_names[t]:t}).each(function(t,e){i(this,t,e)}),(p?y.transition():y).attr("x",a).attr("y",c),x=S.legend.selectAll("rect."+s.legendItemEvent).data(t),(p?x.transition():x).attr("width",function(t){return O[t]}).attr("height",function(t){return I[t]}).attr("x",o).attr("y",l),_=S.legend.selectAll("line."+s.legendItemTile).data(t),(p?_.transition():_).style("stroke",S.color).attr("x1",h).attr("y1",f).attr("x2",d).attr("y2",f),w&&(p?w.transition():w).attr("height",S.getLegendHeight()-12).attr("width",M*(R+1)+10),S.legend.selectAll("."+s.legendItem).classed(s.legendItemHidden,function(t){return!S.isTargetToShow(t)}),S.updateLegendItemWidth(M),S.updateLegendItemHeight(P),S.updateLegendStep(R),S.updateSizes(),S.updateScales(),S.updateSvgSize(),S.transformAll(v,n),S.legendHasRendered=!0},P.initRegion=function(){this.region=this.main.append("g").attr("clip-path",this.clipPath).attr("class",s.regions)},P.updateRegion=function(t){var e=this.config;this.region.style("visibility",this.hasArcType()?"hidden":"visible"),this.mainRegion=this.main.select("."+s.regions).selectAll("."+s.region).data(e.regions),this.mainRegion.enter().append("g").append("rect").style("fill-opacity",0),this.mainRegion.attr("class",this.classRegion.bind(this)),this.mainRegion.exit().transition().duration(t).style("opacity",0).remove()},P.redrawRegion=function(t){var e=this,n=e.mainRegion.selectAll("rect").each(function(){var t=e.d3.select(this.parentNode).datum();e.d3.select(this).datum(t)}),i=e.regionX.bind(e),r=e.regionY.bind(e),a=e.regionWidth.bind(e),o=e.regionHeight.bind(e);return[(t?n.transition():n).attr("x",i).attr("y",r).attr("width",a).attr("height",o).style("fill-opacity",function(t){return h(t.opacity)?t.opacity:.1})]},P.regionX=function(t){var e=this.config,n="y"===t.axis?this.y:this.y2;return"y"===t.axis||"y2"===t.axis?e.axis_rotated&&"start"in t?n(t.start):0:e.axis_rotated?0:"start"in t?this.x(this.isTimeSeries()?this.parseDate(t.start):t.start):0},P.regionY=function(t){var e=this.config,n="y"===t.axis?this.y:this.y2;return"y"===t.axis||"y2"===t.axis?e.axis_rotated?0:"end"in t?n(t.end):0:e.axis_rotated&&"start"in t?this.x(this.isTimeSeries()?this.parseDate(t.start):t.start):0},P.regionWidth=function(t){var e,n=this.config,i=this.regionX(t),r="y"===t.axis?this.y:this.y2;return(e="y"===t.axis||"y2"===t.axis?n.axis_rotated&&"end"in t?r(t.end):this.width:n.axis_rotated?this.width:"end"in t?this.x(this.isTimeSeries()?this.parseDate(t.end):t.end):this.width)<i?0:e-i},P.regionHeight=function(t){var e,n=this.config,i=this.regionY(t),r="y"===t.axis?this.y:this.y2;return(e="y"===t.axis||"y2"===t.axis?n.axis_rotated?this.height:"start"in t?r(t.start):this.height:n.axis_rotated&&"end"in t?this.x(this.isTimeSeries()?this.parseDate(t.end):t.end):this.height)<i?0:e-i},P.isRegionOnX=function(t){return!t.axis||"x"===t.axis},P.getScale=function(t,e,n){return(n?this.d3.time.scale():this.d3.scale.linear()).range([t,e])},P.getX=function(t,e,n,i){var r,a=this.getScale(t,e,this.isTimeSeries()),o=n?a.domain(n):a;this.isCategorized()?(i=i||function(){return 0},a=function(t,e){var n=o(t)+i(t);return e?n:Math.ceil(n)}):a=function(t,e){var n=o(t);return e?n:Math.ceil(n)};for(r in o)a[r]=o[r];return a.orgDomain=function(){return o.domain()},this.isCategorized()&&(a.domain=function(t){return arguments.length?(o.domain(t),a):(t=this.orgDomain(),[t[0],t[1]+1])}),a},P.getY=function(t,e,n){var i=this.getScale(t,e,this.isTimeSeriesY());return n&&i.domain(n),i},P.getYScale=function(t){return"y2"===this.axis.getId(t)?this.y2:this.y},P.getSubYScale=function(t){return"y2"===this.axis.getId(t)?this.subY2:this.subY},P.updateScales=function(){var t=this,e=t.config,n=!t.x;t.xMin=e.axis_rotated?1:0,t.xMax=e.axis_rotated?t.height:t.width,t.yMin=e.axis_rotated?0:t.height,t.yMax=e.axis_rotated?t.width:1,t.subXMin=t.xMin,t.subXMax=t.xMax,t.subYMin=e.axis_rotated?0:t.height2,t.subYMax=e.axis_rotated?t.width2:1,t.x=t.getX(t.xMin,t.xMax,n?void 0:t.x.orgDomain(),function(){return t.xAxis.tickOffset()}),t.y=t.getY(t.yMin,t.yMax,n?e.axis_y_default:t.y.domain()),t.y2=t.getY(t.yMin,t.yMax,n?e.axis_y2_default:t.y2.domain()),t.subX=t.getX(t.xMin,t.xMax,t.orgXDomain,function(e){return e%1?0:t.subXAxis.tickOffset()}),t.subY=t.getY(t.subYMin,t.subYMax,n?e.axis_y_default:t.subY.domain()),t.subY2=t.getY(t.subYMin,t.subYMax,n?e.axis_y2_default:t.subY2.domain()),t.xAxisTickFormat=t.axis.getXAxisTickFormat(),t.xAxisTickValues=t.axis.getXAxisTickValues(),t.yAxisTickValues=t.axis.getYAxisTickValues(),t.y2AxisTickValues=t.axis.getY2AxisTickValues(),t.xAxis=t.axis.getXAxis(t.x,t.xOrient,t.xAxisTickFormat,t.xAxisTickValues,e.axis_x_tick_outer),t.subXAxis=t.axis.getXAxis(t.subX,t.subXOrient,t.xAxisTickFormat,t.xAxisTickValues,e.axis_x_tick_outer),t.yAxis=t.axis.getYAxis(t.y,t.yOrient,e.axis_y_tick_format,t.yAxisTickValues,e.axis_y_tick_outer),t.y2Axis=t.axis.getYAxis(t.y2,t.y2Orient,e.axis_y2_tick_format,t.y2AxisTickValues,e.axis_y2_tick_outer),n||(t.brush&&t.brush.scale(t.subX),e.zoom_enabled&&t.zoom.scale(t.x)),t.updateArc&&t.updateArc()},P.selectPoint=function(t,e,n){var i=this,r=i.config,a=(r.axis_rotated?i.circleY:i.circleX).bind(i),o=(r.axis_rotated?i.circleX:i.circleY).bind(i),u=i.pointSelectR.bind(i);r.data_onselected.call(i.api,e,t.node()),i.main.select("."+s.selectedCircles+i.getTargetSelectorSuffix(e.id)).selectAll("."+s.selectedCircle+"-"+n).data([e]).enter().append("circle").attr("class",function(){return i.generateClass(s.selectedCircle,n)}).attr("cx",a).attr("cy",o).attr("stroke",function(){return i.color(e)}).attr("r",function(t){return 1.4*i.pointSelectR(t)}).transition().duration(100).attr("r",u)},P.unselectPoint=function(t,e,n){this.config.data_onunselected.call(this.api,e,t.node()),this.main.select("."+s.selectedCircles+this.getTargetSelectorSuffix(e.id)).selectAll("."+s.selectedCircle+"-"+n).transition().duration(100).attr("r",0).remove()},P.togglePoint=function(t,e,n,i){t?this.selectPoint(e,n,i):this.unselectPoint(e,n,i)},P.selectPath=function(t,e){var n=this;n.config.data_onselected.call(n,e,t.node()),n.config.interaction_brighten&&t.transition().duration(100).style("fill",function(){return n.d3.rgb(n.color(e)).brighter(.75)})},P.unselectPath=function(t,e){var n=this;n.config.data_onunselected.call(n,e,t.node()),n.config.interaction_brighten&&t.transition().duration(100).style("fill",function(){return n.color(e)})},P.togglePath=function(t,e,n,i){t?this.selectPath(e,n,i):this.unselectPath(e,n,i)},P.getToggle=function(t,e){var n;return"circle"===t.nodeName?n=this.isStepType(e)?function(){}:this.togglePoint:"path"===t.nodeName&&(n=this.togglePath),n},P.toggleShape=function(t,e,n){var i=this.d3,r=this.config,a=i.select(t),o=a.classed(s.SELECTED),u=this.getToggle(t,e).bind(this);r.data_selection_enabled&&r.data_selection_isselectable(e)&&(r.data_selection_multiple||this.main.selectAll("."+s.shapes+(r.data_selection_grouped?this.getTargetSelectorSuffix(e.id):"")).selectAll("."+s.shape).each(function(t,e){var n=i.select(this);n.classed(s.SELECTED)&&u(!1,n.classed(s.SELECTED,!1),t,e)}),a.classed(s.SELECTED,!o),u(!o,a,e,n))},P.initBar=function(){this.main.select("."+s.chart).append("g").attr("class",s.chartBars)},P.updateTargetsForBar=function(t){var e=this.config,n=this.classChartBar.bind(this),i=this.classBars.bind(this),r=this.classFocus.bind(this);this.main.select("."+s.chartBars).selectAll("."+s.chartBar).data(t).attr("class",function(t){return n(t)+r(t)}).enter().append("g").attr("class",n).style("pointer-events","none").append("g").attr("class",i).style("cursor",function(t){return e.data_selection_isselectable(t)?"pointer":null})},P.updateBar=function(t){var e=this,n=e.barData.bind(e),i=e.classBar.bind(e),r=e.initialOpacity.bind(e),a=function(t){return e.color(t.id)};e.mainBar=e.main.selectAll("."+s.bars).selectAll("."+s.bar).data(n),e.mainBar.enter().append("path").attr("class",i).style("stroke",a).style("fill",a),e.mainBar.style("opacity",r),e.mainBar.exit().transition().duration(t).remove()},P.redrawBar=function(t,e){return[(e?this.mainBar.transition(Math.random().toString()):this.mainBar).attr("d",t).style("stroke",this.color).style("fill",this.color).style("opacity",1)]},P.getBarW=function(t,e){var n=this.config,i="number"==typeof n.bar_width?n.bar_width:e?t.tickInterval()*n.bar_width_ratio/e:0;return n.bar_width_max&&i>n.bar_width_max?n.bar_width_max:i},P.getBars=function(t,e){return(e?this.main.selectAll("."+s.bars+this.getTargetSelectorSuffix(e)):this.main).selectAll("."+s.bar+(h(t)?"-"+t:""))},P.expandBars=function(t,e,n){n&&this.unexpandBars(),this.getBars(t,e).classed(s.EXPANDED,!0)},P.unexpandBars=function(t){this.getBars(t).classed(s.EXPANDED,!1)},P.generateDrawBar=function(t,e){var n=this.config,i=this.generateGetBarPoints(t,e);return function(t,e){var r=i(t,e),a=n.axis_rotated?1:0,o=n.axis_rotated?0:1;return"M "+r[0][a]+","+r[0][o]+" L"+r[1][a]+","+r[1][o]+" L"+r[2][a]+","+r[2][o]+" L"+r[3][a]+","+r[3][o]+" z"}},P.generateGetBarPoints=function(t,e){var n=this,i=e?n.subXAxis:n.xAxis,r=t.__max__+1,a=n.getBarW(i,r),o=n.getShapeX(a,r,t,!!e),s=n.getShapeY(!!e),u=n.getShapeOffset(n.isBarType,t,!!e),c=a*(n.config.bar_space/2),l=e?n.getSubYScale:n.getYScale;return function(t,e){var i=l.call(n,t.id)(0),r=u(t,e)||i,h=o(t),d=s(t);return n.config.axis_rotated&&(0<t.value&&d<i||t.value<0&&i<d)&&(d=i),[[h+c,r],[h+c,d-(i-r)],[h+a-c,d-(i-r)],[h+a-c,r]]}},P.isWithinBar=function(t){var e=this.d3.mouse(t),n=t.getBoundingClientRect(),i=t.pathSegList.getItem(0),r=t.pathSegList.getItem(1),a=Math.min(i.x,r.x),o=Math.min(i.y,r.y),s=a+n.width+2,u=o+n.height+2,c=o-2;return a-2<e[0]&&e[0]<s&&c<e[1]&&e[1]<u},P.getShapeIndices=function(t){var e,n,i=this.config,r={},a=0;return this.filterTargetsToShow(this.data.targets.filter(t,this)).forEach(function(t){for(e=0;e<i.data_groups.length;e++)if(!(i.data_groups[e].indexOf(t.id)<0))for(n=0;n<i.data_groups[e].length;n++)if(i.data_groups[e][n]in r){r[t.id]=r[i.data_groups[e][n]];break}p(r[t.id])&&(r[t.id]=a++)}),r.__max__=a-1,r},P.getShapeX=function(t,e,n,i){var r=i?this.subX:this.x;return function(i){var a=i.id in n?n[i.id]:0;return i.x||0===i.x?r(i.x)-t*(e/2-a):0}},P.getShapeY=function(t){var e=this;return function(n){return(t?e.getSubYScale(n.id):e.getYScale(n.id))(n.value)}},P.getShapeOffset=function(t,e,n){var i=this,r=i.orderTargets(i.filterTargetsToShow(i.data.targets.filter(t,i))),a=r.map(function(t){return t.id});return function(t,o){var s=n?i.getSubYScale(t.id):i.getYScale(t.id),u=s(0),c=u;return r.forEach(function(n){var r=i.isStepType(t)?i.convertValuesToStep(n.values):n.values;n.id!==t.id&&e[n.id]===e[t.id]&&a.indexOf(n.id)<a.indexOf(t.id)&&(void 0!==r[o]&&+r[o].x==+t.x||(o=-1,r.forEach(function(e,n){e.x===t.x&&(o=n)})),o in r&&r[o].value*t.value>=0&&(c+=s(r[o].value)-u))}),c}},P.isWithinShape=function(t,e){var n,i=this.d3.select(t);return this.isTargetToShow(e.id)?"circle"===t.nodeName?n=this.isStepType(e)?this.isWithinStep(t,this.getYScale(e.id)(e.value)):this.isWithinCircle(t,1.5*this.pointSelectR(e)):"path"===t.nodeName&&(n=!i.classed(s.bar)||this.isWithinBar(t)):n=!1,n},P.getInterpolate=function(t){var e=this.isInterpolationType(this.config.spline_interpolation_type)?this.config.spline_interpolation_type:"cardinal";return this.isSplineType(t)?e:this.isStepType(t)?this.config.line_step_type:"linear"},P.initLine=function(){this.main.select("."+s.chart).append("g").attr("class",s.chartLines)},P.updateTargetsForLine=function(t){var e,n=this,i=n.config,r=n.classChartLine.bind(n),a=n.classLines.bind(n),o=n.classAreas.bind(n),u=n.classCircles.bind(n),c=n.classFocus.bind(n);(e=n.main.select("."+s.chartLines).selectAll("."+s.chartLine).data(t).attr("class",function(t){return r(t)+c(t)}).enter().append("g").attr("class",r).style("opacity",0).style("pointer-events","none")).append("g").attr("class",a),e.append("g").attr("class",o),e.append("g").attr("class",function(t){return n.generateClass(s.selectedCircles,t.id)}),e.append("g").attr("class",u).style("cursor",function(t){return i.data_selection_isselectable(t)?"pointer":null}),t.forEach(function(t){n.main.selectAll("."+s.selectedCircles+n.getTargetSelectorSuffix(t.id)).selectAll("."+s.selectedCircle).each(function(e){e.value=t.values[e.index].value})})},P.updateLine=function(t){var e=this;e.mainLine=e.main.selectAll("."+s.lines).selectAll("."+s.line).data(e.lineData.bind(e)),e.mainLine.enter().append("path").attr("class",e.classLine.bind(e)).style("stroke",e.color),e.mainLine.style("opacity",e.initialOpacity.bind(e)).style("shape-rendering",function(t){return e.isStepType(t)?"crispEdges":""}).attr("transform",null),e.mainLine.exit().transition().duration(t).style("opacity",0).remove()},P.redrawLine=function(t,e){return[(e?this.mainLine.transition(Math.random().toString()):this.mainLine).attr("d",t).style("stroke",this.color).style("opacity",1)]},P.generateDrawLine=function(t,e){var n=this,i=n.config,r=n.d3.svg.line(),a=n.generateGetLinePoints(t,e),o=e?n.getSubYScale:n.getYScale,s=function(t){return(e?n.subxx:n.xx).call(n,t)},u=function(t,e){return i.data_groups.length>0?a(t,e)[0][1]:o.call(n,t.id)(t.value)};return r=i.axis_rotated?r.x(u).y(s):r.x(s).y(u),i.line_connectNull||(r=r.defined(function(t){return null!=t.value})),function(t){var a,s=i.line_connectNull?n.filterRemoveNull(t.values):t.values,u=e?n.x:n.subX,c=o.call(n,t.id),l=0,h=0;return n.isLineType(t)?i.data_regions[t.id]?a=n.lineWithRegions(s,u,c,i.data_regions[t.id]):(n.isStepType(t)&&(s=n.convertValuesToStep(s)),a=r.interpolate(n.getInterpolate(t))(s)):(s[0]&&(l=u(s[0].x),h=c(s[0].value)),a=i.axis_rotated?"M "+h+" "+l:"M "+l+" "+h),a||"M 0 0"}},P.generateGetLinePoints=function(t,e){var n=this,i=n.config,r=t.__max__+1,a=n.getShapeX(0,r,t,!!e),o=n.getShapeY(!!e),s=n.getShapeOffset(n.isLineType,t,!!e),u=e?n.getSubYScale:n.getYScale;return function(t,e){var r=u.call(n,t.id)(0),c=s(t,e)||r,l=a(t),h=o(t);return i.axis_rotated&&(0<t.value&&h<r||t.value<0&&r<h)&&(h=r),[[l,h-(r-c)],[l,h-(r-c)],[l,h-(r-c)],[l,h-(r-c)]]}},P.lineWithRegions=function(t,e,n,i){function r(t,e){var n;for(n=0;n<e.length;n++)if(e[n].start<t&&t<=e[n].end)return!0;return!1}function a(t){return"M"+t[0][0]+" "+t[0][1]+" "+t[1][0]+" "+t[1][1]}var o,s,u,c,l,h,d,f,g,v,y,x=this.config,_="M",w=this.isCategorized()?.5:0,b=[];if(m(i))for(o=0;o<i.length;o++)b[o]={},p(i[o].start)?b[o].start=t[0].x:b[o].start=this.isTimeSeries()?this.parseDate(i[o].start):i[o].start,p(i[o].end)?b[o].end=t[t.length-1].x:b[o].end=this.isTimeSeries()?this.parseDate(i[o].end):i[o].end;for(v=x.axis_rotated?function(t){return n(t.value)}:function(t){return e(t.x)},y=x.axis_rotated?function(t){return e(t.x)}:function(t){return n(t.value)},u=this.isTimeSeries()?function(t,i,r,o){var s,u=t.x.getTime(),c=i.x-t.x,h=new Date(u+c*r),d=new Date(u+c*(r+o));return s=x.axis_rotated?[[n(l(r)),e(h)],[n(l(r+o)),e(d)]]:[[e(h),n(l(r))],[e(d),n(l(r+o))]],a(s)}:function(t,i,r,o){var s;return s=x.axis_rotated?[[n(l(r),!0),e(c(r))],[n(l(r+o),!0),e(c(r+o))]]:[[e(c(r),!0),n(l(r))],[e(c(r+o),!0),n(l(r+o))]],a(s)},o=0;o<t.length;o++){if(p(b)||!r(t[o].x,b))_+=" "+v(t[o])+" "+y(t[o]);else for(c=this.getScale(t[o-1].x+w,t[o].x+w,this.isTimeSeries()),l=this.getScale(t[o-1].value,t[o].value),h=e(t[o].x)-e(t[o-1].x),d=n(t[o].value)-n(t[o-1].value),g=2*(f=2/Math.sqrt(Math.pow(h,2)+Math.pow(d,2))),s=f;s<=1;s+=g)_+=u(t[o-1],t[o],s,f);t[o].x}return _},P.updateArea=function(t){var e=this,n=e.d3;e.mainArea=e.main.selectAll("."+s.areas).selectAll("."+s.area).data(e.lineData.bind(e)),e.mainArea.enter().append("path").attr("class",e.classArea.bind(e)).style("fill",e.color).style("opacity",function(){return e.orgAreaOpacity=+n.select(this).style("opacity"),0}),e.mainArea.style("opacity",e.orgAreaOpacity),e.mainArea.exit().transition().duration(t).style("opacity",0).remove()},P.redrawArea=function(t,e){return[(e?this.mainArea.transition(Math.random().toString()):this.mainArea).attr("d",t).style("fill",this.color).style("opacity",this.orgAreaOpacity)]},P.generateDrawArea=function(t,e){var n=this,i=n.config,r=n.d3.svg.area(),a=n.generateGetAreaPoints(t,e),o=e?n.getSubYScale:n.getYScale,s=function(t){return(e?n.subxx:n.xx).call(n,t)},u=function(t,e){return i.data_groups.length>0?a(t,e)[0][1]:o.call(n,t.id)(n.getAreaBaseValue(t.id))},c=function(t,e){return i.data_groups.length>0?a(t,e)[1][1]:o.call(n,t.id)(t.value)};return r=i.axis_rotated?r.x0(u).x1(c).y(s):r.x(s).y0(i.area_above?0:u).y1(c),i.line_connectNull||(r=r.defined(function(t){return null!==t.value})),function(t){var e,a=i.line_connectNull?n.filterRemoveNull(t.values):t.values,o=0,s=0;return n.isAreaType(t)?(n.isStepType(t)&&(a=n.convertValuesToStep(a)),e=r.interpolate(n.getInterpolate(t))(a)):(a[0]&&(o=n.x(a[0].x),s=n.getYScale(t.id)(a[0].value)),e=i.axis_rotated?"M "+s+" "+o:"M "+o+" "+s),e||"M 0 0"}},P.getAreaBaseValue=function(){return 0},P.generateGetAreaPoints=function(t,e){var n=this,i=n.config,r=t.__max__+1,a=n.getShapeX(0,r,t,!!e),o=n.getShapeY(!!e),s=n.getShapeOffset(n.isAreaType,t,!!e),u=e?n.getSubYScale:n.getYScale;return function(t,e){var r=u.call(n,t.id)(0),c=s(t,e)||r,l=a(t),h=o(t);return i.axis_rotated&&(0<t.value&&h<r||t.value<0&&r<h)&&(h=r),[[l,c],[l,h-(r-c)],[l,h-(r-c)],[l,c]]}},P.updateCircle=function(){this.mainCircle=this.main.selectAll("."+s.circles).selectAll("."+s.circle).data(this.lineOrScatterData.bind(this)),this.mainCircle.enter().append("circle").attr("class",this.classCircle.bind(this)).attr("r",this.pointR.bind(this)).style("fill",this.color),this.mainCircle.style("opacity",this.initialOpacityForCircle.bind(this)),this.mainCircle.exit().remove()},P.redrawCircle=function(t,e,n){var i=this.main.selectAll("."+s.selectedCircle);return[(n?this.mainCircle.transition(Math.random().toString()):this.mainCircle).style("opacity",this.opacityForCircle.bind(this)).style("fill",this.color).attr("cx",t).attr("cy",e),(n?i.transition(Math.random().toString()):i).attr("cx",t).attr("cy",e)]},P.circleX=function(t){return t.x||0===t.x?this.x(t.x):null},P.updateCircleY=function(){var t,e,n=this;n.config.data_groups.length>0?(t=n.getShapeIndices(n.isLineType),e=n.generateGetLinePoints(t),n.circleY=function(t,n){return e(t,n)[0][1]}):n.circleY=function(t){return n.getYScale(t.id)(t.value)}},P.getCircles=function(t,e){return(e?this.main.selectAll("."+s.circles+this.getTargetSelectorSuffix(e)):this.main).selectAll("."+s.circle+(h(t)?"-"+t:""))},P.expandCircles=function(t,e,n){var i=this.pointExpandedR.bind(this);n&&this.unexpandCircles(),this.getCircles(t,e).classed(s.EXPANDED,!0).attr("r",i)},P.unexpandCircles=function(t){var e=this,n=e.pointR.bind(e);e.getCircles(t).filter(function(){return e.d3.select(this).classed(s.EXPANDED)}).classed(s.EXPANDED,!1).attr("r",n)},P.pointR=function(t){var e=this.config;return this.isStepType(t)?0:d(e.point_r)?e.point_r(t):e.point_r},P.pointExpandedR=function(t){var e=this.config;return e.point_focus_expand_enabled?d(e.point_focus_expand_r)?e.point_focus_expand_r(t):e.point_focus_expand_r?e.point_focus_expand_r:1.75*this.pointR(t):this.pointR(t)},P.pointSelectR=function(t){var e=this.config;return d(e.point_select_r)?e.point_select_r(t):e.point_select_r?e.point_select_r:4*this.pointR(t)},P.isWithinCircle=function(t,e){var n=this.d3,i=n.mouse(t),r=n.select(t),a=+r.attr("cx"),o=+r.attr("cy");return Math.sqrt(Math.pow(a-i[0],2)+Math.pow(o-i[1],2))<e},P.isWithinStep=function(t,e){return Math.abs(e-this.d3.mouse(t)[1])<30},P.getCurrentWidth=function(){var t=this.config;return t.size_width?t.size_width:this.getParentWidth()},P.getCurrentHeight=function(){var t=this.config,e=t.size_height?t.size_height:this.getParentHeight();return e>0?e:320/(this.hasType("gauge")&&!t.gauge_fullCircle?2:1)},P.getCurrentPaddingTop=function(){var t=this.config,e=h(t.padding_top)?t.padding_top:0;return this.title&&this.title.node()&&(e+=this.getTitlePadding()),e},P.getCurrentPaddingBottom=function(){var t=this.config;return h(t.padding_bottom)?t.padding_bottom:0},P.getCurrentPaddingLeft=function(t){var e=this.config;return h(e.padding_left)?e.padding_left:e.axis_rotated?e.axis_x_show?Math.max(v(this.getAxisWidthByAxisId("x",t)),40):1:!e.axis_y_show||e.axis_y_inner?this.axis.getYAxisLabelPosition().isOuter?30:1:v(this.getAxisWidthByAxisId("y",t))},P.getCurrentPaddingRight=function(){var t=this.config,e=this.isLegendRight?this.getLegendWidth()+20:0;return h(t.padding_right)?t.padding_right+1:t.axis_rotated?10+e:!t.axis_y2_show||t.axis_y2_inner?2+e+(this.axis.getY2AxisLabelPosition().isOuter?20:0):v(this.getAxisWidthByAxisId("y2"))+e},P.getParentRectValue=function(t){for(var e,n=this.selectChart.node();n&&"BODY"!==n.tagName;){try{e=n.getBoundingClientRect()[t]}catch(i){"width"===t&&(e=n.offsetWidth)}if(e)break;n=n.parentNode}return e},P.getParentWidth=function(){return this.getParentRectValue("width")},P.getParentHeight=function(){var t=this.selectChart.style("height");return t.indexOf("px")>0?+t.replace("px",""):0},P.getSvgLeft=function(t){var e=this.config,n=e.axis_rotated||!e.axis_rotated&&!e.axis_y_inner,i=e.axis_rotated?s.axisX:s.axisY,r=this.main.select("."+i).node(),a=r&&n?r.getBoundingClientRect():{right:0},o=this.selectChart.node().getBoundingClientRect(),u=this.hasArcType(),c=a.right-o.left-(u?0:this.getCurrentPaddingLeft(t));return c>0?c:0},P.getAxisWidthByAxisId=function(t,e){var n=this.axis.getLabelPositionById(t);return this.axis.getMaxTickWidth(t,e)+(n.isInner?20:40)},P.getHorizontalAxisHeight=function(t){var e=this.config,n=30;return"x"!==t||e.axis_x_show?"x"===t&&e.axis_x_height?e.axis_x_height:"y"!==t||e.axis_y_show?"y2"!==t||e.axis_y2_show?("x"===t&&!e.axis_rotated&&e.axis_x_tick_rotate&&(n=30+this.axis.getMaxTickWidth(t)*Math.cos(Math.PI*(90-e.axis_x_tick_rotate)/180)),"y"===t&&e.axis_rotated&&e.axis_y_tick_rotate&&(n=30+this.axis.getMaxTickWidth(t)*Math.cos(Math.PI*(90-e.axis_y_tick_rotate)/180)),n+(this.axis.getLabelPositionById(t).isInner?0:10)+("y2"===t?-10:0)):this.rotated_padding_top:!e.legend_show||this.isLegendRight||this.isLegendInset?1:10:8},P.getEventRectWidth=function(){return Math.max(0,this.xAxis.tickInterval())},P.initBrush=function(){var t=this,e=t.d3;t.brush=e.svg.brush().on("brush",function(){t.redrawForBrush()}),t.brush.update=function(){return t.context&&t.context.select("."+s.brush).call(this),this},t.brush.scale=function(e){return t.config.axis_rotated?this.y(e):this.x(e)}},P.initSubchart=function(){var t=this.config,e=this.context=this.svg.append("g").attr("transform",this.getTranslate("context")),n=t.subchart_show?"visible":"hidden";e.style("visibility",n),e.append("g").attr("clip-path",this.clipPathForSubchart).attr("class",s.chart),e.select("."+s.chart).append("g").attr("class",s.chartBars),e.select("."+s.chart).append("g").attr("class",s.chartLines),e.append("g").attr("clip-path",this.clipPath).attr("class",s.brush).call(this.brush),this.axes.subx=e.append("g").attr("class",s.axisX).attr("transform",this.getTranslate("subx")).attr("clip-path",t.axis_rotated?"":this.clipPathForXAxis).style("visibility",t.subchart_axis_x_show?n:"hidden")},P.updateTargetsForSubchart=function(t){var e,n=this.context,i=this.config,r=this.classChartBar.bind(this),a=this.classBars.bind(this),o=this.classChartLine.bind(this),u=this.classLines.bind(this),c=this.classAreas.bind(this);i.subchart_show&&(n.select("."+s.chartBars).selectAll("."+s.chartBar).data(t).attr("class",r).enter().append("g").style("opacity",0).attr("class",r).append("g").attr("class",a),(e=n.select("."+s.chartLines).selectAll("."+s.chartLine).data(t).attr("class",o).enter().append("g").style("opacity",0).attr("class",o)).append("g").attr("class",u),e.append("g").attr("class",c),n.selectAll("."+s.brush+" rect").attr(i.axis_rotated?"width":"height",i.axis_rotated?this.width2:this.height2))},P.updateBarForSubchart=function(t){this.contextBar=this.context.selectAll("."+s.bars).selectAll("."+s.bar).data(this.barData.bind(this)),this.contextBar.enter().append("path").attr("class",this.classBar.bind(this)).style("stroke","none").style("fill",this.color),this.contextBar.style("opacity",this.initialOpacity.bind(this)),this.contextBar.exit().transition().duration(t).style("opacity",0).remove()},P.redrawBarForSubchart=function(t,e,n){(e?this.contextBar.transition(Math.random().toString()).duration(n):this.contextBar).attr("d",t).style("opacity",1)},P.updateLineForSubchart=function(t){this.contextLine=this.context.selectAll("."+s.lines).selectAll("."+s.line).data(this.lineData.bind(this)),this.contextLine.enter().append("path").attr("class",this.classLine.bind(this)).style("stroke",this.color),this.contextLine.style("opacity",this.initialOpacity.bind(this)),this.contextLine.exit().transition().duration(t).style("opacity",0).remove()},P.redrawLineForSubchart=function(t,e,n){(e?this.contextLine.transition(Math.random().toString()).duration(n):this.contextLine).attr("d",t).style("opacity",1)},P.updateAreaForSubchart=function(t){var e=this,n=e.d3;e.contextArea=e.context.selectAll("."+s.areas).selectAll("."+s.area).data(e.lineData.bind(e)),e.contextArea.enter().append("path").attr("class",e.classArea.bind(e)).style("fill",e.color).style("opacity",function(){return e.orgAreaOpacity=+n.select(this).style("opacity"),0}),e.contextArea.style("opacity",0),e.contextArea.exit().transition().duration(t).style("opacity",0).remove()},P.redrawAreaForSubchart=function(t,e,n){(e?this.contextArea.transition(Math.random().toString()).duration(n):this.contextArea).attr("d",t).style("fill",this.color).style("opacity",this.orgAreaOpacity)},P.redrawSubchart=function(t,e,n,i,r,a,o){var s,u,c,l=this.d3,h=this.config;this.context.style("visibility",h.subchart_show?"visible":"hidden"),h.subchart_show&&(l.event&&"zoom"===l.event.type&&this.brush.extent(this.x.orgDomain()).update(),t&&(this.brush.empty()||this.brush.extent(this.x.orgDomain()).update(),s=this.generateDrawArea(r,!0),u=this.generateDrawBar(a,!0),c=this.generateDrawLine(o,!0),this.updateBarForSubchart(n),this.updateLineForSubchart(n),this.updateAreaForSubchart(n),this.redrawBarForSubchart(u,n,n),this.redrawLineForSubchart(c,n,n),this.redrawAreaForSubchart(s,n,n)))},P.redrawForBrush=function(){var t=this.x;this.redraw({withTransition:!1,withY:this.config.zoom_rescale,withSubchart:!1,withUpdateXDomain:!0,withDimension:!1}),this.config.subchart_onbrush.call(this.api,t.orgDomain())},P.transformContext=function(t,e){var n;e&&e.axisSubX?n=e.axisSubX:(n=this.context.select("."+s.axisX),t&&(n=n.transition())),this.context.attr("transform",this.getTranslate("context")),n.attr("transform",this.getTranslate("subx"))},P.getDefaultExtent=function(){var t=this.config,e=d(t.axis_x_extent)?t.axis_x_extent(this.getXDomain(this.data.targets)):t.axis_x_extent;return this.isTimeSeries()&&(e=[this.parseDate(e[0]),this.parseDate(e[1])]),e},P.initText=function(){this.main.select("."+s.chart).append("g").attr("class",s.chartTexts),this.mainText=this.d3.selectAll([])},P.updateTargetsForText=function(t){var e=this.classChartText.bind(this),n=this.classTexts.bind(this),i=this.classFocus.bind(this);this.main.select("."+s.chartTexts).selectAll("."+s.chartText).data(t).attr("class",function(t){return e(t)+i(t)}).enter().append("g").attr("class",e).style("opacity",0).style("pointer-events","none").append("g").attr("class",n)},P.updateText=function(t){var e=this,n=e.config,i=e.barOrLineData.bind(e),r=e.classText.bind(e);e.mainText=e.main.selectAll("."+s.texts).selectAll("."+s.text).data(i),e.mainText.enter().append("text").attr("class",r).attr("text-anchor",function(t){return n.axis_rotated?t.value<0?"end":"start":"middle"}).style("stroke","none").style("fill",function(t){return e.color(t)}).style("fill-opacity",0),e.mainText.text(function(t,n,i){return e.dataLabelFormat(t.id)(t.value,t.id,n,i)}),e.mainText.exit().transition().duration(t).style("fill-opacity",0).remove()},P.redrawText=function(t,e,n,i){return[(i?this.mainText.transition():this.mainText).attr("x",t).attr("y",e).style("fill",this.color).style("fill-opacity",n?0:this.opacityForText.bind(this))]},P.getTextRect=function(t,e,n){var i,r=this.d3.select("body").append("div").classed("c3",!0),a=r.append("svg").style("visibility","hidden").style("position","fixed").style("top",0).style("left",0),o=this.d3.select(n).style("font");return a.selectAll(".dummy").data([t]).enter().append("text").classed(e||"",!0).style("font",o).text(t).each(function(){i=this.getBoundingClientRect()}),r.remove(),i},P.generateXYForText=function(t,e,n,i){var r=this,a=r.generateGetAreaPoints(t,!1),o=r.generateGetBarPoints(e,!1),s=r.generateGetLinePoints(n,!1),u=i?r.getXForText:r.getYForText;return function(t,e){var n=r.isAreaType(t)?a:r.isBarType(t)?o:s;return u.call(r,n(t,e),t,this)}},P.getXForText=function(t,e,n){var i,r,a=n.getBoundingClientRect();return this.config.axis_rotated?(r=this.isBarType(e)?4:6,i=t[2][1]+r*(e.value<0?-1:1)):i=this.hasType("bar")?(t[2][0]+t[0][0])/2:t[0][0],null===e.value&&(i>this.width?i=this.width-a.width:i<0&&(i=4)),i},P.getYForText=function(t,e,n){var i,r=n.getBoundingClientRect();return this.config.axis_rotated?i=(t[0][0]+t[2][0]+.6*r.height)/2:(i=t[2][1],e.value<0||0===e.value&&!this.hasPositiveValue?(i+=r.height,this.isBarType(e)&&this.isSafari()?i-=3:!this.isBarType(e)&&this.isChrome()&&(i+=3)):i+=this.isBarType(e)?-3:-6),null!==e.value||this.config.axis_rotated||(i<r.height?i=r.height:i>this.height&&(i=this.height-4)),i},P.initTitle=function(){this.title=this.svg.append("text").text(this.config.title_text).attr("class",this.CLASS.title)},P.redrawTitle=function(){this.title.attr("x",this.xForTitle.bind(this)).attr("y",this.yForTitle.bind(this))},P.xForTitle=function(){var t=this.config,e=t.title_position||"left";return e.indexOf("right")>=0?this.currentWidth-this.getTextRect(this.title.node().textContent,this.CLASS.title,this.title.node()).width-t.title_padding.right:e.indexOf("center")>=0?(this.currentWidth-this.getTextRect(this.title.node().textContent,this.CLASS.title,this.title.node()).width)/2:t.title_padding.left},P.yForTitle=function(){return this.config.title_padding.top+this.getTextRect(this.title.node().textContent,this.CLASS.title,this.title.node()).height},P.getTitlePadding=function(){return this.yForTitle()+this.config.title_padding.bottom},P.initTooltip=function(){var t,e=this,n=e.config;if(e.tooltip=e.selectChart.style("position","relative").append("div").attr("class",s.tooltipContainer).style("position","absolute").style("pointer-events","none").style("display","none"),n.tooltip_init_show){if(e.isTimeSeries()&&g(n.tooltip_init_x)){for(n.tooltip_init_x=e.parseDate(n.tooltip_init_x),t=0;t<e.data.targets[0].values.length&&e.data.targets[0].values[t].x-n.tooltip_init_x!=0;t++);n.tooltip_init_x=t}e.tooltip.html(n.tooltip_contents.call(e,e.data.targets.map(function(t){return e.addName(t.values[n.tooltip_init_x])}),e.axis.getXAxisTickFormat(),e.getYFormat(e.hasArcType()),e.color)),e.tooltip.style("top",n.tooltip_init_position.top).style("left",n.tooltip_init_position.left).style("display","block")}},P.getTooltipSortFunction=function(){var t=this.config;if(0!==t.data_groups.length&&void 0===t.tooltip_order){var e=this.orderTargets(this.data.targets).map(function(t){return t.id});return(this.isOrderAsc()||this.isOrderDesc())&&(e=e.reverse()),function(t,n){return e.indexOf(t.id)-e.indexOf(n.id)}}var n=t.tooltip_order;void 0===n&&(n=t.data_order);var i=function(t){return t?t.value:null};if(g(n)&&"asc"===n.toLowerCase())return function(t,e){return i(t)-i(e)};if(g(n)&&"desc"===n.toLowerCase())return function(t,e){return i(e)-i(t)};if(d(n)){var r=n;return void 0===t.tooltip_order&&(r=function(t,e){return n(t?{id:t.id,values:[t]}:null,e?{id:e.id,values:[e]}:null)}),r}return f(n)?function(t,e){return n.indexOf(t.id)-n.indexOf(e.id)}:void 0},P.getTooltipContent=function(t,e,n,i){var r,a,o,s,u,c,l=this.config,h=l.tooltip_format_title||e,d=l.tooltip_format_name||function(t){return t},f=l.tooltip_format_value||n,g=this.getTooltipSortFunction();for(g&&t.sort(g),a=0;a<t.length;a++)if(t[a]&&(t[a].value||0===t[a].value)&&(r||(o=T(h?h(t[a].x):t[a].x),r="<table class='"+this.CLASS.tooltip+"'>"+(o||0===o?"<tr><th colspan='2'>"+o+"</th></tr>":"")),void 0!==(s=T(f(t[a].value,t[a].ratio,t[a].id,t[a].index,t))))){if(null===t[a].name)continue;u=T(d(t[a].name,t[a].ratio,t[a].id,t[a].index)),c=this.levelColor?this.levelColor(t[a].value):i(t[a].id),r+="<tr class='"+this.CLASS.tooltipName+"-"+this.getTargetSelectorSuffix(t[a].id)+"'>",r+="<td class='name'><span style='background-color:"+c+"'></span>"+u+"</td>",r+="<td class='value'>"+s+"</td>",r+="</tr>"}return r+"</table>"},P.tooltipPosition=function(t,e,n,i){var r,a,o,s,u,c=this.config,l=this.d3,h=this.hasArcType(),d=l.mouse(i);return h?(a=(this.width-(this.isLegendRight?this.getLegendWidth():0))/2+d[0],s=this.height/2+d[1]+20):(r=this.getSvgLeft(!0),c.axis_rotated?(o=(a=r+d[0]+100)+e,u=this.currentWidth-this.getCurrentPaddingRight(),s=this.x(t[0].x)+20):(o=(a=r+this.getCurrentPaddingLeft(!0)+this.x(t[0].x)+20)+e,u=r+this.currentWidth-this.getCurrentPaddingRight(),s=d[1]+15),o>u&&(a-=o-u+20),s+n>this.currentHeight&&(s-=n+30)),s<0&&(s=0),{top:s,left:a}},P.showTooltip=function(t,e){var n,i,r,a=this.config,o=this.hasArcType(),s=t.filter(function(t){return t&&h(t.value)}),u=a.tooltip_position||P.tooltipPosition;0!==s.length&&a.tooltip_show&&(this.tooltip.html(a.tooltip_contents.call(this,t,this.axis.getXAxisTickFormat(),this.getYFormat(o),this.color)).style("display","block"),n=this.tooltip.property("offsetWidth"),i=this.tooltip.property("offsetHeight"),r=u.call(this,s,n,i,e),this.tooltip.style("top",r.top+"px").style("left",r.left+"px"))},P.hideTooltip=function(){this.tooltip.style("display","none")},P.setTargetType=function(t,e){var n=this,i=n.config;n.mapToTargetIds(t).forEach(function(t){n.withoutFadeIn[t]=e===i.data_types[t],i.data_types[t]=e}),t||(i.data_type=e)},P.hasType=function(t,e){var n=this.config.data_types,i=!1;return(e=e||this.data.targets)&&e.length?e.forEach(function(e){var r=n[e.id];(r&&r.indexOf(t)>=0||!r&&"line"===t)&&(i=!0)}):Object.keys(n).length?Object.keys(n).forEach(function(e){n[e]===t&&(i=!0)}):i=this.config.data_type===t,i},P.hasArcType=function(t){return this.hasType("pie",t)||this.hasType("donut",t)||this.hasType("gauge",t)},P.isLineType=function(t){var e=this.config,n=g(t)?t:t.id;return!e.data_types[n]||["line","spline","area","area-spline","step","area-step"].indexOf(e.data_types[n])>=0},P.isStepType=function(t){var e=g(t)?t:t.id;return["step","area-step"].indexOf(this.config.data_types[e])>=0},P.isSplineType=function(t){var e=g(t)?t:t.id;return["spline","area-spline"].indexOf(this.config.data_types[e])>=0},P.isAreaType=function(t){var e=g(t)?t:t.id;return["area","area-spline","area-step"].indexOf(this.config.data_types[e])>=0},P.isBarType=function(t){var e=g(t)?t:t.id;return"bar"===this.config.data_types[e]},P.isScatterType=function(t){var e=g(t)?t:t.id;return"scatter"===this.config.data_types[e]},P.isPieType=function(t){var e=g(t)?t:t.id;return"pie"===this.config.data_types[e]},P.isGaugeType=function(t){var e=g(t)?t:t.id;return"gauge"===this.config.data_types[e]},P.isDonutType=function(t){var e=g(t)?t:t.id;return"donut"===this.config.data_types[e]},P.isArcType=function(t){return this.isPieType(t)||this.isDonutType(t)||this.isGaugeType(t)},P.lineData=function(t){return this.isLineType(t)?[t]:[]},P.arcData=function(t){return this.isArcType(t.data)?[t]:[]},P.barData=function(t){return this.isBarType(t)?t.values:[]},P.lineOrScatterData=function(t){return this.isLineType(t)||this.isScatterType(t)?t.values:[]},P.barOrLineData=function(t){return this.isBarType(t)||this.isLineType(t)?t.values:[]},P.isInterpolationType=function(t){return["linear","linear-closed","basis","basis-open","basis-closed","bundle","cardinal","cardinal-open","cardinal-closed","monotone"].indexOf(t)>=0},P.isSafari=function(){var t=window.navigator.userAgent;return t.indexOf("Safari")>=0&&t.indexOf("Chrome")<0},P.isChrome=function(){return window.navigator.userAgent.indexOf("Chrome")>=0},P.initZoom=function(){var t,e=this,n=e.d3,i=e.config;e.zoom=n.behavior.zoom().on("zoomstart",function(){t=n.event.sourceEvent,e.zoom.altDomain=n.event.sourceEvent.altKey?e.x.orgDomain():null,i.zoom_onzoomstart.call(e.api,n.event.sourceEvent)}).on("zoom",function(){e.redrawForZoom.call(e)}).on("zoomend",function(){var r=n.event.sourceEvent;r&&t.clientX===r.clientX&&t.clientY===r.clientY||(e.redrawEventRect(),e.updateZoom(),i.zoom_onzoomend.call(e.api,e.x.orgDomain()))}),e.zoom.scale=function(t){return i.axis_rotated?this.y(t):this.x(t)},e.zoom.orgScaleExtent=function(){var t=i.zoom_extent?i.zoom_extent:[1,10];return[t[0],Math.max(e.getMaxDataCount()/t[1],t[1])]},e.zoom.updateScaleExtent=function(){var t=x(e.x.orgDomain())/x(e.getZoomDomain()),n=this.orgScaleExtent();return this.scaleExtent([n[0]*t,n[1]*t]),this}},P.getZoomDomain=function(){var t=this.config,e=this.d3;return[e.min([this.orgXDomain[0],t.zoom_x_min]),e.max([this.orgXDomain[1],t.zoom_x_max])]},P.updateZoom=function(){var t=this.config.zoom_enabled?this.zoom:function(){};this.main.select("."+s.zoomRect).call(t).on("dblclick.zoom",null),this.main.selectAll("."+s.eventRect).call(t).on("dblclick.zoom",null)},P.redrawForZoom=function(){var t=this.d3,e=this.config,n=this.zoom,i=this.x;if(e.zoom_enabled&&0!==this.filterTargetsToShow(this.data.targets).length){if("mousemove"===t.event.sourceEvent.type&&n.altDomain)return i.domain(n.altDomain),void n.scale(i).updateScaleExtent();this.isCategorized()&&i.orgDomain()[0]===this.orgXDomain[0]&&i.domain([this.orgXDomain[0]-1e-10,i.orgDomain()[1]]),this.redraw({withTransition:!1,withY:e.zoom_rescale,withSubchart:!1,withEventRect:!1,withDimension:!1}),"mousemove"===t.event.sourceEvent.type&&(this.cancelClick=!0),e.zoom_onzoom.call(this.api,i.orgDomain())}},E})},{d3:3}],2:[function(t,e,n){function i(t){if(t)return function(t){for(var e in i.prototype)t[e]=i.prototype[e];return t}(t)}void 0!==e&&(e.exports=i),i.prototype.on=i.prototype.addEventListener=function(t,e){return this._callbacks=this._callbacks||{},(this._callbacks["$"+t]=this._callbacks["$"+t]||[]).push(e),this},i.prototype.once=function(t,e){function n(){this.off(t,n),e.apply(this,arguments)}return n.fn=e,this.on(t,n),this},i.prototype.off=i.prototype.removeListener=i.prototype.removeAllListeners=i.prototype.removeEventListener=function(t,e){if(this._callbacks=this._callbacks||{},0==arguments.length)return this._callbacks={},this;var n=this._callbacks["$"+t];if(!n)return this;if(1==arguments.length)return delete this._callbacks["$"+t],this;for(var i,r=0;r<n.length;r++)if((i=n[r])===e||i.fn===e){n.splice(r,1);break}return this},i.prototype.emit=function(t){this._callbacks=this._callbacks||{};var e=[].slice.call(arguments,1),n=this._callbacks["$"+t];if(n)for(var i=0,r=(n=n.slice(0)).length;i<r;++i)n[i].apply(this,e);return this},i.prototype.listeners=function(t){return this._callbacks=this._callbacks||{},this._callbacks["$"+t]||[]},i.prototype.hasListeners=function(t){return!!this.listeners(t).length}},{}],3:[function(t,e,n){!function(){function t(t){return t&&(t.ownerDocument||t.document||t).documentElement}function n(t){return t&&(t.ownerDocument&&t.ownerDocument.defaultView||t.document&&t||t.defaultView)}function i(t,e){return t<e?-1:t>e?1:t>=e?0:NaN}function r(t){return null===t?NaN:+t}function a(t){return!isNaN(t)}function o(t){return{left:function(e,n,i,r){for(arguments.length<3&&(i=0),arguments.length<4&&(r=e.length);i<r;){var a=i+r>>>1;t(e[a],n)<0?i=a+1:r=a}return i},right:function(e,n,i,r){for(arguments.length<3&&(i=0),arguments.length<4&&(r=e.length);i<r;){var a=i+r>>>1;t(e[a],n)>0?r=a:i=a+1}return i}}}function s(t){return t.length}function u(t,e){for(var n in e)Object.defineProperty(t.prototype,n,{value:e[n],enumerable:!1})}function c(){this._=Object.create(null)}function l(t){return(t+="")===oa||t[0]===sa?sa+t:t}function h(t){return(t+="")[0]===sa?t.slice(1):t}function d(t){return l(t)in this._}function f(t){return(t=l(t))in this._&&delete this._[t]}function g(){var t=[];for(var e in this._)t.push(h(e));return t}function p(){var t=0;for(var e in this._)++t;return t}function m(){for(var t in this._)return!1;return!0}function v(){this._=Object.create(null)}function y(t){return t}function x(t,e){if(e in t)return e;e=e.charAt(0).toUpperCase()+e.slice(1);for(var n=0,i=ua.length;n<i;++n){var r=ua[n]+e;if(r in t)return r}}function _(){}function w(){}function b(t){function e(){for(var e,i=n,r=-1,a=i.length;++r<a;)(e=i[r].on)&&e.apply(this,arguments);return t}var n=[],i=new c;return e.on=function(e,r){var a,o=i.get(e);return arguments.length<2?o&&o.on:(o&&(o.on=null,n=n.slice(0,a=n.indexOf(o)).concat(n.slice(a+1)),i.remove(e)),r&&n.push(i.set(e,{on:r})),t)},e}function S(){Qr.event.preventDefault()}function T(){for(var t,e=Qr.event;t=e.sourceEvent;)e=t;return e}function A(t){for(var e=new w,n=0,i=arguments.length;++n<i;)e[arguments[n]]=b(e);return e.of=function(n,i){return function(r){try{var a=r.sourceEvent=Qr.event;r.target=t,Qr.event=r,e[r.type].apply(n,i)}finally{Qr.event=a}}},e}function C(t){return la(t,ga),t}function M(t){return"function"==typeof t?t:function(){return ha(t,this)}}function P(t){return"function"==typeof t?t:function(){return da(t,this)}}function E(t,e){return t=Qr.ns.qualify(t),null==e?t.local?function(){this.removeAttributeNS(t.space,t.local)}:function(){this.removeAttribute(t)}:"function"==typeof e?t.local?function(){var n=e.apply(this,arguments);null==n?this.removeAttributeNS(t.space,t.local):this.setAttributeNS(t.space,t.local,n)}:function(){var n=e.apply(this,arguments);null==n?this.removeAttribute(t):this.setAttribute(t,n)}:t.local?function(){this.setAttributeNS(t.space,t.local,e)}:function(){this.setAttribute(t,e)}}function L(t){return t.trim().replace(/\s+/g," ")}function k(t){return new RegExp("(?:^|\\s+)"+Qr.requote(t)+"(?:\\s+|$)","g")}function D(t){return(t+"").trim().split(/^|\s+/)}function O(t,e){var n=(t=D(t).map(I)).length;return"function"==typeof e?function(){for(var i=-1,r=e.apply(this,arguments);++i<n;)t[i](this,r)}:function(){for(var i=-1;++i<n;)t[i](this,e)}}function I(t){var e=k(t);return function(n,i){if(r=n.classList)return i?r.add(t):r.remove(t);var r=n.getAttribute("class")||"";i?(e.lastIndex=0,e.test(r)||n.setAttribute("class",L(r+" "+t))):n.setAttribute("class",L(r.replace(e," ")))}}function V(t,e,n){return null==e?function(){this.style.removeProperty(t)}:"function"==typeof e?function(){var i=e.apply(this,arguments);null==i?this.style.removeProperty(t):this.style.setProperty(t,i,n)}:function(){this.style.setProperty(t,e,n)}}function G(t,e){return null==e?function(){delete this[t]}:"function"==typeof e?function(){var n=e.apply(this,arguments);null==n?delete this[t]:this[t]=n}:function(){this[t]=e}}function R(t){return"function"==typeof t?t:(t=Qr.ns.qualify(t)).local?function(){return this.ownerDocument.createElementNS(t.space,t.local)}:function(){var e=this.ownerDocument,n=this.namespaceURI;return n===pa&&e.documentElement.namespaceURI===pa?e.createElement(t):e.createElementNS(n,t)}}function N(){var t=this.parentNode;t&&t.removeChild(this)}function j(t){return{__data__:t}}function F(t){return function(){return fa(this,t)}}function z(t,e){for(var n=0,i=t.length;n<i;n++)for(var r,a=t[n],o=0,s=a.length;o<s;o++)(r=a[o])&&e(r,o,n);return t}function H(t){return la(t,va),t}function Y(t,e,n){function i(){var e=this[r];e&&(this.removeEventListener(t,e,e.$),delete this[r])}var r="__on"+t,a=t.indexOf("."),o=X;a>0&&(t=t.slice(0,a));var s=ya.get(t);return s&&(t=s,o=B),a?e?function(){var a=o(e,Zr(arguments));i.call(this),this.addEventListener(t,this[r]=a,a.$=n),a._=e}:i:e?_:function(){var e,n=new RegExp("^__on([^.]+)"+Qr.requote(t)+"$");for(var i in this)if(e=i.match(n)){var r=this[i];this.removeEventListener(e[1],r,r.$),delete this[i]}}}function X(t,e){return function(n){var i=Qr.event;Qr.event=n,e[0]=this.__data__;try{t.apply(this,e)}finally{Qr.event=i}}}function B(t,e){var n=X(t,e);return function(t){var e=t.relatedTarget;e&&(e===this||8&e.compareDocumentPosition(this))||n.call(this,t)}}function U(e){var i=".dragsuppress-"+ ++_a,r="click"+i,a=Qr.select(n(e)).on("touchmove"+i,S).on("dragstart"+i,S).on("selectstart"+i,S);if(null==xa&&(xa=!("onselectstart"in e)&&x(e.style,"userSelect")),xa){var o=t(e).style,s=o[xa];o[xa]="none"}return function(t){if(a.on(i,null),xa&&(o[xa]=s),t){var e=function(){a.on(r,null)};a.on(r,function(){S(),e()},!0),setTimeout(e,0)}}}function q(t,e){e.changedTouches&&(e=e.changedTouches[0]);var i=t.ownerSVGElement||t;if(i.createSVGPoint){var r=i.createSVGPoint();if(wa<0){var a=n(t);if(a.scrollX||a.scrollY){var o=(i=Qr.select("body").append("svg").style({position:"absolute",top:0,left:0,margin:0,padding:0,border:"none"},"important"))[0][0].getScreenCTM();wa=!(o.f||o.e),i.remove()}}return wa?(r.x=e.pageX,r.y=e.pageY):(r.x=e.clientX,r.y=e.clientY),r=r.matrixTransform(t.getScreenCTM().inverse()),[r.x,r.y]}var s=t.getBoundingClientRect();return[e.clientX-s.left-t.clientLeft,e.clientY-s.top-t.clientTop]}function W(){return Qr.event.changedTouches[0].identifier}function Q(t){return t>0?1:t<0?-1:0}function $(t,e,n){return(e[0]-t[0])*(n[1]-t[1])-(e[1]-t[1])*(n[0]-t[0])}function Z(t){return t>1?0:t<-1?Ta:Math.acos(t)}function K(t){return t>1?Ma:t<-1?-Ma:Math.asin(t)}function J(t){return((t=Math.exp(t))+1/t)/2}function tt(t){return(t=Math.sin(t/2))*t}function et(){}function nt(t,e,n){return this instanceof nt?(this.h=+t,this.s=+e,void(this.l=+n)):arguments.length<2?t instanceof nt?new nt(t.h,t.s,t.l):mt(""+t,vt,nt):new nt(t,e,n)}function it(t,e,n){function i(t){return Math.round(255*function(t){return t>360?t-=360:t<0&&(t+=360),t<60?r+(a-r)*t/60:t<180?a:t<240?r+(a-r)*(240-t)/60:r}(t))}var r,a;return t=isNaN(t)?0:(t%=360)<0?t+360:t,e=isNaN(e)?0:e<0?0:e>1?1:e,n=n<0?0:n>1?1:n,a=n<=.5?n*(1+e):n+e-n*e,r=2*n-a,new dt(i(t+120),i(t),i(t-120))}function rt(t,e,n){return this instanceof rt?(this.h=+t,this.c=+e,void(this.l=+n)):arguments.length<2?t instanceof rt?new rt(t.h,t.c,t.l):t instanceof ot?ut(t.l,t.a,t.b):ut((t=yt((t=Qr.rgb(t)).r,t.g,t.b)).l,t.a,t.b):new rt(t,e,n)}function at(t,e,n){return isNaN(t)&&(t=0),isNaN(e)&&(e=0),new ot(n,Math.cos(t*=Pa)*e,Math.sin(t)*e)}function ot(t,e,n){return this instanceof ot?(this.l=+t,this.a=+e,void(this.b=+n)):arguments.length<2?t instanceof ot?new ot(t.l,t.a,t.b):t instanceof rt?at(t.h,t.c,t.l):yt((t=dt(t)).r,t.g,t.b):new ot(t,e,n)}function st(t,e,n){var i=(t+16)/116,r=i+e/500,a=i-n/200;return r=ct(r)*Ra,i=ct(i)*Na,a=ct(a)*ja,new dt(ht(3.2404542*r-1.5371385*i-.4985314*a),ht(-.969266*r+1.8760108*i+.041556*a),ht(.0556434*r-.2040259*i+1.0572252*a))}function ut(t,e,n){return t>0?new rt(Math.atan2(n,e)*Ea,Math.sqrt(e*e+n*n),t):new rt(NaN,NaN,t)}function ct(t){return t>.206893034?t*t*t:(t-4/29)/7.787037}function lt(t){return t>.008856?Math.pow(t,1/3):7.787037*t+4/29}function ht(t){return Math.round(255*(t<=.00304?12.92*t:1.055*Math.pow(t,1/2.4)-.055))}function dt(t,e,n){return this instanceof dt?(this.r=~~t,this.g=~~e,void(this.b=~~n)):arguments.length<2?t instanceof dt?new dt(t.r,t.g,t.b):mt(""+t,dt,it):new dt(t,e,n)}function ft(t){return new dt(t>>16,t>>8&255,255&t)}function gt(t){return ft(t)+""}function pt(t){return t<16?"0"+Math.max(0,t).toString(16):Math.min(255,t).toString(16)}function mt(t,e,n){var i,r,a,o=0,s=0,u=0;if(i=/([a-z]+)\((.*)\)/.exec(t=t.toLowerCase()))switch(r=i[2].split(","),i[1]){case"hsl":return n(parseFloat(r[0]),parseFloat(r[1])/100,parseFloat(r[2])/100);case"rgb":return e(_t(r[0]),_t(r[1]),_t(r[2]))}return(a=Ha.get(t))?e(a.r,a.g,a.b):(null==t||"#"!==t.charAt(0)||isNaN(a=parseInt(t.slice(1),16))||(4===t.length?(o=(3840&a)>>4,o|=o>>4,s=240&a,s|=s>>4,u=15&a,u|=u<<4):7===t.length&&(o=(16711680&a)>>16,s=(65280&a)>>8,u=255&a)),e(o,s,u))}function vt(t,e,n){var i,r,a=Math.min(t/=255,e/=255,n/=255),o=Math.max(t,e,n),s=o-a,u=(o+a)/2;return s?(r=u<.5?s/(o+a):s/(2-o-a),i=t==o?(e-n)/s+(e<n?6:0):e==o?(n-t)/s+2:(t-e)/s+4,i*=60):(i=NaN,r=u>0&&u<1?0:i),new nt(i,r,u)}function yt(t,e,n){var i=lt((.4124564*(t=xt(t))+.3575761*(e=xt(e))+.1804375*(n=xt(n)))/Ra),r=lt((.2126729*t+.7151522*e+.072175*n)/Na);return ot(116*r-16,500*(i-r),200*(r-lt((.0193339*t+.119192*e+.9503041*n)/ja)))}function xt(t){return(t/=255)<=.04045?t/12.92:Math.pow((t+.055)/1.055,2.4)}function _t(t){var e=parseFloat(t);return"%"===t.charAt(t.length-1)?Math.round(2.55*e):e}function wt(t){return"function"==typeof t?t:function(){return t}}function bt(t){return function(e,n,i){return 2===arguments.length&&"function"==typeof n&&(i=n,n=null),St(e,n,t,i)}}function St(t,e,n,i){function r(){var t,e=u.status;if(!e&&function(t){var e=t.responseType;return e&&"text"!==e?t.response:t.responseText}(u)||e>=200&&e<300||304===e){try{t=n.call(a,u)}catch(t){return void o.error.call(a,t)}o.load.call(a,t)}else o.error.call(a,u)}var a={},o=Qr.dispatch("beforesend","progress","load","error"),s={},u=new XMLHttpRequest,c=null;return!this.XDomainRequest||"withCredentials"in u||!/^(http(s)?:)?\/\//.test(t)||(u=new XDomainRequest),"onload"in u?u.onload=u.onerror=r:u.onreadystatechange=function(){u.readyState>3&&r()},u.onprogress=function(t){var e=Qr.event;Qr.event=t;try{o.progress.call(a,u)}finally{Qr.event=e}},a.header=function(t,e){return t=(t+"").toLowerCase(),arguments.length<2?s[t]:(null==e?delete s[t]:s[t]=e+"",a)},a.mimeType=function(t){return arguments.length?(e=null==t?null:t+"",a):e},a.responseType=function(t){return arguments.length?(c=t,a):c},a.response=function(t){return n=t,a},["get","post"].forEach(function(t){a[t]=function(){return a.send.apply(a,[t].concat(Zr(arguments)))}}),a.send=function(n,i,r){if(2===arguments.length&&"function"==typeof i&&(r=i,i=null),u.open(n,t,!0),null==e||"accept"in s||(s.accept=e+",*/*"),u.setRequestHeader)for(var l in s)u.setRequestHeader(l,s[l]);return null!=e&&u.overrideMimeType&&u.overrideMimeType(e),null!=c&&(u.responseType=c),null!=r&&a.on("error",r).on("load",function(t){r(null,t)}),o.beforesend.call(a,u),u.send(null==i?null:i),a},a.abort=function(){return u.abort(),a},Qr.rebind(a,o,"on"),null==i?a:a.get(function(t){return 1===t.length?function(e,n){t(null==e?n:null)}:t}(i))}function Tt(t,e,n){var i=arguments.length;i<2&&(e=0),i<3&&(n=Date.now());var r={c:t,t:n+e,n:null};return Xa?Xa.n=r:Ya=r,Xa=r,Ba||(Ua=clearTimeout(Ua),Ba=1,qa(At)),r}function At(){var t=Ct(),e=Mt()-t;e>24?(isFinite(e)&&(clearTimeout(Ua),Ua=setTimeout(At,e)),Ba=0):(Ba=1,qa(At))}function Ct(){for(var t=Date.now(),e=Ya;e;)t>=e.t&&e.c(t-e.t)&&(e.c=null),e=e.n;return t}function Mt(){for(var t,e=Ya,n=1/0;e;)e.c?(e.t<n&&(n=e.t),e=(t=e).n):e=t?t.n=e.n:Ya=e.n;return Xa=t,n}function Pt(t,e){return e-(t?Math.ceil(Math.log(t)/Math.LN10):1)}function Et(){this._=new Date(arguments.length>1?Date.UTC.apply(this,arguments):arguments[0])}function Lt(t,e,n){function i(e){var n=t(e),i=a(n,1);return e-n<i-e?n:i}function r(n){return e(n=t(new Ka(n-1)),1),n}function a(t,n){return e(t=new Ka(+t),n),t}function o(t,i,a){var o=r(t),s=[];if(a>1)for(;o<i;)n(o)%a||s.push(new Date(+o)),e(o,1);else for(;o<i;)s.push(new Date(+o)),e(o,1);return s}t.floor=t,t.round=i,t.ceil=r,t.offset=a,t.range=o;var s=t.utc=kt(t);return s.floor=s,s.round=kt(i),s.ceil=kt(r),s.offset=kt(a),s.range=function(t,e,n){try{Ka=Et;var i=new Et;return i._=t,o(i,e,n)}finally{Ka=Date}},t}function kt(t){return function(e,n){try{Ka=Et;var i=new Et;return i._=e,t(i,n)._}finally{Ka=Date}}}function Dt(t,e,n){var i=t<0?"-":"",r=(i?-t:t)+"",a=r.length;return i+(a<n?new Array(n-a+1).join(e)+r:r)}function Ot(t){return new RegExp("^(?:"+t.map(Qr.requote).join("|")+")","i")}function It(t){for(var e=new c,n=-1,i=t.length;++n<i;)e.set(t[n].toLowerCase(),n);return e}function Vt(t,e,n){eo.lastIndex=0;var i=eo.exec(e.slice(n,n+1));return i?(t.w=+i[0],n+i[0].length):-1}function Gt(t,e,n){eo.lastIndex=0;var i=eo.exec(e.slice(n));return i?(t.U=+i[0],n+i[0].length):-1}function Rt(t,e,n){eo.lastIndex=0;var i=eo.exec(e.slice(n));return i?(t.W=+i[0],n+i[0].length):-1}function Nt(t,e,n){eo.lastIndex=0;var i=eo.exec(e.slice(n,n+4));return i?(t.y=+i[0],n+i[0].length):-1}function jt(t,e,n){eo.lastIndex=0;var i=eo.exec(e.slice(n,n+2));return i?(t.y=function(t){return t+(t>68?1900:2e3)}(+i[0]),n+i[0].length):-1}function Ft(t,e,n){return/^[+-]\d{4}$/.test(e=e.slice(n,n+5))?(t.Z=-e,n+5):-1}function zt(t,e,n){eo.lastIndex=0;var i=eo.exec(e.slice(n,n+2));return i?(t.m=i[0]-1,n+i[0].length):-1}function Ht(t,e,n){eo.lastIndex=0;var i=eo.exec(e.slice(n,n+2));return i?(t.d=+i[0],n+i[0].length):-1}function Yt(t,e,n){eo.lastIndex=0;var i=eo.exec(e.slice(n,n+3));return i?(t.j=+i[0],n+i[0].length):-1}function Xt(t,e,n){eo.lastIndex=0;var i=eo.exec(e.slice(n,n+2));return i?(t.H=+i[0],n+i[0].length):-1}function Bt(t,e,n){eo.lastIndex=0;var i=eo.exec(e.slice(n,n+2));return i?(t.M=+i[0],n+i[0].length):-1}function Ut(t,e,n){eo.lastIndex=0;var i=eo.exec(e.slice(n,n+2));return i?(t.S=+i[0],n+i[0].length):-1}function qt(t,e,n){eo.lastIndex=0;var i=eo.exec(e.slice(n,n+3));return i?(t.L=+i[0],n+i[0].length):-1}function Wt(t){var e=t.getTimezoneOffset(),n=e>0?"-":"+",i=aa(e)/60|0,r=aa(e)%60;return n+Dt(i,"0",2)+Dt(r,"0",2)}function Qt(t,e,n){no.lastIndex=0;var i=no.exec(e.slice(n,n+1));return i?n+i[0].length:-1}function $t(t){for(var e=t.length,n=-1;++n<e;)t[n][0]=this(t[n][0]);return function(e){for(var n=0,i=t[n];!i[1](e);)i=t[++n];return i[0](e)}}function Zt(){}function Kt(t,e,n){var i=n.s=t+e,r=i-t,a=i-r;n.t=t-a+(e-r)}function Jt(t,e){t&&oo.hasOwnProperty(t.type)&&oo[t.type](t,e)}function te(t,e,n){var i,r=-1,a=t.length-n;for(e.lineStart();++r<a;)i=t[r],e.point(i[0],i[1],i[2]);e.lineEnd()}function ee(t,e){var n=-1,i=t.length;for(e.polygonStart();++n<i;)te(t[n],e,1);e.polygonEnd()}function ne(){function t(t,e){e=e*Pa/2+Ta/4;var n=(t*=Pa)-i,o=n>=0?1:-1,s=o*n,u=Math.cos(e),c=Math.sin(e),l=a*c,h=r*u+l*Math.cos(s),d=l*o*Math.sin(s);uo.add(Math.atan2(d,h)),i=t,r=u,a=c}var e,n,i,r,a;co.point=function(o,s){co.point=t,i=(e=o)*Pa,r=Math.cos(s=(n=s)*Pa/2+Ta/4),a=Math.sin(s)},co.lineEnd=function(){t(e,n)}}function ie(t){var e=t[0],n=t[1],i=Math.cos(n);return[i*Math.cos(e),i*Math.sin(e),Math.sin(n)]}function re(t,e){return t[0]*e[0]+t[1]*e[1]+t[2]*e[2]}function ae(t,e){return[t[1]*e[2]-t[2]*e[1],t[2]*e[0]-t[0]*e[2],t[0]*e[1]-t[1]*e[0]]}function oe(t,e){t[0]+=e[0],t[1]+=e[1],t[2]+=e[2]}function se(t,e){return[t[0]*e,t[1]*e,t[2]*e]}function ue(t){var e=Math.sqrt(t[0]*t[0]+t[1]*t[1]+t[2]*t[2]);t[0]/=e,t[1]/=e,t[2]/=e}function ce(t){return[Math.atan2(t[1],t[0]),K(t[2])]}function le(t,e){return aa(t[0]-e[0])<ba&&aa(t[1]-e[1])<ba}function he(t,e){t*=Pa;var n=Math.cos(e*=Pa);de(n*Math.cos(t),n*Math.sin(t),Math.sin(e))}function de(t,e,n){fo+=(t-fo)/++lo,go+=(e-go)/lo,po+=(n-po)/lo}function fe(){function t(t,r){t*=Pa;var a=Math.cos(r*=Pa),o=a*Math.cos(t),s=a*Math.sin(t),u=Math.sin(r),c=Math.atan2(Math.sqrt((c=n*u-i*s)*c+(c=i*o-e*u)*c+(c=e*s-n*o)*c),e*o+n*s+i*u);ho+=c,mo+=c*(e+(e=o)),vo+=c*(n+(n=s)),yo+=c*(i+(i=u)),de(e,n,i)}var e,n,i;bo.point=function(r,a){r*=Pa;var o=Math.cos(a*=Pa);e=o*Math.cos(r),n=o*Math.sin(r),i=Math.sin(a),bo.point=t,de(e,n,i)}}function ge(){bo.point=he}function pe(){function t(t,e){t*=Pa;var n=Math.cos(e*=Pa),o=n*Math.cos(t),s=n*Math.sin(t),u=Math.sin(e),c=r*u-a*s,l=a*o-i*u,h=i*s-r*o,d=Math.sqrt(c*c+l*l+h*h),f=i*o+r*s+a*u,g=d&&-Z(f)/d,p=Math.atan2(d,f);xo+=g*c,_o+=g*l,wo+=g*h,ho+=p,mo+=p*(i+(i=o)),vo+=p*(r+(r=s)),yo+=p*(a+(a=u)),de(i,r,a)}var e,n,i,r,a;bo.point=function(o,s){e=o,n=s,bo.point=t,o*=Pa;var u=Math.cos(s*=Pa);i=u*Math.cos(o),r=u*Math.sin(o),a=Math.sin(s),de(i,r,a)},bo.lineEnd=function(){t(e,n),bo.lineEnd=ge,bo.point=he}}function me(t,e){function n(n,i){return n=t(n,i),e(n[0],n[1])}return t.invert&&e.invert&&(n.invert=function(n,i){return(n=e.invert(n,i))&&t.invert(n[0],n[1])}),n}function ve(){return!0}function ye(t,e,n,i,r){var a=[],o=[];if(t.forEach(function(t){if(!((e=t.length-1)<=0)){var e,n=t[0],i=t[e];if(le(n,i)){r.lineStart();for(var s=0;s<e;++s)r.point((n=t[s])[0],n[1]);r.lineEnd()}else{var u=new _e(n,t,null,!0),c=new _e(n,null,u,!1);u.o=c,a.push(u),o.push(c),c=new _e(i,null,u=new _e(i,t,null,!1),!0),u.o=c,a.push(u),o.push(c)}}}),o.sort(e),xe(a),xe(o),a.length){for(var s=0,u=n,c=o.length;s<c;++s)o[s].e=u=!u;for(var l,h,d=a[0];;){for(var f=d,g=!0;f.v;)if((f=f.n)===d)return;l=f.z,r.lineStart();do{if(f.v=f.o.v=!0,f.e){if(g)for(s=0,c=l.length;s<c;++s)r.point((h=l[s])[0],h[1]);else i(f.x,f.n.x,1,r);f=f.n}else{if(g)for(s=(l=f.p.z).length-1;s>=0;--s)r.point((h=l[s])[0],h[1]);else i(f.x,f.p.x,-1,r);f=f.p}l=(f=f.o).z,g=!g}while(!f.v);r.lineEnd()}}}function xe(t){if(e=t.length){for(var e,n,i=0,r=t[0];++i<e;)r.n=n=t[i],n.p=r,r=n;r.n=n=t[0],n.p=r}}function _e(t,e,n,i){this.x=t,this.z=e,this.o=n,this.e=i,this.v=!1,this.n=this.p=null}function we(t,e,n,i){return function(r,a){function o(e,n){var i=r(e,n);t(e=i[0],n=i[1])&&a.point(e,n)}function s(t,e){var n=r(t,e);m.point(n[0],n[1])}function u(){y.point=s,m.lineStart()}function c(){y.point=o,m.lineEnd()}function l(t,e){p.push([t,e]);var n=r(t,e);_.point(n[0],n[1])}function h(){_.lineStart(),p=[]}function d(){l(p[0][0],p[0][1]),_.lineEnd();var t,e=_.clean(),n=x.buffer(),i=n.length;if(p.pop(),g.push(p),p=null,i)if(1&e){var r,o=-1;if((i=(t=n[0]).length-1)>0){for(w||(a.polygonStart(),w=!0),a.lineStart();++o<i;)a.point((r=t[o])[0],r[1]);a.lineEnd()}}else i>1&&2&e&&n.push(n.pop().concat(n.shift())),f.push(n.filter(be))}var f,g,p,m=e(a),v=r.invert(i[0],i[1]),y={point:o,lineStart:u,lineEnd:c,polygonStart:function(){y.point=l,y.lineStart=h,y.lineEnd=d,f=[],g=[]},polygonEnd:function(){y.point=o,y.lineStart=u,y.lineEnd=c,f=Qr.merge(f);var t=function(t,e){var n=t[0],i=t[1],r=[Math.sin(n),-Math.cos(n),0],a=0,o=0;uo.reset();for(var s=0,u=e.length;s<u;++s){var c=e[s],l=c.length;if(l)for(var h=c[0],d=h[0],f=h[1]/2+Ta/4,g=Math.sin(f),p=Math.cos(f),m=1;;){m===l&&(m=0);var v=(t=c[m])[0],y=t[1]/2+Ta/4,x=Math.sin(y),_=Math.cos(y),w=v-d,b=w>=0?1:-1,S=b*w,T=S>Ta,A=g*x;if(uo.add(Math.atan2(A*b*Math.sin(S),p*_+A*Math.cos(S))),a+=T?w+b*Aa:w,T^d>=n^v>=n){var C=ae(ie(h),ie(t));ue(C);var M=ae(r,C);ue(M);var P=(T^w>=0?-1:1)*K(M[2]);(i>P||i===P&&(C[0]||C[1]))&&(o+=T^w>=0?1:-1)}if(!m++)break;d=v,g=x,p=_,h=t}}return(a<-ba||a<ba&&uo<-ba)^1&o}(v,g);f.length?(w||(a.polygonStart(),w=!0),ye(f,Te,t,n,a)):t&&(w||(a.polygonStart(),w=!0),a.lineStart(),n(null,null,1,a),a.lineEnd()),w&&(a.polygonEnd(),w=!1),f=g=null},sphere:function(){a.polygonStart(),a.lineStart(),n(null,null,1,a),a.lineEnd(),a.polygonEnd()}},x=Se(),_=e(x),w=!1;return y}}function be(t){return t.length>1}function Se(){var t,e=[];return{lineStart:function(){e.push(t=[])},point:function(e,n){t.push([e,n])},lineEnd:_,buffer:function(){var n=e;return e=[],t=null,n},rejoin:function(){e.length>1&&e.push(e.pop().concat(e.shift()))}}}function Te(t,e){return((t=t.x)[0]<0?t[1]-Ma-ba:Ma-t[1])-((e=e.x)[0]<0?e[1]-Ma-ba:Ma-e[1])}function Ae(t,e,n,i){return function(r){var a,o=r.a,s=r.b,u=o.x,c=o.y,l=0,h=1,d=s.x-u,f=s.y-c;if(a=t-u,d||!(a>0)){if(a/=d,d<0){if(a<l)return;a<h&&(h=a)}else if(d>0){if(a>h)return;a>l&&(l=a)}if(a=n-u,d||!(a<0)){if(a/=d,d<0){if(a>h)return;a>l&&(l=a)}else if(d>0){if(a<l)return;a<h&&(h=a)}if(a=e-c,f||!(a>0)){if(a/=f,f<0){if(a<l)return;a<h&&(h=a)}else if(f>0){if(a>h)return;a>l&&(l=a)}if(a=i-c,f||!(a<0)){if(a/=f,f<0){if(a>h)return;a>l&&(l=a)}else if(f>0){if(a<l)return;a<h&&(h=a)}return l>0&&(r.a={x:u+l*d,y:c+l*f}),h<1&&(r.b={x:u+h*d,y:c+h*f}),r}}}}}}function Ce(t,e,n,i){function r(i,r){return aa(i[0]-t)<ba?r>0?0:3:aa(i[0]-n)<ba?r>0?2:1:aa(i[1]-e)<ba?r>0?1:0:r>0?3:2}function a(t,e){return o(t.x,e.x)}function o(t,e){var n=r(t,1),i=r(e,1);return n!==i?n-i:0===n?e[1]-t[1]:1===n?t[0]-e[0]:2===n?t[1]-e[1]:e[0]-t[0]}return function(s){function u(a,s,u,c){var l=0,h=0;if(null==a||(l=r(a,u))!==(h=r(s,u))||o(a,s)<0^u>0)do{c.point(0===l||3===l?t:n,l>1?i:e)}while((l=(l+u+4)%4)!==h);else c.point(s[0],s[1])}function c(r,a){return t<=r&&r<=n&&e<=a&&a<=i}function l(t,e){c(t,e)&&s.point(t,e)}function h(t,e){var n=c(t=Math.max(-To,Math.min(To,t)),e=Math.max(-To,Math.min(To,e)));if(f&&g.push([t,e]),w)p=t,m=e,v=n,w=!1,n&&(s.lineStart(),s.point(t,e));else if(n&&_)s.point(t,e);else{var i={a:{x:y,y:x},b:{x:t,y:e}};A(i)?(_||(s.lineStart(),s.point(i.a.x,i.a.y)),s.point(i.b.x,i.b.y),n||s.lineEnd(),b=!1):n&&(s.lineStart(),s.point(t,e),b=!1)}y=t,x=e,_=n}var d,f,g,p,m,v,y,x,_,w,b,S=s,T=Se(),A=Ae(t,e,n,i),C={point:l,lineStart:function(){C.point=h,f&&f.push(g=[]),w=!0,_=!1,y=x=NaN},lineEnd:function(){d&&(h(p,m),v&&_&&T.rejoin(),d.push(T.buffer())),C.point=l,_&&s.lineEnd()},polygonStart:function(){s=T,d=[],f=[],b=!0},polygonEnd:function(){s=S,d=Qr.merge(d);var e=function(t){for(var e=0,n=f.length,i=t[1],r=0;r<n;++r)for(var a,o=1,s=f[r],u=s.length,c=s[0];o<u;++o)a=s[o],c[1]<=i?a[1]>i&&$(c,a,t)>0&&++e:a[1]<=i&&$(c,a,t)<0&&--e,c=a;return 0!==e}([t,i]),n=b&&e,r=d.length;(n||r)&&(s.polygonStart(),n&&(s.lineStart(),u(null,null,1,s),s.lineEnd()),r&&ye(d,a,e,u,s),s.polygonEnd()),d=f=g=null}};return C}}function Me(t){var e=0,n=Ta/3,i=je(t),r=i(e,n);return r.parallels=function(t){return arguments.length?i(e=t[0]*Ta/180,n=t[1]*Ta/180):[e/Ta*180,n/Ta*180]},r}function Pe(t,e){function n(t,e){var n=Math.sqrt(a-2*r*Math.sin(e))/r;return[n*Math.sin(t*=r),o-n*Math.cos(t)]}var i=Math.sin(t),r=(i+Math.sin(e))/2,a=1+i*(2*r-i),o=Math.sqrt(a)/r;return n.invert=function(t,e){var n=o-e;return[Math.atan2(t,n)/r,K((a-(t*t+n*n)*r*r)/(2*r))]},n}function Ee(){function t(t,e){Co+=r*t-i*e,i=t,r=e}var e,n,i,r;ko.point=function(a,o){ko.point=t,e=i=a,n=r=o},ko.lineEnd=function(){t(e,n)}}function Le(t){return"m0,"+t+"a"+t+","+t+" 0 1,1 0,"+-2*t+"a"+t+","+t+" 0 1,1 0,"+2*t+"z"}function ke(t,e){fo+=t,go+=e,++po}function De(){function t(t,i){var r=t-e,a=i-n,o=Math.sqrt(r*r+a*a);mo+=o*(e+t)/2,vo+=o*(n+i)/2,yo+=o,ke(e=t,n=i)}var e,n;Oo.point=function(i,r){Oo.point=t,ke(e=i,n=r)}}function Oe(){Oo.point=ke}function Ie(){function t(t,e){var n=t-i,a=e-r,o=Math.sqrt(n*n+a*a);mo+=o*(i+t)/2,vo+=o*(r+e)/2,yo+=o,xo+=(o=r*t-i*e)*(i+t),_o+=o*(r+e),wo+=3*o,ke(i=t,r=e)}var e,n,i,r;Oo.point=function(a,o){Oo.point=t,ke(e=i=a,n=r=o)},Oo.lineEnd=function(){t(e,n)}}function Ve(t){function e(e){return(a?function(e){function i(n,i){n=t(n,i),e.point(n[0],n[1])}function r(){x=NaN,T.point=o,e.lineStart()}function o(i,r){var o=ie([i,r]),s=t(i,r);n(x,_,y,w,b,S,x=s[0],_=s[1],y=i,w=o[0],b=o[1],S=o[2],a,e),e.point(x,_)}function s(){T.point=i,e.lineEnd()}function u(){r(),T.point=c,T.lineEnd=l}function c(t,e){o(h=t,d=e),f=x,g=_,p=w,m=b,v=S,T.point=o}function l(){n(x,_,y,w,b,S,f,g,h,p,m,v,a,e),T.lineEnd=s,s()}var h,d,f,g,p,m,v,y,x,_,w,b,S,T={point:i,lineStart:r,lineEnd:s,polygonStart:function(){e.polygonStart(),T.lineStart=u},polygonEnd:function(){e.polygonEnd(),T.lineStart=r}};return T}:function(e){return Re(e,function(n,i){n=t(n,i),e.point(n[0],n[1])})})(e)}function n(e,a,o,s,u,c,l,h,d,f,g,p,m,v){var y=l-e,x=h-a,_=y*y+x*x;if(_>4*i&&m--){var w=s+f,b=u+g,S=c+p,T=Math.sqrt(w*w+b*b+S*S),A=Math.asin(S/=T),C=aa(aa(S)-1)<ba||aa(o-d)<ba?(o+d)/2:Math.atan2(b,w),M=t(C,A),P=M[0],E=M[1],L=P-e,k=E-a,D=x*L-y*k;(D*D/_>i||aa((y*L+x*k)/_-.5)>.3||s*f+u*g+c*p<r)&&(n(e,a,o,s,u,c,P,E,C,w/=T,b/=T,S,m,v),v.point(P,E),n(P,E,C,w,b,S,l,h,d,f,g,p,m,v))}}var i=.5,r=Math.cos(30*Pa),a=16;return e.precision=function(t){return arguments.length?(a=(i=t*t)>0&&16,e):Math.sqrt(i)},e}function Ge(t){this.stream=t}function Re(t,e){return{point:e,sphere:function(){t.sphere()},lineStart:function(){t.lineStart()},lineEnd:function(){t.lineEnd()},polygonStart:function(){t.polygonStart()},polygonEnd:function(){t.polygonEnd()}}}function Ne(t){return je(function(){return t})()}function je(t){function e(t){return t=o(t[0]*Pa,t[1]*Pa),[t[0]*h+s,u-t[1]*h]}function n(){o=me(a=Ye(m,v,x),r);var t=r(g,p);return s=d-t[0]*h,u=f+t[1]*h,i()}function i(){return c&&(c.valid=!1,c=null),e}var r,a,o,s,u,c,l=Ve(function(t,e){return t=r(t,e),[t[0]*h+s,u-t[1]*h]}),h=150,d=480,f=250,g=0,p=0,m=0,v=0,x=0,_=So,w=y,b=null,S=null;return e.stream=function(t){return c&&(c.valid=!1),c=Fe(_(a,l(w(t)))),c.valid=!0,c},e.clipAngle=function(t){return arguments.length?(_=null==t?(b=t,So):function(t){function e(t,e){return Math.cos(t)*Math.cos(e)>r}function n(t,e,n){var i=[1,0,0],a=ae(ie(t),ie(e)),o=re(a,a),s=a[0],u=o-s*s;if(!u)return!n&&t;var c=r*o/u,l=-r*s/u,h=ae(i,a),d=se(i,c);oe(d,se(a,l));var f=h,g=re(d,f),p=re(f,f),m=g*g-p*(re(d,d)-1);if(!(m<0)){var v=Math.sqrt(m),y=se(f,(-g-v)/p);if(oe(y,d),y=ce(y),!n)return y;var x,_=t[0],w=e[0],b=t[1],S=e[1];w<_&&(x=_,_=w,w=x);var T=w-_,A=aa(T-Ta)<ba;if(!A&&S<b&&(x=b,b=S,S=x),A||T<ba?A?b+S>0^y[1]<(aa(y[0]-_)<ba?b:S):b<=y[1]&&y[1]<=S:T>Ta^(_<=y[0]&&y[0]<=w)){var C=se(f,(-g+v)/p);return oe(C,d),[y,ce(C)]}}}function i(e,n){var i=a?t:Ta-t,r=0;return e<-i?r|=1:e>i&&(r|=2),n<-i?r|=4:n>i&&(r|=8),r}var r=Math.cos(t),a=r>0,o=aa(r)>ba;return we(e,function(t){var r,s,u,c,l;return{lineStart:function(){c=u=!1,l=1},point:function(h,d){var f,g=[h,d],p=e(h,d),m=a?p?0:i(h,d):p?i(h+(h<0?Ta:-Ta),d):0;if(!r&&(c=u=p)&&t.lineStart(),p!==u&&(f=n(r,g),(le(r,f)||le(g,f))&&(g[0]+=ba,g[1]+=ba,p=e(g[0],g[1]))),p!==u)l=0,p?(t.lineStart(),f=n(g,r),t.point(f[0],f[1])):(f=n(r,g),t.point(f[0],f[1]),t.lineEnd()),r=f;else if(o&&r&&a^p){var v;m&s||!(v=n(g,r,!0))||(l=0,a?(t.lineStart(),t.point(v[0][0],v[0][1]),t.point(v[1][0],v[1][1]),t.lineEnd()):(t.point(v[1][0],v[1][1]),t.lineEnd(),t.lineStart(),t.point(v[0][0],v[0][1])))}!p||r&&le(r,g)||t.point(g[0],g[1]),r=g,u=p,s=m},lineEnd:function(){u&&t.lineEnd(),r=null},clean:function(){return l|(c&&u)<<1}}},qe(t,6*Pa),a?[0,-t]:[-Ta,t-Ta])}((b=+t)*Pa),i()):b},e.clipExtent=function(t){return arguments.length?(S=t,w=t?Ce(t[0][0],t[0][1],t[1][0],t[1][1]):y,i()):S},e.scale=function(t){return arguments.length?(h=+t,n()):h},e.translate=function(t){return arguments.length?(d=+t[0],f=+t[1],n()):[d,f]},e.center=function(t){return arguments.length?(g=t[0]%360*Pa,p=t[1]%360*Pa,n()):[g*Ea,p*Ea]},e.rotate=function(t){return arguments.length?(m=t[0]%360*Pa,v=t[1]%360*Pa,x=t.length>2?t[2]%360*Pa:0,n()):[m*Ea,v*Ea,x*Ea]},Qr.rebind(e,l,"precision"),function(){return r=t.apply(this,arguments),e.invert=r.invert&&function(t){return(t=o.invert((t[0]-s)/h,(u-t[1])/h))&&[t[0]*Ea,t[1]*Ea]},n()}}function Fe(t){return Re(t,function(e,n){t.point(e*Pa,n*Pa)})}function ze(t,e){return[t,e]}function He(t,e){return[t>Ta?t-Aa:t<-Ta?t+Aa:t,e]}function Ye(t,e,n){return t?e||n?me(Be(t),Ue(e,n)):Be(t):e||n?Ue(e,n):He}function Xe(t){return function(e,n){return e+=t,[e>Ta?e-Aa:e<-Ta?e+Aa:e,n]}}function Be(t){var e=Xe(t);return e.invert=Xe(-t),e}function Ue(t,e){function n(t,e){var n=Math.cos(e),s=Math.cos(t)*n,u=Math.sin(t)*n,c=Math.sin(e),l=c*i+s*r;return[Math.atan2(u*a-l*o,s*i-c*r),K(l*a+u*o)]}var i=Math.cos(t),r=Math.sin(t),a=Math.cos(e),o=Math.sin(e);return n.invert=function(t,e){var n=Math.cos(e),s=Math.cos(t)*n,u=Math.sin(t)*n,c=Math.sin(e),l=c*a-u*o;return[Math.atan2(u*a+c*o,s*i+l*r),K(l*i-s*r)]},n}function qe(t,e){var n=Math.cos(t),i=Math.sin(t);return function(r,a,o,s){var u=o*e;null!=r?(r=We(n,r),a=We(n,a),(o>0?r<a:r>a)&&(r+=o*Aa)):(r=t+o*Aa,a=t-.5*u);for(var c,l=r;o>0?l>a:l<a;l-=u)s.point((c=ce([n,-i*Math.cos(l),-i*Math.sin(l)]))[0],c[1])}}function We(t,e){var n=ie(e);n[0]-=t,ue(n);var i=Z(-n[1]);return((-n[2]<0?-i:i)+2*Math.PI-ba)%(2*Math.PI)}function Qe(t,e,n){var i=Qr.range(t,e-ba,n).concat(e);return function(t){return i.map(function(e){return[t,e]})}}function $e(t,e,n){var i=Qr.range(t,e-ba,n).concat(e);return function(t){return i.map(function(e){return[e,t]})}}function Ze(t){return t.source}function Ke(t){return t.target}function Je(t,e){function n(e,n){var i=Math.cos(e),r=Math.cos(n),a=t(i*r);return[a*r*Math.sin(e),a*Math.sin(n)]}return n.invert=function(t,n){var i=Math.sqrt(t*t+n*n),r=e(i),a=Math.sin(r),o=Math.cos(r);return[Math.atan2(t*a,i*o),Math.asin(i&&n*a/i)]},n}function tn(t,e){function n(t,e){o>0?e<-Ma+ba&&(e=-Ma+ba):e>Ma-ba&&(e=Ma-ba);var n=o/Math.pow(r(e),a);return[n*Math.sin(a*t),o-n*Math.cos(a*t)]}var i=Math.cos(t),r=function(t){return Math.tan(Ta/4+t/2)},a=t===e?Math.sin(t):Math.log(i/Math.cos(e))/Math.log(r(e)/r(t)),o=i*Math.pow(r(t),a)/a;return a?(n.invert=function(t,e){var n=o-e,i=Q(a)*Math.sqrt(t*t+n*n);return[Math.atan2(t,n)/a,2*Math.atan(Math.pow(o/i,1/a))-Ma]},n):nn}function en(t,e){function n(t,e){var n=a-e;return[n*Math.sin(r*t),a-n*Math.cos(r*t)]}var i=Math.cos(t),r=t===e?Math.sin(t):(i-Math.cos(e))/(e-t),a=i/r+t;return aa(r)<ba?ze:(n.invert=function(t,e){var n=a-e;return[Math.atan2(t,n)/r,a-Q(r)*Math.sqrt(t*t+n*n)]},n)}function nn(t,e){return[t,Math.log(Math.tan(Ta/4+e/2))]}function rn(t){var e,n=Ne(t),i=n.scale,r=n.translate,a=n.clipExtent;return n.scale=function(){var t=i.apply(n,arguments);return t===n?e?n.clipExtent(null):n:t},n.translate=function(){var t=r.apply(n,arguments);return t===n?e?n.clipExtent(null):n:t},n.clipExtent=function(t){var o=a.apply(n,arguments);if(o===n){if(e=null==t){var s=Ta*i(),u=r();a([[u[0]-s,u[1]-s],[u[0]+s,u[1]+s]])}}else e&&(o=null);return o},n.clipExtent(null)}function an(t,e){return[Math.log(Math.tan(Ta/4+e/2)),-t]}function on(t){return t[0]}function sn(t){return t[1]}function un(t){for(var e=t.length,n=[0,1],i=2,r=2;r<e;r++){for(;i>1&&$(t[n[i-2]],t[n[i-1]],t[r])<=0;)--i;n[i++]=r}return n.slice(0,i)}function cn(t,e){return t[0]-e[0]||t[1]-e[1]}function ln(t,e,n){return(n[0]-e[0])*(t[1]-e[1])<(n[1]-e[1])*(t[0]-e[0])}function hn(t,e,n,i){var r=t[0],a=n[0],o=e[0]-r,s=i[0]-a,u=t[1],c=n[1],l=e[1]-u,h=i[1]-c,d=(s*(u-c)-h*(r-a))/(h*o-s*l);return[r+d*o,u+d*l]}function dn(t){var e=t[0],n=t[t.length-1];return!(e[0]-n[0]||e[1]-n[1])}function fn(t){var e=qo.pop()||new function(){Pn(this),this.edge=this.site=this.circle=null};return e.site=t,e}function gn(t){bn(t),Xo.remove(t),qo.push(t),Pn(t)}function pn(t){var e=t.circle,n=e.x,i=e.cy,r={x:n,y:i},a=t.P,o=t.N,s=[t];gn(t);for(var u=a;u.circle&&aa(n-u.circle.x)<ba&&aa(i-u.circle.cy)<ba;)a=u.P,s.unshift(u),gn(u),u=a;s.unshift(u),bn(u);for(var c=o;c.circle&&aa(n-c.circle.x)<ba&&aa(i-c.circle.cy)<ba;)o=c.N,s.push(c),gn(c),c=o;s.push(c),bn(c);var l,h=s.length;for(l=1;l<h;++l)c=s[l],u=s[l-1],An(c.edge,u.site,c.site,r);u=s[0],(c=s[h-1]).edge=Tn(u.site,c.site,null,r),wn(u),wn(c)}function mn(t){for(var e,n,i,r,a=t.x,o=t.y,s=Xo._;s;)if((i=vn(s,o)-a)>ba)s=s.L;else{if(!((r=a-function(t,e){var n=t.N;if(n)return vn(n,e);var i=t.site;return i.y===e?i.x:1/0}(s,o))>ba)){i>-ba?(e=s.P,n=s):r>-ba?(e=s,n=s.N):e=n=s;break}if(!s.R){e=s;break}s=s.R}var u=fn(t);if(Xo.insert(e,u),e||n){if(e===n)return bn(e),n=fn(e.site),Xo.insert(u,n),u.edge=n.edge=Tn(e.site,u.site),wn(e),void wn(n);if(n){bn(e),bn(n);var c=e.site,l=c.x,h=c.y,d=t.x-l,f=t.y-h,g=n.site,p=g.x-l,m=g.y-h,v=2*(d*m-f*p),y=d*d+f*f,x=p*p+m*m,_={x:(m*y-f*x)/v+l,y:(d*x-p*y)/v+h};An(n.edge,c,g,_),u.edge=Tn(c,t,null,_),n.edge=Tn(t,g,null,_),wn(e),wn(n)}else u.edge=Tn(e.site,u.site)}}function vn(t,e){var n=t.site,i=n.x,r=n.y,a=r-e;if(!a)return i;var o=t.P;if(!o)return-1/0;var s=(n=o.site).x,u=n.y,c=u-e;if(!c)return s;var l=s-i,h=1/a-1/c,d=l/c;return h?(-d+Math.sqrt(d*d-2*h*(l*l/(-2*c)-u+c/2+r-a/2)))/h+i:(i+s)/2}function yn(t){this.site=t,this.edges=[]}function xn(t){for(var e,n,i,r,a,o,s,u,c,l,h=t[0][0],d=t[1][0],f=t[0][1],g=t[1][1],p=Yo,m=p.length;m--;)if((a=p[m])&&a.prepare())for(u=(s=a.edges).length,o=0;o<u;)i=(l=s[o].end()).x,r=l.y,e=(c=s[++o%u].start()).x,n=c.y,(aa(i-e)>ba||aa(r-n)>ba)&&(s.splice(o,0,new Cn(function(t,e,n){var i=new Sn(t,null);return i.a=e,i.b=n,Ho.push(i),i}(a.site,l,aa(i-h)<ba&&g-r>ba?{x:h,y:aa(e-h)<ba?n:g}:aa(r-g)<ba&&d-i>ba?{x:aa(n-g)<ba?e:d,y:g}:aa(i-d)<ba&&r-f>ba?{x:d,y:aa(e-d)<ba?n:f}:aa(r-f)<ba&&i-h>ba?{x:aa(n-f)<ba?e:h,y:f}:null),a.site,null)),++u)}function _n(t,e){return e.angle-t.angle}function wn(t){var e=t.P,n=t.N;if(e&&n){var i=e.site,r=t.site,a=n.site;if(i!==a){var o=r.x,s=r.y,u=i.x-o,c=i.y-s,l=a.x-o,h=2*(u*(m=a.y-s)-c*l);if(!(h>=-Sa)){var d=u*u+c*c,f=l*l+m*m,g=(m*d-c*f)/h,p=(u*f-l*d)/h,m=p+s,v=Wo.pop()||new function(){Pn(this),this.x=this.y=this.arc=this.site=this.cy=null};v.arc=t,v.site=r,v.x=g+o,v.y=m+Math.sqrt(g*g+p*p),v.cy=m,t.circle=v;for(var y=null,x=Uo._;x;)if(v.y<x.y||v.y===x.y&&v.x<=x.x){if(!x.L){y=x.P;break}x=x.L}else{if(!x.R){y=x;break}x=x.R}Uo.insert(y,v),y||(Bo=v)}}}}function bn(t){var e=t.circle;e&&(e.P||(Bo=e.N),Uo.remove(e),Wo.push(e),Pn(e),t.circle=null)}function Sn(t,e){this.l=t,this.r=e,this.a=this.b=null}function Tn(t,e,n,i){var r=new Sn(t,e);return Ho.push(r),n&&An(r,t,e,n),i&&An(r,e,t,i),Yo[t.i].edges.push(new Cn(r,t,e)),Yo[e.i].edges.push(new Cn(r,e,t)),r}function An(t,e,n,i){t.a||t.b?t.l===n?t.b=i:t.a=i:(t.a=i,t.l=e,t.r=n)}function Cn(t,e,n){var i=t.a,r=t.b;this.edge=t,this.site=e,this.angle=n?Math.atan2(n.y-e.y,n.x-e.x):t.l===e?Math.atan2(r.x-i.x,i.y-r.y):Math.atan2(i.x-r.x,r.y-i.y)}function Mn(){this._=null}function Pn(t){t.U=t.C=t.L=t.R=t.P=t.N=null}function En(t,e){var n=e,i=e.R,r=n.U;r?r.L===n?r.L=i:r.R=i:t._=i,i.U=r,n.U=i,n.R=i.L,n.R&&(n.R.U=n),i.L=n}function Ln(t,e){var n=e,i=e.L,r=n.U;r?r.L===n?r.L=i:r.R=i:t._=i,i.U=r,n.U=i,n.L=i.R,n.L&&(n.L.U=n),i.R=n}function kn(t){for(;t.L;)t=t.L;return t}function Dn(t,e){var n,i,r,a=t.sort(On).pop();for(Ho=[],Yo=new Array(t.length),Xo=new Mn,Uo=new Mn;;)if(r=Bo,a&&(!r||a.y<r.y||a.y===r.y&&a.x<r.x))a.x===n&&a.y===i||(Yo[a.i]=new yn(a),mn(a),n=a.x,i=a.y),a=t.pop();else{if(!r)break;pn(r.arc)}e&&(function(t){for(var e,n=Ho,i=Ae(t[0][0],t[0][1],t[1][0],t[1][1]),r=n.length;r--;)(!function(t,e){var n=t.b;if(n)return!0;var i,r,a=t.a,o=e[0][0],s=e[1][0],u=e[0][1],c=e[1][1],l=t.l,h=t.r,d=l.x,f=l.y,g=h.x,p=h.y,m=(d+g)/2,v=(f+p)/2;if(p===f){if(m<o||m>=s)return;if(d>g){if(a){if(a.y>=c)return}else a={x:m,y:u};n={x:m,y:c}}else{if(a){if(a.y<u)return}else a={x:m,y:c};n={x:m,y:u}}}else if(i=(d-g)/(p-f),r=v-i*m,i<-1||i>1)if(d>g){if(a){if(a.y>=c)return}else a={x:(u-r)/i,y:u};n={x:(c-r)/i,y:c}}else{if(a){if(a.y<u)return}else a={x:(c-r)/i,y:c};n={x:(u-r)/i,y:u}}else if(f<p){if(a){if(a.x>=s)return}else a={x:o,y:i*o+r};n={x:s,y:i*s+r}}else{if(a){if(a.x<o)return}else a={x:s,y:i*s+r};n={x:o,y:i*o+r}}return t.a=a,t.b=n,!0}(e=n[r],t)||!i(e)||aa(e.a.x-e.b.x)<ba&&aa(e.a.y-e.b.y)<ba)&&(e.a=e.b=null,n.splice(r,1))}(e),xn(e));var o={cells:Yo,edges:Ho};return Xo=Uo=Ho=Yo=null,o}function On(t,e){return e.y-t.y||e.x-t.x}function In(t){return t.x}function Vn(t){return t.y}function Gn(t,e,n,i,r,a){if(!t(e,n,i,r,a)){var o=.5*(n+r),s=.5*(i+a),u=e.nodes;u[0]&&Gn(t,u[0],n,i,o,s),u[1]&&Gn(t,u[1],o,i,r,s),u[2]&&Gn(t,u[2],n,s,o,a),u[3]&&Gn(t,u[3],o,s,r,a)}}function Rn(t,e){t=Qr.rgb(t),e=Qr.rgb(e);var n=t.r,i=t.g,r=t.b,a=e.r-n,o=e.g-i,s=e.b-r;return function(t){return"#"+pt(Math.round(n+a*t))+pt(Math.round(i+o*t))+pt(Math.round(r+s*t))}}function Nn(t,e){var n,i={},r={};for(n in t)n in e?i[n]=zn(t[n],e[n]):r[n]=t[n];for(n in e)n in t||(r[n]=e[n]);return function(t){for(n in i)r[n]=i[n](t);return r}}function jn(t,e){return t=+t,e=+e,function(n){return t*(1-n)+e*n}}function Fn(t,e){var n,i,r,a=$o.lastIndex=Zo.lastIndex=0,o=-1,s=[],u=[];for(t+="",e+="";(n=$o.exec(t))&&(i=Zo.exec(e));)(r=i.index)>a&&(r=e.slice(a,r),s[o]?s[o]+=r:s[++o]=r),(n=n[0])===(i=i[0])?s[o]?s[o]+=i:s[++o]=i:(s[++o]=null,u.push({i:o,x:jn(n,i)})),a=Zo.lastIndex;return a<e.length&&(r=e.slice(a),s[o]?s[o]+=r:s[++o]=r),s.length<2?u[0]?(e=u[0].x,function(t){return e(t)+""}):function(){return e}:(e=u.length,function(t){for(var n,i=0;i<e;++i)s[(n=u[i]).i]=n.x(t);return s.join("")})}function zn(t,e){for(var n,i=Qr.interpolators.length;--i>=0&&!(n=Qr.interpolators[i](t,e)););return n}function Hn(t,e){var n,i=[],r=[],a=t.length,o=e.length,s=Math.min(t.length,e.length);for(n=0;n<s;++n)i.push(zn(t[n],e[n]));for(;n<a;++n)r[n]=t[n];for(;n<o;++n)r[n]=e[n];return function(t){for(n=0;n<s;++n)r[n]=i[n](t);return r}}function Yn(t){return function(e){return 1-t(1-e)}}function Xn(t){return function(e){return.5*(e<.5?t(2*e):2-t(2-2*e))}}function Bn(t){return t*t}function Un(t){return t*t*t}function qn(t){return 1-Math.cos(t*Ma)}function Wn(t){return Math.pow(2,10*(t-1))}function Qn(t){return 1-Math.sqrt(1-t*t)}function $n(t){return t<1/2.75?7.5625*t*t:t<2/2.75?7.5625*(t-=1.5/2.75)*t+.75:t<2.5/2.75?7.5625*(t-=2.25/2.75)*t+.9375:7.5625*(t-=2.625/2.75)*t+.984375}function Zn(t,e){return e-=t,function(n){return Math.round(t+e*n)}}function Kn(t){var e=[t.a,t.b],n=[t.c,t.d],i=ti(e),r=Jn(e,n),a=ti(function(t,e,n){return t[0]+=n*e[0],t[1]+=n*e[1],t}(n,e,-r))||0;e[0]*n[1]<n[0]*e[1]&&(e[0]*=-1,e[1]*=-1,i*=-1,r*=-1),this.rotate=(i?Math.atan2(e[1],e[0]):Math.atan2(-n[0],n[1]))*Ea,this.translate=[t.e,t.f],this.scale=[i,a],this.skew=a?Math.atan2(r,a)*Ea:0}function Jn(t,e){return t[0]*e[0]+t[1]*e[1]}function ti(t){var e=Math.sqrt(Jn(t,t));return e&&(t[0]/=e,t[1]/=e),e}function ei(t){return t.length?t.pop()+",":""}function ni(t,e){var n=[],i=[];return t=Qr.transform(t),e=Qr.transform(e),function(t,e,n,i){if(t[0]!==e[0]||t[1]!==e[1]){var r=n.push("translate(",null,",",null,")");i.push({i:r-4,x:jn(t[0],e[0])},{i:r-2,x:jn(t[1],e[1])})}else(e[0]||e[1])&&n.push("translate("+e+")")}(t.translate,e.translate,n,i),function(t,e,n,i){t!==e?(t-e>180?e+=360:e-t>180&&(t+=360),i.push({i:n.push(ei(n)+"rotate(",null,")")-2,x:jn(t,e)})):e&&n.push(ei(n)+"rotate("+e+")")}(t.rotate,e.rotate,n,i),function(t,e,n,i){t!==e?i.push({i:n.push(ei(n)+"skewX(",null,")")-2,x:jn(t,e)}):e&&n.push(ei(n)+"skewX("+e+")")}(t.skew,e.skew,n,i),function(t,e,n,i){if(t[0]!==e[0]||t[1]!==e[1]){var r=n.push(ei(n)+"scale(",null,",",null,")");i.push({i:r-4,x:jn(t[0],e[0])},{i:r-2,x:jn(t[1],e[1])})}else 1===e[0]&&1===e[1]||n.push(ei(n)+"scale("+e+")")}(t.scale,e.scale,n,i),t=e=null,function(t){for(var e,r=-1,a=i.length;++r<a;)n[(e=i[r]).i]=e.x(t);return n.join("")}}function ii(t){for(var e=t.source,n=t.target,i=function(t,e){if(t===e)return t;var n=ri(t),i=ri(e),r=n.pop(),a=i.pop(),o=null;for(;r===a;)o=r,r=n.pop(),a=i.pop();return o}(e,n),r=[e];e!==i;)e=e.parent,r.push(e);for(var a=r.length;n!==i;)r.splice(a,0,n),n=n.parent;return r}function ri(t){for(var e=[],n=t.parent;null!=n;)e.push(t),t=n,n=n.parent;return e.push(t),e}function ai(t){t.fixed|=2}function oi(t){t.fixed&=-7}function si(t){t.fixed|=4,t.px=t.x,t.py=t.y}function ui(t){t.fixed&=-5}function ci(t,e,n){var i=0,r=0;if(t.charge=0,!t.leaf)for(var a,o=t.nodes,s=o.length,u=-1;++u<s;)null!=(a=o[u])&&(ci(a,e,n),t.charge+=a.charge,i+=a.charge*a.cx,r+=a.charge*a.cy);if(t.point){t.leaf||(t.point.x+=Math.random()-.5,t.point.y+=Math.random()-.5);var c=e*n[t.point.index];t.charge+=t.pointCharge=c,i+=c*t.point.x,r+=c*t.point.y}t.cx=i/t.charge,t.cy=r/t.charge}function li(t,e){return Qr.rebind(t,e,"sort","children","value"),t.nodes=t,t.links=mi,t}function hi(t,e){for(var n=[t];null!=(t=n.pop());)if(e(t),(r=t.children)&&(i=r.length))for(var i,r;--i>=0;)n.push(r[i])}function di(t,e){for(var n=[t],i=[];null!=(t=n.pop());)if(i.push(t),(a=t.children)&&(r=a.length))for(var r,a,o=-1;++o<r;)n.push(a[o]);for(;null!=(t=i.pop());)e(t)}function fi(t){return t.children}function gi(t){return t.value}function pi(t,e){return e.value-t.value}function mi(t){return Qr.merge(t.map(function(t){return(t.children||[]).map(function(e){return{source:t,target:e}})}))}function vi(t){return t.x}function yi(t){return t.y}function xi(t,e,n){t.y0=e,t.y=n}function _i(t){return Qr.range(t.length)}function wi(t){for(var e=-1,n=t[0].length,i=[];++e<n;)i[e]=0;return i}function bi(t){for(var e,n=1,i=0,r=t[0][1],a=t.length;n<a;++n)(e=t[n][1])>r&&(i=n,r=e);return i}function Si(t){return t.reduce(Ti,0)}function Ti(t,e){return t+e[1]}function Ai(t,e){return Ci(t,Math.ceil(Math.log(e.length)/Math.LN2+1))}function Ci(t,e){for(var n=-1,i=+t[0],r=(t[1]-i)/e,a=[];++n<=e;)a[n]=r*n+i;return a}function Mi(t){return[Qr.min(t),Qr.max(t)]}function Pi(t,e){return t.value-e.value}function Ei(t,e){var n=t._pack_next;t._pack_next=e,e._pack_prev=t,e._pack_next=n,n._pack_prev=e}function Li(t,e){t._pack_next=e,e._pack_prev=t}function ki(t,e){var n=e.x-t.x,i=e.y-t.y,r=t.r+e.r;return.999*r*r>n*n+i*i}function Di(t){function e(t){l=Math.min(t.x-t.r,l),h=Math.max(t.x+t.r,h),d=Math.min(t.y-t.r,d),f=Math.max(t.y+t.r,f)}if((n=t.children)&&(c=n.length)){var n,i,r,a,o,s,u,c,l=1/0,h=-1/0,d=1/0,f=-1/0;if(n.forEach(Oi),i=n[0],i.x=-i.r,i.y=0,e(i),c>1&&(r=n[1],r.x=r.r,r.y=0,e(r),c>2))for(Gi(i,r,a=n[2]),e(a),Ei(i,a),i._pack_prev=a,Ei(a,r),r=i._pack_next,o=3;o<c;o++){Gi(i,r,a=n[o]);var g=0,p=1,m=1;for(s=r._pack_next;s!==r;s=s._pack_next,p++)if(ki(s,a)){g=1;break}if(1==g)for(u=i._pack_prev;u!==s._pack_prev&&!ki(u,a);u=u._pack_prev,m++);g?(p<m||p==m&&r.r<i.r?Li(i,r=s):Li(i=u,r),o--):(Ei(i,a),r=a,e(a))}var v=(l+h)/2,y=(d+f)/2,x=0;for(o=0;o<c;o++)(a=n[o]).x-=v,a.y-=y,x=Math.max(x,a.r+Math.sqrt(a.x*a.x+a.y*a.y));t.r=x,n.forEach(Ii)}}function Oi(t){t._pack_next=t._pack_prev=t}function Ii(t){delete t._pack_next,delete t._pack_prev}function Vi(t,e,n,i){var r=t.children;if(t.x=e+=i*t.x,t.y=n+=i*t.y,t.r*=i,r)for(var a=-1,o=r.length;++a<o;)Vi(r[a],e,n,i)}function Gi(t,e,n){var i=t.r+n.r,r=e.x-t.x,a=e.y-t.y;if(i&&(r||a)){var o=e.r+n.r,s=r*r+a*a,u=.5+((i*=i)-(o*=o))/(2*s),c=Math.sqrt(Math.max(0,2*o*(i+s)-(i-=s)*i-o*o))/(2*s);n.x=t.x+u*r+c*a,n.y=t.y+u*a-c*r}else n.x=t.x+i,n.y=t.y}function Ri(t,e){return t.parent==e.parent?1:2}function Ni(t){var e=t.children;return e.length?e[0]:t.t}function ji(t){var e,n=t.children;return(e=n.length)?n[e-1]:t.t}function Fi(t){var e=t.children;return e&&e.length?Fi(e[0]):t}function zi(t){var e,n=t.children;return n&&(e=n.length)?zi(n[e-1]):t}function Hi(t){return{x:t.x,y:t.y,dx:t.dx,dy:t.dy}}function Yi(t,e){var n=t.x+e[3],i=t.y+e[0],r=t.dx-e[1]-e[3],a=t.dy-e[0]-e[2];return r<0&&(n+=r/2,r=0),a<0&&(i+=a/2,a=0),{x:n,y:i,dx:r,dy:a}}function Xi(t){var e=t[0],n=t[t.length-1];return e<n?[e,n]:[n,e]}function Bi(t){return t.rangeExtent?t.rangeExtent():Xi(t.range())}function Ui(t,e){var n,i=0,r=t.length-1,a=t[i],o=t[r];return o<a&&(n=i,i=r,r=n,n=a,a=o,o=n),t[i]=e.floor(a),t[r]=e.ceil(o),t}function qi(t){return t?{floor:function(e){return Math.floor(e/t)*t},ceil:function(e){return Math.ceil(e/t)*t}}:us}function Wi(t,e,n,i){function r(){var r=Math.min(t.length,e.length)>2?function(t,e,n,i){var r=[],a=[],o=0,s=Math.min(t.length,e.length)-1;for(t[s]<t[0]&&(t=t.slice().reverse(),e=e.slice().reverse());++o<=s;)r.push(n(t[o-1],t[o])),a.push(i(e[o-1],e[o]));return function(e){var n=Qr.bisect(t,e,1,s)-1;return a[n](r[n](e))}}:function(t,e,n,i){var r=n(t[0],t[1]),a=i(e[0],e[1]);return function(t){return a(r(t))}},u=i?function(t,e){return e=(e-=t=+t)||1/e,function(n){return Math.max(0,Math.min(1,(n-t)/e))}}:function(t,e){return e=(e-=t=+t)||1/e,function(n){return(n-t)/e}};return o=r(t,e,u,n),s=r(e,t,u,zn),a}function a(t){return o(t)}var o,s;return a.invert=function(t){return s(t)},a.domain=function(e){return arguments.length?(t=e.map(Number),r()):t},a.range=function(t){return arguments.length?(e=t,r()):e},a.rangeRound=function(t){return a.range(t).interpolate(Zn)},a.clamp=function(t){return arguments.length?(i=t,r()):i},a.interpolate=function(t){return arguments.length?(n=t,r()):n},a.ticks=function(e){return Ki(t,e)},a.tickFormat=function(e,n){return Ji(t,e,n)},a.nice=function(e){return $i(t,e),r()},a.copy=function(){return Wi(t,e,n,i)},r()}function Qi(t,e){return Qr.rebind(t,e,"range","rangeRound","interpolate","clamp")}function $i(t,e){return Ui(t,qi(Zi(t,e)[2])),Ui(t,qi(Zi(t,e)[2])),t}function Zi(t,e){null==e&&(e=10);var n=Xi(t),i=n[1]-n[0],r=Math.pow(10,Math.floor(Math.log(i/e)/Math.LN10)),a=e/i*r;return a<=.15?r*=10:a<=.35?r*=5:a<=.75&&(r*=2),n[0]=Math.ceil(n[0]/r)*r,n[1]=Math.floor(n[1]/r)*r+.5*r,n[2]=r,n}function Ki(t,e){return Qr.range.apply(Qr,Zi(t,e))}function Ji(t,e,n){var i=Zi(t,e);if(n){var r=Qa.exec(n);if(r.shift(),"s"===r[8]){var a=Qr.formatPrefix(Math.max(aa(i[0]),aa(i[1])));return r[7]||(r[7]="."+tr(a.scale(i[2]))),r[8]="f",n=Qr.format(r.join("")),function(t){return n(a.scale(t))+a.symbol}}r[7]||(r[7]="."+function(t,e){var n=tr(e[2]);return t in cs?Math.abs(n-tr(Math.max(aa(e[0]),aa(e[1]))))+ +("e"!==t):n-2*("%"===t)}(r[8],i)),n=r.join("")}else n=",."+tr(i[2])+"f";return Qr.format(n)}function tr(t){return-Math.floor(Math.log(t)/Math.LN10+.01)}function er(t,e,n,i){function r(t){return(n?Math.log(t<0?0:t):-Math.log(t>0?0:-t))/Math.log(e)}function a(t){return n?Math.pow(e,t):-Math.pow(e,-t)}function o(e){return t(r(e))}return o.invert=function(e){return a(t.invert(e))},o.domain=function(e){return arguments.length?(n=e[0]>=0,t.domain((i=e.map(Number)).map(r)),o):i},o.base=function(n){return arguments.length?(e=+n,t.domain(i.map(r)),o):e},o.nice=function(){var e=Ui(i.map(r),n?Math:hs);return t.domain(e),i=e.map(a),o},o.ticks=function(){var t=Xi(i),o=[],s=t[0],u=t[1],c=Math.floor(r(s)),l=Math.ceil(r(u)),h=e%1?2:e;if(isFinite(l-c)){if(n){for(;c<l;c++)for(var d=1;d<h;d++)o.push(a(c)*d);o.push(a(c))}else for(o.push(a(c));c++<l;)for(d=h-1;d>0;d--)o.push(a(c)*d);for(c=0;o[c]<s;c++);for(l=o.length;o[l-1]>u;l--);o=o.slice(c,l)}return o},o.tickFormat=function(t,n){if(!arguments.length)return ls;arguments.length<2?n=ls:"function"!=typeof n&&(n=Qr.format(n));var i=Math.max(1,e*t/o.ticks().length);return function(t){var o=t/a(Math.round(r(t)));return o*e<e-.5&&(o*=e),o<=i?n(t):""}},o.copy=function(){return er(t.copy(),e,n,i)},Qi(o,t)}function nr(t,e,n){function i(e){return t(r(e))}var r=ir(e),a=ir(1/e);return i.invert=function(e){return a(t.invert(e))},i.domain=function(e){return arguments.length?(t.domain((n=e.map(Number)).map(r)),i):n},i.ticks=function(t){return Ki(n,t)},i.tickFormat=function(t,e){return Ji(n,t,e)},i.nice=function(t){return i.domain($i(n,t))},i.exponent=function(o){return arguments.length?(r=ir(e=o),a=ir(1/e),t.domain(n.map(r)),i):e},i.copy=function(){return nr(t.copy(),e,n)},Qi(i,t)}function ir(t){return function(e){return e<0?-Math.pow(-e,t):Math.pow(e,t)}}function rr(t,e){function n(n){return a[((r.get(n)||("range"===e.t?r.set(n,t.push(n)):NaN))-1)%a.length]}function i(e,n){return Qr.range(t.length).map(function(t){return e+n*t})}var r,a,o;return n.domain=function(i){if(!arguments.length)return t;t=[],r=new c;for(var a,o=-1,s=i.length;++o<s;)r.has(a=i[o])||r.set(a,t.push(a));return n[e.t].apply(n,e.a)},n.range=function(t){return arguments.length?(a=t,o=0,e={t:"range",a:arguments},n):a},n.rangePoints=function(r,s){arguments.length<2&&(s=0);var u=r[0],c=r[1],l=t.length<2?(u=(u+c)/2,0):(c-u)/(t.length-1+s);return a=i(u+l*s/2,l),o=0,e={t:"rangePoints",a:arguments},n},n.rangeRoundPoints=function(r,s){arguments.length<2&&(s=0);var u=r[0],c=r[1],l=t.length<2?(u=c=Math.round((u+c)/2),0):(c-u)/(t.length-1+s)|0;return a=i(u+Math.round(l*s/2+(c-u-(t.length-1+s)*l)/2),l),o=0,e={t:"rangeRoundPoints",a:arguments},n},n.rangeBands=function(r,s,u){arguments.length<2&&(s=0),arguments.length<3&&(u=s);var c=r[1]<r[0],l=r[c-0],h=(r[1-c]-l)/(t.length-s+2*u);return a=i(l+h*u,h),c&&a.reverse(),o=h*(1-s),e={t:"rangeBands",a:arguments},n},n.rangeRoundBands=function(r,s,u){arguments.length<2&&(s=0),arguments.length<3&&(u=s);var c=r[1]<r[0],l=r[c-0],h=r[1-c],d=Math.floor((h-l)/(t.length-s+2*u));return a=i(l+Math.round((h-l-(t.length-s)*d)/2),d),c&&a.reverse(),o=Math.round(d*(1-s)),e={t:"rangeRoundBands",a:arguments},n},n.rangeBand=function(){return o},n.rangeExtent=function(){return Xi(e.a[0])},n.copy=function(){return rr(t,e)},n.domain(t)}function ar(t,e){function n(){var n=0,i=e.length;for(s=[];++n<i;)s[n-1]=Qr.quantile(t,n/i);return o}function o(t){if(!isNaN(t=+t))return e[Qr.bisect(s,t)]}var s;return o.domain=function(e){return arguments.length?(t=e.map(r).filter(a).sort(i),n()):t},o.range=function(t){return arguments.length?(e=t,n()):e},o.quantiles=function(){return s},o.invertExtent=function(n){return(n=e.indexOf(n))<0?[NaN,NaN]:[n>0?s[n-1]:t[0],n<s.length?s[n]:t[t.length-1]]},o.copy=function(){return ar(t,e)},n()}function or(t,e,n){function i(e){return n[Math.max(0,Math.min(o,Math.floor(a*(e-t))))]}function r(){return a=n.length/(e-t),o=n.length-1,i}var a,o;return i.domain=function(n){return arguments.length?(t=+n[0],e=+n[n.length-1],r()):[t,e]},i.range=function(t){return arguments.length?(n=t,r()):n},i.invertExtent=function(e){return e=n.indexOf(e),e=e<0?NaN:e/a+t,[e,e+1/a]},i.copy=function(){return or(t,e,n)},r()}function sr(t,e){function n(n){if(n<=n)return e[Qr.bisect(t,n)]}return n.domain=function(e){return arguments.length?(t=e,n):t},n.range=function(t){return arguments.length?(e=t,n):e},n.invertExtent=function(n){return n=e.indexOf(n),[t[n-1],t[n]]},n.copy=function(){return sr(t,e)},n}function ur(t){function e(t){return+t}return e.invert=e,e.domain=e.range=function(n){return arguments.length?(t=n.map(e),e):t},e.ticks=function(e){return Ki(t,e)},e.tickFormat=function(e,n){return Ji(t,e,n)},e.copy=function(){return ur(t)},e}function cr(){return 0}function lr(t){return t.innerRadius}function hr(t){return t.outerRadius}function dr(t){return t.startAngle}function fr(t){return t.endAngle}function gr(t){return t&&t.padAngle}function pr(t,e,n,i){return(t-n)*e-(e-i)*t>0?0:1}function mr(t,e,n,i,r){var a=t[0]-e[0],o=t[1]-e[1],s=(r?i:-i)/Math.sqrt(a*a+o*o),u=s*o,c=-s*a,l=t[0]+u,h=t[1]+c,d=e[0]+u,f=e[1]+c,g=(l+d)/2,p=(h+f)/2,m=d-l,v=f-h,y=m*m+v*v,x=n-i,_=l*f-d*h,w=(v<0?-1:1)*Math.sqrt(Math.max(0,x*x*y-_*_)),b=(_*v-m*w)/y,S=(-_*m-v*w)/y,T=(_*v+m*w)/y,A=(-_*m+v*w)/y,C=b-g,M=S-p,P=T-g,E=A-p;return C*C+M*M>P*P+E*E&&(b=T,S=A),[[b-u,S-c],[b*n/x,S*n/x]]}function vr(t){function e(e){function o(){c.push("M",a(t(l),s))}for(var u,c=[],l=[],h=-1,d=e.length,f=wt(n),g=wt(i);++h<d;)r.call(this,u=e[h],h)?l.push([+f.call(this,u,h),+g.call(this,u,h)]):l.length&&(o(),l=[]);return l.length&&o(),c.length?c.join(""):null}var n=on,i=sn,r=ve,a=yr,o=a.key,s=.7;return e.x=function(t){return arguments.length?(n=t,e):n},e.y=function(t){return arguments.length?(i=t,e):i},e.defined=function(t){return arguments.length?(r=t,e):r},e.interpolate=function(t){return arguments.length?(o="function"==typeof t?a=t:(a=vs.get(t)||yr).key,e):o},e.tension=function(t){return arguments.length?(s=t,e):s},e}function yr(t){return t.length>1?t.join("L"):t+"Z"}function xr(t){return t.join("L")+"Z"}function _r(t){for(var e=0,n=t.length,i=t[0],r=[i[0],",",i[1]];++e<n;)r.push("V",(i=t[e])[1],"H",i[0]);return r.join("")}function wr(t){for(var e=0,n=t.length,i=t[0],r=[i[0],",",i[1]];++e<n;)r.push("H",(i=t[e])[0],"V",i[1]);return r.join("")}function br(t,e){if(e.length<1||t.length!=e.length&&t.length!=e.length+2)return yr(t);var n=t.length!=e.length,i="",r=t[0],a=t[1],o=e[0],s=o,u=1;if(n&&(i+="Q"+(a[0]-2*o[0]/3)+","+(a[1]-2*o[1]/3)+","+a[0]+","+a[1],r=t[1],u=2),e.length>1){s=e[1],a=t[u],u++,i+="C"+(r[0]+o[0])+","+(r[1]+o[1])+","+(a[0]-s[0])+","+(a[1]-s[1])+","+a[0]+","+a[1];for(var c=2;c<e.length;c++,u++)a=t[u],s=e[c],i+="S"+(a[0]-s[0])+","+(a[1]-s[1])+","+a[0]+","+a[1]}if(n){var l=t[u];i+="Q"+(a[0]+2*s[0]/3)+","+(a[1]+2*s[1]/3)+","+l[0]+","+l[1]}return i}function Sr(t,e){for(var n,i=[],r=(1-e)/2,a=t[0],o=t[1],s=1,u=t.length;++s<u;)n=a,a=o,o=t[s],i.push([r*(o[0]-n[0]),r*(o[1]-n[1])]);return i}function Tr(t){if(t.length<3)return yr(t);var e=1,n=t.length,i=t[0],r=i[0],a=i[1],o=[r,r,r,(i=t[1])[0]],s=[a,a,a,i[1]],u=[r,",",a,"L",Ar(_s,o),",",Ar(_s,s)];for(t.push(t[n-1]);++e<=n;)i=t[e],o.shift(),o.push(i[0]),s.shift(),s.push(i[1]),Cr(u,o,s);return t.pop(),u.push("L",i),u.join("")}function Ar(t,e){return t[0]*e[0]+t[1]*e[1]+t[2]*e[2]+t[3]*e[3]}function Cr(t,e,n){t.push("C",Ar(ys,e),",",Ar(ys,n),",",Ar(xs,e),",",Ar(xs,n),",",Ar(_s,e),",",Ar(_s,n))}function Mr(t,e){return(e[1]-t[1])/(e[0]-t[0])}function Pr(t){for(var e,n,i,r,a=[],o=function(t){for(var e=0,n=t.length-1,i=[],r=t[0],a=t[1],o=i[0]=Mr(r,a);++e<n;)i[e]=(o+(o=Mr(r=a,a=t[e+1])))/2;return i[e]=o,i}(t),s=-1,u=t.length-1;++s<u;)e=Mr(t[s],t[s+1]),aa(e)<ba?o[s]=o[s+1]=0:(r=(n=o[s]/e)*n+(i=o[s+1]/e)*i)>9&&(r=3*e/Math.sqrt(r),o[s]=r*n,o[s+1]=r*i);for(s=-1;++s<=u;)r=(t[Math.min(u,s+1)][0]-t[Math.max(0,s-1)][0])/(6*(1+o[s]*o[s])),a.push([r||0,o[s]*r||0]);return a}function Er(t){for(var e,n,i,r=-1,a=t.length;++r<a;)n=(e=t[r])[0],i=e[1]-Ma,e[0]=n*Math.cos(i),e[1]=n*Math.sin(i);return t}function Lr(t){function e(e){function u(){p.push("M",s(t(v),h),l,c(t(m.reverse()),h),"Z")}for(var d,f,g,p=[],m=[],v=[],y=-1,x=e.length,_=wt(n),w=wt(r),b=n===i?function(){return f}:wt(i),S=r===a?function(){return g}:wt(a);++y<x;)o.call(this,d=e[y],y)?(m.push([f=+_.call(this,d,y),g=+w.call(this,d,y)]),v.push([+b.call(this,d,y),+S.call(this,d,y)])):m.length&&(u(),m=[],v=[]);return m.length&&u(),p.length?p.join(""):null}var n=on,i=on,r=0,a=sn,o=ve,s=yr,u=s.key,c=s,l="L",h=.7;return e.x=function(t){return arguments.length?(n=i=t,e):i},e.x0=function(t){return arguments.length?(n=t,e):n},e.x1=function(t){return arguments.length?(i=t,e):i},e.y=function(t){return arguments.length?(r=a=t,e):a},e.y0=function(t){return arguments.length?(r=t,e):r},e.y1=function(t){return arguments.length?(a=t,e):a},e.defined=function(t){return arguments.length?(o=t,e):o},e.interpolate=function(t){return arguments.length?(u="function"==typeof t?s=t:(s=vs.get(t)||yr).key,c=s.reverse||s,l=s.closed?"M":"L",e):u},e.tension=function(t){return arguments.length?(h=t,e):h},e}function kr(t){return t.radius}function Dr(t){return[t.x,t.y]}function Or(){return 64}function Ir(){return"circle"}function Vr(t){var e=Math.sqrt(t/Ta);return"M0,"+e+"A"+e+","+e+" 0 1,1 0,"+-e+"A"+e+","+e+" 0 1,1 0,"+e+"Z"}function Gr(t){return function(){var e,n,i;(e=this[t])&&(i=e[n=e.active])&&(i.timer.c=null,i.timer.t=NaN,--e.count?delete e[n]:delete this[t],e.active+=.5,i.event&&i.event.interrupt.call(this,this.__data__,i.index))}}function Rr(t,e,n){return la(t,Ms),t.namespace=e,t.id=n,t}function Nr(t,e,n,i){var r=t.id,a=t.namespace;return z(t,"function"==typeof n?function(t,o,s){t[a][r].tween.set(e,i(n.call(t,t.__data__,o,s)))}:(n=i(n),function(t){t[a][r].tween.set(e,n)}))}function jr(t){return null==t&&(t=""),function(){this.textContent=t}}function Fr(t){return null==t?"__transition__":"__transition_"+t+"__"}function zr(t,e,n,i,r){function a(t){var e=p.delay;if(l.t=e+u,e<=t)return o(t-e);l.c=o}function o(n){var r=g.active,a=g[r];a&&(a.timer.c=null,a.timer.t=NaN,--g.count,delete g[r],a.event&&a.event.interrupt.call(t,t.__data__,a.index));for(var o in g)if(+o<i){var c=g[o];c.timer.c=null,c.timer.t=NaN,--g.count,delete g[o]}l.c=s,Tt(function(){return l.c&&s(n||1)&&(l.c=null,l.t=NaN),1},0,u),g.active=i,p.event&&p.event.start.call(t,t.__data__,e),f=[],p.tween.forEach(function(n,i){(i=i.call(t,t.__data__,e))&&f.push(i)}),d=p.ease,h=p.duration}function s(r){for(var a=r/h,o=d(a),s=f.length;s>0;)f[--s].call(t,o);if(a>=1)return p.event&&p.event.end.call(t,t.__data__,e),--g.count?delete g[i]:delete t[n],1}var u,l,h,d,f,g=t[n]||(t[n]={active:0,count:0}),p=g[i];p||(u=r.time,l=Tt(a,0,u),p=g[i]={tween:new c,time:u,timer:l,delay:r.delay,duration:r.duration,ease:r.ease,index:e},r=null,++g.count)}function Hr(t,e,n){t.attr("transform",function(t){var i=e(t);return"translate("+(isFinite(i)?i:n(t))+",0)"})}function Yr(t,e,n){t.attr("transform",function(t){var i=e(t);return"translate(0,"+(isFinite(i)?i:n(t))+")"})}function Xr(t){return t.toISOString()}function Br(t,e,n){function i(e){return t(e)}function r(t,n){var i=(t[1]-t[0])/n,r=Qr.bisect(Gs,i);return r==Gs.length?[e.year,Zi(t.map(function(t){return t/31536e6}),n)[2]]:r?e[i/Gs[r-1]<Gs[r]/i?r-1:r]:[js,Zi(t,n)[2]]}return i.invert=function(e){return Ur(t.invert(e))},i.domain=function(e){return arguments.length?(t.domain(e),i):t.domain().map(Ur)},i.nice=function(t,e){function n(n){return!isNaN(n)&&!t.range(n,Ur(+n+1),e).length}var a=i.domain(),o=Xi(a),s=null==t?r(o,10):"number"==typeof t&&r(o,t);return s&&(t=s[0],e=s[1]),i.domain(Ui(a,e>1?{floor:function(e){for(;n(e=t.floor(e));)e=Ur(e-1);return e},ceil:function(e){for(;n(e=t.ceil(e));)e=Ur(+e+1);return e}}:t))},i.ticks=function(t,e){var n=Xi(i.domain()),a=null==t?r(n,10):"number"==typeof t?r(n,t):!t.range&&[{range:t},e];return a&&(t=a[0],e=a[1]),t.range(n[0],Ur(+n[1]+1),e<1?1:e)},i.tickFormat=function(){return n},i.copy=function(){return Br(t.copy(),e,n)},Qi(i,t)}function Ur(t){return new Date(t)}function qr(t){return JSON.parse(t.responseText)}function Wr(t){var e=Kr.createRange();return e.selectNode(Kr.body),e.createContextualFragment(t.responseText)}var Qr={version:"3.5.17"},$r=[].slice,Zr=function(t){return $r.call(t)},Kr=this.document;if(Kr)try{Zr(Kr.documentElement.childNodes)[0].nodeType}catch(t){Zr=function(t){for(var e=t.length,n=new Array(e);e--;)n[e]=t[e];return n}}if(Date.now||(Date.now=function(){return+new Date}),Kr)try{Kr.createElement("DIV").style.setProperty("opacity",0,"")}catch(t){var Jr=this.Element.prototype,ta=Jr.setAttribute,ea=Jr.setAttributeNS,na=this.CSSStyleDeclaration.prototype,ia=na.setProperty;Jr.setAttribute=function(t,e){ta.call(this,t,e+"")},Jr.setAttributeNS=function(t,e,n){ea.call(this,t,e,n+"")},na.setProperty=function(t,e,n){ia.call(this,t,e+"",n)}}Qr.ascending=i,Qr.descending=function(t,e){return e<t?-1:e>t?1:e>=t?0:NaN},Qr.min=function(t,e){var n,i,r=-1,a=t.length;if(1===arguments.length){for(;++r<a;)if(null!=(i=t[r])&&i>=i){n=i;break}for(;++r<a;)null!=(i=t[r])&&n>i&&(n=i)}else{for(;++r<a;)if(null!=(i=e.call(t,t[r],r))&&i>=i){n=i;break}for(;++r<a;)null!=(i=e.call(t,t[r],r))&&n>i&&(n=i)}return n},Qr.max=function(t,e){var n,i,r=-1,a=t.length;if(1===arguments.length){for(;++r<a;)if(null!=(i=t[r])&&i>=i){n=i;break}for(;++r<a;)null!=(i=t[r])&&i>n&&(n=i)}else{for(;++r<a;)if(null!=(i=e.call(t,t[r],r))&&i>=i){n=i;break}for(;++r<a;)null!=(i=e.call(t,t[r],r))&&i>n&&(n=i)}return n},Qr.extent=function(t,e){var n,i,r,a=-1,o=t.length;if(1===arguments.length){for(;++a<o;)if(null!=(i=t[a])&&i>=i){n=r=i;break}for(;++a<o;)null!=(i=t[a])&&(n>i&&(n=i),r<i&&(r=i))}else{for(;++a<o;)if(null!=(i=e.call(t,t[a],a))&&i>=i){n=r=i;break}for(;++a<o;)null!=(i=e.call(t,t[a],a))&&(n>i&&(n=i),r<i&&(r=i))}return[n,r]},Qr.sum=function(t,e){var n,i=0,r=t.length,o=-1;if(1===arguments.length)for(;++o<r;)a(n=+t[o])&&(i+=n);else for(;++o<r;)a(n=+e.call(t,t[o],o))&&(i+=n);return i},Qr.mean=function(t,e){var n,i=0,o=t.length,s=-1,u=o;if(1===arguments.length)for(;++s<o;)a(n=r(t[s]))?i+=n:--u;else for(;++s<o;)a(n=r(e.call(t,t[s],s)))?i+=n:--u;if(u)return i/u},Qr.quantile=function(t,e){var n=(t.length-1)*e+1,i=Math.floor(n),r=+t[i-1],a=n-i;return a?r+a*(t[i]-r):r},Qr.median=function(t,e){var n,o=[],s=t.length,u=-1;if(1===arguments.length)for(;++u<s;)a(n=r(t[u]))&&o.push(n);else for(;++u<s;)a(n=r(e.call(t,t[u],u)))&&o.push(n);if(o.length)return Qr.quantile(o.sort(i),.5)},Qr.variance=function(t,e){var n,i,o=t.length,s=0,u=0,c=-1,l=0;if(1===arguments.length)for(;++c<o;)a(n=r(t[c]))&&(u+=(i=n-s)*(n-(s+=i/++l)));else for(;++c<o;)a(n=r(e.call(t,t[c],c)))&&(u+=(i=n-s)*(n-(s+=i/++l)));if(l>1)return u/(l-1)},Qr.deviation=function(){var t=Qr.variance.apply(this,arguments);return t?Math.sqrt(t):t};var ra=o(i);Qr.bisectLeft=ra.left,Qr.bisect=Qr.bisectRight=ra.right,Qr.bisector=function(t){return o(1===t.length?function(e,n){return i(t(e),n)}:t)},Qr.shuffle=function(t,e,n){(a=arguments.length)<3&&(n=t.length,a<2&&(e=0));for(var i,r,a=n-e;a;)r=Math.random()*a--|0,i=t[a+e],t[a+e]=t[r+e],t[r+e]=i;return t},Qr.permute=function(t,e){for(var n=e.length,i=new Array(n);n--;)i[n]=t[e[n]];return i},Qr.pairs=function(t){for(var e=0,n=t.length-1,i=t[0],r=new Array(n<0?0:n);e<n;)r[e]=[i,i=t[++e]];return r},Qr.transpose=function(t){if(!(r=t.length))return[];for(var e=-1,n=Qr.min(t,s),i=new Array(n);++e<n;)for(var r,a=-1,o=i[e]=new Array(r);++a<r;)o[a]=t[a][e];return i},Qr.zip=function(){return Qr.transpose(arguments)},Qr.keys=function(t){var e=[];for(var n in t)e.push(n);return e},Qr.values=function(t){var e=[];for(var n in t)e.push(t[n]);return e},Qr.entries=function(t){var e=[];for(var n in t)e.push({key:n,value:t[n]});return e},Qr.merge=function(t){for(var e,n,i,r=t.length,a=-1,o=0;++a<r;)o+=t[a].length;for(n=new Array(o);--r>=0;)for(e=(i=t[r]).length;--e>=0;)n[--o]=i[e];return n};var aa=Math.abs;Qr.range=function(t,e,n){if(arguments.length<3&&(n=1,arguments.length<2&&(e=t,t=0)),(e-t)/n==1/0)throw new Error("infinite range");var i,r=[],a=function(t){for(var e=1;t*e%1;)e*=10;return e}(aa(n)),o=-1;if(t*=a,e*=a,(n*=a)<0)for(;(i=t+n*++o)>e;)r.push(i/a);else for(;(i=t+n*++o)<e;)r.push(i/a);return r},Qr.map=function(t,e){var n=new c;if(t instanceof c)t.forEach(function(t,e){n.set(t,e)});else if(Array.isArray(t)){var i,r=-1,a=t.length;if(1===arguments.length)for(;++r<a;)n.set(r,t[r]);else for(;++r<a;)n.set(e.call(t,i=t[r],r),i)}else for(var o in t)n.set(o,t[o]);return n};var oa="__proto__",sa="\0";u(c,{has:d,get:function(t){return this._[l(t)]},set:function(t,e){return this._[l(t)]=e},remove:f,keys:g,values:function(){var t=[];for(var e in this._)t.push(this._[e]);return t},entries:function(){var t=[];for(var e in this._)t.push({key:h(e),value:this._[e]});return t},size:p,empty:m,forEach:function(t){for(var e in this._)t.call(this,h(e),this._[e])}}),Qr.nest=function(){function t(e,o,s){if(s>=a.length)return i?i.call(r,o):n?o.sort(n):o;for(var u,l,h,d,f=-1,g=o.length,p=a[s++],m=new c;++f<g;)(d=m.get(u=p(l=o[f])))?d.push(l):m.set(u,[l]);return e?(l=e(),h=function(n,i){l.set(n,t(e,i,s))}):(l={},h=function(n,i){l[n]=t(e,i,s)}),m.forEach(h),l}function e(t,n){if(n>=a.length)return t;var i=[],r=o[n++];return t.forEach(function(t,r){i.push({key:t,values:e(r,n)})}),r?i.sort(function(t,e){return r(t.key,e.key)}):i}var n,i,r={},a=[],o=[];return r.map=function(e,n){return t(n,e,0)},r.entries=function(n){return e(t(Qr.map,n,0),0)},r.key=function(t){return a.push(t),r},r.sortKeys=function(t){return o[a.length-1]=t,r},r.sortValues=function(t){return n=t,r},r.rollup=function(t){return i=t,r},r},Qr.set=function(t){var e=new v;if(t)for(var n=0,i=t.length;n<i;++n)e.add(t[n]);return e},u(v,{has:d,add:function(t){return this._[l(t+="")]=!0,t},remove:f,values:g,size:p,empty:m,forEach:function(t){for(var e in this._)t.call(this,h(e))}}),Qr.behavior={},Qr.rebind=function(t,e){for(var n,i=1,r=arguments.length;++i<r;)t[n=arguments[i]]=function(t,e,n){return function(){var i=n.apply(e,arguments);return i===e?t:i}}(t,e,e[n]);return t};var ua=["webkit","ms","moz","Moz","o","O"];Qr.dispatch=function(){for(var t=new w,e=-1,n=arguments.length;++e<n;)t[arguments[e]]=b(t);return t},w.prototype.on=function(t,e){var n=t.indexOf("."),i="";if(n>=0&&(i=t.slice(n+1),t=t.slice(0,n)),t)return arguments.length<2?this[t].on(i):this[t].on(i,e);if(2===arguments.length){if(null==e)for(t in this)this.hasOwnProperty(t)&&this[t].on(i,null);return this}},Qr.event=null,Qr.requote=function(t){return t.replace(ca,"\\$&")};var ca=/[\\\^\$\*\+\?\|\[\]\(\)\.\{\}]/g,la={}.__proto__?function(t,e){t.__proto__=e}:function(t,e){for(var n in e)t[n]=e[n]},ha=function(t,e){return e.querySelector(t)},da=function(t,e){return e.querySelectorAll(t)},fa=function(t,e){var n=t.matches||t[x(t,"matchesSelector")];return(fa=function(t,e){return n.call(t,e)})(t,e)};"function"==typeof Sizzle&&(ha=function(t,e){return Sizzle(t,e)[0]||null},da=Sizzle,fa=Sizzle.matchesSelector),Qr.selection=function(){return Qr.select(Kr.documentElement)};var ga=Qr.selection.prototype=[];ga.select=function(t){var e,n,i,r,a=[];t=M(t);for(var o=-1,s=this.length;++o<s;){a.push(e=[]),e.parentNode=(i=this[o]).parentNode;for(var u=-1,c=i.length;++u<c;)(r=i[u])?(e.push(n=t.call(r,r.__data__,u,o)),n&&"__data__"in r&&(n.__data__=r.__data__)):e.push(null)}return C(a)},ga.selectAll=function(t){var e,n,i=[];t=P(t);for(var r=-1,a=this.length;++r<a;)for(var o=this[r],s=-1,u=o.length;++s<u;)(n=o[s])&&(i.push(e=Zr(t.call(n,n.__data__,s,r))),e.parentNode=n);return C(i)};var pa="http://www.w3.org/1999/xhtml",ma={svg:"http://www.w3.org/2000/svg",xhtml:pa,xlink:"http://www.w3.org/1999/xlink",xml:"http://www.w3.org/XML/1998/namespace",xmlns:"http://www.w3.org/2000/xmlns/"};Qr.ns={prefix:ma,qualify:function(t){var e=t.indexOf(":"),n=t;return e>=0&&"xmlns"!==(n=t.slice(0,e))&&(t=t.slice(e+1)),ma.hasOwnProperty(n)?{space:ma[n],local:t}:t}},ga.attr=function(t,e){if(arguments.length<2){if("string"==typeof t){var n=this.node();return(t=Qr.ns.qualify(t)).local?n.getAttributeNS(t.space,t.local):n.getAttribute(t)}for(e in t)this.each(E(e,t[e]));return this}return this.each(E(t,e))},ga.classed=function(t,e){if(arguments.length<2){if("string"==typeof t){var n=this.node(),i=(t=D(t)).length,r=-1;if(e=n.classList){for(;++r<i;)if(!e.contains(t[r]))return!1}else for(e=n.getAttribute("class");++r<i;)if(!k(t[r]).test(e))return!1;return!0}for(e in t)this.each(O(e,t[e]));return this}return this.each(O(t,e))},ga.style=function(t,e,i){var r=arguments.length;if(r<3){if("string"!=typeof t){r<2&&(e="");for(i in t)this.each(V(i,t[i],e));return this}if(r<2){var a=this.node();return n(a).getComputedStyle(a,null).getPropertyValue(t)}i=""}return this.each(V(t,e,i))},ga.property=function(t,e){if(arguments.length<2){if("string"==typeof t)return this.node()[t];for(e in t)this.each(G(e,t[e]));return this}return this.each(G(t,e))},ga.text=function(t){return arguments.length?this.each("function"==typeof t?function(){var e=t.apply(this,arguments);this.textContent=null==e?"":e}:null==t?function(){this.textContent=""}:function(){this.textContent=t}):this.node().textContent},ga.html=function(t){return arguments.length?this.each("function"==typeof t?function(){var e=t.apply(this,arguments);this.innerHTML=null==e?"":e}:null==t?function(){this.innerHTML=""}:function(){this.innerHTML=t}):this.node().innerHTML},ga.append=function(t){return t=R(t),this.select(function(){return this.appendChild(t.apply(this,arguments))})},ga.insert=function(t,e){return t=R(t),e=M(e),this.select(function(){return this.insertBefore(t.apply(this,arguments),e.apply(this,arguments)||null)})},ga.remove=function(){return this.each(N)},ga.data=function(t,e){function n(t,n){var i,r,a,o=t.length,h=n.length,d=Math.min(o,h),f=new Array(h),g=new Array(h),p=new Array(o);if(e){var m,v=new c,y=new Array(o);for(i=-1;++i<o;)(r=t[i])&&(v.has(m=e.call(r,r.__data__,i))?p[i]=r:v.set(m,r),y[i]=m);for(i=-1;++i<h;)(r=v.get(m=e.call(n,a=n[i],i)))?!0!==r&&(f[i]=r,r.__data__=a):g[i]=j(a),v.set(m,!0);for(i=-1;++i<o;)i in y&&!0!==v.get(y[i])&&(p[i]=t[i])}else{for(i=-1;++i<d;)r=t[i],a=n[i],r?(r.__data__=a,f[i]=r):g[i]=j(a);for(;i<h;++i)g[i]=j(n[i]);for(;i<o;++i)p[i]=t[i]}g.update=f,g.parentNode=f.parentNode=p.parentNode=t.parentNode,s.push(g),u.push(f),l.push(p)}var i,r,a=-1,o=this.length;if(!arguments.length){for(t=new Array(o=(i=this[0]).length);++a<o;)(r=i[a])&&(t[a]=r.__data__);return t}var s=H([]),u=C([]),l=C([]);if("function"==typeof t)for(;++a<o;)n(i=this[a],t.call(i,i.parentNode.__data__,a));else for(;++a<o;)n(i=this[a],t);return u.enter=function(){return s},u.exit=function(){return l},u},ga.datum=function(t){return arguments.length?this.property("__data__",t):this.property("__data__")},ga.filter=function(t){var e,n,i,r=[];"function"!=typeof t&&(t=F(t));for(var a=0,o=this.length;a<o;a++){r.push(e=[]),e.parentNode=(n=this[a]).parentNode;for(var s=0,u=n.length;s<u;s++)(i=n[s])&&t.call(i,i.__data__,s,a)&&e.push(i)}return C(r)},ga.order=function(){for(var t=-1,e=this.length;++t<e;)for(var n,i=this[t],r=i.length-1,a=i[r];--r>=0;)(n=i[r])&&(a&&a!==n.nextSibling&&a.parentNode.insertBefore(n,a),a=n);return this},ga.sort=function(t){t=function(t){return arguments.length||(t=i),function(e,n){return e&&n?t(e.__data__,n.__data__):!e-!n}}.apply(this,arguments);for(var e=-1,n=this.length;++e<n;)this[e].sort(t);return this.order()},ga.each=function(t){return z(this,function(e,n,i){t.call(e,e.__data__,n,i)})},ga.call=function(t){var e=Zr(arguments);return t.apply(e[0]=this,e),this},ga.empty=function(){return!this.node()},ga.node=function(){for(var t=0,e=this.length;t<e;t++)for(var n=this[t],i=0,r=n.length;i<r;i++){var a=n[i];if(a)return a}return null},ga.size=function(){var t=0;return z(this,function(){++t}),t};var va=[];Qr.selection.enter=H,Qr.selection.enter.prototype=va,va.append=ga.append,va.empty=ga.empty,va.node=ga.node,va.call=ga.call,va.size=ga.size,va.select=function(t){for(var e,n,i,r,a,o=[],s=-1,u=this.length;++s<u;){i=(r=this[s]).update,o.push(e=[]),e.parentNode=r.parentNode;for(var c=-1,l=r.length;++c<l;)(a=r[c])?(e.push(i[c]=n=t.call(r.parentNode,a.__data__,c,s)),n.__data__=a.__data__):e.push(null)}return C(o)},va.insert=function(t,e){return arguments.length<2&&(e=function(t){var e,n;return function(i,r,a){var o,s=t[a].update,u=s.length;for(a!=n&&(n=a,e=0),r>=e&&(e=r+1);!(o=s[e])&&++e<u;);return o}}(this)),ga.insert.call(this,t,e)},Qr.select=function(e){var n;return"string"==typeof e?(n=[ha(e,Kr)]).parentNode=Kr.documentElement:(n=[e]).parentNode=t(e),C([n])},Qr.selectAll=function(t){var e;return"string"==typeof t?(e=Zr(da(t,Kr))).parentNode=Kr.documentElement:(e=Zr(t)).parentNode=null,C([e])},ga.on=function(t,e,n){var i=arguments.length;if(i<3){if("string"!=typeof t){i<2&&(e=!1);for(n in t)this.each(Y(n,t[n],e));return this}if(i<2)return(i=this.node()["__on"+t])&&i._;n=!1}return this.each(Y(t,e,n))};var ya=Qr.map({mouseenter:"mouseover",mouseleave:"mouseout"});Kr&&ya.forEach(function(t){"on"+t in Kr&&ya.remove(t)});var xa,_a=0;Qr.mouse=function(t){return q(t,T())};var wa=this.navigator&&/WebKit/.test(this.navigator.userAgent)?-1:0;Qr.touch=function(t,e,n){if(arguments.length<3&&(n=e,e=T().changedTouches),e)for(var i,r=0,a=e.length;r<a;++r)if((i=e[r]).identifier===n)return q(t,i)},Qr.behavior.drag=function(){function t(){this.on("mousedown.drag",a).on("touchstart.drag",o)}function e(t,e,n,a,o){return function(){var s,u=Qr.event.target.correspondingElement||Qr.event.target,c=this.parentNode,l=i.of(this,arguments),h=0,d=t(),f=".drag"+(null==d?"":"-"+d),g=Qr.select(n(u)).on(a+f,function(){var t,n,i=e(c,d);i&&(t=i[0]-m[0],n=i[1]-m[1],h|=t|n,m=i,l({type:"drag",x:i[0]+s[0],y:i[1]+s[1],dx:t,dy:n}))}).on(o+f,function(){e(c,d)&&(g.on(a+f,null).on(o+f,null),p(h),l({type:"dragend"}))}),p=U(u),m=e(c,d);s=r?[(s=r.apply(this,arguments)).x-m[0],s.y-m[1]]:[0,0],l({type:"dragstart"})}}var i=A(t,"drag","dragstart","dragend"),r=null,a=e(_,Qr.mouse,n,"mousemove","mouseup"),o=e(W,Qr.touch,y,"touchmove","touchend");return t.origin=function(e){return arguments.length?(r=e,t):r},Qr.rebind(t,i,"on")},Qr.touches=function(t,e){return arguments.length<2&&(e=T().touches),e?Zr(e).map(function(e){var n=q(t,e);return n.identifier=e.identifier,n}):[]};var ba=1e-6,Sa=ba*ba,Ta=Math.PI,Aa=2*Ta,Ca=Aa-ba,Ma=Ta/2,Pa=Ta/180,Ea=180/Ta,La=Math.SQRT2;Qr.interpolateZoom=function(t,e){var n,i,r=t[0],a=t[1],o=t[2],s=e[0],u=e[1],c=e[2],l=s-r,h=u-a,d=l*l+h*h;if(d<Sa)i=Math.log(c/o)/La,n=function(t){return[r+t*l,a+t*h,o*Math.exp(La*t*i)]};else{var f=Math.sqrt(d),g=(c*c-o*o+4*d)/(2*o*2*f),p=(c*c-o*o-4*d)/(2*c*2*f),m=Math.log(Math.sqrt(g*g+1)-g),v=Math.log(Math.sqrt(p*p+1)-p);i=(v-m)/La,n=function(t){var e=t*i,n=J(m),s=o/(2*f)*(n*function(t){return((t=Math.exp(2*t))-1)/(t+1)}(La*e+m)-function(t){return((t=Math.exp(t))-1/t)/2}(m));return[r+s*l,a+s*h,o*n/J(La*e+m)]}}return n.duration=1e3*i,n},Qr.behavior.zoom=function(){function t(t){t.on(L,l).on(Da+".zoom",d).on("dblclick.zoom",f).on(O,h)}function e(t){return[(t[0]-T.x)/T.k,(t[1]-T.y)/T.k]}function i(t){T.k=Math.max(M[0],Math.min(M[1],t))}function r(t,e){e=function(t){return[t[0]*T.k+T.x,t[1]*T.k+T.y]}(e),T.x+=t[0]-e[0],T.y+=t[1]-e[1]}function a(e,n,a,o){e.__chart__={x:T.x,y:T.y,k:T.k},i(Math.pow(2,o)),r(p=n,a),e=Qr.select(e),P>0&&(e=e.transition().duration(P)),e.call(t.event)}function o(){_&&_.domain(x.range().map(function(t){return(t-T.x)/T.k}).map(x.invert)),b&&b.domain(w.range().map(function(t){return(t-T.y)/T.k}).map(w.invert))}function s(t){E++||t({type:"zoomstart"})}function u(t){o(),t({type:"zoom",scale:T.k,translate:[T.x,T.y]})}function c(t){--E||(t({type:"zoomend"}),p=null)}function l(){var t=this,i=I.of(t,arguments),a=0,o=Qr.select(n(t)).on(k,function(){a=1,r(Qr.mouse(t),l),u(i)}).on(D,function(){o.on(k,null).on(D,null),h(a),c(i)}),l=e(Qr.mouse(t)),h=U(t);Cs.call(t),s(i)}function h(){function t(){var t=Qr.touches(g);return f=T.k,t.forEach(function(t){t.identifier in m&&(m[t.identifier]=e(t))}),t}function n(){var e=Qr.event.target;Qr.select(e).on(_,o).on(w,d),b.push(e);for(var n=Qr.event.changedTouches,i=0,r=n.length;i<r;++i)m[n[i].identifier]=null;var s=t(),u=Date.now();if(1===s.length){if(u-y<500){var c=s[0];a(g,c,m[c.identifier],Math.floor(Math.log(T.k)/Math.LN2)+1),S()}y=u}else if(s.length>1){c=s[0];var l=s[1],h=c[0]-l[0],f=c[1]-l[1];v=h*h+f*f}}function o(){var t,e,n,a,o=Qr.touches(g);Cs.call(g);for(var s=0,c=o.length;s<c;++s,a=null)if(n=o[s],a=m[n.identifier]){if(e)break;t=n,e=a}if(a){var l=(l=n[0]-t[0])*l+(l=n[1]-t[1])*l,h=v&&Math.sqrt(l/v);t=[(t[0]+n[0])/2,(t[1]+n[1])/2],e=[(e[0]+a[0])/2,(e[1]+a[1])/2],i(h*f)}y=null,r(t,e),u(p)}function d(){if(Qr.event.touches.length){for(var e=Qr.event.changedTouches,n=0,i=e.length;n<i;++n)delete m[e[n].identifier];for(var r in m)return void t()}Qr.selectAll(b).on(x,null),A.on(L,l).on(O,h),C(),c(p)}var f,g=this,p=I.of(g,arguments),m={},v=0,x=".zoom-"+Qr.event.changedTouches[0].identifier,_="touchmove"+x,w="touchend"+x,b=[],A=Qr.select(g),C=U(g);n(),s(p),A.on(L,null).on(O,n)}function d(){var t=I.of(this,arguments);v?clearTimeout(v):(Cs.call(this),g=e(p=m||Qr.mouse(this)),s(t)),v=setTimeout(function(){v=null,c(t)},50),S(),i(Math.pow(2,.002*ka())*T.k),r(p,g),u(t)}function f(){var t=Qr.mouse(this),n=Math.log(T.k)/Math.LN2;a(this,t,e(t),Qr.event.shiftKey?Math.ceil(n)-1:Math.floor(n)+1)}var g,p,m,v,y,x,_,w,b,T={x:0,y:0,k:1},C=[960,500],M=Oa,P=250,E=0,L="mousedown.zoom",k="mousemove.zoom",D="mouseup.zoom",O="touchstart.zoom",I=A(t,"zoomstart","zoom","zoomend");return Da||(Da="onwheel"in Kr?(ka=function(){return-Qr.event.deltaY*(Qr.event.deltaMode?120:1)},"wheel"):"onmousewheel"in Kr?(ka=function(){return Qr.event.wheelDelta},"mousewheel"):(ka=function(){return-Qr.event.detail},"MozMousePixelScroll")),t.event=function(t){t.each(function(){var t=I.of(this,arguments),e=T;Ts?Qr.select(this).transition().each("start.zoom",function(){T=this.__chart__||{x:0,y:0,k:1},s(t)}).tween("zoom:zoom",function(){var n=C[0],i=C[1],r=p?p[0]:n/2,a=p?p[1]:i/2,o=Qr.interpolateZoom([(r-T.x)/T.k,(a-T.y)/T.k,n/T.k],[(r-e.x)/e.k,(a-e.y)/e.k,n/e.k]);return function(e){var i=o(e),s=n/i[2];this.__chart__=T={x:r-i[0]*s,y:a-i[1]*s,k:s},u(t)}}).each("interrupt.zoom",function(){c(t)}).each("end.zoom",function(){c(t)}):(this.__chart__=T,s(t),u(t),c(t))})},t.translate=function(e){return arguments.length?(T={x:+e[0],y:+e[1],k:T.k},o(),t):[T.x,T.y]},t.scale=function(e){return arguments.length?(T={x:T.x,y:T.y,k:null},i(+e),o(),t):T.k},t.scaleExtent=function(e){return arguments.length?(M=null==e?Oa:[+e[0],+e[1]],t):M},t.center=function(e){return arguments.length?(m=e&&[+e[0],+e[1]],t):m},t.size=function(e){return arguments.length?(C=e&&[+e[0],+e[1]],t):C},t.duration=function(e){return arguments.length?(P=+e,t):P},t.x=function(e){return arguments.length?(_=e,x=e.copy(),T={x:0,y:0,k:1},t):_},t.y=function(e){return arguments.length?(b=e,w=e.copy(),T={x:0,y:0,k:1},t):b},Qr.rebind(t,I,"on")};var ka,Da,Oa=[0,1/0];Qr.color=et,et.prototype.toString=function(){return this.rgb()+""},Qr.hsl=nt;var Ia=nt.prototype=new et;Ia.brighter=function(t){return t=Math.pow(.7,arguments.length?t:1),new nt(this.h,this.s,this.l/t)},Ia.darker=function(t){return t=Math.pow(.7,arguments.length?t:1),new nt(this.h,this.s,t*this.l)},Ia.rgb=function(){return it(this.h,this.s,this.l)},Qr.hcl=rt;var Va=rt.prototype=new et;Va.brighter=function(t){return new rt(this.h,this.c,Math.min(100,this.l+Ga*(arguments.length?t:1)))},Va.darker=function(t){return new rt(this.h,this.c,Math.max(0,this.l-Ga*(arguments.length?t:1)))},Va.rgb=function(){return at(this.h,this.c,this.l).rgb()},Qr.lab=ot;var Ga=18,Ra=.95047,Na=1,ja=1.08883,Fa=ot.prototype=new et;Fa.brighter=function(t){return new ot(Math.min(100,this.l+Ga*(arguments.length?t:1)),this.a,this.b)},Fa.darker=function(t){return new ot(Math.max(0,this.l-Ga*(arguments.length?t:1)),this.a,this.b)},Fa.rgb=function(){return st(this.l,this.a,this.b)},Qr.rgb=dt;var za=dt.prototype=new et;za.brighter=function(t){t=Math.pow(.7,arguments.length?t:1);var e=this.r,n=this.g,i=this.b;return e||n||i?(e&&e<30&&(e=30),n&&n<30&&(n=30),i&&i<30&&(i=30),new dt(Math.min(255,e/t),Math.min(255,n/t),Math.min(255,i/t))):new dt(30,30,30)},za.darker=function(t){return t=Math.pow(.7,arguments.length?t:1),new dt(t*this.r,t*this.g,t*this.b)},za.hsl=function(){return vt(this.r,this.g,this.b)},za.toString=function(){return"#"+pt(this.r)+pt(this.g)+pt(this.b)};var Ha=Qr.map({aliceblue:15792383,antiquewhite:16444375,aqua:65535,aquamarine:8388564,azure:15794175,beige:16119260,bisque:16770244,black:0,blanchedalmond:16772045,blue:255,blueviolet:9055202,brown:10824234,burlywood:14596231,cadetblue:6266528,chartreuse:8388352,chocolate:13789470,coral:16744272,cornflowerblue:6591981,cornsilk:16775388,crimson:14423100,cyan:65535,darkblue:139,darkcyan:35723,darkgoldenrod:12092939,darkgray:11119017,darkgreen:25600,darkgrey:11119017,darkkhaki:12433259,darkmagenta:9109643,darkolivegreen:5597999,darkorange:16747520,darkorchid:10040012,darkred:9109504,darksalmon:15308410,darkseagreen:9419919,darkslateblue:4734347,darkslategray:3100495,darkslategrey:3100495,darkturquoise:52945,darkviolet:9699539,deeppink:16716947,deepskyblue:49151,dimgray:6908265,dimgrey:6908265,dodgerblue:2003199,firebrick:11674146,floralwhite:16775920,forestgreen:2263842,fuchsia:16711935,gainsboro:14474460,ghostwhite:16316671,gold:16766720,goldenrod:14329120,gray:8421504,green:32768,greenyellow:11403055,grey:8421504,honeydew:15794160,hotpink:16738740,indianred:13458524,indigo:4915330,ivory:16777200,khaki:15787660,lavender:15132410,lavenderblush:16773365,lawngreen:8190976,lemonchiffon:16775885,lightblue:11393254,lightcoral:15761536,lightcyan:14745599,lightgoldenrodyellow:16448210,lightgray:13882323,lightgreen:9498256,lightgrey:13882323,lightpink:16758465,lightsalmon:16752762,lightseagreen:2142890,lightskyblue:8900346,lightslategray:7833753,lightslategrey:7833753,lightsteelblue:11584734,lightyellow:16777184,lime:65280,limegreen:3329330,linen:16445670,magenta:16711935,maroon:8388608,mediumaquamarine:6737322,mediumblue:205,mediumorchid:12211667,mediumpurple:9662683,mediumseagreen:3978097,mediumslateblue:8087790,mediumspringgreen:64154,mediumturquoise:4772300,mediumvioletred:13047173,midnightblue:1644912,mintcream:16121850,mistyrose:16770273,moccasin:16770229,navajowhite:16768685,navy:128,oldlace:16643558,olive:8421376,olivedrab:7048739,orange:16753920,orangered:16729344,orchid:14315734,palegoldenrod:15657130,palegreen:10025880,paleturquoise:11529966,palevioletred:14381203,papayawhip:16773077,peachpuff:16767673,peru:13468991,pink:16761035,plum:14524637,powderblue:11591910,purple:8388736,rebeccapurple:6697881,red:16711680,rosybrown:12357519,royalblue:4286945,saddlebrown:9127187,salmon:16416882,sandybrown:16032864,seagreen:3050327,seashell:16774638,sienna:10506797,silver:12632256,skyblue:8900331,slateblue:6970061,slategray:7372944,slategrey:7372944,snow:16775930,springgreen:65407,steelblue:4620980,tan:13808780,teal:32896,thistle:14204888,tomato:16737095,turquoise:4251856,violet:15631086,wheat:16113331,white:16777215,whitesmoke:16119285,yellow:16776960,yellowgreen:10145074});Ha.forEach(function(t,e){Ha.set(t,ft(e))}),Qr.functor=wt,Qr.xhr=bt(y),Qr.dsv=function(t,e){function n(t,n,a){arguments.length<3&&(a=n,n=null);var o=St(t,e,null==n?i:r(n),a);return o.row=function(t){return arguments.length?o.response(null==(n=t)?i:r(t)):n},o}function i(t){return n.parse(t.responseText)}function r(t){return function(e){return n.parse(e.responseText,t)}}function a(e){return e.map(o).join(t)}function o(t){return s.test(t)?'"'+t.replace(/\"/g,'""')+'"':t}var s=new RegExp('["'+t+"\n]"),u=t.charCodeAt(0);return n.parse=function(t,e){var i;return n.parseRows(t,function(t,n){if(i)return i(t,n-1);var r=new Function("d","return {"+t.map(function(t,e){return JSON.stringify(t)+": d["+e+"]"}).join(",")+"}");i=e?function(t,n){return e(r(t),n)}:r})},n.parseRows=function(t,e){function n(){if(l>=c)return o;if(r)return r=!1,a;var e=l;if(34===t.charCodeAt(e)){for(var n=e;n++<c;)if(34===t.charCodeAt(n)){if(34!==t.charCodeAt(n+1))break;++n}l=n+2;return 13===(i=t.charCodeAt(n+1))?(r=!0,10===t.charCodeAt(n+2)&&++l):10===i&&(r=!0),t.slice(e+1,n).replace(/""/g,'"')}for(;l<c;){var i,s=1;if(10===(i=t.charCodeAt(l++)))r=!0;else if(13===i)r=!0,10===t.charCodeAt(l)&&(++l,++s);else if(i!==u)continue;return t.slice(e,l-s)}return t.slice(e)}for(var i,r,a={},o={},s=[],c=t.length,l=0,h=0;(i=n())!==o;){for(var d=[];i!==a&&i!==o;)d.push(i),i=n();e&&null==(d=e(d,h++))||s.push(d)}return s},n.format=function(e){if(Array.isArray(e[0]))return n.formatRows(e);var i=new v,r=[];return e.forEach(function(t){for(var e in t)i.has(e)||r.push(i.add(e))}),[r.map(o).join(t)].concat(e.map(function(e){return r.map(function(t){return o(e[t])}).join(t)})).join("\n")},n.formatRows=function(t){return t.map(a).join("\n")},n},Qr.csv=Qr.dsv(",","text/csv"),Qr.tsv=Qr.dsv("\t","text/tab-separated-values");var Ya,Xa,Ba,Ua,qa=this[x(this,"requestAnimationFrame")]||function(t){setTimeout(t,17)};Qr.timer=function(){Tt.apply(this,arguments)},Qr.timer.flush=function(){Ct(),Mt()},Qr.round=function(t,e){return e?Math.round(t*(e=Math.pow(10,e)))/e:Math.round(t)};var Wa=["y","z","a","f","p","n","µ","m","","k","M","G","T","P","E","Z","Y"].map(function(t,e){var n=Math.pow(10,3*aa(8-e));return{scale:e>8?function(t){return t/n}:function(t){return t*n},symbol:t}});Qr.formatPrefix=function(t,e){var n=0;return(t=+t)&&(t<0&&(t*=-1),e&&(t=Qr.round(t,Pt(t,e))),n=1+Math.floor(1e-12+Math.log(t)/Math.LN10),n=Math.max(-24,Math.min(24,3*Math.floor((n-1)/3)))),Wa[8+n/3]};var Qa=/(?:([^{])?([<>=^]))?([+\- ])?([$#])?(0)?(\d+)?(,)?(\.-?\d+)?([a-z%])?/i,$a=Qr.map({b:function(t){return t.toString(2)},c:function(t){return String.fromCharCode(t)},o:function(t){return t.toString(8)},x:function(t){return t.toString(16)},X:function(t){return t.toString(16).toUpperCase()},g:function(t,e){return t.toPrecision(e)},e:function(t,e){return t.toExponential(e)},f:function(t,e){return t.toFixed(e)},r:function(t,e){return(t=Qr.round(t,Pt(t,e))).toFixed(Math.max(0,Math.min(20,Pt(t*(1+1e-15),e))))}}),Za=Qr.time={},Ka=Date;Et.prototype={getDate:function(){return this._.getUTCDate()},getDay:function(){return this._.getUTCDay()},getFullYear:function(){return this._.getUTCFullYear()},getHours:function(){return this._.getUTCHours()},getMilliseconds:function(){return this._.getUTCMilliseconds()},getMinutes:function(){return this._.getUTCMinutes()},getMonth:function(){return this._.getUTCMonth()},getSeconds:function(){return this._.getUTCSeconds()},getTime:function(){return this._.getTime()},getTimezoneOffset:function(){return 0},valueOf:function(){return this._.valueOf()},setDate:function(){Ja.setUTCDate.apply(this._,arguments)},setDay:function(){Ja.setUTCDay.apply(this._,arguments)},setFullYear:function(){Ja.setUTCFullYear.apply(this._,arguments)},setHours:function(){Ja.setUTCHours.apply(this._,arguments)},setMilliseconds:function(){Ja.setUTCMilliseconds.apply(this._,arguments)},setMinutes:function(){Ja.setUTCMinutes.apply(this._,arguments)},setMonth:function(){Ja.setUTCMonth.apply(this._,arguments)},setSeconds:function(){Ja.setUTCSeconds.apply(this._,arguments)},setTime:function(){Ja.setTime.apply(this._,arguments)}};var Ja=Date.prototype;Za.year=Lt(function(t){return(t=Za.day(t)).setMonth(0,1),t},function(t,e){t.setFullYear(t.getFullYear()+e)},function(t){return t.getFullYear()}),Za.years=Za.year.range,Za.years.utc=Za.year.utc.range,Za.day=Lt(function(t){var e=new Ka(2e3,0);return e.setFullYear(t.getFullYear(),t.getMonth(),t.getDate()),e},function(t,e){t.setDate(t.getDate()+e)},function(t){return t.getDate()-1}),Za.days=Za.day.range,Za.days.utc=Za.day.utc.range,Za.dayOfYear=function(t){var e=Za.year(t);return Math.floor((t-e-6e4*(t.getTimezoneOffset()-e.getTimezoneOffset()))/864e5)},["sunday","monday","tuesday","wednesday","thursday","friday","saturday"].forEach(function(t,e){e=7-e;var n=Za[t]=Lt(function(t){return(t=Za.day(t)).setDate(t.getDate()-(t.getDay()+e)%7),t},function(t,e){t.setDate(t.getDate()+7*Math.floor(e))},function(t){var n=Za.year(t).getDay();return Math.floor((Za.dayOfYear(t)+(n+e)%7)/7)-(n!==e)});Za[t+"s"]=n.range,Za[t+"s"].utc=n.utc.range,Za[t+"OfYear"]=function(t){var n=Za.year(t).getDay();return Math.floor((Za.dayOfYear(t)+(n+e)%7)/7)}}),Za.week=Za.sunday,Za.weeks=Za.sunday.range,Za.weeks.utc=Za.sunday.utc.range,Za.weekOfYear=Za.sundayOfYear;var to={"-":"",_:" ",0:"0"},eo=/^\s*\d+/,no=/^%/;Qr.locale=function(t){return{numberFormat:function(t){var e=t.decimal,n=t.thousands,i=t.grouping,r=t.currency,a=i&&n?function(t,e){for(var r=t.length,a=[],o=0,s=i[0],u=0;r>0&&s>0&&(u+s+1>e&&(s=Math.max(1,e-u)),a.push(t.substring(r-=s,r+s)),!((u+=s+1)>e));)s=i[o=(o+1)%i.length];return a.reverse().join(n)}:y;return function(t){var n=Qa.exec(t),i=n[1]||" ",o=n[2]||">",s=n[3]||"-",u=n[4]||"",c=n[5],l=+n[6],h=n[7],d=n[8],f=n[9],g=1,p="",m="",v=!1,y=!0;switch(d&&(d=+d.substring(1)),(c||"0"===i&&"="===o)&&(c=i="0",o="="),f){case"n":h=!0,f="g";break;case"%":g=100,m="%",f="f";break;case"p":g=100,m="%",f="r";break;case"b":case"o":case"x":case"X":"#"===u&&(p="0"+f.toLowerCase());case"c":y=!1;case"d":v=!0,d=0;break;case"s":g=-1,f="r"}"$"===u&&(p=r[0],m=r[1]),"r"!=f||d||(f="g"),null!=d&&("g"==f?d=Math.max(1,Math.min(21,d)):"e"!=f&&"f"!=f||(d=Math.max(0,Math.min(20,d)))),f=$a.get(f)||function(t){return t+""};var x=c&&h;return function(t){var n=m;if(v&&t%1)return"";var r=t<0||0===t&&1/t<0?(t=-t,"-"):"-"===s?"":s;if(g<0){var u=Qr.formatPrefix(t,d);t=u.scale(t),n=u.symbol+m}else t*=g;var _,w,b=(t=f(t,d)).lastIndexOf(".");if(b<0){var S=y?t.lastIndexOf("e"):-1;S<0?(_=t,w=""):(_=t.substring(0,S),w=t.substring(S))}else _=t.substring(0,b),w=e+t.substring(b+1);!c&&h&&(_=a(_,1/0));var T=p.length+_.length+w.length+(x?0:r.length),A=T<l?new Array(T=l-T+1).join(i):"";return x&&(_=a(A+_,A.length?l-w.length:1/0)),r+=p,t=_+w,("<"===o?r+t+A:">"===o?A+r+t:"^"===o?A.substring(0,T>>=1)+r+t+A.substring(T):r+(x?t:A+t))+n}}}(t),timeFormat:function(t){function e(t){function e(e){for(var n,r,a,o=[],s=-1,u=0;++s<i;)37===t.charCodeAt(s)&&(o.push(t.slice(u,s)),null!=(r=to[n=t.charAt(++s)])&&(n=t.charAt(++s)),(a=_[n])&&(n=a(e,null==r?"e"===n?" ":"0":r)),o.push(n),u=s+1);return o.push(t.slice(u,s)),o.join("")}var i=t.length;return e.parse=function(e){var i={y:1900,m:0,d:1,H:0,M:0,S:0,L:0,Z:null};if(n(i,t,e,0)!=e.length)return null;"p"in i&&(i.H=i.H%12+12*i.p);var r=null!=i.Z&&Ka!==Et,a=new(r?Et:Ka);return"j"in i?a.setFullYear(i.y,0,i.j):"W"in i||"U"in i?("w"in i||(i.w="W"in i?1:0),a.setFullYear(i.y,0,1),a.setFullYear(i.y,0,"W"in i?(i.w+6)%7+7*i.W-(a.getDay()+5)%7:i.w+7*i.U-(a.getDay()+6)%7)):a.setFullYear(i.y,i.m,i.d),a.setHours(i.H+(i.Z/100|0),i.M+i.Z%100,i.S,i.L),r?a._:a},e.toString=function(){return t},e}function n(t,e,n,i){for(var r,a,o,s=0,u=e.length,c=n.length;s<u;){if(i>=c)return-1;if(37===(r=e.charCodeAt(s++))){if(o=e.charAt(s++),!(a=w[o in to?e.charAt(s++):o])||(i=a(t,n,i))<0)return-1}else if(r!=n.charCodeAt(i++))return-1}return i}var i=t.dateTime,r=t.date,a=t.time,o=t.periods,s=t.days,u=t.shortDays,c=t.months,l=t.shortMonths;e.multi=(e.utc=function(t){function n(t){try{var e=new(Ka=Et);return e._=t,i(e)}finally{Ka=Date}}var i=e(t);return n.parse=function(t){try{Ka=Et;var e=i.parse(t);return e&&e._}finally{Ka=Date}},n.toString=i.toString,n}).multi=$t;var h=Qr.map(),d=Ot(s),f=It(s),g=Ot(u),p=It(u),m=Ot(c),v=It(c),y=Ot(l),x=It(l);o.forEach(function(t,e){h.set(t.toLowerCase(),e)});var _={a:function(t){return u[t.getDay()]},A:function(t){return s[t.getDay()]},b:function(t){return l[t.getMonth()]},B:function(t){return c[t.getMonth()]},c:e(i),d:function(t,e){return Dt(t.getDate(),e,2)},e:function(t,e){return Dt(t.getDate(),e,2)},H:function(t,e){return Dt(t.getHours(),e,2)},I:function(t,e){return Dt(t.getHours()%12||12,e,2)},j:function(t,e){return Dt(1+Za.dayOfYear(t),e,3)},L:function(t,e){return Dt(t.getMilliseconds(),e,3)},m:function(t,e){return Dt(t.getMonth()+1,e,2)},M:function(t,e){return Dt(t.getMinutes(),e,2)},p:function(t){return o[+(t.getHours()>=12)]},S:function(t,e){return Dt(t.getSeconds(),e,2)},U:function(t,e){return Dt(Za.sundayOfYear(t),e,2)},w:function(t){return t.getDay()},W:function(t,e){return Dt(Za.mondayOfYear(t),e,2)},x:e(r),X:e(a),y:function(t,e){return Dt(t.getFullYear()%100,e,2)},Y:function(t,e){return Dt(t.getFullYear()%1e4,e,4)},Z:Wt,"%":function(){return"%"}},w={a:function(t,e,n){g.lastIndex=0;var i=g.exec(e.slice(n));return i?(t.w=p.get(i[0].toLowerCase()),n+i[0].length):-1},A:function(t,e,n){d.lastIndex=0;var i=d.exec(e.slice(n));return i?(t.w=f.get(i[0].toLowerCase()),n+i[0].length):-1},b:function(t,e,n){y.lastIndex=0;var i=y.exec(e.slice(n));return i?(t.m=x.get(i[0].toLowerCase()),n+i[0].length):-1},B:function(t,e,n){m.lastIndex=0;var i=m.exec(e.slice(n));return i?(t.m=v.get(i[0].toLowerCase()),n+i[0].length):-1},c:function(t,e,i){return n(t,_.c.toString(),e,i)},d:Ht,e:Ht,H:Xt,I:Xt,j:Yt,L:qt,m:zt,M:Bt,p:function(t,e,n){var i=h.get(e.slice(n,n+=2).toLowerCase());return null==i?-1:(t.p=i,n)},S:Ut,U:Gt,w:Vt,W:Rt,x:function(t,e,i){return n(t,_.x.toString(),e,i)},X:function(t,e,i){return n(t,_.X.toString(),e,i)},y:jt,Y:Nt,Z:Ft,"%":Qt};return e}(t)}};var io=Qr.locale({decimal:".",thousands:",",grouping:[3],currency:["$",""],dateTime:"%a %b %e %X %Y",date:"%m/%d/%Y",time:"%H:%M:%S",periods:["AM","PM"],days:["Sunday","Monday","Tuesday","Wednesday","Thursday","Friday","Saturday"],shortDays:["Sun","Mon","Tue","Wed","Thu","Fri","Sat"],months:["January","February","March","April","May","June","July","August","September","October","November","December"],shortMonths:["Jan","Feb","Mar","Apr","May","Jun","Jul","Aug","Sep","Oct","Nov","Dec"]});Qr.format=io.numberFormat,Qr.geo={},Zt.prototype={s:0,t:0,add:function(t){Kt(t,this.t,ro),Kt(ro.s,this.s,this),this.s?this.t+=ro.t:this.s=ro.t},reset:function(){this.s=this.t=0},valueOf:function(){return this.s}};var ro=new Zt;Qr.geo.stream=function(t,e){t&&ao.hasOwnProperty(t.type)?ao[t.type](t,e):Jt(t,e)};var ao={Feature:function(t,e){Jt(t.geometry,e)},FeatureCollection:function(t,e){for(var n=t.features,i=-1,r=n.length;++i<r;)Jt(n[i].geometry,e)}},oo={Sphere:function(t,e){e.sphere()},Point:function(t,e){t=t.coordinates,e.point(t[0],t[1],t[2])},MultiPoint:function(t,e){for(var n=t.coordinates,i=-1,r=n.length;++i<r;)t=n[i],e.point(t[0],t[1],t[2])},LineString:function(t,e){te(t.coordinates,e,0)},MultiLineString:function(t,e){for(var n=t.coordinates,i=-1,r=n.length;++i<r;)te(n[i],e,0)},Polygon:function(t,e){ee(t.coordinates,e)},MultiPolygon:function(t,e){for(var n=t.coordinates,i=-1,r=n.length;++i<r;)ee(n[i],e)},GeometryCollection:function(t,e){for(var n=t.geometries,i=-1,r=n.length;++i<r;)Jt(n[i],e)}};Qr.geo.area=function(t){return so=0,Qr.geo.stream(t,co),so};var so,uo=new Zt,co={sphere:function(){so+=4*Ta},point:_,lineStart:_,lineEnd:_,polygonStart:function(){uo.reset(),co.lineStart=ne},polygonEnd:function(){var t=2*uo;so+=t<0?4*Ta+t:t,co.lineStart=co.lineEnd=co.point=_}};Qr.geo.bounds=function(){function t(t,e){x.push(_=[l=t,d=t]),e<h&&(h=e),e>f&&(f=e)}function e(e,n){var i=ie([e*Pa,n*Pa]);if(v){var r=ae(v,i),a=ae([r[1],-r[0],0],r);ue(a),a=ce(a);var o=e-g,u=o>0?1:-1,c=a[0]*Ea*u,p=aa(o)>180;if(p^(u*g<c&&c<u*e)){(m=a[1]*Ea)>f&&(f=m)}else if(c=(c+360)%360-180,p^(u*g<c&&c<u*e)){var m;(m=-a[1]*Ea)<h&&(h=m)}else n<h&&(h=n),n>f&&(f=n);p?e<g?s(l,e)>s(l,d)&&(d=e):s(e,d)>s(l,d)&&(l=e):d>=l?(e<l&&(l=e),e>d&&(d=e)):e>g?s(l,e)>s(l,d)&&(d=e):s(e,d)>s(l,d)&&(l=e)}else t(e,n);v=i,g=e}function n(){w.point=e}function i(){_[0]=l,_[1]=d,w.point=t,v=null}function r(t,n){if(v){var i=t-g;y+=aa(i)>180?i+(i>0?360:-360):i}else p=t,m=n;co.point(t,n),e(t,n)}function a(){co.lineStart()}function o(){r(p,m),co.lineEnd(),aa(y)>ba&&(l=-(d=180)),_[0]=l,_[1]=d,v=null}function s(t,e){return(e-=t)<0?e+360:e}function u(t,e){return t[0]-e[0]}function c(t,e){return e[0]<=e[1]?e[0]<=t&&t<=e[1]:t<e[0]||e[1]<t}var l,h,d,f,g,p,m,v,y,x,_,w={point:t,lineStart:n,lineEnd:i,polygonStart:function(){w.point=r,w.lineStart=a,w.lineEnd=o,y=0,co.polygonStart()},polygonEnd:function(){co.polygonEnd(),w.point=t,w.lineStart=n,w.lineEnd=i,uo<0?(l=-(d=180),h=-(f=90)):y>ba?f=90:y<-ba&&(h=-90),_[0]=l,_[1]=d}};return function(t){f=d=-(l=h=1/0),x=[],Qr.geo.stream(t,w);if(r=x.length){x.sort(u);for(var e=1,n=[g=x[0]];e<r;++e)c((a=x[e])[0],g)||c(a[1],g)?(s(g[0],a[1])>s(g[0],g[1])&&(g[1]=a[1]),s(a[0],g[1])>s(g[0],g[1])&&(g[0]=a[0])):n.push(g=a);for(var i,r,a,o=-1/0,g=(e=0,n[r=n.length-1]);e<=r;g=a,++e)a=n[e],(i=s(g[1],a[0]))>o&&(o=i,l=a[0],d=g[1])}return x=_=null,l===1/0||h===1/0?[[NaN,NaN],[NaN,NaN]]:[[l,h],[d,f]]}}(),Qr.geo.centroid=function(t){lo=ho=fo=go=po=mo=vo=yo=xo=_o=wo=0,Qr.geo.stream(t,bo);var e=xo,n=_o,i=wo,r=e*e+n*n+i*i;return r<Sa&&(e=mo,n=vo,i=yo,ho<ba&&(e=fo,n=go,i=po),(r=e*e+n*n+i*i)<Sa)?[NaN,NaN]:[Math.atan2(n,e)*Ea,K(i/Math.sqrt(r))*Ea]};var lo,ho,fo,go,po,mo,vo,yo,xo,_o,wo,bo={sphere:_,point:he,lineStart:fe,lineEnd:ge,polygonStart:function(){bo.lineStart=pe},polygonEnd:function(){bo.lineStart=fe}},So=we(ve,function(t){var e,n=NaN,i=NaN,r=NaN;return{lineStart:function(){t.lineStart(),e=1},point:function(a,o){var s=a>0?Ta:-Ta,u=aa(a-n);aa(u-Ta)<ba?(t.point(n,i=(i+o)/2>0?Ma:-Ma),t.point(r,i),t.lineEnd(),t.lineStart(),t.point(s,i),t.point(a,i),e=0):r!==s&&u>=Ta&&(aa(n-r)<ba&&(n-=r*ba),aa(a-s)<ba&&(a-=s*ba),i=function(t,e,n,i){var r,a,o=Math.sin(t-n);return aa(o)>ba?Math.atan((Math.sin(e)*(a=Math.cos(i))*Math.sin(n)-Math.sin(i)*(r=Math.cos(e))*Math.sin(t))/(r*a*o)):(e+i)/2}(n,i,a,o),t.point(r,i),t.lineEnd(),t.lineStart(),t.point(s,i),e=0),t.point(n=a,i=o),r=s},lineEnd:function(){t.lineEnd(),n=i=NaN},clean:function(){return 2-e}}},function(t,e,n,i){var r;if(null==t)r=n*Ma,i.point(-Ta,r),i.point(0,r),i.point(Ta,r),i.point(Ta,0),i.point(Ta,-r),i.point(0,-r),i.point(-Ta,-r),i.point(-Ta,0),i.point(-Ta,r);else if(aa(t[0]-e[0])>ba){var a=t[0]<e[0]?Ta:-Ta;r=n*a/2,i.point(-a,r),i.point(0,r),i.point(a,r)}else i.point(e[0],e[1])},[-Ta,-Ta/2]),To=1e9;Qr.geo.clipExtent=function(){var t,e,n,i,r,a,o={stream:function(t){return r&&(r.valid=!1),r=a(t),r.valid=!0,r},extent:function(s){return arguments.length?(a=Ce(t=+s[0][0],e=+s[0][1],n=+s[1][0],i=+s[1][1]),r&&(r.valid=!1,r=null),o):[[t,e],[n,i]]}};return o.extent([[0,0],[960,500]])},(Qr.geo.conicEqualArea=function(){return Me(Pe)}).raw=Pe,Qr.geo.albers=function(){return Qr.geo.conicEqualArea().rotate([96,0]).center([-.6,38.7]).parallels([29.5,45.5]).scale(1070)},Qr.geo.albersUsa=function(){function t(t){var a=t[0],o=t[1];return e=null,n(a,o),e||(i(a,o),e)||r(a,o),e}var e,n,i,r,a=Qr.geo.albers(),o=Qr.geo.conicEqualArea().rotate([154,0]).center([-2,58.5]).parallels([55,65]),s=Qr.geo.conicEqualArea().rotate([157,0]).center([-3,19.9]).parallels([8,18]),u={point:function(t,n){e=[t,n]}};return t.invert=function(t){var e=a.scale(),n=a.translate(),i=(t[0]-n[0])/e,r=(t[1]-n[1])/e;return(r>=.12&&r<.234&&i>=-.425&&i<-.214?o:r>=.166&&r<.234&&i>=-.214&&i<-.115?s:a).invert(t)},t.stream=function(t){var e=a.stream(t),n=o.stream(t),i=s.stream(t);return{point:function(t,r){e.point(t,r),n.point(t,r),i.point(t,r)},sphere:function(){e.sphere(),n.sphere(),i.sphere()},lineStart:function(){e.lineStart(),n.lineStart(),i.lineStart()},lineEnd:function(){e.lineEnd(),n.lineEnd(),i.lineEnd()},polygonStart:function(){e.polygonStart(),n.polygonStart(),i.polygonStart()},polygonEnd:function(){e.polygonEnd(),n.polygonEnd(),i.polygonEnd()}}},t.precision=function(e){return arguments.length?(a.precision(e),o.precision(e),s.precision(e),t):a.precision()},t.scale=function(e){return arguments.length?(a.scale(e),o.scale(.35*e),s.scale(e),t.translate(a.translate())):a.scale()},t.translate=function(e){if(!arguments.length)return a.translate();var c=a.scale(),l=+e[0],h=+e[1];return n=a.translate(e).clipExtent([[l-.455*c,h-.238*c],[l+.455*c,h+.238*c]]).stream(u).point,i=o.translate([l-.307*c,h+.201*c]).clipExtent([[l-.425*c+ba,h+.12*c+ba],[l-.214*c-ba,h+.234*c-ba]]).stream(u).point,r=s.translate([l-.205*c,h+.212*c]).clipExtent([[l-.214*c+ba,h+.166*c+ba],[l-.115*c-ba,h+.234*c-ba]]).stream(u).point,t},t.scale(1070)};var Ao,Co,Mo,Po,Eo,Lo,ko={point:_,lineStart:_,lineEnd:_,polygonStart:function(){Co=0,ko.lineStart=Ee},polygonEnd:function(){ko.lineStart=ko.lineEnd=ko.point=_,Ao+=aa(Co/2)}},Do={point:function(t,e){t<Mo&&(Mo=t),t>Eo&&(Eo=t),e<Po&&(Po=e),e>Lo&&(Lo=e)},lineStart:_,lineEnd:_,polygonStart:_,polygonEnd:_},Oo={point:ke,lineStart:De,lineEnd:Oe,polygonStart:function(){Oo.lineStart=Ie},polygonEnd:function(){Oo.point=ke,Oo.lineStart=De,Oo.lineEnd=Oe}};Qr.geo.path=function(){function t(t){return t&&("function"==typeof s&&a.pointRadius(+s.apply(this,arguments)),o&&o.valid||(o=r(a)),Qr.geo.stream(t,o)),a.result()}function e(){return o=null,t}var n,i,r,a,o,s=4.5;return t.area=function(t){return Ao=0,Qr.geo.stream(t,r(ko)),Ao},t.centroid=function(t){return fo=go=po=mo=vo=yo=xo=_o=wo=0,Qr.geo.stream(t,r(Oo)),wo?[xo/wo,_o/wo]:yo?[mo/yo,vo/yo]:po?[fo/po,go/po]:[NaN,NaN]},t.bounds=function(t){return Eo=Lo=-(Mo=Po=1/0),Qr.geo.stream(t,r(Do)),[[Mo,Po],[Eo,Lo]]},t.projection=function(t){return arguments.length?(r=(n=t)?t.stream||function(t){var e=Ve(function(e,n){return t([e*Ea,n*Ea])});return function(t){return Fe(e(t))}}(t):y,e()):n},t.context=function(t){return arguments.length?(a=null==(i=t)?new function(){function t(t,e){o.push("M",t,",",e,a)}function e(t,e){o.push("M",t,",",e),s.point=n}function n(t,e){o.push("L",t,",",e)}function i(){s.point=t}function r(){o.push("Z")}var a=Le(4.5),o=[],s={point:t,lineStart:function(){s.point=e},lineEnd:i,polygonStart:function(){s.lineEnd=r},polygonEnd:function(){s.lineEnd=i,s.point=t},pointRadius:function(t){return a=Le(t),s},result:function(){if(o.length){var t=o.join("");return o=[],t}}};return s}:new function(t){function e(e,n){t.moveTo(e+o,n),t.arc(e,n,o,0,Aa)}function n(e,n){t.moveTo(e,n),s.point=i}function i(e,n){t.lineTo(e,n)}function r(){s.point=e}function a(){t.closePath()}var o=4.5,s={point:e,lineStart:function(){s.point=n},lineEnd:r,polygonStart:function(){s.lineEnd=a},polygonEnd:function(){s.lineEnd=r,s.point=e},pointRadius:function(t){return o=t,s},result:_};return s}(t),"function"!=typeof s&&a.pointRadius(s),e()):i},t.pointRadius=function(e){return arguments.length?(s="function"==typeof e?e:(a.pointRadius(+e),+e),t):s},t.projection(Qr.geo.albersUsa()).context(null)},Qr.geo.transform=function(t){return{stream:function(e){var n=new Ge(e);for(var i in t)n[i]=t[i];return n}}},Ge.prototype={point:function(t,e){this.stream.point(t,e)},sphere:function(){this.stream.sphere()},lineStart:function(){this.stream.lineStart()},lineEnd:function(){this.stream.lineEnd()},polygonStart:function(){this.stream.polygonStart()},polygonEnd:function(){this.stream.polygonEnd()}},Qr.geo.projection=Ne,Qr.geo.projectionMutator=je,(Qr.geo.equirectangular=function(){return Ne(ze)}).raw=ze.invert=ze,Qr.geo.rotation=function(t){function e(e){return e=t(e[0]*Pa,e[1]*Pa),e[0]*=Ea,e[1]*=Ea,e}return t=Ye(t[0]%360*Pa,t[1]*Pa,t.length>2?t[2]*Pa:0),e.invert=function(e){return e=t.invert(e[0]*Pa,e[1]*Pa),e[0]*=Ea,e[1]*=Ea,e},e},He.invert=ze,Qr.geo.circle=function(){function t(){var t="function"==typeof i?i.apply(this,arguments):i,e=Ye(-t[0]*Pa,-t[1]*Pa,0).invert,r=[];return n(null,null,1,{point:function(t,n){r.push(t=e(t,n)),t[0]*=Ea,t[1]*=Ea}}),{type:"Polygon",coordinates:[r]}}var e,n,i=[0,0],r=6;return t.origin=function(e){return arguments.length?(i=e,t):i},t.angle=function(i){return arguments.length?(n=qe((e=+i)*Pa,r*Pa),t):e},t.precision=function(i){return arguments.length?(n=qe(e*Pa,(r=+i)*Pa),t):r},t.angle(90)},Qr.geo.distance=function(t,e){var n,i=(e[0]-t[0])*Pa,r=t[1]*Pa,a=e[1]*Pa,o=Math.sin(i),s=Math.cos(i),u=Math.sin(r),c=Math.cos(r),l=Math.sin(a),h=Math.cos(a);return Math.atan2(Math.sqrt((n=h*o)*n+(n=c*l-u*h*s)*n),u*l+c*h*s)},Qr.geo.graticule=function(){function t(){return{type:"MultiLineString",coordinates:e()}}function e(){return Qr.range(Math.ceil(a/m)*m,r,m).map(d).concat(Qr.range(Math.ceil(c/v)*v,u,v).map(f)).concat(Qr.range(Math.ceil(i/g)*g,n,g).filter(function(t){return aa(t%m)>ba}).map(l)).concat(Qr.range(Math.ceil(s/p)*p,o,p).filter(function(t){return aa(t%v)>ba}).map(h))}var n,i,r,a,o,s,u,c,l,h,d,f,g=10,p=g,m=90,v=360,y=2.5;return t.lines=function(){return e().map(function(t){return{type:"LineString",coordinates:t}})},t.outline=function(){return{type:"Polygon",coordinates:[d(a).concat(f(u).slice(1),d(r).reverse().slice(1),f(c).reverse().slice(1))]}},t.extent=function(e){return arguments.length?t.majorExtent(e).minorExtent(e):t.minorExtent()},t.majorExtent=function(e){return arguments.length?(a=+e[0][0],r=+e[1][0],c=+e[0][1],u=+e[1][1],a>r&&(e=a,a=r,r=e),c>u&&(e=c,c=u,u=e),t.precision(y)):[[a,c],[r,u]]},t.minorExtent=function(e){return arguments.length?(i=+e[0][0],n=+e[1][0],s=+e[0][1],o=+e[1][1],i>n&&(e=i,i=n,n=e),s>o&&(e=s,s=o,o=e),t.precision(y)):[[i,s],[n,o]]},t.step=function(e){return arguments.length?t.majorStep(e).minorStep(e):t.minorStep()},t.majorStep=function(e){return arguments.length?(m=+e[0],v=+e[1],t):[m,v]},t.minorStep=function(e){return arguments.length?(g=+e[0],p=+e[1],t):[g,p]},t.precision=function(e){return arguments.length?(y=+e,l=Qe(s,o,90),h=$e(i,n,y),d=Qe(c,u,90),f=$e(a,r,y),t):y},t.majorExtent([[-180,-90+ba],[180,90-ba]]).minorExtent([[-180,-80-ba],[180,80+ba]])},Qr.geo.greatArc=function(){function t(){return{type:"LineString",coordinates:[e||i.apply(this,arguments),n||r.apply(this,arguments)]}}var e,n,i=Ze,r=Ke;return t.distance=function(){return Qr.geo.distance(e||i.apply(this,arguments),n||r.apply(this,arguments))},t.source=function(n){return arguments.length?(i=n,e="function"==typeof n?null:n,t):i},t.target=function(e){return arguments.length?(r=e,n="function"==typeof e?null:e,t):r},t.precision=function(){return arguments.length?t:0},t},Qr.geo.interpolate=function(t,e){return function(t,e,n,i){var r=Math.cos(e),a=Math.sin(e),o=Math.cos(i),s=Math.sin(i),u=r*Math.cos(t),c=r*Math.sin(t),l=o*Math.cos(n),h=o*Math.sin(n),d=2*Math.asin(Math.sqrt(tt(i-e)+r*o*tt(n-t))),f=1/Math.sin(d),g=d?function(t){var e=Math.sin(t*=d)*f,n=Math.sin(d-t)*f,i=n*u+e*l,r=n*c+e*h,o=n*a+e*s;return[Math.atan2(r,i)*Ea,Math.atan2(o,Math.sqrt(i*i+r*r))*Ea]}:function(){return[t*Ea,e*Ea]};return g.distance=d,g}(t[0]*Pa,t[1]*Pa,e[0]*Pa,e[1]*Pa)},Qr.geo.length=function(t){return Io=0,Qr.geo.stream(t,Vo),Io};var Io,Vo={sphere:_,point:_,lineStart:function(){function t(t,r){var a=Math.sin(r*=Pa),o=Math.cos(r),s=aa((t*=Pa)-e),u=Math.cos(s);Io+=Math.atan2(Math.sqrt((s=o*Math.sin(s))*s+(s=i*a-n*o*u)*s),n*a+i*o*u),e=t,n=a,i=o}var e,n,i;Vo.point=function(r,a){e=r*Pa,n=Math.sin(a*=Pa),i=Math.cos(a),Vo.point=t},Vo.lineEnd=function(){Vo.point=Vo.lineEnd=_}},lineEnd:_,polygonStart:_,polygonEnd:_},Go=Je(function(t){return Math.sqrt(2/(1+t))},function(t){return 2*Math.asin(t/2)});(Qr.geo.azimuthalEqualArea=function(){return Ne(Go)}).raw=Go;var Ro=Je(function(t){var e=Math.acos(t);return e&&e/Math.sin(e)},y);(Qr.geo.azimuthalEquidistant=function(){return Ne(Ro)}).raw=Ro,(Qr.geo.conicConformal=function(){return Me(tn)}).raw=tn,(Qr.geo.conicEquidistant=function(){return Me(en)}).raw=en;var No=Je(function(t){return 1/t},Math.atan);(Qr.geo.gnomonic=function(){return Ne(No)}).raw=No,nn.invert=function(t,e){return[t,2*Math.atan(Math.exp(e))-Ma]},(Qr.geo.mercator=function(){return rn(nn)}).raw=nn;var jo=Je(function(){return 1},Math.asin);(Qr.geo.orthographic=function(){return Ne(jo)}).raw=jo;var Fo=Je(function(t){return 1/(1+t)},function(t){return 2*Math.atan(t)});(Qr.geo.stereographic=function(){return Ne(Fo)}).raw=Fo,an.invert=function(t,e){return[-e,2*Math.atan(Math.exp(t))-Ma]},(Qr.geo.transverseMercator=function(){var t=rn(an),e=t.center,n=t.rotate;return t.center=function(t){return t?e([-t[1],t[0]]):(t=e(),[t[1],-t[0]])},t.rotate=function(t){return t?n([t[0],t[1],t.length>2?t[2]+90:90]):(t=n(),[t[0],t[1],t[2]-90])},n([0,0,90])}).raw=an,Qr.geom={},Qr.geom.hull=function(t){function e(t){if(t.length<3)return[];var e,r=wt(n),a=wt(i),o=t.length,s=[],u=[];for(e=0;e<o;e++)s.push([+r.call(this,t[e],e),+a.call(this,t[e],e),e]);for(s.sort(cn),e=0;e<o;e++)u.push([s[e][0],-s[e][1]]);var c=un(s),l=un(u),h=l[0]===c[0],d=l[l.length-1]===c[c.length-1],f=[];for(e=c.length-1;e>=0;--e)f.push(t[s[c[e]][2]]);for(e=+h;e<l.length-d;++e)f.push(t[s[l[e]][2]]);return f}var n=on,i=sn;return arguments.length?e(t):(e.x=function(t){return arguments.length?(n=t,e):n},e.y=function(t){return arguments.length?(i=t,e):i},e)},Qr.geom.polygon=function(t){return la(t,zo),t};var zo=Qr.geom.polygon.prototype=[];zo.area=function(){for(var t,e=-1,n=this.length,i=this[n-1],r=0;++e<n;)t=i,i=this[e],r+=t[1]*i[0]-t[0]*i[1];return.5*r},zo.centroid=function(t){var e,n,i=-1,r=this.length,a=0,o=0,s=this[r-1];for(arguments.length||(t=-1/(6*this.area()));++i<r;)e=s,s=this[i],n=e[0]*s[1]-s[0]*e[1],a+=(e[0]+s[0])*n,o+=(e[1]+s[1])*n;return[a*t,o*t]},zo.clip=function(t){for(var e,n,i,r,a,o,s=dn(t),u=-1,c=this.length-dn(this),l=this[c-1];++u<c;){for(e=t.slice(),t.length=0,r=this[u],a=e[(i=e.length-s)-1],n=-1;++n<i;)ln(o=e[n],l,r)?(ln(a,l,r)||t.push(hn(a,o,l,r)),t.push(o)):ln(a,l,r)&&t.push(hn(a,o,l,r)),a=o;s&&t.push(t[0]),l=r}return t};var Ho,Yo,Xo,Bo,Uo,qo=[],Wo=[];yn.prototype.prepare=function(){for(var t,e=this.edges,n=e.length;n--;)(t=e[n].edge).b&&t.a||e.splice(n,1);return e.sort(_n),e.length},Cn.prototype={start:function(){return this.edge.l===this.site?this.edge.a:this.edge.b},end:function(){return this.edge.l===this.site?this.edge.b:this.edge.a}},Mn.prototype={insert:function(t,e){var n,i,r;if(t){if(e.P=t,e.N=t.N,t.N&&(t.N.P=e),t.N=e,t.R){for(t=t.R;t.L;)t=t.L;t.L=e}else t.R=e;n=t}else this._?(t=kn(this._),e.P=null,e.N=t,t.P=t.L=e,n=t):(e.P=e.N=null,this._=e,n=null);for(e.L=e.R=null,e.U=n,e.C=!0,t=e;n&&n.C;)n===(i=n.U).L?(r=i.R)&&r.C?(n.C=r.C=!1,i.C=!0,t=i):(t===n.R&&(En(this,n),n=(t=n).U),n.C=!1,i.C=!0,Ln(this,i)):(r=i.L)&&r.C?(n.C=r.C=!1,i.C=!0,t=i):(t===n.L&&(Ln(this,n),n=(t=n).U),n.C=!1,i.C=!0,En(this,i)),n=t.U;this._.C=!1},remove:function(t){t.N&&(t.N.P=t.P),t.P&&(t.P.N=t.N),t.N=t.P=null;var e,n,i,r=t.U,a=t.L,o=t.R;if(n=a?o?kn(o):a:o,r?r.L===t?r.L=n:r.R=n:this._=n,a&&o?(i=n.C,n.C=t.C,n.L=a,a.U=n,n!==o?(r=n.U,n.U=t.U,t=n.R,r.L=t,n.R=o,o.U=n):(n.U=r,r=n,t=n.R)):(i=t.C,t=n),t&&(t.U=r),!i)if(t&&t.C)t.C=!1;else{do{if(t===this._)break;if(t===r.L){if((e=r.R).C&&(e.C=!1,r.C=!0,En(this,r),e=r.R),e.L&&e.L.C||e.R&&e.R.C){e.R&&e.R.C||(e.L.C=!1,e.C=!0,Ln(this,e),e=r.R),e.C=r.C,r.C=e.R.C=!1,En(this,r),t=this._;break}}else if((e=r.L).C&&(e.C=!1,r.C=!0,Ln(this,r),e=r.L),e.L&&e.L.C||e.R&&e.R.C){e.L&&e.L.C||(e.R.C=!1,e.C=!0,En(this,e),e=r.L),e.C=r.C,r.C=e.L.C=!1,Ln(this,r),t=this._;break}e.C=!0,t=r,r=r.U}while(!t.C);t&&(t.C=!1)}}},Qr.geom.voronoi=function(t){function e(t){var e=new Array(t.length),i=s[0][0],r=s[0][1],a=s[1][0],o=s[1][1];return Dn(n(t),s).cells.forEach(function(n,s){var u=n.edges,c=n.site;(e[s]=u.length?u.map(function(t){var e=t.start();return[e.x,e.y]}):c.x>=i&&c.x<=a&&c.y>=r&&c.y<=o?[[i,o],[a,o],[a,r],[i,r]]:[]).point=t[s]}),e}function n(t){return t.map(function(t,e){return{x:Math.round(a(t,e)/ba)*ba,y:Math.round(o(t,e)/ba)*ba,i:e}})}var i=on,r=sn,a=i,o=r,s=Qo;return t?e(t):(e.links=function(t){return Dn(n(t)).edges.filter(function(t){return t.l&&t.r}).map(function(e){return{source:t[e.l.i],target:t[e.r.i]}})},e.triangles=function(t){var e=[];return Dn(n(t)).cells.forEach(function(n,i){for(var r,a=n.site,o=n.edges.sort(_n),s=-1,u=o.length,c=o[u-1].edge,l=c.l===a?c.r:c.l;++s<u;)c,r=l,l=(c=o[s].edge).l===a?c.r:c.l,i<r.i&&i<l.i&&function(t,e,n){return(t.x-n.x)*(e.y-t.y)-(t.x-e.x)*(n.y-t.y)}(a,r,l)<0&&e.push([t[i],t[r.i],t[l.i]])}),e},e.x=function(t){return arguments.length?(a=wt(i=t),e):i},e.y=function(t){return arguments.length?(o=wt(r=t),e):r},e.clipExtent=function(t){return arguments.length?(s=null==t?Qo:t,e):s===Qo?null:s},e.size=function(t){return arguments.length?e.clipExtent(t&&[[0,0],t]):s===Qo?null:s&&s[1]},e)};var Qo=[[-1e6,-1e6],[1e6,1e6]];Qr.geom.delaunay=function(t){return Qr.geom.voronoi().triangles(t)},Qr.geom.quadtree=function(t,e,n,i,r){function a(t){function a(t,e,n,i,r,a,o,s){if(!isNaN(n)&&!isNaN(i))if(t.leaf){var u=t.x,l=t.y;if(null!=u)if(aa(u-n)+aa(l-i)<.01)c(t,e,n,i,r,a,o,s);else{var h=t.point;t.x=t.y=t.point=null,c(t,h,u,l,r,a,o,s),c(t,e,n,i,r,a,o,s)}else t.x=n,t.y=i,t.point=e}else c(t,e,n,i,r,a,o,s)}function c(t,e,n,i,r,o,s,u){var c=.5*(r+s),l=.5*(o+u),h=n>=c,d=i>=l,f=d<<1|h;t.leaf=!1,t=t.nodes[f]||(t.nodes[f]={leaf:!0,nodes:[],point:null,x:null,y:null}),h?r=c:s=c,d?o=l:u=l,a(t,e,n,i,r,o,s,u)}var l,h,d,f,g,p,m,v,y,x=wt(s),_=wt(u);if(null!=e)p=e,m=n,v=i,y=r;else if(v=y=-(p=m=1/0),h=[],d=[],g=t.length,o)for(f=0;f<g;++f)(l=t[f]).x<p&&(p=l.x),l.y<m&&(m=l.y),l.x>v&&(v=l.x),l.y>y&&(y=l.y),h.push(l.x),d.push(l.y);else for(f=0;f<g;++f){var w=+x(l=t[f],f),b=+_(l,f);w<p&&(p=w),b<m&&(m=b),w>v&&(v=w),b>y&&(y=b),h.push(w),d.push(b)}var S=v-p,T=y-m;S>T?y=m+S:v=p+T;var A={leaf:!0,nodes:[],point:null,x:null,y:null};if(A.add=function(t){a(A,t,+x(t,++f),+_(t,f),p,m,v,y)},A.visit=function(t){Gn(t,A,p,m,v,y)},A.find=function(t){return function(t,e,n,i,r,a,o){var s,u=1/0;return function t(c,l,h,d,f){if(!(l>a||h>o||d<i||f<r)){if(g=c.point){var g,p=e-c.x,m=n-c.y,v=p*p+m*m;if(v<u){var y=Math.sqrt(u=v);i=e-y,r=n-y,a=e+y,o=n+y,s=g}}for(var x=c.nodes,_=.5*(l+d),w=.5*(h+f),b=(n>=w)<<1|e>=_,S=b+4;b<S;++b)if(c=x[3&b])switch(3&b){case 0:t(c,l,h,_,w);break;case 1:t(c,_,h,d,w);break;case 2:t(c,l,w,_,f);break;case 3:t(c,_,w,d,f)}}}(t,i,r,a,o),s}(A,t[0],t[1],p,m,v,y)},f=-1,null==e){for(;++f<g;)a(A,t[f],h[f],d[f],p,m,v,y);--f}else t.forEach(A.add);return h=d=t=l=null,A}var o,s=on,u=sn;return(o=arguments.length)?(s=In,u=Vn,3===o&&(r=n,i=e,n=e=0),a(t)):(a.x=function(t){return arguments.length?(s=t,a):s},a.y=function(t){return arguments.length?(u=t,a):u},a.extent=function(t){return arguments.length?(null==t?e=n=i=r=null:(e=+t[0][0],n=+t[0][1],i=+t[1][0],r=+t[1][1]),a):null==e?null:[[e,n],[i,r]]},a.size=function(t){return arguments.length?(null==t?e=n=i=r=null:(e=n=0,i=+t[0],r=+t[1]),a):null==e?null:[i-e,r-n]},a)},Qr.interpolateRgb=Rn,Qr.interpolateObject=Nn,Qr.interpolateNumber=jn,Qr.interpolateString=Fn;var $o=/[-+]?(?:\d+\.?\d*|\.?\d+)(?:[eE][-+]?\d+)?/g,Zo=new RegExp($o.source,"g");Qr.interpolate=zn,Qr.interpolators=[function(t,e){var n=typeof e;return("string"===n?Ha.has(e.toLowerCase())||/^(#|rgb\(|hsl\()/i.test(e)?Rn:Fn:e instanceof et?Rn:Array.isArray(e)?Hn:"object"===n&&isNaN(e)?Nn:jn)(t,e)}],Qr.interpolateArray=Hn;var Ko=function(){return y},Jo=Qr.map({linear:Ko,poly:function(t){return function(e){return Math.pow(e,t)}},quad:function(){return Bn},cubic:function(){return Un},sin:function(){return qn},exp:function(){return Wn},circle:function(){return Qn},elastic:function(t,e){var n;return arguments.length<2&&(e=.45),arguments.length?n=e/Aa*Math.asin(1/t):(t=1,n=e/4),function(i){return 1+t*Math.pow(2,-10*i)*Math.sin((i-n)*Aa/e)}},back:function(t){return t||(t=1.70158),function(e){return e*e*((t+1)*e-t)}},bounce:function(){return $n}}),ts=Qr.map({in:y,out:Yn,"in-out":Xn,"out-in":function(t){return Xn(Yn(t))}});Qr.ease=function(t){var e=t.indexOf("-"),n=e>=0?t.slice(0,e):t,i=e>=0?t.slice(e+1):"in";return n=Jo.get(n)||Ko,i=ts.get(i)||y,function(t){return function(e){return e<=0?0:e>=1?1:t(e)}}(i(n.apply(null,$r.call(arguments,1))))},Qr.interpolateHcl=function(t,e){t=Qr.hcl(t),e=Qr.hcl(e);var n=t.h,i=t.c,r=t.l,a=e.h-n,o=e.c-i,s=e.l-r;return isNaN(o)&&(o=0,i=isNaN(i)?e.c:i),isNaN(a)?(a=0,n=isNaN(n)?e.h:n):a>180?a-=360:a<-180&&(a+=360),function(t){return at(n+a*t,i+o*t,r+s*t)+""}},Qr.interpolateHsl=function(t,e){t=Qr.hsl(t),e=Qr.hsl(e);var n=t.h,i=t.s,r=t.l,a=e.h-n,o=e.s-i,s=e.l-r;return isNaN(o)&&(o=0,i=isNaN(i)?e.s:i),isNaN(a)?(a=0,n=isNaN(n)?e.h:n):a>180?a-=360:a<-180&&(a+=360),function(t){return it(n+a*t,i+o*t,r+s*t)+""}},Qr.interpolateLab=function(t,e){t=Qr.lab(t),e=Qr.lab(e);var n=t.l,i=t.a,r=t.b,a=e.l-n,o=e.a-i,s=e.b-r;return function(t){return st(n+a*t,i+o*t,r+s*t)+""}},Qr.interpolateRound=Zn,Qr.transform=function(t){var e=Kr.createElementNS(Qr.ns.prefix.svg,"g");return(Qr.transform=function(t){if(null!=t){e.setAttribute("transform",t);var n=e.transform.baseVal.consolidate()}return new Kn(n?n.matrix:es)})(t)},Kn.prototype.toString=function(){return"translate("+this.translate+")rotate("+this.rotate+")skewX("+this.skew+")scale("+this.scale+")"};var es={a:1,b:0,c:0,d:1,e:0,f:0};Qr.interpolateTransform=ni,Qr.layout={},Qr.layout.bundle=function(){return function(t){for(var e=[],n=-1,i=t.length;++n<i;)e.push(ii(t[n]));return e}},Qr.layout.chord=function(){function t(){var t,c,h,d,f,g={},p=[],m=Qr.range(a),v=[];for(n=[],i=[],t=0,d=-1;++d<a;){for(c=0,f=-1;++f<a;)c+=r[d][f];p.push(c),v.push(Qr.range(a)),t+=c}for(o&&m.sort(function(t,e){return o(p[t],p[e])}),s&&v.forEach(function(t,e){t.sort(function(t,n){return s(r[e][t],r[e][n])})}),t=(Aa-l*a)/t,c=0,d=-1;++d<a;){for(h=c,f=-1;++f<a;){var y=m[d],x=v[y][f],_=r[y][x],w=c,b=c+=_*t;g[y+"-"+x]={index:y,subindex:x,startAngle:w,endAngle:b,value:_}}i[y]={index:y,startAngle:h,endAngle:c,value:p[y]},c+=l}for(d=-1;++d<a;)for(f=d-1;++f<a;){var S=g[d+"-"+f],T=g[f+"-"+d];(S.value||T.value)&&n.push(S.value<T.value?{source:T,target:S}:{source:S,target:T})}u&&e()}function e(){n.sort(function(t,e){return u((t.source.value+t.target.value)/2,(e.source.value+e.target.value)/2)})}var n,i,r,a,o,s,u,c={},l=0;return c.matrix=function(t){return arguments.length?(a=(r=t)&&r.length,n=i=null,c):r},c.padding=function(t){return arguments.length?(l=t,n=i=null,c):l},c.sortGroups=function(t){return arguments.length?(o=t,n=i=null,c):o},c.sortSubgroups=function(t){return arguments.length?(s=t,n=null,c):s},c.sortChords=function(t){return arguments.length?(u=t,n&&e(),c):u},c.chords=function(){return n||t(),n},c.groups=function(){return i||t(),i},c},Qr.layout.force=function(){function t(t){return function(e,n,i,r){if(e.point!==t){var a=e.cx-t.x,o=e.cy-t.y,s=r-n,u=a*a+o*o;if(s*s/v<u){if(u<p){var c=e.charge/u;t.px-=a*c,t.py-=o*c}return!0}if(e.point&&u&&u<p){c=e.pointCharge/u;t.px-=a*c,t.py-=o*c}}return!e.charge}}function e(t){t.px=Qr.event.x,t.py=Qr.event.y,u.resume()}var n,i,r,a,o,s,u={},c=Qr.dispatch("start","tick","end"),l=[1,1],h=.9,d=ns,f=is,g=-30,p=rs,m=.1,v=.64,x=[],_=[];return u.tick=function(){if((r*=.99)<.005)return n=null,c.end({type:"end",alpha:r=0}),!0;var e,i,u,d,f,p,v,y,w,b=x.length,S=_.length;for(i=0;i<S;++i)d=(u=_[i]).source,(p=(y=(f=u.target).x-d.x)*y+(w=f.y-d.y)*w)&&(y*=p=r*o[i]*((p=Math.sqrt(p))-a[i])/p,w*=p,f.x-=y*(v=d.weight+f.weight?d.weight/(d.weight+f.weight):.5),f.y-=w*v,d.x+=y*(v=1-v),d.y+=w*v);if((v=r*m)&&(y=l[0]/2,w=l[1]/2,i=-1,v))for(;++i<b;)(u=x[i]).x+=(y-u.x)*v,u.y+=(w-u.y)*v;if(g)for(ci(e=Qr.geom.quadtree(x),r,s),i=-1;++i<b;)(u=x[i]).fixed||e.visit(t(u));for(i=-1;++i<b;)(u=x[i]).fixed?(u.x=u.px,u.y=u.py):(u.x-=(u.px-(u.px=u.x))*h,u.y-=(u.py-(u.py=u.y))*h);c.tick({type:"tick",alpha:r})},u.nodes=function(t){return arguments.length?(x=t,u):x},u.links=function(t){return arguments.length?(_=t,u):_},u.size=function(t){return arguments.length?(l=t,u):l},u.linkDistance=function(t){return arguments.length?(d="function"==typeof t?t:+t,u):d},u.distance=u.linkDistance,u.linkStrength=function(t){return arguments.length?(f="function"==typeof t?t:+t,u):f},u.friction=function(t){return arguments.length?(h=+t,u):h},u.charge=function(t){return arguments.length?(g="function"==typeof t?t:+t,u):g},u.chargeDistance=function(t){return arguments.length?(p=t*t,u):Math.sqrt(p)},u.gravity=function(t){return arguments.length?(m=+t,u):m},u.theta=function(t){return arguments.length?(v=t*t,u):Math.sqrt(v)},u.alpha=function(t){return arguments.length?(t=+t,r?t>0?r=t:(n.c=null,n.t=NaN,n=null,c.end({type:"end",alpha:r=0})):t>0&&(c.start({type:"start",alpha:r=t}),n=Tt(u.tick)),u):r},u.start=function(){function t(t,i){if(!n){for(n=new Array(r),u=0;u<r;++u)n[u]=[];for(u=0;u<c;++u){var a=_[u];n[a.source.index].push(a.target),n[a.target.index].push(a.source)}}for(var o,s=n[e],u=-1,l=s.length;++u<l;)if(!isNaN(o=s[u][t]))return o;return Math.random()*i}var e,n,i,r=x.length,c=_.length,h=l[0],p=l[1];for(e=0;e<r;++e)(i=x[e]).index=e,i.weight=0;for(e=0;e<c;++e)"number"==typeof(i=_[e]).source&&(i.source=x[i.source]),"number"==typeof i.target&&(i.target=x[i.target]),++i.source.weight,++i.target.weight;for(e=0;e<r;++e)i=x[e],isNaN(i.x)&&(i.x=t("x",h)),isNaN(i.y)&&(i.y=t("y",p)),isNaN(i.px)&&(i.px=i.x),isNaN(i.py)&&(i.py=i.y);if(a=[],"function"==typeof d)for(e=0;e<c;++e)a[e]=+d.call(this,_[e],e);else for(e=0;e<c;++e)a[e]=d;if(o=[],"function"==typeof f)for(e=0;e<c;++e)o[e]=+f.call(this,_[e],e);else for(e=0;e<c;++e)o[e]=f;if(s=[],"function"==typeof g)for(e=0;e<r;++e)s[e]=+g.call(this,x[e],e);else for(e=0;e<r;++e)s[e]=g;return u.resume()},u.resume=function(){return u.alpha(.1)},u.stop=function(){return u.alpha(0)},u.drag=function(){if(i||(i=Qr.behavior.drag().origin(y).on("dragstart.force",ai).on("drag.force",e).on("dragend.force",oi)),!arguments.length)return i;this.on("mouseover.force",si).on("mouseout.force",ui).call(i)},Qr.rebind(u,c,"on")};var ns=20,is=1,rs=1/0;Qr.layout.hierarchy=function(){function t(r){var a,o=[r],s=[];for(r.depth=0;null!=(a=o.pop());)if(s.push(a),(c=n.call(t,a,a.depth))&&(u=c.length)){for(var u,c,l;--u>=0;)o.push(l=c[u]),l.parent=a,l.depth=a.depth+1;i&&(a.value=0),a.children=c}else i&&(a.value=+i.call(t,a,a.depth)||0),delete a.children;return di(r,function(t){var n,r;e&&(n=t.children)&&n.sort(e),i&&(r=t.parent)&&(r.value+=t.value)}),s}var e=pi,n=fi,i=gi;return t.sort=function(n){return arguments.length?(e=n,t):e},t.children=function(e){return arguments.length?(n=e,t):n},t.value=function(e){return arguments.length?(i=e,t):i},t.revalue=function(e){return i&&(hi(e,function(t){t.children&&(t.value=0)}),di(e,function(e){var n;e.children||(e.value=+i.call(t,e,e.depth)||0),(n=e.parent)&&(n.value+=e.value)})),e},t},Qr.layout.partition=function(){function t(e,n,i,r){var a=e.children;if(e.x=n,e.y=e.depth*r,e.dx=i,e.dy=r,a&&(o=a.length)){var o,s,u,c=-1;for(i=e.value?i/e.value:0;++c<o;)t(s=a[c],n,u=s.value*i,r),n+=u}}function e(t){var n=t.children,i=0;if(n&&(r=n.length))for(var r,a=-1;++a<r;)i=Math.max(i,e(n[a]));return 1+i}function n(n,a){var o=i.call(this,n,a);return t(o[0],0,r[0],r[1]/e(o[0])),o}var i=Qr.layout.hierarchy(),r=[1,1];return n.size=function(t){return arguments.length?(r=t,n):r},li(n,i)},Qr.layout.pie=function(){function t(o){var s,u=o.length,c=o.map(function(n,i){return+e.call(t,n,i)}),l=+("function"==typeof i?i.apply(this,arguments):i),h=("function"==typeof r?r.apply(this,arguments):r)-l,d=Math.min(Math.abs(h)/u,+("function"==typeof a?a.apply(this,arguments):a)),f=d*(h<0?-1:1),g=Qr.sum(c),p=g?(h-u*f)/g:0,m=Qr.range(u),v=[];return null!=n&&m.sort(n===as?function(t,e){return c[e]-c[t]}:function(t,e){return n(o[t],o[e])}),m.forEach(function(t){v[t]={data:o[t],value:s=c[t],startAngle:l,endAngle:l+=s*p+f,padAngle:d}}),v}var e=Number,n=as,i=0,r=Aa,a=0;return t.value=function(n){return arguments.length?(e=n,t):e},t.sort=function(e){return arguments.length?(n=e,t):n},t.startAngle=function(e){return arguments.length?(i=e,t):i},t.endAngle=function(e){return arguments.length?(r=e,t):r},t.padAngle=function(e){return arguments.length?(a=e,t):a},t};var as={};Qr.layout.stack=function(){function t(s,u){if(!(d=s.length))return s;var c=s.map(function(n,i){return e.call(t,n,i)}),l=c.map(function(e){return e.map(function(e,n){return[a.call(t,e,n),o.call(t,e,n)]})}),h=n.call(t,l,u);c=Qr.permute(c,h),l=Qr.permute(l,h);var d,f,g,p,m=i.call(t,l,u),v=c[0].length;for(g=0;g<v;++g)for(r.call(t,c[0][g],p=m[g],l[0][g][1]),f=1;f<d;++f)r.call(t,c[f][g],p+=l[f-1][g][1],l[f][g][1]);return s}var e=y,n=_i,i=wi,r=xi,a=vi,o=yi;return t.values=function(n){return arguments.length?(e=n,t):e},t.order=function(e){return arguments.length?(n="function"==typeof e?e:os.get(e)||_i,t):n},t.offset=function(e){return arguments.length?(i="function"==typeof e?e:ss.get(e)||wi,t):i},t.x=function(e){return arguments.length?(a=e,t):a},t.y=function(e){return arguments.length?(o=e,t):o},t.out=function(e){return arguments.length?(r=e,t):r},t};var os=Qr.map({"inside-out":function(t){var e,n,i=t.length,r=t.map(bi),a=t.map(Si),o=Qr.range(i).sort(function(t,e){return r[t]-r[e]}),s=0,u=0,c=[],l=[];for(e=0;e<i;++e)n=o[e],s<u?(s+=a[n],c.push(n)):(u+=a[n],l.push(n));return l.reverse().concat(c)},reverse:function(t){return Qr.range(t.length).reverse()},default:_i}),ss=Qr.map({silhouette:function(t){var e,n,i,r=t.length,a=t[0].length,o=[],s=0,u=[];for(n=0;n<a;++n){for(e=0,i=0;e<r;e++)i+=t[e][n][1];i>s&&(s=i),o.push(i)}for(n=0;n<a;++n)u[n]=(s-o[n])/2;return u},wiggle:function(t){var e,n,i,r,a,o,s,u,c,l=t.length,h=t[0],d=h.length,f=[];for(f[0]=u=c=0,n=1;n<d;++n){for(e=0,r=0;e<l;++e)r+=t[e][n][1];for(e=0,a=0,s=h[n][0]-h[n-1][0];e<l;++e){for(i=0,o=(t[e][n][1]-t[e][n-1][1])/(2*s);i<e;++i)o+=(t[i][n][1]-t[i][n-1][1])/s;a+=o*t[e][n][1]}f[n]=u-=r?a/r*s:0,u<c&&(c=u)}for(n=0;n<d;++n)f[n]-=c;return f},expand:function(t){var e,n,i,r=t.length,a=t[0].length,o=1/r,s=[];for(n=0;n<a;++n){for(e=0,i=0;e<r;e++)i+=t[e][n][1];if(i)for(e=0;e<r;e++)t[e][n][1]/=i;else for(e=0;e<r;e++)t[e][n][1]=o}for(n=0;n<a;++n)s[n]=0;return s},zero:wi});Qr.layout.histogram=function(){function t(t,a){for(var o,s,u=[],c=t.map(n,this),l=i.call(this,c,a),h=r.call(this,l,c,a),d=(a=-1,c.length),f=h.length-1,g=e?1:1/d;++a<f;)(o=u[a]=[]).dx=h[a+1]-(o.x=h[a]),o.y=0;if(f>0)for(a=-1;++a<d;)(s=c[a])>=l[0]&&s<=l[1]&&((o=u[Qr.bisect(h,s,1,f)-1]).y+=g,o.push(t[a]));return u}var e=!0,n=Number,i=Mi,r=Ai;return t.value=function(e){return arguments.length?(n=e,t):n},t.range=function(e){return arguments.length?(i=wt(e),t):i},t.bins=function(e){return arguments.length?(r="number"==typeof e?function(t){return Ci(t,e)}:wt(e),t):r},t.frequency=function(n){return arguments.length?(e=!!n,t):e},t},Qr.layout.pack=function(){function t(t,a){var o=n.call(this,t,a),s=o[0],u=r[0],c=r[1],l=null==e?Math.sqrt:"function"==typeof e?e:function(){return e};if(s.x=s.y=0,di(s,function(t){t.r=+l(t.value)}),di(s,Di),i){var h=i*(e?1:Math.max(2*s.r/u,2*s.r/c))/2;di(s,function(t){t.r+=h}),di(s,Di),di(s,function(t){t.r-=h})}return Vi(s,u/2,c/2,e?1:1/Math.max(2*s.r/u,2*s.r/c)),o}var e,n=Qr.layout.hierarchy().sort(Pi),i=0,r=[1,1];return t.size=function(e){return arguments.length?(r=e,t):r},t.radius=function(n){return arguments.length?(e=null==n||"function"==typeof n?n:+n,t):e},t.padding=function(e){return arguments.length?(i=+e,t):i},li(t,n)},Qr.layout.tree=function(){function t(t,u){var c=r.call(this,t,u),l=c[0],h=function(t){var e,n={A:null,children:[t]},i=[n];for(;null!=(e=i.pop());)for(var r,a=e.children,o=0,s=a.length;o<s;++o)i.push((a[o]=r={_:a[o],parent:e,children:(r=a[o].children)&&r.slice()||[],A:null,a:null,z:0,m:0,c:0,s:0,t:null,i:o}).a=r);return n.children[0]}(l);if(di(h,e),h.parent.m=-h.z,hi(h,n),s)hi(l,i);else{var d=l,f=l,g=l;hi(l,function(t){t.x<d.x&&(d=t),t.x>f.x&&(f=t),t.depth>g.depth&&(g=t)});var p=a(d,f)/2-d.x,m=o[0]/(f.x+a(f,d)/2+p),v=o[1]/(g.depth||1);hi(l,function(t){t.x=(t.x+p)*m,t.y=t.depth*v})}return c}function e(t){var e=t.children,n=t.parent.children,i=t.i?n[t.i-1]:null;if(e.length){!function(t){for(var e,n=0,i=0,r=t.children,a=r.length;--a>=0;)(e=r[a]).z+=n,e.m+=n,n+=e.s+(i+=e.c)}(t);var r=(e[0].z+e[e.length-1].z)/2;i?(t.z=i.z+a(t._,i._),t.m=t.z-r):t.z=r}else i&&(t.z=i.z+a(t._,i._));t.parent.A=function(t,e,n){if(e){for(var i,r=t,o=t,s=e,u=r.parent.children[0],c=r.m,l=o.m,h=s.m,d=u.m;s=ji(s),r=Ni(r),s&&r;)u=Ni(u),(o=ji(o)).a=t,(i=s.z+h-r.z-c+a(s._,r._))>0&&(!function(t,e,n){var i=n/(e.i-t.i);e.c-=i,e.s+=n,t.c+=i,e.z+=n,e.m+=n}(function(t,e,n){return t.a.parent===e.parent?t.a:n}(s,t,n),t,i),c+=i,l+=i),h+=s.m,c+=r.m,d+=u.m,l+=o.m;s&&!ji(o)&&(o.t=s,o.m+=h-l),r&&!Ni(u)&&(u.t=r,u.m+=c-d,n=t)}return n}(t,i,t.parent.A||n[0])}function n(t){t._.x=t.z+t.parent.m,t.m+=t.parent.m}function i(t){t.x*=o[0],t.y=t.depth*o[1]}var r=Qr.layout.hierarchy().sort(null).value(null),a=Ri,o=[1,1],s=null;return t.separation=function(e){return arguments.length?(a=e,t):a},t.size=function(e){return arguments.length?(s=null==(o=e)?i:null,t):s?null:o},t.nodeSize=function(e){return arguments.length?(s=null==(o=e)?null:i,t):s?o:null},li(t,r)},Qr.layout.cluster=function(){function t(t,a){var o,s=e.call(this,t,a),u=s[0],c=0;di(u,function(t){var e=t.children;e&&e.length?(t.x=function(t){return t.reduce(function(t,e){return t+e.x},0)/t.length}(e),t.y=function(t){return 1+Qr.max(t,function(t){return t.y})}(e)):(t.x=o?c+=n(t,o):0,t.y=0,o=t)});var l=Fi(u),h=zi(u),d=l.x-n(l,h)/2,f=h.x+n(h,l)/2;return di(u,r?function(t){t.x=(t.x-u.x)*i[0],t.y=(u.y-t.y)*i[1]}:function(t){t.x=(t.x-d)/(f-d)*i[0],t.y=(1-(u.y?t.y/u.y:1))*i[1]}),s}var e=Qr.layout.hierarchy().sort(null).value(null),n=Ri,i=[1,1],r=!1;return t.separation=function(e){return arguments.length?(n=e,t):n},t.size=function(e){return arguments.length?(r=null==(i=e),t):r?null:i},t.nodeSize=function(e){return arguments.length?(r=null!=(i=e),t):r?i:null},li(t,e)},Qr.layout.treemap=function(){function t(t,e){for(var n,i,r=-1,a=t.length;++r<a;)i=(n=t[r]).value*(e<0?0:e),n.area=isNaN(i)||i<=0?0:i}function e(n){var r=n.children;if(r&&r.length){var a,o,s,u=l(n),c=[],h=r.slice(),g=1/0,p="slice"===d?u.dx:"dice"===d?u.dy:"slice-dice"===d?1&n.depth?u.dy:u.dx:Math.min(u.dx,u.dy);for(t(h,u.dx*u.dy/n.value),c.area=0;(s=h.length)>0;)c.push(a=h[s-1]),c.area+=a.area,"squarify"!==d||(o=function(t,e){var n,i=t.area,r=0,a=1/0,o=-1,s=t.length;for(;++o<s;)(n=t[o].area)&&(n<a&&(a=n),n>r&&(r=n));return i*=i,e*=e,i?Math.max(e*r*f/i,i/(e*a*f)):1/0}(c,p))<=g?(h.pop(),g=o):(c.area-=c.pop().area,i(c,p,u,!1),p=Math.min(u.dx,u.dy),c.length=c.area=0,g=1/0);c.length&&(i(c,p,u,!0),c.length=c.area=0),r.forEach(e)}}function n(e){var r=e.children;if(r&&r.length){var a,o=l(e),s=r.slice(),u=[];for(t(s,o.dx*o.dy/e.value),u.area=0;a=s.pop();)u.push(a),u.area+=a.area,null!=a.z&&(i(u,a.z?o.dx:o.dy,o,!s.length),u.length=u.area=0);r.forEach(n)}}function i(t,e,n,i){var r,a=-1,o=t.length,u=n.x,c=n.y,l=e?s(t.area/e):0;if(e==n.dx){for((i||l>n.dy)&&(l=n.dy);++a<o;)(r=t[a]).x=u,r.y=c,r.dy=l,u+=r.dx=Math.min(n.x+n.dx-u,l?s(r.area/l):0);r.z=!0,r.dx+=n.x+n.dx-u,n.y+=l,n.dy-=l}else{for((i||l>n.dx)&&(l=n.dx);++a<o;)(r=t[a]).x=u,r.y=c,r.dx=l,c+=r.dy=Math.min(n.y+n.dy-c,l?s(r.area/l):0);r.z=!1,r.dy+=n.y+n.dy-c,n.x+=l,n.dx-=l}}function r(i){var r=a||o(i),s=r[0];return s.x=s.y=0,s.value?(s.dx=u[0],s.dy=u[1]):s.dx=s.dy=0,a&&o.revalue(s),t([s],s.dx*s.dy/s.value),(a?n:e)(s),h&&(a=r),r}var a,o=Qr.layout.hierarchy(),s=Math.round,u=[1,1],c=null,l=Hi,h=!1,d="squarify",f=.5*(1+Math.sqrt(5));return r.size=function(t){return arguments.length?(u=t,r):u},r.padding=function(t){function e(e){return Yi(e,t)}if(!arguments.length)return c;var n;return l=null==(c=t)?Hi:"function"==(n=typeof t)?function(e){var n=t.call(r,e,e.depth);return null==n?Hi(e):Yi(e,"number"==typeof n?[n,n,n,n]:n)}:"number"===n?(t=[t,t,t,t],e):e,r},r.round=function(t){return arguments.length?(s=t?Math.round:Number,r):s!=Number},r.sticky=function(t){return arguments.length?(h=t,a=null,r):h},r.ratio=function(t){return arguments.length?(f=t,r):f},r.mode=function(t){return arguments.length?(d=t+"",r):d},li(r,o)},Qr.random={normal:function(t,e){var n=arguments.length;return n<2&&(e=1),n<1&&(t=0),function(){var n,i,r;do{r=(n=2*Math.random()-1)*n+(i=2*Math.random()-1)*i}while(!r||r>1);return t+e*n*Math.sqrt(-2*Math.log(r)/r)}},logNormal:function(){var t=Qr.random.normal.apply(Qr,arguments);return function(){return Math.exp(t())}},bates:function(t){var e=Qr.random.irwinHall(t);return function(){return e()/t}},irwinHall:function(t){return function(){for(var e=0,n=0;n<t;n++)e+=Math.random();return e}}},Qr.scale={};var us={floor:y,ceil:y};Qr.scale.linear=function(){return Wi([0,1],[0,1],zn,!1)};var cs={s:1,g:1,p:1,r:1,e:1};Qr.scale.log=function(){return er(Qr.scale.linear().domain([0,1]),10,!0,[1,10])};var ls=Qr.format(".0e"),hs={floor:function(t){return-Math.ceil(-t)},ceil:function(t){return-Math.floor(-t)}};Qr.scale.pow=function(){return nr(Qr.scale.linear(),1,[0,1])},Qr.scale.sqrt=function(){return Qr.scale.pow().exponent(.5)},Qr.scale.ordinal=function(){return rr([],{t:"range",a:[[]]})},Qr.scale.category10=function(){return Qr.scale.ordinal().range(ds)},Qr.scale.category20=function(){return Qr.scale.ordinal().range(fs)},Qr.scale.category20b=function(){return Qr.scale.ordinal().range(gs)},Qr.scale.category20c=function(){return Qr.scale.ordinal().range(ps)};var ds=[2062260,16744206,2924588,14034728,9725885,9197131,14907330,8355711,12369186,1556175].map(gt),fs=[2062260,11454440,16744206,16759672,2924588,10018698,14034728,16750742,9725885,12955861,9197131,12885140,14907330,16234194,8355711,13092807,12369186,14408589,1556175,10410725].map(gt),gs=[3750777,5395619,7040719,10264286,6519097,9216594,11915115,13556636,9202993,12426809,15186514,15190932,8666169,11356490,14049643,15177372,8077683,10834324,13528509,14589654].map(gt),ps=[3244733,7057110,10406625,13032431,15095053,16616764,16625259,16634018,3253076,7652470,10607003,13101504,7695281,10394312,12369372,14342891,6513507,9868950,12434877,14277081].map(gt);Qr.scale.quantile=function(){return ar([],[])},Qr.scale.quantize=function(){return or(0,1,[0,1])},Qr.scale.threshold=function(){return sr([.5],[0,1])},Qr.scale.identity=function(){return ur([0,1])},Qr.svg={},Qr.svg.arc=function(){function t(){var t=Math.max(0,+n.apply(this,arguments)),c=Math.max(0,+i.apply(this,arguments)),l=o.apply(this,arguments)-Ma,h=s.apply(this,arguments)-Ma,d=Math.abs(h-l),f=l>h?0:1;if(c<t&&(g=c,c=t,t=g),d>=Ca)return e(c,f)+(t?e(t,1-f):"")+"Z";var g,p,m,v,y,x,_,w,b,S,T,A,C=0,M=0,P=[];if((v=(+u.apply(this,arguments)||0)/2)&&(m=a===ms?Math.sqrt(t*t+c*c):+a.apply(this,arguments),f||(M*=-1),c&&(M=K(m/c*Math.sin(v))),t&&(C=K(m/t*Math.sin(v)))),c){y=c*Math.cos(l+M),x=c*Math.sin(l+M),_=c*Math.cos(h-M),w=c*Math.sin(h-M);var E=Math.abs(h-l-2*M)<=Ta?0:1;if(M&&pr(y,x,_,w)===f^E){var L=(l+h)/2;y=c*Math.cos(L),x=c*Math.sin(L),_=w=null}}else y=x=0;if(t){b=t*Math.cos(h-C),S=t*Math.sin(h-C),T=t*Math.cos(l+C),A=t*Math.sin(l+C);var k=Math.abs(l-h+2*C)<=Ta?0:1;if(C&&pr(b,S,T,A)===1-f^k){var D=(l+h)/2;b=t*Math.cos(D),S=t*Math.sin(D),T=A=null}}else b=S=0;if(d>ba&&(g=Math.min(Math.abs(c-t)/2,+r.apply(this,arguments)))>.001){p=t<c^f?0:1;var O=g,I=g;if(d<Ta){var V=null==T?[b,S]:null==_?[y,x]:hn([y,x],[T,A],[_,w],[b,S]),G=y-V[0],R=x-V[1],N=_-V[0],j=w-V[1],F=1/Math.sin(Math.acos((G*N+R*j)/(Math.sqrt(G*G+R*R)*Math.sqrt(N*N+j*j)))/2),z=Math.sqrt(V[0]*V[0]+V[1]*V[1]);I=Math.min(g,(t-z)/(F-1)),O=Math.min(g,(c-z)/(F+1))}if(null!=_){var H=mr(null==T?[b,S]:[T,A],[y,x],c,O,f),Y=mr([_,w],[b,S],c,O,f);g===O?P.push("M",H[0],"A",O,",",O," 0 0,",p," ",H[1],"A",c,",",c," 0 ",1-f^pr(H[1][0],H[1][1],Y[1][0],Y[1][1]),",",f," ",Y[1],"A",O,",",O," 0 0,",p," ",Y[0]):P.push("M",H[0],"A",O,",",O," 0 1,",p," ",Y[0])}else P.push("M",y,",",x);if(null!=T){var X=mr([y,x],[T,A],t,-I,f),B=mr([b,S],null==_?[y,x]:[_,w],t,-I,f);g===I?P.push("L",B[0],"A",I,",",I," 0 0,",p," ",B[1],"A",t,",",t," 0 ",f^pr(B[1][0],B[1][1],X[1][0],X[1][1]),",",1-f," ",X[1],"A",I,",",I," 0 0,",p," ",X[0]):P.push("L",B[0],"A",I,",",I," 0 0,",p," ",X[0])}else P.push("L",b,",",S)}else P.push("M",y,",",x),null!=_&&P.push("A",c,",",c," 0 ",E,",",f," ",_,",",w),P.push("L",b,",",S),null!=T&&P.push("A",t,",",t," 0 ",k,",",1-f," ",T,",",A);return P.push("Z"),P.join("")}function e(t,e){return"M0,"+t+"A"+t+","+t+" 0 1,"+e+" 0,"+-t+"A"+t+","+t+" 0 1,"+e+" 0,"+t}var n=lr,i=hr,r=cr,a=ms,o=dr,s=fr,u=gr;return t.innerRadius=function(e){return arguments.length?(n=wt(e),t):n},t.outerRadius=function(e){return arguments.length?(i=wt(e),t):i},t.cornerRadius=function(e){return arguments.length?(r=wt(e),t):r},t.padRadius=function(e){return arguments.length?(a=e==ms?ms:wt(e),t):a},t.startAngle=function(e){return arguments.length?(o=wt(e),t):o},t.endAngle=function(e){return arguments.length?(s=wt(e),t):s},t.padAngle=function(e){return arguments.length?(u=wt(e),t):u},t.centroid=function(){var t=(+n.apply(this,arguments)+ +i.apply(this,arguments))/2,e=(+o.apply(this,arguments)+ +s.apply(this,arguments))/2-Ma;return[Math.cos(e)*t,Math.sin(e)*t]},t};var ms="auto";Qr.svg.line=function(){return vr(y)};var vs=Qr.map({linear:yr,"linear-closed":xr,step:function(t){for(var e=0,n=t.length,i=t[0],r=[i[0],",",i[1]];++e<n;)r.push("H",(i[0]+(i=t[e])[0])/2,"V",i[1]);return n>1&&r.push("H",i[0]),r.join("")},"step-before":_r,"step-after":wr,basis:Tr,"basis-open":function(t){if(t.length<4)return yr(t);for(var e,n=[],i=-1,r=t.length,a=[0],o=[0];++i<3;)e=t[i],a.push(e[0]),o.push(e[1]);for(n.push(Ar(_s,a)+","+Ar(_s,o)),--i;++i<r;)e=t[i],a.shift(),a.push(e[0]),o.shift(),o.push(e[1]),Cr(n,a,o);return n.join("")},"basis-closed":function(t){for(var e,n,i=-1,r=t.length,a=r+4,o=[],s=[];++i<4;)n=t[i%r],o.push(n[0]),s.push(n[1]);for(e=[Ar(_s,o),",",Ar(_s,s)],--i;++i<a;)n=t[i%r],o.shift(),o.push(n[0]),s.shift(),s.push(n[1]),Cr(e,o,s);return e.join("")},bundle:function(t,e){var n=t.length-1;if(n)for(var i,r,a=t[0][0],o=t[0][1],s=t[n][0]-a,u=t[n][1]-o,c=-1;++c<=n;)r=c/n,(i=t[c])[0]=e*i[0]+(1-e)*(a+r*s),i[1]=e*i[1]+(1-e)*(o+r*u);return Tr(t)},cardinal:function(t,e){return t.length<3?yr(t):t[0]+br(t,Sr(t,e))},"cardinal-open":function(t,e){return t.length<4?yr(t):t[1]+br(t.slice(1,-1),Sr(t,e))},"cardinal-closed":function(t,e){return t.length<3?xr(t):t[0]+br((t.push(t[0]),t),Sr([t[t.length-2]].concat(t,[t[1]]),e))},monotone:function(t){return t.length<3?yr(t):t[0]+br(t,Pr(t))}});vs.forEach(function(t,e){e.key=t,e.closed=/-closed$/.test(t)});var ys=[0,2/3,1/3,0],xs=[0,1/3,2/3,0],_s=[0,1/6,2/3,1/6];Qr.svg.line.radial=function(){var t=vr(Er);return t.radius=t.x,delete t.x,t.angle=t.y,delete t.y,t},_r.reverse=wr,wr.reverse=_r,Qr.svg.area=function(){return Lr(y)},Qr.svg.area.radial=function(){var t=Lr(Er);return t.radius=t.x,delete t.x,t.innerRadius=t.x0,delete t.x0,t.outerRadius=t.x1,delete t.x1,t.angle=t.y,delete t.y,t.startAngle=t.y0,delete t.y0,t.endAngle=t.y1,delete t.y1,t},Qr.svg.chord=function(){function t(t,o){var s=e(this,r,t,o),u=e(this,a,t,o);return"M"+s.p0+n(s.r,s.p1,s.a1-s.a0)+(function(t,e){return t.a0==e.a0&&t.a1==e.a1}(s,u)?i(s.r,s.p1,s.r,s.p0):i(s.r,s.p1,u.r,u.p0)+n(u.r,u.p1,u.a1-u.a0)+i(u.r,u.p1,s.r,s.p0))+"Z"}function e(t,e,n,i){var r=e.call(t,n,i),a=o.call(t,r,i),c=s.call(t,r,i)-Ma,l=u.call(t,r,i)-Ma;return{r:a,a0:c,a1:l,p0:[a*Math.cos(c),a*Math.sin(c)],p1:[a*Math.cos(l),a*Math.sin(l)]}}function n(t,e,n){return"A"+t+","+t+" 0 "+ +(n>Ta)+",1 "+e}function i(t,e,n,i){return"Q 0,0 "+i}var r=Ze,a=Ke,o=kr,s=dr,u=fr;return t.radius=function(e){return arguments.length?(o=wt(e),t):o},t.source=function(e){return arguments.length?(r=wt(e),t):r},t.target=function(e){return arguments.length?(a=wt(e),t):a},t.startAngle=function(e){return arguments.length?(s=wt(e),t):s},t.endAngle=function(e){return arguments.length?(u=wt(e),t):u},t},Qr.svg.diagonal=function(){function t(t,r){var a=e.call(this,t,r),o=n.call(this,t,r),s=(a.y+o.y)/2,u=[a,{x:a.x,y:s},{x:o.x,y:s},o];return"M"+(u=u.map(i))[0]+"C"+u[1]+" "+u[2]+" "+u[3]}var e=Ze,n=Ke,i=Dr;return t.source=function(n){return arguments.length?(e=wt(n),t):e},t.target=function(e){return arguments.length?(n=wt(e),t):n},t.projection=function(e){return arguments.length?(i=e,t):i},t},Qr.svg.diagonal.radial=function(){var t=Qr.svg.diagonal(),e=Dr,n=t.projection;return t.projection=function(t){return arguments.length?n(function(t){return function(){var e=t.apply(this,arguments),n=e[0],i=e[1]-Ma;return[n*Math.cos(i),n*Math.sin(i)]}}(e=t)):e},t},Qr.svg.symbol=function(){function t(t,i){return(ws.get(e.call(this,t,i))||Vr)(n.call(this,t,i))}var e=Ir,n=Or;return t.type=function(n){return arguments.length?(e=wt(n),t):e},t.size=function(e){return arguments.length?(n=wt(e),t):n},t};var ws=Qr.map({circle:Vr,cross:function(t){var e=Math.sqrt(t/5)/2;return"M"+-3*e+","+-e+"H"+-e+"V"+-3*e+"H"+e+"V"+-e+"H"+3*e+"V"+e+"H"+e+"V"+3*e+"H"+-e+"V"+e+"H"+-3*e+"Z"},diamond:function(t){var e=Math.sqrt(t/(2*Ss)),n=e*Ss;return"M0,"+-e+"L"+n+",0 0,"+e+" "+-n+",0Z"},square:function(t){var e=Math.sqrt(t)/2;return"M"+-e+","+-e+"L"+e+","+-e+" "+e+","+e+" "+-e+","+e+"Z"},"triangle-down":function(t){var e=Math.sqrt(t/bs),n=e*bs/2;return"M0,"+n+"L"+e+","+-n+" "+-e+","+-n+"Z"},"triangle-up":function(t){var e=Math.sqrt(t/bs),n=e*bs/2;return"M0,"+-n+"L"+e+","+n+" "+-e+","+n+"Z"}});Qr.svg.symbolTypes=ws.keys();var bs=Math.sqrt(3),Ss=Math.tan(30*Pa);ga.transition=function(t){for(var e,n,i=Ts||++Ps,r=Fr(t),a=[],o=As||{time:Date.now(),ease:function(t){if(t<=0)return 0;if(t>=1)return 1;var e=t*t,n=e*t;return 4*(t<.5?n:3*(t-e)+n-.75)},delay:0,duration:250},s=-1,u=this.length;++s<u;){a.push(e=[]);for(var c=this[s],l=-1,h=c.length;++l<h;)(n=c[l])&&zr(n,l,r,i,o),e.push(n)}return Rr(a,r,i)},ga.interrupt=function(t){return this.each(null==t?Cs:Gr(Fr(t)))};var Ts,As,Cs=Gr(Fr()),Ms=[],Ps=0;Ms.call=ga.call,Ms.empty=ga.empty,Ms.node=ga.node,Ms.size=ga.size,Qr.transition=function(t,e){return t&&t.transition?Ts?t.transition(e):t:Qr.selection().transition(t)},Qr.transition.prototype=Ms,Ms.select=function(t){var e,n,i,r=this.id,a=this.namespace,o=[];t=M(t);for(var s=-1,u=this.length;++s<u;){o.push(e=[]);for(var c=this[s],l=-1,h=c.length;++l<h;)(i=c[l])&&(n=t.call(i,i.__data__,l,s))?("__data__"in i&&(n.__data__=i.__data__),zr(n,l,a,r,i[a][r]),e.push(n)):e.push(null)}return Rr(o,a,r)},Ms.selectAll=function(t){var e,n,i,r,a,o=this.id,s=this.namespace,u=[];t=P(t);for(var c=-1,l=this.length;++c<l;)for(var h=this[c],d=-1,f=h.length;++d<f;)if(i=h[d]){a=i[s][o],n=t.call(i,i.__data__,d,c),u.push(e=[]);for(var g=-1,p=n.length;++g<p;)(r=n[g])&&zr(r,g,s,o,a),e.push(r)}return Rr(u,s,o)},Ms.filter=function(t){var e,n,i=[];"function"!=typeof t&&(t=F(t));for(var r=0,a=this.length;r<a;r++){i.push(e=[]);for(var o,s=0,u=(o=this[r]).length;s<u;s++)(n=o[s])&&t.call(n,n.__data__,s,r)&&e.push(n)}return Rr(i,this.namespace,this.id)},Ms.tween=function(t,e){var n=this.id,i=this.namespace;return arguments.length<2?this.node()[i][n].tween.get(t):z(this,null==e?function(e){e[i][n].tween.remove(t)}:function(r){r[i][n].tween.set(t,e)})},Ms.attr=function(t,e){if(arguments.length<2){for(e in t)this.attr(e,t[e]);return this}var n="transform"==t?ni:zn,i=Qr.ns.qualify(t);return Nr(this,"attr."+t,e,i.local?function(t){return null==t?function(){this.removeAttributeNS(i.space,i.local)}:(t+="",function(){var e,r=this.getAttributeNS(i.space,i.local);return r!==t&&(e=n(r,t),function(t){this.setAttributeNS(i.space,i.local,e(t))})})}:function(t){return null==t?function(){this.removeAttribute(i)}:(t+="",function(){var e,r=this.getAttribute(i);return r!==t&&(e=n(r,t),function(t){this.setAttribute(i,e(t))})})})},Ms.attrTween=function(t,e){var n=Qr.ns.qualify(t);return this.tween("attr."+t,n.local?function(t,i){var r=e.call(this,t,i,this.getAttributeNS(n.space,n.local));return r&&function(t){this.setAttributeNS(n.space,n.local,r(t))}}:function(t,i){var r=e.call(this,t,i,this.getAttribute(n));return r&&function(t){this.setAttribute(n,r(t))}})},Ms.style=function(t,e,i){var r=arguments.length;if(r<3){if("string"!=typeof t){r<2&&(e="");for(i in t)this.style(i,t[i],e);return this}i=""}return Nr(this,"style."+t,e,function(e){return null==e?function(){this.style.removeProperty(t)}:(e+="",function(){var r,a=n(this).getComputedStyle(this,null).getPropertyValue(t);return a!==e&&(r=zn(a,e),function(e){this.style.setProperty(t,r(e),i)})})})},Ms.styleTween=function(t,e,i){return arguments.length<3&&(i=""),this.tween("style."+t,function(r,a){var o=e.call(this,r,a,n(this).getComputedStyle(this,null).getPropertyValue(t));return o&&function(e){this.style.setProperty(t,o(e),i)}})},Ms.text=function(t){return Nr(this,"text",t,jr)},Ms.remove=function(){var t=this.namespace;return this.each("end.transition",function(){var e;this[t].count<2&&(e=this.parentNode)&&e.removeChild(this)})},Ms.ease=function(t){var e=this.id,n=this.namespace;return arguments.length<1?this.node()[n][e].ease:("function"!=typeof t&&(t=Qr.ease.apply(Qr,arguments)),z(this,function(i){i[n][e].ease=t}))},Ms.delay=function(t){var e=this.id,n=this.namespace;return arguments.length<1?this.node()[n][e].delay:z(this,"function"==typeof t?function(i,r,a){i[n][e].delay=+t.call(i,i.__data__,r,a)}:(t=+t,function(i){i[n][e].delay=t}))},Ms.duration=function(t){var e=this.id,n=this.namespace;return arguments.length<1?this.node()[n][e].duration:z(this,"function"==typeof t?function(i,r,a){i[n][e].duration=Math.max(1,t.call(i,i.__data__,r,a))}:(t=Math.max(1,t),function(i){i[n][e].duration=t}))},Ms.each=function(t,e){var n=this.id,i=this.namespace;if(arguments.length<2){var r=As,a=Ts;try{Ts=n,z(this,function(e,r,a){As=e[i][n],t.call(e,e.__data__,r,a)})}finally{As=r,Ts=a}}else z(this,function(r){var a=r[i][n];(a.event||(a.event=Qr.dispatch("start","end","interrupt"))).on(t,e)});return this},Ms.transition=function(){for(var t,e,n,i=this.id,r=++Ps,a=this.namespace,o=[],s=0,u=this.length;s<u;s++){o.push(t=[]);for(var c,l=0,h=(c=this[s]).length;l<h;l++)(e=c[l])&&zr(e,l,a,r,{time:(n=e[a][i]).time,ease:n.ease,delay:n.delay+n.duration,duration:n.duration}),t.push(e)}return Rr(o,a,r)},Qr.svg.axis=function(){function t(t){t.each(function(){var t,c=Qr.select(this),l=this.__chart__||n,h=this.__chart__=n.copy(),d=null==u?h.ticks?h.ticks.apply(h,s):h.domain():u,f=null==e?h.tickFormat?h.tickFormat.apply(h,s):y:e,g=c.selectAll(".tick").data(d,h),p=g.enter().insert("g",".domain").attr("class","tick").style("opacity",ba),m=Qr.transition(g.exit()).style("opacity",ba).remove(),v=Qr.transition(g.order()).style("opacity",1),x=Math.max(r,0)+o,_=Bi(h),w=c.selectAll(".domain").data([0]),b=(w.enter().append("path").attr("class","domain"),Qr.transition(w));p.append("line"),p.append("text");var S,T,A,C,M=p.select("line"),P=v.select("line"),E=g.select("text").text(f),L=p.select("text"),k=v.select("text"),D="top"===i||"left"===i?-1:1;if("bottom"===i||"top"===i?(t=Hr,S="x",A="y",T="x2",C="y2",E.attr("dy",D<0?"0em":".71em").style("text-anchor","middle"),b.attr("d","M"+_[0]+","+D*a+"V0H"+_[1]+"V"+D*a)):(t=Yr,S="y",A="x",T="y2",C="x2",E.attr("dy",".32em").style("text-anchor",D<0?"end":"start"),b.attr("d","M"+D*a+","+_[0]+"H0V"+_[1]+"H"+D*a)),M.attr(C,D*r),L.attr(A,D*x),P.attr(T,0).attr(C,D*r),k.attr(S,0).attr(A,D*x),h.rangeBand){var O=h,I=O.rangeBand()/2;l=h=function(t){return O(t)+I}}else l.rangeBand?l=h:m.call(t,h,l);p.call(t,l,h),v.call(t,h,h)})}var e,n=Qr.scale.linear(),i=Es,r=6,a=6,o=3,s=[10],u=null;return t.scale=function(e){return arguments.length?(n=e,t):n},t.orient=function(e){return arguments.length?(i=e in Ls?e+"":Es,t):i},t.ticks=function(){return arguments.length?(s=Zr(arguments),t):s},t.tickValues=function(e){return arguments.length?(u=e,t):u},t.tickFormat=function(n){return arguments.length?(e=n,t):e},t.tickSize=function(e){var n=arguments.length;return n?(r=+e,a=+arguments[n-1],t):r},t.innerTickSize=function(e){return arguments.length?(r=+e,t):r},t.outerTickSize=function(e){return arguments.length?(a=+e,t):a},t.tickPadding=function(e){return arguments.length?(o=+e,t):o},t.tickSubdivide=function(){return arguments.length&&t},t};var Es="bottom",Ls={top:1,right:1,bottom:1,left:1};Qr.svg.brush=function(){function t(n){n.each(function(){var n=Qr.select(this).style("pointer-events","all").style("-webkit-tap-highlight-color","rgba(0,0,0,0)").on("mousedown.brush",a).on("touchstart.brush",a),o=n.selectAll(".background").data([0]);o.enter().append("rect").attr("class","background").style("visibility","hidden").style("cursor","crosshair"),n.selectAll(".extent").data([0]).enter().append("rect").attr("class","extent").style("cursor","move");var s=n.selectAll(".resize").data(p,y);s.exit().remove(),s.enter().append("g").attr("class",function(t){return"resize "+t}).style("cursor",function(t){return ks[t]}).append("rect").attr("x",function(t){return/[ew]$/.test(t)?-3:null}).attr("y",function(t){return/^[ns]/.test(t)?-3:null}).attr("width",6).attr("height",6).style("visibility","hidden"),s.style("display",t.empty()?"none":null);var u,h=Qr.transition(n),d=Qr.transition(o);c&&(u=Bi(c),d.attr("x",u[0]).attr("width",u[1]-u[0]),i(h)),l&&(u=Bi(l),d.attr("y",u[0]).attr("height",u[1]-u[0]),r(h)),e(h)})}function e(t){t.selectAll(".resize").attr("transform",function(t){return"translate("+h[+/e$/.test(t)]+","+d[+/^s/.test(t)]+")"})}function i(t){t.select(".extent").attr("x",h[0]),t.selectAll(".extent,.n>rect,.s>rect").attr("width",h[1]-h[0])}function r(t){t.select(".extent").attr("y",d[0]),t.selectAll(".extent,.e>rect,.w>rect").attr("height",d[1]-d[0])}function a(){function a(){var t=Qr.mouse(x),n=!1;y&&(t[0]+=y[0],t[1]+=y[1]),M||(Qr.event.altKey?(v||(v=[(h[0]+h[1])/2,(d[0]+d[1])/2]),E[0]=h[+(t[0]<v[0])],E[1]=d[+(t[1]<v[1])]):v=null),A&&p(t,c,0)&&(i(b),n=!0),C&&p(t,l,1)&&(r(b),n=!0),n&&(e(b),w({type:"brush",mode:M?"move":"resize"}))}function p(t,e,n){var i,r,a=Bi(e),u=a[0],c=a[1],l=E[n],p=n?d:h,m=p[1]-p[0];if(M&&(u-=l,c-=m+l),i=(n?g:f)?Math.max(u,Math.min(c,t[n])):t[n],M?r=(i+=l)+m:(v&&(l=Math.max(u,Math.min(c,2*v[n]-i))),l<i?(r=i,i=l):r=l),p[0]!=i||p[1]!=r)return n?s=null:o=null,p[0]=i,p[1]=r,!0}function m(){a(),b.style("pointer-events","all").selectAll(".resize").style("display",t.empty()?"none":null),Qr.select("body").style("cursor",null),L.on("mousemove.brush",null).on("mouseup.brush",null).on("touchmove.brush",null).on("touchend.brush",null).on("keydown.brush",null).on("keyup.brush",null),P(),w({type:"brushend"})}var v,y,x=this,_=Qr.select(Qr.event.target),w=u.of(x,arguments),b=Qr.select(x),T=_.datum(),A=!/^(n|s)$/.test(T)&&c,C=!/^(e|w)$/.test(T)&&l,M=_.classed("extent"),P=U(x),E=Qr.mouse(x),L=Qr.select(n(x)).on("keydown.brush",function(){32==Qr.event.keyCode&&(M||(v=null,E[0]-=h[1],E[1]-=d[1],M=2),S())}).on("keyup.brush",function(){32==Qr.event.keyCode&&2==M&&(E[0]+=h[1],E[1]+=d[1],M=0,S())});if(Qr.event.changedTouches?L.on("touchmove.brush",a).on("touchend.brush",m):L.on("mousemove.brush",a).on("mouseup.brush",m),b.interrupt().selectAll("*").interrupt(),M)E[0]=h[0]-E[0],E[1]=d[0]-E[1];else if(T){var k=+/w$/.test(T),D=+/^n/.test(T);y=[h[1-k]-E[0],d[1-D]-E[1]],E[0]=h[k],E[1]=d[D]}else Qr.event.altKey&&(v=E.slice());b.style("pointer-events","none").selectAll(".resize").style("display",null),Qr.select("body").style("cursor",_.style("cursor")),w({type:"brushstart"}),a()}var o,s,u=A(t,"brushstart","brush","brushend"),c=null,l=null,h=[0,0],d=[0,0],f=!0,g=!0,p=Ds[0];return t.event=function(t){t.each(function(){var t=u.of(this,arguments),e={x:h,y:d,i:o,j:s},n=this.__chart__||e;this.__chart__=e,Ts?Qr.select(this).transition().each("start.brush",function(){o=n.i,s=n.j,h=n.x,d=n.y,t({type:"brushstart"})}).tween("brush:brush",function(){var n=Hn(h,e.x),i=Hn(d,e.y);return o=s=null,function(r){h=e.x=n(r),d=e.y=i(r),t({type:"brush",mode:"resize"})}}).each("end.brush",function(){o=e.i,s=e.j,t({type:"brush",mode:"resize"}),t({type:"brushend"})}):(t({type:"brushstart"}),t({type:"brush",mode:"resize"}),t({type:"brushend"}))})},t.x=function(e){return arguments.length?(c=e,p=Ds[!c<<1|!l],t):c},t.y=function(e){return arguments.length?(l=e,p=Ds[!c<<1|!l],t):l},t.clamp=function(e){return arguments.length?(c&&l?(f=!!e[0],g=!!e[1]):c?f=!!e:l&&(g=!!e),t):c&&l?[f,g]:c?f:l?g:null},t.extent=function(e){var n,i,r,a,u;return arguments.length?(c&&(n=e[0],i=e[1],l&&(n=n[0],i=i[0]),o=[n,i],c.invert&&(n=c(n),i=c(i)),i<n&&(u=n,n=i,i=u),n==h[0]&&i==h[1]||(h=[n,i])),l&&(r=e[0],a=e[1],c&&(r=r[1],a=a[1]),s=[r,a],l.invert&&(r=l(r),a=l(a)),a<r&&(u=r,r=a,a=u),r==d[0]&&a==d[1]||(d=[r,a])),t):(c&&(o?(n=o[0],i=o[1]):(n=h[0],i=h[1],c.invert&&(n=c.invert(n),i=c.invert(i)),i<n&&(u=n,n=i,i=u))),l&&(s?(r=s[0],a=s[1]):(r=d[0],a=d[1],l.invert&&(r=l.invert(r),a=l.invert(a)),a<r&&(u=r,r=a,a=u))),c&&l?[[n,r],[i,a]]:c?[n,i]:l&&[r,a])},t.clear=function(){return t.empty()||(h=[0,0],d=[0,0],o=s=null),t},t.empty=function(){return!!c&&h[0]==h[1]||!!l&&d[0]==d[1]},Qr.rebind(t,u,"on")};var ks={n:"ns-resize",e:"ew-resize",s:"ns-resize",w:"ew-resize",nw:"nwse-resize",ne:"nesw-resize",se:"nwse-resize",sw:"nesw-resize"},Ds=[["n","e","s","w","nw","ne","se","sw"],["e","w"],["n","s"],[]],Os=Za.format=io.timeFormat,Is=Os.utc,Vs=Is("%Y-%m-%dT%H:%M:%S.%LZ");Os.iso=Date.prototype.toISOString&&+new Date("2000-01-01T00:00:00.000Z")?Xr:Vs,Xr.parse=function(t){var e=new Date(t);return isNaN(e)?null:e},Xr.toString=Vs.toString,Za.second=Lt(function(t){return new Ka(1e3*Math.floor(t/1e3))},function(t,e){t.setTime(t.getTime()+1e3*Math.floor(e))},function(t){return t.getSeconds()}),Za.seconds=Za.second.range,Za.seconds.utc=Za.second.utc.range,Za.minute=Lt(function(t){return new Ka(6e4*Math.floor(t/6e4))},function(t,e){t.setTime(t.getTime()+6e4*Math.floor(e))},function(t){return t.getMinutes()}),Za.minutes=Za.minute.range,Za.minutes.utc=Za.minute.utc.range,Za.hour=Lt(function(t){var e=t.getTimezoneOffset()/60;return new Ka(36e5*(Math.floor(t/36e5-e)+e))},function(t,e){t.setTime(t.getTime()+36e5*Math.floor(e))},function(t){return t.getHours()}),Za.hours=Za.hour.range,Za.hours.utc=Za.hour.utc.range,Za.month=Lt(function(t){return(t=Za.day(t)).setDate(1),t},function(t,e){t.setMonth(t.getMonth()+e)},function(t){return t.getMonth()}),Za.months=Za.month.range,Za.months.utc=Za.month.utc.range;var Gs=[1e3,5e3,15e3,3e4,6e4,3e5,9e5,18e5,36e5,108e5,216e5,432e5,864e5,1728e5,6048e5,2592e6,7776e6,31536e6],Rs=[[Za.second,1],[Za.second,5],[Za.second,15],[Za.second,30],[Za.minute,1],[Za.minute,5],[Za.minute,15],[Za.minute,30],[Za.hour,1],[Za.hour,3],[Za.hour,6],[Za.hour,12],[Za.day,1],[Za.day,2],[Za.week,1],[Za.month,1],[Za.month,3],[Za.year,1]],Ns=Os.multi([[".%L",function(t){return t.getMilliseconds()}],[":%S",function(t){return t.getSeconds()}],["%I:%M",function(t){return t.getMinutes()}],["%I %p",function(t){return t.getHours()}],["%a %d",function(t){return t.getDay()&&1!=t.getDate()}],["%b %d",function(t){return 1!=t.getDate()}],["%B",function(t){return t.getMonth()}],["%Y",ve]]),js={range:function(t,e,n){return Qr.range(Math.ceil(t/n)*n,+e,n).map(Ur)},floor:y,ceil:y};Rs.year=Za.year,Za.scale=function(){return Br(Qr.scale.linear(),Rs,Ns)};var Fs=Rs.map(function(t){return[t[0].utc,t[1]]}),zs=Is.multi([[".%L",function(t){return t.getUTCMilliseconds()}],[":%S",function(t){return t.getUTCSeconds()}],["%I:%M",function(t){return t.getUTCMinutes()}],["%I %p",function(t){return t.getUTCHours()}],["%a %d",function(t){return t.getUTCDay()&&1!=t.getUTCDate()}],["%b %d",function(t){return 1!=t.getUTCDate()}],["%B",function(t){return t.getUTCMonth()}],["%Y",ve]]);Fs.year=Za.year.utc,Za.scale.utc=function(){return Br(Qr.scale.linear(),Fs,zs)},Qr.text=bt(function(t){return t.responseText}),Qr.json=function(t,e){return St(t,"application/json",qr,e)},Qr.html=function(t,e){return St(t,"text/html",Wr,e)},Qr.xml=bt(function(t){return t.responseXML}),"function"==typeof define&&define.amd?(this.d3=Qr,define(Qr)):"object"==typeof e&&e.exports?e.exports=Qr:this.d3=Qr}()},{}],4:[function(t,e,n){!function(t,i){"object"==typeof n&&void 0!==e?e.exports=i():"function"==typeof define&&define.amd?define(i):t.flatpickr=i()}(this,function(){"use strict";function t(t,e,n){return!1!==n?new Date(t.getTime()).setHours(0,0,0,0)-new Date(e.getTime()).setHours(0,0,0,0):t.getTime()-e.getTime()}function e(t,e,n){void 0===n&&(n=!1);var i;return function(){var r=this,a=arguments;null!==i&&clearTimeout(i),i=window.setTimeout(function(){i=null,n||t.apply(r,a)},e),n&&!i&&t.apply(r,a)}}function n(t,e,n){if(!0===n)return t.classList.add(e);t.classList.remove(e)}function i(t,e,n){var i=window.document.createElement(t);return e=e||"",n=n||"",i.className=e,void 0!==n&&(i.textContent=n),i}function r(t,e){return e(t)?t:t.parentNode?r(t.parentNode,e):void 0}function a(t){var e=i("div","numInputWrapper"),n=i("input","numInput "+t),r=i("span","arrowUp"),a=i("span","arrowDown");return n.type="text",n.pattern="\\d*",e.appendChild(n),e.appendChild(r),e.appendChild(a),e}function o(o,s){function h(t){return t.bind(ot)}function d(t){if(ot.config.noCalendar&&0===ot.selectedDates.length){var e=ot.config.minDate;ot.setDate((new Date).setHours(e?e.getHours():ot.config.defaultHour,e?e.getMinutes():ot.config.defaultMinute,e&&ot.config.enableSeconds?e.getSeconds():ot.config.defaultSeconds),!1),m(),it()}!function(t){t.preventDefault();var e="keydown"===t.type,n=t.target;void 0!==ot.amPM&&t.target===ot.amPM&&(ot.amPM.textContent=ot.l10n.amPM[g(ot.amPM.textContent===ot.l10n.amPM[0])]);var i=Number(n.min),r=Number(n.max),a=Number(n.step),o=parseInt(n.value,10),s=t.delta||(e?38===t.which?1:-1:Math.max(-1,Math.min(1,t.wheelDelta||-t.deltaY))||0),u=o+a*s;if(void 0!==n.value&&2===n.value.length){var c=n===ot.hourElement,l=n===ot.minuteElement;u<i?(u=r+u+g(!c)+(g(c)&&g(!ot.amPM)),l&&D(void 0,-1,ot.hourElement)):u>r&&(u=n===ot.hourElement?u-r-g(!ot.amPM):i,l&&D(void 0,1,ot.hourElement)),ot.amPM&&c&&(1===a?u+o===23:Math.abs(u-o)>a)&&(ot.amPM.textContent=ot.l10n.amPM[g(ot.amPM.textContent===ot.l10n.amPM[0])]),n.value=f(u)}}(t),0!==ot.selectedDates.length&&(!ot.minDateHasTime||"input"!==t.type||t.target.value.length>=2?(m(),it()):setTimeout(function(){m(),it()},1e3))}function m(){if(void 0!==ot.hourElement&&void 0!==ot.minuteElement){var e=(parseInt(ot.hourElement.value.slice(-2),10)||0)%24,n=(parseInt(ot.minuteElement.value,10)||0)%60,i=void 0!==ot.secondElement?(parseInt(ot.secondElement.value,10)||0)%60:0;void 0!==ot.amPM&&(e=function(t,e){return t%12+12*g(e===ot.l10n.amPM[1])}(e,ot.amPM.textContent)),ot.config.minDate&&ot.minDateHasTime&&ot.latestSelectedDateObj&&0===t(ot.latestSelectedDateObj,ot.config.minDate)&&(e=Math.max(e,ot.config.minDate.getHours()))===ot.config.minDate.getHours()&&(n=Math.max(n,ot.config.minDate.getMinutes())),ot.config.maxDate&&ot.maxDateHasTime&&ot.latestSelectedDateObj&&0===t(ot.latestSelectedDateObj,ot.config.maxDate)&&(e=Math.min(e,ot.config.maxDate.getHours()))===ot.config.maxDate.getHours()&&(n=Math.min(n,ot.config.maxDate.getMinutes())),b(e,n,i)}}function w(t){var e=t||ot.latestSelectedDateObj;e&&b(e.getHours(),e.getMinutes(),e.getSeconds())}function b(t,e,n){void 0!==ot.latestSelectedDateObj&&ot.latestSelectedDateObj.setHours(t%24,e,n||0,0),ot.hourElement&&ot.minuteElement&&!ot.isMobile&&(ot.hourElement.value=f(ot.config.time_24hr?t:(12+t)%12+12*g(t%12==0)),ot.minuteElement.value=f(e),void 0!==ot.amPM&&(ot.amPM.textContent=ot.l10n.amPM[g(t>=12)]),void 0!==ot.secondElement&&(ot.secondElement.value=f(n)))}function S(t){var e=parseInt(t.target.value)+(t.delta||0);4!==e.toString().length&&"Enter"!==t.key||(ot.currentYearElement.blur(),/[^\d]/.test(e.toString())||z(e))}function T(t,e,n){return e instanceof Array?e.forEach(function(e){return T(t,e,n)}):t instanceof Array?t.forEach(function(t){return T(t,e,n)}):(t.addEventListener(e,n),void ot._handlers.push({element:t,event:e,handler:n}))}function A(t){return function(e){1===e.which&&t(e)}}function C(){J("onChange")}function M(){ot._animationLoop.forEach(function(t){return t()}),ot._animationLoop=[]}function P(t){if(ot.daysContainer&&ot.daysContainer.childNodes.length>1)switch(t.animationName){case"fpSlideLeft":ot.daysContainer.lastChild&&ot.daysContainer.lastChild.classList.remove("slideLeftNew"),ot.daysContainer.removeChild(ot.daysContainer.firstChild),ot.days=ot.daysContainer.firstChild,M();break;case"fpSlideRight":ot.daysContainer.firstChild&&ot.daysContainer.firstChild.classList.remove("slideRightNew"),ot.daysContainer.removeChild(ot.daysContainer.lastChild),ot.days=ot.daysContainer.firstChild,M()}}function E(t){switch(t.animationName){case"fpSlideLeftNew":case"fpSlideRightNew":ot.navigationCurrentMonth.classList.remove("slideLeftNew"),ot.navigationCurrentMonth.classList.remove("slideRightNew");for(var e=ot.navigationCurrentMonth;e.nextSibling&&/curr/.test(e.nextSibling.className);)ot.monthNav.removeChild(e.nextSibling);for(;e.previousSibling&&/curr/.test(e.previousSibling.className);)ot.monthNav.removeChild(e.previousSibling);ot.oldCurMonth=void 0}}function L(t){var e=void 0!==t?K(t):ot.latestSelectedDateObj||(ot.config.minDate&&ot.config.minDate>ot.now?ot.config.minDate:ot.config.maxDate&&ot.config.maxDate<ot.now?ot.config.maxDate:ot.now);try{void 0!==e&&(ot.currentYear=e.getFullYear(),ot.currentMonth=e.getMonth())}catch(t){t.message="Invalid date supplied: "+e,ot.config.errorHandler(t)}ot.redraw()}function k(t){~t.target.className.indexOf("arrow")&&D(t,t.target.classList.contains("arrowUp")?1:-1)}function D(t,e,n){var i=t&&t.target,r=n||i&&i.parentNode&&i.parentNode.firstChild,a=tt("increment");a.delta=e,r&&r.dispatchEvent(a)}function O(e,r,a,o){var s=H(r,!0),u=i("span","flatpickr-day "+e,r.getDate().toString());return u.dateObj=r,u.$i=o,u.setAttribute("aria-label",ot.formatDate(r,ot.config.ariaDateFormat)),0===t(r,ot.now)&&(ot.todayDateElem=u,u.classList.add("today")),s?(u.tabIndex=-1,et(r)&&(u.classList.add("selected"),ot.selectedDateElem=u,"range"===ot.config.mode&&(n(u,"startRange",ot.selectedDates[0]&&0===t(r,ot.selectedDates[0])),n(u,"endRange",ot.selectedDates[1]&&0===t(r,ot.selectedDates[1]))))):(u.classList.add("disabled"),ot.selectedDates[0]&&ot.minRangeDate&&r>ot.minRangeDate&&r<ot.selectedDates[0]?ot.minRangeDate=r:ot.selectedDates[0]&&ot.maxRangeDate&&r<ot.maxRangeDate&&r>ot.selectedDates[0]&&(ot.maxRangeDate=r)),"range"===ot.config.mode&&(function(e){return!("range"!==ot.config.mode||ot.selectedDates.length<2)&&t(e,ot.selectedDates[0])>=0&&t(e,ot.selectedDates[1])<=0}(r)&&!et(r)&&u.classList.add("inRange"),1===ot.selectedDates.length&&void 0!==ot.minRangeDate&&void 0!==ot.maxRangeDate&&(r<ot.minRangeDate||r>ot.maxRangeDate)&&u.classList.add("notAllowed")),ot.weekNumbers&&"prevMonthDay"!==e&&a%7==1&&ot.weekNumbers.insertAdjacentHTML("beforeend","<span class='disabled flatpickr-day'>"+ot.config.getWeek(r)+"</span>"),J("onDayCreate",u),u}function I(t,e){var n=t+e||0,i=void 0!==t?ot.days.childNodes[n]:ot.selectedDateElem||ot.todayDateElem||ot.days.childNodes[0],r=function(){(i=i||ot.days.childNodes[n]).focus(),"range"===ot.config.mode&&X(i)};if(void 0===i&&0!==e)return e>0?(ot.changeMonth(1,!0,void 0,!0),n%=42):e<0&&(ot.changeMonth(-1,!0,void 0,!0),n+=42),V(r);r()}function V(t){!0===ot.config.animate?ot._animationLoop.push(t):t()}function G(t){if(void 0!==ot.daysContainer){var e=(new Date(ot.currentYear,ot.currentMonth,1).getDay()-ot.l10n.firstDayOfWeek+7)%7,n="range"===ot.config.mode,r=ot.utils.getDaysInMonth((ot.currentMonth-1+12)%12),a=ot.utils.getDaysInMonth(),o=window.document.createDocumentFragment(),s=r+1-e,u=0;for(ot.weekNumbers&&ot.weekNumbers.firstChild&&(ot.weekNumbers.textContent=""),n&&(ot.minRangeDate=new Date(ot.currentYear,ot.currentMonth-1,s),ot.maxRangeDate=new Date(ot.currentYear,ot.currentMonth+1,(42-e)%a));s<=r;s++,u++)o.appendChild(O("prevMonthDay",new Date(ot.currentYear,ot.currentMonth-1,s),s,u));for(s=1;s<=a;s++,u++)o.appendChild(O("",new Date(ot.currentYear,ot.currentMonth,s),s,u));for(var c=a+1;c<=42-e;c++,u++)o.appendChild(O("nextMonthDay",new Date(ot.currentYear,ot.currentMonth+1,c%a),c,u));n&&1===ot.selectedDates.length&&o.childNodes[0]?(ot._hidePrevMonthArrow=ot._hidePrevMonthArrow||!!ot.minRangeDate&&ot.minRangeDate>o.childNodes[0].dateObj,ot._hideNextMonthArrow=ot._hideNextMonthArrow||!!ot.maxRangeDate&&ot.maxRangeDate<new Date(ot.currentYear,ot.currentMonth+1,1)):nt();var l=i("div","dayContainer");if(l.appendChild(o),ot.config.animate&&void 0!==t)for(;ot.daysContainer.childNodes.length>1;)ot.daysContainer.removeChild(ot.daysContainer.firstChild);else!function(t){for(;t.firstChild;)t.removeChild(t.firstChild)}(ot.daysContainer);t&&t>=0?ot.daysContainer.appendChild(l):ot.daysContainer.insertBefore(l,ot.daysContainer.firstChild),ot.days=ot.daysContainer.childNodes[0]}}function R(){ot.weekdayContainer||(ot.weekdayContainer=i("div","flatpickr-weekdays"));var t=ot.l10n.firstDayOfWeek,e=ot.l10n.weekdays.shorthand.slice();return t>0&&t<e.length&&(e=e.splice(t,e.length).concat(e.splice(0,t))),ot.weekdayContainer.innerHTML="\n    <span class=flatpickr-weekday>\n      "+e.join("</span><span class=flatpickr-weekday>")+"\n    </span>\n    ",ot.weekdayContainer}function N(t,e,n,i){void 0===e&&(e=!0),void 0===n&&(n=ot.config.animate),void 0===i&&(i=!1);var r=e?t:t-ot.currentMonth;if(!(r<0&&ot._hidePrevMonthArrow||r>0&&ot._hideNextMonthArrow)){if(ot.currentMonth+=r,(ot.currentMonth<0||ot.currentMonth>11)&&(ot.currentYear+=ot.currentMonth>11?1:-1,ot.currentMonth=(ot.currentMonth+12)%12,J("onYearChange")),G(n?r:void 0),!n)return J("onMonthChange"),nt();var a=ot.navigationCurrentMonth;if(r<0)for(;a.nextSibling&&/curr/.test(a.nextSibling.className);)ot.monthNav.removeChild(a.nextSibling);else if(r>0)for(;a.previousSibling&&/curr/.test(a.previousSibling.className);)ot.monthNav.removeChild(a.previousSibling);ot.oldCurMonth=ot.navigationCurrentMonth,ot.navigationCurrentMonth=ot.monthNav.insertBefore(ot.oldCurMonth.cloneNode(!0),r>0?ot.oldCurMonth.nextSibling:ot.oldCurMonth);var o=ot.daysContainer;if(o.firstChild&&o.lastChild&&(r>0?(o.firstChild.classList.add("slideLeft"),o.lastChild.classList.add("slideLeftNew"),ot.oldCurMonth.classList.add("slideLeft"),ot.navigationCurrentMonth.classList.add("slideLeftNew")):r<0&&(o.firstChild.classList.add("slideRightNew"),o.lastChild.classList.add("slideRight"),ot.oldCurMonth.classList.add("slideRight"),ot.navigationCurrentMonth.classList.add("slideRightNew"))),ot.currentMonthElement=ot.navigationCurrentMonth.firstChild,ot.currentYearElement=ot.navigationCurrentMonth.lastChild.childNodes[0],nt(),ot.oldCurMonth.firstChild&&(ot.oldCurMonth.firstChild.textContent=c(ot.currentMonth-r,ot.config.shorthandCurrentMonth,ot.l10n)),V(function(){return J("onMonthChange")}),i&&document.activeElement&&document.activeElement.$i){var s=document.activeElement.$i;V(function(){I(s,0)})}}}function j(t){return!(!ot.config.appendTo||!ot.config.appendTo.contains(t))||ot.calendarContainer.contains(t)}function F(t){if(ot.isOpen&&!ot.config.inline){var e=j(t.target),n=t.target===ot.input||t.target===ot.altInput||ot.element.contains(t.target)||t.path&&t.path.indexOf&&(~t.path.indexOf(ot.input)||~t.path.indexOf(ot.altInput));("blur"===t.type?n&&t.relatedTarget&&!j(t.relatedTarget):!n&&!e)&&-1===ot.config.ignoredFocusElements.indexOf(t.target)&&(ot.close(),"range"===ot.config.mode&&1===ot.selectedDates.length&&(ot.clear(!1),ot.redraw()))}}function z(t){if(!(!t||ot.currentYearElement.min&&t<parseInt(ot.currentYearElement.min)||ot.currentYearElement.max&&t>parseInt(ot.currentYearElement.max))){var e=t,n=ot.currentYear!==e;ot.currentYear=e||ot.currentYear,ot.config.maxDate&&ot.currentYear===ot.config.maxDate.getFullYear()?ot.currentMonth=Math.min(ot.config.maxDate.getMonth(),ot.currentMonth):ot.config.minDate&&ot.currentYear===ot.config.minDate.getFullYear()&&(ot.currentMonth=Math.max(ot.config.minDate.getMonth(),ot.currentMonth)),n&&(ot.redraw(),J("onYearChange"))}}function H(e,n){void 0===n&&(n=!0);var i=ot.parseDate(e,void 0,n);if(ot.config.minDate&&i&&t(i,ot.config.minDate,void 0!==n?n:!ot.minDateHasTime)<0||ot.config.maxDate&&i&&t(i,ot.config.maxDate,void 0!==n?n:!ot.maxDateHasTime)>0)return!1;if(!ot.config.enable.length&&!ot.config.disable.length)return!0;if(void 0===i)return!1;for(var r=ot.config.enable.length>0,a=r?ot.config.enable:ot.config.disable,o=0,s=void 0;o<a.length;o++){if("function"==typeof(s=a[o])&&s(i))return r;if(s instanceof Date&&void 0!==i&&s.getTime()===i.getTime())return r;if("string"==typeof s&&void 0!==i){var u=ot.parseDate(s,void 0,!0);return u&&u.getTime()===i.getTime()?r:!r}if("object"==typeof s&&void 0!==i&&s.from&&s.to&&i.getTime()>=s.from.getTime()&&i.getTime()<=s.to.getTime())return r}return!r}function Y(t){var e=t.target===ot._input,n=j(t.target),i=ot.config.allowInput,r=ot.isOpen&&(!i||!e),a=ot.config.inline&&e&&!i;if("Enter"===t.key&&e){if(i)return ot.setDate(ot._input.value,!0,t.target===ot.altInput?ot.config.altFormat:ot.config.dateFormat),t.target.blur();ot.open()}else if(n||r||a){var o=!!ot.timeContainer&&ot.timeContainer.contains(t.target);switch(t.key){case"Enter":o?it():Q(t);break;case"Escape":t.preventDefault(),ot.close();break;case"Backspace":case"Delete":e&&!ot.config.allowInput&&ot.clear();break;case"ArrowLeft":case"ArrowRight":if(o)ot.hourElement&&ot.hourElement.focus();else if(t.preventDefault(),ot.daysContainer){var s="ArrowRight"===t.key?1:-1;t.ctrlKey?N(s,!0,void 0,!0):I(t.target.$i,s)}break;case"ArrowUp":case"ArrowDown":t.preventDefault();var u="ArrowDown"===t.key?1:-1;ot.daysContainer&&void 0!==t.target.$i?t.ctrlKey?(z(ot.currentYear-u),I(t.target.$i,0)):o||I(t.target.$i,7*u):ot.config.enableTime&&(!o&&ot.hourElement&&ot.hourElement.focus(),d(t),ot._debouncedChange());break;case"Tab":t.target===ot.hourElement?(t.preventDefault(),ot.minuteElement.select()):t.target===ot.minuteElement&&(ot.secondElement||ot.amPM)?(t.preventDefault(),void 0!==ot.secondElement?ot.secondElement.focus():void 0!==ot.amPM&&ot.amPM.focus()):t.target===ot.secondElement&&ot.amPM&&(t.preventDefault(),ot.amPM.focus());break;case ot.l10n.amPM[0].charAt(0):void 0!==ot.amPM&&t.target===ot.amPM&&(ot.amPM.textContent=ot.l10n.amPM[0],m(),it());break;case ot.l10n.amPM[1].charAt(0):void 0!==ot.amPM&&t.target===ot.amPM&&(ot.amPM.textContent=ot.l10n.amPM[1],m(),it())}J("onKeyDown",t)}}function X(t){if(1===ot.selectedDates.length&&t.classList.contains("flatpickr-day")&&void 0!==ot.minRangeDate&&void 0!==ot.maxRangeDate){for(var e=t.dateObj,n=ot.parseDate(ot.selectedDates[0],void 0,!0),i=Math.min(e.getTime(),ot.selectedDates[0].getTime()),r=Math.max(e.getTime(),ot.selectedDates[0].getTime()),a=!1,o=i;o<r;o+=l.DAY)if(!H(new Date(o))){a=!0;break}for(var s=function(o,s){var u=s.getTime(),c=u<ot.minRangeDate.getTime()||u>ot.maxRangeDate.getTime(),l=ot.days.childNodes[o];if(c)return l.classList.add("notAllowed"),["inRange","startRange","endRange"].forEach(function(t){l.classList.remove(t)}),"continue";if(a&&!c)return"continue";["startRange","inRange","endRange","notAllowed"].forEach(function(t){l.classList.remove(t)});var h=Math.max(ot.minRangeDate.getTime(),i),d=Math.min(ot.maxRangeDate.getTime(),r);t.classList.add(e<ot.selectedDates[0]?"startRange":"endRange"),n<e&&u===n.getTime()?l.classList.add("startRange"):n>e&&u===n.getTime()&&l.classList.add("endRange"),u>=h&&u<=d&&l.classList.add("inRange")},u=0,c=ot.days.childNodes[u].dateObj;u<42;u++,c=ot.days.childNodes[u]&&ot.days.childNodes[u].dateObj)s(u,c)}}function B(){!ot.isOpen||ot.config.static||ot.config.inline||q()}function U(t){return function(e){var n=ot.config["_"+t+"Date"]=ot.parseDate(e),i=ot.config["_"+("min"===t?"max":"min")+"Date"];void 0!==n&&(ot["min"===t?"minDateHasTime":"maxDateHasTime"]=n.getHours()>0||n.getMinutes()>0||n.getSeconds()>0),ot.selectedDates&&(ot.selectedDates=ot.selectedDates.filter(function(t){return H(t)}),ot.selectedDates.length||"min"!==t||w(n),it()),ot.daysContainer&&(W(),void 0!==n?ot.currentYearElement[t]=n.getFullYear().toString():ot.currentYearElement.removeAttribute(t),ot.currentYearElement.disabled=!!i&&void 0!==n&&i.getFullYear()===n.getFullYear())}}function q(t){if(void 0===t&&(t=ot._positionElement),void 0!==ot.calendarContainer){var e=ot.calendarContainer.offsetHeight,i=ot.calendarContainer.offsetWidth,r=ot.config.position,a=t.getBoundingClientRect(),o=window.innerHeight-a.bottom,s="above"===r||"below"!==r&&o<e&&a.top>e,u=window.pageYOffset+a.top+(s?-e-2:t.offsetHeight+2);if(n(ot.calendarContainer,"arrowTop",!s),n(ot.calendarContainer,"arrowBottom",s),!ot.config.inline){var c=window.pageXOffset+a.left,l=window.document.body.offsetWidth-a.right,h=c+i>window.document.body.offsetWidth;n(ot.calendarContainer,"rightMost",h),ot.config.static||(ot.calendarContainer.style.top=u+"px",h?(ot.calendarContainer.style.left="auto",ot.calendarContainer.style.right=l+"px"):(ot.calendarContainer.style.left=c+"px",ot.calendarContainer.style.right="auto"))}}}function W(){ot.config.noCalendar||ot.isMobile||(R(),nt(),G())}function Q(e){e.preventDefault(),e.stopPropagation();var n=r(e.target,function(t){return t.classList&&t.classList.contains("flatpickr-day")&&!t.classList.contains("disabled")&&!t.classList.contains("notAllowed")});if(void 0!==n){var i=n,a=ot.latestSelectedDateObj=new Date(i.dateObj.getTime()),o=a.getMonth()!==ot.currentMonth&&"range"!==ot.config.mode;if(ot.selectedDateElem=i,"single"===ot.config.mode)ot.selectedDates=[a];else if("multiple"===ot.config.mode){var s=et(a);s?ot.selectedDates.splice(parseInt(s),1):ot.selectedDates.push(a)}else"range"===ot.config.mode&&(2===ot.selectedDates.length&&ot.clear(),ot.selectedDates.push(a),0!==t(a,ot.selectedDates[0],!0)&&ot.selectedDates.sort(function(t,e){return t.getTime()-e.getTime()}));if(m(),o){var u=ot.currentYear!==a.getFullYear();ot.currentYear=a.getFullYear(),ot.currentMonth=a.getMonth(),u&&J("onYearChange"),J("onMonthChange")}if(G(),ot.config.minDate&&ot.minDateHasTime&&ot.config.enableTime&&0===t(a,ot.config.minDate)&&w(ot.config.minDate),it(),ot.config.enableTime&&setTimeout(function(){return ot.showTimeInput=!0},50),"range"===ot.config.mode&&(1===ot.selectedDates.length?(X(i),ot._hidePrevMonthArrow=ot._hidePrevMonthArrow||void 0!==ot.minRangeDate&&ot.minRangeDate>ot.days.childNodes[0].dateObj,ot._hideNextMonthArrow=ot._hideNextMonthArrow||void 0!==ot.maxRangeDate&&ot.maxRangeDate<new Date(ot.currentYear,ot.currentMonth+1,1)):nt()),J("onChange"),o?V(function(){return ot.selectedDateElem&&ot.selectedDateElem.focus()}):I(i.$i,0),void 0!==ot.hourElement&&setTimeout(function(){return void 0!==ot.hourElement&&ot.hourElement.select()},451),ot.config.closeOnSelect){var c="single"===ot.config.mode&&!ot.config.enableTime,l="range"===ot.config.mode&&2===ot.selectedDates.length&&!ot.config.enableTime;(c||l)&&ot.close()}}}function $(t,e){var n=[];if(t instanceof Array)n=t.map(function(t){return ot.parseDate(t,e)});else if(t instanceof Date||"number"==typeof t)n=[ot.parseDate(t,e)];else if("string"==typeof t)switch(ot.config.mode){case"single":n=[ot.parseDate(t,e)];break;case"multiple":n=t.split(ot.config.conjunction).map(function(t){return ot.parseDate(t,e)});break;case"range":n=t.split(ot.l10n.rangeSeparator).map(function(t){return ot.parseDate(t,e)})}else ot.config.errorHandler(new Error("Invalid date supplied: "+JSON.stringify(t)));ot.selectedDates=n.filter(function(t){return t instanceof Date&&H(t,!1)}),ot.selectedDates.sort(function(t,e){return t.getTime()-e.getTime()})}function Z(t){return t.map(function(t){return"string"==typeof t||"number"==typeof t||t instanceof Date?ot.parseDate(t,void 0,!0):t&&"object"==typeof t&&t.from&&t.to?{from:ot.parseDate(t.from,void 0),to:ot.parseDate(t.to,void 0)}:t}).filter(function(t){return t})}function K(t,e,n){if(0===t||t){var i,r=t;if(t instanceof Date)i=new Date(t.getTime());else if("string"!=typeof t&&void 0!==t.toFixed)i=new Date(t);else if("string"==typeof t){var a=e||(ot.config||_.defaultConfig).dateFormat,o=String(t).trim();if("today"===o)i=new Date,n=!0;else if(/Z$/.test(o)||/GMT$/.test(o))i=new Date(t);else if(ot.config&&ot.config.parseDate)i=ot.config.parseDate(t,a);else{i=ot.config&&ot.config.noCalendar?new Date((new Date).setHours(0,0,0,0)):new Date((new Date).getFullYear(),0,1,0,0,0,0);for(var s=void 0,u=[],c=0,l=0,h="";c<a.length;c++){var d=a[c],f="\\"===d,g="\\"===a[c-1]||f;if(y[d]&&!g){h+=y[d];var p=new RegExp(h).exec(t);p&&(s=!0)&&u["Y"!==d?"push":"unshift"]({fn:v[d],val:p[++l]})}else f||(h+=".");u.forEach(function(t){var e=t.fn,n=t.val;return i=e(i,n,ot.l10n)||i})}i=s?i:void 0}}if(i instanceof Date)return!0===n&&i.setHours(0,0,0,0),i;ot.config.errorHandler(new Error("Invalid date provided: "+r))}}function J(t,e){var n=ot.config[t];if(void 0!==n&&n.length>0)for(var i=0;n[i]&&i<n.length;i++)n[i](ot.selectedDates,ot.input.value,ot,e);"onChange"===t&&(ot.input.dispatchEvent(tt("change")),ot.input.dispatchEvent(tt("input")))}function tt(t){var e=document.createEvent("Event");return e.initEvent(t,!0,!0),e}function et(e){for(var n=0;n<ot.selectedDates.length;n++)if(0===t(ot.selectedDates[n],e))return""+n;return!1}function nt(){ot.config.noCalendar||ot.isMobile||!ot.monthNav||(ot.currentMonthElement.textContent=c(ot.currentMonth,ot.config.shorthandCurrentMonth,ot.l10n)+" ",ot.currentYearElement.value=ot.currentYear.toString(),ot._hidePrevMonthArrow=void 0!==ot.config.minDate&&(ot.currentYear===ot.config.minDate.getFullYear()?ot.currentMonth<=ot.config.minDate.getMonth():ot.currentYear<ot.config.minDate.getFullYear()),ot._hideNextMonthArrow=void 0!==ot.config.maxDate&&(ot.currentYear===ot.config.maxDate.getFullYear()?ot.currentMonth+1>ot.config.maxDate.getMonth():ot.currentYear>ot.config.maxDate.getFullYear()))}function it(t){if(void 0===t&&(t=!0),!ot.selectedDates.length)return ot.clear(t);void 0!==ot.mobileInput&&ot.mobileFormatStr&&(ot.mobileInput.value=void 0!==ot.latestSelectedDateObj?ot.formatDate(ot.latestSelectedDateObj,ot.mobileFormatStr):"");var e="range"!==ot.config.mode?ot.config.conjunction:ot.l10n.rangeSeparator;ot.input.value=ot.selectedDates.map(function(t){return ot.formatDate(t,ot.config.dateFormat)}).join(e),void 0!==ot.altInput&&(ot.altInput.value=ot.selectedDates.map(function(t){return ot.formatDate(t,ot.config.altFormat)}).join(e)),!1!==t&&J("onValueUpdate")}function rt(t){t.preventDefault();var e=ot.currentYearElement.parentNode&&ot.currentYearElement.parentNode.contains(t.target);if(t.target===ot.currentMonthElement||e){var n=function(t){return(t.wheelDelta||-t.deltaY)>=0?1:-1}(t);e?(z(ot.currentYear+n),t.target.value=ot.currentYear.toString()):ot.changeMonth(n,!0,!1)}}function at(t){var e=ot.prevMonthNav.contains(t.target),n=ot.nextMonthNav.contains(t.target);e||n?N(e?-1:1):t.target===ot.currentYearElement?(t.preventDefault(),ot.currentYearElement.select()):"arrowUp"===t.target.className?ot.changeYear(ot.currentYear+1):"arrowDown"===t.target.className&&ot.changeYear(ot.currentYear-1)}var ot={};return ot.parseDate=K,ot.formatDate=function(t,e){return void 0!==ot.config&&void 0!==ot.config.formatDate?ot.config.formatDate(t,e):e.split("").map(function(e,n,i){return x[e]&&"\\"!==i[n-1]?x[e](t,ot.l10n,ot.config):"\\"!==e?e:""}).join("")},ot._animationLoop=[],ot._handlers=[],ot._bind=T,ot._setHoursFromDate=w,ot.changeMonth=N,ot.changeYear=z,ot.clear=function(t){void 0===t&&(t=!0),ot.input.value="",ot.altInput&&(ot.altInput.value=""),ot.mobileInput&&(ot.mobileInput.value=""),ot.selectedDates=[],ot.latestSelectedDateObj=void 0,ot.showTimeInput=!1,ot.redraw(),t&&J("onChange")},ot.close=function(){ot.isOpen=!1,ot.isMobile||(ot.calendarContainer.classList.remove("open"),ot._input.classList.remove("active")),J("onClose")},ot._createElement=i,ot.destroy=function(){void 0!==ot.config&&J("onDestroy");for(var t=ot._handlers.length;t--;){var e=ot._handlers[t];e.element.removeEventListener(e.event,e.handler)}ot._handlers=[],ot.mobileInput?(ot.mobileInput.parentNode&&ot.mobileInput.parentNode.removeChild(ot.mobileInput),ot.mobileInput=void 0):ot.calendarContainer&&ot.calendarContainer.parentNode&&ot.calendarContainer.parentNode.removeChild(ot.calendarContainer),ot.altInput&&(ot.input.type="text",ot.altInput.parentNode&&ot.altInput.parentNode.removeChild(ot.altInput),delete ot.altInput),ot.input&&(ot.input.type=ot.input._type,ot.input.classList.remove("flatpickr-input"),ot.input.removeAttribute("readonly"),ot.input.value=""),["_showTimeInput","latestSelectedDateObj","_hideNextMonthArrow","_hidePrevMonthArrow","__hideNextMonthArrow","__hidePrevMonthArrow","isMobile","isOpen","selectedDateElem","minDateHasTime","maxDateHasTime","days","daysContainer","_input","_positionElement","innerContainer","rContainer","monthNav","todayDateElem","calendarContainer","weekdayContainer","prevMonthNav","nextMonthNav","currentMonthElement","currentYearElement","navigationCurrentMonth","selectedDateElem","config"].forEach(function(t){try{delete ot[t]}catch(t){}})},ot.isEnabled=H,ot.jumpToDate=L,ot.open=function(t,e){if(void 0===e&&(e=ot._input),ot.isMobile)return t&&(t.preventDefault(),t.target&&t.target.blur()),setTimeout(function(){void 0!==ot.mobileInput&&ot.mobileInput.click()},0),void J("onOpen");if(!ot._input.disabled&&!ot.config.inline){var n=ot.isOpen;ot.isOpen=!0,q(e),ot.calendarContainer.classList.add("open"),ot._input.classList.add("active"),!n&&J("onOpen")}},ot.redraw=W,ot.set=function(t,e){null!==t&&"object"==typeof t?Object.assign(ot.config,t):ot.config[t]=e,ot.redraw(),L()},ot.setDate=function(t,e,n){if(void 0===e&&(e=!1),0!==t&&!t)return ot.clear(e);$(t,n),ot.showTimeInput=ot.selectedDates.length>0,ot.latestSelectedDateObj=ot.selectedDates[0],ot.redraw(),L(),w(),it(e),e&&J("onChange")},ot.toggle=function(){if(ot.isOpen)return ot.close();ot.open()},ot.element=ot.input=o,ot.isOpen=!1,function(){var t=["wrap","weekNumbers","allowInput","clickOpens","time_24hr","enableTime","noCalendar","altInput","shorthandCurrentMonth","inline","static","enableSeconds","disableMobile"],e=["onChange","onClose","onDayCreate","onDestroy","onKeyDown","onMonthChange","onOpen","onParseConfig","onReady","onValueUpdate","onYearChange"];ot.config=u({},_.defaultConfig);var n=u({},s,JSON.parse(JSON.stringify(o.dataset||{}))),i={};Object.defineProperty(ot.config,"enable",{get:function(){return ot.config._enable||[]},set:function(t){ot.config._enable=Z(t)}}),Object.defineProperty(ot.config,"disable",{get:function(){return ot.config._disable||[]},set:function(t){ot.config._disable=Z(t)}}),!n.dateFormat&&n.enableTime&&(i.dateFormat=n.noCalendar?"H:i"+(n.enableSeconds?":S":""):_.defaultConfig.dateFormat+" H:i"+(n.enableSeconds?":S":"")),n.altInput&&n.enableTime&&!n.altFormat&&(i.altFormat=n.noCalendar?"h:i"+(n.enableSeconds?":S K":" K"):_.defaultConfig.altFormat+" h:i"+(n.enableSeconds?":S":"")+" K"),Object.defineProperty(ot.config,"minDate",{get:function(){return ot.config._minDate},set:U("min")}),Object.defineProperty(ot.config,"maxDate",{get:function(){return ot.config._maxDate},set:U("max")}),Object.assign(ot.config,i,n);for(var r=0;r<t.length;r++)ot.config[t[r]]=!0===ot.config[t[r]]||"true"===ot.config[t[r]];for(var r=e.length;r--;)void 0!==ot.config[e[r]]&&(ot.config[e[r]]=p(ot.config[e[r]]||[]).map(h));for(var r=0;r<ot.config.plugins.length;r++){var a=ot.config.plugins[r](ot)||{};for(var c in a)~e.indexOf(c)?ot.config[c]=p(a[c]).map(h).concat(ot.config[c]):void 0===n[c]&&(ot.config[c]=a[c])}ot.isMobile=!ot.config.disableMobile&&!ot.config.inline&&"single"===ot.config.mode&&!ot.config.disable.length&&!ot.config.enable.length&&!ot.config.weekNumbers&&/Android|webOS|iPhone|iPad|iPod|BlackBerry|IEMobile|Opera Mini/i.test(navigator.userAgent),J("onParseConfig")}(),"object"!=typeof ot.config.locale&&void 0===_.l10ns[ot.config.locale]&&ot.config.errorHandler(new Error("flatpickr: invalid locale "+ot.config.locale)),ot.l10n=u({},_.l10ns.default,"object"==typeof ot.config.locale?ot.config.locale:"default"!==ot.config.locale?_.l10ns[ot.config.locale]:void 0),y.K="("+ot.l10n.amPM[0]+"|"+ot.l10n.amPM[1]+"|"+ot.l10n.amPM[0].toLowerCase()+"|"+ot.l10n.amPM[1].toLowerCase()+")",ot.input=ot.config.wrap?o.querySelector("[data-input]"):o,ot.input?(ot.input._type=ot.input.type,ot.input.type="text",ot.input.classList.add("flatpickr-input"),ot._input=ot.input,ot.config.altInput&&(ot.altInput=i(ot.input.nodeName,ot.input.className+" "+ot.config.altInputClass),ot._input=ot.altInput,ot.altInput.placeholder=ot.input.placeholder,ot.altInput.disabled=ot.input.disabled,ot.altInput.required=ot.input.required,ot.altInput.type="text",ot.input.type="hidden",!ot.config.static&&ot.input.parentNode&&ot.input.parentNode.insertBefore(ot.altInput,ot.input.nextSibling)),ot.config.allowInput||ot._input.setAttribute("readonly","readonly"),ot._positionElement=ot.config.positionElement||ot._input):ot.config.errorHandler(new Error("Invalid input element specified")),function(){ot.selectedDates=[],ot.now=new Date;var t=ot.config.defaultDate||ot.input.value;t&&$(t,ot.config.dateFormat);var e=ot.selectedDates.length?ot.selectedDates[0]:ot.config.minDate&&ot.config.minDate.getTime()>ot.now.getTime()?ot.config.minDate:ot.config.maxDate&&ot.config.maxDate.getTime()<ot.now.getTime()?ot.config.maxDate:ot.now;ot.currentYear=e.getFullYear(),ot.currentMonth=e.getMonth(),ot.selectedDates.length&&(ot.latestSelectedDateObj=ot.selectedDates[0]),ot.minDateHasTime=!!ot.config.minDate&&(ot.config.minDate.getHours()>0||ot.config.minDate.getMinutes()>0||ot.config.minDate.getSeconds()>0),ot.maxDateHasTime=!!ot.config.maxDate&&(ot.config.maxDate.getHours()>0||ot.config.maxDate.getMinutes()>0||ot.config.maxDate.getSeconds()>0),Object.defineProperty(ot,"showTimeInput",{get:function(){return ot._showTimeInput},set:function(t){ot._showTimeInput=t,ot.calendarContainer&&n(ot.calendarContainer,"showTimeInput",t),q()}})}(),ot.utils={getDaysInMonth:function(t,e){return void 0===t&&(t=ot.currentMonth),void 0===e&&(e=ot.currentYear),1===t&&(e%4==0&&e%100!=0||e%400==0)?29:ot.l10n.daysInMonth[t]}},ot.isMobile||function(){var t=window.document.createDocumentFragment();if(ot.calendarContainer=i("div","flatpickr-calendar"),ot.calendarContainer.tabIndex=-1,!ot.config.noCalendar){if(t.appendChild(function(){var t=window.document.createDocumentFragment();ot.monthNav=i("div","flatpickr-month"),ot.prevMonthNav=i("span","flatpickr-prev-month"),ot.prevMonthNav.innerHTML=ot.config.prevArrow,ot.currentMonthElement=i("span","cur-month"),ot.currentMonthElement.title=ot.l10n.scrollTitle;var e=a("cur-year");return ot.currentYearElement=e.childNodes[0],ot.currentYearElement.title=ot.l10n.scrollTitle,ot.config.minDate&&(ot.currentYearElement.min=ot.config.minDate.getFullYear().toString()),ot.config.maxDate&&(ot.currentYearElement.max=ot.config.maxDate.getFullYear().toString(),ot.currentYearElement.disabled=!!ot.config.minDate&&ot.config.minDate.getFullYear()===ot.config.maxDate.getFullYear()),ot.nextMonthNav=i("span","flatpickr-next-month"),ot.nextMonthNav.innerHTML=ot.config.nextArrow,ot.navigationCurrentMonth=i("div","flatpickr-current-month"),ot.navigationCurrentMonth.appendChild(ot.currentMonthElement),ot.navigationCurrentMonth.appendChild(e),t.appendChild(ot.prevMonthNav),t.appendChild(ot.navigationCurrentMonth),t.appendChild(ot.nextMonthNav),ot.monthNav.appendChild(t),Object.defineProperty(ot,"_hidePrevMonthArrow",{get:function(){return ot.__hidePrevMonthArrow},set:function(t){ot.__hidePrevMonthArrow!==t&&(ot.prevMonthNav.style.display=t?"none":"block"),ot.__hidePrevMonthArrow=t}}),Object.defineProperty(ot,"_hideNextMonthArrow",{get:function(){return ot.__hideNextMonthArrow},set:function(t){ot.__hideNextMonthArrow!==t&&(ot.nextMonthNav.style.display=t?"none":"block"),ot.__hideNextMonthArrow=t}}),nt(),ot.monthNav}()),ot.innerContainer=i("div","flatpickr-innerContainer"),ot.config.weekNumbers){var e=function(){ot.calendarContainer.classList.add("hasWeeks");var t=i("div","flatpickr-weekwrapper");t.appendChild(i("span","flatpickr-weekday",ot.l10n.weekAbbreviation));var e=i("div","flatpickr-weeks");return t.appendChild(e),{weekWrapper:t,weekNumbers:e}}(),r=e.weekWrapper,o=e.weekNumbers;ot.innerContainer.appendChild(r),ot.weekNumbers=o,ot.weekWrapper=r}ot.rContainer=i("div","flatpickr-rContainer"),ot.rContainer.appendChild(R()),ot.daysContainer||(ot.daysContainer=i("div","flatpickr-days"),ot.daysContainer.tabIndex=-1),G(),ot.rContainer.appendChild(ot.daysContainer),ot.innerContainer.appendChild(ot.rContainer),t.appendChild(ot.innerContainer)}ot.config.enableTime&&t.appendChild(function(){ot.calendarContainer.classList.add("hasTime"),ot.config.noCalendar&&ot.calendarContainer.classList.add("noCalendar"),ot.timeContainer=i("div","flatpickr-time"),ot.timeContainer.tabIndex=-1;var t=i("span","flatpickr-time-separator",":"),e=a("flatpickr-hour");ot.hourElement=e.childNodes[0];var n=a("flatpickr-minute");if(ot.minuteElement=n.childNodes[0],ot.hourElement.tabIndex=ot.minuteElement.tabIndex=-1,ot.hourElement.value=f(ot.latestSelectedDateObj?ot.latestSelectedDateObj.getHours():ot.config.time_24hr?ot.config.defaultHour:function(t){switch(t%24){case 0:case 12:return 12;default:return t%12}}(ot.config.defaultHour)),ot.minuteElement.value=f(ot.latestSelectedDateObj?ot.latestSelectedDateObj.getMinutes():ot.config.defaultMinute),ot.hourElement.step=ot.config.hourIncrement.toString(),ot.minuteElement.step=ot.config.minuteIncrement.toString(),ot.hourElement.min=ot.config.time_24hr?"0":"1",ot.hourElement.max=ot.config.time_24hr?"23":"12",ot.minuteElement.min="0",ot.minuteElement.max="59",ot.hourElement.title=ot.minuteElement.title=ot.l10n.scrollTitle,ot.timeContainer.appendChild(e),ot.timeContainer.appendChild(t),ot.timeContainer.appendChild(n),ot.config.time_24hr&&ot.timeContainer.classList.add("time24hr"),ot.config.enableSeconds){ot.timeContainer.classList.add("hasSeconds");var r=a("flatpickr-second");ot.secondElement=r.childNodes[0],ot.secondElement.value=f(ot.latestSelectedDateObj?ot.latestSelectedDateObj.getSeconds():ot.config.defaultSeconds),ot.secondElement.step=ot.minuteElement.step,ot.secondElement.min=ot.minuteElement.min,ot.secondElement.max=ot.minuteElement.max,ot.timeContainer.appendChild(i("span","flatpickr-time-separator",":")),ot.timeContainer.appendChild(r)}return ot.config.time_24hr||(ot.amPM=i("span","flatpickr-am-pm",ot.l10n.amPM[g((ot.latestSelectedDateObj?ot.hourElement.value:ot.config.defaultHour)>11)]),ot.amPM.title=ot.l10n.toggleTitle,ot.amPM.tabIndex=-1,ot.timeContainer.appendChild(ot.amPM)),ot.timeContainer}()),n(ot.calendarContainer,"rangeMode","range"===ot.config.mode),n(ot.calendarContainer,"animate",ot.config.animate),ot.calendarContainer.appendChild(t);var s=void 0!==ot.config.appendTo&&ot.config.appendTo.nodeType;if((ot.config.inline||ot.config.static)&&(ot.calendarContainer.classList.add(ot.config.inline?"inline":"static"),ot.config.inline&&(!s&&ot.element.parentNode?ot.element.parentNode.insertBefore(ot.calendarContainer,ot._input.nextSibling):void 0!==ot.config.appendTo&&ot.config.appendTo.appendChild(ot.calendarContainer)),ot.config.static)){var u=i("div","flatpickr-wrapper");ot.element.parentNode&&ot.element.parentNode.insertBefore(u,ot.element),u.appendChild(ot.element),ot.altInput&&u.appendChild(ot.altInput),u.appendChild(ot.calendarContainer)}ot.config.static||ot.config.inline||(void 0!==ot.config.appendTo?ot.config.appendTo:window.document.body).appendChild(ot.calendarContainer)}(),function(){if(ot.config.wrap&&["open","close","toggle","clear"].forEach(function(t){Array.prototype.forEach.call(ot.element.querySelectorAll("[data-"+t+"]"),function(e){return T(e,"click",ot[t])})}),ot.isMobile)!function(){var t=ot.config.enableTime?ot.config.noCalendar?"time":"datetime-local":"date";ot.mobileInput=i("input",ot.input.className+" flatpickr-mobile"),ot.mobileInput.step=ot.input.getAttribute("step")||"any",ot.mobileInput.tabIndex=1,ot.mobileInput.type=t,ot.mobileInput.disabled=ot.input.disabled,ot.mobileInput.placeholder=ot.input.placeholder,ot.mobileFormatStr="datetime-local"===t?"Y-m-d\\TH:i:S":"date"===t?"Y-m-d":"H:i:S",ot.selectedDates.length&&(ot.mobileInput.defaultValue=ot.mobileInput.value=ot.formatDate(ot.selectedDates[0],ot.mobileFormatStr)),ot.config.minDate&&(ot.mobileInput.min=ot.formatDate(ot.config.minDate,"Y-m-d")),ot.config.maxDate&&(ot.mobileInput.max=ot.formatDate(ot.config.maxDate,"Y-m-d")),ot.input.type="hidden",void 0!==ot.altInput&&(ot.altInput.type="hidden");try{ot.input.parentNode&&ot.input.parentNode.insertBefore(ot.mobileInput,ot.input.nextSibling)}catch(t){}T(ot.mobileInput,"change",function(t){ot.setDate(t.target.value,!1,ot.mobileFormatStr),J("onChange"),J("onClose")})}();else{var t=e(B,50);if(ot._debouncedChange=e(C,300),"range"===ot.config.mode&&ot.daysContainer&&!/iPhone|iPad|iPod/i.test(navigator.userAgent)&&T(ot.daysContainer,"mouseover",function(t){return X(t.target)}),T(window.document.body,"keydown",Y),ot.config.static||T(ot._input,"keydown",Y),ot.config.inline||ot.config.static||T(window,"resize",t),void 0!==window.ontouchstart&&T(window.document.body,"touchstart",F),T(window.document.body,"mousedown",A(F)),T(ot._input,"blur",F),!0===ot.config.clickOpens&&(T(ot._input,"focus",ot.open),T(ot._input,"mousedown",A(ot.open))),void 0!==ot.daysContainer&&(ot.monthNav.addEventListener("wheel",function(t){return t.preventDefault()}),T(ot.monthNav,"wheel",e(rt,10)),T(ot.monthNav,"mousedown",A(at)),T(ot.monthNav,["keyup","increment"],S),T(ot.daysContainer,"mousedown",A(Q)),ot.config.animate&&(T(ot.daysContainer,["webkitAnimationEnd","animationend"],P),T(ot.monthNav,["webkitAnimationEnd","animationend"],E))),void 0!==ot.timeContainer&&void 0!==ot.minuteElement&&void 0!==ot.hourElement){var n=function(t){return t.target.select()};T(ot.timeContainer,["wheel","input","increment"],d),T(ot.timeContainer,"mousedown",A(k)),T(ot.timeContainer,["wheel","increment"],ot._debouncedChange),T(ot.timeContainer,"input",C),T([ot.hourElement,ot.minuteElement],["focus","click"],n),void 0!==ot.secondElement&&T(ot.secondElement,"focus",function(){return ot.secondElement&&ot.secondElement.select()}),void 0!==ot.amPM&&T(ot.amPM,"mousedown",A(function(t){d(t),C()}))}}}(),(ot.selectedDates.length||ot.config.noCalendar)&&(ot.config.enableTime&&w(ot.config.noCalendar?ot.latestSelectedDateObj||ot.config.minDate:void 0),it(!1)),ot.showTimeInput=ot.selectedDates.length>0||ot.config.noCalendar,void 0!==ot.weekWrapper&&void 0!==ot.daysContainer&&(ot.calendarContainer.style.width=ot.daysContainer.offsetWidth+ot.weekWrapper.offsetWidth+"px"),ot.isMobile||q(),J("onReady"),ot}function s(t,e){for(var n=Array.prototype.slice.call(t),i=[],r=0;r<n.length;r++){var a=n[r];try{if(null!==a.getAttribute("data-fp-omit"))continue;void 0!==a._flatpickr&&(a._flatpickr.destroy(),a._flatpickr=void 0),a._flatpickr=o(a,e||{}),i.push(a._flatpickr)}catch(t){console.error(t)}}return 1===i.length?i[0]:i}var u=Object.assign||function(t){for(var e,n=1,i=arguments.length;n<i;n++){e=arguments[n];for(var r in e)Object.prototype.hasOwnProperty.call(e,r)&&(t[r]=e[r])}return t},c=function(t,e,n){return n.months[e?"shorthand":"longhand"][t]},l={DAY:864e5},h={_disable:[],_enable:[],allowInput:!1,altFormat:"F j, Y",altInput:!1,altInputClass:"form-control input",animate:"object"==typeof window&&-1===window.navigator.userAgent.indexOf("MSIE"),ariaDateFormat:"F j, Y",clickOpens:!0,closeOnSelect:!0,conjunction:", ",dateFormat:"Y-m-d",defaultHour:12,defaultMinute:0,defaultSeconds:0,disable:[],disableMobile:!1,enable:[],enableSeconds:!1,enableTime:!1,errorHandler:console.warn,getWeek:function(t){var e=new Date(t.getFullYear(),0,1);return Math.ceil(((t.getTime()-e.getTime())/864e5+e.getDay()+1)/7)},hourIncrement:1,ignoredFocusElements:[],inline:!1,locale:"default",minuteIncrement:5,mode:"single",nextArrow:"<svg version='1.1' xmlns='http://www.w3.org/2000/svg' xmlns:xlink='http://www.w3.org/1999/xlink' viewBox='0 0 17 17'><g></g><path d='M13.207 8.472l-7.854 7.854-0.707-0.707 7.146-7.146-7.146-7.148 0.707-0.707 7.854 7.854z' /></svg>",noCalendar:!1,onChange:[],onClose:[],onDayCreate:[],onDestroy:[],onKeyDown:[],onMonthChange:[],onOpen:[],onParseConfig:[],onReady:[],onValueUpdate:[],onYearChange:[],plugins:[],position:"auto",positionElement:void 0,prevArrow:"<svg version='1.1' xmlns='http://www.w3.org/2000/svg' xmlns:xlink='http://www.w3.org/1999/xlink' viewBox='0 0 17 17'><g></g><path d='M5.207 8.471l7.146 7.147-0.707 0.707-7.853-7.854 7.854-7.853 0.707 0.707-7.147 7.146z' /></svg>",shorthandCurrentMonth:!1,static:!1,time_24hr:!1,weekNumbers:!1,wrap:!1},d={weekdays:{shorthand:["Sun","Mon","Tue","Wed","Thu","Fri","Sat"],longhand:["Sunday","Monday","Tuesday","Wednesday","Thursday","Friday","Saturday"]},months:{shorthand:["Jan","Feb","Mar","Apr","May","Jun","Jul","Aug","Sep","Oct","Nov","Dec"],longhand:["January","February","March","April","May","June","July","August","September","October","November","December"]},daysInMonth:[31,28,31,30,31,30,31,31,30,31,30,31],firstDayOfWeek:0,ordinal:function(t){var e=t%100;if(e>3&&e<21)return"th";switch(e%10){case 1:return"st";case 2:return"nd";case 3:return"rd";default:return"th"}},rangeSeparator:" to ",weekAbbreviation:"Wk",scrollTitle:"Scroll to increment",toggleTitle:"Click to toggle",amPM:["AM","PM"]},f=function(t){return("0"+t).slice(-2)},g=function(t){return!0===t?1:0},p=function(t){return t instanceof Array?t:[t]},m=function(){},v={D:m,F:function(t,e,n){t.setMonth(n.months.longhand.indexOf(e))},G:function(t,e){t.setHours(parseFloat(e))},H:function(t,e){t.setHours(parseFloat(e))},J:function(t,e){t.setDate(parseFloat(e))},K:function(t,e,n){t.setHours(t.getHours()%12+12*g(new RegExp(n.amPM[1],"i").test(e)))},M:function(t,e,n){t.setMonth(n.months.shorthand.indexOf(e))},S:function(t,e){t.setSeconds(parseFloat(e))},U:function(t,e){return new Date(1e3*parseFloat(e))},W:function(t,e){var n=parseInt(e);return new Date(t.getFullYear(),0,2+7*(n-1),0,0,0,0)},Y:function(t,e){t.setFullYear(parseFloat(e))},Z:function(t,e){return new Date(e)},d:function(t,e){t.setDate(parseFloat(e))},h:function(t,e){t.setHours(parseFloat(e))},i:function(t,e){t.setMinutes(parseFloat(e))},j:function(t,e){t.setDate(parseFloat(e))},l:m,m:function(t,e){t.setMonth(parseFloat(e)-1)},n:function(t,e){t.setMonth(parseFloat(e)-1)},s:function(t,e){t.setSeconds(parseFloat(e))},w:m,y:function(t,e){t.setFullYear(2e3+parseFloat(e))}},y={D:"(\\w+)",F:"(\\w+)",G:"(\\d\\d|\\d)",H:"(\\d\\d|\\d)",J:"(\\d\\d|\\d)\\w+",K:"",M:"(\\w+)",S:"(\\d\\d|\\d)",U:"(.+)",W:"(\\d\\d|\\d)",Y:"(\\d{4})",Z:"(.+)",d:"(\\d\\d|\\d)",h:"(\\d\\d|\\d)",i:"(\\d\\d|\\d)",j:"(\\d\\d|\\d)",l:"(\\w+)",m:"(\\d\\d|\\d)",n:"(\\d\\d|\\d)",s:"(\\d\\d|\\d)",w:"(\\d\\d|\\d)",y:"(\\d{2})"},x={Z:function(t){return t.toISOString()},D:function(t,e,n){return e.weekdays.shorthand[x.w(t,e,n)]},F:function(t,e,n){return c(x.n(t,e,n)-1,!1,e)},G:function(t,e,n){return f(x.h(t,e,n))},H:function(t){return f(t.getHours())},J:function(t,e){return void 0!==e.ordinal?t.getDate()+e.ordinal(t.getDate()):t.getDate()},K:function(t,e){return e.amPM[g(t.getHours()>11)]},M:function(t,e){return c(t.getMonth(),!0,e)},S:function(t){return f(t.getSeconds())},U:function(t){return t.getTime()/1e3},W:function(t,e,n){return n.getWeek(t)},Y:function(t){return t.getFullYear()},d:function(t){return f(t.getDate())},h:function(t){return t.getHours()%12?t.getHours()%12:12},i:function(t){return f(t.getMinutes())},j:function(t){return t.getDate()},l:function(t,e){return e.weekdays.longhand[t.getDay()]},m:function(t){return f(t.getMonth()+1)},n:function(t){return t.getMonth()+1},s:function(t){return t.getSeconds()},w:function(t){return t.getDay()},y:function(t){return String(t.getFullYear()).substring(2)}};"function"!=typeof Object.assign&&(Object.assign=function(t){for(var e=[],n=1;n<arguments.length;n++)e[n-1]=arguments[n];if(!t)throw TypeError("Cannot convert undefined or null to object");for(var i=function(e){e&&Object.keys(e).forEach(function(n){return t[n]=e[n]})},r=0,a=e;r<a.length;r++){i(a[r])}return t}),"undefined"!=typeof HTMLElement&&(HTMLCollection.prototype.flatpickr=NodeList.prototype.flatpickr=function(t){return s(this,t)},HTMLElement.prototype.flatpickr=function(t){return s([this],t)});var _;_=function(t,e){return t instanceof NodeList?s(t,e):"string"==typeof t?s(window.document.querySelectorAll(t),e):s([t],e)},"object"==typeof window&&(window.flatpickr=_),_.defaultConfig=h,_.l10ns={en:u({},d),default:u({},d)},_.localize=function(t){_.l10ns.default=u({},_.l10ns.default,t)},_.setDefaults=function(t){_.defaultConfig=u({},_.defaultConfig,t)},"undefined"!=typeof jQuery&&(jQuery.fn.flatpickr=function(t){return s(this,t)}),Date.prototype.fp_incr=function(t){return new Date(this.getFullYear(),this.getMonth(),this.getDate()+("string"==typeof t?parseInt(t,10):t))};return _})},{}],5:[function(t,e,n){e.exports=function(t){function e(i){if(n[i])return n[i].exports;var r=n[i]={i:i,l:!1,exports:{}};return t[i].call(r.exports,r,r.exports,e),r.l=!0,r.exports}var n={};return e.m=t,e.c=n,e.i=function(t){return t},e.d=function(t,n,i){e.o(t,n)||Object.defineProperty(t,n,{configurable:!1,enumerable:!0,get:i})},e.n=function(t){var n=t&&t.__esModule?function(){return t.default}:function(){return t};return e.d(n,"a",n),n},e.o=function(t,e){return Object.prototype.hasOwnProperty.call(t,e)},e.p="dist",e(e.s=6)}([function(t,e,n){"use strict";Object.defineProperty(e,"__esModule",{value:!0});var i=n(5),r=n(4),a=(n.n(r),/(^\d{1,4}[\.|\\\/|-]\d{1,2}[\.|\\\/|-]\d{1,4})(\s*(?:0?[1-9]:[0-5]|1(?=[012])\d:[0-5])\d\s*[ap]m)?$/),o=/\d{2}:\d{2}:\d{2} GMT-\d{4}/,s=/\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}.\d{3}Z/,u=window.requestAnimationFrame||function(t){return t(),0},c={hoverPreviewEnabled:!1,hoverPreviewArrayCount:100,hoverPreviewFieldCount:5,animateOpen:!0,animateClose:!0,theme:null},l=function(){function t(t,e,n,i){void 0===e&&(e=1),void 0===n&&(n=c),this.json=t,this.open=e,this.config=n,this.key=i,this._isOpen=null,void 0===this.config.hoverPreviewEnabled&&(this.config.hoverPreviewEnabled=c.hoverPreviewEnabled),void 0===this.config.hoverPreviewArrayCount&&(this.config.hoverPreviewArrayCount=c.hoverPreviewArrayCount),void 0===this.config.hoverPreviewFieldCount&&(this.config.hoverPreviewFieldCount=c.hoverPreviewFieldCount)}return Object.defineProperty(t.prototype,"isOpen",{get:function(){return null!==this._isOpen?this._isOpen:this.open>0},set:function(t){this._isOpen=t},enumerable:!0,configurable:!0}),Object.defineProperty(t.prototype,"isDate",{get:function(){return"string"===this.type&&(a.test(this.json)||s.test(this.json)||o.test(this.json))},enumerable:!0,configurable:!0}),Object.defineProperty(t.prototype,"isUrl",{get:function(){return"string"===this.type&&0===this.json.indexOf("http")},enumerable:!0,configurable:!0}),Object.defineProperty(t.prototype,"isArray",{get:function(){return Array.isArray(this.json)},enumerable:!0,configurable:!0}),Object.defineProperty(t.prototype,"isObject",{get:function(){return n.i(i.a)(this.json)},enumerable:!0,configurable:!0}),Object.defineProperty(t.prototype,"isEmptyObject",{get:function(){return!this.keys.length&&!this.isArray},enumerable:!0,configurable:!0}),Object.defineProperty(t.prototype,"isEmpty",{get:function(){return this.isEmptyObject||this.keys&&!this.keys.length&&this.isArray},enumerable:!0,configurable:!0}),Object.defineProperty(t.prototype,"hasKey",{get:function(){return void 0!==this.key},enumerable:!0,configurable:!0}),Object.defineProperty(t.prototype,"constructorName",{get:function(){return n.i(i.b)(this.json)},enumerable:!0,configurable:!0}),Object.defineProperty(t.prototype,"type",{get:function(){return n.i(i.c)(this.json)},enumerable:!0,configurable:!0}),Object.defineProperty(t.prototype,"keys",{get:function(){return this.isObject?Object.keys(this.json).map(function(t){return t||'""'}):[]},enumerable:!0,configurable:!0}),t.prototype.toggleOpen=function(){this.isOpen=!this.isOpen,this.element&&(this.isOpen?this.appendChildren(this.config.animateOpen):this.removeChildren(this.config.animateClose),this.element.classList.toggle(n.i(i.d)("open")))},t.prototype.openAtDepth=function(t){void 0===t&&(t=1),t<0||(this.open=t,this.isOpen=0!==t,this.element&&(this.removeChildren(!1),0===t?this.element.classList.remove(n.i(i.d)("open")):(this.appendChildren(this.config.animateOpen),this.element.classList.add(n.i(i.d)("open")))))},t.prototype.getInlinepreview=function(){var t=this;if(this.isArray)return this.json.length>this.config.hoverPreviewArrayCount?"Array["+this.json.length+"]":"["+this.json.map(i.e).join(", ")+"]";var e=this.keys,r=e.slice(0,this.config.hoverPreviewFieldCount).map(function(e){return e+":"+n.i(i.e)(t.json[e])}),a=e.length>=this.config.hoverPreviewFieldCount?"…":"";return"{"+r.join(", ")+a+"}"},t.prototype.render=function(){this.element=n.i(i.f)("div","row");var t=n.i(i.f)("a","toggler-link");if(this.isObject&&t.appendChild(n.i(i.f)("span","toggler")),this.hasKey&&t.appendChild(n.i(i.f)("span","key",this.key+":")),this.isObject){var e=n.i(i.f)("span","value"),r=n.i(i.f)("span"),a=n.i(i.f)("span","constructor-name",this.constructorName);if(r.appendChild(a),this.isArray){var o=n.i(i.f)("span");o.appendChild(n.i(i.f)("span","bracket","[")),o.appendChild(n.i(i.f)("span","number",this.json.length)),o.appendChild(n.i(i.f)("span","bracket","]")),r.appendChild(o)}e.appendChild(r),t.appendChild(e)}else{(e=this.isUrl?n.i(i.f)("a"):n.i(i.f)("span")).classList.add(n.i(i.d)(this.type)),this.isDate&&e.classList.add(n.i(i.d)("date")),this.isUrl&&(e.classList.add(n.i(i.d)("url")),e.setAttribute("href",this.json));var s=n.i(i.g)(this.json,this.json);e.appendChild(document.createTextNode(s)),t.appendChild(e)}if(this.isObject&&this.config.hoverPreviewEnabled){var u=n.i(i.f)("span","preview-text");u.appendChild(document.createTextNode(this.getInlinepreview())),t.appendChild(u)}var c=n.i(i.f)("div","children");return this.isObject&&c.classList.add(n.i(i.d)("object")),this.isArray&&c.classList.add(n.i(i.d)("array")),this.isEmpty&&c.classList.add(n.i(i.d)("empty")),this.config&&this.config.theme&&this.element.classList.add(n.i(i.d)(this.config.theme)),this.isOpen&&this.element.classList.add(n.i(i.d)("open")),this.element.appendChild(t),this.element.appendChild(c),this.isObject&&this.isOpen&&this.appendChildren(),this.isObject&&t.addEventListener("click",this.toggleOpen.bind(this)),this.element},t.prototype.appendChildren=function(e){var r=this;void 0===e&&(e=!1);var a=this.element.querySelector("div."+n.i(i.d)("children"));if(a&&!this.isEmpty)if(e){var o=0,s=function(){var e=r.keys[o],n=new t(r.json[e],r.open-1,r.config,e);a.appendChild(n.render()),(o+=1)<r.keys.length&&(o>10?s():u(s))};u(s)}else this.keys.forEach(function(e){var n=new t(r.json[e],r.open-1,r.config,e);a.appendChild(n.render())})},t.prototype.removeChildren=function(t){void 0===t&&(t=!1);var e=this.element.querySelector("div."+n.i(i.d)("children"));if(t){var r=0,a=function(){e&&e.children.length&&(e.removeChild(e.children[0]),(r+=1)>10?a():u(a))};u(a)}else e&&(e.innerHTML="")},t}();e.default=l},function(t,e,n){(t.exports=n(2)()).push([t.i,'.json-formatter-row {\n  font-family: monospace;\n}\n.json-formatter-row,\n.json-formatter-row a,\n.json-formatter-row a:hover {\n  color: black;\n  text-decoration: none;\n}\n.json-formatter-row .json-formatter-row {\n  margin-left: 1rem;\n}\n.json-formatter-row .json-formatter-children.json-formatter-empty {\n  opacity: 0.5;\n  margin-left: 1rem;\n}\n.json-formatter-row .json-formatter-children.json-formatter-empty:after {\n  display: none;\n}\n.json-formatter-row .json-formatter-children.json-formatter-empty.json-formatter-object:after {\n  content: "No properties";\n}\n.json-formatter-row .json-formatter-children.json-formatter-empty.json-formatter-array:after {\n  content: "[]";\n}\n.json-formatter-row .json-formatter-string {\n  color: green;\n  white-space: pre;\n  word-wrap: break-word;\n}\n.json-formatter-row .json-formatter-number {\n  color: blue;\n}\n.json-formatter-row .json-formatter-boolean {\n  color: red;\n}\n.json-formatter-row .json-formatter-null {\n  color: #855A00;\n}\n.json-formatter-row .json-formatter-undefined {\n  color: #ca0b69;\n}\n.json-formatter-row .json-formatter-function {\n  color: #FF20ED;\n}\n.json-formatter-row .json-formatter-date {\n  background-color: rgba(0, 0, 0, 0.05);\n}\n.json-formatter-row .json-formatter-url {\n  text-decoration: underline;\n  color: blue;\n  cursor: pointer;\n}\n.json-formatter-row .json-formatter-bracket {\n  color: blue;\n}\n.json-formatter-row .json-formatter-key {\n  color: #00008B;\n  cursor: pointer;\n  padding-right: 0.2rem;\n}\n.json-formatter-row .json-formatter-constructor-name {\n  cursor: pointer;\n}\n.json-formatter-row .json-formatter-toggler {\n  line-height: 1.2rem;\n  font-size: 0.7rem;\n  vertical-align: middle;\n  opacity: 0.6;\n  cursor: pointer;\n  padding-right: 0.2rem;\n}\n.json-formatter-row .json-formatter-toggler:after {\n  display: inline-block;\n  transition: transform 100ms ease-in;\n  content: "\\25BA";\n}\n.json-formatter-row > a > .json-formatter-preview-text {\n  opacity: 0;\n  transition: opacity 0.15s ease-in;\n  font-style: italic;\n}\n.json-formatter-row:hover > a > .json-formatter-preview-text {\n  opacity: 0.6;\n}\n.json-formatter-row.json-formatter-open > .json-formatter-toggler-link .json-formatter-toggler:after {\n  transform: rotate(90deg);\n}\n.json-formatter-row.json-formatter-open > .json-formatter-children:after {\n  display: inline-block;\n}\n.json-formatter-row.json-formatter-open > a > .json-formatter-preview-text {\n  display: none;\n}\n.json-formatter-row.json-formatter-open.json-formatter-empty:after {\n  display: block;\n}\n.json-formatter-dark.json-formatter-row {\n  font-family: monospace;\n}\n.json-formatter-dark.json-formatter-row,\n.json-formatter-dark.json-formatter-row a,\n.json-formatter-dark.json-formatter-row a:hover {\n  color: white;\n  text-decoration: none;\n}\n.json-formatter-dark.json-formatter-row .json-formatter-row {\n  margin-left: 1rem;\n}\n.json-formatter-dark.json-formatter-row .json-formatter-children.json-formatter-empty {\n  opacity: 0.5;\n  margin-left: 1rem;\n}\n.json-formatter-dark.json-formatter-row .json-formatter-children.json-formatter-empty:after {\n  display: none;\n}\n.json-formatter-dark.json-formatter-row .json-formatter-children.json-formatter-empty.json-formatter-object:after {\n  content: "No properties";\n}\n.json-formatter-dark.json-formatter-row .json-formatter-children.json-formatter-empty.json-formatter-array:after {\n  content: "[]";\n}\n.json-formatter-dark.json-formatter-row .json-formatter-string {\n  color: #31F031;\n  white-space: pre;\n  word-wrap: break-word;\n}\n.json-formatter-dark.json-formatter-row .json-formatter-number {\n  color: #66C2FF;\n}\n.json-formatter-dark.json-formatter-row .json-formatter-boolean {\n  color: #EC4242;\n}\n.json-formatter-dark.json-formatter-row .json-formatter-null {\n  color: #EEC97D;\n}\n.json-formatter-dark.json-formatter-row .json-formatter-undefined {\n  color: #ef8fbe;\n}\n.json-formatter-dark.json-formatter-row .json-formatter-function {\n  color: #FD48CB;\n}\n.json-formatter-dark.json-formatter-row .json-formatter-date {\n  background-color: rgba(255, 255, 255, 0.05);\n}\n.json-formatter-dark.json-formatter-row .json-formatter-url {\n  text-decoration: underline;\n  color: #027BFF;\n  cursor: pointer;\n}\n.json-formatter-dark.json-formatter-row .json-formatter-bracket {\n  color: #9494FF;\n}\n.json-formatter-dark.json-formatter-row .json-formatter-key {\n  color: #23A0DB;\n  cursor: pointer;\n  padding-right: 0.2rem;\n}\n.json-formatter-dark.json-formatter-row .json-formatter-constructor-name {\n  cursor: pointer;\n}\n.json-formatter-dark.json-formatter-row .json-formatter-toggler {\n  line-height: 1.2rem;\n  font-size: 0.7rem;\n  vertical-align: middle;\n  opacity: 0.6;\n  cursor: pointer;\n  padding-right: 0.2rem;\n}\n.json-formatter-dark.json-formatter-row .json-formatter-toggler:after {\n  display: inline-block;\n  transition: transform 100ms ease-in;\n  content: "\\25BA";\n}\n.json-formatter-dark.json-formatter-row > a > .json-formatter-preview-text {\n  opacity: 0;\n  transition: opacity 0.15s ease-in;\n  font-style: italic;\n}\n.json-formatter-dark.json-formatter-row:hover > a > .json-formatter-preview-text {\n  opacity: 0.6;\n}\n.json-formatter-dark.json-formatter-row.json-formatter-open > .json-formatter-toggler-link .json-formatter-toggler:after {\n  transform: rotate(90deg);\n}\n.json-formatter-dark.json-formatter-row.json-formatter-open > .json-formatter-children:after {\n  display: inline-block;\n}\n.json-formatter-dark.json-formatter-row.json-formatter-open > a > .json-formatter-preview-text {\n  display: none;\n}\n.json-formatter-dark.json-formatter-row.json-formatter-open.json-formatter-empty:after {\n  display: block;\n}\n',""])},function(t,e){t.exports=function(){var t=[];return t.toString=function(){for(var t=[],e=0;e<this.length;e++){var n=this[e];n[2]?t.push("@media "+n[2]+"{"+n[1]+"}"):t.push(n[1])}return t.join("")},t.i=function(e,n){"string"==typeof e&&(e=[[null,e,""]]);for(var i={},r=0;r<this.length;r++){var a=this[r][0];"number"==typeof a&&(i[a]=!0)}for(r=0;r<e.length;r++){var o=e[r];"number"==typeof o[0]&&i[o[0]]||(n&&!o[2]?o[2]=n:n&&(o[2]="("+o[2]+") and ("+n+")"),t.push(o))}},t}},function(t,e){function n(t,e){for(var n=0;n<t.length;n++){var i=t[n],r=c[i.id];if(r){r.refs++;for(var a=0;a<r.parts.length;a++)r.parts[a](i.parts[a]);for(;a<i.parts.length;a++)r.parts.push(s(i.parts[a],e))}else{var o=[];for(a=0;a<i.parts.length;a++)o.push(s(i.parts[a],e));c[i.id]={id:i.id,refs:1,parts:o}}}}function i(t){for(var e=[],n={},i=0;i<t.length;i++){var r=t[i],a=r[0],o={css:r[1],media:r[2],sourceMap:r[3]};n[a]?n[a].parts.push(o):e.push(n[a]={id:a,parts:[o]})}return e}function r(t,e){var n=d(),i=p[p.length-1];if("top"===t.insertAt)i?i.nextSibling?n.insertBefore(e,i.nextSibling):n.appendChild(e):n.insertBefore(e,n.firstChild),p.push(e);else{if("bottom"!==t.insertAt)throw new Error("Invalid value for parameter 'insertAt'. Must be 'top' or 'bottom'.");n.appendChild(e)}}function a(t){t.parentNode.removeChild(t);var e=p.indexOf(t);e>=0&&p.splice(e,1)}function o(t){var e=document.createElement("style");return e.type="text/css",r(t,e),e}function s(t,e){var n,i,s;if(e.singleton){var c=g++;n=f||(f=o(e)),i=u.bind(null,n,c,!1),s=u.bind(null,n,c,!0)}else t.sourceMap&&"function"==typeof URL&&"function"==typeof URL.createObjectURL&&"function"==typeof URL.revokeObjectURL&&"function"==typeof Blob&&"function"==typeof btoa?(n=function(t){var e=document.createElement("link");return e.rel="stylesheet",r(t,e),e}(e),i=function(t,e){var n=e.css,i=e.sourceMap;i&&(n+="\n/*# sourceMappingURL=data:application/json;base64,"+btoa(unescape(encodeURIComponent(JSON.stringify(i))))+" */");var r=new Blob([n],{type:"text/css"}),a=t.href;t.href=URL.createObjectURL(r),a&&URL.revokeObjectURL(a)}.bind(null,n),s=function(){a(n),n.href&&URL.revokeObjectURL(n.href)}):(n=o(e),i=function(t,e){var n=e.css,i=e.media;if(i&&t.setAttribute("media",i),t.styleSheet)t.styleSheet.cssText=n;else{for(;t.firstChild;)t.removeChild(t.firstChild);t.appendChild(document.createTextNode(n))}}.bind(null,n),s=function(){a(n)});return i(t),function(e){if(e){if(e.css===t.css&&e.media===t.media&&e.sourceMap===t.sourceMap)return;i(t=e)}else s()}}function u(t,e,n,i){var r=n?"":i.css;if(t.styleSheet)t.styleSheet.cssText=m(e,r);else{var a=document.createTextNode(r),o=t.childNodes;o[e]&&t.removeChild(o[e]),o.length?t.insertBefore(a,o[e]):t.appendChild(a)}}var c={},l=function(t){var e;return function(){return void 0===e&&(e=t.apply(this,arguments)),e}},h=l(function(){return/msie [6-9]\b/.test(window.navigator.userAgent.toLowerCase())}),d=l(function(){return document.head||document.getElementsByTagName("head")[0]}),f=null,g=0,p=[];t.exports=function(t,e){if("undefined"!=typeof DEBUG&&DEBUG&&"object"!=typeof document)throw new Error("The style-loader cannot be used in a non-browser environment");void 0===(e=e||{}).singleton&&(e.singleton=h()),void 0===e.insertAt&&(e.insertAt="bottom");var r=i(t);return n(r,e),function(t){for(var a=[],o=0;o<r.length;o++){var s=r[o];(u=c[s.id]).refs--,a.push(u)}t&&n(i(t),e);for(o=0;o<a.length;o++){var u;if(0===(u=a[o]).refs){for(var l=0;l<u.parts.length;l++)u.parts[l]();delete c[u.id]}}}};var m=function(){var t=[];return function(e,n){return t[e]=n,t.filter(Boolean).join("\n")}}()},function(t,e,n){var i=n(1);"string"==typeof i&&(i=[[t.i,i,""]]),n(3)(i,{}),i.locals&&(t.exports=i.locals)},function(t,e,n){"use strict";function i(t){return!!t&&"object"==typeof t}function r(t){if(void 0===t)return"";if(null===t)return"Object";if("object"==typeof t&&!t.constructor)return"Object";var e=/function ([^(]*)/.exec(t.constructor.toString());return e&&e.length>1?e[1]:""}function a(t){return null===t?"null":typeof t}function o(t,e){var n=a(t);return"null"===n||"undefined"===n?n:("string"===n&&(e='"'+function(t){return t.replace('"','"')}(e)+'"'),"function"===n?t.toString().replace(/[\r\n]/g,"").replace(/\{.*\}/,"")+"{…}":e)}function s(t){return"json-formatter-"+t}e.a=i,e.b=r,e.c=a,e.g=o,e.e=function(t){var e="";return i(t)?(e=r(t),Array.isArray(t)&&(e+="["+t.length+"]")):e=o(t,t),e},e.d=s,e.f=function(t,e,n){var i=document.createElement(t);return e&&i.classList.add(s(e)),void 0!==n&&(n instanceof Node?i.appendChild(n):i.appendChild(document.createTextNode(String(n)))),i}},function(t,e,n){t.exports=n(0)}])},{}],6:[function(t,e,n){function i(t){if(!f(t))return t;var e=[];for(var n in t)r(e,n,t[n]);return e.join("&")}function r(t,e,n){if(null!=n)if(Array.isArray(n))n.forEach(function(n){r(t,e,n)});else if(f(n))for(var i in n)r(t,e+"["+i+"]",n[i]);else t.push(encodeURIComponent(e)+"="+encodeURIComponent(n));else null===n&&t.push(encodeURIComponent(e))}function a(t){for(var e,n,i={},r=t.split("&"),a=0,o=r.length;a<o;++a)-1==(n=(e=r[a]).indexOf("="))?i[decodeURIComponent(e)]="":i[decodeURIComponent(e.slice(0,n))]=decodeURIComponent(e.slice(n+1));return i}function o(t){return/[\/+]json\b/.test(t)}function s(t){this.req=t,this.xhr=this.req.xhr,this.text="HEAD"!=this.req.method&&(""===this.xhr.responseType||"text"===this.xhr.responseType)||void 0===this.xhr.responseType?this.xhr.responseText:null,this.statusText=this.req.xhr.statusText;var e=this.xhr.status;1223===e&&(e=204),this._setStatusProperties(e),this.header=this.headers=function(t){var e,n,i,r,a=t.split(/\r?\n/),o={};a.pop();for(var s=0,u=a.length;s<u;++s)e=(n=a[s]).indexOf(":"),i=n.slice(0,e).toLowerCase(),r=v(n.slice(e+1)),o[i]=r;return o}(this.xhr.getAllResponseHeaders()),this.header["content-type"]=this.xhr.getResponseHeader("content-type"),this._setHeaderProperties(this.header),null===this.text&&t._responseType?this.body=this.xhr.response:this.body="HEAD"!=this.req.method?this._parseBody(this.text?this.text:this.xhr.response):null}function u(t,e){var n=this;this._query=this._query||[],this.method=t,this.url=e,this.header={},this._header={},this.on("end",function(){var t=null,e=null;try{e=new s(n)}catch(e){return t=new Error("Parser is unable to parse the response"),t.parse=!0,t.original=e,n.xhr?(t.rawResponse=void 0===n.xhr.responseType?n.xhr.responseText:n.xhr.response,t.status=n.xhr.status?n.xhr.status:null,t.statusCode=t.status):(t.rawResponse=null,t.status=null),n.callback(t)}n.emit("response",e);var i;try{n._isResponseOK(e)||((i=new Error(e.statusText||"Unsuccessful HTTP response")).original=t,i.response=e,i.status=e.status)}catch(t){i=t}i?n.callback(i,e):n.callback(null,e)})}function c(t,e,n){var i=m("DELETE",t);return"function"==typeof e&&(n=e,e=null),e&&i.send(e),n&&i.end(n),i}var l;"undefined"!=typeof window?l=window:"undefined"!=typeof self?l=self:(console.warn("Using browser-only version of superagent in non-browser environment"),l=this);var h=t("component-emitter"),d=t("./request-base"),f=t("./is-object"),g=t("./response-base"),p=t("./should-retry"),m=n=e.exports=function(t,e){return"function"==typeof e?new n.Request("GET",t).end(e):1==arguments.length?new n.Request("GET",t):new n.Request(t,e)};n.Request=u,m.getXHR=function(){if(!(!l.XMLHttpRequest||l.location&&"file:"==l.location.protocol&&l.ActiveXObject))return new XMLHttpRequest;try{return new ActiveXObject("Microsoft.XMLHTTP")}catch(t){}try{return new ActiveXObject("Msxml2.XMLHTTP.6.0")}catch(t){}try{return new ActiveXObject("Msxml2.XMLHTTP.3.0")}catch(t){}try{return new ActiveXObject("Msxml2.XMLHTTP")}catch(t){}throw Error("Browser-only version of superagent could not find XHR")};var v="".trim?function(t){return t.trim()}:function(t){return t.replace(/(^\s*|\s*$)/g,"")};m.serializeObject=i,m.parseString=a,m.types={html:"text/html",json:"application/json",xml:"text/xml",urlencoded:"application/x-www-form-urlencoded",form:"application/x-www-form-urlencoded","form-data":"application/x-www-form-urlencoded"},m.serialize={"application/x-www-form-urlencoded":i,"application/json":JSON.stringify},m.parse={"application/x-www-form-urlencoded":a,"application/json":JSON.parse},g(s.prototype),s.prototype._parseBody=function(t){var e=m.parse[this.type];return this.req._parser?this.req._parser(this,t):(!e&&o(this.type)&&(e=m.parse["application/json"]),e&&t&&(t.length||t instanceof Object)?e(t):null)},s.prototype.toError=function(){var t=this.req,e=t.method,n=t.url,i="cannot "+e+" "+n+" ("+this.status+")",r=new Error(i);return r.status=this.status,r.method=e,r.url=n,r},m.Response=s,h(u.prototype),d(u.prototype),u.prototype.type=function(t){return this.set("Content-Type",m.types[t]||t),this},u.prototype.accept=function(t){return this.set("Accept",m.types[t]||t),this},u.prototype.auth=function(t,e,n){switch("object"==typeof e&&null!==e&&(n=e),n||(n={type:"function"==typeof btoa?"basic":"auto"}),n.type){case"basic":this.set("Authorization","Basic "+btoa(t+":"+e));break;case"auto":this.username=t,this.password=e;break;case"bearer":this.set("Authorization","Bearer "+t)}return this},u.prototype.query=function(t){return"string"!=typeof t&&(t=i(t)),t&&this._query.push(t),this},u.prototype.attach=function(t,e,n){if(e){if(this._data)throw Error("superagent can't mix .send() and .attach()");this._getFormData().append(t,e,n||e.name)}return this},u.prototype._getFormData=function(){return this._formData||(this._formData=new l.FormData),this._formData},u.prototype.callback=function(t,e){if(this._maxRetries&&this._retries++<this._maxRetries&&p(t,e))return this._retry();var n=this._callback;this.clearTimeout(),t&&(this._maxRetries&&(t.retries=this._retries-1),this.emit("error",t)),n(t,e)},u.prototype.crossDomainError=function(){var t=new Error("Request has been terminated\nPossible causes: the network is offline, Origin is not allowed by Access-Control-Allow-Origin, the page is being unloaded, etc.");t.crossDomain=!0,t.status=this.status,t.method=this.method,t.url=this.url,this.callback(t)},u.prototype.buffer=u.prototype.ca=u.prototype.agent=function(){return console.warn("This is not supported in browser version of superagent"),this},u.prototype.pipe=u.prototype.write=function(){throw Error("Streaming is not supported in browser version of superagent")},u.prototype._isHost=function(t){return t&&"object"==typeof t&&!Array.isArray(t)&&"[object Object]"!==Object.prototype.toString.call(t)},u.prototype.end=function(t){return this._endCalled&&console.warn("Warning: .end() was called twice. This is not supported in superagent"),this._endCalled=!0,this._callback=t||function(){},this._finalizeQueryString(),this._end()},u.prototype._end=function(){var t=this,e=this.xhr=m.getXHR(),n=this._formData||this._data;this._setTimeouts(),e.onreadystatechange=function(){var n=e.readyState;if(n>=2&&t._responseTimeoutTimer&&clearTimeout(t._responseTimeoutTimer),4==n){var i;try{i=e.status}catch(t){i=0}if(!i){if(t.timedout||t._aborted)return;return t.crossDomainError()}t.emit("end")}};var i=function(e,n){n.total>0&&(n.percent=n.loaded/n.total*100),n.direction=e,t.emit("progress",n)};if(this.hasListeners("progress"))try{e.onprogress=i.bind(null,"download"),e.upload&&(e.upload.onprogress=i.bind(null,"upload"))}catch(t){}try{this.username&&this.password?e.open(this.method,this.url,!0,this.username,this.password):e.open(this.method,this.url,!0)}catch(t){return this.callback(t)}if(this._withCredentials&&(e.withCredentials=!0),!this._formData&&"GET"!=this.method&&"HEAD"!=this.method&&"string"!=typeof n&&!this._isHost(n)){var r=this._header["content-type"],a=this._serializer||m.serialize[r?r.split(";")[0]:""];!a&&o(r)&&(a=m.serialize["application/json"]),a&&(n=a(n))}for(var s in this.header)null!=this.header[s]&&this.header.hasOwnProperty(s)&&e.setRequestHeader(s,this.header[s]);return this._responseType&&(e.responseType=this._responseType),this.emit("request",this),e.send(void 0!==n?n:null),this},m.get=function(t,e,n){var i=m("GET",t);return"function"==typeof e&&(n=e,e=null),e&&i.query(e),n&&i.end(n),i},m.head=function(t,e,n){var i=m("HEAD",t);return"function"==typeof e&&(n=e,e=null),e&&i.query(e),n&&i.end(n),i},m.options=function(t,e,n){var i=m("OPTIONS",t);return"function"==typeof e&&(n=e,e=null),e&&i.send(e),n&&i.end(n),i},m.del=c,m.delete=c,m.patch=function(t,e,n){var i=m("PATCH",t);return"function"==typeof e&&(n=e,e=null),e&&i.send(e),n&&i.end(n),i},m.post=function(t,e,n){var i=m("POST",t);return"function"==typeof e&&(n=e,e=null),e&&i.send(e),n&&i.end(n),i},m.put=function(t,e,n){var i=m("PUT",t);return"function"==typeof e&&(n=e,e=null),e&&i.send(e),n&&i.end(n),i}},{"./is-object":7,"./request-base":8,"./response-base":9,"./should-retry":10,"component-emitter":2}],7:[function(t,e,n){e.exports=function(t){return null!==t&&"object"==typeof t}},{}],8:[function(t,e,n){function i(t){if(t)return function(t){for(var e in i.prototype)t[e]=i.prototype[e];return t}(t)}var r=t("./is-object");e.exports=i,i.prototype.clearTimeout=function(){return clearTimeout(this._timer),clearTimeout(this._responseTimeoutTimer),delete this._timer,delete this._responseTimeoutTimer,this},i.prototype.parse=function(t){return this._parser=t,this},i.prototype.responseType=function(t){return this._responseType=t,this},i.prototype.serialize=function(t){return this._serializer=t,this},i.prototype.timeout=function(t){if(!t||"object"!=typeof t)return this._timeout=t,this._responseTimeout=0,this;for(var e in t)switch(e){case"deadline":this._timeout=t.deadline;break;case"response":this._responseTimeout=t.response;break;default:console.warn("Unknown timeout option",e)}return this},i.prototype.retry=function(t){return 0!==arguments.length&&!0!==t||(t=1),t<=0&&(t=0),this._maxRetries=t,this._retries=0,this},i.prototype._retry=function(){return this.clearTimeout(),this.req&&(this.req=null,this.req=this.request()),this._aborted=!1,this.timedout=!1,this._end()},i.prototype.then=function(t,e){if(!this._fullfilledPromise){var n=this;this._endCalled&&console.warn("Warning: superagent request was sent twice, because both .end() and .then() were called. Never call .end() if you use promises"),this._fullfilledPromise=new Promise(function(t,e){n.end(function(n,i){n?e(n):t(i)})})}return this._fullfilledPromise.then(t,e)},i.prototype.catch=function(t){return this.then(void 0,t)},i.prototype.use=function(t){return t(this),this},i.prototype.ok=function(t){if("function"!=typeof t)throw Error("Callback required");return this._okCallback=t,this},i.prototype._isResponseOK=function(t){return!!t&&(this._okCallback?this._okCallback(t):t.status>=200&&t.status<300)},i.prototype.get=function(t){return this._header[t.toLowerCase()]},i.prototype.getHeader=i.prototype.get,i.prototype.set=function(t,e){if(r(t)){for(var n in t)this.set(n,t[n]);return this}return this._header[t.toLowerCase()]=e,this.header[t]=e,this},i.prototype.unset=function(t){return delete this._header[t.toLowerCase()],delete this.header[t],this},i.prototype.field=function(t,e){if(null===t||void 0===t)throw new Error(".field(name, val) name can not be empty");if(this._data&&console.error(".field() can't be used if .send() is used. Please use only .send() or only .field() & .attach()"),r(t)){for(var n in t)this.field(n,t[n]);return this}if(Array.isArray(e)){for(var i in e)this.field(t,e[i]);return this}if(null===e||void 0===e)throw new Error(".field(name, val) val can not be empty");return"boolean"==typeof e&&(e=""+e),this._getFormData().append(t,e),this},i.prototype.abort=function(){return this._aborted?this:(this._aborted=!0,this.xhr&&this.xhr.abort(),this.req&&this.req.abort(),this.clearTimeout(),this.emit("abort"),this)},i.prototype.withCredentials=function(t){return void 0==t&&(t=!0),this._withCredentials=t,this},i.prototype.redirects=function(t){return this._maxRedirects=t,this},i.prototype.toJSON=function(){return{method:this.method,url:this.url,data:this._data,headers:this._header}},i.prototype.send=function(t){var e=r(t),n=this._header["content-type"];if(this._formData&&console.error(".send() can't be used if .attach() or .field() is used. Please use only .send() or only .field() & .attach()"),e&&!this._data)Array.isArray(t)?this._data=[]:this._isHost(t)||(this._data={});else if(t&&this._data&&this._isHost(this._data))throw Error("Can't merge these send calls");if(e&&r(this._data))for(var i in t)this._data[i]=t[i];else"string"==typeof t?(n||this.type("form"),n=this._header["content-type"],this._data="application/x-www-form-urlencoded"==n?this._data?this._data+"&"+t:t:(this._data||"")+t):this._data=t;return!e||this._isHost(t)?this:(n||this.type("json"),this)},i.prototype.sortQuery=function(t){return this._sort=void 0===t||t,this},i.prototype._finalizeQueryString=function(){var t=this._query.join("&");if(t&&(this.url+=(this.url.indexOf("?")>=0?"&":"?")+t),this._query.length=0,this._sort){var e=this.url.indexOf("?");if(e>=0){var n=this.url.substring(e+1).split("&");"function"==typeof this._sort?n.sort(this._sort):n.sort(),this.url=this.url.substring(0,e)+"?"+n.join("&")}}},i.prototype._appendQueryString=function(){console.trace("Unsupported")},i.prototype._timeoutError=function(t,e,n){if(!this._aborted){var i=new Error(t+e+"ms exceeded");i.timeout=e,i.code="ECONNABORTED",i.errno=n,this.timedout=!0,this.abort(),this.callback(i)}},i.prototype._setTimeouts=function(){var t=this;this._timeout&&!this._timer&&(this._timer=setTimeout(function(){t._timeoutError("Timeout of ",t._timeout,"ETIME")},this._timeout)),this._responseTimeout&&!this._responseTimeoutTimer&&(this._responseTimeoutTimer=setTimeout(function(){t._timeoutError("Response timeout of ",t._responseTimeout,"ETIMEDOUT")},this._responseTimeout))}},{"./is-object":7}],9:[function(t,e,n){function i(t){if(t)return function(t){for(var e in i.prototype)t[e]=i.prototype[e];return t}(t)}var r=t("./utils");e.exports=i,i.prototype.get=function(t){return this.header[t.toLowerCase()]},i.prototype._setHeaderProperties=function(t){var e=t["content-type"]||"";this.type=r.type(e);var n=r.params(e);for(var i in n)this[i]=n[i];this.links={};try{t.link&&(this.links=r.parseLinks(t.link))}catch(t){}},i.prototype._setStatusProperties=function(t){var e=t/100|0;this.status=this.statusCode=t,this.statusType=e,this.info=1==e,this.ok=2==e,this.redirect=3==e,this.clientError=4==e,this.serverError=5==e,this.error=(4==e||5==e)&&this.toError(),this.accepted=202==t,this.noContent=204==t,this.badRequest=400==t,this.unauthorized=401==t,this.notAcceptable=406==t,this.forbidden=403==t,this.notFound=404==t}},{"./utils":11}],10:[function(t,e,n){var i=["ECONNRESET","ETIMEDOUT","EADDRINFO","ESOCKETTIMEDOUT"];e.exports=function(t,e){return!!(t&&t.code&&~i.indexOf(t.code))||(!!(e&&e.status&&e.status>=500)||(!!(t&&"timeout"in t&&"ECONNABORTED"==t.code)||!!(t&&"crossDomain"in t)))}},{}],11:[function(t,e,n){n.type=function(t){return t.split(/ *; */).shift()},n.params=function(t){return t.split(/ *; */).reduce(function(t,e){var n=e.split(/ *= */),i=n.shift(),r=n.shift();return i&&r&&(t[i]=r),t},{})},n.parseLinks=function(t){return t.split(/ *, */).reduce(function(t,e){var n=e.split(/ *; */),i=n[0].slice(1,-1);return t[n[1].split(/ *= */)[1].slice(1,-1)]=i,t},{})},n.cleanHeader=function(t,e){return delete t["content-type"],delete t["content-length"],delete t["transfer-encoding"],delete t.host,e&&delete t.cookie,t}},{}],12:[function(t,e,n){"use strict";function i(t){var e;if(null==t||"object"!=typeof t)return t;if(t instanceof Date)return(e=new Date).setTime(t.getTime()),e;if(t instanceof Array){e=[];for(var n=0,r=t.length;n<r;n++)e[n]=i(t[n]);return e}if(t instanceof Object){e={};for(var a in t)t.hasOwnProperty(a)&&(e[a]=i(t[a]));return e}throw new Error("Unable to copy obj! Its type isn't supported.")}function r(){let t=_.value.split(" to ");return 2!=t.length?[]:t}function a(t,e,n){let i=["",""];n==d.All&&(i=r()),e<0&&(e=0);let a=Number(v.value),o=Number(A.value);m.DataFetch({ID:a,Page:e,Span:n,Limit:o,StartAt:i[0],EndAt:i[1]}).then(e=>{let n=e.body;if(void 0===n)throw new Error("これより以後のデータの取得に失敗しました");if(!n.is_success)throw new Error(`これより以後のデータの取得に失敗しました: ${n.reason}`);if(0==n.data.length)throw t.button.disabled=!0,new Error("これより以後のデータが存在しませんでした");t.data=n.data}).catch(e=>{t.data=null,t.error=e})}function o(t,e,n){let i=["",""];n==d.All&&(i=r());let a=Number(v.value),o=Number(A.value);m.DataFetch({ID:a,Page:e,Span:n,Limit:o,StartAt:i[0],EndAt:i[1]}).then(e=>{let n=e.body;if(void 0===n)throw new Error("これより以前のデータの取得に失敗しました");if(!n.is_success)throw new Error(`これより以前のデータの取得に失敗しました: ${n.reason}`);0!=n.data.length?t.data=n.data:t.button.disabled=!0}).catch(e=>{t.data=null,t.error=e})}var s=this&&this.__awaiter||function(t,e,n,i){return new(n||(n=Promise))(function(r,a){function o(t){try{u(i.next(t))}catch(t){a(t)}}function s(t){try{u(i.throw(t))}catch(t){a(t)}}function u(t){t.done?r(t.value):new n(function(e){e(t.value)}).then(o,s)}u((i=i.apply(t,e||[])).next())})};Object.defineProperty(n,"__esModule",{value:!0});const u=t("superagent"),c=t("c3"),l=t("flatpickr"),h=t("json-formatter-js");var d;!function(t){t.Week="week",t.Month="month",t.All="all"}(d||(d={}));var f,g,p,m=new class{constructor(){this._token="",this._datePtn=/^([0-9]{4}-[0-9]{2}-[0-9]{2})T([0-9]{2}:[0-9]{2}:[0-9]{2})\+[0-9]{2}:[0-9]{2}$/;let t=document.getElementById("api-token");this._token=t.value}get token(){return this._token}get datePtn(){return this._datePtn}AddTag(){let t=document.getElementById("tag_name").value;u.put("/mypage/api/add_tag").set("Content-Type","application/json").set("Authorization",`Bearer ${this._token}`).send({tag_name:t}).end(function(t,e){if(t||!e.ok)alert("http error: "+t);else{let t=e.body;t.is_success?(alert("タグを追加しました"),window.location.reload(!0)):(alert(`${t.reason}`),window.location.reload(!0))}})}DataFetch(t){return u.post("/mypage/api/data").set("Content-Type","application/json").set("Authorization",`Bearer ${this._token}`).send({tag_id:t.ID,page:t.Page,span:t.Span,limit:t.Limit,start_at:t.StartAt,end_at:t.EndAt})}Graph(t,e){let n=e.map(t=>{let e=JSON.parse(t.payload),n=t.updated_at;return e.date=n.replace(m.datePtn,"$1 $2"),e}),i=Object.keys(n[0]),r=`#${t}chart`,a=document.getElementById(`${t}json`);this.InitializeDom(t),c.generate({bindto:r,data:{x:"date",xFormat:"%Y-%m-%d %H:%M:%S",json:n.reverse(),keys:{x:"date",value:i},onmouseover:function(t){let i=e[t.index];i.payload=n[t.index],delete i.updated_at,a.childElementCount>0&&a.removeChild(a.firstChild);let r=new h.default(i,1/0);a.appendChild(r.render())}},axis:{x:{type:"timeseries",tick:{format:"%Y-%m-%d"}}}})}InitializeDom(t){let e=document.getElementById(`${t}chart`);for(;e.firstChild;)e.removeChild(e.firstChild);let n=document.getElementById(`${t}json`);n.childElementCount>0&&n.removeChild(n.firstChild)}},v=(new Date,document.getElementById("action")),y=document.getElementById("tagname"),x=v.value,_=document.querySelector(".calendar"),w=document.getElementById("input-week"),b=document.getElementById("input-week-value");b.textContent=w.value,w.addEventListener("input",t=>{t.preventDefault(),b.textContent=w.value});var S=document.getElementById("input-month"),T=document.getElementById("input-month-value");T.textContent=S.value,S.addEventListener("input",t=>{t.preventDefault(),T.textContent=S.value});var A=document.getElementById("input-all"),C=document.getElementById("input-all-value");C.textContent=A.value,A.addEventListener("input",t=>{t.preventDefault(),C.textContent=A.value});var M={button:document.querySelector("#week-pagination .reload"),error:new Error},P=0,E={button:document.querySelector("#week-pagination .prev"),error:new Error};E.button.disabled=!0;var L={button:document.querySelector("#week-pagination .next"),error:new Error};M.button.addEventListener("mouseover",t=>{t.preventDefault(),M.data=null;let e=Number(v.value),n=Number(w.value);m.DataFetch({ID:e,Page:0,Span:d.Week,Limit:n,StartAt:"",EndAt:""}).then(t=>{let e=t.body;if(void 0===e)throw new Error("更新すべき全期間のデータの取得に失敗しました");if(!e.is_success)throw new Error(`更新すべき全期間のデータの取得に失敗しました: ${e.reason}`);if(0==e.data.length)throw new Error("更新すべき全期間のデータが存在しませんでした");M.data=e.data}).catch(t=>{M.data=null,M.error=t})}),M.button.addEventListener("click",t=>{t.stopImmediatePropagation(),P=0,L.button.disabled=!0,E.button.disabled=!1,null!=M.data?m.Graph("week-",M.data):alert(M.error)}),L.button.addEventListener("mouseover",t=>{t.stopImmediatePropagation(),L.data=null,a(L,P-1,d.Week)}),L.button.addEventListener("click",t=>{if(t.stopImmediatePropagation(),!L.button.disabled){if(null==L.data)return""!=L.error.message?void alert(L.error):void a(L,P-1,d.Week);E.button.disabled&&(E.button.disabled=!1),--P<=0&&(P=0,L.button.disabled=!0),m.Graph("week-",L.data),L.data=null,a(L,P-1,d.Week)}}),E.button.addEventListener("mouseover",t=>{t.stopImmediatePropagation(),E.data=null,o(E,P+1,d.Week)}),E.button.addEventListener("click",t=>{if(t.stopImmediatePropagation(),!E.button.disabled){if(L.button.disabled&&(L.button.disabled=!1),null==E.data)return""!=E.error.message?void alert(E.error):void o(E,P+1,d.Week);P++,m.Graph("week-",E.data),E.data=null,o(E,P+1,d.Week)}});var k={button:document.querySelector("#month-pagination .reload"),error:new Error},D=0,O={button:document.querySelector("#month-pagination .prev"),error:new Error};O.button.disabled=!0;var I={button:document.querySelector("#month-pagination .next"),error:new Error};k.button.addEventListener("mouseover",t=>{t.stopImmediatePropagation(),k.data=null;let e=Number(v.value),n=Number(S.value);m.DataFetch({ID:e,Page:0,Span:d.Month,Limit:n,StartAt:"",EndAt:""}).then(t=>{let e=t.body;if(void 0===e)throw new Error("更新すべき全期間のデータの取得に失敗しました");if(!e.is_success)throw new Error(`更新すべき全期間のデータの取得に失敗しました: ${e.reason}`);if(0==e.data.length)throw new Error("更新すべき全期間のデータが存在しませんでした");k.data=e.data}).catch(t=>{k.data=null,k.error=t})}),k.button.addEventListener("click",t=>{t.stopImmediatePropagation(),D=0,I.button.disabled=!0,O.button.disabled=!1,null!=k.data?m.Graph("month-",k.data):alert(k.error)}),I.button.addEventListener("mouseover",t=>{t.stopImmediatePropagation(),I.data=null,a(I,D-1,d.Month)}),I.button.addEventListener("click",t=>{if(t.stopImmediatePropagation(),!I.button.disabled){if(null==I.data)return""!=I.error.message?void alert(I.error):void a(I,D-1,d.Month);O.button.disabled&&(O.button.disabled=!1),--D<=0&&(D=0,I.button.disabled=!0),m.Graph("month-",I.data),I.data=null,a(I,D-1,d.Month)}}),O.button.addEventListener("mouseover",t=>{t.stopImmediatePropagation(),O.data=null,o(O,D+1,d.Month)}),O.button.addEventListener("click",t=>{if(t.stopImmediatePropagation(),!O.button.disabled){if(I.button.disabled&&(I.button.disabled=!1),null==O.data)return""!=O.error.message?void alert(O.error):void o(O,D+1,d.Month);D++,m.Graph("month-",O.data),O.data=null,o(O,D+1,d.Month)}});var V={button:document.querySelector("#all-pagination .reload"),error:new Error},G=0,R={button:document.querySelector("#all-pagination .prev"),error:new Error};R.button.disabled=!0;var N={button:document.querySelector("#all-pagination .next"),error:new Error};V.button.addEventListener("mouseover",t=>{t.stopImmediatePropagation(),V.data=null;let e=Number(v.value),n=Number(A.value),i=_.value.split(" to "),r=i[0],a=i[1]||"";m.DataFetch({ID:e,Page:0,Span:d.All,Limit:n,StartAt:r,EndAt:a}).then(t=>{let e=t.body;if(void 0===e)throw new Error("更新すべき全期間のデータの取得に失敗しました");if(!e.is_success)throw new Error(`更新すべき全期間のデータの取得に失敗しました: ${e.reason}`);if(0==e.data.length)throw new Error("更新すべき全期間のデータが存在しませんでした");V.data=e.data}).catch(t=>{V.data=null,V.error=t})}),V.button.addEventListener("click",t=>{t.stopImmediatePropagation(),G=0,N.button.disabled=!0,R.button.disabled=!1,null!=V.data?m.Graph("",V.data):alert(V.error)}),N.button.addEventListener("mouseover",t=>{t.stopImmediatePropagation(),N.data=null,a(N,G-1,d.All)}),N.button.addEventListener("click",t=>{if(t.stopImmediatePropagation(),!N.button.disabled){if(null==N.data)return""!=N.error.message?void alert(N.error):void a(N,G-1,d.All);R.button.disabled&&(R.button.disabled=!1),--G<=0&&(G=0,N.button.disabled=!0),m.Graph("",N.data),N.data=null,a(N,G-1,d.All)}}),R.button.addEventListener("mouseover",t=>{t.stopImmediatePropagation(),R.data=null,o(R,G+1,d.All)}),R.button.addEventListener("click",t=>{if(t.stopImmediatePropagation(),!R.button.disabled){if(N.button.disabled&&(N.button.disabled=!1),null==R.data)return""!=R.error.message?void alert(R.error):void o(R,G+1,d.All);G++,m.Graph("",R.data),R.data=null,o(R,G+1,d.All)}}),v.addEventListener("change",t=>s(this,void 0,void 0,function*(){if(t.preventDefault(),""==v.value)return;let e=Number(v.value),n=Number(w.value),r=Number(S.value),a=Number(A.value),o=_.value.split(" to "),s=o[0],u=o[1]||"",c=!1;yield Promise.all([m.DataFetch({ID:e,Page:0,Span:d.Week,Limit:n,StartAt:"",EndAt:""}).then(t=>{m.InitializeDom("week-");let e=t.body;if(void 0!==e){if(!e.is_success)throw new Error(`直近1週間分のデータの取得に失敗しました: ${e.reason}`);0!=e.data.length&&(f=e.data,m.Graph("week-",e.data))}},t=>t),m.DataFetch({ID:e,Page:0,Span:d.Month,Limit:r,StartAt:"",EndAt:""}).then(t=>{m.InitializeDom("month-");let e=t.body;if(void 0!==e){if(!e.is_success)throw new Error(`直近1ヶ月分のデータの取得に失敗しました: ${e.reason}`);0!=e.data.length&&(g=e.data,m.Graph("month-",e.data))}},t=>t),m.DataFetch({ID:e,Page:0,Span:d.All,Limit:a,StartAt:s,EndAt:u}).then(t=>{m.InitializeDom("");let e=t.body;if(void 0===e)throw new Error("全期間のデータの取得に失敗しました");if(!e.is_success)throw new Error(`全期間のデータの取得に失敗しました: ${e.reason}`);if(0==e.data.length)throw new Error("全期間のデータが存在しませんでした");p=e.data,m.Graph("",e.data)},t=>t)]).catch(function(t){alert("タグの切り替え時にエラーが発生しました\n"+t),c=!0,v.value=x,null!=f&&m.Graph("week-",i(f)),null!=g&&m.Graph("month-",i(g)),null!=p&&m.Graph("",i(p))}),c||(null!=p&&p.length>0&&l(_,{mode:"range",maxDate:p[0].updated_at}),G=0,E.button.disabled=!1,L.button.disabled=!0,O.button.disabled=!1,I.button.disabled=!0,R.button.disabled=!1,N.button.disabled=!0,y.textContent=`タグ${v[v.selectedIndex].text}のグラフ`,x=v.value)}));document.getElementById("add-tag").addEventListener("click",t=>{t.preventDefault(),m.AddTag()})},{c3:1,flatpickr:4,"json-formatter-js":5,superagent:6}]},{},[12]);
\ No newline at end of file
+(function fe(pe,le,st){function dt($,mt){if(!le[$]){if(!pe[$]){var P=typeof require=="function"&&require;if(!mt&&P)return P($,!0);if(H)return H($,!0);throw new Error("Cannot find module '"+$+"'")}var Zt=le[$]={exports:{}};pe[$][0].call(Zt.exports,function(ft){var ae=pe[$][1][ft];return dt(ae||ft)},Zt,Zt.exports,fe,pe,le,st)}return le[$].exports}for(var H=typeof require=="function"&&require,B=0;B<st.length;B++)dt(st[B]);return dt})({1:[function(fe,pe,le){(function(st,dt){typeof le=="object"&&pe!==void 0?pe.exports=dt():typeof define=="function"&&define.amd?define(dt):st.c3=dt()})(this,function(){"use strict";function st(e,n){this.component=e,this.params=n||{},this.d3=e.d3,this.scale=this.d3.scale.linear(),this.range,this.orient="bottom",this.innerTickSize=6,this.outerTickSize=this.params.withOuterTick?6:0,this.tickPadding=3,this.tickValues=null,this.tickFormat,this.tickArguments,this.tickOffset=0,this.tickCulling=!0,this.tickCentered,this.tickTextCharSize,this.tickTextRotate=this.params.tickTextRotate,this.tickLength,this.axis=this.generateAxis()}function dt(e,n,o){this.owner=e,Le.chart.internal[n]=o}function H(e){var n=this.internal=new B(this);n.loadConfig(e),n.beforeInit(e),n.init(),n.afterInit(e),(function o(l,p,y){Object.keys(l).forEach(function(_){p[_]=l[_].bind(y),Object.keys(l[_]).length>0&&o(l[_],p[_],y)})})(bt,this,this)}function B(e){this.d3=window.d3?window.d3:fe!==void 0?fe("d3"):void 0,this.api=e,this.config=this.getDefaultConfig(),this.data={},this.cache={},this.axes={}}var $,mt,P={target:"c3-target",chart:"c3-chart",chartLine:"c3-chart-line",chartLines:"c3-chart-lines",chartBar:"c3-chart-bar",chartBars:"c3-chart-bars",chartText:"c3-chart-text",chartTexts:"c3-chart-texts",chartArc:"c3-chart-arc",chartArcs:"c3-chart-arcs",chartArcsTitle:"c3-chart-arcs-title",chartArcsBackground:"c3-chart-arcs-background",chartArcsGaugeUnit:"c3-chart-arcs-gauge-unit",chartArcsGaugeMax:"c3-chart-arcs-gauge-max",chartArcsGaugeMin:"c3-chart-arcs-gauge-min",selectedCircle:"c3-selected-circle",selectedCircles:"c3-selected-circles",eventRect:"c3-event-rect",eventRects:"c3-event-rects",eventRectsSingle:"c3-event-rects-single",eventRectsMultiple:"c3-event-rects-multiple",zoomRect:"c3-zoom-rect",brush:"c3-brush",focused:"c3-focused",defocused:"c3-defocused",region:"c3-region",regions:"c3-regions",title:"c3-title",tooltipContainer:"c3-tooltip-container",tooltip:"c3-tooltip",tooltipName:"c3-tooltip-name",shape:"c3-shape",shapes:"c3-shapes",line:"c3-line",lines:"c3-lines",bar:"c3-bar",bars:"c3-bars",circle:"c3-circle",circles:"c3-circles",arc:"c3-arc",arcs:"c3-arcs",area:"c3-area",areas:"c3-areas",empty:"c3-empty",text:"c3-text",texts:"c3-texts",gaugeValue:"c3-gauge-value",grid:"c3-grid",gridLines:"c3-grid-lines",xgrid:"c3-xgrid",xgrids:"c3-xgrids",xgridLine:"c3-xgrid-line",xgridLines:"c3-xgrid-lines",xgridFocus:"c3-xgrid-focus",ygrid:"c3-ygrid",ygrids:"c3-ygrids",ygridLine:"c3-ygrid-line",ygridLines:"c3-ygrid-lines",axis:"c3-axis",axisX:"c3-axis-x",axisXLabel:"c3-axis-x-label",axisY:"c3-axis-y",axisYLabel:"c3-axis-y-label",axisY2:"c3-axis-y2",axisY2Label:"c3-axis-y2-label",legendBackground:"c3-legend-background",legendItem:"c3-legend-item",legendItemEvent:"c3-legend-item-event",legendItemTile:"c3-legend-item-tile",legendItemHidden:"c3-legend-item-hidden",legendItemFocused:"c3-legend-item-focused",dragarea:"c3-dragarea",EXPANDED:"_expanded_",SELECTED:"_selected_",INCLUDED:"_included_"},Zt=typeof Symbol=="function"&&typeof Symbol.iterator=="symbol"?function(e){return typeof e}:function(e){return e&&typeof Symbol=="function"&&e.constructor===Symbol&&e!==Symbol.prototype?"symbol":typeof e},ft=function(e,n){if(!(e instanceof n))throw new TypeError("Cannot call a class as a function")},ae=function(e,n){if(!e)throw new ReferenceError("this hasn't been initialised - super() hasn't been called");return!n||typeof n!="object"&&typeof n!="function"?e:n},jt=function(e){return e||e===0},Ht=function(e){return typeof e=="function"},Ut=function(e){return Array.isArray(e)},yt=function(e){return typeof e=="string"},Lt=function(e){return e===void 0},nt=function(e){return e!==void 0},at=function(e){return 10*Math.ceil(e/10)},K=function(e){return Math.ceil(e)+.5},ut=function(e){return e[1]-e[0]},vt=function(e){return e==null||yt(e)&&e.length===0||(e===void 0?"undefined":Zt(e))==="object"&&Object.keys(e).length===0},Ct=function(e){return!w.isEmpty(e)},Y=function(e,n,o){return nt(e[n])?e[n]:o},Q=function(e,n){var o=!1;return Object.keys(e).forEach(function(l){e[l]===n&&(o=!0)}),o},pt=function(e){return typeof e=="string"?e.replace(/</g,"&lt;").replace(/>/g,"&gt;"):e},Xt=function(e){var n=e.getBoundingClientRect(),o=[e.pathSegList.getItem(0),e.pathSegList.getItem(1)];return{x:o[0].x,y:Math.min(o[0].y,o[1].y),width:n.width,height:n.height}};(mt=st.prototype).axisX=function(e,n,o){e.attr("transform",function(l){return"translate("+Math.ceil(n(l)+o)+", 0)"})},mt.axisY=function(e,n){e.attr("transform",function(o){return"translate(0,"+Math.ceil(n(o))+")"})},mt.scaleExtent=function(e){var n=e[0],o=e[e.length-1];return n<o?[n,o]:[o,n]},mt.generateTicks=function(e){var n,o,l=[];if(e.ticks)return e.ticks.apply(e,this.tickArguments);for(o=e.domain(),n=Math.ceil(o[0]);n<o[1];n++)l.push(n);return l.length>0&&l[0]>0&&l.unshift(l[0]-(l[1]-l[0])),l},mt.copyScale=function(){var e,n=this.scale.copy();return this.params.isCategory&&(e=this.scale.domain(),n.domain([e[0],e[1]-1])),n},mt.textFormatted=function(e){var n=this.tickFormat?this.tickFormat(e):e;return n!==void 0?n:""},mt.updateRange=function(){return this.range=this.scale.rangeExtent?this.scale.rangeExtent():this.scaleExtent(this.scale.range()),this.range},mt.updateTickTextCharSize=function(e){var n=this;if(n.tickTextCharSize)return n.tickTextCharSize;var o={h:11.5,w:5.5};return e.select("text").text(function(l){return n.textFormatted(l)}).each(function(l){var p=this.getBoundingClientRect(),y=n.textFormatted(l),_=p.height,T=y?p.width/y.length:void 0;_&&T&&(o.h=_,o.w=T)}).text(""),n.tickTextCharSize=o,o},mt.transitionise=function(e){return this.params.withoutTransition?e:this.d3.transition(e)},mt.isVertical=function(){return this.orient==="left"||this.orient==="right"},mt.tspanData=function(e,n,o,l){var p=this.params.tickMultiline?this.splitTickText(e,o,l):[].concat(this.textFormatted(e));return p.map(function(y){return{index:n,splitted:y,length:p.length}})},mt.splitTickText=function(e,n,o){function l(F,q){y=void 0;for(var ot=1;ot<q.length;ot++)if(q.charAt(ot)===" "&&(y=ot),p=q.substr(0,ot+1),_=T.tickTextCharSize.w*p.length,V<_)return l(F.concat(q.substr(0,y||ot)),q.slice(y?y+1:ot));return F.concat(q)}var p,y,_,T=this,L=T.textFormatted(e),V=T.params.tickWidth;return Object.prototype.toString.call(L)==="[object Array]"?L:((!V||V<=0)&&(V=T.isVertical()?95:T.params.isCategory?Math.ceil(o(n[1])-o(n[0]))-12:110),l([],L+""))},mt.updateTickLength=function(){this.tickLength=Math.max(this.innerTickSize,0)+this.tickPadding},mt.lineY2=function(e){var n=this.scale(e)+(this.tickCentered?0:this.tickOffset);return this.range[0]<n&&n<this.range[1]?this.innerTickSize:0},mt.textY=function(){var e=this.tickTextRotate;return e?11.5-e/15*2.5*(e>0?1:-1):this.tickLength},mt.textTransform=function(){var e=this.tickTextRotate;return e?"rotate("+e+")":""},mt.textTextAnchor=function(){var e=this.tickTextRotate;return e?e>0?"start":"end":"middle"},mt.tspanDx=function(){var e=this.tickTextRotate;return e?8*Math.sin(Math.PI*(e/180)):0},mt.tspanDy=function(e,n){var o=this.tickTextCharSize.h;return n===0&&(o=this.isVertical()?-((e.length-1)*(this.tickTextCharSize.h/2)-3):".71em"),o},mt.generateAxis=function(){function e(p){p.each(function(){var y,_,T,L=e.g=o.select(this),V=this.__chart__||n.scale,F=this.__chart__=n.copyScale(),q=n.tickValues?n.tickValues:n.generateTicks(F),ot=L.selectAll(".tick").data(q,F),lt=ot.enter().insert("g",".domain").attr("class","tick").style("opacity",1e-6),ht=ot.exit().remove(),kt=n.transitionise(ot).style("opacity",1);l.isCategory?(n.tickOffset=Math.ceil((F(1)-F(0))/2),_=n.tickCentered?0:n.tickOffset,T=n.tickCentered?n.tickOffset:0):n.tickOffset=_=0,lt.append("line"),lt.append("text"),n.updateRange(),n.updateTickLength(),n.updateTickTextCharSize(L.select(".tick"));var Rt=kt.select("line"),qt=kt.select("text"),Jt=ot.select("text").selectAll("tspan").data(function(xt,St){return n.tspanData(xt,St,q,F)});Jt.enter().append("tspan"),Jt.exit().remove(),Jt.text(function(xt){return xt.splitted});var Yt=L.selectAll(".domain").data([0]),j=(Yt.enter().append("path").attr("class","domain"),n.transitionise(Yt));switch(n.orient){case"bottom":y=n.axisX,Rt.attr("x1",_).attr("x2",_).attr("y2",function(xt,St){return n.lineY2(xt,St)}),qt.attr("x",0).attr("y",function(xt,St){return n.textY(xt,St)}).attr("transform",function(xt,St){return n.textTransform(xt,St)}).style("text-anchor",function(xt,St){return n.textTextAnchor(xt,St)}),Jt.attr("x",0).attr("dy",function(xt,St){return n.tspanDy(xt,St)}).attr("dx",function(xt,St){return n.tspanDx(xt,St)}),j.attr("d","M"+n.range[0]+","+n.outerTickSize+"V0H"+n.range[1]+"V"+n.outerTickSize);break;case"top":y=n.axisX,Rt.attr("x2",0).attr("y2",-n.innerTickSize),qt.attr("x",0).attr("y",-n.tickLength).style("text-anchor","middle"),Jt.attr("x",0).attr("dy","0em"),j.attr("d","M"+n.range[0]+","+-n.outerTickSize+"V0H"+n.range[1]+"V"+-n.outerTickSize);break;case"left":y=n.axisY,Rt.attr("x2",-n.innerTickSize).attr("y1",T).attr("y2",T),qt.attr("x",-n.tickLength).attr("y",n.tickOffset).style("text-anchor","end"),Jt.attr("x",-n.tickLength).attr("dy",function(xt,St){return n.tspanDy(xt,St)}),j.attr("d","M"+-n.outerTickSize+","+n.range[0]+"H0V"+n.range[1]+"H"+-n.outerTickSize);break;case"right":y=n.axisY,Rt.attr("x2",n.innerTickSize).attr("y2",0),qt.attr("x",n.tickLength).attr("y",0).style("text-anchor","start"),Jt.attr("x",n.tickLength).attr("dy",function(xt,St){return n.tspanDy(xt,St)}),j.attr("d","M"+n.outerTickSize+","+n.range[0]+"H0V"+n.range[1]+"H"+n.outerTickSize)}if(F.rangeBand){var tt=F,Ot=tt.rangeBand()/2;V=F=function(xt){return tt(xt)+Ot}}else V.rangeBand?V=F:ht.call(y,F,n.tickOffset);lt.call(y,V,n.tickOffset),kt.call(y,F,n.tickOffset)})}var n=this,o=n.d3,l=n.params;return e.scale=function(p){return arguments.length?(n.scale=p,e):n.scale},e.orient=function(p){return arguments.length?(n.orient=p in{top:1,right:1,bottom:1,left:1}?p+"":"bottom",e):n.orient},e.tickFormat=function(p){return arguments.length?(n.tickFormat=p,e):n.tickFormat},e.tickCentered=function(p){return arguments.length?(n.tickCentered=p,e):n.tickCentered},e.tickOffset=function(){return n.tickOffset},e.tickInterval=function(){var p;return(p=l.isCategory?2*n.tickOffset:(e.g.select("path.domain").node().getTotalLength()-2*n.outerTickSize)/e.g.selectAll("line").size())===1/0?0:p},e.ticks=function(){return arguments.length?(n.tickArguments=arguments,e):n.tickArguments},e.tickCulling=function(p){return arguments.length?(n.tickCulling=p,e):n.tickCulling},e.tickValues=function(p){if(typeof p=="function")n.tickValues=function(){return p(n.scale.domain())};else{if(!arguments.length)return n.tickValues;n.tickValues=p}return e},e};var ne=(function(e){function n(o){ft(this,n);var l={fn:$,internal:{fn:mt}},p=ae(this,(n.__proto__||Object.getPrototypeOf(n)).call(this,o,"axis",l));return p.d3=o.d3,p.internal=st,p}return(function(o,l){if(typeof l!="function"&&l!==null)throw new TypeError("Super expression must either be null or a function, not "+typeof l);o.prototype=Object.create(l&&l.prototype,{constructor:{value:o,enumerable:!1,writable:!0,configurable:!0}}),l&&(Object.setPrototypeOf?Object.setPrototypeOf(o,l):o.__proto__=l)})(n,dt),n})();($=ne.prototype).init=function(){var e=this.owner,n=e.config,o=e.main;e.axes.x=o.append("g").attr("class",P.axis+" "+P.axisX).attr("clip-path",e.clipPathForXAxis).attr("transform",e.getTranslate("x")).style("visibility",n.axis_x_show?"visible":"hidden"),e.axes.x.append("text").attr("class",P.axisXLabel).attr("transform",n.axis_rotated?"rotate(-90)":"").style("text-anchor",this.textAnchorForXAxisLabel.bind(this)),e.axes.y=o.append("g").attr("class",P.axis+" "+P.axisY).attr("clip-path",n.axis_y_inner?"":e.clipPathForYAxis).attr("transform",e.getTranslate("y")).style("visibility",n.axis_y_show?"visible":"hidden"),e.axes.y.append("text").attr("class",P.axisYLabel).attr("transform",n.axis_rotated?"":"rotate(-90)").style("text-anchor",this.textAnchorForYAxisLabel.bind(this)),e.axes.y2=o.append("g").attr("class",P.axis+" "+P.axisY2).attr("transform",e.getTranslate("y2")).style("visibility",n.axis_y2_show?"visible":"hidden"),e.axes.y2.append("text").attr("class",P.axisY2Label).attr("transform",n.axis_rotated?"":"rotate(-90)").style("text-anchor",this.textAnchorForY2AxisLabel.bind(this))},$.getXAxis=function(e,n,o,l,p,y,_){var T=this.owner,L=T.config,V={isCategory:T.isCategorized(),withOuterTick:p,tickMultiline:L.axis_x_tick_multiline,tickWidth:L.axis_x_tick_width,tickTextRotate:_?0:L.axis_x_tick_rotate,withoutTransition:y},F=new this.internal(this,V).axis.scale(e).orient(n);return T.isTimeSeries()&&l&&typeof l!="function"&&(l=l.map(function(q){return T.parseDate(q)})),F.tickFormat(o).tickValues(l),T.isCategorized()&&(F.tickCentered(L.axis_x_tick_centered),vt(L.axis_x_tick_culling)&&(L.axis_x_tick_culling=!1)),F},$.updateXAxisTickValues=function(e,n){var o,l=this.owner,p=l.config;return(p.axis_x_tick_fit||p.axis_x_tick_count)&&(o=this.generateTickValues(l.mapTargetsToUniqueXs(e),p.axis_x_tick_count,l.isTimeSeries())),n?n.tickValues(o):(l.xAxis.tickValues(o),l.subXAxis.tickValues(o)),o},$.getYAxis=function(e,n,o,l,p,y,_){var T=this.owner,L=T.config,V={withOuterTick:p,withoutTransition:y,tickTextRotate:_?0:L.axis_y_tick_rotate},F=new this.internal(this,V).axis.scale(e).orient(n).tickFormat(o);return T.isTimeSeriesY()?F.ticks(T.d3.time[L.axis_y_tick_time_value],L.axis_y_tick_time_interval):F.tickValues(l),F},$.getId=function(e){var n=this.owner.config;return e in n.data_axes?n.data_axes[e]:"y"},$.getXAxisTickFormat=function(){var e=this.owner,n=e.config,o=e.isTimeSeries()?e.defaultAxisTimeFormat:e.isCategorized()?e.categoryName:function(l){return l<0?l.toFixed(0):l};return n.axis_x_tick_format&&(Ht(n.axis_x_tick_format)?o=n.axis_x_tick_format:e.isTimeSeries()&&(o=function(l){return l?e.axisTimeFormat(n.axis_x_tick_format)(l):""})),Ht(o)?function(l){return o.call(e,l)}:o},$.getTickValues=function(e,n){return e||(n?n.tickValues():void 0)},$.getXAxisTickValues=function(){return this.getTickValues(this.owner.config.axis_x_tick_values,this.owner.xAxis)},$.getYAxisTickValues=function(){return this.getTickValues(this.owner.config.axis_y_tick_values,this.owner.yAxis)},$.getY2AxisTickValues=function(){return this.getTickValues(this.owner.config.axis_y2_tick_values,this.owner.y2Axis)},$.getLabelOptionByAxisId=function(e){var n,o=this.owner.config;return e==="y"?n=o.axis_y_label:e==="y2"?n=o.axis_y2_label:e==="x"&&(n=o.axis_x_label),n},$.getLabelText=function(e){var n=this.getLabelOptionByAxisId(e);return yt(n)?n:n?n.text:null},$.setLabelText=function(e,n){var o=this.owner.config,l=this.getLabelOptionByAxisId(e);yt(l)?e==="y"?o.axis_y_label=n:e==="y2"?o.axis_y2_label=n:e==="x"&&(o.axis_x_label=n):l&&(l.text=n)},$.getLabelPosition=function(e,n){var o=this.getLabelOptionByAxisId(e),l=o&&(o===void 0?"undefined":Zt(o))==="object"&&o.position?o.position:n;return{isInner:l.indexOf("inner")>=0,isOuter:l.indexOf("outer")>=0,isLeft:l.indexOf("left")>=0,isCenter:l.indexOf("center")>=0,isRight:l.indexOf("right")>=0,isTop:l.indexOf("top")>=0,isMiddle:l.indexOf("middle")>=0,isBottom:l.indexOf("bottom")>=0}},$.getXAxisLabelPosition=function(){return this.getLabelPosition("x",this.owner.config.axis_rotated?"inner-top":"inner-right")},$.getYAxisLabelPosition=function(){return this.getLabelPosition("y",this.owner.config.axis_rotated?"inner-right":"inner-top")},$.getY2AxisLabelPosition=function(){return this.getLabelPosition("y2",this.owner.config.axis_rotated?"inner-right":"inner-top")},$.getLabelPositionById=function(e){return e==="y2"?this.getY2AxisLabelPosition():e==="y"?this.getYAxisLabelPosition():this.getXAxisLabelPosition()},$.textForXAxisLabel=function(){return this.getLabelText("x")},$.textForYAxisLabel=function(){return this.getLabelText("y")},$.textForY2AxisLabel=function(){return this.getLabelText("y2")},$.xForAxisLabel=function(e,n){var o=this.owner;return e?n.isLeft?0:n.isCenter?o.width/2:o.width:n.isBottom?-o.height:n.isMiddle?-o.height/2:0},$.dxForAxisLabel=function(e,n){return e?n.isLeft?"0.5em":n.isRight?"-0.5em":"0":n.isTop?"-0.5em":n.isBottom?"0.5em":"0"},$.textAnchorForAxisLabel=function(e,n){return e?n.isLeft?"start":n.isCenter?"middle":"end":n.isBottom?"start":n.isMiddle?"middle":"end"},$.xForXAxisLabel=function(){return this.xForAxisLabel(!this.owner.config.axis_rotated,this.getXAxisLabelPosition())},$.xForYAxisLabel=function(){return this.xForAxisLabel(this.owner.config.axis_rotated,this.getYAxisLabelPosition())},$.xForY2AxisLabel=function(){return this.xForAxisLabel(this.owner.config.axis_rotated,this.getY2AxisLabelPosition())},$.dxForXAxisLabel=function(){return this.dxForAxisLabel(!this.owner.config.axis_rotated,this.getXAxisLabelPosition())},$.dxForYAxisLabel=function(){return this.dxForAxisLabel(this.owner.config.axis_rotated,this.getYAxisLabelPosition())},$.dxForY2AxisLabel=function(){return this.dxForAxisLabel(this.owner.config.axis_rotated,this.getY2AxisLabelPosition())},$.dyForXAxisLabel=function(){var e=this.owner.config,n=this.getXAxisLabelPosition();return e.axis_rotated?n.isInner?"1.2em":-25-this.getMaxTickWidth("x"):n.isInner?"-0.5em":e.axis_x_height?e.axis_x_height-10:"3em"},$.dyForYAxisLabel=function(){var e=this.owner,n=this.getYAxisLabelPosition();return e.config.axis_rotated?n.isInner?"-0.5em":"3em":n.isInner?"1.2em":-10-(e.config.axis_y_inner?0:this.getMaxTickWidth("y")+10)},$.dyForY2AxisLabel=function(){var e=this.owner,n=this.getY2AxisLabelPosition();return e.config.axis_rotated?n.isInner?"1.2em":"-2.2em":n.isInner?"-0.5em":15+(e.config.axis_y2_inner?0:this.getMaxTickWidth("y2")+15)},$.textAnchorForXAxisLabel=function(){var e=this.owner;return this.textAnchorForAxisLabel(!e.config.axis_rotated,this.getXAxisLabelPosition())},$.textAnchorForYAxisLabel=function(){var e=this.owner;return this.textAnchorForAxisLabel(e.config.axis_rotated,this.getYAxisLabelPosition())},$.textAnchorForY2AxisLabel=function(){var e=this.owner;return this.textAnchorForAxisLabel(e.config.axis_rotated,this.getY2AxisLabelPosition())},$.getMaxTickWidth=function(e,n){var o,l,p,y,_=this.owner,T=_.config,L=0;return n&&_.currentMaxTickWidths[e]||(_.svg&&(o=_.filterTargetsToShow(_.data.targets),e==="y"?(l=_.y.copy().domain(_.getYDomain(o,"y")),p=this.getYAxis(l,_.yOrient,T.axis_y_tick_format,_.yAxisTickValues,!1,!0,!0)):e==="y2"?(l=_.y2.copy().domain(_.getYDomain(o,"y2")),p=this.getYAxis(l,_.y2Orient,T.axis_y2_tick_format,_.y2AxisTickValues,!1,!0,!0)):(l=_.x.copy().domain(_.getXDomain(o)),p=this.getXAxis(l,_.xOrient,_.xAxisTickFormat,_.xAxisTickValues,!1,!0,!0),this.updateXAxisTickValues(o,p)),(y=_.d3.select("body").append("div").classed("c3",!0)).append("svg").style("visibility","hidden").style("position","fixed").style("top",0).style("left",0).append("g").call(p).each(function(){_.d3.select(this).selectAll("text").each(function(){var V=this.getBoundingClientRect();L<V.width&&(L=V.width)}),y.remove()})),_.currentMaxTickWidths[e]=L<=0?_.currentMaxTickWidths[e]:L),_.currentMaxTickWidths[e]},$.updateLabels=function(e){var n=this.owner,o=n.main.select("."+P.axisX+" ."+P.axisXLabel),l=n.main.select("."+P.axisY+" ."+P.axisYLabel),p=n.main.select("."+P.axisY2+" ."+P.axisY2Label);(e?o.transition():o).attr("x",this.xForXAxisLabel.bind(this)).attr("dx",this.dxForXAxisLabel.bind(this)).attr("dy",this.dyForXAxisLabel.bind(this)).text(this.textForXAxisLabel.bind(this)),(e?l.transition():l).attr("x",this.xForYAxisLabel.bind(this)).attr("dx",this.dxForYAxisLabel.bind(this)).attr("dy",this.dyForYAxisLabel.bind(this)).text(this.textForYAxisLabel.bind(this)),(e?p.transition():p).attr("x",this.xForY2AxisLabel.bind(this)).attr("dx",this.dxForY2AxisLabel.bind(this)).attr("dy",this.dyForY2AxisLabel.bind(this)).text(this.textForY2AxisLabel.bind(this))},$.getPadding=function(e,n,o,l){var p=typeof e=="number"?e:e[n];return jt(p)?e.unit==="ratio"?e[n]*l:this.convertPixelsToAxisPadding(p,l):o},$.convertPixelsToAxisPadding=function(e,n){var o=this.owner;return n*(e/(o.config.axis_rotated?o.width:o.height))},$.generateTickValues=function(e,n,o){var l,p,y,_,T,L,V,F=e;if(n){if((l=Ht(n)?n():n)===1)F=[e[0]];else if(l===2)F=[e[0],e[e.length-1]];else if(l>2){for(_=l-2,p=e[0],T=((y=e[e.length-1])-p)/(_+1),F=[p],L=0;L<_;L++)V=+p+T*(L+1),F.push(o?new Date(V):V);F.push(y)}}return o||(F=F.sort(function(q,ot){return q-ot})),F},$.generateTransitions=function(e){var n=this.owner.axes;return{axisX:e?n.x.transition().duration(e):n.x,axisY:e?n.y.transition().duration(e):n.y,axisY2:e?n.y2.transition().duration(e):n.y2,axisSubX:e?n.subx.transition().duration(e):n.subx}},$.redraw=function(e,n){var o=this.owner;o.axes.x.style("opacity",n?0:1),o.axes.y.style("opacity",n?0:1),o.axes.y2.style("opacity",n?0:1),o.axes.subx.style("opacity",n?0:1),e.axisX.call(o.xAxis),e.axisY.call(o.yAxis),e.axisY2.call(o.y2Axis),e.axisSubX.call(o.subXAxis)};var bt,w,Le={version:"0.4.18"};return Le.generate=function(e){return new H(e)},Le.chart={fn:H.prototype,internal:{fn:B.prototype}},bt=Le.chart.fn,w=Le.chart.internal.fn,w.beforeInit=function(){},w.afterInit=function(){},w.init=function(){var e=this.config;if(this.initParams(),e.data_url)this.convertUrlToData(e.data_url,e.data_mimeType,e.data_headers,e.data_keys,this.initWithData);else if(e.data_json)this.initWithData(this.convertJsonToData(e.data_json,e.data_keys));else if(e.data_rows)this.initWithData(this.convertRowsToData(e.data_rows));else{if(!e.data_columns)throw Error("url or json or rows or columns is required.");this.initWithData(this.convertColumnsToData(e.data_columns))}},w.initParams=function(){var e=this.d3,n=this.config;this.clipId="c3-"+ +new Date+"-clip",this.clipIdForXAxis=this.clipId+"-xaxis",this.clipIdForYAxis=this.clipId+"-yaxis",this.clipIdForGrid=this.clipId+"-grid",this.clipIdForSubchart=this.clipId+"-subchart",this.clipPath=this.getClipPath(this.clipId),this.clipPathForXAxis=this.getClipPath(this.clipIdForXAxis),this.clipPathForYAxis=this.getClipPath(this.clipIdForYAxis),this.clipPathForGrid=this.getClipPath(this.clipIdForGrid),this.clipPathForSubchart=this.getClipPath(this.clipIdForSubchart),this.dragStart=null,this.dragging=!1,this.flowing=!1,this.cancelClick=!1,this.mouseover=!1,this.transiting=!1,this.color=this.generateColor(),this.levelColor=this.generateLevelColor(),this.dataTimeFormat=n.data_xLocaltime?e.time.format:e.time.format.utc,this.axisTimeFormat=n.axis_x_localtime?e.time.format:e.time.format.utc,this.defaultAxisTimeFormat=this.axisTimeFormat.multi([[".%L",function(o){return o.getMilliseconds()}],[":%S",function(o){return o.getSeconds()}],["%I:%M",function(o){return o.getMinutes()}],["%I %p",function(o){return o.getHours()}],["%-m/%-d",function(o){return o.getDay()&&o.getDate()!==1}],["%-m/%-d",function(o){return o.getDate()!==1}],["%-m/%-d",function(o){return o.getMonth()}],["%Y/%-m/%-d",function(){return!0}]]),this.hiddenTargetIds=[],this.hiddenLegendIds=[],this.focusedTargetIds=[],this.defocusedTargetIds=[],this.xOrient=n.axis_rotated?"left":"bottom",this.yOrient=n.axis_rotated?n.axis_y_inner?"top":"bottom":n.axis_y_inner?"right":"left",this.y2Orient=n.axis_rotated?n.axis_y2_inner?"bottom":"top":n.axis_y2_inner?"left":"right",this.subXOrient=n.axis_rotated?"left":"bottom",this.isLegendRight=n.legend_position==="right",this.isLegendInset=n.legend_position==="inset",this.isLegendTop=n.legend_inset_anchor==="top-left"||n.legend_inset_anchor==="top-right",this.isLegendLeft=n.legend_inset_anchor==="top-left"||n.legend_inset_anchor==="bottom-left",this.legendStep=0,this.legendItemWidth=0,this.legendItemHeight=0,this.currentMaxTickWidths={x:0,y:0,y2:0},this.rotated_padding_left=30,this.rotated_padding_right=n.axis_rotated&&!n.axis_x_show?0:30,this.rotated_padding_top=5,this.withoutFadeIn={},this.intervalForObserveInserted=void 0,this.axes.subx=e.selectAll([])},w.initChartElements=function(){this.initBar&&this.initBar(),this.initLine&&this.initLine(),this.initArc&&this.initArc(),this.initGauge&&this.initGauge(),this.initText&&this.initText()},w.initWithData=function(e){var n,o,l=this,p=l.d3,y=l.config,_=!0;l.axis=new ne(l),l.initPie&&l.initPie(),l.initBrush&&l.initBrush(),l.initZoom&&l.initZoom(),y.bindto?typeof y.bindto.node=="function"?l.selectChart=y.bindto:l.selectChart=p.select(y.bindto):l.selectChart=p.selectAll([]),l.selectChart.empty()&&(l.selectChart=p.select(document.createElement("div")).style("opacity",0),l.observeInserted(l.selectChart),_=!1),l.selectChart.html("").classed("c3",!0),l.data.xs={},l.data.targets=l.convertDataToTargets(e),y.data_filter&&(l.data.targets=l.data.targets.filter(y.data_filter)),y.data_hide&&l.addHiddenTargetIds(y.data_hide===!0?l.mapToIds(l.data.targets):y.data_hide),y.legend_hide&&l.addHiddenLegendIds(y.legend_hide===!0?l.mapToIds(l.data.targets):y.legend_hide),l.hasType("gauge")&&(y.legend_show=!1),l.updateSizes(),l.updateScales(),l.x.domain(p.extent(l.getXDomain(l.data.targets))),l.y.domain(l.getYDomain(l.data.targets,"y")),l.y2.domain(l.getYDomain(l.data.targets,"y2")),l.subX.domain(l.x.domain()),l.subY.domain(l.y.domain()),l.subY2.domain(l.y2.domain()),l.orgXDomain=l.x.domain(),l.brush&&l.brush.scale(l.subX),y.zoom_enabled&&l.zoom.scale(l.x),l.svg=l.selectChart.append("svg").style("overflow","hidden").on("mouseenter",function(){return y.onmouseover.call(l)}).on("mouseleave",function(){return y.onmouseout.call(l)}),l.config.svg_classname&&l.svg.attr("class",l.config.svg_classname),n=l.svg.append("defs"),l.clipChart=l.appendClip(n,l.clipId),l.clipXAxis=l.appendClip(n,l.clipIdForXAxis),l.clipYAxis=l.appendClip(n,l.clipIdForYAxis),l.clipGrid=l.appendClip(n,l.clipIdForGrid),l.clipSubchart=l.appendClip(n,l.clipIdForSubchart),l.updateSvgSize(),o=l.main=l.svg.append("g").attr("transform",l.getTranslate("main")),l.initSubchart&&l.initSubchart(),l.initTooltip&&l.initTooltip(),l.initLegend&&l.initLegend(),l.initTitle&&l.initTitle(),o.append("text").attr("class",P.text+" "+P.empty).attr("text-anchor","middle").attr("dominant-baseline","middle"),l.initRegion(),l.initGrid(),o.append("g").attr("clip-path",l.clipPath).attr("class",P.chart),y.grid_lines_front&&l.initGridLines(),l.initEventRect(),l.initChartElements(),o.insert("rect",y.zoom_privileged?null:"g."+P.regions).attr("class",P.zoomRect).attr("width",l.width).attr("height",l.height).style("opacity",0).on("dblclick.zoom",null),y.axis_x_extent&&l.brush.extent(l.getDefaultExtent()),l.axis.init(),l.updateTargets(l.data.targets),_&&(l.updateDimension(),l.config.oninit.call(l),l.redraw({withTransition:!1,withTransform:!0,withUpdateXDomain:!0,withUpdateOrgXDomain:!0,withTransitionForAxis:!1})),l.bindResize(),l.api.element=l.selectChart.node()},w.smoothLines=function(e,n){var o=this;n==="grid"&&e.each(function(){var l=o.d3.select(this),p=l.attr("x1"),y=l.attr("x2"),_=l.attr("y1"),T=l.attr("y2");l.attr({x1:Math.ceil(p),x2:Math.ceil(y),y1:Math.ceil(_),y2:Math.ceil(T)})})},w.updateSizes=function(){var e=this.config,n=this.legend?this.getLegendHeight():0,o=this.legend?this.getLegendWidth():0,l=this.isLegendRight||this.isLegendInset?0:n,p=this.hasArcType(),y=e.axis_rotated||p?0:this.getHorizontalAxisHeight("x"),_=e.subchart_show&&!p?e.subchart_size_height+y:0;this.currentWidth=this.getCurrentWidth(),this.currentHeight=this.getCurrentHeight(),this.margin=e.axis_rotated?{top:this.getHorizontalAxisHeight("y2")+this.getCurrentPaddingTop(),right:p?0:this.getCurrentPaddingRight(),bottom:this.getHorizontalAxisHeight("y")+l+this.getCurrentPaddingBottom(),left:_+(p?0:this.getCurrentPaddingLeft())}:{top:4+this.getCurrentPaddingTop(),right:p?0:this.getCurrentPaddingRight(),bottom:y+_+l+this.getCurrentPaddingBottom(),left:p?0:this.getCurrentPaddingLeft()},this.margin2=e.axis_rotated?{top:this.margin.top,right:NaN,bottom:20+l,left:this.rotated_padding_left}:{top:this.currentHeight-_-l,right:NaN,bottom:y+l,left:this.margin.left},this.margin3={top:0,right:NaN,bottom:0,left:0},this.updateSizeForLegend&&this.updateSizeForLegend(n,o),this.width=this.currentWidth-this.margin.left-this.margin.right,this.height=this.currentHeight-this.margin.top-this.margin.bottom,this.width<0&&(this.width=0),this.height<0&&(this.height=0),this.width2=e.axis_rotated?this.margin.left-this.rotated_padding_left-this.rotated_padding_right:this.width,this.height2=e.axis_rotated?this.height:this.currentHeight-this.margin2.top-this.margin2.bottom,this.width2<0&&(this.width2=0),this.height2<0&&(this.height2=0),this.arcWidth=this.width-(this.isLegendRight?o+10:0),this.arcHeight=this.height-(this.isLegendRight?0:10),this.hasType("gauge")&&!e.gauge_fullCircle&&(this.arcHeight+=this.height-this.getGaugeLabelHeight()),this.updateRadius&&this.updateRadius(),this.isLegendRight&&p&&(this.margin3.left=this.arcWidth/2+1.1*this.radiusExpanded)},w.updateTargets=function(e){this.updateTargetsForText(e),this.updateTargetsForBar(e),this.updateTargetsForLine(e),this.hasArcType()&&this.updateTargetsForArc&&this.updateTargetsForArc(e),this.updateTargetsForSubchart&&this.updateTargetsForSubchart(e),this.showTargets()},w.showTargets=function(){var e=this;e.svg.selectAll("."+P.target).filter(function(n){return e.isTargetToShow(n.id)}).transition().duration(e.config.transition_duration).style("opacity",1)},w.redraw=function(e,n){var o,l,p,y,_,T,L,V,F,q,ot,lt,ht,kt,Rt,qt,Jt,Yt,j,tt,Ot,xt,St,re,zt,oe,te,Ft,ye,ct=this,Ve=ct.main,we=ct.d3,he=ct.config,Pt=ct.getShapeIndices(ct.isAreaType),c=ct.getShapeIndices(ct.isBarType),I=ct.getShapeIndices(ct.isLineType),X=ct.hasArcType(),W=ct.filterTargetsToShow(ct.data.targets),gt=ct.xv.bind(ct);if(e=e||{},o=Y(e,"withY",!0),l=Y(e,"withSubchart",!0),p=Y(e,"withTransition",!0),T=Y(e,"withTransform",!1),L=Y(e,"withUpdateXDomain",!1),V=Y(e,"withUpdateOrgXDomain",!1),F=Y(e,"withTrimXDomain",!0),ht=Y(e,"withUpdateXAxis",L),q=Y(e,"withLegend",!1),ot=Y(e,"withEventRect",!0),lt=Y(e,"withDimension",!0),y=Y(e,"withTransitionForExit",p),_=Y(e,"withTransitionForAxis",p),j=p?he.transition_duration:0,tt=y?j:0,Ot=_?j:0,n=n||ct.axis.generateTransitions(Ot),q&&he.legend_show?ct.updateLegend(ct.mapToIds(ct.data.targets),e,n):lt&&ct.updateDimension(!0),ct.isCategorized()&&W.length===0&&ct.x.domain([0,ct.axes.x.selectAll(".tick").size()]),W.length?(ct.updateXDomain(W,L,V,F),he.axis_x_tick_values||(re=ct.axis.updateXAxisTickValues(W))):(ct.xAxis.tickValues([]),ct.subXAxis.tickValues([])),he.zoom_rescale&&!e.flow&&(te=ct.x.orgDomain()),ct.y.domain(ct.getYDomain(W,"y",te)),ct.y2.domain(ct.getYDomain(W,"y2",te)),!he.axis_y_tick_values&&he.axis_y_tick_count&&ct.yAxis.tickValues(ct.axis.generateTickValues(ct.y.domain(),he.axis_y_tick_count)),!he.axis_y2_tick_values&&he.axis_y2_tick_count&&ct.y2Axis.tickValues(ct.axis.generateTickValues(ct.y2.domain(),he.axis_y2_tick_count)),ct.axis.redraw(n,X),ct.axis.updateLabels(p),(L||ht)&&W.length)if(he.axis_x_tick_culling&&re){for(zt=1;zt<re.length;zt++)if(re.length/zt<he.axis_x_tick_culling_max){oe=zt;break}ct.svg.selectAll("."+P.axisX+" .tick text").each(function(Tt){var Gt=re.indexOf(Tt);Gt>=0&&we.select(this).style("display",Gt%oe?"none":"block")})}else ct.svg.selectAll("."+P.axisX+" .tick text").style("display","block");kt=ct.generateDrawArea?ct.generateDrawArea(Pt,!1):void 0,Rt=ct.generateDrawBar?ct.generateDrawBar(c):void 0,qt=ct.generateDrawLine?ct.generateDrawLine(I,!1):void 0,Jt=ct.generateXYForText(Pt,c,I,!0),Yt=ct.generateXYForText(Pt,c,I,!1),o&&(ct.subY.domain(ct.getYDomain(W,"y")),ct.subY2.domain(ct.getYDomain(W,"y2"))),ct.updateXgridFocus(),Ve.select("text."+P.text+"."+P.empty).attr("x",ct.width/2).attr("y",ct.height/2).text(he.data_empty_label_text).transition().style("opacity",W.length?0:1),ct.updateGrid(j),ct.updateRegion(j),ct.updateBar(tt),ct.updateLine(tt),ct.updateArea(tt),ct.updateCircle(),ct.hasDataLabel()&&ct.updateText(tt),ct.redrawTitle&&ct.redrawTitle(),ct.redrawArc&&ct.redrawArc(j,tt,T),ct.redrawSubchart&&ct.redrawSubchart(l,n,j,tt,Pt,c,I),Ve.selectAll("."+P.selectedCircles).filter(ct.isBarType.bind(ct)).selectAll("circle").remove(),he.interaction_enabled&&!e.flow&&ot&&(ct.redrawEventRect(),ct.updateZoom&&ct.updateZoom()),ct.updateCircleY(),Ft=(ct.config.axis_rotated?ct.circleY:ct.circleX).bind(ct),ye=(ct.config.axis_rotated?ct.circleX:ct.circleY).bind(ct),e.flow&&(St=ct.generateFlow({targets:W,flow:e.flow,duration:e.flow.duration,drawBar:Rt,drawLine:qt,drawArea:kt,cx:Ft,cy:ye,xv:gt,xForText:Jt,yForText:Yt})),(j||St)&&ct.isTabVisible()?we.transition().duration(j).each(function(){var Tt=[];[ct.redrawBar(Rt,!0),ct.redrawLine(qt,!0),ct.redrawArea(kt,!0),ct.redrawCircle(Ft,ye,!0),ct.redrawText(Jt,Yt,e.flow,!0),ct.redrawRegion(!0),ct.redrawGrid(!0)].forEach(function(Gt){Gt.forEach(function(Et){Tt.push(Et)})}),xt=ct.generateWait(),Tt.forEach(function(Gt){xt.add(Gt)})}).call(xt,function(){St&&St(),he.onrendered&&he.onrendered.call(ct)}):(ct.redrawBar(Rt),ct.redrawLine(qt),ct.redrawArea(kt),ct.redrawCircle(Ft,ye),ct.redrawText(Jt,Yt,e.flow),ct.redrawRegion(),ct.redrawGrid(),he.onrendered&&he.onrendered.call(ct)),ct.mapToIds(ct.data.targets).forEach(function(Tt){ct.withoutFadeIn[Tt]=!0})},w.updateAndRedraw=function(e){var n,o=this.config;(e=e||{}).withTransition=Y(e,"withTransition",!0),e.withTransform=Y(e,"withTransform",!1),e.withLegend=Y(e,"withLegend",!1),e.withUpdateXDomain=!0,e.withUpdateOrgXDomain=!0,e.withTransitionForExit=!1,e.withTransitionForTransform=Y(e,"withTransitionForTransform",e.withTransition),this.updateSizes(),e.withLegend&&o.legend_show||(n=this.axis.generateTransitions(e.withTransitionForAxis?o.transition_duration:0),this.updateScales(),this.updateSvgSize(),this.transformAll(e.withTransitionForTransform,n)),this.redraw(e,n)},w.redrawWithoutRescale=function(){this.redraw({withY:!1,withSubchart:!1,withEventRect:!1,withTransitionForAxis:!1})},w.isTimeSeries=function(){return this.config.axis_x_type==="timeseries"},w.isCategorized=function(){return this.config.axis_x_type.indexOf("categor")>=0},w.isCustomX=function(){var e=this.config;return!this.isTimeSeries()&&(e.data_x||Ct(e.data_xs))},w.isTimeSeriesY=function(){return this.config.axis_y_type==="timeseries"},w.getTranslate=function(e){var n,o,l=this.config;return e==="main"?(n=K(this.margin.left),o=K(this.margin.top)):e==="context"?(n=K(this.margin2.left),o=K(this.margin2.top)):e==="legend"?(n=this.margin3.left,o=this.margin3.top):e==="x"?(n=0,o=l.axis_rotated?0:this.height):e==="y"?(n=0,o=l.axis_rotated?this.height:0):e==="y2"?(n=l.axis_rotated?0:this.width,o=l.axis_rotated?1:0):e==="subx"?(n=0,o=l.axis_rotated?0:this.height2):e==="arc"&&(n=this.arcWidth/2,o=this.arcHeight/2),"translate("+n+","+o+")"},w.initialOpacity=function(e){return e.value!==null&&this.withoutFadeIn[e.id]?1:0},w.initialOpacityForCircle=function(e){return e.value!==null&&this.withoutFadeIn[e.id]?this.opacityForCircle(e):0},w.opacityForCircle=function(e){var n=(Ht(this.config.point_show)?this.config.point_show(e):this.config.point_show)?1:0;return jt(e.value)?this.isScatterType(e)?.5:n:0},w.opacityForText=function(){return this.hasDataLabel()?1:0},w.xx=function(e){return e?this.x(e.x):null},w.xv=function(e){var n=e.value;return this.isTimeSeries()?n=this.parseDate(e.value):this.isCategorized()&&typeof e.value=="string"&&(n=this.config.axis_x_categories.indexOf(e.value)),Math.ceil(this.x(n))},w.yv=function(e){var n=e.axis&&e.axis==="y2"?this.y2:this.y;return Math.ceil(n(e.value))},w.subxx=function(e){return e?this.subX(e.x):null},w.transformMain=function(e,n){var o,l,p;n&&n.axisX?o=n.axisX:(o=this.main.select("."+P.axisX),e&&(o=o.transition())),n&&n.axisY?l=n.axisY:(l=this.main.select("."+P.axisY),e&&(l=l.transition())),n&&n.axisY2?p=n.axisY2:(p=this.main.select("."+P.axisY2),e&&(p=p.transition())),(e?this.main.transition():this.main).attr("transform",this.getTranslate("main")),o.attr("transform",this.getTranslate("x")),l.attr("transform",this.getTranslate("y")),p.attr("transform",this.getTranslate("y2")),this.main.select("."+P.chartArcs).attr("transform",this.getTranslate("arc"))},w.transformAll=function(e,n){this.transformMain(e,n),this.config.subchart_show&&this.transformContext(e,n),this.legend&&this.transformLegend(e)},w.updateSvgSize=function(){var e=this.svg.select(".c3-brush .background");this.svg.attr("width",this.currentWidth).attr("height",this.currentHeight),this.svg.selectAll(["#"+this.clipId,"#"+this.clipIdForGrid]).select("rect").attr("width",this.width).attr("height",this.height),this.svg.select("#"+this.clipIdForXAxis).select("rect").attr("x",this.getXAxisClipX.bind(this)).attr("y",this.getXAxisClipY.bind(this)).attr("width",this.getXAxisClipWidth.bind(this)).attr("height",this.getXAxisClipHeight.bind(this)),this.svg.select("#"+this.clipIdForYAxis).select("rect").attr("x",this.getYAxisClipX.bind(this)).attr("y",this.getYAxisClipY.bind(this)).attr("width",this.getYAxisClipWidth.bind(this)).attr("height",this.getYAxisClipHeight.bind(this)),this.svg.select("#"+this.clipIdForSubchart).select("rect").attr("width",this.width).attr("height",e.size()?e.attr("height"):0),this.svg.select("."+P.zoomRect).attr("width",this.width).attr("height",this.height),this.selectChart.style("max-height",this.currentHeight+"px")},w.updateDimension=function(e){e||(this.config.axis_rotated?(this.axes.x.call(this.xAxis),this.axes.subx.call(this.subXAxis)):(this.axes.y.call(this.yAxis),this.axes.y2.call(this.y2Axis))),this.updateSizes(),this.updateScales(),this.updateSvgSize(),this.transformAll(!1)},w.observeInserted=function(e){var n,o=this;typeof MutationObserver!="undefined"?(n=new MutationObserver(function(l){l.forEach(function(p){p.type==="childList"&&p.previousSibling&&(n.disconnect(),o.intervalForObserveInserted=window.setInterval(function(){e.node().parentNode&&(window.clearInterval(o.intervalForObserveInserted),o.updateDimension(),o.brush&&o.brush.update(),o.config.oninit.call(o),o.redraw({withTransform:!0,withUpdateXDomain:!0,withUpdateOrgXDomain:!0,withTransition:!1,withTransitionForTransform:!1,withLegend:!0}),e.transition().style("opacity",1))},10))})})).observe(e.node(),{attributes:!0,childList:!0,characterData:!0}):window.console.error("MutationObserver not defined.")},w.bindResize=function(){var e=this,n=e.config;if(e.resizeFunction=e.generateResize(),e.resizeFunction.add(function(){n.onresize.call(e)}),n.resize_auto&&e.resizeFunction.add(function(){e.resizeTimeout!==void 0&&window.clearTimeout(e.resizeTimeout),e.resizeTimeout=window.setTimeout(function(){delete e.resizeTimeout,e.api.flush()},100)}),e.resizeFunction.add(function(){n.onresized.call(e)}),window.attachEvent)window.attachEvent("onresize",e.resizeFunction);else if(window.addEventListener)window.addEventListener("resize",e.resizeFunction,!1);else{var o=window.onresize;o?o.add&&o.remove||(o=e.generateResize()).add(window.onresize):o=e.generateResize(),o.add(e.resizeFunction),window.onresize=o}},w.generateResize=function(){function e(){n.forEach(function(o){o()})}var n=[];return e.add=function(o){n.push(o)},e.remove=function(o){for(var l=0;l<n.length;l++)if(n[l]===o){n.splice(l,1);break}},e},w.endall=function(e,n){var o=0;e.each(function(){++o}).each("end",function(){--o||n.apply(this,arguments)})},w.generateWait=function(){var e=[],n=function(o,l){var p=setInterval(function(){var y=0;e.forEach(function(_){if(_.empty())y+=1;else try{_.transition()}catch(T){y+=1}}),y===e.length&&(clearInterval(p),l&&l())},10)};return n.add=function(o){e.push(o)},n},w.parseDate=function(e){var n;return e instanceof Date?n=e:typeof e=="string"?n=this.dataTimeFormat(this.config.data_xFormat).parse(e):(e===void 0?"undefined":Zt(e))==="object"?n=new Date(+e):typeof e!="number"||isNaN(e)||(n=new Date(+e)),n&&!isNaN(+n)||window.console.error("Failed to parse x '"+e+"' to Date object"),n},w.isTabVisible=function(){var e;return document.hidden!==void 0?e="hidden":document.mozHidden!==void 0?e="mozHidden":document.msHidden!==void 0?e="msHidden":document.webkitHidden!==void 0&&(e="webkitHidden"),!document[e]},w.isValue=jt,w.isFunction=Ht,w.isString=yt,w.isUndefined=Lt,w.isDefined=nt,w.ceil10=at,w.asHalfPixel=K,w.diffDomain=ut,w.isEmpty=vt,w.notEmpty=Ct,w.notEmpty=Ct,w.getOption=Y,w.hasValue=Q,w.sanitise=pt,w.getPathBox=Xt,w.CLASS=P,Function.prototype.bind||(Function.prototype.bind=function(e){if(typeof this!="function")throw new TypeError("Function.prototype.bind - what is trying to be bound is not callable");var n=Array.prototype.slice.call(arguments,1),o=this,l=function(){},p=function(){return o.apply(this instanceof l?this:e,n.concat(Array.prototype.slice.call(arguments)))};return l.prototype=this.prototype,p.prototype=new l,p}),"SVGPathSeg"in window||(window.SVGPathSeg=function(e,n,o){this.pathSegType=e,this.pathSegTypeAsLetter=n,this._owningPathSegList=o},window.SVGPathSeg.prototype.classname="SVGPathSeg",window.SVGPathSeg.PATHSEG_UNKNOWN=0,window.SVGPathSeg.PATHSEG_CLOSEPATH=1,window.SVGPathSeg.PATHSEG_MOVETO_ABS=2,window.SVGPathSeg.PATHSEG_MOVETO_REL=3,window.SVGPathSeg.PATHSEG_LINETO_ABS=4,window.SVGPathSeg.PATHSEG_LINETO_REL=5,window.SVGPathSeg.PATHSEG_CURVETO_CUBIC_ABS=6,window.SVGPathSeg.PATHSEG_CURVETO_CUBIC_REL=7,window.SVGPathSeg.PATHSEG_CURVETO_QUADRATIC_ABS=8,window.SVGPathSeg.PATHSEG_CURVETO_QUADRATIC_REL=9,window.SVGPathSeg.PATHSEG_ARC_ABS=10,window.SVGPathSeg.PATHSEG_ARC_REL=11,window.SVGPathSeg.PATHSEG_LINETO_HORIZONTAL_ABS=12,window.SVGPathSeg.PATHSEG_LINETO_HORIZONTAL_REL=13,window.SVGPathSeg.PATHSEG_LINETO_VERTICAL_ABS=14,window.SVGPathSeg.PATHSEG_LINETO_VERTICAL_REL=15,window.SVGPathSeg.PATHSEG_CURVETO_CUBIC_SMOOTH_ABS=16,window.SVGPathSeg.PATHSEG_CURVETO_CUBIC_SMOOTH_REL=17,window.SVGPathSeg.PATHSEG_CURVETO_QUADRATIC_SMOOTH_ABS=18,window.SVGPathSeg.PATHSEG_CURVETO_QUADRATIC_SMOOTH_REL=19,window.SVGPathSeg.prototype._segmentChanged=function(){this._owningPathSegList&&this._owningPathSegList.segmentChanged(this)},window.SVGPathSegClosePath=function(e){window.SVGPathSeg.call(this,window.SVGPathSeg.PATHSEG_CLOSEPATH,"z",e)},window.SVGPathSegClosePath.prototype=Object.create(window.SVGPathSeg.prototype),window.SVGPathSegClosePath.prototype.toString=function(){return"[object SVGPathSegClosePath]"},window.SVGPathSegClosePath.prototype._asPathString=function(){return this.pathSegTypeAsLetter},window.SVGPathSegClosePath.prototype.clone=function(){return new window.SVGPathSegClosePath(void 0)},window.SVGPathSegMovetoAbs=function(e,n,o){window.SVGPathSeg.call(this,window.SVGPathSeg.PATHSEG_MOVETO_ABS,"M",e),this._x=n,this._y=o},window.SVGPathSegMovetoAbs.prototype=Object.create(window.SVGPathSeg.prototype),window.SVGPathSegMovetoAbs.prototype.toString=function(){return"[object SVGPathSegMovetoAbs]"},window.SVGPathSegMovetoAbs.prototype._asPathString=function(){return this.pathSegTypeAsLetter+" "+this._x+" "+this._y},window.SVGPathSegMovetoAbs.prototype.clone=function(){return new window.SVGPathSegMovetoAbs(void 0,this._x,this._y)},Object.defineProperty(window.SVGPathSegMovetoAbs.prototype,"x",{get:function(){return this._x},set:function(e){this._x=e,this._segmentChanged()},enumerable:!0}),Object.defineProperty(window.SVGPathSegMovetoAbs.prototype,"y",{get:function(){return this._y},set:function(e){this._y=e,this._segmentChanged()},enumerable:!0}),window.SVGPathSegMovetoRel=function(e,n,o){window.SVGPathSeg.call(this,window.SVGPathSeg.PATHSEG_MOVETO_REL,"m",e),this._x=n,this._y=o},window.SVGPathSegMovetoRel.prototype=Object.create(window.SVGPathSeg.prototype),window.SVGPathSegMovetoRel.prototype.toString=function(){return"[object SVGPathSegMovetoRel]"},window.SVGPathSegMovetoRel.prototype._asPathString=function(){return this.pathSegTypeAsLetter+" "+this._x+" "+this._y},window.SVGPathSegMovetoRel.prototype.clone=function(){return new window.SVGPathSegMovetoRel(void 0,this._x,this._y)},Object.defineProperty(window.SVGPathSegMovetoRel.prototype,"x",{get:function(){return this._x},set:function(e){this._x=e,this._segmentChanged()},enumerable:!0}),Object.defineProperty(window.SVGPathSegMovetoRel.prototype,"y",{get:function(){return this._y},set:function(e){this._y=e,this._segmentChanged()},enumerable:!0}),window.SVGPathSegLinetoAbs=function(e,n,o){window.SVGPathSeg.call(this,window.SVGPathSeg.PATHSEG_LINETO_ABS,"L",e),this._x=n,this._y=o},window.SVGPathSegLinetoAbs.prototype=Object.create(window.SVGPathSeg.prototype),window.SVGPathSegLinetoAbs.prototype.toString=function(){return"[object SVGPathSegLinetoAbs]"},window.SVGPathSegLinetoAbs.prototype._asPathString=function(){return this.pathSegTypeAsLetter+" "+this._x+" "+this._y},window.SVGPathSegLinetoAbs.prototype.clone=function(){return new window.SVGPathSegLinetoAbs(void 0,this._x,this._y)},Object.defineProperty(window.SVGPathSegLinetoAbs.prototype,"x",{get:function(){return this._x},set:function(e){this._x=e,this._segmentChanged()},enumerable:!0}),Object.defineProperty(window.SVGPathSegLinetoAbs.prototype,"y",{get:function(){return this._y},set:function(e){this._y=e,this._segmentChanged()},enumerable:!0}),window.SVGPathSegLinetoRel=function(e,n,o){window.SVGPathSeg.call(this,window.SVGPathSeg.PATHSEG_LINETO_REL,"l",e),this._x=n,this._y=o},window.SVGPathSegLinetoRel.prototype=Object.create(window.SVGPathSeg.prototype),window.SVGPathSegLinetoRel.prototype.toString=function(){return"[object SVGPathSegLinetoRel]"},window.SVGPathSegLinetoRel.prototype._asPathString=function(){return this.pathSegTypeAsLetter+" "+this._x+" "+this._y},window.SVGPathSegLinetoRel.prototype.clone=function(){return new window.SVGPathSegLinetoRel(void 0,this._x,this._y)},Object.defineProperty(window.SVGPathSegLinetoRel.prototype,"x",{get:function(){return this._x},set:function(e){this._x=e,this._segmentChanged()},enumerable:!0}),Object.defineProperty(window.SVGPathSegLinetoRel.prototype,"y",{get:function(){return this._y},set:function(e){this._y=e,this._segmentChanged()},enumerable:!0}),window.SVGPathSegCurvetoCubicAbs=function(e,n,o,l,p,y,_){window.SVGPathSeg.call(this,window.SVGPathSeg.PATHSEG_CURVETO_CUBIC_ABS,"C",e),this._x=n,this._y=o,this._x1=l,this._y1=p,this._x2=y,this._y2=_},window.SVGPathSegCurvetoCubicAbs.prototype=Object.create(window.SVGPathSeg.prototype),window.SVGPathSegCurvetoCubicAbs.prototype.toString=function(){return"[object SVGPathSegCurvetoCubicAbs]"},window.SVGPathSegCurvetoCubicAbs.prototype._asPathString=function(){return this.pathSegTypeAsLetter+" "+this._x1+" "+this._y1+" "+this._x2+" "+this._y2+" "+this._x+" "+this._y},window.SVGPathSegCurvetoCubicAbs.prototype.clone=function(){return new window.SVGPathSegCurvetoCubicAbs(void 0,this._x,this._y,this._x1,this._y1,this._x2,this._y2)},Object.defineProperty(window.SVGPathSegCurvetoCubicAbs.prototype,"x",{get:function(){return this._x},set:function(e){this._x=e,this._segmentChanged()},enumerable:!0}),Object.defineProperty(window.SVGPathSegCurvetoCubicAbs.prototype,"y",{get:function(){return this._y},set:function(e){this._y=e,this._segmentChanged()},enumerable:!0}),Object.defineProperty(window.SVGPathSegCurvetoCubicAbs.prototype,"x1",{get:function(){return this._x1},set:function(e){this._x1=e,this._segmentChanged()},enumerable:!0}),Object.defineProperty(window.SVGPathSegCurvetoCubicAbs.prototype,"y1",{get:function(){return this._y1},set:function(e){this._y1=e,this._segmentChanged()},enumerable:!0}),Object.defineProperty(window.SVGPathSegCurvetoCubicAbs.prototype,"x2",{get:function(){return this._x2},set:function(e){this._x2=e,this._segmentChanged()},enumerable:!0}),Object.defineProperty(window.SVGPathSegCurvetoCubicAbs.prototype,"y2",{get:function(){return this._y2},set:function(e){this._y2=e,this._segmentChanged()},enumerable:!0}),window.SVGPathSegCurvetoCubicRel=function(e,n,o,l,p,y,_){window.SVGPathSeg.call(this,window.SVGPathSeg.PATHSEG_CURVETO_CUBIC_REL,"c",e),this._x=n,this._y=o,this._x1=l,this._y1=p,this._x2=y,this._y2=_},window.SVGPathSegCurvetoCubicRel.prototype=Object.create(window.SVGPathSeg.prototype),window.SVGPathSegCurvetoCubicRel.prototype.toString=function(){return"[object SVGPathSegCurvetoCubicRel]"},window.SVGPathSegCurvetoCubicRel.prototype._asPathString=function(){return this.pathSegTypeAsLetter+" "+this._x1+" "+this._y1+" "+this._x2+" "+this._y2+" "+this._x+" "+this._y},window.SVGPathSegCurvetoCubicRel.prototype.clone=function(){return new window.SVGPathSegCurvetoCubicRel(void 0,this._x,this._y,this._x1,this._y1,this._x2,this._y2)},Object.defineProperty(window.SVGPathSegCurvetoCubicRel.prototype,"x",{get:function(){return this._x},set:function(e){this._x=e,this._segmentChanged()},enumerable:!0}),Object.defineProperty(window.SVGPathSegCurvetoCubicRel.prototype,"y",{get:function(){return this._y},set:function(e){this._y=e,this._segmentChanged()},enumerable:!0}),Object.defineProperty(window.SVGPathSegCurvetoCubicRel.prototype,"x1",{get:function(){return this._x1},set:function(e){this._x1=e,this._segmentChanged()},enumerable:!0}),Object.defineProperty(window.SVGPathSegCurvetoCubicRel.prototype,"y1",{get:function(){return this._y1},set:function(e){this._y1=e,this._segmentChanged()},enumerable:!0}),Object.defineProperty(window.SVGPathSegCurvetoCubicRel.prototype,"x2",{get:function(){return this._x2},set:function(e){this._x2=e,this._segmentChanged()},enumerable:!0}),Object.defineProperty(window.SVGPathSegCurvetoCubicRel.prototype,"y2",{get:function(){return this._y2},set:function(e){this._y2=e,this._segmentChanged()},enumerable:!0}),window.SVGPathSegCurvetoQuadraticAbs=function(e,n,o,l,p){window.SVGPathSeg.call(this,window.SVGPathSeg.PATHSEG_CURVETO_QUADRATIC_ABS,"Q",e),this._x=n,this._y=o,this._x1=l,this._y1=p},window.SVGPathSegCurvetoQuadraticAbs.prototype=Object.create(window.SVGPathSeg.prototype),window.SVGPathSegCurvetoQuadraticAbs.prototype.toString=function(){return"[object SVGPathSegCurvetoQuadraticAbs]"},window.SVGPathSegCurvetoQuadraticAbs.prototype._asPathString=function(){return this.pathSegTypeAsLetter+" "+this._x1+" "+this._y1+" "+this._x+" "+this._y},window.SVGPathSegCurvetoQuadraticAbs.prototype.clone=function(){return new window.SVGPathSegCurvetoQuadraticAbs(void 0,this._x,this._y,this._x1,this._y1)},Object.defineProperty(window.SVGPathSegCurvetoQuadraticAbs.prototype,"x",{get:function(){return this._x},set:function(e){this._x=e,this._segmentChanged()},enumerable:!0}),Object.defineProperty(window.SVGPathSegCurvetoQuadraticAbs.prototype,"y",{get:function(){return this._y},set:function(e){this._y=e,this._segmentChanged()},enumerable:!0}),Object.defineProperty(window.SVGPathSegCurvetoQuadraticAbs.prototype,"x1",{get:function(){return this._x1},set:function(e){this._x1=e,this._segmentChanged()},enumerable:!0}),Object.defineProperty(window.SVGPathSegCurvetoQuadraticAbs.prototype,"y1",{get:function(){return this._y1},set:function(e){this._y1=e,this._segmentChanged()},enumerable:!0}),window.SVGPathSegCurvetoQuadraticRel=function(e,n,o,l,p){window.SVGPathSeg.call(this,window.SVGPathSeg.PATHSEG_CURVETO_QUADRATIC_REL,"q",e),this._x=n,this._y=o,this._x1=l,this._y1=p},window.SVGPathSegCurvetoQuadraticRel.prototype=Object.create(window.SVGPathSeg.prototype),window.SVGPathSegCurvetoQuadraticRel.prototype.toString=function(){return"[object SVGPathSegCurvetoQuadraticRel]"},window.SVGPathSegCurvetoQuadraticRel.prototype._asPathString=function(){return this.pathSegTypeAsLetter+" "+this._x1+" "+this._y1+" "+this._x+" "+this._y},window.SVGPathSegCurvetoQuadraticRel.prototype.clone=function(){return new window.SVGPathSegCurvetoQuadraticRel(void 0,this._x,this._y,this._x1,this._y1)},Object.defineProperty(window.SVGPathSegCurvetoQuadraticRel.prototype,"x",{get:function(){return this._x},set:function(e){this._x=e,this._segmentChanged()},enumerable:!0}),Object.defineProperty(window.SVGPathSegCurvetoQuadraticRel.prototype,"y",{get:function(){return this._y},set:function(e){this._y=e,this._segmentChanged()},enumerable:!0}),Object.defineProperty(window.SVGPathSegCurvetoQuadraticRel.prototype,"x1",{get:function(){return this._x1},set:function(e){this._x1=e,this._segmentChanged()},enumerable:!0}),Object.defineProperty(window.SVGPathSegCurvetoQuadraticRel.prototype,"y1",{get:function(){return this._y1},set:function(e){this._y1=e,this._segmentChanged()},enumerable:!0}),window.SVGPathSegArcAbs=function(e,n,o,l,p,y,_,T){window.SVGPathSeg.call(this,window.SVGPathSeg.PATHSEG_ARC_ABS,"A",e),this._x=n,this._y=o,this._r1=l,this._r2=p,this._angle=y,this._largeArcFlag=_,this._sweepFlag=T},window.SVGPathSegArcAbs.prototype=Object.create(window.SVGPathSeg.prototype),window.SVGPathSegArcAbs.prototype.toString=function(){return"[object SVGPathSegArcAbs]"},window.SVGPathSegArcAbs.prototype._asPathString=function(){return this.pathSegTypeAsLetter+" "+this._r1+" "+this._r2+" "+this._angle+" "+(this._largeArcFlag?"1":"0")+" "+(this._sweepFlag?"1":"0")+" "+this._x+" "+this._y},window.SVGPathSegArcAbs.prototype.clone=function(){return new window.SVGPathSegArcAbs(void 0,this._x,this._y,this._r1,this._r2,this._angle,this._largeArcFlag,this._sweepFlag)},Object.defineProperty(window.SVGPathSegArcAbs.prototype,"x",{get:function(){return this._x},set:function(e){this._x=e,this._segmentChanged()},enumerable:!0}),Object.defineProperty(window.SVGPathSegArcAbs.prototype,"y",{get:function(){return this._y},set:function(e){this._y=e,this._segmentChanged()},enumerable:!0}),Object.defineProperty(window.SVGPathSegArcAbs.prototype,"r1",{get:function(){return this._r1},set:function(e){this._r1=e,this._segmentChanged()},enumerable:!0}),Object.defineProperty(window.SVGPathSegArcAbs.prototype,"r2",{get:function(){return this._r2},set:function(e){this._r2=e,this._segmentChanged()},enumerable:!0}),Object.defineProperty(window.SVGPathSegArcAbs.prototype,"angle",{get:function(){return this._angle},set:function(e){this._angle=e,this._segmentChanged()},enumerable:!0}),Object.defineProperty(window.SVGPathSegArcAbs.prototype,"largeArcFlag",{get:function(){return this._largeArcFlag},set:function(e){this._largeArcFlag=e,this._segmentChanged()},enumerable:!0}),Object.defineProperty(window.SVGPathSegArcAbs.prototype,"sweepFlag",{get:function(){return this._sweepFlag},set:function(e){this._sweepFlag=e,this._segmentChanged()},enumerable:!0}),window.SVGPathSegArcRel=function(e,n,o,l,p,y,_,T){window.SVGPathSeg.call(this,window.SVGPathSeg.PATHSEG_ARC_REL,"a",e),this._x=n,this._y=o,this._r1=l,this._r2=p,this._angle=y,this._largeArcFlag=_,this._sweepFlag=T},window.SVGPathSegArcRel.prototype=Object.create(window.SVGPathSeg.prototype),window.SVGPathSegArcRel.prototype.toString=function(){return"[object SVGPathSegArcRel]"},window.SVGPathSegArcRel.prototype._asPathString=function(){return this.pathSegTypeAsLetter+" "+this._r1+" "+this._r2+" "+this._angle+" "+(this._largeArcFlag?"1":"0")+" "+(this._sweepFlag?"1":"0")+" "+this._x+" "+this._y},window.SVGPathSegArcRel.prototype.clone=function(){return new window.SVGPathSegArcRel(void 0,this._x,this._y,this._r1,this._r2,this._angle,this._largeArcFlag,this._sweepFlag)},Object.defineProperty(window.SVGPathSegArcRel.prototype,"x",{get:function(){return this._x},set:function(e){this._x=e,this._segmentChanged()},enumerable:!0}),Object.defineProperty(window.SVGPathSegArcRel.prototype,"y",{get:function(){return this._y},set:function(e){this._y=e,this._segmentChanged()},enumerable:!0}),Object.defineProperty(window.SVGPathSegArcRel.prototype,"r1",{get:function(){return this._r1},set:function(e){this._r1=e,this._segmentChanged()},enumerable:!0}),Object.defineProperty(window.SVGPathSegArcRel.prototype,"r2",{get:function(){return this._r2},set:function(e){this._r2=e,this._segmentChanged()},enumerable:!0}),Object.defineProperty(window.SVGPathSegArcRel.prototype,"angle",{get:function(){return this._angle},set:function(e){this._angle=e,this._segmentChanged()},enumerable:!0}),Object.defineProperty(window.SVGPathSegArcRel.prototype,"largeArcFlag",{get:function(){return this._largeArcFlag},set:function(e){this._largeArcFlag=e,this._segmentChanged()},enumerable:!0}),Object.defineProperty(window.SVGPathSegArcRel.prototype,"sweepFlag",{get:function(){return this._sweepFlag},set:function(e){this._sweepFlag=e,this._segmentChanged()},enumerable:!0}),window.SVGPathSegLinetoHorizontalAbs=function(e,n){window.SVGPathSeg.call(this,window.SVGPathSeg.PATHSEG_LINETO_HORIZONTAL_ABS,"H",e),this._x=n},window.SVGPathSegLinetoHorizontalAbs.prototype=Object.create(window.SVGPathSeg.prototype),window.SVGPathSegLinetoHorizontalAbs.prototype.toString=function(){return"[object SVGPathSegLinetoHorizontalAbs]"},window.SVGPathSegLinetoHorizontalAbs.prototype._asPathString=function(){return this.pathSegTypeAsLetter+" "+this._x},window.SVGPathSegLinetoHorizontalAbs.prototype.clone=function(){return new window.SVGPathSegLinetoHorizontalAbs(void 0,this._x)},Object.defineProperty(window.SVGPathSegLinetoHorizontalAbs.prototype,"x",{get:function(){return this._x},set:function(e){this._x=e,this._segmentChanged()},enumerable:!0}),window.SVGPathSegLinetoHorizontalRel=function(e,n){window.SVGPathSeg.call(this,window.SVGPathSeg.PATHSEG_LINETO_HORIZONTAL_REL,"h",e),this._x=n},window.SVGPathSegLinetoHorizontalRel.prototype=Object.create(window.SVGPathSeg.prototype),window.SVGPathSegLinetoHorizontalRel.prototype.toString=function(){return"[object SVGPathSegLinetoHorizontalRel]"},window.SVGPathSegLinetoHorizontalRel.prototype._asPathString=function(){return this.pathSegTypeAsLetter+" "+this._x},window.SVGPathSegLinetoHorizontalRel.prototype.clone=function(){return new window.SVGPathSegLinetoHorizontalRel(void 0,this._x)},Object.defineProperty(window.SVGPathSegLinetoHorizontalRel.prototype,"x",{get:function(){return this._x},set:function(e){this._x=e,this._segmentChanged()},enumerable:!0}),window.SVGPathSegLinetoVerticalAbs=function(e,n){window.SVGPathSeg.call(this,window.SVGPathSeg.PATHSEG_LINETO_VERTICAL_ABS,"V",e),this._y=n},window.SVGPathSegLinetoVerticalAbs.prototype=Object.create(window.SVGPathSeg.prototype),window.SVGPathSegLinetoVerticalAbs.prototype.toString=function(){return"[object SVGPathSegLinetoVerticalAbs]"},window.SVGPathSegLinetoVerticalAbs.prototype._asPathString=function(){return this.pathSegTypeAsLetter+" "+this._y},window.SVGPathSegLinetoVerticalAbs.prototype.clone=function(){return new window.SVGPathSegLinetoVerticalAbs(void 0,this._y)},Object.defineProperty(window.SVGPathSegLinetoVerticalAbs.prototype,"y",{get:function(){return this._y},set:function(e){this._y=e,this._segmentChanged()},enumerable:!0}),window.SVGPathSegLinetoVerticalRel=function(e,n){window.SVGPathSeg.call(this,window.SVGPathSeg.PATHSEG_LINETO_VERTICAL_REL,"v",e),this._y=n},window.SVGPathSegLinetoVerticalRel.prototype=Object.create(window.SVGPathSeg.prototype),window.SVGPathSegLinetoVerticalRel.prototype.toString=function(){return"[object SVGPathSegLinetoVerticalRel]"},window.SVGPathSegLinetoVerticalRel.prototype._asPathString=function(){return this.pathSegTypeAsLetter+" "+this._y},window.SVGPathSegLinetoVerticalRel.prototype.clone=function(){return new window.SVGPathSegLinetoVerticalRel(void 0,this._y)},Object.defineProperty(window.SVGPathSegLinetoVerticalRel.prototype,"y",{get:function(){return this._y},set:function(e){this._y=e,this._segmentChanged()},enumerable:!0}),window.SVGPathSegCurvetoCubicSmoothAbs=function(e,n,o,l,p){window.SVGPathSeg.call(this,window.SVGPathSeg.PATHSEG_CURVETO_CUBIC_SMOOTH_ABS,"S",e),this._x=n,this._y=o,this._x2=l,this._y2=p},window.SVGPathSegCurvetoCubicSmoothAbs.prototype=Object.create(window.SVGPathSeg.prototype),window.SVGPathSegCurvetoCubicSmoothAbs.prototype.toString=function(){return"[object SVGPathSegCurvetoCubicSmoothAbs]"},window.SVGPathSegCurvetoCubicSmoothAbs.prototype._asPathString=function(){return this.pathSegTypeAsLetter+" "+this._x2+" "+this._y2+" "+this._x+" "+this._y},window.SVGPathSegCurvetoCubicSmoothAbs.prototype.clone=function(){return new window.SVGPathSegCurvetoCubicSmoothAbs(void 0,this._x,this._y,this._x2,this._y2)},Object.defineProperty(window.SVGPathSegCurvetoCubicSmoothAbs.prototype,"x",{get:function(){return this._x},set:function(e){this._x=e,this._segmentChanged()},enumerable:!0}),Object.defineProperty(window.SVGPathSegCurvetoCubicSmoothAbs.prototype,"y",{get:function(){return this._y},set:function(e){this._y=e,this._segmentChanged()},enumerable:!0}),Object.defineProperty(window.SVGPathSegCurvetoCubicSmoothAbs.prototype,"x2",{get:function(){return this._x2},set:function(e){this._x2=e,this._segmentChanged()},enumerable:!0}),Object.defineProperty(window.SVGPathSegCurvetoCubicSmoothAbs.prototype,"y2",{get:function(){return this._y2},set:function(e){this._y2=e,this._segmentChanged()},enumerable:!0}),window.SVGPathSegCurvetoCubicSmoothRel=function(e,n,o,l,p){window.SVGPathSeg.call(this,window.SVGPathSeg.PATHSEG_CURVETO_CUBIC_SMOOTH_REL,"s",e),this._x=n,this._y=o,this._x2=l,this._y2=p},window.SVGPathSegCurvetoCubicSmoothRel.prototype=Object.create(window.SVGPathSeg.prototype),window.SVGPathSegCurvetoCubicSmoothRel.prototype.toString=function(){return"[object SVGPathSegCurvetoCubicSmoothRel]"},window.SVGPathSegCurvetoCubicSmoothRel.prototype._asPathString=function(){return this.pathSegTypeAsLetter+" "+this._x2+" "+this._y2+" "+this._x+" "+this._y},window.SVGPathSegCurvetoCubicSmoothRel.prototype.clone=function(){return new window.SVGPathSegCurvetoCubicSmoothRel(void 0,this._x,this._y,this._x2,this._y2)},Object.defineProperty(window.SVGPathSegCurvetoCubicSmoothRel.prototype,"x",{get:function(){return this._x},set:function(e){this._x=e,this._segmentChanged()},enumerable:!0}),Object.defineProperty(window.SVGPathSegCurvetoCubicSmoothRel.prototype,"y",{get:function(){return this._y},set:function(e){this._y=e,this._segmentChanged()},enumerable:!0}),Object.defineProperty(window.SVGPathSegCurvetoCubicSmoothRel.prototype,"x2",{get:function(){return this._x2},set:function(e){this._x2=e,this._segmentChanged()},enumerable:!0}),Object.defineProperty(window.SVGPathSegCurvetoCubicSmoothRel.prototype,"y2",{get:function(){return this._y2},set:function(e){this._y2=e,this._segmentChanged()},enumerable:!0}),window.SVGPathSegCurvetoQuadraticSmoothAbs=function(e,n,o){window.SVGPathSeg.call(this,window.SVGPathSeg.PATHSEG_CURVETO_QUADRATIC_SMOOTH_ABS,"T",e),this._x=n,this._y=o},window.SVGPathSegCurvetoQuadraticSmoothAbs.prototype=Object.create(window.SVGPathSeg.prototype),window.SVGPathSegCurvetoQuadraticSmoothAbs.prototype.toString=function(){return"[object SVGPathSegCurvetoQuadraticSmoothAbs]"},window.SVGPathSegCurvetoQuadraticSmoothAbs.prototype._asPathString=function(){return this.pathSegTypeAsLetter+" "+this._x+" "+this._y},window.SVGPathSegCurvetoQuadraticSmoothAbs.prototype.clone=function(){return new window.SVGPathSegCurvetoQuadraticSmoothAbs(void 0,this._x,this._y)},Object.defineProperty(window.SVGPathSegCurvetoQuadraticSmoothAbs.prototype,"x",{get:function(){return this._x},set:function(e){this._x=e,this._segmentChanged()},enumerable:!0}),Object.defineProperty(window.SVGPathSegCurvetoQuadraticSmoothAbs.prototype,"y",{get:function(){return this._y},set:function(e){this._y=e,this._segmentChanged()},enumerable:!0}),window.SVGPathSegCurvetoQuadraticSmoothRel=function(e,n,o){window.SVGPathSeg.call(this,window.SVGPathSeg.PATHSEG_CURVETO_QUADRATIC_SMOOTH_REL,"t",e),this._x=n,this._y=o},window.SVGPathSegCurvetoQuadraticSmoothRel.prototype=Object.create(window.SVGPathSeg.prototype),window.SVGPathSegCurvetoQuadraticSmoothRel.prototype.toString=function(){return"[object SVGPathSegCurvetoQuadraticSmoothRel]"},window.SVGPathSegCurvetoQuadraticSmoothRel.prototype._asPathString=function(){return this.pathSegTypeAsLetter+" "+this._x+" "+this._y},window.SVGPathSegCurvetoQuadraticSmoothRel.prototype.clone=function(){return new window.SVGPathSegCurvetoQuadraticSmoothRel(void 0,this._x,this._y)},Object.defineProperty(window.SVGPathSegCurvetoQuadraticSmoothRel.prototype,"x",{get:function(){return this._x},set:function(e){this._x=e,this._segmentChanged()},enumerable:!0}),Object.defineProperty(window.SVGPathSegCurvetoQuadraticSmoothRel.prototype,"y",{get:function(){return this._y},set:function(e){this._y=e,this._segmentChanged()},enumerable:!0}),window.SVGPathElement.prototype.createSVGPathSegClosePath=function(){return new window.SVGPathSegClosePath(void 0)},window.SVGPathElement.prototype.createSVGPathSegMovetoAbs=function(e,n){return new window.SVGPathSegMovetoAbs(void 0,e,n)},window.SVGPathElement.prototype.createSVGPathSegMovetoRel=function(e,n){return new window.SVGPathSegMovetoRel(void 0,e,n)},window.SVGPathElement.prototype.createSVGPathSegLinetoAbs=function(e,n){return new window.SVGPathSegLinetoAbs(void 0,e,n)},window.SVGPathElement.prototype.createSVGPathSegLinetoRel=function(e,n){return new window.SVGPathSegLinetoRel(void 0,e,n)},window.SVGPathElement.prototype.createSVGPathSegCurvetoCubicAbs=function(e,n,o,l,p,y){return new window.SVGPathSegCurvetoCubicAbs(void 0,e,n,o,l,p,y)},window.SVGPathElement.prototype.createSVGPathSegCurvetoCubicRel=function(e,n,o,l,p,y){return new window.SVGPathSegCurvetoCubicRel(void 0,e,n,o,l,p,y)},window.SVGPathElement.prototype.createSVGPathSegCurvetoQuadraticAbs=function(e,n,o,l){return new window.SVGPathSegCurvetoQuadraticAbs(void 0,e,n,o,l)},window.SVGPathElement.prototype.createSVGPathSegCurvetoQuadraticRel=function(e,n,o,l){return new window.SVGPathSegCurvetoQuadraticRel(void 0,e,n,o,l)},window.SVGPathElement.prototype.createSVGPathSegArcAbs=function(e,n,o,l,p,y,_){return new window.SVGPathSegArcAbs(void 0,e,n,o,l,p,y,_)},window.SVGPathElement.prototype.createSVGPathSegArcRel=function(e,n,o,l,p,y,_){return new window.SVGPathSegArcRel(void 0,e,n,o,l,p,y,_)},window.SVGPathElement.prototype.createSVGPathSegLinetoHorizontalAbs=function(e){return new window.SVGPathSegLinetoHorizontalAbs(void 0,e)},window.SVGPathElement.prototype.createSVGPathSegLinetoHorizontalRel=function(e){return new window.SVGPathSegLinetoHorizontalRel(void 0,e)},window.SVGPathElement.prototype.createSVGPathSegLinetoVerticalAbs=function(e){return new window.SVGPathSegLinetoVerticalAbs(void 0,e)},window.SVGPathElement.prototype.createSVGPathSegLinetoVerticalRel=function(e){return new window.SVGPathSegLinetoVerticalRel(void 0,e)},window.SVGPathElement.prototype.createSVGPathSegCurvetoCubicSmoothAbs=function(e,n,o,l){return new window.SVGPathSegCurvetoCubicSmoothAbs(void 0,e,n,o,l)},window.SVGPathElement.prototype.createSVGPathSegCurvetoCubicSmoothRel=function(e,n,o,l){return new window.SVGPathSegCurvetoCubicSmoothRel(void 0,e,n,o,l)},window.SVGPathElement.prototype.createSVGPathSegCurvetoQuadraticSmoothAbs=function(e,n){return new window.SVGPathSegCurvetoQuadraticSmoothAbs(void 0,e,n)},window.SVGPathElement.prototype.createSVGPathSegCurvetoQuadraticSmoothRel=function(e,n){return new window.SVGPathSegCurvetoQuadraticSmoothRel(void 0,e,n)},"getPathSegAtLength"in window.SVGPathElement.prototype||(window.SVGPathElement.prototype.getPathSegAtLength=function(e){if(e===void 0||!isFinite(e))throw"Invalid arguments.";var n=document.createElementNS("http://www.w3.org/2000/svg","path");n.setAttribute("d",this.getAttribute("d"));var o=n.pathSegList.numberOfItems-1;if(o<=0)return 0;do{if(n.pathSegList.removeItem(o),e>n.getTotalLength())break;o--}while(o>0);return o})),"SVGPathSegList"in window||(window.SVGPathSegList=function(e){this._pathElement=e,this._list=this._parsePath(this._pathElement.getAttribute("d")),this._mutationObserverConfig={attributes:!0,attributeFilter:["d"]},this._pathElementMutationObserver=new MutationObserver(this._updateListFromPathMutations.bind(this)),this._pathElementMutationObserver.observe(this._pathElement,this._mutationObserverConfig)},window.SVGPathSegList.prototype.classname="SVGPathSegList",Object.defineProperty(window.SVGPathSegList.prototype,"numberOfItems",{get:function(){return this._checkPathSynchronizedToList(),this._list.length},enumerable:!0}),Object.defineProperty(window.SVGPathElement.prototype,"pathSegList",{get:function(){return this._pathSegList||(this._pathSegList=new window.SVGPathSegList(this)),this._pathSegList},enumerable:!0}),Object.defineProperty(window.SVGPathElement.prototype,"normalizedPathSegList",{get:function(){return this.pathSegList},enumerable:!0}),Object.defineProperty(window.SVGPathElement.prototype,"animatedPathSegList",{get:function(){return this.pathSegList},enumerable:!0}),Object.defineProperty(window.SVGPathElement.prototype,"animatedNormalizedPathSegList",{get:function(){return this.pathSegList},enumerable:!0}),window.SVGPathSegList.prototype._checkPathSynchronizedToList=function(){this._updateListFromPathMutations(this._pathElementMutationObserver.takeRecords())},window.SVGPathSegList.prototype._updateListFromPathMutations=function(e){if(this._pathElement){var n=!1;e.forEach(function(o){o.attributeName=="d"&&(n=!0)}),n&&(this._list=this._parsePath(this._pathElement.getAttribute("d")))}},window.SVGPathSegList.prototype._writeListToPath=function(){this._pathElementMutationObserver.disconnect(),this._pathElement.setAttribute("d",window.SVGPathSegList._pathSegArrayAsString(this._list)),this._pathElementMutationObserver.observe(this._pathElement,this._mutationObserverConfig)},window.SVGPathSegList.prototype.segmentChanged=function(e){this._writeListToPath()},window.SVGPathSegList.prototype.clear=function(){this._checkPathSynchronizedToList(),this._list.forEach(function(e){e._owningPathSegList=null}),this._list=[],this._writeListToPath()},window.SVGPathSegList.prototype.initialize=function(e){return this._checkPathSynchronizedToList(),this._list=[e],e._owningPathSegList=this,this._writeListToPath(),e},window.SVGPathSegList.prototype._checkValidIndex=function(e){if(isNaN(e)||e<0||e>=this.numberOfItems)throw"INDEX_SIZE_ERR"},window.SVGPathSegList.prototype.getItem=function(e){return this._checkPathSynchronizedToList(),this._checkValidIndex(e),this._list[e]},window.SVGPathSegList.prototype.insertItemBefore=function(e,n){return this._checkPathSynchronizedToList(),n>this.numberOfItems&&(n=this.numberOfItems),e._owningPathSegList&&(e=e.clone()),this._list.splice(n,0,e),e._owningPathSegList=this,this._writeListToPath(),e},window.SVGPathSegList.prototype.replaceItem=function(e,n){return this._checkPathSynchronizedToList(),e._owningPathSegList&&(e=e.clone()),this._checkValidIndex(n),this._list[n]=e,e._owningPathSegList=this,this._writeListToPath(),e},window.SVGPathSegList.prototype.removeItem=function(e){this._checkPathSynchronizedToList(),this._checkValidIndex(e);var n=this._list[e];return this._list.splice(e,1),this._writeListToPath(),n},window.SVGPathSegList.prototype.appendItem=function(e){return this._checkPathSynchronizedToList(),e._owningPathSegList&&(e=e.clone()),this._list.push(e),e._owningPathSegList=this,this._writeListToPath(),e},window.SVGPathSegList._pathSegArrayAsString=function(e){var n="",o=!0;return e.forEach(function(l){o?(o=!1,n+=l._asPathString()):n+=" "+l._asPathString()}),n},window.SVGPathSegList.prototype._parsePath=function(e){if(!e||e.length==0)return[];var n=this,o=function(){this.pathSegList=[]};o.prototype.appendSegment=function(T){this.pathSegList.push(T)};var l=function(T){this._string=T,this._currentIndex=0,this._endIndex=this._string.length,this._previousCommand=window.SVGPathSeg.PATHSEG_UNKNOWN,this._skipOptionalSpaces()};l.prototype._isCurrentSpace=function(){var T=this._string[this._currentIndex];return T<=" "&&(T==" "||T==`
+`||T=="	"||T=="\r"||T=="\f")},l.prototype._skipOptionalSpaces=function(){for(;this._currentIndex<this._endIndex&&this._isCurrentSpace();)this._currentIndex++;return this._currentIndex<this._endIndex},l.prototype._skipOptionalSpacesOrDelimiter=function(){return!(this._currentIndex<this._endIndex&&!this._isCurrentSpace()&&this._string.charAt(this._currentIndex)!=",")&&(this._skipOptionalSpaces()&&this._currentIndex<this._endIndex&&this._string.charAt(this._currentIndex)==","&&(this._currentIndex++,this._skipOptionalSpaces()),this._currentIndex<this._endIndex)},l.prototype.hasMoreData=function(){return this._currentIndex<this._endIndex},l.prototype.peekSegmentType=function(){var T=this._string[this._currentIndex];return this._pathSegTypeFromChar(T)},l.prototype._pathSegTypeFromChar=function(T){switch(T){case"Z":case"z":return window.SVGPathSeg.PATHSEG_CLOSEPATH;case"M":return window.SVGPathSeg.PATHSEG_MOVETO_ABS;case"m":return window.SVGPathSeg.PATHSEG_MOVETO_REL;case"L":return window.SVGPathSeg.PATHSEG_LINETO_ABS;case"l":return window.SVGPathSeg.PATHSEG_LINETO_REL;case"C":return window.SVGPathSeg.PATHSEG_CURVETO_CUBIC_ABS;case"c":return window.SVGPathSeg.PATHSEG_CURVETO_CUBIC_REL;case"Q":return window.SVGPathSeg.PATHSEG_CURVETO_QUADRATIC_ABS;case"q":return window.SVGPathSeg.PATHSEG_CURVETO_QUADRATIC_REL;case"A":return window.SVGPathSeg.PATHSEG_ARC_ABS;case"a":return window.SVGPathSeg.PATHSEG_ARC_REL;case"H":return window.SVGPathSeg.PATHSEG_LINETO_HORIZONTAL_ABS;case"h":return window.SVGPathSeg.PATHSEG_LINETO_HORIZONTAL_REL;case"V":return window.SVGPathSeg.PATHSEG_LINETO_VERTICAL_ABS;case"v":return window.SVGPathSeg.PATHSEG_LINETO_VERTICAL_REL;case"S":return window.SVGPathSeg.PATHSEG_CURVETO_CUBIC_SMOOTH_ABS;case"s":return window.SVGPathSeg.PATHSEG_CURVETO_CUBIC_SMOOTH_REL;case"T":return window.SVGPathSeg.PATHSEG_CURVETO_QUADRATIC_SMOOTH_ABS;case"t":return window.SVGPathSeg.PATHSEG_CURVETO_QUADRATIC_SMOOTH_REL;default:return window.SVGPathSeg.PATHSEG_UNKNOWN}},l.prototype._nextCommandHelper=function(T,L){return(T=="+"||T=="-"||T=="."||T>="0"&&T<="9")&&L!=window.SVGPathSeg.PATHSEG_CLOSEPATH?L==window.SVGPathSeg.PATHSEG_MOVETO_ABS?window.SVGPathSeg.PATHSEG_LINETO_ABS:L==window.SVGPathSeg.PATHSEG_MOVETO_REL?window.SVGPathSeg.PATHSEG_LINETO_REL:L:window.SVGPathSeg.PATHSEG_UNKNOWN},l.prototype.initialCommandIsMoveTo=function(){if(!this.hasMoreData())return!0;var T=this.peekSegmentType();return T==window.SVGPathSeg.PATHSEG_MOVETO_ABS||T==window.SVGPathSeg.PATHSEG_MOVETO_REL},l.prototype._parseNumber=function(){var T=0,L=0,V=1,F=0,q=1,ot=1,lt=this._currentIndex;if(this._skipOptionalSpaces(),this._currentIndex<this._endIndex&&this._string.charAt(this._currentIndex)=="+"?this._currentIndex++:this._currentIndex<this._endIndex&&this._string.charAt(this._currentIndex)=="-"&&(this._currentIndex++,q=-1),!(this._currentIndex==this._endIndex||(this._string.charAt(this._currentIndex)<"0"||this._string.charAt(this._currentIndex)>"9")&&this._string.charAt(this._currentIndex)!=".")){for(var ht=this._currentIndex;this._currentIndex<this._endIndex&&this._string.charAt(this._currentIndex)>="0"&&this._string.charAt(this._currentIndex)<="9";)this._currentIndex++;if(this._currentIndex!=ht)for(var kt=this._currentIndex-1,Rt=1;kt>=ht;)L+=Rt*(this._string.charAt(kt--)-"0"),Rt*=10;if(this._currentIndex<this._endIndex&&this._string.charAt(this._currentIndex)=="."){if(this._currentIndex++,this._currentIndex>=this._endIndex||this._string.charAt(this._currentIndex)<"0"||this._string.charAt(this._currentIndex)>"9")return;for(;this._currentIndex<this._endIndex&&this._string.charAt(this._currentIndex)>="0"&&this._string.charAt(this._currentIndex)<="9";)V*=10,F+=(this._string.charAt(this._currentIndex)-"0")/V,this._currentIndex+=1}if(this._currentIndex!=lt&&this._currentIndex+1<this._endIndex&&(this._string.charAt(this._currentIndex)=="e"||this._string.charAt(this._currentIndex)=="E")&&this._string.charAt(this._currentIndex+1)!="x"&&this._string.charAt(this._currentIndex+1)!="m"){if(this._currentIndex++,this._string.charAt(this._currentIndex)=="+"?this._currentIndex++:this._string.charAt(this._currentIndex)=="-"&&(this._currentIndex++,ot=-1),this._currentIndex>=this._endIndex||this._string.charAt(this._currentIndex)<"0"||this._string.charAt(this._currentIndex)>"9")return;for(;this._currentIndex<this._endIndex&&this._string.charAt(this._currentIndex)>="0"&&this._string.charAt(this._currentIndex)<="9";)T*=10,T+=this._string.charAt(this._currentIndex)-"0",this._currentIndex++}var qt=L+F;if(qt*=q,T&&(qt*=Math.pow(10,ot*T)),lt!=this._currentIndex)return this._skipOptionalSpacesOrDelimiter(),qt}},l.prototype._parseArcFlag=function(){if(!(this._currentIndex>=this._endIndex)){var T=!1,L=this._string.charAt(this._currentIndex++);if(L=="0")T=!1;else{if(L!="1")return;T=!0}return this._skipOptionalSpacesOrDelimiter(),T}},l.prototype.parseSegment=function(){var T=this._string[this._currentIndex],L=this._pathSegTypeFromChar(T);if(L==window.SVGPathSeg.PATHSEG_UNKNOWN){if(this._previousCommand==window.SVGPathSeg.PATHSEG_UNKNOWN||(L=this._nextCommandHelper(T,this._previousCommand))==window.SVGPathSeg.PATHSEG_UNKNOWN)return null}else this._currentIndex++;switch(this._previousCommand=L,L){case window.SVGPathSeg.PATHSEG_MOVETO_REL:return new window.SVGPathSegMovetoRel(n,this._parseNumber(),this._parseNumber());case window.SVGPathSeg.PATHSEG_MOVETO_ABS:return new window.SVGPathSegMovetoAbs(n,this._parseNumber(),this._parseNumber());case window.SVGPathSeg.PATHSEG_LINETO_REL:return new window.SVGPathSegLinetoRel(n,this._parseNumber(),this._parseNumber());case window.SVGPathSeg.PATHSEG_LINETO_ABS:return new window.SVGPathSegLinetoAbs(n,this._parseNumber(),this._parseNumber());case window.SVGPathSeg.PATHSEG_LINETO_HORIZONTAL_REL:return new window.SVGPathSegLinetoHorizontalRel(n,this._parseNumber());case window.SVGPathSeg.PATHSEG_LINETO_HORIZONTAL_ABS:return new window.SVGPathSegLinetoHorizontalAbs(n,this._parseNumber());case window.SVGPathSeg.PATHSEG_LINETO_VERTICAL_REL:return new window.SVGPathSegLinetoVerticalRel(n,this._parseNumber());case window.SVGPathSeg.PATHSEG_LINETO_VERTICAL_ABS:return new window.SVGPathSegLinetoVerticalAbs(n,this._parseNumber());case window.SVGPathSeg.PATHSEG_CLOSEPATH:return this._skipOptionalSpaces(),new window.SVGPathSegClosePath(n);case window.SVGPathSeg.PATHSEG_CURVETO_CUBIC_REL:var V={x1:this._parseNumber(),y1:this._parseNumber(),x2:this._parseNumber(),y2:this._parseNumber(),x:this._parseNumber(),y:this._parseNumber()};return new window.SVGPathSegCurvetoCubicRel(n,V.x,V.y,V.x1,V.y1,V.x2,V.y2);case window.SVGPathSeg.PATHSEG_CURVETO_CUBIC_ABS:return V={x1:this._parseNumber(),y1:this._parseNumber(),x2:this._parseNumber(),y2:this._parseNumber(),x:this._parseNumber(),y:this._parseNumber()},new window.SVGPathSegCurvetoCubicAbs(n,V.x,V.y,V.x1,V.y1,V.x2,V.y2);case window.SVGPathSeg.PATHSEG_CURVETO_CUBIC_SMOOTH_REL:return V={x2:this._parseNumber(),y2:this._parseNumber(),x:this._parseNumber(),y:this._parseNumber()},new window.SVGPathSegCurvetoCubicSmoothRel(n,V.x,V.y,V.x2,V.y2);case window.SVGPathSeg.PATHSEG_CURVETO_CUBIC_SMOOTH_ABS:return V={x2:this._parseNumber(),y2:this._parseNumber(),x:this._parseNumber(),y:this._parseNumber()},new window.SVGPathSegCurvetoCubicSmoothAbs(n,V.x,V.y,V.x2,V.y2);case window.SVGPathSeg.PATHSEG_CURVETO_QUADRATIC_REL:return V={x1:this._parseNumber(),y1:this._parseNumber(),x:this._parseNumber(),y:this._parseNumber()},new window.SVGPathSegCurvetoQuadraticRel(n,V.x,V.y,V.x1,V.y1);case window.SVGPathSeg.PATHSEG_CURVETO_QUADRATIC_ABS:return V={x1:this._parseNumber(),y1:this._parseNumber(),x:this._parseNumber(),y:this._parseNumber()},new window.SVGPathSegCurvetoQuadraticAbs(n,V.x,V.y,V.x1,V.y1);case window.SVGPathSeg.PATHSEG_CURVETO_QUADRATIC_SMOOTH_REL:return new window.SVGPathSegCurvetoQuadraticSmoothRel(n,this._parseNumber(),this._parseNumber());case window.SVGPathSeg.PATHSEG_CURVETO_QUADRATIC_SMOOTH_ABS:return new window.SVGPathSegCurvetoQuadraticSmoothAbs(n,this._parseNumber(),this._parseNumber());case window.SVGPathSeg.PATHSEG_ARC_REL:return V={x1:this._parseNumber(),y1:this._parseNumber(),arcAngle:this._parseNumber(),arcLarge:this._parseArcFlag(),arcSweep:this._parseArcFlag(),x:this._parseNumber(),y:this._parseNumber()},new window.SVGPathSegArcRel(n,V.x,V.y,V.x1,V.y1,V.arcAngle,V.arcLarge,V.arcSweep);case window.SVGPathSeg.PATHSEG_ARC_ABS:return V={x1:this._parseNumber(),y1:this._parseNumber(),arcAngle:this._parseNumber(),arcLarge:this._parseArcFlag(),arcSweep:this._parseArcFlag(),x:this._parseNumber(),y:this._parseNumber()},new window.SVGPathSegArcAbs(n,V.x,V.y,V.x1,V.y1,V.arcAngle,V.arcLarge,V.arcSweep);default:throw"Unknown path seg type."}};var p=new o,y=new l(e);if(!y.initialCommandIsMoveTo())return[];for(;y.hasMoreData();){var _=y.parseSegment();if(!_)return[];p.appendSegment(_)}return p.pathSegList}),bt.axis=function(){},bt.axis.labels=function(e){var n=this.internal;arguments.length&&(Object.keys(e).forEach(function(o){n.axis.setLabelText(o,e[o])}),n.axis.updateLabels())},bt.axis.max=function(e){var n=this.internal,o=n.config;if(!arguments.length)return{x:o.axis_x_max,y:o.axis_y_max,y2:o.axis_y2_max};(e===void 0?"undefined":Zt(e))==="object"?(jt(e.x)&&(o.axis_x_max=e.x),jt(e.y)&&(o.axis_y_max=e.y),jt(e.y2)&&(o.axis_y2_max=e.y2)):o.axis_y_max=o.axis_y2_max=e,n.redraw({withUpdateOrgXDomain:!0,withUpdateXDomain:!0})},bt.axis.min=function(e){var n=this.internal,o=n.config;if(!arguments.length)return{x:o.axis_x_min,y:o.axis_y_min,y2:o.axis_y2_min};(e===void 0?"undefined":Zt(e))==="object"?(jt(e.x)&&(o.axis_x_min=e.x),jt(e.y)&&(o.axis_y_min=e.y),jt(e.y2)&&(o.axis_y2_min=e.y2)):o.axis_y_min=o.axis_y2_min=e,n.redraw({withUpdateOrgXDomain:!0,withUpdateXDomain:!0})},bt.axis.range=function(e){if(!arguments.length)return{max:this.axis.max(),min:this.axis.min()};nt(e.max)&&this.axis.max(e.max),nt(e.min)&&this.axis.min(e.min)},bt.category=function(e,n){var o=this.internal,l=o.config;return arguments.length>1&&(l.axis_x_categories[e]=n,o.redraw()),l.axis_x_categories[e]},bt.categories=function(e){var n=this.internal,o=n.config;return arguments.length&&(o.axis_x_categories=e,n.redraw()),o.axis_x_categories},bt.resize=function(e){var n=this.internal.config;n.size_width=e?e.width:null,n.size_height=e?e.height:null,this.flush()},bt.flush=function(){this.internal.updateAndRedraw({withLegend:!0,withTransition:!1,withTransitionForTransform:!1})},bt.destroy=function(){var e=this.internal;if(window.clearInterval(e.intervalForObserveInserted),e.resizeTimeout!==void 0&&window.clearTimeout(e.resizeTimeout),window.detachEvent)window.detachEvent("onresize",e.resizeFunction);else if(window.removeEventListener)window.removeEventListener("resize",e.resizeFunction);else{var n=window.onresize;n&&n.add&&n.remove&&n.remove(e.resizeFunction)}return e.selectChart.classed("c3",!1).html(""),Object.keys(e).forEach(function(o){e[o]=null}),null},bt.color=function(e){return this.internal.color(e)},bt.data=function(e){var n=this.internal.data.targets;return e===void 0?n:n.filter(function(o){return[].concat(e).indexOf(o.id)>=0})},bt.data.shown=function(e){return this.internal.filterTargetsToShow(this.data(e))},bt.data.values=function(e){var n,o=null;return e&&(o=(n=this.data(e))[0]?n[0].values.map(function(l){return l.value}):null),o},bt.data.names=function(e){return this.internal.clearLegendItemTextBoxCache(),this.internal.updateDataAttributes("names",e)},bt.data.colors=function(e){return this.internal.updateDataAttributes("colors",e)},bt.data.axes=function(e){return this.internal.updateDataAttributes("axes",e)},bt.flow=function(e){var n,o,l,p,y,_,T,L=this.internal,V=[],F=L.getMaxDataCount(),q=0,ot=0;if(e.json)o=L.convertJsonToData(e.json,e.keys);else if(e.rows)o=L.convertRowsToData(e.rows);else{if(!e.columns)return;o=L.convertColumnsToData(e.columns)}n=L.convertDataToTargets(o,!0),L.data.targets.forEach(function(lt){var ht,kt,Rt=!1;for(ht=0;ht<n.length;ht++)if(lt.id===n[ht].id){for(Rt=!0,lt.values[lt.values.length-1]&&(ot=lt.values[lt.values.length-1].index+1),q=n[ht].values.length,kt=0;kt<q;kt++)n[ht].values[kt].index=ot+kt,L.isTimeSeries()||(n[ht].values[kt].x=ot+kt);lt.values=lt.values.concat(n[ht].values),n.splice(ht,1);break}Rt||V.push(lt.id)}),L.data.targets.forEach(function(lt){var ht,kt;for(ht=0;ht<V.length;ht++)if(lt.id===V[ht])for(ot=lt.values[lt.values.length-1].index+1,kt=0;kt<q;kt++)lt.values.push({id:lt.id,index:ot+kt,x:L.isTimeSeries()?L.getOtherTargetX(ot+kt):ot+kt,value:null})}),L.data.targets.length&&n.forEach(function(lt){var ht,kt=[];for(ht=L.data.targets[0].values[0].index;ht<ot;ht++)kt.push({id:lt.id,index:ht,x:L.isTimeSeries()?L.getOtherTargetX(ht):ht,value:null});lt.values.forEach(function(Rt){Rt.index+=ot,L.isTimeSeries()||(Rt.x+=ot)}),lt.values=kt.concat(lt.values)}),L.data.targets=L.data.targets.concat(n),L.getMaxDataCount(),y=(p=L.data.targets[0]).values[0],nt(e.to)?(q=0,T=L.isTimeSeries()?L.parseDate(e.to):e.to,p.values.forEach(function(lt){lt.x<T&&q++})):nt(e.length)&&(q=e.length),F?F===1&&L.isTimeSeries()&&(_=(p.values[p.values.length-1].x-y.x)/2,l=[new Date(+y.x-_),new Date(+y.x+_)],L.updateXDomain(null,!0,!0,!1,l)):(_=L.isTimeSeries()?p.values.length>1?p.values[p.values.length-1].x-y.x:y.x-L.getXDomain(L.data.targets)[0]:1,l=[y.x-_,y.x],L.updateXDomain(null,!0,!0,!1,l)),L.updateTargets(L.data.targets),L.redraw({flow:{index:y.index,length:q,duration:jt(e.duration)?e.duration:L.config.transition_duration,done:e.done,orgDataCount:F},withLegend:!0,withTransition:F>1,withTrimXDomain:!1,withUpdateXAxis:!0})},w.generateFlow=function(e){var n=this,o=n.config,l=n.d3;return function(){var p,y,_,T=e.targets,L=e.flow,V=e.drawBar,F=e.drawLine,q=e.drawArea,ot=e.cx,lt=e.cy,ht=e.xv,kt=e.xForText,Rt=e.yForText,qt=e.duration,Jt=1,Yt=L.index,j=L.length,tt=n.getValueOnIndex(n.data.targets[0].values,Yt),Ot=n.getValueOnIndex(n.data.targets[0].values,Yt+j),xt=n.x.domain(),St=L.duration||qt,re=L.done||function(){},zt=n.generateWait(),oe=n.xgrid||l.selectAll([]),te=n.xgridLines||l.selectAll([]),Ft=n.mainRegion||l.selectAll([]),ye=n.mainText||l.selectAll([]),ct=n.mainBar||l.selectAll([]),Ve=n.mainLine||l.selectAll([]),we=n.mainArea||l.selectAll([]),he=n.mainCircle||l.selectAll([]);n.flowing=!0,n.data.targets.forEach(function(Pt){Pt.values.splice(0,j)}),_=n.updateXDomain(T,!0,!0),n.updateXGrid&&n.updateXGrid(!0),L.orgDataCount?p=L.orgDataCount===1||(tt&&tt.x)===(Ot&&Ot.x)||n.isTimeSeries()?n.x(xt[0])-n.x(_[0]):n.x(tt.x)-n.x(Ot.x):n.data.targets[0].values.length!==1?p=n.x(xt[0])-n.x(_[0]):n.isTimeSeries()?(tt=n.getValueOnIndex(n.data.targets[0].values,0),Ot=n.getValueOnIndex(n.data.targets[0].values,n.data.targets[0].values.length-1),p=n.x(tt.x)-n.x(Ot.x)):p=ut(_)/2,Jt=ut(xt)/ut(_),y="translate("+p+",0) scale("+Jt+",1)",n.hideXGridFocus(),l.transition().ease("linear").duration(St).each(function(){zt.add(n.axes.x.transition().call(n.xAxis)),zt.add(ct.transition().attr("transform",y)),zt.add(Ve.transition().attr("transform",y)),zt.add(we.transition().attr("transform",y)),zt.add(he.transition().attr("transform",y)),zt.add(ye.transition().attr("transform",y)),zt.add(Ft.filter(n.isRegionOnX).transition().attr("transform",y)),zt.add(oe.transition().attr("transform",y)),zt.add(te.transition().attr("transform",y))}).call(zt,function(){var Pt,c=[],I=[],X=[];if(j){for(Pt=0;Pt<j;Pt++)c.push("."+P.shape+"-"+(Yt+Pt)),I.push("."+P.text+"-"+(Yt+Pt)),X.push("."+P.eventRect+"-"+(Yt+Pt));n.svg.selectAll("."+P.shapes).selectAll(c).remove(),n.svg.selectAll("."+P.texts).selectAll(I).remove(),n.svg.selectAll("."+P.eventRects).selectAll(X).remove(),n.svg.select("."+P.xgrid).remove()}oe.attr("transform",null).attr(n.xgridAttr),te.attr("transform",null),te.select("line").attr("x1",o.axis_rotated?0:ht).attr("x2",o.axis_rotated?n.width:ht),te.select("text").attr("x",o.axis_rotated?n.width:0).attr("y",ht),ct.attr("transform",null).attr("d",V),Ve.attr("transform",null).attr("d",F),we.attr("transform",null).attr("d",q),he.attr("transform",null).attr("cx",ot).attr("cy",lt),ye.attr("transform",null).attr("x",kt).attr("y",Rt).style("fill-opacity",n.opacityForText.bind(n)),Ft.attr("transform",null),Ft.select("rect").filter(n.isRegionOnX).attr("x",n.regionX.bind(n)).attr("width",n.regionWidth.bind(n)),o.interaction_enabled&&n.redrawEventRect(),re(),n.flowing=!1})}},bt.focus=function(e){var n,o=this.internal;e=o.mapToTargetIds(e),n=o.svg.selectAll(o.selectorTargets(e.filter(o.isTargetToShow,o))),this.revert(),this.defocus(),n.classed(P.focused,!0).classed(P.defocused,!1),o.hasArcType()&&o.expandArc(e),o.toggleFocusLegend(e,!0),o.focusedTargetIds=e,o.defocusedTargetIds=o.defocusedTargetIds.filter(function(l){return e.indexOf(l)<0})},bt.defocus=function(e){var n=this.internal;e=n.mapToTargetIds(e),n.svg.selectAll(n.selectorTargets(e.filter(n.isTargetToShow,n))).classed(P.focused,!1).classed(P.defocused,!0),n.hasArcType()&&n.unexpandArc(e),n.toggleFocusLegend(e,!1),n.focusedTargetIds=n.focusedTargetIds.filter(function(o){return e.indexOf(o)<0}),n.defocusedTargetIds=e},bt.revert=function(e){var n=this.internal;e=n.mapToTargetIds(e),n.svg.selectAll(n.selectorTargets(e)).classed(P.focused,!1).classed(P.defocused,!1),n.hasArcType()&&n.unexpandArc(e),n.config.legend_show&&(n.showLegend(e.filter(n.isLegendToShow.bind(n))),n.legend.selectAll(n.selectorLegends(e)).filter(function(){return n.d3.select(this).classed(P.legendItemFocused)}).classed(P.legendItemFocused,!1)),n.focusedTargetIds=[],n.defocusedTargetIds=[]},bt.xgrids=function(e){var n=this.internal,o=n.config;return e&&(o.grid_x_lines=e,n.redrawWithoutRescale()),o.grid_x_lines},bt.xgrids.add=function(e){var n=this.internal;return this.xgrids(n.config.grid_x_lines.concat(e||[]))},bt.xgrids.remove=function(e){this.internal.removeGridLines(e,!0)},bt.ygrids=function(e){var n=this.internal,o=n.config;return e&&(o.grid_y_lines=e,n.redrawWithoutRescale()),o.grid_y_lines},bt.ygrids.add=function(e){var n=this.internal;return this.ygrids(n.config.grid_y_lines.concat(e||[]))},bt.ygrids.remove=function(e){this.internal.removeGridLines(e,!1)},bt.groups=function(e){var n=this.internal,o=n.config;return Lt(e)||(o.data_groups=e,n.redraw()),o.data_groups},bt.legend=function(){},bt.legend.show=function(e){var n=this.internal;n.showLegend(n.mapToTargetIds(e)),n.updateAndRedraw({withLegend:!0})},bt.legend.hide=function(e){var n=this.internal;n.hideLegend(n.mapToTargetIds(e)),n.updateAndRedraw({withLegend:!0})},bt.load=function(e){var n=this.internal,o=n.config;e.xs&&n.addXs(e.xs),"names"in e&&bt.data.names.bind(this)(e.names),"classes"in e&&Object.keys(e.classes).forEach(function(l){o.data_classes[l]=e.classes[l]}),"categories"in e&&n.isCategorized()&&(o.axis_x_categories=e.categories),"axes"in e&&Object.keys(e.axes).forEach(function(l){o.data_axes[l]=e.axes[l]}),"colors"in e&&Object.keys(e.colors).forEach(function(l){o.data_colors[l]=e.colors[l]}),"cacheIds"in e&&n.hasCaches(e.cacheIds)?n.load(n.getCaches(e.cacheIds),e.done):"unload"in e?n.unload(n.mapToTargetIds(typeof e.unload=="boolean"&&e.unload?null:e.unload),function(){n.loadFromArgs(e)}):n.loadFromArgs(e)},bt.unload=function(e){var n=this.internal;(e=e||{})instanceof Array?e={ids:e}:typeof e=="string"&&(e={ids:[e]}),n.unload(n.mapToTargetIds(e.ids),function(){n.redraw({withUpdateOrgXDomain:!0,withUpdateXDomain:!0,withLegend:!0}),e.done&&e.done()})},bt.regions=function(e){var n=this.internal,o=n.config;return e&&(o.regions=e,n.redrawWithoutRescale()),o.regions},bt.regions.add=function(e){var n=this.internal,o=n.config;return e&&(o.regions=o.regions.concat(e),n.redrawWithoutRescale()),o.regions},bt.regions.remove=function(e){var n,o,l,p=this.internal,y=p.config;return e=e||{},n=p.getOption(e,"duration",y.transition_duration),o=p.getOption(e,"classes",[P.region]),l=p.main.select("."+P.regions).selectAll(o.map(function(_){return"."+_})),(n?l.transition().duration(n):l).style("opacity",0).remove(),y.regions=y.regions.filter(function(_){var T=!1;return!_.class||(_.class.split(" ").forEach(function(L){o.indexOf(L)>=0&&(T=!0)}),!T)}),y.regions},bt.selected=function(e){var n=this.internal,o=n.d3;return o.merge(n.main.selectAll("."+P.shapes+n.getTargetSelectorSuffix(e)).selectAll("."+P.shape).filter(function(){return o.select(this).classed(P.SELECTED)}).map(function(l){return l.map(function(p){var y=p.__data__;return y.data?y.data:y})}))},bt.select=function(e,n,o){var l=this.internal,p=l.d3,y=l.config;y.data_selection_enabled&&l.main.selectAll("."+P.shapes).selectAll("."+P.shape).each(function(_,T){var L=p.select(this),V=_.data?_.data.id:_.id,F=l.getToggle(this,_).bind(l),q=y.data_selection_grouped||!e||e.indexOf(V)>=0,ot=!n||n.indexOf(T)>=0,lt=L.classed(P.SELECTED);L.classed(P.line)||L.classed(P.area)||(q&&ot?y.data_selection_isselectable(_)&&!lt&&F(!0,L.classed(P.SELECTED,!0),_,T):nt(o)&&o&&lt&&F(!1,L.classed(P.SELECTED,!1),_,T))})},bt.unselect=function(e,n){var o=this.internal,l=o.d3,p=o.config;p.data_selection_enabled&&o.main.selectAll("."+P.shapes).selectAll("."+P.shape).each(function(y,_){var T=l.select(this),L=y.data?y.data.id:y.id,V=o.getToggle(this,y).bind(o),F=p.data_selection_grouped||!e||e.indexOf(L)>=0,q=!n||n.indexOf(_)>=0,ot=T.classed(P.SELECTED);T.classed(P.line)||T.classed(P.area)||F&&q&&p.data_selection_isselectable(y)&&ot&&V(!1,T.classed(P.SELECTED,!1),y,_)})},bt.show=function(e,n){var o,l=this.internal;e=l.mapToTargetIds(e),n=n||{},l.removeHiddenTargetIds(e),(o=l.svg.selectAll(l.selectorTargets(e))).transition().style("opacity",1,"important").call(l.endall,function(){o.style("opacity",null).style("opacity",1)}),n.withLegend&&l.showLegend(e),l.redraw({withUpdateOrgXDomain:!0,withUpdateXDomain:!0,withLegend:!0})},bt.hide=function(e,n){var o,l=this.internal;e=l.mapToTargetIds(e),n=n||{},l.addHiddenTargetIds(e),(o=l.svg.selectAll(l.selectorTargets(e))).transition().style("opacity",0,"important").call(l.endall,function(){o.style("opacity",null).style("opacity",0)}),n.withLegend&&l.hideLegend(e),l.redraw({withUpdateOrgXDomain:!0,withUpdateXDomain:!0,withLegend:!0})},bt.toggle=function(e,n){var o=this,l=this.internal;l.mapToTargetIds(e).forEach(function(p){l.isTargetToShow(p)?o.hide(p,n):o.show(p,n)})},bt.tooltip=function(){},bt.tooltip.show=function(e){var n,o,l=this.internal;e.mouse&&(o=e.mouse),e.data?l.isMultipleX()?(o=[l.x(e.data.x),l.getYScale(e.data.id)(e.data.value)],n=null):n=jt(e.data.index)?e.data.index:l.getIndexByX(e.data.x):e.x!==void 0?n=l.getIndexByX(e.x):e.index!==void 0&&(n=e.index),l.dispatchEvent("mouseover",n,o),l.dispatchEvent("mousemove",n,o),l.config.tooltip_onshow.call(l,e.data)},bt.tooltip.hide=function(){this.internal.dispatchEvent("mouseout",0),this.internal.config.tooltip_onhide.call(this)},bt.transform=function(e,n){var o=this.internal,l=["pie","donut"].indexOf(e)>=0?{withTransform:!0}:null;o.transformTo(n,e,l)},w.transformTo=function(e,n,o){var l=!this.hasArcType(),p=o||{withTransitionForAxis:l};p.withTransitionForTransform=!1,this.transiting=!1,this.setTargetType(e,n),this.updateTargets(this.data.targets),this.updateAndRedraw(p)},bt.x=function(e){var n=this.internal;return arguments.length&&(n.updateTargetX(n.data.targets,e),n.redraw({withUpdateOrgXDomain:!0,withUpdateXDomain:!0})),n.data.xs},bt.xs=function(e){var n=this.internal;return arguments.length&&(n.updateTargetXs(n.data.targets,e),n.redraw({withUpdateOrgXDomain:!0,withUpdateXDomain:!0})),n.data.xs},bt.zoom=function(e){var n=this.internal;return e&&(n.isTimeSeries()&&(e=e.map(function(o){return n.parseDate(o)})),n.brush.extent(e),n.redraw({withUpdateXDomain:!0,withY:n.config.zoom_rescale}),n.config.zoom_onzoom.call(this,n.x.orgDomain())),n.brush.extent()},bt.zoom.enable=function(e){var n=this.internal;n.config.zoom_enabled=e,n.updateAndRedraw()},bt.unzoom=function(){var e=this.internal;e.brush.clear().update(),e.redraw({withUpdateXDomain:!0})},bt.zoom.max=function(e){var n=this.internal,o=n.config,l=n.d3;if(e!==0&&!e)return o.zoom_x_max;o.zoom_x_max=l.max([n.orgXDomain[1],e])},bt.zoom.min=function(e){var n=this.internal,o=n.config,l=n.d3;if(e!==0&&!e)return o.zoom_x_min;o.zoom_x_min=l.min([n.orgXDomain[0],e])},bt.zoom.range=function(e){if(!arguments.length)return{max:this.domain.max(),min:this.domain.min()};nt(e.max)&&this.domain.max(e.max),nt(e.min)&&this.domain.min(e.min)},w.initPie=function(){var e=this.d3;this.pie=e.layout.pie().value(function(n){return n.values.reduce(function(o,l){return o+l.value},0)}),this.pie.sort(this.getOrderFunction()||null)},w.updateRadius=function(){var e=this.config,n=e.gauge_width||e.donut_width;this.radiusExpanded=Math.min(this.arcWidth,this.arcHeight)/2,this.radius=.95*this.radiusExpanded,this.innerRadiusRatio=n?(this.radius-n)/this.radius:.6,this.innerRadius=this.hasType("donut")||this.hasType("gauge")?this.radius*this.innerRadiusRatio:0},w.updateArc=function(){this.svgArc=this.getSvgArc(),this.svgArcExpanded=this.getSvgArcExpanded(),this.svgArcExpandedSub=this.getSvgArcExpanded(.98)},w.updateAngle=function(e){var n,o,l,p,y=this.config,_=!1,T=0;return y?(this.pie(this.filterTargetsToShow(this.data.targets)).forEach(function(L){_||L.data.id!==e.data.id||(_=!0,(e=L).index=T),T++}),isNaN(e.startAngle)&&(e.startAngle=0),isNaN(e.endAngle)&&(e.endAngle=e.startAngle),this.isGaugeType(e.data)&&(n=y.gauge_min,o=y.gauge_max,l=Math.PI*(y.gauge_fullCircle?2:1)/(o-n),p=e.value<n?0:e.value<o?e.value-n:o-n,e.startAngle=y.gauge_startingAngle,e.endAngle=e.startAngle+l*p),_?e:null):null},w.getSvgArc=function(){var e=this,n=e.d3.svg.arc().outerRadius(e.radius).innerRadius(e.innerRadius),o=function(l,p){var y;return p?n(l):(y=e.updateAngle(l))?n(y):"M 0 0"};return o.centroid=n.centroid,o},w.getSvgArcExpanded=function(e){var n=this,o=n.d3.svg.arc().outerRadius(n.radiusExpanded*(e||1)).innerRadius(n.innerRadius);return function(l){var p=n.updateAngle(l);return p?o(p):"M 0 0"}},w.getArc=function(e,n,o){return o||this.isArcType(e.data)?this.svgArc(e,n):"M 0 0"},w.transformForArcLabel=function(e){var n,o,l,p,y,_=this.config,T=this.updateAngle(e),L="";return T&&!this.hasType("gauge")&&(n=this.svgArc.centroid(T),o=isNaN(n[0])?0:n[0],l=isNaN(n[1])?0:n[1],p=Math.sqrt(o*o+l*l),L="translate("+o*(y=this.hasType("donut")&&_.donut_label_ratio?Ht(_.donut_label_ratio)?_.donut_label_ratio(e,this.radius,p):_.donut_label_ratio:this.hasType("pie")&&_.pie_label_ratio?Ht(_.pie_label_ratio)?_.pie_label_ratio(e,this.radius,p):_.pie_label_ratio:this.radius&&p?(36/this.radius>.375?1.175-36/this.radius:.8)*this.radius/p:0)+","+l*y+")"),L},w.getArcRatio=function(e){var n=this.config,o=Math.PI*(this.hasType("gauge")&&!n.gauge_fullCircle?1:2);return e?(e.endAngle-e.startAngle)/o:null},w.convertToArcData=function(e){return this.addName({id:e.data.id,value:e.value,ratio:this.getArcRatio(e),index:e.index})},w.textForArcLabel=function(e){var n,o,l,p,y;return this.shouldShowArcLabel()?(n=this.updateAngle(e),o=n?n.value:null,l=this.getArcRatio(n),p=e.data.id,this.hasType("gauge")||this.meetsArcLabelThreshold(l)?(y=this.getArcLabelFormat())?y(o,l,p):this.defaultArcValueFormat(o,l):""):""},w.textForGaugeMinMax=function(e,n){var o=this.getGaugeLabelExtents();return o?o(e,n):e},w.expandArc=function(e){var n,o=this;o.transiting?n=window.setInterval(function(){o.transiting||(window.clearInterval(n),o.legend.selectAll(".c3-legend-item-focused").size()>0&&o.expandArc(e))},10):(e=o.mapToTargetIds(e),o.svg.selectAll(o.selectorTargets(e,"."+P.chartArc)).each(function(l){o.shouldExpand(l.data.id)&&o.d3.select(this).selectAll("path").transition().duration(o.expandDuration(l.data.id)).attr("d",o.svgArcExpanded).transition().duration(2*o.expandDuration(l.data.id)).attr("d",o.svgArcExpandedSub).each(function(p){o.isDonutType(p.data)})}))},w.unexpandArc=function(e){var n=this;n.transiting||(e=n.mapToTargetIds(e),n.svg.selectAll(n.selectorTargets(e,"."+P.chartArc)).selectAll("path").transition().duration(function(o){return n.expandDuration(o.data.id)}).attr("d",n.svgArc),n.svg.selectAll("."+P.arc))},w.expandDuration=function(e){var n=this.config;return this.isDonutType(e)?n.donut_expand_duration:this.isGaugeType(e)?n.gauge_expand_duration:this.isPieType(e)?n.pie_expand_duration:50},w.shouldExpand=function(e){var n=this.config;return this.isDonutType(e)&&n.donut_expand||this.isGaugeType(e)&&n.gauge_expand||this.isPieType(e)&&n.pie_expand},w.shouldShowArcLabel=function(){var e=this.config,n=!0;return this.hasType("donut")?n=e.donut_label_show:this.hasType("pie")&&(n=e.pie_label_show),n},w.meetsArcLabelThreshold=function(e){var n=this.config;return e>=(this.hasType("donut")?n.donut_label_threshold:n.pie_label_threshold)},w.getArcLabelFormat=function(){var e=this.config,n=e.pie_label_format;return this.hasType("gauge")?n=e.gauge_label_format:this.hasType("donut")&&(n=e.donut_label_format),n},w.getGaugeLabelExtents=function(){return this.config.gauge_label_extents},w.getArcTitle=function(){return this.hasType("donut")?this.config.donut_title:""},w.updateTargetsForArc=function(e){var n,o=this.main,l=this.classChartArc.bind(this),p=this.classArcs.bind(this),y=this.classFocus.bind(this);(n=o.select("."+P.chartArcs).selectAll("."+P.chartArc).data(this.pie(e)).attr("class",function(_){return l(_)+y(_.data)}).enter().append("g").attr("class",l)).append("g").attr("class",p),n.append("text").attr("dy",this.hasType("gauge")?"-.1em":".35em").style("opacity",0).style("text-anchor","middle").style("pointer-events","none")},w.initArc=function(){this.arcs=this.main.select("."+P.chart).append("g").attr("class",P.chartArcs).attr("transform",this.getTranslate("arc")),this.arcs.append("text").attr("class",P.chartArcsTitle).style("text-anchor","middle").text(this.getArcTitle())},w.redrawArc=function(e,n,o){var l,p=this,y=p.d3,_=p.config,T=p.main;(l=T.selectAll("."+P.arcs).selectAll("."+P.arc).data(p.arcData.bind(p))).enter().append("path").attr("class",p.classArc.bind(p)).style("fill",function(L){return p.color(L.data)}).style("cursor",function(L){return _.interaction_enabled&&_.data_selection_isselectable(L)?"pointer":null}).each(function(L){p.isGaugeType(L.data)&&(L.startAngle=L.endAngle=_.gauge_startingAngle),this._current=L}),l.attr("transform",function(L){return!p.isGaugeType(L.data)&&o?"scale(0)":""}).on("mouseover",_.interaction_enabled?function(L){var V,F;p.transiting||(V=p.updateAngle(L))&&(F=p.convertToArcData(V),p.expandArc(V.data.id),p.api.focus(V.data.id),p.toggleFocusLegend(V.data.id,!0),p.config.data_onmouseover(F,this))}:null).on("mousemove",_.interaction_enabled?function(L){var V,F=p.updateAngle(L);F&&(V=[p.convertToArcData(F)],p.showTooltip(V,this))}:null).on("mouseout",_.interaction_enabled?function(L){var V,F;p.transiting||(V=p.updateAngle(L))&&(F=p.convertToArcData(V),p.unexpandArc(V.data.id),p.api.revert(),p.revertLegend(),p.hideTooltip(),p.config.data_onmouseout(F,this))}:null).on("click",_.interaction_enabled?function(L,V){var F,q=p.updateAngle(L);q&&(F=p.convertToArcData(q),p.toggleShape&&p.toggleShape(this,F,V),p.config.data_onclick.call(p.api,F,this))}:null).each(function(){p.transiting=!0}).transition().duration(e).attrTween("d",function(L){var V,F=p.updateAngle(L);return F?(isNaN(this._current.startAngle)&&(this._current.startAngle=0),isNaN(this._current.endAngle)&&(this._current.endAngle=this._current.startAngle),V=y.interpolate(this._current,F),this._current=V(0),function(q){var ot=V(q);return ot.data=L.data,p.getArc(ot,!0)}):function(){return"M 0 0"}}).attr("transform",o?"scale(1)":"").style("fill",function(L){return p.levelColor?p.levelColor(L.data.values[0].value):p.color(L.data.id)}).call(p.endall,function(){p.transiting=!1}),l.exit().transition().duration(n).style("opacity",0).remove(),T.selectAll("."+P.chartArc).select("text").style("opacity",0).attr("class",function(L){return p.isGaugeType(L.data)?P.gaugeValue:""}).text(p.textForArcLabel.bind(p)).attr("transform",p.transformForArcLabel.bind(p)).style("font-size",function(L){return p.isGaugeType(L.data)?Math.round(p.radius/5)+"px":""}).transition().duration(e).style("opacity",function(L){return p.isTargetToShow(L.data.id)&&p.isArcType(L.data)?1:0}),T.select("."+P.chartArcsTitle).style("opacity",p.hasType("donut")||p.hasType("gauge")?1:0),p.hasType("gauge")&&(p.arcs.select("."+P.chartArcsBackground).attr("d",function(){var L={data:[{value:_.gauge_max}],startAngle:_.gauge_startingAngle,endAngle:-1*_.gauge_startingAngle};return p.getArc(L,!0,!0)}),p.arcs.select("."+P.chartArcsGaugeUnit).attr("dy",".75em").text(_.gauge_label_show?_.gauge_units:""),p.arcs.select("."+P.chartArcsGaugeMin).attr("dx",-1*(p.innerRadius+(p.radius-p.innerRadius)/(_.gauge_fullCircle?1:2))+"px").attr("dy","1.2em").text(_.gauge_label_show?p.textForGaugeMinMax(_.gauge_min,!1):""),p.arcs.select("."+P.chartArcsGaugeMax).attr("dx",p.innerRadius+(p.radius-p.innerRadius)/(_.gauge_fullCircle?1:2)+"px").attr("dy","1.2em").text(_.gauge_label_show?p.textForGaugeMinMax(_.gauge_max,!0):""))},w.initGauge=function(){var e=this.arcs;this.hasType("gauge")&&(e.append("path").attr("class",P.chartArcsBackground),e.append("text").attr("class",P.chartArcsGaugeUnit).style("text-anchor","middle").style("pointer-events","none"),e.append("text").attr("class",P.chartArcsGaugeMin).style("text-anchor","middle").style("pointer-events","none"),e.append("text").attr("class",P.chartArcsGaugeMax).style("text-anchor","middle").style("pointer-events","none"))},w.getGaugeLabelHeight=function(){return this.config.gauge_label_show?20:0},w.hasCaches=function(e){for(var n=0;n<e.length;n++)if(!(e[n]in this.cache))return!1;return!0},w.addCache=function(e,n){this.cache[e]=this.cloneTarget(n)},w.getCaches=function(e){var n,o=[];for(n=0;n<e.length;n++)e[n]in this.cache&&o.push(this.cloneTarget(this.cache[e[n]]));return o},w.categoryName=function(e){var n=this.config;return e<n.axis_x_categories.length?n.axis_x_categories[e]:e},w.generateClass=function(e,n){return" "+e+" "+e+this.getTargetSelectorSuffix(n)},w.classText=function(e){return this.generateClass(P.text,e.index)},w.classTexts=function(e){return this.generateClass(P.texts,e.id)},w.classShape=function(e){return this.generateClass(P.shape,e.index)},w.classShapes=function(e){return this.generateClass(P.shapes,e.id)},w.classLine=function(e){return this.classShape(e)+this.generateClass(P.line,e.id)},w.classLines=function(e){return this.classShapes(e)+this.generateClass(P.lines,e.id)},w.classCircle=function(e){return this.classShape(e)+this.generateClass(P.circle,e.index)},w.classCircles=function(e){return this.classShapes(e)+this.generateClass(P.circles,e.id)},w.classBar=function(e){return this.classShape(e)+this.generateClass(P.bar,e.index)},w.classBars=function(e){return this.classShapes(e)+this.generateClass(P.bars,e.id)},w.classArc=function(e){return this.classShape(e.data)+this.generateClass(P.arc,e.data.id)},w.classArcs=function(e){return this.classShapes(e.data)+this.generateClass(P.arcs,e.data.id)},w.classArea=function(e){return this.classShape(e)+this.generateClass(P.area,e.id)},w.classAreas=function(e){return this.classShapes(e)+this.generateClass(P.areas,e.id)},w.classRegion=function(e,n){return this.generateClass(P.region,n)+" "+("class"in e?e.class:"")},w.classEvent=function(e){return this.generateClass(P.eventRect,e.index)},w.classTarget=function(e){var n=this.config.data_classes[e],o="";return n&&(o=" "+P.target+"-"+n),this.generateClass(P.target,e)+o},w.classFocus=function(e){return this.classFocused(e)+this.classDefocused(e)},w.classFocused=function(e){return" "+(this.focusedTargetIds.indexOf(e.id)>=0?P.focused:"")},w.classDefocused=function(e){return" "+(this.defocusedTargetIds.indexOf(e.id)>=0?P.defocused:"")},w.classChartText=function(e){return P.chartText+this.classTarget(e.id)},w.classChartLine=function(e){return P.chartLine+this.classTarget(e.id)},w.classChartBar=function(e){return P.chartBar+this.classTarget(e.id)},w.classChartArc=function(e){return P.chartArc+this.classTarget(e.data.id)},w.getTargetSelectorSuffix=function(e){return e||e===0?("-"+e).replace(/[\s?!@#$%^&*()_=+,.<>'":;\[\]\/|~`{}\\]/g,"-"):""},w.selectorTarget=function(e,n){return(n||"")+"."+P.target+this.getTargetSelectorSuffix(e)},w.selectorTargets=function(e,n){var o=this;return(e=e||[]).length?e.map(function(l){return o.selectorTarget(l,n)}):null},w.selectorLegend=function(e){return"."+P.legendItem+this.getTargetSelectorSuffix(e)},w.selectorLegends=function(e){var n=this;return e&&e.length?e.map(function(o){return n.selectorLegend(o)}):null},w.getClipPath=function(e){return"url("+(window.navigator.appVersion.toLowerCase().indexOf("msie 9.")>=0?"":document.URL.split("#")[0])+"#"+e+")"},w.appendClip=function(e,n){return e.append("clipPath").attr("id",n).append("rect")},w.getAxisClipX=function(e){var n=Math.max(30,this.margin.left);return e?-(1+n):-(n-1)},w.getAxisClipY=function(e){return e?-20:-this.margin.top},w.getXAxisClipX=function(){return this.getAxisClipX(!this.config.axis_rotated)},w.getXAxisClipY=function(){return this.getAxisClipY(!this.config.axis_rotated)},w.getYAxisClipX=function(){return this.config.axis_y_inner?-1:this.getAxisClipX(this.config.axis_rotated)},w.getYAxisClipY=function(){return this.getAxisClipY(this.config.axis_rotated)},w.getAxisClipWidth=function(e){var n=Math.max(30,this.margin.left),o=Math.max(30,this.margin.right);return e?this.width+2+n+o:this.margin.left+20},w.getAxisClipHeight=function(e){return(e?this.margin.bottom:this.margin.top+this.height)+20},w.getXAxisClipWidth=function(){return this.getAxisClipWidth(!this.config.axis_rotated)},w.getXAxisClipHeight=function(){return this.getAxisClipHeight(!this.config.axis_rotated)},w.getYAxisClipWidth=function(){return this.getAxisClipWidth(this.config.axis_rotated)+(this.config.axis_y_inner?20:0)},w.getYAxisClipHeight=function(){return this.getAxisClipHeight(this.config.axis_rotated)},w.generateColor=function(){var e=this.config,n=this.d3,o=e.data_colors,l=Ct(e.color_pattern)?e.color_pattern:n.scale.category10().range(),p=e.data_color,y=[];return function(_){var T,L=_.id||_.data&&_.data.id||_;return o[L]instanceof Function?T=o[L](_):o[L]?T=o[L]:(y.indexOf(L)<0&&y.push(L),T=l[y.indexOf(L)%l.length],o[L]=T),p instanceof Function?p(T,_):T}},w.generateLevelColor=function(){var e=this.config,n=e.color_pattern,o=e.color_threshold,l=o.unit==="value",p=o.values&&o.values.length?o.values:[],y=o.max||100;return Ct(e.color_threshold)?function(_){var T,L=n[n.length-1];for(T=0;T<p.length;T++)if((l?_:100*_/y)<p[T]){L=n[T];break}return L}:null},w.getDefaultConfig=function(){var e={bindto:"#chart",svg_classname:void 0,size_width:void 0,size_height:void 0,padding_left:void 0,padding_right:void 0,padding_top:void 0,padding_bottom:void 0,resize_auto:!0,zoom_enabled:!1,zoom_extent:void 0,zoom_privileged:!1,zoom_rescale:!1,zoom_onzoom:function(){},zoom_onzoomstart:function(){},zoom_onzoomend:function(){},zoom_x_min:void 0,zoom_x_max:void 0,interaction_brighten:!0,interaction_enabled:!0,onmouseover:function(){},onmouseout:function(){},onresize:function(){},onresized:function(){},oninit:function(){},onrendered:function(){},transition_duration:350,data_x:void 0,data_xs:{},data_xFormat:"%Y-%m-%d",data_xLocaltime:!0,data_xSort:!0,data_idConverter:function(n){return n},data_names:{},data_classes:{},data_groups:[],data_axes:{},data_type:void 0,data_types:{},data_labels:{},data_order:"desc",data_regions:{},data_color:void 0,data_colors:{},data_hide:!1,data_filter:void 0,data_selection_enabled:!1,data_selection_grouped:!1,data_selection_isselectable:function(){return!0},data_selection_multiple:!0,data_selection_draggable:!1,data_onclick:function(){},data_onmouseover:function(){},data_onmouseout:function(){},data_onselected:function(){},data_onunselected:function(){},data_url:void 0,data_headers:void 0,data_json:void 0,data_rows:void 0,data_columns:void 0,data_mimeType:void 0,data_keys:void 0,data_empty_label_text:"",subchart_show:!1,subchart_size_height:60,subchart_axis_x_show:!0,subchart_onbrush:function(){},color_pattern:[],color_threshold:{},legend_show:!0,legend_hide:!1,legend_position:"bottom",legend_inset_anchor:"top-left",legend_inset_x:10,legend_inset_y:0,legend_inset_step:void 0,legend_item_onclick:void 0,legend_item_onmouseover:void 0,legend_item_onmouseout:void 0,legend_equally:!1,legend_padding:0,legend_item_tile_width:10,legend_item_tile_height:10,axis_rotated:!1,axis_x_show:!0,axis_x_type:"indexed",axis_x_localtime:!0,axis_x_categories:[],axis_x_tick_centered:!1,axis_x_tick_format:void 0,axis_x_tick_culling:{},axis_x_tick_culling_max:10,axis_x_tick_count:void 0,axis_x_tick_fit:!0,axis_x_tick_values:null,axis_x_tick_rotate:0,axis_x_tick_outer:!0,axis_x_tick_multiline:!0,axis_x_tick_width:null,axis_x_max:void 0,axis_x_min:void 0,axis_x_padding:{},axis_x_height:void 0,axis_x_extent:void 0,axis_x_label:{},axis_y_show:!0,axis_y_type:void 0,axis_y_max:void 0,axis_y_min:void 0,axis_y_inverted:!1,axis_y_center:void 0,axis_y_inner:void 0,axis_y_label:{},axis_y_tick_format:void 0,axis_y_tick_outer:!0,axis_y_tick_values:null,axis_y_tick_rotate:0,axis_y_tick_count:void 0,axis_y_tick_time_value:void 0,axis_y_tick_time_interval:void 0,axis_y_padding:{},axis_y_default:void 0,axis_y2_show:!1,axis_y2_max:void 0,axis_y2_min:void 0,axis_y2_inverted:!1,axis_y2_center:void 0,axis_y2_inner:void 0,axis_y2_label:{},axis_y2_tick_format:void 0,axis_y2_tick_outer:!0,axis_y2_tick_values:null,axis_y2_tick_count:void 0,axis_y2_padding:{},axis_y2_default:void 0,grid_x_show:!1,grid_x_type:"tick",grid_x_lines:[],grid_y_show:!1,grid_y_lines:[],grid_y_ticks:10,grid_focus_show:!0,grid_lines_front:!0,point_show:!0,point_r:2.5,point_sensitivity:10,point_focus_expand_enabled:!0,point_focus_expand_r:void 0,point_select_r:void 0,line_connectNull:!1,line_step_type:"step",bar_width:void 0,bar_width_ratio:.6,bar_width_max:void 0,bar_zerobased:!0,bar_space:0,area_zerobased:!0,area_above:!1,pie_label_show:!0,pie_label_format:void 0,pie_label_threshold:.05,pie_label_ratio:void 0,pie_expand:{},pie_expand_duration:50,gauge_fullCircle:!1,gauge_label_show:!0,gauge_label_format:void 0,gauge_min:0,gauge_max:100,gauge_startingAngle:-1*Math.PI/2,gauge_label_extents:void 0,gauge_units:void 0,gauge_width:void 0,gauge_expand:{},gauge_expand_duration:50,donut_label_show:!0,donut_label_format:void 0,donut_label_threshold:.05,donut_label_ratio:void 0,donut_width:void 0,donut_title:"",donut_expand:{},donut_expand_duration:50,spline_interpolation_type:"cardinal",regions:[],tooltip_show:!0,tooltip_grouped:!0,tooltip_order:void 0,tooltip_format_title:void 0,tooltip_format_name:void 0,tooltip_format_value:void 0,tooltip_position:void 0,tooltip_contents:function(n,o,l,p){return this.getTooltipContent?this.getTooltipContent(n,o,l,p):""},tooltip_init_show:!1,tooltip_init_x:0,tooltip_init_position:{top:"0px",left:"50px"},tooltip_onshow:function(){},tooltip_onhide:function(){},title_text:void 0,title_padding:{top:0,right:0,bottom:0,left:0},title_position:"top-center"};return Object.keys(this.additionalConfig).forEach(function(n){e[n]=this.additionalConfig[n]},this),e},w.additionalConfig={},w.loadConfig=function(e){function n(){var _=l.shift();return _&&o&&(o===void 0?"undefined":Zt(o))==="object"&&_ in o?(o=o[_],n()):_?void 0:o}var o,l,p,y=this.config;Object.keys(y).forEach(function(_){o=e,l=_.split("_"),p=n(),nt(p)&&(y[_]=p)})},w.convertUrlToData=function(e,n,o,l,p){var y=this,_=n||"csv",T=y.d3.xhr(e);o&&Object.keys(o).forEach(function(L){T.header(L,o[L])}),T.get(function(L,V){var F,q=V.response||V.responseText;if(!V)throw new Error(L.responseURL+" "+L.status+" ("+L.statusText+")");F=_==="json"?y.convertJsonToData(JSON.parse(q),l):_==="tsv"?y.convertTsvToData(q):y.convertCsvToData(q),p.call(y,F)})},w.convertXsvToData=function(e,n){var o,l=n.parseRows(e);return l.length===1?(o=[{}],l[0].forEach(function(p){o[0][p]=null})):o=n.parse(e),o},w.convertCsvToData=function(e){return this.convertXsvToData(e,this.d3.csv)},w.convertTsvToData=function(e){return this.convertXsvToData(e,this.d3.tsv)},w.convertJsonToData=function(e,n){var o,l,p=this,y=[];return n?(n.x?(o=n.value.concat(n.x),p.config.data_x=n.x):o=n.value,y.push(o),e.forEach(function(_){var T=[];o.forEach(function(L){var V=p.findValueInJson(_,L);Lt(V)&&(V=null),T.push(V)}),y.push(T)}),l=p.convertRowsToData(y)):(Object.keys(e).forEach(function(_){y.push([_].concat(e[_]))}),l=p.convertColumnsToData(y)),l},w.findValueInJson=function(e,n){for(var o=(n=(n=n.replace(/\[(\w+)\]/g,".$1")).replace(/^\./,"")).split("."),l=0;l<o.length;++l){var p=o[l];if(!(p in e))return;e=e[p]}return e},w.convertRowsToData=function(e){for(var n=[],o=e[0],l=1;l<e.length;l++){for(var p={},y=0;y<e[l].length;y++){if(Lt(e[l][y]))throw new Error("Source data is missing a component at ("+l+","+y+")!");p[o[y]]=e[l][y]}n.push(p)}return n},w.convertColumnsToData=function(e){for(var n=[],o=0;o<e.length;o++)for(var l=e[o][0],p=1;p<e[o].length;p++){if(Lt(n[p-1])&&(n[p-1]={}),Lt(e[o][p]))throw new Error("Source data is missing a component at ("+o+","+p+")!");n[p-1][l]=e[o][p]}return n},w.convertDataToTargets=function(e,n){var o,l=this,p=l.config,y=l.d3.keys(e[0]).filter(l.isNotX,l),_=l.d3.keys(e[0]).filter(l.isX,l);return y.forEach(function(T){var L=l.getXKey(T);l.isCustomX()||l.isTimeSeries()?_.indexOf(L)>=0?l.data.xs[T]=(n&&l.data.xs[T]?l.data.xs[T]:[]).concat(e.map(function(V){return V[L]}).filter(jt).map(function(V,F){return l.generateTargetX(V,T,F)})):p.data_x?l.data.xs[T]=l.getOtherTargetXs():Ct(p.data_xs)&&(l.data.xs[T]=l.getXValuesOfXKey(L,l.data.targets)):l.data.xs[T]=e.map(function(V,F){return F})}),y.forEach(function(T){if(!l.data.xs[T])throw new Error('x is not defined for id = "'+T+'".')}),(o=y.map(function(T,L){var V=p.data_idConverter(T);return{id:V,id_org:T,values:e.map(function(F,q){var ot,lt=F[l.getXKey(T)],ht=F[T]===null||isNaN(F[T])?null:+F[T];return l.isCustomX()&&l.isCategorized()&&!Lt(lt)?(L===0&&q===0&&(p.axis_x_categories=[]),(ot=p.axis_x_categories.indexOf(lt))===-1&&(ot=p.axis_x_categories.length,p.axis_x_categories.push(lt))):ot=l.generateTargetX(lt,T,q),(Lt(F[T])||l.data.xs[T].length<=q)&&(ot=void 0),{x:ot,value:ht,id:V}}).filter(function(F){return nt(F.x)})}})).forEach(function(T){var L;p.data_xSort&&(T.values=T.values.sort(function(V,F){return(V.x||V.x===0?V.x:1/0)-(F.x||F.x===0?F.x:1/0)})),L=0,T.values.forEach(function(V){V.index=L++}),l.data.xs[T.id].sort(function(V,F){return V-F})}),l.hasNegativeValue=l.hasNegativeValueInTargets(o),l.hasPositiveValue=l.hasPositiveValueInTargets(o),p.data_type&&l.setTargetType(l.mapToIds(o).filter(function(T){return!(T in p.data_types)}),p.data_type),o.forEach(function(T){l.addCache(T.id_org,T)}),o},w.isX=function(e){var n=this.config;return n.data_x&&e===n.data_x||Ct(n.data_xs)&&Q(n.data_xs,e)},w.isNotX=function(e){return!this.isX(e)},w.getXKey=function(e){var n=this.config;return n.data_x?n.data_x:Ct(n.data_xs)?n.data_xs[e]:null},w.getXValuesOfXKey=function(e,n){var o,l=this;return(n&&Ct(n)?l.mapToIds(n):[]).forEach(function(p){l.getXKey(p)===e&&(o=l.data.xs[p])}),o},w.getIndexByX=function(e){var n=this.filterByX(this.data.targets,e);return n.length?n[0].index:null},w.getXValue=function(e,n){return e in this.data.xs&&this.data.xs[e]&&jt(this.data.xs[e][n])?this.data.xs[e][n]:n},w.getOtherTargetXs=function(){var e=Object.keys(this.data.xs);return e.length?this.data.xs[e[0]]:null},w.getOtherTargetX=function(e){var n=this.getOtherTargetXs();return n&&e<n.length?n[e]:null},w.addXs=function(e){var n=this;Object.keys(e).forEach(function(o){n.config.data_xs[o]=e[o]})},w.hasMultipleX=function(e){return this.d3.set(Object.keys(e).map(function(n){return e[n]})).size()>1},w.isMultipleX=function(){return Ct(this.config.data_xs)||!this.config.data_xSort||this.hasType("scatter")},w.addName=function(e){var n;return e&&(n=this.config.data_names[e.id],e.name=n!==void 0?n:e.id),e},w.getValueOnIndex=function(e,n){var o=e.filter(function(l){return l.index===n});return o.length?o[0]:null},w.updateTargetX=function(e,n){var o=this;e.forEach(function(l){l.values.forEach(function(p,y){p.x=o.generateTargetX(n[y],l.id,y)}),o.data.xs[l.id]=n})},w.updateTargetXs=function(e,n){var o=this;e.forEach(function(l){n[l.id]&&o.updateTargetX([l],n[l.id])})},w.generateTargetX=function(e,n,o){return this.isTimeSeries()?e?this.parseDate(e):this.parseDate(this.getXValue(n,o)):this.isCustomX()&&!this.isCategorized()?jt(e)?+e:this.getXValue(n,o):o},w.cloneTarget=function(e){return{id:e.id,id_org:e.id_org,values:e.values.map(function(n){return{x:n.x,value:n.value,id:n.id}})}},w.updateXs=function(){var e=this;e.data.targets.length&&(e.xs=[],e.data.targets[0].values.forEach(function(n){e.xs[n.index]=n.x}))},w.getPrevX=function(e){var n=this.xs[e-1];return n!==void 0?n:null},w.getNextX=function(e){var n=this.xs[e+1];return n!==void 0?n:null},w.getMaxDataCount=function(){return this.d3.max(this.data.targets,function(e){return e.values.length})},w.getMaxDataCountTarget=function(e){var n,o=e.length,l=0;return o>1?e.forEach(function(p){p.values.length>l&&(n=p,l=p.values.length)}):n=o?e[0]:null,n},w.getEdgeX=function(e){return e.length?[this.d3.min(e,function(n){return n.values[0].x}),this.d3.max(e,function(n){return n.values[n.values.length-1].x})]:[0,0]},w.mapToIds=function(e){return e.map(function(n){return n.id})},w.mapToTargetIds=function(e){return e?[].concat(e):this.mapToIds(this.data.targets)},w.hasTarget=function(e,n){var o,l=this.mapToIds(e);for(o=0;o<l.length;o++)if(l[o]===n)return!0;return!1},w.isTargetToShow=function(e){return this.hiddenTargetIds.indexOf(e)<0},w.isLegendToShow=function(e){return this.hiddenLegendIds.indexOf(e)<0},w.filterTargetsToShow=function(e){var n=this;return e.filter(function(o){return n.isTargetToShow(o.id)})},w.mapTargetsToUniqueXs=function(e){var n=this.d3.set(this.d3.merge(e.map(function(o){return o.values.map(function(l){return+l.x})}))).values();return(n=this.isTimeSeries()?n.map(function(o){return new Date(+o)}):n.map(function(o){return+o})).sort(function(o,l){return o<l?-1:o>l?1:o>=l?0:NaN})},w.addHiddenTargetIds=function(e){e=e instanceof Array?e:new Array(e);for(var n=0;n<e.length;n++)this.hiddenTargetIds.indexOf(e[n])<0&&(this.hiddenTargetIds=this.hiddenTargetIds.concat(e[n]))},w.removeHiddenTargetIds=function(e){this.hiddenTargetIds=this.hiddenTargetIds.filter(function(n){return e.indexOf(n)<0})},w.addHiddenLegendIds=function(e){e=e instanceof Array?e:new Array(e);for(var n=0;n<e.length;n++)this.hiddenLegendIds.indexOf(e[n])<0&&(this.hiddenLegendIds=this.hiddenLegendIds.concat(e[n]))},w.removeHiddenLegendIds=function(e){this.hiddenLegendIds=this.hiddenLegendIds.filter(function(n){return e.indexOf(n)<0})},w.getValuesAsIdKeyed=function(e){var n={};return e.forEach(function(o){n[o.id]=[],o.values.forEach(function(l){n[o.id].push(l.value)})}),n},w.checkValueInTargets=function(e,n){var o,l,p,y=Object.keys(e);for(o=0;o<y.length;o++)for(p=e[y[o]].values,l=0;l<p.length;l++)if(n(p[l].value))return!0;return!1},w.hasNegativeValueInTargets=function(e){return this.checkValueInTargets(e,function(n){return n<0})},w.hasPositiveValueInTargets=function(e){return this.checkValueInTargets(e,function(n){return n>0})},w.isOrderDesc=function(){var e=this.config;return typeof e.data_order=="string"&&e.data_order.toLowerCase()==="desc"},w.isOrderAsc=function(){var e=this.config;return typeof e.data_order=="string"&&e.data_order.toLowerCase()==="asc"},w.getOrderFunction=function(){var e=this.config,n=this.isOrderAsc(),o=this.isOrderDesc();if(n||o)return function(p,y){var _=function(V,F){return V+Math.abs(F.value)},T=p.values.reduce(_,0),L=y.values.reduce(_,0);return o?L-T:T-L};if(Ht(e.data_order))return e.data_order;if(Ut(e.data_order)){var l=e.data_order;return function(p,y){return l.indexOf(p.id)-l.indexOf(y.id)}}},w.orderTargets=function(e){var n=this.getOrderFunction();return n&&(e.sort(n),(this.isOrderAsc()||this.isOrderDesc())&&e.reverse()),e},w.filterByX=function(e,n){return this.d3.merge(e.map(function(o){return o.values})).filter(function(o){return o.x-n==0})},w.filterRemoveNull=function(e){return e.filter(function(n){return jt(n.value)})},w.filterByXDomain=function(e,n){return e.map(function(o){return{id:o.id,id_org:o.id_org,values:o.values.filter(function(l){return n[0]<=l.x&&l.x<=n[1]})}})},w.hasDataLabel=function(){var e=this.config;return!(typeof e.data_labels!="boolean"||!e.data_labels)||!(Zt(e.data_labels)!=="object"||!Ct(e.data_labels))},w.getDataLabelLength=function(e,n,o){var l=this,p=[0,0];return l.selectChart.select("svg").selectAll(".dummy").data([e,n]).enter().append("text").text(function(y){return l.dataLabelFormat(y.id)(y)}).each(function(y,_){p[_]=1.3*this.getBoundingClientRect()[o]}).remove(),p},w.isNoneArc=function(e){return this.hasTarget(this.data.targets,e.id)},w.isArc=function(e){return"data"in e&&this.hasTarget(this.data.targets,e.data.id)},w.findSameXOfValues=function(e,n){var o,l=e[n].x,p=[];for(o=n-1;o>=0&&l===e[o].x;o--)p.push(e[o]);for(o=n;o<e.length&&l===e[o].x;o++)p.push(e[o]);return p},w.findClosestFromTargets=function(e,n){var o,l=this;return o=e.map(function(p){return l.findClosest(p.values,n)}),l.findClosest(o,n)},w.findClosest=function(e,n){var o,l=this,p=l.config.point_sensitivity;return e.filter(function(y){return y&&l.isBarType(y.id)}).forEach(function(y){var _=l.main.select("."+P.bars+l.getTargetSelectorSuffix(y.id)+" ."+P.bar+"-"+y.index).node();!o&&l.isWithinBar(_)&&(o=y)}),e.filter(function(y){return y&&!l.isBarType(y.id)}).forEach(function(y){var _=l.dist(y,n);_<p&&(p=_,o=y)}),o},w.dist=function(e,n){var o=this.config,l=o.axis_rotated?1:0,p=o.axis_rotated?0:1,y=this.circleY(e,e.index),_=this.x(e.x);return Math.sqrt(Math.pow(_-n[l],2)+Math.pow(y-n[p],2))},w.convertValuesToStep=function(e){var n,o=[].concat(e);if(!this.isCategorized())return e;for(n=e.length+1;0<n;n--)o[n]=o[n-1];return o[0]={x:o[0].x-1,value:o[0].value,id:o[0].id},o[e.length+1]={x:o[e.length].x+1,value:o[e.length].value,id:o[e.length].id},o},w.updateDataAttributes=function(e,n){var o=this.config["data_"+e];return n===void 0||(Object.keys(n).forEach(function(l){o[l]=n[l]}),this.redraw({withLegend:!0})),o},w.load=function(e,n){var o=this;e&&(n.filter&&(e=e.filter(n.filter)),(n.type||n.types)&&e.forEach(function(l){var p=n.types&&n.types[l.id]?n.types[l.id]:n.type;o.setTargetType(l.id,p)}),o.data.targets.forEach(function(l){for(var p=0;p<e.length;p++)if(l.id===e[p].id){l.values=e[p].values,e.splice(p,1);break}}),o.data.targets=o.data.targets.concat(e)),o.updateTargets(o.data.targets),o.redraw({withUpdateOrgXDomain:!0,withUpdateXDomain:!0,withLegend:!0}),n.done&&n.done()},w.loadFromArgs=function(e){var n=this;e.data?n.load(n.convertDataToTargets(e.data),e):e.url?n.convertUrlToData(e.url,e.mimeType,e.headers,e.keys,function(o){n.load(n.convertDataToTargets(o),e)}):e.json?n.load(n.convertDataToTargets(n.convertJsonToData(e.json,e.keys)),e):e.rows?n.load(n.convertDataToTargets(n.convertRowsToData(e.rows)),e):e.columns?n.load(n.convertDataToTargets(n.convertColumnsToData(e.columns)),e):n.load(null,e)},w.unload=function(e,n){var o=this;n||(n=function(){}),(e=e.filter(function(l){return o.hasTarget(o.data.targets,l)}))&&e.length!==0?(o.svg.selectAll(e.map(function(l){return o.selectorTarget(l)})).transition().style("opacity",0).remove().call(o.endall,n),e.forEach(function(l){o.withoutFadeIn[l]=!1,o.legend&&o.legend.selectAll("."+P.legendItem+o.getTargetSelectorSuffix(l)).remove(),o.data.targets=o.data.targets.filter(function(p){return p.id!==l})})):n()},w.getYDomainMin=function(e){var n,o,l,p,y,_,T=this,L=T.config,V=T.mapToIds(e),F=T.getValuesAsIdKeyed(e);if(L.data_groups.length>0){for(_=T.hasNegativeValueInTargets(e),n=0;n<L.data_groups.length;n++)if((p=L.data_groups[n].filter(function(q){return V.indexOf(q)>=0})).length!==0)for(l=p[0],_&&F[l]&&F[l].forEach(function(q,ot){F[l][ot]=q<0?q:0}),o=1;o<p.length;o++)y=p[o],F[y]&&F[y].forEach(function(q,ot){T.axis.getId(y)!==T.axis.getId(l)||!F[l]||_&&+q>0||(F[l][ot]+=+q)})}return T.d3.min(Object.keys(F).map(function(q){return T.d3.min(F[q])}))},w.getYDomainMax=function(e){var n,o,l,p,y,_,T=this,L=T.config,V=T.mapToIds(e),F=T.getValuesAsIdKeyed(e);if(L.data_groups.length>0){for(_=T.hasPositiveValueInTargets(e),n=0;n<L.data_groups.length;n++)if((p=L.data_groups[n].filter(function(q){return V.indexOf(q)>=0})).length!==0)for(l=p[0],_&&F[l]&&F[l].forEach(function(q,ot){F[l][ot]=q>0?q:0}),o=1;o<p.length;o++)y=p[o],F[y]&&F[y].forEach(function(q,ot){T.axis.getId(y)!==T.axis.getId(l)||!F[l]||_&&+q<0||(F[l][ot]+=+q)})}return T.d3.max(Object.keys(F).map(function(q){return T.d3.max(F[q])}))},w.getYDomain=function(e,n,o){var l,p,y,_,T,L,V,F,q,ot,lt=this,ht=lt.config,kt=e.filter(function(zt){return lt.axis.getId(zt.id)===n}),Rt=o?lt.filterByXDomain(kt,o):kt,qt=n==="y2"?ht.axis_y2_min:ht.axis_y_min,Jt=n==="y2"?ht.axis_y2_max:ht.axis_y_max,Yt=lt.getYDomainMin(Rt),j=lt.getYDomainMax(Rt),tt=n==="y2"?ht.axis_y2_center:ht.axis_y_center,Ot=lt.hasType("bar",Rt)&&ht.bar_zerobased||lt.hasType("area",Rt)&&ht.area_zerobased,xt=n==="y2"?ht.axis_y2_inverted:ht.axis_y_inverted,St=lt.hasDataLabel()&&ht.axis_rotated,re=lt.hasDataLabel()&&!ht.axis_rotated;return Yt=jt(qt)?qt:jt(Jt)?Yt<Jt?Yt:Jt-10:Yt,j=jt(Jt)?Jt:jt(qt)?qt<j?j:qt+10:j,Rt.length===0?n==="y2"?lt.y2.domain():lt.y.domain():(isNaN(Yt)&&(Yt=0),isNaN(j)&&(j=Yt),Yt===j&&(Yt<0?j=0:Yt=0),q=Yt>=0&&j>=0,ot=Yt<=0&&j<=0,(jt(qt)&&q||jt(Jt)&&ot)&&(Ot=!1),Ot&&(q&&(Yt=0),ot&&(j=0)),p=Math.abs(j-Yt),y=_=.1*p,tt!==void 0&&(j=tt+(T=Math.max(Math.abs(Yt),Math.abs(j))),Yt=tt-T),St?(L=lt.getDataLabelLength(Yt,j,"width"),V=ut(lt.y.range()),y+=p*((F=[L[0]/V,L[1]/V])[1]/(1-F[0]-F[1])),_+=p*(F[0]/(1-F[0]-F[1]))):re&&(L=lt.getDataLabelLength(Yt,j,"height"),y+=lt.axis.convertPixelsToAxisPadding(L[1],p),_+=lt.axis.convertPixelsToAxisPadding(L[0],p)),n==="y"&&Ct(ht.axis_y_padding)&&(y=lt.axis.getPadding(ht.axis_y_padding,"top",y,p),_=lt.axis.getPadding(ht.axis_y_padding,"bottom",_,p)),n==="y2"&&Ct(ht.axis_y2_padding)&&(y=lt.axis.getPadding(ht.axis_y2_padding,"top",y,p),_=lt.axis.getPadding(ht.axis_y2_padding,"bottom",_,p)),Ot&&(q&&(_=Yt),ot&&(y=-j)),l=[Yt-_,j+y],xt?l.reverse():l)},w.getXDomainMin=function(e){var n=this,o=n.config;return nt(o.axis_x_min)?n.isTimeSeries()?this.parseDate(o.axis_x_min):o.axis_x_min:n.d3.min(e,function(l){return n.d3.min(l.values,function(p){return p.x})})},w.getXDomainMax=function(e){var n=this,o=n.config;return nt(o.axis_x_max)?n.isTimeSeries()?this.parseDate(o.axis_x_max):o.axis_x_max:n.d3.max(e,function(l){return n.d3.max(l.values,function(p){return p.x})})},w.getXDomainPadding=function(e){var n,o,l,p,y=this.config,_=e[1]-e[0];return o=this.isCategorized()?0:this.hasType("bar")?(n=this.getMaxDataCount())>1?_/(n-1)/2:.5:.01*_,Zt(y.axis_x_padding)==="object"&&Ct(y.axis_x_padding)?(l=jt(y.axis_x_padding.left)?y.axis_x_padding.left:o,p=jt(y.axis_x_padding.right)?y.axis_x_padding.right:o):l=p=typeof y.axis_x_padding=="number"?y.axis_x_padding:o,{left:l,right:p}},w.getXDomain=function(e){var n=[this.getXDomainMin(e),this.getXDomainMax(e)],o=n[0],l=n[1],p=this.getXDomainPadding(n),y=0,_=0;return o-l!=0||this.isCategorized()||(this.isTimeSeries()?(o=new Date(.5*o.getTime()),l=new Date(1.5*l.getTime())):(o=o===0?1:.5*o,l=l===0?-1:1.5*l)),(o||o===0)&&(y=this.isTimeSeries()?new Date(o.getTime()-p.left):o-p.left),(l||l===0)&&(_=this.isTimeSeries()?new Date(l.getTime()+p.right):l+p.right),[y,_]},w.updateXDomain=function(e,n,o,l,p){var y=this.config;return o&&(this.x.domain(p||this.d3.extent(this.getXDomain(e))),this.orgXDomain=this.x.domain(),y.zoom_enabled&&this.zoom.scale(this.x).updateScaleExtent(),this.subX.domain(this.x.domain()),this.brush&&this.brush.scale(this.subX)),n&&(this.x.domain(p||(!this.brush||this.brush.empty()?this.orgXDomain:this.brush.extent())),y.zoom_enabled&&this.zoom.scale(this.x).updateScaleExtent()),l&&this.x.domain(this.trimXDomain(this.x.orgDomain())),this.x.domain()},w.trimXDomain=function(e){var n=this.getZoomDomain(),o=n[0],l=n[1];return e[0]<=o&&(e[1]=+e[1]+(o-e[0]),e[0]=o),l<=e[1]&&(e[0]=+e[0]-(e[1]-l),e[1]=l),e},w.drag=function(e){var n,o,l,p,y,_,T,L,V=this,F=V.config,q=V.main,ot=V.d3;V.hasArcType()||F.data_selection_enabled&&(F.zoom_enabled&&!V.zoom.altDomain||F.data_selection_multiple&&(n=V.dragStart[0],o=V.dragStart[1],l=e[0],p=e[1],y=Math.min(n,l),_=Math.max(n,l),T=F.data_selection_grouped?V.margin.top:Math.min(o,p),L=F.data_selection_grouped?V.height:Math.max(o,p),q.select("."+P.dragarea).attr("x",y).attr("y",T).attr("width",_-y).attr("height",L-T),q.selectAll("."+P.shapes).selectAll("."+P.shape).filter(function(lt){return F.data_selection_isselectable(lt)}).each(function(lt,ht){var kt,Rt,qt,Jt,Yt,j,tt=ot.select(this),Ot=tt.classed(P.SELECTED),xt=tt.classed(P.INCLUDED),St=!1;if(tt.classed(P.circle))kt=1*tt.attr("cx"),Rt=1*tt.attr("cy"),Yt=V.togglePoint,St=y<kt&&kt<_&&T<Rt&&Rt<L;else{if(!tt.classed(P.bar))return;kt=(j=Xt(this)).x,Rt=j.y,qt=j.width,Jt=j.height,Yt=V.togglePath,St=!(_<kt||kt+qt<y||L<Rt||Rt+Jt<T)}St^xt&&(tt.classed(P.INCLUDED,!xt),tt.classed(P.SELECTED,!Ot),Yt.call(V,!Ot,tt,lt,ht))})))},w.dragstart=function(e){var n=this.config;this.hasArcType()||n.data_selection_enabled&&(this.dragStart=e,this.main.select("."+P.chart).append("rect").attr("class",P.dragarea).style("opacity",.1),this.dragging=!0)},w.dragend=function(){var e=this.config;this.hasArcType()||e.data_selection_enabled&&(this.main.select("."+P.dragarea).transition().duration(100).style("opacity",0).remove(),this.main.selectAll("."+P.shape).classed(P.INCLUDED,!1),this.dragging=!1)},w.getYFormat=function(e){var n=this,o=e&&!n.hasType("gauge")?n.defaultArcValueFormat:n.yFormat,l=e&&!n.hasType("gauge")?n.defaultArcValueFormat:n.y2Format;return function(p,y,_){return(n.axis.getId(_)==="y2"?l:o).call(n,p,y)}},w.yFormat=function(e){var n=this.config;return(n.axis_y_tick_format?n.axis_y_tick_format:this.defaultValueFormat)(e)},w.y2Format=function(e){var n=this.config;return(n.axis_y2_tick_format?n.axis_y2_tick_format:this.defaultValueFormat)(e)},w.defaultValueFormat=function(e){return jt(e)?+e:""},w.defaultArcValueFormat=function(e,n){return(100*n).toFixed(1)+"%"},w.dataLabelFormat=function(e){var n=this.config.data_labels,o=function(l){return jt(l)?+l:""};return typeof n.format=="function"?n.format:Zt(n.format)==="object"?n.format[e]?n.format[e]===!0?o:n.format[e]:function(){return""}:o},w.initGrid=function(){var e=this.config,n=this.d3;this.grid=this.main.append("g").attr("clip-path",this.clipPathForGrid).attr("class",P.grid),e.grid_x_show&&this.grid.append("g").attr("class",P.xgrids),e.grid_y_show&&this.grid.append("g").attr("class",P.ygrids),e.grid_focus_show&&this.grid.append("g").attr("class",P.xgridFocus).append("line").attr("class",P.xgridFocus),this.xgrid=n.selectAll([]),e.grid_lines_front||this.initGridLines()},w.initGridLines=function(){var e=this.d3;this.gridLines=this.main.append("g").attr("clip-path",this.clipPathForGrid).attr("class",P.grid+" "+P.gridLines),this.gridLines.append("g").attr("class",P.xgridLines),this.gridLines.append("g").attr("class",P.ygridLines),this.xgridLines=e.selectAll([])},w.updateXGrid=function(e){var n=this,o=n.config,l=n.d3,p=n.generateGridData(o.grid_x_type,n.x),y=n.isCategorized()?n.xAxis.tickOffset():0;n.xgridAttr=o.axis_rotated?{x1:0,x2:n.width,y1:function(_){return n.x(_)-y},y2:function(_){return n.x(_)-y}}:{x1:function(_){return n.x(_)+y},x2:function(_){return n.x(_)+y},y1:0,y2:n.height},n.xgrid=n.main.select("."+P.xgrids).selectAll("."+P.xgrid).data(p),n.xgrid.enter().append("line").attr("class",P.xgrid),e||n.xgrid.attr(n.xgridAttr).style("opacity",function(){return+l.select(this).attr(o.axis_rotated?"y1":"x1")===(o.axis_rotated?n.height:0)?0:1}),n.xgrid.exit().remove()},w.updateYGrid=function(){var e=this.config,n=this.yAxis.tickValues()||this.y.ticks(e.grid_y_ticks);this.ygrid=this.main.select("."+P.ygrids).selectAll("."+P.ygrid).data(n),this.ygrid.enter().append("line").attr("class",P.ygrid),this.ygrid.attr("x1",e.axis_rotated?this.y:0).attr("x2",e.axis_rotated?this.y:this.width).attr("y1",e.axis_rotated?0:this.y).attr("y2",e.axis_rotated?this.height:this.y),this.ygrid.exit().remove(),this.smoothLines(this.ygrid,"grid")},w.gridTextAnchor=function(e){return e.position?e.position:"end"},w.gridTextDx=function(e){return e.position==="start"?4:e.position==="middle"?0:-4},w.xGridTextX=function(e){return e.position==="start"?-this.height:e.position==="middle"?-this.height/2:0},w.yGridTextX=function(e){return e.position==="start"?0:e.position==="middle"?this.width/2:this.width},w.updateGrid=function(e){var n,o,l,p=this.main,y=this.config;this.grid.style("visibility",this.hasArcType()?"hidden":"visible"),p.select("line."+P.xgridFocus).style("visibility","hidden"),y.grid_x_show&&this.updateXGrid(),this.xgridLines=p.select("."+P.xgridLines).selectAll("."+P.xgridLine).data(y.grid_x_lines),(n=this.xgridLines.enter().append("g").attr("class",function(_){return P.xgridLine+(_.class?" "+_.class:"")})).append("line").style("opacity",0),n.append("text").attr("text-anchor",this.gridTextAnchor).attr("transform",y.axis_rotated?"":"rotate(-90)").attr("dx",this.gridTextDx).attr("dy",-5).style("opacity",0),this.xgridLines.exit().transition().duration(e).style("opacity",0).remove(),y.grid_y_show&&this.updateYGrid(),this.ygridLines=p.select("."+P.ygridLines).selectAll("."+P.ygridLine).data(y.grid_y_lines),(o=this.ygridLines.enter().append("g").attr("class",function(_){return P.ygridLine+(_.class?" "+_.class:"")})).append("line").style("opacity",0),o.append("text").attr("text-anchor",this.gridTextAnchor).attr("transform",y.axis_rotated?"rotate(-90)":"").attr("dx",this.gridTextDx).attr("dy",-5).style("opacity",0),l=this.yv.bind(this),this.ygridLines.select("line").transition().duration(e).attr("x1",y.axis_rotated?l:0).attr("x2",y.axis_rotated?l:this.width).attr("y1",y.axis_rotated?0:l).attr("y2",y.axis_rotated?this.height:l).style("opacity",1),this.ygridLines.select("text").transition().duration(e).attr("x",y.axis_rotated?this.xGridTextX.bind(this):this.yGridTextX.bind(this)).attr("y",l).text(function(_){return _.text}).style("opacity",1),this.ygridLines.exit().transition().duration(e).style("opacity",0).remove()},w.redrawGrid=function(e){var n=this.config,o=this.xv.bind(this),l=this.xgridLines.select("line"),p=this.xgridLines.select("text");return[(e?l.transition():l).attr("x1",n.axis_rotated?0:o).attr("x2",n.axis_rotated?this.width:o).attr("y1",n.axis_rotated?o:0).attr("y2",n.axis_rotated?o:this.height).style("opacity",1),(e?p.transition():p).attr("x",n.axis_rotated?this.yGridTextX.bind(this):this.xGridTextX.bind(this)).attr("y",o).text(function(y){return y.text}).style("opacity",1)]},w.showXGridFocus=function(e){var n=this.config,o=e.filter(function(y){return y&&jt(y.value)}),l=this.main.selectAll("line."+P.xgridFocus),p=this.xx.bind(this);n.tooltip_show&&(this.hasType("scatter")||this.hasArcType()||(l.style("visibility","visible").data([o[0]]).attr(n.axis_rotated?"y1":"x1",p).attr(n.axis_rotated?"y2":"x2",p),this.smoothLines(l,"grid")))},w.hideXGridFocus=function(){this.main.select("line."+P.xgridFocus).style("visibility","hidden")},w.updateXgridFocus=function(){var e=this.config;this.main.select("line."+P.xgridFocus).attr("x1",e.axis_rotated?0:-10).attr("x2",e.axis_rotated?this.width:-10).attr("y1",e.axis_rotated?-10:0).attr("y2",e.axis_rotated?-10:this.height)},w.generateGridData=function(e,n){var o,l,p,y,_=[],T=this.main.select("."+P.axisX).selectAll(".tick").size();if(e==="year")for(l=(o=this.getXDomain())[0].getFullYear(),p=o[1].getFullYear(),y=l;y<=p;y++)_.push(new Date(y+"-01-01 00:00:00"));else(_=n.ticks(10)).length>T&&(_=_.filter(function(L){return(""+L).indexOf(".")<0}));return _},w.getGridFilterToRemove=function(e){return e?function(n){var o=!1;return[].concat(e).forEach(function(l){("value"in l&&n.value===l.value||"class"in l&&n.class===l.class)&&(o=!0)}),o}:function(){return!0}},w.removeGridLines=function(e,n){var o=this.config,l=this.getGridFilterToRemove(e),p=function(T){return!l(T)},y=n?P.xgridLines:P.ygridLines,_=n?P.xgridLine:P.ygridLine;this.main.select("."+y).selectAll("."+_).filter(l).transition().duration(o.transition_duration).style("opacity",0).remove(),n?o.grid_x_lines=o.grid_x_lines.filter(p):o.grid_y_lines=o.grid_y_lines.filter(p)},w.initEventRect=function(){this.main.select("."+P.chart).append("g").attr("class",P.eventRects).style("fill-opacity",0)},w.redrawEventRect=function(){var e,n,o=this.config,l=this.isMultipleX(),p=this.main.select("."+P.eventRects).style("cursor",o.zoom_enabled?o.axis_rotated?"ns-resize":"ew-resize":null).classed(P.eventRectsMultiple,l).classed(P.eventRectsSingle,!l);p.selectAll("."+P.eventRect).remove(),this.eventRect=p.selectAll("."+P.eventRect),l?(e=this.eventRect.data([0]),this.generateEventRectsForMultipleXs(e.enter()),this.updateEventRect(e)):(n=this.getMaxDataCountTarget(this.data.targets),p.datum(n?n.values:[]),this.eventRect=p.selectAll("."+P.eventRect),e=this.eventRect.data(function(y){return y}),this.generateEventRectsForSingleX(e.enter()),this.updateEventRect(e),e.exit().remove())},w.updateEventRect=function(e){var n,o,l,p,y,_,T=this,L=T.config;e=e||T.eventRect.data(function(V){return V}),T.isMultipleX()?(n=0,o=0,l=T.width,p=T.height):(!T.isCustomX()&&!T.isTimeSeries()||T.isCategorized()?(y=T.getEventRectWidth(),_=function(V){return T.x(V.x)-y/2}):(T.updateXs(),y=function(V){var F=T.getPrevX(V.index),q=T.getNextX(V.index);return F===null&&q===null?L.axis_rotated?T.height:T.width:(F===null&&(F=T.x.domain()[0]),q===null&&(q=T.x.domain()[1]),Math.max(0,(T.x(q)-T.x(F))/2))},_=function(V){var F=T.getPrevX(V.index),q=T.getNextX(V.index),ot=T.data.xs[V.id][V.index];return F===null&&q===null?0:(F===null&&(F=T.x.domain()[0]),(T.x(ot)+T.x(F))/2)}),n=L.axis_rotated?0:_,o=L.axis_rotated?_:0,l=L.axis_rotated?T.width:y,p=L.axis_rotated?y:T.height),e.attr("class",T.classEvent.bind(T)).attr("x",n).attr("y",o).attr("width",l).attr("height",p)},w.generateEventRectsForSingleX=function(e){var n=this,o=n.d3,l=n.config;e.append("rect").attr("class",n.classEvent.bind(n)).style("cursor",l.data_selection_enabled&&l.data_selection_grouped?"pointer":null).on("mouseover",function(p){var y=p.index;n.dragging||n.flowing||n.hasArcType()||(l.point_focus_expand_enabled&&n.expandCircles(y,null,!0),n.expandBars(y,null,!0),n.main.selectAll("."+P.shape+"-"+y).each(function(_){l.data_onmouseover.call(n.api,_)}))}).on("mouseout",function(p){var y=p.index;n.config&&(n.hasArcType()||(n.hideXGridFocus(),n.hideTooltip(),n.unexpandCircles(),n.unexpandBars(),n.main.selectAll("."+P.shape+"-"+y).each(function(_){l.data_onmouseout.call(n.api,_)})))}).on("mousemove",function(p){var y,_=p.index,T=n.svg.select("."+P.eventRect+"-"+_);n.dragging||n.flowing||n.hasArcType()||(n.isStepType(p)&&n.config.line_step_type==="step-after"&&o.mouse(this)[0]<n.x(n.getXValue(p.id,_))&&(_-=1),y=n.filterTargetsToShow(n.data.targets).map(function(L){return n.addName(n.getValueOnIndex(L.values,_))}),l.tooltip_grouped&&(n.showTooltip(y,this),n.showXGridFocus(y)),(!l.tooltip_grouped||l.data_selection_enabled&&!l.data_selection_grouped)&&n.main.selectAll("."+P.shape+"-"+_).each(function(){o.select(this).classed(P.EXPANDED,!0),l.data_selection_enabled&&T.style("cursor",l.data_selection_grouped?"pointer":null),l.tooltip_grouped||(n.hideXGridFocus(),n.hideTooltip(),l.data_selection_grouped||(n.unexpandCircles(_),n.unexpandBars(_)))}).filter(function(L){return n.isWithinShape(this,L)}).each(function(L){l.data_selection_enabled&&(l.data_selection_grouped||l.data_selection_isselectable(L))&&T.style("cursor","pointer"),l.tooltip_grouped||(n.showTooltip([L],this),n.showXGridFocus([L]),l.point_focus_expand_enabled&&n.expandCircles(_,L.id,!0),n.expandBars(_,L.id,!0))}))}).on("click",function(p){var y=p.index;!n.hasArcType()&&n.toggleShape&&(n.cancelClick?n.cancelClick=!1:(n.isStepType(p)&&l.line_step_type==="step-after"&&o.mouse(this)[0]<n.x(n.getXValue(p.id,y))&&(y-=1),n.main.selectAll("."+P.shape+"-"+y).each(function(_){(l.data_selection_grouped||n.isWithinShape(this,_))&&(n.toggleShape(this,_,y),n.config.data_onclick.call(n.api,_,this))})))}).call(l.data_selection_draggable&&n.drag?o.behavior.drag().origin(Object).on("drag",function(){n.drag(o.mouse(this))}).on("dragstart",function(){n.dragstart(o.mouse(this))}).on("dragend",function(){n.dragend()}):function(){})},w.generateEventRectsForMultipleXs=function(e){function n(){o.svg.select("."+P.eventRect).style("cursor",null),o.hideXGridFocus(),o.hideTooltip(),o.unexpandCircles(),o.unexpandBars()}var o=this,l=o.d3,p=o.config;e.append("rect").attr("x",0).attr("y",0).attr("width",o.width).attr("height",o.height).attr("class",P.eventRect).on("mouseout",function(){o.config&&(o.hasArcType()||n())}).on("mousemove",function(){var y,_,T,L=o.filterTargetsToShow(o.data.targets);o.dragging||o.hasArcType(L)||(y=l.mouse(this),_=o.findClosestFromTargets(L,y),!o.mouseover||_&&_.id===o.mouseover.id||(p.data_onmouseout.call(o.api,o.mouseover),o.mouseover=void 0),_?(T=(o.isScatterType(_)||!p.tooltip_grouped?[_]:o.filterByX(L,_.x)).map(function(V){return o.addName(V)}),o.showTooltip(T,this),p.point_focus_expand_enabled&&o.expandCircles(_.index,_.id,!0),o.expandBars(_.index,_.id,!0),o.showXGridFocus(T),(o.isBarType(_.id)||o.dist(_,y)<p.point_sensitivity)&&(o.svg.select("."+P.eventRect).style("cursor","pointer"),o.mouseover||(p.data_onmouseover.call(o.api,_),o.mouseover=_))):n())}).on("click",function(){var y,_,T=o.filterTargetsToShow(o.data.targets);o.hasArcType(T)||(y=l.mouse(this),(_=o.findClosestFromTargets(T,y))&&(o.isBarType(_.id)||o.dist(_,y)<p.point_sensitivity)&&o.main.selectAll("."+P.shapes+o.getTargetSelectorSuffix(_.id)).selectAll("."+P.shape+"-"+_.index).each(function(){(p.data_selection_grouped||o.isWithinShape(this,_))&&(o.toggleShape(this,_,_.index),o.config.data_onclick.call(o.api,_,this))}))}).call(p.data_selection_draggable&&o.drag?l.behavior.drag().origin(Object).on("drag",function(){o.drag(l.mouse(this))}).on("dragstart",function(){o.dragstart(l.mouse(this))}).on("dragend",function(){o.dragend()}):function(){})},w.dispatchEvent=function(e,n,o){var l="."+P.eventRect+(this.isMultipleX()?"":"-"+n),p=this.main.select(l).node(),y=p.getBoundingClientRect(),_=y.left+(o?o[0]:0),T=y.top+(o?o[1]:0),L=document.createEvent("MouseEvents");L.initMouseEvent(e,!0,!0,window,0,_,T,_,T,!1,!1,!1,!1,0,null),p.dispatchEvent(L)},w.initLegend=function(){if(this.legendItemTextBox={},this.legendHasRendered=!1,this.legend=this.svg.append("g").attr("transform",this.getTranslate("legend")),!this.config.legend_show)return this.legend.style("visibility","hidden"),void(this.hiddenLegendIds=this.mapToIds(this.data.targets));this.updateLegendWithDefaults()},w.updateLegendWithDefaults=function(){this.updateLegend(this.mapToIds(this.data.targets),{withTransform:!1,withTransitionForTransform:!1,withTransition:!1})},w.updateSizeForLegend=function(e,n){var o=this.config,l=this.isLegendTop?this.getCurrentPaddingTop()+o.legend_inset_y+5.5:this.currentHeight-e-this.getCurrentPaddingBottom()-o.legend_inset_y,p=this.isLegendLeft?this.getCurrentPaddingLeft()+o.legend_inset_x+.5:this.currentWidth-n-this.getCurrentPaddingRight()-o.legend_inset_x+.5;this.margin3={top:this.isLegendRight?0:this.isLegendInset?l:this.currentHeight-e,right:NaN,bottom:0,left:this.isLegendRight?this.currentWidth-n:this.isLegendInset?p:0}},w.transformLegend=function(e){(e?this.legend.transition():this.legend).attr("transform",this.getTranslate("legend"))},w.updateLegendStep=function(e){this.legendStep=e},w.updateLegendItemWidth=function(e){this.legendItemWidth=e},w.updateLegendItemHeight=function(e){this.legendItemHeight=e},w.getLegendWidth=function(){return this.config.legend_show?this.isLegendRight||this.isLegendInset?this.legendItemWidth*(this.legendStep+1):this.currentWidth:0},w.getLegendHeight=function(){var e=0;return this.config.legend_show&&(e=this.isLegendRight?this.currentHeight:Math.max(20,this.legendItemHeight)*(this.legendStep+1)),e},w.opacityForLegend=function(e){return e.classed(P.legendItemHidden)?null:1},w.opacityForUnfocusedLegend=function(e){return e.classed(P.legendItemHidden)?null:.3},w.toggleFocusLegend=function(e,n){var o=this;e=o.mapToTargetIds(e),o.legend.selectAll("."+P.legendItem).filter(function(l){return e.indexOf(l)>=0}).classed(P.legendItemFocused,n).transition().duration(100).style("opacity",function(){return(n?o.opacityForLegend:o.opacityForUnfocusedLegend).call(o,o.d3.select(this))})},w.revertLegend=function(){var e=this,n=e.d3;e.legend.selectAll("."+P.legendItem).classed(P.legendItemFocused,!1).transition().duration(100).style("opacity",function(){return e.opacityForLegend(n.select(this))})},w.showLegend=function(e){var n=this,o=n.config;o.legend_show||(o.legend_show=!0,n.legend.style("visibility","visible"),n.legendHasRendered||n.updateLegendWithDefaults()),n.removeHiddenLegendIds(e),n.legend.selectAll(n.selectorLegends(e)).style("visibility","visible").transition().style("opacity",function(){return n.opacityForLegend(n.d3.select(this))})},w.hideLegend=function(e){var n=this.config;n.legend_show&&vt(e)&&(n.legend_show=!1,this.legend.style("visibility","hidden")),this.addHiddenLegendIds(e),this.legend.selectAll(this.selectorLegends(e)).style("opacity",0).style("visibility","hidden")},w.clearLegendItemTextBoxCache=function(){this.legendItemTextBox={}},w.updateLegend=function(e,n,o){function l(Pt,c,I){function X(Te,Ae){Ae||(W=(me-te-xe)/2)<zt&&(W=(me-xe)/2,te=0,he++),we[Te]=he,Ve[he]=j.isLegendInset?10:W,Ft[Te]=te,te+=xe}var W,gt,Tt=I===0,Gt=I===e.length-1,Et=(function(Te,Ae){return j.legendItemTextBox[Ae]||(j.legendItemTextBox[Ae]=j.getTextRect(Te.textContent,P.legendItem,Te)),j.legendItemTextBox[Ae]})(Pt,c),ie=Et.width+oe+(!Gt||j.isLegendRight||j.isLegendInset?xt:0)+tt.legend_padding,It=Et.height+Ot,xe=j.isLegendRight||j.isLegendInset?It:ie,me=j.isLegendRight||j.isLegendInset?j.getLegendHeight():j.getLegendWidth();Tt&&(te=0,he=0,St=0,re=0),!tt.legend_show||j.isLegendToShow(c)?(ye[c]=ie,ct[c]=It,(!St||ie>=St)&&(St=ie),(!re||It>=re)&&(re=It),gt=j.isLegendRight||j.isLegendInset?re:St,tt.legend_equally?(Object.keys(ye).forEach(function(Te){ye[Te]=St}),Object.keys(ct).forEach(function(Te){ct[Te]=re}),(W=(me-gt*e.length)/2)<zt?(te=0,he=0,e.forEach(function(Te){X(Te)})):X(c,!0)):X(c)):ye[c]=ct[c]=we[c]=Ft[c]=0}var p,y,_,T,L,V,F,q,ot,lt,ht,kt,Rt,qt,Jt,Yt,j=this,tt=j.config,Ot=4,xt=10,St=0,re=0,zt=10,oe=tt.legend_item_tile_width+5,te=0,Ft={},ye={},ct={},Ve=[0],we={},he=0;e=e.filter(function(Pt){return!nt(tt.data_names[Pt])||tt.data_names[Pt]!==null}),ht=Y(n=n||{},"withTransition",!0),kt=Y(n,"withTransitionForTransform",!0),j.isLegendInset&&(he=tt.legend_inset_step?tt.legend_inset_step:e.length,j.updateLegendStep(he)),j.isLegendRight?(p=function(Pt){return St*we[Pt]},T=function(Pt){return Ve[we[Pt]]+Ft[Pt]}):j.isLegendInset?(p=function(Pt){return St*we[Pt]+10},T=function(Pt){return Ve[we[Pt]]+Ft[Pt]}):(p=function(Pt){return Ve[we[Pt]]+Ft[Pt]},T=function(Pt){return re*we[Pt]}),y=function(Pt,c){return p(Pt,c)+4+tt.legend_item_tile_width},L=function(Pt,c){return T(Pt,c)+9},_=function(Pt,c){return p(Pt,c)},V=function(Pt,c){return T(Pt,c)-5},F=function(Pt,c){return p(Pt,c)-2},q=function(Pt,c){return p(Pt,c)-2+tt.legend_item_tile_width},ot=function(Pt,c){return T(Pt,c)+4},(lt=j.legend.selectAll("."+P.legendItem).data(e).enter().append("g").attr("class",function(Pt){return j.generateClass(P.legendItem,Pt)}).style("visibility",function(Pt){return j.isLegendToShow(Pt)?"visible":"hidden"}).style("cursor","pointer").on("click",function(Pt){tt.legend_item_onclick?tt.legend_item_onclick.call(j,Pt):j.d3.event.altKey?(j.api.hide(),j.api.show(Pt)):(j.api.toggle(Pt),j.isTargetToShow(Pt)?j.api.focus(Pt):j.api.revert())}).on("mouseover",function(Pt){tt.legend_item_onmouseover?tt.legend_item_onmouseover.call(j,Pt):(j.d3.select(this).classed(P.legendItemFocused,!0),!j.transiting&&j.isTargetToShow(Pt)&&j.api.focus(Pt))}).on("mouseout",function(Pt){tt.legend_item_onmouseout?tt.legend_item_onmouseout.call(j,Pt):(j.d3.select(this).classed(P.legendItemFocused,!1),j.api.revert())})).append("text").text(function(Pt){return nt(tt.data_names[Pt])?tt.data_names[Pt]:Pt}).each(function(Pt,c){l(this,Pt,c)}).style("pointer-events","none").attr("x",j.isLegendRight||j.isLegendInset?y:-200).attr("y",j.isLegendRight||j.isLegendInset?-200:L),lt.append("rect").attr("class",P.legendItemEvent).style("fill-opacity",0).attr("x",j.isLegendRight||j.isLegendInset?_:-200).attr("y",j.isLegendRight||j.isLegendInset?-200:V),lt.append("line").attr("class",P.legendItemTile).style("stroke",j.color).style("pointer-events","none").attr("x1",j.isLegendRight||j.isLegendInset?F:-200).attr("y1",j.isLegendRight||j.isLegendInset?-200:ot).attr("x2",j.isLegendRight||j.isLegendInset?q:-200).attr("y2",j.isLegendRight||j.isLegendInset?-200:ot).attr("stroke-width",tt.legend_item_tile_height),Yt=j.legend.select("."+P.legendBackground+" rect"),j.isLegendInset&&St>0&&Yt.size()===0&&(Yt=j.legend.insert("g","."+P.legendItem).attr("class",P.legendBackground).append("rect")),Rt=j.legend.selectAll("text").data(e).text(function(Pt){return nt(tt.data_names[Pt])?tt.data_names[Pt]:Pt}).each(function(Pt,c){l(this,Pt,c)}),(ht?Rt.transition():Rt).attr("x",y).attr("y",L),qt=j.legend.selectAll("rect."+P.legendItemEvent).data(e),(ht?qt.transition():qt).attr("width",function(Pt){return ye[Pt]}).attr("height",function(Pt){return ct[Pt]}).attr("x",_).attr("y",V),Jt=j.legend.selectAll("line."+P.legendItemTile).data(e),(ht?Jt.transition():Jt).style("stroke",j.color).attr("x1",F).attr("y1",ot).attr("x2",q).attr("y2",ot),Yt&&(ht?Yt.transition():Yt).attr("height",j.getLegendHeight()-12).attr("width",St*(he+1)+10),j.legend.selectAll("."+P.legendItem).classed(P.legendItemHidden,function(Pt){return!j.isTargetToShow(Pt)}),j.updateLegendItemWidth(St),j.updateLegendItemHeight(re),j.updateLegendStep(he),j.updateSizes(),j.updateScales(),j.updateSvgSize(),j.transformAll(kt,o),j.legendHasRendered=!0},w.initRegion=function(){this.region=this.main.append("g").attr("clip-path",this.clipPath).attr("class",P.regions)},w.updateRegion=function(e){var n=this.config;this.region.style("visibility",this.hasArcType()?"hidden":"visible"),this.mainRegion=this.main.select("."+P.regions).selectAll("."+P.region).data(n.regions),this.mainRegion.enter().append("g").append("rect").style("fill-opacity",0),this.mainRegion.attr("class",this.classRegion.bind(this)),this.mainRegion.exit().transition().duration(e).style("opacity",0).remove()},w.redrawRegion=function(e){var n=this,o=n.mainRegion.selectAll("rect").each(function(){var T=n.d3.select(this.parentNode).datum();n.d3.select(this).datum(T)}),l=n.regionX.bind(n),p=n.regionY.bind(n),y=n.regionWidth.bind(n),_=n.regionHeight.bind(n);return[(e?o.transition():o).attr("x",l).attr("y",p).attr("width",y).attr("height",_).style("fill-opacity",function(T){return jt(T.opacity)?T.opacity:.1})]},w.regionX=function(e){var n=this.config,o=e.axis==="y"?this.y:this.y2;return e.axis==="y"||e.axis==="y2"?n.axis_rotated&&"start"in e?o(e.start):0:n.axis_rotated?0:"start"in e?this.x(this.isTimeSeries()?this.parseDate(e.start):e.start):0},w.regionY=function(e){var n=this.config,o=e.axis==="y"?this.y:this.y2;return e.axis==="y"||e.axis==="y2"?n.axis_rotated?0:"end"in e?o(e.end):0:n.axis_rotated&&"start"in e?this.x(this.isTimeSeries()?this.parseDate(e.start):e.start):0},w.regionWidth=function(e){var n,o=this.config,l=this.regionX(e),p=e.axis==="y"?this.y:this.y2;return(n=e.axis==="y"||e.axis==="y2"?o.axis_rotated&&"end"in e?p(e.end):this.width:o.axis_rotated?this.width:"end"in e?this.x(this.isTimeSeries()?this.parseDate(e.end):e.end):this.width)<l?0:n-l},w.regionHeight=function(e){var n,o=this.config,l=this.regionY(e),p=e.axis==="y"?this.y:this.y2;return(n=e.axis==="y"||e.axis==="y2"?o.axis_rotated?this.height:"start"in e?p(e.start):this.height:o.axis_rotated&&"end"in e?this.x(this.isTimeSeries()?this.parseDate(e.end):e.end):this.height)<l?0:n-l},w.isRegionOnX=function(e){return!e.axis||e.axis==="x"},w.getScale=function(e,n,o){return(o?this.d3.time.scale():this.d3.scale.linear()).range([e,n])},w.getX=function(e,n,o,l){var p,y=this.getScale(e,n,this.isTimeSeries()),_=o?y.domain(o):y;this.isCategorized()?(l=l||function(){return 0},y=function(T,L){var V=_(T)+l(T);return L?V:Math.ceil(V)}):y=function(T,L){var V=_(T);return L?V:Math.ceil(V)};for(p in _)y[p]=_[p];return y.orgDomain=function(){return _.domain()},this.isCategorized()&&(y.domain=function(T){return arguments.length?(_.domain(T),y):(T=this.orgDomain(),[T[0],T[1]+1])}),y},w.getY=function(e,n,o){var l=this.getScale(e,n,this.isTimeSeriesY());return o&&l.domain(o),l},w.getYScale=function(e){return this.axis.getId(e)==="y2"?this.y2:this.y},w.getSubYScale=function(e){return this.axis.getId(e)==="y2"?this.subY2:this.subY},w.updateScales=function(){var e=this,n=e.config,o=!e.x;e.xMin=n.axis_rotated?1:0,e.xMax=n.axis_rotated?e.height:e.width,e.yMin=n.axis_rotated?0:e.height,e.yMax=n.axis_rotated?e.width:1,e.subXMin=e.xMin,e.subXMax=e.xMax,e.subYMin=n.axis_rotated?0:e.height2,e.subYMax=n.axis_rotated?e.width2:1,e.x=e.getX(e.xMin,e.xMax,o?void 0:e.x.orgDomain(),function(){return e.xAxis.tickOffset()}),e.y=e.getY(e.yMin,e.yMax,o?n.axis_y_default:e.y.domain()),e.y2=e.getY(e.yMin,e.yMax,o?n.axis_y2_default:e.y2.domain()),e.subX=e.getX(e.xMin,e.xMax,e.orgXDomain,function(l){return l%1?0:e.subXAxis.tickOffset()}),e.subY=e.getY(e.subYMin,e.subYMax,o?n.axis_y_default:e.subY.domain()),e.subY2=e.getY(e.subYMin,e.subYMax,o?n.axis_y2_default:e.subY2.domain()),e.xAxisTickFormat=e.axis.getXAxisTickFormat(),e.xAxisTickValues=e.axis.getXAxisTickValues(),e.yAxisTickValues=e.axis.getYAxisTickValues(),e.y2AxisTickValues=e.axis.getY2AxisTickValues(),e.xAxis=e.axis.getXAxis(e.x,e.xOrient,e.xAxisTickFormat,e.xAxisTickValues,n.axis_x_tick_outer),e.subXAxis=e.axis.getXAxis(e.subX,e.subXOrient,e.xAxisTickFormat,e.xAxisTickValues,n.axis_x_tick_outer),e.yAxis=e.axis.getYAxis(e.y,e.yOrient,n.axis_y_tick_format,e.yAxisTickValues,n.axis_y_tick_outer),e.y2Axis=e.axis.getYAxis(e.y2,e.y2Orient,n.axis_y2_tick_format,e.y2AxisTickValues,n.axis_y2_tick_outer),o||(e.brush&&e.brush.scale(e.subX),n.zoom_enabled&&e.zoom.scale(e.x)),e.updateArc&&e.updateArc()},w.selectPoint=function(e,n,o){var l=this,p=l.config,y=(p.axis_rotated?l.circleY:l.circleX).bind(l),_=(p.axis_rotated?l.circleX:l.circleY).bind(l),T=l.pointSelectR.bind(l);p.data_onselected.call(l.api,n,e.node()),l.main.select("."+P.selectedCircles+l.getTargetSelectorSuffix(n.id)).selectAll("."+P.selectedCircle+"-"+o).data([n]).enter().append("circle").attr("class",function(){return l.generateClass(P.selectedCircle,o)}).attr("cx",y).attr("cy",_).attr("stroke",function(){return l.color(n)}).attr("r",function(L){return 1.4*l.pointSelectR(L)}).transition().duration(100).attr("r",T)},w.unselectPoint=function(e,n,o){this.config.data_onunselected.call(this.api,n,e.node()),this.main.select("."+P.selectedCircles+this.getTargetSelectorSuffix(n.id)).selectAll("."+P.selectedCircle+"-"+o).transition().duration(100).attr("r",0).remove()},w.togglePoint=function(e,n,o,l){e?this.selectPoint(n,o,l):this.unselectPoint(n,o,l)},w.selectPath=function(e,n){var o=this;o.config.data_onselected.call(o,n,e.node()),o.config.interaction_brighten&&e.transition().duration(100).style("fill",function(){return o.d3.rgb(o.color(n)).brighter(.75)})},w.unselectPath=function(e,n){var o=this;o.config.data_onunselected.call(o,n,e.node()),o.config.interaction_brighten&&e.transition().duration(100).style("fill",function(){return o.color(n)})},w.togglePath=function(e,n,o,l){e?this.selectPath(n,o,l):this.unselectPath(n,o,l)},w.getToggle=function(e,n){var o;return e.nodeName==="circle"?o=this.isStepType(n)?function(){}:this.togglePoint:e.nodeName==="path"&&(o=this.togglePath),o},w.toggleShape=function(e,n,o){var l=this.d3,p=this.config,y=l.select(e),_=y.classed(P.SELECTED),T=this.getToggle(e,n).bind(this);p.data_selection_enabled&&p.data_selection_isselectable(n)&&(p.data_selection_multiple||this.main.selectAll("."+P.shapes+(p.data_selection_grouped?this.getTargetSelectorSuffix(n.id):"")).selectAll("."+P.shape).each(function(L,V){var F=l.select(this);F.classed(P.SELECTED)&&T(!1,F.classed(P.SELECTED,!1),L,V)}),y.classed(P.SELECTED,!_),T(!_,y,n,o))},w.initBar=function(){this.main.select("."+P.chart).append("g").attr("class",P.chartBars)},w.updateTargetsForBar=function(e){var n=this.config,o=this.classChartBar.bind(this),l=this.classBars.bind(this),p=this.classFocus.bind(this);this.main.select("."+P.chartBars).selectAll("."+P.chartBar).data(e).attr("class",function(y){return o(y)+p(y)}).enter().append("g").attr("class",o).style("pointer-events","none").append("g").attr("class",l).style("cursor",function(y){return n.data_selection_isselectable(y)?"pointer":null})},w.updateBar=function(e){var n=this,o=n.barData.bind(n),l=n.classBar.bind(n),p=n.initialOpacity.bind(n),y=function(_){return n.color(_.id)};n.mainBar=n.main.selectAll("."+P.bars).selectAll("."+P.bar).data(o),n.mainBar.enter().append("path").attr("class",l).style("stroke",y).style("fill",y),n.mainBar.style("opacity",p),n.mainBar.exit().transition().duration(e).remove()},w.redrawBar=function(e,n){return[(n?this.mainBar.transition(Math.random().toString()):this.mainBar).attr("d",e).style("stroke",this.color).style("fill",this.color).style("opacity",1)]},w.getBarW=function(e,n){var o=this.config,l=typeof o.bar_width=="number"?o.bar_width:n?e.tickInterval()*o.bar_width_ratio/n:0;return o.bar_width_max&&l>o.bar_width_max?o.bar_width_max:l},w.getBars=function(e,n){return(n?this.main.selectAll("."+P.bars+this.getTargetSelectorSuffix(n)):this.main).selectAll("."+P.bar+(jt(e)?"-"+e:""))},w.expandBars=function(e,n,o){o&&this.unexpandBars(),this.getBars(e,n).classed(P.EXPANDED,!0)},w.unexpandBars=function(e){this.getBars(e).classed(P.EXPANDED,!1)},w.generateDrawBar=function(e,n){var o=this.config,l=this.generateGetBarPoints(e,n);return function(p,y){var _=l(p,y),T=o.axis_rotated?1:0,L=o.axis_rotated?0:1;return"M "+_[0][T]+","+_[0][L]+" L"+_[1][T]+","+_[1][L]+" L"+_[2][T]+","+_[2][L]+" L"+_[3][T]+","+_[3][L]+" z"}},w.generateGetBarPoints=function(e,n){var o=this,l=n?o.subXAxis:o.xAxis,p=e.__max__+1,y=o.getBarW(l,p),_=o.getShapeX(y,p,e,!!n),T=o.getShapeY(!!n),L=o.getShapeOffset(o.isBarType,e,!!n),V=y*(o.config.bar_space/2),F=n?o.getSubYScale:o.getYScale;return function(q,ot){var lt=F.call(o,q.id)(0),ht=L(q,ot)||lt,kt=_(q),Rt=T(q);return o.config.axis_rotated&&(0<q.value&&Rt<lt||q.value<0&&lt<Rt)&&(Rt=lt),[[kt+V,ht],[kt+V,Rt-(lt-ht)],[kt+y-V,Rt-(lt-ht)],[kt+y-V,ht]]}},w.isWithinBar=function(e){var n=this.d3.mouse(e),o=e.getBoundingClientRect(),l=e.pathSegList.getItem(0),p=e.pathSegList.getItem(1),y=Math.min(l.x,p.x),_=Math.min(l.y,p.y),T=y+o.width+2,L=_+o.height+2,V=_-2;return y-2<n[0]&&n[0]<T&&V<n[1]&&n[1]<L},w.getShapeIndices=function(e){var n,o,l=this.config,p={},y=0;return this.filterTargetsToShow(this.data.targets.filter(e,this)).forEach(function(_){for(n=0;n<l.data_groups.length;n++)if(!(l.data_groups[n].indexOf(_.id)<0)){for(o=0;o<l.data_groups[n].length;o++)if(l.data_groups[n][o]in p){p[_.id]=p[l.data_groups[n][o]];break}}Lt(p[_.id])&&(p[_.id]=y++)}),p.__max__=y-1,p},w.getShapeX=function(e,n,o,l){var p=l?this.subX:this.x;return function(y){var _=y.id in o?o[y.id]:0;return y.x||y.x===0?p(y.x)-e*(n/2-_):0}},w.getShapeY=function(e){var n=this;return function(o){return(e?n.getSubYScale(o.id):n.getYScale(o.id))(o.value)}},w.getShapeOffset=function(e,n,o){var l=this,p=l.orderTargets(l.filterTargetsToShow(l.data.targets.filter(e,l))),y=p.map(function(_){return _.id});return function(_,T){var L=o?l.getSubYScale(_.id):l.getYScale(_.id),V=L(0),F=V;return p.forEach(function(q){var ot=l.isStepType(_)?l.convertValuesToStep(q.values):q.values;q.id!==_.id&&n[q.id]===n[_.id]&&y.indexOf(q.id)<y.indexOf(_.id)&&(ot[T]!==void 0&&+ot[T].x==+_.x||(T=-1,ot.forEach(function(lt,ht){lt.x===_.x&&(T=ht)})),T in ot&&ot[T].value*_.value>=0&&(F+=L(ot[T].value)-V))}),F}},w.isWithinShape=function(e,n){var o,l=this.d3.select(e);return this.isTargetToShow(n.id)?e.nodeName==="circle"?o=this.isStepType(n)?this.isWithinStep(e,this.getYScale(n.id)(n.value)):this.isWithinCircle(e,1.5*this.pointSelectR(n)):e.nodeName==="path"&&(o=!l.classed(P.bar)||this.isWithinBar(e)):o=!1,o},w.getInterpolate=function(e){var n=this.isInterpolationType(this.config.spline_interpolation_type)?this.config.spline_interpolation_type:"cardinal";return this.isSplineType(e)?n:this.isStepType(e)?this.config.line_step_type:"linear"},w.initLine=function(){this.main.select("."+P.chart).append("g").attr("class",P.chartLines)},w.updateTargetsForLine=function(e){var n,o=this,l=o.config,p=o.classChartLine.bind(o),y=o.classLines.bind(o),_=o.classAreas.bind(o),T=o.classCircles.bind(o),L=o.classFocus.bind(o);(n=o.main.select("."+P.chartLines).selectAll("."+P.chartLine).data(e).attr("class",function(V){return p(V)+L(V)}).enter().append("g").attr("class",p).style("opacity",0).style("pointer-events","none")).append("g").attr("class",y),n.append("g").attr("class",_),n.append("g").attr("class",function(V){return o.generateClass(P.selectedCircles,V.id)}),n.append("g").attr("class",T).style("cursor",function(V){return l.data_selection_isselectable(V)?"pointer":null}),e.forEach(function(V){o.main.selectAll("."+P.selectedCircles+o.getTargetSelectorSuffix(V.id)).selectAll("."+P.selectedCircle).each(function(F){F.value=V.values[F.index].value})})},w.updateLine=function(e){var n=this;n.mainLine=n.main.selectAll("."+P.lines).selectAll("."+P.line).data(n.lineData.bind(n)),n.mainLine.enter().append("path").attr("class",n.classLine.bind(n)).style("stroke",n.color),n.mainLine.style("opacity",n.initialOpacity.bind(n)).style("shape-rendering",function(o){return n.isStepType(o)?"crispEdges":""}).attr("transform",null),n.mainLine.exit().transition().duration(e).style("opacity",0).remove()},w.redrawLine=function(e,n){return[(n?this.mainLine.transition(Math.random().toString()):this.mainLine).attr("d",e).style("stroke",this.color).style("opacity",1)]},w.generateDrawLine=function(e,n){var o=this,l=o.config,p=o.d3.svg.line(),y=o.generateGetLinePoints(e,n),_=n?o.getSubYScale:o.getYScale,T=function(V){return(n?o.subxx:o.xx).call(o,V)},L=function(V,F){return l.data_groups.length>0?y(V,F)[0][1]:_.call(o,V.id)(V.value)};return p=l.axis_rotated?p.x(L).y(T):p.x(T).y(L),l.line_connectNull||(p=p.defined(function(V){return V.value!=null})),function(V){var F,q=l.line_connectNull?o.filterRemoveNull(V.values):V.values,ot=n?o.x:o.subX,lt=_.call(o,V.id),ht=0,kt=0;return o.isLineType(V)?l.data_regions[V.id]?F=o.lineWithRegions(q,ot,lt,l.data_regions[V.id]):(o.isStepType(V)&&(q=o.convertValuesToStep(q)),F=p.interpolate(o.getInterpolate(V))(q)):(q[0]&&(ht=ot(q[0].x),kt=lt(q[0].value)),F=l.axis_rotated?"M "+kt+" "+ht:"M "+ht+" "+kt),F||"M 0 0"}},w.generateGetLinePoints=function(e,n){var o=this,l=o.config,p=e.__max__+1,y=o.getShapeX(0,p,e,!!n),_=o.getShapeY(!!n),T=o.getShapeOffset(o.isLineType,e,!!n),L=n?o.getSubYScale:o.getYScale;return function(V,F){var q=L.call(o,V.id)(0),ot=T(V,F)||q,lt=y(V),ht=_(V);return l.axis_rotated&&(0<V.value&&ht<q||V.value<0&&q<ht)&&(ht=q),[[lt,ht-(q-ot)],[lt,ht-(q-ot)],[lt,ht-(q-ot)],[lt,ht-(q-ot)]]}},w.lineWithRegions=function(e,n,o,l){function p(tt,Ot){var xt;for(xt=0;xt<Ot.length;xt++)if(Ot[xt].start<tt&&tt<=Ot[xt].end)return!0;return!1}function y(tt){return"M"+tt[0][0]+" "+tt[0][1]+" "+tt[1][0]+" "+tt[1][1]}var _,T,L,V,F,q,ot,lt,ht,kt,Rt,qt=this.config,Jt="M",Yt=this.isCategorized()?.5:0,j=[];if(nt(l))for(_=0;_<l.length;_++)j[_]={},Lt(l[_].start)?j[_].start=e[0].x:j[_].start=this.isTimeSeries()?this.parseDate(l[_].start):l[_].start,Lt(l[_].end)?j[_].end=e[e.length-1].x:j[_].end=this.isTimeSeries()?this.parseDate(l[_].end):l[_].end;for(kt=qt.axis_rotated?function(tt){return o(tt.value)}:function(tt){return n(tt.x)},Rt=qt.axis_rotated?function(tt){return n(tt.x)}:function(tt){return o(tt.value)},L=this.isTimeSeries()?function(tt,Ot,xt,St){var re,zt=tt.x.getTime(),oe=Ot.x-tt.x,te=new Date(zt+oe*xt),Ft=new Date(zt+oe*(xt+St));return re=qt.axis_rotated?[[o(F(xt)),n(te)],[o(F(xt+St)),n(Ft)]]:[[n(te),o(F(xt))],[n(Ft),o(F(xt+St))]],y(re)}:function(tt,Ot,xt,St){var re;return re=qt.axis_rotated?[[o(F(xt),!0),n(V(xt))],[o(F(xt+St),!0),n(V(xt+St))]]:[[n(V(xt),!0),o(F(xt))],[n(V(xt+St),!0),o(F(xt+St))]],y(re)},_=0;_<e.length;_++){if(Lt(j)||!p(e[_].x,j))Jt+=" "+kt(e[_])+" "+Rt(e[_]);else for(V=this.getScale(e[_-1].x+Yt,e[_].x+Yt,this.isTimeSeries()),F=this.getScale(e[_-1].value,e[_].value),q=n(e[_].x)-n(e[_-1].x),ot=o(e[_].value)-o(e[_-1].value),ht=2*(lt=2/Math.sqrt(Math.pow(q,2)+Math.pow(ot,2))),T=lt;T<=1;T+=ht)Jt+=L(e[_-1],e[_],T,lt);e[_].x}return Jt},w.updateArea=function(e){var n=this,o=n.d3;n.mainArea=n.main.selectAll("."+P.areas).selectAll("."+P.area).data(n.lineData.bind(n)),n.mainArea.enter().append("path").attr("class",n.classArea.bind(n)).style("fill",n.color).style("opacity",function(){return n.orgAreaOpacity=+o.select(this).style("opacity"),0}),n.mainArea.style("opacity",n.orgAreaOpacity),n.mainArea.exit().transition().duration(e).style("opacity",0).remove()},w.redrawArea=function(e,n){return[(n?this.mainArea.transition(Math.random().toString()):this.mainArea).attr("d",e).style("fill",this.color).style("opacity",this.orgAreaOpacity)]},w.generateDrawArea=function(e,n){var o=this,l=o.config,p=o.d3.svg.area(),y=o.generateGetAreaPoints(e,n),_=n?o.getSubYScale:o.getYScale,T=function(F){return(n?o.subxx:o.xx).call(o,F)},L=function(F,q){return l.data_groups.length>0?y(F,q)[0][1]:_.call(o,F.id)(o.getAreaBaseValue(F.id))},V=function(F,q){return l.data_groups.length>0?y(F,q)[1][1]:_.call(o,F.id)(F.value)};return p=l.axis_rotated?p.x0(L).x1(V).y(T):p.x(T).y0(l.area_above?0:L).y1(V),l.line_connectNull||(p=p.defined(function(F){return F.value!==null})),function(F){var q,ot=l.line_connectNull?o.filterRemoveNull(F.values):F.values,lt=0,ht=0;return o.isAreaType(F)?(o.isStepType(F)&&(ot=o.convertValuesToStep(ot)),q=p.interpolate(o.getInterpolate(F))(ot)):(ot[0]&&(lt=o.x(ot[0].x),ht=o.getYScale(F.id)(ot[0].value)),q=l.axis_rotated?"M "+ht+" "+lt:"M "+lt+" "+ht),q||"M 0 0"}},w.getAreaBaseValue=function(){return 0},w.generateGetAreaPoints=function(e,n){var o=this,l=o.config,p=e.__max__+1,y=o.getShapeX(0,p,e,!!n),_=o.getShapeY(!!n),T=o.getShapeOffset(o.isAreaType,e,!!n),L=n?o.getSubYScale:o.getYScale;return function(V,F){var q=L.call(o,V.id)(0),ot=T(V,F)||q,lt=y(V),ht=_(V);return l.axis_rotated&&(0<V.value&&ht<q||V.value<0&&q<ht)&&(ht=q),[[lt,ot],[lt,ht-(q-ot)],[lt,ht-(q-ot)],[lt,ot]]}},w.updateCircle=function(){this.mainCircle=this.main.selectAll("."+P.circles).selectAll("."+P.circle).data(this.lineOrScatterData.bind(this)),this.mainCircle.enter().append("circle").attr("class",this.classCircle.bind(this)).attr("r",this.pointR.bind(this)).style("fill",this.color),this.mainCircle.style("opacity",this.initialOpacityForCircle.bind(this)),this.mainCircle.exit().remove()},w.redrawCircle=function(e,n,o){var l=this.main.selectAll("."+P.selectedCircle);return[(o?this.mainCircle.transition(Math.random().toString()):this.mainCircle).style("opacity",this.opacityForCircle.bind(this)).style("fill",this.color).attr("cx",e).attr("cy",n),(o?l.transition(Math.random().toString()):l).attr("cx",e).attr("cy",n)]},w.circleX=function(e){return e.x||e.x===0?this.x(e.x):null},w.updateCircleY=function(){var e,n,o=this;o.config.data_groups.length>0?(e=o.getShapeIndices(o.isLineType),n=o.generateGetLinePoints(e),o.circleY=function(l,p){return n(l,p)[0][1]}):o.circleY=function(l){return o.getYScale(l.id)(l.value)}},w.getCircles=function(e,n){return(n?this.main.selectAll("."+P.circles+this.getTargetSelectorSuffix(n)):this.main).selectAll("."+P.circle+(jt(e)?"-"+e:""))},w.expandCircles=function(e,n,o){var l=this.pointExpandedR.bind(this);o&&this.unexpandCircles(),this.getCircles(e,n).classed(P.EXPANDED,!0).attr("r",l)},w.unexpandCircles=function(e){var n=this,o=n.pointR.bind(n);n.getCircles(e).filter(function(){return n.d3.select(this).classed(P.EXPANDED)}).classed(P.EXPANDED,!1).attr("r",o)},w.pointR=function(e){var n=this.config;return this.isStepType(e)?0:Ht(n.point_r)?n.point_r(e):n.point_r},w.pointExpandedR=function(e){var n=this.config;return n.point_focus_expand_enabled?Ht(n.point_focus_expand_r)?n.point_focus_expand_r(e):n.point_focus_expand_r?n.point_focus_expand_r:1.75*this.pointR(e):this.pointR(e)},w.pointSelectR=function(e){var n=this.config;return Ht(n.point_select_r)?n.point_select_r(e):n.point_select_r?n.point_select_r:4*this.pointR(e)},w.isWithinCircle=function(e,n){var o=this.d3,l=o.mouse(e),p=o.select(e),y=+p.attr("cx"),_=+p.attr("cy");return Math.sqrt(Math.pow(y-l[0],2)+Math.pow(_-l[1],2))<n},w.isWithinStep=function(e,n){return Math.abs(n-this.d3.mouse(e)[1])<30},w.getCurrentWidth=function(){var e=this.config;return e.size_width?e.size_width:this.getParentWidth()},w.getCurrentHeight=function(){var e=this.config,n=e.size_height?e.size_height:this.getParentHeight();return n>0?n:320/(this.hasType("gauge")&&!e.gauge_fullCircle?2:1)},w.getCurrentPaddingTop=function(){var e=this.config,n=jt(e.padding_top)?e.padding_top:0;return this.title&&this.title.node()&&(n+=this.getTitlePadding()),n},w.getCurrentPaddingBottom=function(){var e=this.config;return jt(e.padding_bottom)?e.padding_bottom:0},w.getCurrentPaddingLeft=function(e){var n=this.config;return jt(n.padding_left)?n.padding_left:n.axis_rotated?n.axis_x_show?Math.max(at(this.getAxisWidthByAxisId("x",e)),40):1:!n.axis_y_show||n.axis_y_inner?this.axis.getYAxisLabelPosition().isOuter?30:1:at(this.getAxisWidthByAxisId("y",e))},w.getCurrentPaddingRight=function(){var e=this.config,n=this.isLegendRight?this.getLegendWidth()+20:0;return jt(e.padding_right)?e.padding_right+1:e.axis_rotated?10+n:!e.axis_y2_show||e.axis_y2_inner?2+n+(this.axis.getY2AxisLabelPosition().isOuter?20:0):at(this.getAxisWidthByAxisId("y2"))+n},w.getParentRectValue=function(e){for(var n,o=this.selectChart.node();o&&o.tagName!=="BODY";){try{n=o.getBoundingClientRect()[e]}catch(l){e==="width"&&(n=o.offsetWidth)}if(n)break;o=o.parentNode}return n},w.getParentWidth=function(){return this.getParentRectValue("width")},w.getParentHeight=function(){var e=this.selectChart.style("height");return e.indexOf("px")>0?+e.replace("px",""):0},w.getSvgLeft=function(e){var n=this.config,o=n.axis_rotated||!n.axis_rotated&&!n.axis_y_inner,l=n.axis_rotated?P.axisX:P.axisY,p=this.main.select("."+l).node(),y=p&&o?p.getBoundingClientRect():{right:0},_=this.selectChart.node().getBoundingClientRect(),T=this.hasArcType(),L=y.right-_.left-(T?0:this.getCurrentPaddingLeft(e));return L>0?L:0},w.getAxisWidthByAxisId=function(e,n){var o=this.axis.getLabelPositionById(e);return this.axis.getMaxTickWidth(e,n)+(o.isInner?20:40)},w.getHorizontalAxisHeight=function(e){var n=this.config,o=30;return e!=="x"||n.axis_x_show?e==="x"&&n.axis_x_height?n.axis_x_height:e!=="y"||n.axis_y_show?e!=="y2"||n.axis_y2_show?(e==="x"&&!n.axis_rotated&&n.axis_x_tick_rotate&&(o=30+this.axis.getMaxTickWidth(e)*Math.cos(Math.PI*(90-n.axis_x_tick_rotate)/180)),e==="y"&&n.axis_rotated&&n.axis_y_tick_rotate&&(o=30+this.axis.getMaxTickWidth(e)*Math.cos(Math.PI*(90-n.axis_y_tick_rotate)/180)),o+(this.axis.getLabelPositionById(e).isInner?0:10)+(e==="y2"?-10:0)):this.rotated_padding_top:!n.legend_show||this.isLegendRight||this.isLegendInset?1:10:8},w.getEventRectWidth=function(){return Math.max(0,this.xAxis.tickInterval())},w.initBrush=function(){var e=this,n=e.d3;e.brush=n.svg.brush().on("brush",function(){e.redrawForBrush()}),e.brush.update=function(){return e.context&&e.context.select("."+P.brush).call(this),this},e.brush.scale=function(o){return e.config.axis_rotated?this.y(o):this.x(o)}},w.initSubchart=function(){var e=this.config,n=this.context=this.svg.append("g").attr("transform",this.getTranslate("context")),o=e.subchart_show?"visible":"hidden";n.style("visibility",o),n.append("g").attr("clip-path",this.clipPathForSubchart).attr("class",P.chart),n.select("."+P.chart).append("g").attr("class",P.chartBars),n.select("."+P.chart).append("g").attr("class",P.chartLines),n.append("g").attr("clip-path",this.clipPath).attr("class",P.brush).call(this.brush),this.axes.subx=n.append("g").attr("class",P.axisX).attr("transform",this.getTranslate("subx")).attr("clip-path",e.axis_rotated?"":this.clipPathForXAxis).style("visibility",e.subchart_axis_x_show?o:"hidden")},w.updateTargetsForSubchart=function(e){var n,o=this.context,l=this.config,p=this.classChartBar.bind(this),y=this.classBars.bind(this),_=this.classChartLine.bind(this),T=this.classLines.bind(this),L=this.classAreas.bind(this);l.subchart_show&&(o.select("."+P.chartBars).selectAll("."+P.chartBar).data(e).attr("class",p).enter().append("g").style("opacity",0).attr("class",p).append("g").attr("class",y),(n=o.select("."+P.chartLines).selectAll("."+P.chartLine).data(e).attr("class",_).enter().append("g").style("opacity",0).attr("class",_)).append("g").attr("class",T),n.append("g").attr("class",L),o.selectAll("."+P.brush+" rect").attr(l.axis_rotated?"width":"height",l.axis_rotated?this.width2:this.height2))},w.updateBarForSubchart=function(e){this.contextBar=this.context.selectAll("."+P.bars).selectAll("."+P.bar).data(this.barData.bind(this)),this.contextBar.enter().append("path").attr("class",this.classBar.bind(this)).style("stroke","none").style("fill",this.color),this.contextBar.style("opacity",this.initialOpacity.bind(this)),this.contextBar.exit().transition().duration(e).style("opacity",0).remove()},w.redrawBarForSubchart=function(e,n,o){(n?this.contextBar.transition(Math.random().toString()).duration(o):this.contextBar).attr("d",e).style("opacity",1)},w.updateLineForSubchart=function(e){this.contextLine=this.context.selectAll("."+P.lines).selectAll("."+P.line).data(this.lineData.bind(this)),this.contextLine.enter().append("path").attr("class",this.classLine.bind(this)).style("stroke",this.color),this.contextLine.style("opacity",this.initialOpacity.bind(this)),this.contextLine.exit().transition().duration(e).style("opacity",0).remove()},w.redrawLineForSubchart=function(e,n,o){(n?this.contextLine.transition(Math.random().toString()).duration(o):this.contextLine).attr("d",e).style("opacity",1)},w.updateAreaForSubchart=function(e){var n=this,o=n.d3;n.contextArea=n.context.selectAll("."+P.areas).selectAll("."+P.area).data(n.lineData.bind(n)),n.contextArea.enter().append("path").attr("class",n.classArea.bind(n)).style("fill",n.color).style("opacity",function(){return n.orgAreaOpacity=+o.select(this).style("opacity"),0}),n.contextArea.style("opacity",0),n.contextArea.exit().transition().duration(e).style("opacity",0).remove()},w.redrawAreaForSubchart=function(e,n,o){(n?this.contextArea.transition(Math.random().toString()).duration(o):this.contextArea).attr("d",e).style("fill",this.color).style("opacity",this.orgAreaOpacity)},w.redrawSubchart=function(e,n,o,l,p,y,_){var T,L,V,F=this.d3,q=this.config;this.context.style("visibility",q.subchart_show?"visible":"hidden"),q.subchart_show&&(F.event&&F.event.type==="zoom"&&this.brush.extent(this.x.orgDomain()).update(),e&&(this.brush.empty()||this.brush.extent(this.x.orgDomain()).update(),T=this.generateDrawArea(p,!0),L=this.generateDrawBar(y,!0),V=this.generateDrawLine(_,!0),this.updateBarForSubchart(o),this.updateLineForSubchart(o),this.updateAreaForSubchart(o),this.redrawBarForSubchart(L,o,o),this.redrawLineForSubchart(V,o,o),this.redrawAreaForSubchart(T,o,o)))},w.redrawForBrush=function(){var e=this.x;this.redraw({withTransition:!1,withY:this.config.zoom_rescale,withSubchart:!1,withUpdateXDomain:!0,withDimension:!1}),this.config.subchart_onbrush.call(this.api,e.orgDomain())},w.transformContext=function(e,n){var o;n&&n.axisSubX?o=n.axisSubX:(o=this.context.select("."+P.axisX),e&&(o=o.transition())),this.context.attr("transform",this.getTranslate("context")),o.attr("transform",this.getTranslate("subx"))},w.getDefaultExtent=function(){var e=this.config,n=Ht(e.axis_x_extent)?e.axis_x_extent(this.getXDomain(this.data.targets)):e.axis_x_extent;return this.isTimeSeries()&&(n=[this.parseDate(n[0]),this.parseDate(n[1])]),n},w.initText=function(){this.main.select("."+P.chart).append("g").attr("class",P.chartTexts),this.mainText=this.d3.selectAll([])},w.updateTargetsForText=function(e){var n=this.classChartText.bind(this),o=this.classTexts.bind(this),l=this.classFocus.bind(this);this.main.select("."+P.chartTexts).selectAll("."+P.chartText).data(e).attr("class",function(p){return n(p)+l(p)}).enter().append("g").attr("class",n).style("opacity",0).style("pointer-events","none").append("g").attr("class",o)},w.updateText=function(e){var n=this,o=n.config,l=n.barOrLineData.bind(n),p=n.classText.bind(n);n.mainText=n.main.selectAll("."+P.texts).selectAll("."+P.text).data(l),n.mainText.enter().append("text").attr("class",p).attr("text-anchor",function(y){return o.axis_rotated?y.value<0?"end":"start":"middle"}).style("stroke","none").style("fill",function(y){return n.color(y)}).style("fill-opacity",0),n.mainText.text(function(y,_,T){return n.dataLabelFormat(y.id)(y.value,y.id,_,T)}),n.mainText.exit().transition().duration(e).style("fill-opacity",0).remove()},w.redrawText=function(e,n,o,l){return[(l?this.mainText.transition():this.mainText).attr("x",e).attr("y",n).style("fill",this.color).style("fill-opacity",o?0:this.opacityForText.bind(this))]},w.getTextRect=function(e,n,o){var l,p=this.d3.select("body").append("div").classed("c3",!0),y=p.append("svg").style("visibility","hidden").style("position","fixed").style("top",0).style("left",0),_=this.d3.select(o).style("font");return y.selectAll(".dummy").data([e]).enter().append("text").classed(n||"",!0).style("font",_).text(e).each(function(){l=this.getBoundingClientRect()}),p.remove(),l},w.generateXYForText=function(e,n,o,l){var p=this,y=p.generateGetAreaPoints(e,!1),_=p.generateGetBarPoints(n,!1),T=p.generateGetLinePoints(o,!1),L=l?p.getXForText:p.getYForText;return function(V,F){var q=p.isAreaType(V)?y:p.isBarType(V)?_:T;return L.call(p,q(V,F),V,this)}},w.getXForText=function(e,n,o){var l,p,y=o.getBoundingClientRect();return this.config.axis_rotated?(p=this.isBarType(n)?4:6,l=e[2][1]+p*(n.value<0?-1:1)):l=this.hasType("bar")?(e[2][0]+e[0][0])/2:e[0][0],n.value===null&&(l>this.width?l=this.width-y.width:l<0&&(l=4)),l},w.getYForText=function(e,n,o){var l,p=o.getBoundingClientRect();return this.config.axis_rotated?l=(e[0][0]+e[2][0]+.6*p.height)/2:(l=e[2][1],n.value<0||n.value===0&&!this.hasPositiveValue?(l+=p.height,this.isBarType(n)&&this.isSafari()?l-=3:!this.isBarType(n)&&this.isChrome()&&(l+=3)):l+=this.isBarType(n)?-3:-6),n.value!==null||this.config.axis_rotated||(l<p.height?l=p.height:l>this.height&&(l=this.height-4)),l},w.initTitle=function(){this.title=this.svg.append("text").text(this.config.title_text).attr("class",this.CLASS.title)},w.redrawTitle=function(){this.title.attr("x",this.xForTitle.bind(this)).attr("y",this.yForTitle.bind(this))},w.xForTitle=function(){var e=this.config,n=e.title_position||"left";return n.indexOf("right")>=0?this.currentWidth-this.getTextRect(this.title.node().textContent,this.CLASS.title,this.title.node()).width-e.title_padding.right:n.indexOf("center")>=0?(this.currentWidth-this.getTextRect(this.title.node().textContent,this.CLASS.title,this.title.node()).width)/2:e.title_padding.left},w.yForTitle=function(){return this.config.title_padding.top+this.getTextRect(this.title.node().textContent,this.CLASS.title,this.title.node()).height},w.getTitlePadding=function(){return this.yForTitle()+this.config.title_padding.bottom},w.initTooltip=function(){var e,n=this,o=n.config;if(n.tooltip=n.selectChart.style("position","relative").append("div").attr("class",P.tooltipContainer).style("position","absolute").style("pointer-events","none").style("display","none"),o.tooltip_init_show){if(n.isTimeSeries()&&yt(o.tooltip_init_x)){for(o.tooltip_init_x=n.parseDate(o.tooltip_init_x),e=0;e<n.data.targets[0].values.length&&n.data.targets[0].values[e].x-o.tooltip_init_x!=0;e++);o.tooltip_init_x=e}n.tooltip.html(o.tooltip_contents.call(n,n.data.targets.map(function(l){return n.addName(l.values[o.tooltip_init_x])}),n.axis.getXAxisTickFormat(),n.getYFormat(n.hasArcType()),n.color)),n.tooltip.style("top",o.tooltip_init_position.top).style("left",o.tooltip_init_position.left).style("display","block")}},w.getTooltipSortFunction=function(){var e=this.config;if(e.data_groups.length!==0&&e.tooltip_order===void 0){var n=this.orderTargets(this.data.targets).map(function(y){return y.id});return(this.isOrderAsc()||this.isOrderDesc())&&(n=n.reverse()),function(y,_){return n.indexOf(y.id)-n.indexOf(_.id)}}var o=e.tooltip_order;o===void 0&&(o=e.data_order);var l=function(y){return y?y.value:null};if(yt(o)&&o.toLowerCase()==="asc")return function(y,_){return l(y)-l(_)};if(yt(o)&&o.toLowerCase()==="desc")return function(y,_){return l(_)-l(y)};if(Ht(o)){var p=o;return e.tooltip_order===void 0&&(p=function(y,_){return o(y?{id:y.id,values:[y]}:null,_?{id:_.id,values:[_]}:null)}),p}return Ut(o)?function(y,_){return o.indexOf(y.id)-o.indexOf(_.id)}:void 0},w.getTooltipContent=function(e,n,o,l){var p,y,_,T,L,V,F=this.config,q=F.tooltip_format_title||n,ot=F.tooltip_format_name||function(kt){return kt},lt=F.tooltip_format_value||o,ht=this.getTooltipSortFunction();for(ht&&e.sort(ht),y=0;y<e.length;y++)if(e[y]&&(e[y].value||e[y].value===0)&&(p||(_=pt(q?q(e[y].x):e[y].x),p="<table class='"+this.CLASS.tooltip+"'>"+(_||_===0?"<tr><th colspan='2'>"+_+"</th></tr>":"")),(T=pt(lt(e[y].value,e[y].ratio,e[y].id,e[y].index,e)))!==void 0)){if(e[y].name===null)continue;L=pt(ot(e[y].name,e[y].ratio,e[y].id,e[y].index)),V=this.levelColor?this.levelColor(e[y].value):l(e[y].id),p+="<tr class='"+this.CLASS.tooltipName+"-"+this.getTargetSelectorSuffix(e[y].id)+"'>",p+="<td class='name'><span style='background-color:"+V+"'></span>"+L+"</td>",p+="<td class='value'>"+T+"</td>",p+="</tr>"}return p+"</table>"},w.tooltipPosition=function(e,n,o,l){var p,y,_,T,L,V=this.config,F=this.d3,q=this.hasArcType(),ot=F.mouse(l);return q?(y=(this.width-(this.isLegendRight?this.getLegendWidth():0))/2+ot[0],T=this.height/2+ot[1]+20):(p=this.getSvgLeft(!0),V.axis_rotated?(_=(y=p+ot[0]+100)+n,L=this.currentWidth-this.getCurrentPaddingRight(),T=this.x(e[0].x)+20):(_=(y=p+this.getCurrentPaddingLeft(!0)+this.x(e[0].x)+20)+n,L=p+this.currentWidth-this.getCurrentPaddingRight(),T=ot[1]+15),_>L&&(y-=_-L+20),T+o>this.currentHeight&&(T-=o+30)),T<0&&(T=0),{top:T,left:y}},w.showTooltip=function(e,n){var o,l,p,y=this.config,_=this.hasArcType(),T=e.filter(function(V){return V&&jt(V.value)}),L=y.tooltip_position||w.tooltipPosition;T.length!==0&&y.tooltip_show&&(this.tooltip.html(y.tooltip_contents.call(this,e,this.axis.getXAxisTickFormat(),this.getYFormat(_),this.color)).style("display","block"),o=this.tooltip.property("offsetWidth"),l=this.tooltip.property("offsetHeight"),p=L.call(this,T,o,l,n),this.tooltip.style("top",p.top+"px").style("left",p.left+"px"))},w.hideTooltip=function(){this.tooltip.style("display","none")},w.setTargetType=function(e,n){var o=this,l=o.config;o.mapToTargetIds(e).forEach(function(p){o.withoutFadeIn[p]=n===l.data_types[p],l.data_types[p]=n}),e||(l.data_type=n)},w.hasType=function(e,n){var o=this.config.data_types,l=!1;return(n=n||this.data.targets)&&n.length?n.forEach(function(p){var y=o[p.id];(y&&y.indexOf(e)>=0||!y&&e==="line")&&(l=!0)}):Object.keys(o).length?Object.keys(o).forEach(function(p){o[p]===e&&(l=!0)}):l=this.config.data_type===e,l},w.hasArcType=function(e){return this.hasType("pie",e)||this.hasType("donut",e)||this.hasType("gauge",e)},w.isLineType=function(e){var n=this.config,o=yt(e)?e:e.id;return!n.data_types[o]||["line","spline","area","area-spline","step","area-step"].indexOf(n.data_types[o])>=0},w.isStepType=function(e){var n=yt(e)?e:e.id;return["step","area-step"].indexOf(this.config.data_types[n])>=0},w.isSplineType=function(e){var n=yt(e)?e:e.id;return["spline","area-spline"].indexOf(this.config.data_types[n])>=0},w.isAreaType=function(e){var n=yt(e)?e:e.id;return["area","area-spline","area-step"].indexOf(this.config.data_types[n])>=0},w.isBarType=function(e){var n=yt(e)?e:e.id;return this.config.data_types[n]==="bar"},w.isScatterType=function(e){var n=yt(e)?e:e.id;return this.config.data_types[n]==="scatter"},w.isPieType=function(e){var n=yt(e)?e:e.id;return this.config.data_types[n]==="pie"},w.isGaugeType=function(e){var n=yt(e)?e:e.id;return this.config.data_types[n]==="gauge"},w.isDonutType=function(e){var n=yt(e)?e:e.id;return this.config.data_types[n]==="donut"},w.isArcType=function(e){return this.isPieType(e)||this.isDonutType(e)||this.isGaugeType(e)},w.lineData=function(e){return this.isLineType(e)?[e]:[]},w.arcData=function(e){return this.isArcType(e.data)?[e]:[]},w.barData=function(e){return this.isBarType(e)?e.values:[]},w.lineOrScatterData=function(e){return this.isLineType(e)||this.isScatterType(e)?e.values:[]},w.barOrLineData=function(e){return this.isBarType(e)||this.isLineType(e)?e.values:[]},w.isInterpolationType=function(e){return["linear","linear-closed","basis","basis-open","basis-closed","bundle","cardinal","cardinal-open","cardinal-closed","monotone"].indexOf(e)>=0},w.isSafari=function(){var e=window.navigator.userAgent;return e.indexOf("Safari")>=0&&e.indexOf("Chrome")<0},w.isChrome=function(){return window.navigator.userAgent.indexOf("Chrome")>=0},w.initZoom=function(){var e,n=this,o=n.d3,l=n.config;n.zoom=o.behavior.zoom().on("zoomstart",function(){e=o.event.sourceEvent,n.zoom.altDomain=o.event.sourceEvent.altKey?n.x.orgDomain():null,l.zoom_onzoomstart.call(n.api,o.event.sourceEvent)}).on("zoom",function(){n.redrawForZoom.call(n)}).on("zoomend",function(){var p=o.event.sourceEvent;p&&e.clientX===p.clientX&&e.clientY===p.clientY||(n.redrawEventRect(),n.updateZoom(),l.zoom_onzoomend.call(n.api,n.x.orgDomain()))}),n.zoom.scale=function(p){return l.axis_rotated?this.y(p):this.x(p)},n.zoom.orgScaleExtent=function(){var p=l.zoom_extent?l.zoom_extent:[1,10];return[p[0],Math.max(n.getMaxDataCount()/p[1],p[1])]},n.zoom.updateScaleExtent=function(){var p=ut(n.x.orgDomain())/ut(n.getZoomDomain()),y=this.orgScaleExtent();return this.scaleExtent([y[0]*p,y[1]*p]),this}},w.getZoomDomain=function(){var e=this.config,n=this.d3;return[n.min([this.orgXDomain[0],e.zoom_x_min]),n.max([this.orgXDomain[1],e.zoom_x_max])]},w.updateZoom=function(){var e=this.config.zoom_enabled?this.zoom:function(){};this.main.select("."+P.zoomRect).call(e).on("dblclick.zoom",null),this.main.selectAll("."+P.eventRect).call(e).on("dblclick.zoom",null)},w.redrawForZoom=function(){var e=this.d3,n=this.config,o=this.zoom,l=this.x;if(n.zoom_enabled&&this.filterTargetsToShow(this.data.targets).length!==0){if(e.event.sourceEvent.type==="mousemove"&&o.altDomain)return l.domain(o.altDomain),void o.scale(l).updateScaleExtent();this.isCategorized()&&l.orgDomain()[0]===this.orgXDomain[0]&&l.domain([this.orgXDomain[0]-1e-10,l.orgDomain()[1]]),this.redraw({withTransition:!1,withY:n.zoom_rescale,withSubchart:!1,withEventRect:!1,withDimension:!1}),e.event.sourceEvent.type==="mousemove"&&(this.cancelClick=!0),n.zoom_onzoom.call(this.api,l.orgDomain())}},Le})},{d3:3}],2:[function(fe,pe,le){function st(dt){if(dt)return(function(H){for(var B in st.prototype)H[B]=st.prototype[B];return H})(dt)}pe!==void 0&&(pe.exports=st),st.prototype.on=st.prototype.addEventListener=function(dt,H){return this._callbacks=this._callbacks||{},(this._callbacks["$"+dt]=this._callbacks["$"+dt]||[]).push(H),this},st.prototype.once=function(dt,H){function B(){this.off(dt,B),H.apply(this,arguments)}return B.fn=H,this.on(dt,B),this},st.prototype.off=st.prototype.removeListener=st.prototype.removeAllListeners=st.prototype.removeEventListener=function(dt,H){if(this._callbacks=this._callbacks||{},arguments.length==0)return this._callbacks={},this;var B=this._callbacks["$"+dt];if(!B)return this;if(arguments.length==1)return delete this._callbacks["$"+dt],this;for(var $,mt=0;mt<B.length;mt++)if(($=B[mt])===H||$.fn===H){B.splice(mt,1);break}return this},st.prototype.emit=function(dt){this._callbacks=this._callbacks||{};var H=[].slice.call(arguments,1),B=this._callbacks["$"+dt];if(B)for(var $=0,mt=(B=B.slice(0)).length;$<mt;++$)B[$].apply(this,H);return this},st.prototype.listeners=function(dt){return this._callbacks=this._callbacks||{},this._callbacks["$"+dt]||[]},st.prototype.hasListeners=function(dt){return!!this.listeners(dt).length}},{}],3:[function(fe,pe,le){(function(){function st(t){return t&&(t.ownerDocument||t.document||t).documentElement}function dt(t){return t&&(t.ownerDocument&&t.ownerDocument.defaultView||t.document&&t||t.defaultView)}function H(t,i){return t<i?-1:t>i?1:t>=i?0:NaN}function B(t){return t===null?NaN:+t}function $(t){return!isNaN(t)}function mt(t){return{left:function(i,r,a,s){for(arguments.length<3&&(a=0),arguments.length<4&&(s=i.length);a<s;){var u=a+s>>>1;t(i[u],r)<0?a=u+1:s=u}return a},right:function(i,r,a,s){for(arguments.length<3&&(a=0),arguments.length<4&&(s=i.length);a<s;){var u=a+s>>>1;t(i[u],r)>0?s=u:a=u+1}return a}}}function P(t){return t.length}function Zt(t,i){for(var r in i)Object.defineProperty(t.prototype,r,{value:i[r],enumerable:!1})}function ft(){this._=Object.create(null)}function ae(t){return(t+="")===_u||t[0]===Er?Er+t:t}function jt(t){return(t+="")[0]===Er?t.slice(1):t}function Ht(t){return ae(t)in this._}function Ut(t){return(t=ae(t))in this._&&delete this._[t]}function yt(){var t=[];for(var i in this._)t.push(jt(i));return t}function Lt(){var t=0;for(var i in this._)++t;return t}function nt(){for(var t in this._)return!1;return!0}function at(){this._=Object.create(null)}function K(t){return t}function ut(t,i){if(i in t)return i;i=i.charAt(0).toUpperCase()+i.slice(1);for(var r=0,a=Po.length;r<a;++r){var s=Po[r]+i;if(s in t)return s}}function vt(){}function Ct(){}function Y(t){function i(){for(var s,u=r,f=-1,h=u.length;++f<h;)(s=u[f].on)&&s.apply(this,arguments);return t}var r=[],a=new ft;return i.on=function(s,u){var f,h=a.get(s);return arguments.length<2?h&&h.on:(h&&(h.on=null,r=r.slice(0,f=r.indexOf(h)).concat(r.slice(f+1)),a.remove(s)),u&&r.push(a.set(s,{on:u})),t)},i}function Q(){b.event.preventDefault()}function pt(){for(var t,i=b.event;t=i.sourceEvent;)i=t;return i}function Xt(t){for(var i=new Ct,r=0,a=arguments.length;++r<a;)i[arguments[r]]=Y(i);return i.of=function(s,u){return function(f){try{var h=f.sourceEvent=b.event;f.target=t,b.event=f,i[f.type].apply(s,u)}finally{b.event=h}}},i}function ne(t){return bi(t,ge),t}function bt(t){return typeof t=="function"?t:function(){return Lr(t,this)}}function w(t){return typeof t=="function"?t:function(){return kr(t,this)}}function Le(t,i){return t=b.ns.qualify(t),i==null?t.local?function(){this.removeAttributeNS(t.space,t.local)}:function(){this.removeAttribute(t)}:typeof i=="function"?t.local?function(){var r=i.apply(this,arguments);r==null?this.removeAttributeNS(t.space,t.local):this.setAttributeNS(t.space,t.local,r)}:function(){var r=i.apply(this,arguments);r==null?this.removeAttribute(t):this.setAttribute(t,r)}:t.local?function(){this.setAttributeNS(t.space,t.local,i)}:function(){this.setAttribute(t,i)}}function e(t){return t.trim().replace(/\s+/g," ")}function n(t){return new RegExp("(?:^|\\s+)"+b.requote(t)+"(?:\\s+|$)","g")}function o(t){return(t+"").trim().split(/^|\s+/)}function l(t,i){var r=(t=o(t).map(p)).length;return typeof i=="function"?function(){for(var a=-1,s=i.apply(this,arguments);++a<r;)t[a](this,s)}:function(){for(var a=-1;++a<r;)t[a](this,i)}}function p(t){var i=n(t);return function(r,a){if(s=r.classList)return a?s.add(t):s.remove(t);var s=r.getAttribute("class")||"";a?(i.lastIndex=0,i.test(s)||r.setAttribute("class",e(s+" "+t))):r.setAttribute("class",e(s.replace(i," ")))}}function y(t,i,r){return i==null?function(){this.style.removeProperty(t)}:typeof i=="function"?function(){var a=i.apply(this,arguments);a==null?this.style.removeProperty(t):this.style.setProperty(t,a,r)}:function(){this.style.setProperty(t,i,r)}}function _(t,i){return i==null?function(){delete this[t]}:typeof i=="function"?function(){var r=i.apply(this,arguments);r==null?delete this[t]:this[t]=r}:function(){this[t]=i}}function T(t){return typeof t=="function"?t:(t=b.ns.qualify(t)).local?function(){return this.ownerDocument.createElementNS(t.space,t.local)}:function(){var i=this.ownerDocument,r=this.namespaceURI;return r===Or&&i.documentElement.namespaceURI===Or?i.createElement(t):i.createElementNS(r,t)}}function L(){var t=this.parentNode;t&&t.removeChild(this)}function V(t){return{__data__:t}}function F(t){return function(){return Dr(this,t)}}function q(t,i){for(var r=0,a=t.length;r<a;r++)for(var s,u=t[r],f=0,h=u.length;f<h;f++)(s=u[f])&&i(s,f,r);return t}function ot(t){return bi(t,nn),t}function lt(t,i,r){function a(){var d=this[s];d&&(this.removeEventListener(t,d,d.$),delete this[s])}var s="__on"+t,u=t.indexOf("."),f=ht;u>0&&(t=t.slice(0,u));var h=Gr.get(t);return h&&(t=h,f=kt),u?i?function(){var d=f(i,Je(arguments));a.call(this),this.addEventListener(t,this[s]=d,d.$=r),d._=i}:a:i?vt:function(){var d,g=new RegExp("^__on([^.]+)"+b.requote(t)+"$");for(var m in this)if(d=m.match(g)){var v=this[m];this.removeEventListener(d[1],v,v.$),delete this[m]}}}function ht(t,i){return function(r){var a=b.event;b.event=r,i[0]=this.__data__;try{t.apply(this,i)}finally{b.event=a}}}function kt(t,i){var r=ht(t,i);return function(a){var s=a.relatedTarget;s&&(s===this||8&s.compareDocumentPosition(this))||r.call(this,a)}}function Rt(t){var i=".dragsuppress-"+ ++bu,r="click"+i,a=b.select(dt(t)).on("touchmove"+i,Q).on("dragstart"+i,Q).on("selectstart"+i,Q);if(xn==null&&(xn=!("onselectstart"in t)&&ut(t.style,"userSelect")),xn){var s=st(t).style,u=s[xn];s[xn]="none"}return function(f){if(a.on(i,null),xn&&(s[xn]=u),f){var h=function(){a.on(r,null)};a.on(r,function(){Q(),h()},!0),setTimeout(h,0)}}}function qt(t,i){i.changedTouches&&(i=i.changedTouches[0]);var r=t.ownerSVGElement||t;if(r.createSVGPoint){var a=r.createSVGPoint();if(Ir<0){var s=dt(t);if(s.scrollX||s.scrollY){var u=(r=b.select("body").append("svg").style({position:"absolute",top:0,left:0,margin:0,padding:0,border:"none"},"important"))[0][0].getScreenCTM();Ir=!(u.f||u.e),r.remove()}}return Ir?(a.x=i.pageX,a.y=i.pageY):(a.x=i.clientX,a.y=i.clientY),a=a.matrixTransform(t.getScreenCTM().inverse()),[a.x,a.y]}var f=t.getBoundingClientRect();return[i.clientX-f.left-t.clientLeft,i.clientY-f.top-t.clientTop]}function Jt(){return b.event.changedTouches[0].identifier}function Yt(t){return t>0?1:t<0?-1:0}function j(t,i,r){return(i[0]-t[0])*(r[1]-t[1])-(i[1]-t[1])*(r[0]-t[0])}function tt(t){return t>1?0:t<-1?$t:Math.acos(t)}function Ot(t){return t>1?Me:t<-1?-Me:Math.asin(t)}function xt(t){return((t=Math.exp(t))+1/t)/2}function St(t){return(t=Math.sin(t/2))*t}function re(){}function zt(t,i,r){return this instanceof zt?(this.h=+t,this.s=+i,void(this.l=+r)):arguments.length<2?t instanceof zt?new zt(t.h,t.s,t.l):gt(""+t,Tt,zt):new zt(t,i,r)}function oe(t,i,r){function a(f){return Math.round(255*(function(h){return h>360?h-=360:h<0&&(h+=360),h<60?s+(u-s)*h/60:h<180?u:h<240?s+(u-s)*(240-h)/60:s})(f))}var s,u;return t=isNaN(t)?0:(t%=360)<0?t+360:t,i=isNaN(i)||i<0?0:i>1?1:i,r=r<0?0:r>1?1:r,u=r<=.5?r*(1+i):r+i-r*i,s=2*r-u,new c(a(t+120),a(t),a(t-120))}function te(t,i,r){return this instanceof te?(this.h=+t,this.c=+i,void(this.l=+r)):arguments.length<2?t instanceof te?new te(t.h,t.c,t.l):t instanceof ye?Ve(t.l,t.a,t.b):Ve((t=Gt((t=b.rgb(t)).r,t.g,t.b)).l,t.a,t.b):new te(t,i,r)}function Ft(t,i,r){return isNaN(t)&&(t=0),isNaN(i)&&(i=0),new ye(r,Math.cos(t*=Bt)*i,Math.sin(t)*i)}function ye(t,i,r){return this instanceof ye?(this.l=+t,this.a=+i,void(this.b=+r)):arguments.length<2?t instanceof ye?new ye(t.l,t.a,t.b):t instanceof te?Ft(t.h,t.c,t.l):Gt((t=c(t)).r,t.g,t.b):new ye(t,i,r)}function ct(t,i,r){var a=(t+16)/116,s=a+i/500,u=a-r/200;return s=we(s)*Eo,a=we(a)*Lo,u=we(u)*ko,new c(Pt(3.2404542*s-1.5371385*a-.4985314*u),Pt(-.969266*s+1.8760108*a+.041556*u),Pt(.0556434*s-.2040259*a+1.0572252*u))}function Ve(t,i,r){return t>0?new te(Math.atan2(r,i)*be,Math.sqrt(i*i+r*r),t):new te(NaN,NaN,t)}function we(t){return t>.206893034?t*t*t:(t-4/29)/7.787037}function he(t){return t>.008856?Math.pow(t,1/3):7.787037*t+4/29}function Pt(t){return Math.round(255*(t<=.00304?12.92*t:1.055*Math.pow(t,1/2.4)-.055))}function c(t,i,r){return this instanceof c?(this.r=~~t,this.g=~~i,void(this.b=~~r)):arguments.length<2?t instanceof c?new c(t.r,t.g,t.b):gt(""+t,c,oe):new c(t,i,r)}function I(t){return new c(t>>16,t>>8&255,255&t)}function X(t){return I(t)+""}function W(t){return t<16?"0"+Math.max(0,t).toString(16):Math.min(255,t).toString(16)}function gt(t,i,r){var a,s,u,f=0,h=0,d=0;if(a=/([a-z]+)\((.*)\)/.exec(t=t.toLowerCase()))switch(s=a[2].split(","),a[1]){case"hsl":return r(parseFloat(s[0]),parseFloat(s[1])/100,parseFloat(s[2])/100);case"rgb":return i(ie(s[0]),ie(s[1]),ie(s[2]))}return(u=Pi.get(t))?i(u.r,u.g,u.b):(t==null||t.charAt(0)!=="#"||isNaN(u=parseInt(t.slice(1),16))||(t.length===4?(f=(3840&u)>>4,f|=f>>4,h=240&u,h|=h>>4,d=15&u,d|=d<<4):t.length===7&&(f=(16711680&u)>>16,h=(65280&u)>>8,d=255&u)),i(f,h,d))}function Tt(t,i,r){var a,s,u=Math.min(t/=255,i/=255,r/=255),f=Math.max(t,i,r),h=f-u,d=(f+u)/2;return h?(s=d<.5?h/(f+u):h/(2-f-u),a=t==f?(i-r)/h+(i<r?6:0):i==f?(r-t)/h+2:(t-i)/h+4,a*=60):(a=NaN,s=d>0&&d<1?0:a),new zt(a,s,d)}function Gt(t,i,r){var a=he((.4124564*(t=Et(t))+.3575761*(i=Et(i))+.1804375*(r=Et(r)))/Eo),s=he((.2126729*t+.7151522*i+.072175*r)/Lo);return ye(116*s-16,500*(a-s),200*(s-he((.0193339*t+.119192*i+.9503041*r)/ko)))}function Et(t){return(t/=255)<=.04045?t/12.92:Math.pow((t+.055)/1.055,2.4)}function ie(t){var i=parseFloat(t);return t.charAt(t.length-1)==="%"?Math.round(2.55*i):i}function It(t){return typeof t=="function"?t:function(){return t}}function xe(t){return function(i,r,a){return arguments.length===2&&typeof r=="function"&&(a=r,r=null),me(i,r,t,a)}}function me(t,i,r,a){function s(){var m,v=d.status;if(!v&&(function(x){var S=x.responseType;return S&&S!=="text"?x.response:x.responseText})(d)||v>=200&&v<300||v===304){try{m=r.call(u,d)}catch(x){return void f.error.call(u,x)}f.load.call(u,m)}else f.error.call(u,d)}var u={},f=b.dispatch("beforesend","progress","load","error"),h={},d=new XMLHttpRequest,g=null;return!this.XDomainRequest||"withCredentials"in d||!/^(http(s)?:)?\/\//.test(t)||(d=new XDomainRequest),"onload"in d?d.onload=d.onerror=s:d.onreadystatechange=function(){d.readyState>3&&s()},d.onprogress=function(m){var v=b.event;b.event=m;try{f.progress.call(u,d)}finally{b.event=v}},u.header=function(m,v){return m=(m+"").toLowerCase(),arguments.length<2?h[m]:(v==null?delete h[m]:h[m]=v+"",u)},u.mimeType=function(m){return arguments.length?(i=m==null?null:m+"",u):i},u.responseType=function(m){return arguments.length?(g=m,u):g},u.response=function(m){return r=m,u},["get","post"].forEach(function(m){u[m]=function(){return u.send.apply(u,[m].concat(Je(arguments)))}}),u.send=function(m,v,x){if(arguments.length===2&&typeof v=="function"&&(x=v,v=null),d.open(m,t,!0),i==null||"accept"in h||(h.accept=i+",*/*"),d.setRequestHeader)for(var S in h)d.setRequestHeader(S,h[S]);return i!=null&&d.overrideMimeType&&d.overrideMimeType(i),g!=null&&(d.responseType=g),x!=null&&u.on("error",x).on("load",function(A){x(null,A)}),f.beforesend.call(u,d),d.send(v==null?null:v),u},u.abort=function(){return d.abort(),u},b.rebind(u,f,"on"),a==null?u:u.get((function(m){return m.length===1?function(v,x){m(v==null?x:null)}:m})(a))}function Te(t,i,r){var a=arguments.length;a<2&&(i=0),a<3&&(r=Date.now());var s={c:t,t:r+i,n:null};return Ei?Ei.n=s:Mi=s,Ei=s,Li||(ki=clearTimeout(ki),Li=1,Do(Ae)),s}function Ae(){var t=on(),i=We()-t;i>24?(isFinite(i)&&(clearTimeout(ki),ki=setTimeout(Ae,i)),Li=0):(Li=1,Do(Ae))}function on(){for(var t=Date.now(),i=Mi;i;)t>=i.t&&i.c(t-i.t)&&(i.c=null),i=i.n;return t}function We(){for(var t,i=Mi,r=1/0;i;)i.c?(i.t<r&&(r=i.t),i=(t=i).n):i=t?t.n=i.n:Mi=i.n;return Ei=t,r}function sn(t,i){return i-(t?Math.ceil(Math.log(t)/Math.LN10):1)}function He(){this._=new Date(arguments.length>1?Date.UTC.apply(this,arguments):arguments[0])}function Ye(t,i,r){function a(d){var g=t(d),m=u(g,1);return d-g<m-d?g:m}function s(d){return i(d=t(new Ne(d-1)),1),d}function u(d,g){return i(d=new Ne(+d),g),d}function f(d,g,m){var v=s(d),x=[];if(m>1)for(;v<g;)r(v)%m||x.push(new Date(+v)),i(v,1);else for(;v<g;)x.push(new Date(+v)),i(v,1);return x}t.floor=t,t.round=a,t.ceil=s,t.offset=u,t.range=f;var h=t.utc=An(t);return h.floor=h,h.round=An(a),h.ceil=An(s),h.offset=An(u),h.range=function(d,g,m){try{Ne=He;var v=new He;return v._=d,f(v,g,m)}finally{Ne=Date}},t}function An(t){return function(i,r){try{Ne=He;var a=new He;return a._=i,t(a,r)._}finally{Ne=Date}}}function ze(t,i,r){var a=t<0?"-":"",s=(a?-t:t)+"",u=s.length;return a+(u<r?new Array(r-u+1).join(i)+s:s)}function Jn(t){return new RegExp("^(?:"+t.map(b.requote).join("|")+")","i")}function ti(t){for(var i=new ft,r=-1,a=t.length;++r<a;)i.set(t[r].toLowerCase(),r);return i}function as(t,i,r){Ee.lastIndex=0;var a=Ee.exec(i.slice(r,r+1));return a?(t.w=+a[0],r+a[0].length):-1}function os(t,i,r){Ee.lastIndex=0;var a=Ee.exec(i.slice(r));return a?(t.U=+a[0],r+a[0].length):-1}function ss(t,i,r){Ee.lastIndex=0;var a=Ee.exec(i.slice(r));return a?(t.W=+a[0],r+a[0].length):-1}function us(t,i,r){Ee.lastIndex=0;var a=Ee.exec(i.slice(r,r+4));return a?(t.y=+a[0],r+a[0].length):-1}function cs(t,i,r){Ee.lastIndex=0;var a=Ee.exec(i.slice(r,r+2));return a?(t.y=(function(s){return s+(s>68?1900:2e3)})(+a[0]),r+a[0].length):-1}function ls(t,i,r){return/^[+-]\d{4}$/.test(i=i.slice(r,r+5))?(t.Z=-i,r+5):-1}function hs(t,i,r){Ee.lastIndex=0;var a=Ee.exec(i.slice(r,r+2));return a?(t.m=a[0]-1,r+a[0].length):-1}function $r(t,i,r){Ee.lastIndex=0;var a=Ee.exec(i.slice(r,r+2));return a?(t.d=+a[0],r+a[0].length):-1}function ds(t,i,r){Ee.lastIndex=0;var a=Ee.exec(i.slice(r,r+3));return a?(t.j=+a[0],r+a[0].length):-1}function Kr(t,i,r){Ee.lastIndex=0;var a=Ee.exec(i.slice(r,r+2));return a?(t.H=+a[0],r+a[0].length):-1}function fs(t,i,r){Ee.lastIndex=0;var a=Ee.exec(i.slice(r,r+2));return a?(t.M=+a[0],r+a[0].length):-1}function gs(t,i,r){Ee.lastIndex=0;var a=Ee.exec(i.slice(r,r+2));return a?(t.S=+a[0],r+a[0].length):-1}function ps(t,i,r){Ee.lastIndex=0;var a=Ee.exec(i.slice(r,r+3));return a?(t.L=+a[0],r+a[0].length):-1}function ms(t){var i=t.getTimezoneOffset(),r=i>0?"-":"+",a=Wt(i)/60|0,s=Wt(i)%60;return r+ze(a,"0",2)+ze(s,"0",2)}function vs(t,i,r){Go.lastIndex=0;var a=Go.exec(i.slice(r,r+1));return a?r+a[0].length:-1}function ys(t){for(var i=t.length,r=-1;++r<i;)t[r][0]=this(t[r][0]);return function(a){for(var s=0,u=t[s];!u[1](a);)u=t[++s];return u[0](a)}}function Wi(){}function Jr(t,i,r){var a=r.s=t+i,s=a-t,u=a-s;r.t=t-u+(i-s)}function ei(t,i){t&&jo.hasOwnProperty(t.type)&&jo[t.type](t,i)}function Qi(t,i,r){var a,s=-1,u=t.length-r;for(i.lineStart();++s<u;)a=t[s],i.point(a[0],a[1],a[2]);i.lineEnd()}function ta(t,i){var r=-1,a=t.length;for(i.polygonStart();++r<a;)Qi(t[r],i,1);i.polygonEnd()}function xs(){function t(f,h){h=h*Bt/2+$t/4;var d=(f*=Bt)-a,g=d>=0?1:-1,m=g*d,v=Math.cos(h),x=Math.sin(h),S=u*x,A=s*v+S*Math.cos(m),E=S*g*Math.sin(m);_n.add(Math.atan2(E,A)),a=f,s=v,u=x}var i,r,a,s,u;Be.point=function(f,h){Be.point=t,a=(i=f)*Bt,s=Math.cos(h=(r=h)*Bt/2+$t/4),u=Math.sin(h)},Be.lineEnd=function(){t(i,r)}}function pn(t){var i=t[0],r=t[1],a=Math.cos(r);return[a*Math.cos(i),a*Math.sin(i),Math.sin(r)]}function ni(t,i){return t[0]*i[0]+t[1]*i[1]+t[2]*i[2]}function Cn(t,i){return[t[1]*i[2]-t[2]*i[1],t[2]*i[0]-t[0]*i[2],t[0]*i[1]-t[1]*i[0]]}function Zi(t,i){t[0]+=i[0],t[1]+=i[1],t[2]+=i[2]}function ii(t,i){return[t[0]*i,t[1]*i,t[2]*i]}function ri(t){var i=Math.sqrt(t[0]*t[0]+t[1]*t[1]+t[2]*t[2]);t[0]/=i,t[1]/=i,t[2]/=i}function ai(t){return[Math.atan2(t[1],t[0]),Ot(t[2])]}function oi(t,i){return Wt(t[0]-i[0])<Mt&&Wt(t[1]-i[1])<Mt}function $i(t,i){t*=Bt;var r=Math.cos(i*=Bt);jn(r*Math.cos(t),r*Math.sin(t),Math.sin(i))}function jn(t,i,r){wn+=(t-wn)/++Vi,bn+=(i-bn)/Vi,an+=(r-an)/Vi}function ea(){function t(s,u){s*=Bt;var f=Math.cos(u*=Bt),h=f*Math.cos(s),d=f*Math.sin(s),g=Math.sin(u),m=Math.atan2(Math.sqrt((m=r*g-a*d)*m+(m=a*h-i*g)*m+(m=i*d-r*h)*m),i*h+r*d+a*g);Gi+=m,cn+=m*(i+(i=h)),ln+=m*(r+(r=d)),tn+=m*(a+(a=g)),jn(i,r,a)}var i,r,a;Ze.point=function(s,u){s*=Bt;var f=Math.cos(u*=Bt);i=f*Math.cos(s),r=f*Math.sin(s),a=Math.sin(u),Ze.point=t,jn(i,r,a)}}function na(){Ze.point=$i}function _s(){function t(f,h){f*=Bt;var d=Math.cos(h*=Bt),g=d*Math.cos(f),m=d*Math.sin(f),v=Math.sin(h),x=s*v-u*m,S=u*g-a*v,A=a*m-s*g,E=Math.sqrt(x*x+S*S+A*A),M=a*g+s*m+u*v,D=E&&-tt(M)/E,O=Math.atan2(E,M);On+=D*x,Vn+=D*S,hn+=D*A,Gi+=O,cn+=O*(a+(a=g)),ln+=O*(s+(s=m)),tn+=O*(u+(u=v)),jn(a,s,u)}var i,r,a,s,u;Ze.point=function(f,h){i=f,r=h,Ze.point=t,f*=Bt;var d=Math.cos(h*=Bt);a=d*Math.cos(f),s=d*Math.sin(f),u=Math.sin(h),jn(a,s,u)},Ze.lineEnd=function(){t(i,r),Ze.lineEnd=na,Ze.point=$i}}function ia(t,i){function r(a,s){return a=t(a,s),i(a[0],a[1])}return t.invert&&i.invert&&(r.invert=function(a,s){return(a=i.invert(a,s))&&t.invert(a[0],a[1])}),r}function Nn(){return!0}function ra(t,i,r,a,s){var u=[],f=[];if(t.forEach(function(E){if(!((M=E.length-1)<=0)){var M,D=E[0],O=E[M];if(oi(D,O)){s.lineStart();for(var k=0;k<M;++k)s.point((D=E[k])[0],D[1]);s.lineEnd()}else{var G=new si(D,E,null,!0),N=new si(D,null,G,!1);G.o=N,u.push(G),f.push(N),N=new si(O,null,G=new si(O,E,null,!1),!0),G.o=N,u.push(G),f.push(N)}}}),f.sort(i),aa(u),aa(f),u.length){for(var h=0,d=r,g=f.length;h<g;++h)f[h].e=d=!d;for(var m,v,x=u[0];;){for(var S=x,A=!0;S.v;)if((S=S.n)===x)return;m=S.z,s.lineStart();do{if(S.v=S.o.v=!0,S.e){if(A)for(h=0,g=m.length;h<g;++h)s.point((v=m[h])[0],v[1]);else a(S.x,S.n.x,1,s);S=S.n}else{if(A)for(h=(m=S.p.z).length-1;h>=0;--h)s.point((v=m[h])[0],v[1]);else a(S.x,S.p.x,-1,s);S=S.p}m=(S=S.o).z,A=!A}while(!S.v);s.lineEnd()}}}function aa(t){if(i=t.length){for(var i,r,a=0,s=t[0];++a<i;)s.n=r=t[a],r.p=s,s=r;s.n=r=t[0],r.p=s}}function si(t,i,r,a){this.x=t,this.z=i,this.o=r,this.e=a,this.v=!1,this.n=this.p=null}function oa(t,i,r,a){return function(s,u){function f(R,C){var z=s(R,C);t(R=z[0],C=z[1])&&u.point(R,C)}function h(R,C){var z=s(R,C);M.point(z[0],z[1])}function d(){O.point=h,M.lineStart()}function g(){O.point=f,M.lineEnd()}function m(R,C){E.push([R,C]);var z=s(R,C);G.point(z[0],z[1])}function v(){G.lineStart(),E=[]}function x(){m(E[0][0],E[0][1]),G.lineEnd();var R,C=G.clean(),z=k.buffer(),et=z.length;if(E.pop(),A.push(E),E=null,et)if(1&C){var it,U=-1;if((et=(R=z[0]).length-1)>0){for(N||(u.polygonStart(),N=!0),u.lineStart();++U<et;)u.point((it=R[U])[0],it[1]);u.lineEnd()}}else et>1&&2&C&&z.push(z.pop().concat(z.shift())),S.push(z.filter(ws))}var S,A,E,M=i(u),D=s.invert(a[0],a[1]),O={point:f,lineStart:d,lineEnd:g,polygonStart:function(){O.point=m,O.lineStart=v,O.lineEnd=x,S=[],A=[]},polygonEnd:function(){O.point=f,O.lineStart=d,O.lineEnd=g,S=b.merge(S);var R=(function(C,z){var et=C[0],it=C[1],U=[Math.sin(et),-Math.cos(et),0],Z=0,J=0;_n.reset();for(var At=0,wt=z.length;At<wt;++At){var rt=z[At],_t=rt.length;if(_t)for(var Dt=rt[0],Nt=Dt[0],Kt=Dt[1]/2+$t/4,ee=Math.sin(Kt),ce=Math.cos(Kt),Qt=1;;){Qt===_t&&(Qt=0);var se=(C=rt[Qt])[0],ue=C[1]/2+$t/4,_e=Math.sin(ue),ve=Math.cos(ue),ke=se-Nt,Ge=ke>=0?1:-1,Re=Ge*ke,Se=Re>$t,de=ee*_e;if(_n.add(Math.atan2(de*Ge*Math.sin(Re),ce*ve+de*Math.cos(Re))),Z+=Se?ke+Ge*Xe:ke,Se^Nt>=et^se>=et){var Ce=Cn(pn(Dt),pn(C));ri(Ce);var Pe=Cn(U,Ce);ri(Pe);var De=(Se^ke>=0?-1:1)*Ot(Pe[2]);(it>De||it===De&&(Ce[0]||Ce[1]))&&(J+=Se^ke>=0?1:-1)}if(!Qt++)break;Nt=se,ee=_e,ce=ve,Dt=C}}return(Z<-Mt||Z<Mt&&_n<-Mt)^1&J})(D,A);S.length?(N||(u.polygonStart(),N=!0),ra(S,bs,R,r,u)):R&&(N||(u.polygonStart(),N=!0),u.lineStart(),r(null,null,1,u),u.lineEnd()),N&&(u.polygonEnd(),N=!1),S=A=null},sphere:function(){u.polygonStart(),u.lineStart(),r(null,null,1,u),u.lineEnd(),u.polygonEnd()}},k=sa(),G=i(k),N=!1;return O}}function ws(t){return t.length>1}function sa(){var t,i=[];return{lineStart:function(){i.push(t=[])},point:function(r,a){t.push([r,a])},lineEnd:vt,buffer:function(){var r=i;return i=[],t=null,r},rejoin:function(){i.length>1&&i.push(i.pop().concat(i.shift()))}}}function bs(t,i){return((t=t.x)[0]<0?t[1]-Me-Mt:Me-t[1])-((i=i.x)[0]<0?i[1]-Me-Mt:Me-i[1])}function ua(t,i,r,a){return function(s){var u,f=s.a,h=s.b,d=f.x,g=f.y,m=0,v=1,x=h.x-d,S=h.y-g;if(u=t-d,x||!(u>0)){if(u/=x,x<0){if(u<m)return;u<v&&(v=u)}else if(x>0){if(u>v)return;u>m&&(m=u)}if(u=r-d,x||!(u<0)){if(u/=x,x<0){if(u>v)return;u>m&&(m=u)}else if(x>0){if(u<m)return;u<v&&(v=u)}if(u=i-g,S||!(u>0)){if(u/=S,S<0){if(u<m)return;u<v&&(v=u)}else if(S>0){if(u>v)return;u>m&&(m=u)}if(u=a-g,S||!(u<0)){if(u/=S,S<0){if(u>v)return;u>m&&(m=u)}else if(S>0){if(u<m)return;u<v&&(v=u)}return m>0&&(s.a={x:d+m*x,y:g+m*S}),v<1&&(s.b={x:d+v*x,y:g+v*S}),s}}}}}}function ca(t,i,r,a){function s(h,d){return Wt(h[0]-t)<Mt?d>0?0:3:Wt(h[0]-r)<Mt?d>0?2:1:Wt(h[1]-i)<Mt?d>0?1:0:d>0?3:2}function u(h,d){return f(h.x,d.x)}function f(h,d){var g=s(h,1),m=s(d,1);return g!==m?g-m:g===0?d[1]-h[1]:g===1?h[0]-d[0]:g===2?h[1]-d[1]:d[0]-h[0]}return function(h){function d(U,Z,J,At){var wt=0,rt=0;if(U==null||(wt=s(U,J))!==(rt=s(Z,J))||f(U,Z)<0^J>0)do At.point(wt===0||wt===3?t:r,wt>1?a:i);while((wt=(wt+J+4)%4)!==rt);else At.point(Z[0],Z[1])}function g(U,Z){return t<=U&&U<=r&&i<=Z&&Z<=a}function m(U,Z){g(U,Z)&&h.point(U,Z)}function v(U,Z){var J=g(U=Math.max(-Ii,Math.min(Ii,U)),Z=Math.max(-Ii,Math.min(Ii,Z)));if(S&&A.push([U,Z]),N)E=U,M=Z,D=J,N=!1,J&&(h.lineStart(),h.point(U,Z));else if(J&&G)h.point(U,Z);else{var At={a:{x:O,y:k},b:{x:U,y:Z}};et(At)?(G||(h.lineStart(),h.point(At.a.x,At.a.y)),h.point(At.b.x,At.b.y),J||h.lineEnd(),R=!1):J&&(h.lineStart(),h.point(U,Z),R=!1)}O=U,k=Z,G=J}var x,S,A,E,M,D,O,k,G,N,R,C=h,z=sa(),et=ua(t,i,r,a),it={point:m,lineStart:function(){it.point=v,S&&S.push(A=[]),N=!0,G=!1,O=k=NaN},lineEnd:function(){x&&(v(E,M),D&&G&&z.rejoin(),x.push(z.buffer())),it.point=m,G&&h.lineEnd()},polygonStart:function(){h=z,x=[],S=[],R=!0},polygonEnd:function(){h=C,x=b.merge(x);var U=(function(At){for(var wt=0,rt=S.length,_t=At[1],Dt=0;Dt<rt;++Dt)for(var Nt,Kt=1,ee=S[Dt],ce=ee.length,Qt=ee[0];Kt<ce;++Kt)Nt=ee[Kt],Qt[1]<=_t?Nt[1]>_t&&j(Qt,Nt,At)>0&&++wt:Nt[1]<=_t&&j(Qt,Nt,At)<0&&--wt,Qt=Nt;return wt!==0})([t,a]),Z=R&&U,J=x.length;(Z||J)&&(h.polygonStart(),Z&&(h.lineStart(),d(null,null,1,h),h.lineEnd()),J&&ra(x,u,U,d,h),h.polygonEnd()),x=S=A=null}};return it}}function Ki(t){var i=0,r=$t/3,a=Ji(t),s=a(i,r);return s.parallels=function(u){return arguments.length?a(i=u[0]*$t/180,r=u[1]*$t/180):[i/$t*180,r/$t*180]},s}function la(t,i){function r(h,d){var g=Math.sqrt(u-2*s*Math.sin(d))/s;return[g*Math.sin(h*=s),f-g*Math.cos(h)]}var a=Math.sin(t),s=(a+Math.sin(i))/2,u=1+a*(2*s-a),f=Math.sqrt(u)/s;return r.invert=function(h,d){var g=f-d;return[Math.atan2(h,g)/s,Ot((u-(h*h+g*g)*s*s)/(2*s))]},r}function Ss(){function t(u,f){Hr+=s*u-a*f,a=u,s=f}var i,r,a,s;dn.point=function(u,f){dn.point=t,i=a=u,r=s=f},dn.lineEnd=function(){t(i,r)}}function ha(t){return"m0,"+t+"a"+t+","+t+" 0 1,1 0,"+-2*t+"a"+t+","+t+" 0 1,1 0,"+2*t+"z"}function mn(t,i){wn+=t,bn+=i,++an}function da(){function t(a,s){var u=a-i,f=s-r,h=Math.sqrt(u*u+f*f);cn+=h*(i+a)/2,ln+=h*(r+s)/2,tn+=h,mn(i=a,r=s)}var i,r;$e.point=function(a,s){$e.point=t,mn(i=a,r=s)}}function fa(){$e.point=mn}function Ts(){function t(u,f){var h=u-a,d=f-s,g=Math.sqrt(h*h+d*d);cn+=g*(a+u)/2,ln+=g*(s+f)/2,tn+=g,On+=(g=s*u-a*f)*(a+u),Vn+=g*(s+f),hn+=3*g,mn(a=u,s=f)}var i,r,a,s;$e.point=function(u,f){$e.point=t,mn(i=a=u,r=s=f)},$e.lineEnd=function(){t(i,r)}}function ga(t){function i(f){return(u?function(h){function d(J,At){J=t(J,At),h.point(J[0],J[1])}function g(){C=NaN,Z.point=m,h.lineStart()}function m(J,At){var wt=pn([J,At]),rt=t(J,At);r(C,z,R,et,it,U,C=rt[0],z=rt[1],R=J,et=wt[0],it=wt[1],U=wt[2],u,h),h.point(C,z)}function v(){Z.point=d,h.lineEnd()}function x(){g(),Z.point=S,Z.lineEnd=A}function S(J,At){m(E=J,M=At),D=C,O=z,k=et,G=it,N=U,Z.point=m}function A(){r(C,z,R,et,it,U,D,O,E,k,G,N,u,h),Z.lineEnd=v,v()}var E,M,D,O,k,G,N,R,C,z,et,it,U,Z={point:d,lineStart:g,lineEnd:v,polygonStart:function(){h.polygonStart(),Z.lineStart=x},polygonEnd:function(){h.polygonEnd(),Z.lineStart=g}};return Z}:function(h){return ma(h,function(d,g){d=t(d,g),h.point(d[0],d[1])})})(f)}function r(f,h,d,g,m,v,x,S,A,E,M,D,O,k){var G=x-f,N=S-h,R=G*G+N*N;if(R>4*a&&O--){var C=g+E,z=m+M,et=v+D,it=Math.sqrt(C*C+z*z+et*et),U=Math.asin(et/=it),Z=Wt(Wt(et)-1)<Mt||Wt(d-A)<Mt?(d+A)/2:Math.atan2(z,C),J=t(Z,U),At=J[0],wt=J[1],rt=At-f,_t=wt-h,Dt=N*rt-G*_t;(Dt*Dt/R>a||Wt((G*rt+N*_t)/R-.5)>.3||g*E+m*M+v*D<s)&&(r(f,h,d,g,m,v,At,wt,Z,C/=it,z/=it,et,O,k),k.point(At,wt),r(At,wt,Z,C,z,et,x,S,A,E,M,D,O,k))}}var a=.5,s=Math.cos(30*Bt),u=16;return i.precision=function(f){return arguments.length?(u=(a=f*f)>0&&16,i):Math.sqrt(a)},i}function pa(t){this.stream=t}function ma(t,i){return{point:i,sphere:function(){t.sphere()},lineStart:function(){t.lineStart()},lineEnd:function(){t.lineEnd()},polygonStart:function(){t.polygonStart()},polygonEnd:function(){t.polygonEnd()}}}function un(t){return Ji(function(){return t})()}function Ji(t){function i(C){return C=f(C[0]*Bt,C[1]*Bt),[C[0]*v+h,d-C[1]*v]}function r(){f=ia(u=tr(M,D,O),s);var C=s(A,E);return h=x-C[0]*v,d=S+C[1]*v,a()}function a(){return g&&(g.valid=!1,g=null),i}var s,u,f,h,d,g,m=ga(function(C,z){return C=s(C,z),[C[0]*v+h,d-C[1]*v]}),v=150,x=480,S=250,A=0,E=0,M=0,D=0,O=0,k=No,G=K,N=null,R=null;return i.stream=function(C){return g&&(g.valid=!1),g=va(k(u,m(G(C)))),g.valid=!0,g},i.clipAngle=function(C){return arguments.length?(k=C==null?(N=C,No):(function(z){function et(wt,rt){return Math.cos(wt)*Math.cos(rt)>Z}function it(wt,rt,_t){var Dt=[1,0,0],Nt=Cn(pn(wt),pn(rt)),Kt=ni(Nt,Nt),ee=Nt[0],ce=Kt-ee*ee;if(!ce)return!_t&&wt;var Qt=Z*Kt/ce,se=-Z*ee/ce,ue=Cn(Dt,Nt),_e=ii(Dt,Qt);Zi(_e,ii(Nt,se));var ve=ue,ke=ni(_e,ve),Ge=ni(ve,ve),Re=ke*ke-Ge*(ni(_e,_e)-1);if(!(Re<0)){var Se=Math.sqrt(Re),de=ii(ve,(-ke-Se)/Ge);if(Zi(de,_e),de=ai(de),!_t)return de;var Ce,Pe=wt[0],De=rt[0],Ie=wt[1],Fe=rt[1];De<Pe&&(Ce=Pe,Pe=De,De=Ce);var qe=De-Pe,gn=Wt(qe-$t)<Mt;if(!gn&&Fe<Ie&&(Ce=Ie,Ie=Fe,Fe=Ce),gn||qe<Mt?gn?Ie+Fe>0^de[1]<(Wt(de[0]-Pe)<Mt?Ie:Fe):Ie<=de[1]&&de[1]<=Fe:qe>$t^(Pe<=de[0]&&de[0]<=De)){var Kn=ii(ve,(-ke+Se)/Ge);return Zi(Kn,_e),[de,ai(Kn)]}}}function U(wt,rt){var _t=J?z:$t-z,Dt=0;return wt<-_t?Dt|=1:wt>_t&&(Dt|=2),rt<-_t?Dt|=4:rt>_t&&(Dt|=8),Dt}var Z=Math.cos(z),J=Z>0,At=Wt(Z)>Mt;return oa(et,function(wt){var rt,_t,Dt,Nt,Kt;return{lineStart:function(){Nt=Dt=!1,Kt=1},point:function(ee,ce){var Qt,se=[ee,ce],ue=et(ee,ce),_e=J?ue?0:U(ee,ce):ue?U(ee+(ee<0?$t:-$t),ce):0;if(!rt&&(Nt=Dt=ue)&&wt.lineStart(),ue!==Dt&&(Qt=it(rt,se),(oi(rt,Qt)||oi(se,Qt))&&(se[0]+=Mt,se[1]+=Mt,ue=et(se[0],se[1]))),ue!==Dt)Kt=0,ue?(wt.lineStart(),Qt=it(se,rt),wt.point(Qt[0],Qt[1])):(Qt=it(rt,se),wt.point(Qt[0],Qt[1]),wt.lineEnd()),rt=Qt;else if(At&&rt&&J^ue){var ve;_e&_t||!(ve=it(se,rt,!0))||(Kt=0,J?(wt.lineStart(),wt.point(ve[0][0],ve[0][1]),wt.point(ve[1][0],ve[1][1]),wt.lineEnd()):(wt.point(ve[1][0],ve[1][1]),wt.lineEnd(),wt.lineStart(),wt.point(ve[0][0],ve[0][1])))}!ue||rt&&oi(rt,se)||wt.point(se[0],se[1]),rt=se,Dt=ue,_t=_e},lineEnd:function(){Dt&&wt.lineEnd(),rt=null},clean:function(){return Kt|(Nt&&Dt)<<1}}},er(z,6*Bt),J?[0,-z]:[-$t,z-$t])})((N=+C)*Bt),a()):N},i.clipExtent=function(C){return arguments.length?(R=C,G=C?ca(C[0][0],C[0][1],C[1][0],C[1][1]):K,a()):R},i.scale=function(C){return arguments.length?(v=+C,r()):v},i.translate=function(C){return arguments.length?(x=+C[0],S=+C[1],r()):[x,S]},i.center=function(C){return arguments.length?(A=C[0]%360*Bt,E=C[1]%360*Bt,r()):[A*be,E*be]},i.rotate=function(C){return arguments.length?(M=C[0]%360*Bt,D=C[1]%360*Bt,O=C.length>2?C[2]%360*Bt:0,r()):[M*be,D*be,O*be]},b.rebind(i,m,"precision"),function(){return s=t.apply(this,arguments),i.invert=s.invert&&function(C){return(C=f.invert((C[0]-h)/v,(d-C[1])/v))&&[C[0]*be,C[1]*be]},r()}}function va(t){return ma(t,function(i,r){t.point(i*Bt,r*Bt)})}function Fn(t,i){return[t,i]}function ya(t,i){return[t>$t?t-Xe:t<-$t?t+Xe:t,i]}function tr(t,i,r){return t?i||r?ia(_a(t),wa(i,r)):_a(t):i||r?wa(i,r):ya}function xa(t){return function(i,r){return i+=t,[i>$t?i-Xe:i<-$t?i+Xe:i,r]}}function _a(t){var i=xa(t);return i.invert=xa(-t),i}function wa(t,i){function r(h,d){var g=Math.cos(d),m=Math.cos(h)*g,v=Math.sin(h)*g,x=Math.sin(d),S=x*a+m*s;return[Math.atan2(v*u-S*f,m*a-x*s),Ot(S*u+v*f)]}var a=Math.cos(t),s=Math.sin(t),u=Math.cos(i),f=Math.sin(i);return r.invert=function(h,d){var g=Math.cos(d),m=Math.cos(h)*g,v=Math.sin(h)*g,x=Math.sin(d),S=x*u-v*f;return[Math.atan2(v*u+x*f,m*a+S*s),Ot(S*a-m*s)]},r}function er(t,i){var r=Math.cos(t),a=Math.sin(t);return function(s,u,f,h){var d=f*i;s!=null?(s=ba(r,s),u=ba(r,u),(f>0?s<u:s>u)&&(s+=f*Xe)):(s=t+f*Xe,u=t-.5*d);for(var g,m=s;f>0?m>u:m<u;m-=d)h.point((g=ai([r,-a*Math.cos(m),-a*Math.sin(m)]))[0],g[1])}}function ba(t,i){var r=pn(i);r[0]-=t,ri(r);var a=tt(-r[1]);return((-r[2]<0?-a:a)+2*Math.PI-Mt)%(2*Math.PI)}function Sa(t,i,r){var a=b.range(t,i-Mt,r).concat(i);return function(s){return a.map(function(u){return[s,u]})}}function Ta(t,i,r){var a=b.range(t,i-Mt,r).concat(i);return function(s){return a.map(function(u){return[u,s]})}}function nr(t){return t.source}function ir(t){return t.target}function zn(t,i){function r(a,s){var u=Math.cos(a),f=Math.cos(s),h=t(u*f);return[h*f*Math.sin(a),h*Math.sin(s)]}return r.invert=function(a,s){var u=Math.sqrt(a*a+s*s),f=i(u),h=Math.sin(f),d=Math.cos(f);return[Math.atan2(a*h,u*d),Math.asin(u&&s*h/u)]},r}function Aa(t,i){function r(h,d){f>0?d<-Me+Mt&&(d=-Me+Mt):d>Me-Mt&&(d=Me-Mt);var g=f/Math.pow(s(d),u);return[g*Math.sin(u*h),f-g*Math.cos(u*h)]}var a=Math.cos(t),s=function(h){return Math.tan($t/4+h/2)},u=t===i?Math.sin(t):Math.log(a/Math.cos(i))/Math.log(s(i)/s(t)),f=a*Math.pow(s(t),u)/u;return u?(r.invert=function(h,d){var g=f-d,m=Yt(u)*Math.sqrt(h*h+g*g);return[Math.atan2(h,g)/u,2*Math.atan(Math.pow(f/m,1/u))-Me]},r):ui}function Ca(t,i){function r(f,h){var d=u-h;return[d*Math.sin(s*f),u-d*Math.cos(s*f)]}var a=Math.cos(t),s=t===i?Math.sin(t):(a-Math.cos(i))/(i-t),u=a/s+t;return Wt(s)<Mt?Fn:(r.invert=function(f,h){var d=u-h;return[Math.atan2(f,d)/s,u-Yt(s)*Math.sqrt(f*f+d*d)]},r)}function ui(t,i){return[t,Math.log(Math.tan($t/4+i/2))]}function Pa(t){var i,r=un(t),a=r.scale,s=r.translate,u=r.clipExtent;return r.scale=function(){var f=a.apply(r,arguments);return f===r?i?r.clipExtent(null):r:f},r.translate=function(){var f=s.apply(r,arguments);return f===r?i?r.clipExtent(null):r:f},r.clipExtent=function(f){var h=u.apply(r,arguments);if(h===r){if(i=f==null){var d=$t*a(),g=s();u([[g[0]-d,g[1]-d],[g[0]+d,g[1]+d]])}}else i&&(h=null);return h},r.clipExtent(null)}function rr(t,i){return[Math.log(Math.tan($t/4+i/2)),-t]}function Pn(t){return t[0]}function Hn(t){return t[1]}function Ma(t){for(var i=t.length,r=[0,1],a=2,s=2;s<i;s++){for(;a>1&&j(t[r[a-2]],t[r[a-1]],t[s])<=0;)--a;r[a++]=s}return r.slice(0,a)}function As(t,i){return t[0]-i[0]||t[1]-i[1]}function ar(t,i,r){return(r[0]-i[0])*(t[1]-i[1])<(r[1]-i[1])*(t[0]-i[0])}function or(t,i,r,a){var s=t[0],u=r[0],f=i[0]-s,h=a[0]-u,d=t[1],g=r[1],m=i[1]-d,v=a[1]-g,x=(h*(d-g)-v*(s-u))/(v*f-h*m);return[s+x*f,d+x*m]}function Ea(t){var i=t[0],r=t[t.length-1];return!(i[0]-r[0]||i[1]-r[1])}function La(t){var i=Bo.pop()||new function(){hi(this),this.edge=this.site=this.circle=null};return i.site=t,i}function sr(t){En(t),Rn.remove(t),Bo.push(t),hi(t)}function Cs(t){var i=t.circle,r=i.x,a=i.cy,s={x:r,y:a},u=t.P,f=t.N,h=[t];sr(t);for(var d=u;d.circle&&Wt(r-d.circle.x)<Mt&&Wt(a-d.circle.cy)<Mt;)u=d.P,h.unshift(d),sr(d),d=u;h.unshift(d),En(d);for(var g=f;g.circle&&Wt(r-g.circle.x)<Mt&&Wt(a-g.circle.cy)<Mt;)f=g.N,h.push(g),sr(g),g=f;h.push(g),En(g);var m,v=h.length;for(m=1;m<v;++m)g=h[m],d=h[m-1],ci(g.edge,d.site,g.site,s);d=h[0],(g=h[v-1]).edge=Yn(d.site,g.site,null,s),Mn(d),Mn(g)}function Ps(t){for(var i,r,a,s,u=t.x,f=t.y,h=Rn._;h;)if((a=ka(h,f)-u)>Mt)h=h.L;else{if(!((s=u-(function(N,R){var C=N.N;if(C)return ka(C,R);var z=N.site;return z.y===R?z.x:1/0})(h,f))>Mt)){a>-Mt?(i=h.P,r=h):s>-Mt?(i=h,r=h.N):i=r=h;break}if(!h.R){i=h;break}h=h.R}var d=La(t);if(Rn.insert(i,d),i||r){if(i===r)return En(i),r=La(i.site),Rn.insert(d,r),d.edge=r.edge=Yn(i.site,d.site),Mn(i),void Mn(r);if(r){En(i),En(r);var g=i.site,m=g.x,v=g.y,x=t.x-m,S=t.y-v,A=r.site,E=A.x-m,M=A.y-v,D=2*(x*M-S*E),O=x*x+S*S,k=E*E+M*M,G={x:(M*O-S*k)/D+m,y:(x*k-E*O)/D+v};ci(r.edge,g,A,G),d.edge=Yn(g,t,null,G),r.edge=Yn(t,A,null,G),Mn(i),Mn(r)}else d.edge=Yn(i.site,d.site)}}function ka(t,i){var r=t.site,a=r.x,s=r.y,u=s-i;if(!u)return a;var f=t.P;if(!f)return-1/0;var h=(r=f.site).x,d=r.y,g=d-i;if(!g)return h;var m=h-a,v=1/u-1/g,x=m/g;return v?(-x+Math.sqrt(x*x-2*v*(m*m/(-2*g)-d+g/2+s-u/2)))/v+a:(a+h)/2}function Da(t){this.site=t,this.edges=[]}function Ms(t){for(var i,r,a,s,u,f,h,d,g,m,v=t[0][0],x=t[1][0],S=t[0][1],A=t[1][1],E=Sn,M=E.length;M--;)if((u=E[M])&&u.prepare())for(d=(h=u.edges).length,f=0;f<d;)a=(m=h[f].end()).x,s=m.y,i=(g=h[++f%d].start()).x,r=g.y,(Wt(a-i)>Mt||Wt(s-r)>Mt)&&(h.splice(f,0,new li((function(D,O,k){var G=new Va(D,null);return G.a=O,G.b=k,In.push(G),G})(u.site,m,Wt(a-v)<Mt&&A-s>Mt?{x:v,y:Wt(i-v)<Mt?r:A}:Wt(s-A)<Mt&&x-a>Mt?{x:Wt(r-A)<Mt?i:x,y:A}:Wt(a-x)<Mt&&s-S>Mt?{x,y:Wt(i-x)<Mt?r:S}:Wt(s-S)<Mt&&a-v>Mt?{x:Wt(r-S)<Mt?i:v,y:S}:null),u.site,null)),++d)}function Oa(t,i){return i.angle-t.angle}function Mn(t){var i=t.P,r=t.N;if(i&&r){var a=i.site,s=t.site,u=r.site;if(a!==u){var f=s.x,h=s.y,d=a.x-f,g=a.y-h,m=u.x-f,v=2*(d*(M=u.y-h)-g*m);if(!(v>=-Si)){var x=d*d+g*g,S=m*m+M*M,A=(M*x-g*S)/v,E=(d*S-m*x)/v,M=E+h,D=Uo.pop()||new function(){hi(this),this.x=this.y=this.arc=this.site=this.cy=null};D.arc=t,D.site=s,D.x=A+f,D.y=M+Math.sqrt(A*A+E*E),D.cy=M,t.circle=D;for(var O=null,k=$n._;k;)if(D.y<k.y||D.y===k.y&&D.x<=k.x){if(!k.L){O=k.P;break}k=k.L}else{if(!k.R){O=k;break}k=k.R}$n.insert(O,D),O||(Xr=D)}}}}function En(t){var i=t.circle;i&&(i.P||(Xr=i.N),$n.remove(i),Uo.push(i),hi(i),t.circle=null)}function Va(t,i){this.l=t,this.r=i,this.a=this.b=null}function Yn(t,i,r,a){var s=new Va(t,i);return In.push(s),r&&ci(s,t,i,r),a&&ci(s,i,t,a),Sn[t.i].edges.push(new li(s,t,i)),Sn[i.i].edges.push(new li(s,i,t)),s}function ci(t,i,r,a){t.a||t.b?t.l===r?t.b=a:t.a=a:(t.a=a,t.l=i,t.r=r)}function li(t,i,r){var a=t.a,s=t.b;this.edge=t,this.site=i,this.angle=r?Math.atan2(r.y-i.y,r.x-i.x):t.l===i?Math.atan2(s.x-a.x,a.y-s.y):Math.atan2(a.x-s.x,s.y-a.y)}function ur(){this._=null}function hi(t){t.U=t.C=t.L=t.R=t.P=t.N=null}function Xn(t,i){var r=i,a=i.R,s=r.U;s?s.L===r?s.L=a:s.R=a:t._=a,a.U=s,r.U=a,r.R=a.L,r.R&&(r.R.U=r),a.L=r}function Bn(t,i){var r=i,a=i.L,s=r.U;s?s.L===r?s.L=a:s.R=a:t._=a,a.U=s,r.U=a,r.L=a.R,r.L&&(r.L.U=r),a.R=r}function Ga(t){for(;t.L;)t=t.L;return t}function cr(t,i){var r,a,s,u=t.sort(Es).pop();for(In=[],Sn=new Array(t.length),Rn=new ur,$n=new ur;;)if(s=Xr,u&&(!s||u.y<s.y||u.y===s.y&&u.x<s.x))u.x===r&&u.y===a||(Sn[u.i]=new Da(u),Ps(u),r=u.x,a=u.y),u=t.pop();else{if(!s)break;Cs(s.arc)}i&&((function(h){for(var d,g=In,m=ua(h[0][0],h[0][1],h[1][0],h[1][1]),v=g.length;v--;)(!(function(x,S){var A=x.b;if(A)return!0;var E,M,D=x.a,O=S[0][0],k=S[1][0],G=S[0][1],N=S[1][1],R=x.l,C=x.r,z=R.x,et=R.y,it=C.x,U=C.y,Z=(z+it)/2,J=(et+U)/2;if(U===et){if(Z<O||Z>=k)return;if(z>it){if(D){if(D.y>=N)return}else D={x:Z,y:G};A={x:Z,y:N}}else{if(D){if(D.y<G)return}else D={x:Z,y:N};A={x:Z,y:G}}}else if(E=(z-it)/(U-et),M=J-E*Z,E<-1||E>1)if(z>it){if(D){if(D.y>=N)return}else D={x:(G-M)/E,y:G};A={x:(N-M)/E,y:N}}else{if(D){if(D.y<G)return}else D={x:(N-M)/E,y:N};A={x:(G-M)/E,y:G}}else if(et<U){if(D){if(D.x>=k)return}else D={x:O,y:E*O+M};A={x:k,y:E*k+M}}else{if(D){if(D.x<O)return}else D={x:k,y:E*k+M};A={x:O,y:E*O+M}}return x.a=D,x.b=A,!0})(d=g[v],h)||!m(d)||Wt(d.a.x-d.b.x)<Mt&&Wt(d.a.y-d.b.y)<Mt)&&(d.a=d.b=null,g.splice(v,1))})(i),Ms(i));var f={cells:Sn,edges:In};return Rn=$n=In=Sn=null,f}function Es(t,i){return i.y-t.y||i.x-t.x}function Ls(t){return t.x}function ks(t){return t.y}function Un(t,i,r,a,s,u){if(!t(i,r,a,s,u)){var f=.5*(r+s),h=.5*(a+u),d=i.nodes;d[0]&&Un(t,d[0],r,a,f,h),d[1]&&Un(t,d[1],f,a,s,h),d[2]&&Un(t,d[2],r,h,f,u),d[3]&&Un(t,d[3],f,h,s,u)}}function lr(t,i){t=b.rgb(t),i=b.rgb(i);var r=t.r,a=t.g,s=t.b,u=i.r-r,f=i.g-a,h=i.b-s;return function(d){return"#"+W(Math.round(r+u*d))+W(Math.round(a+f*d))+W(Math.round(s+h*d))}}function Ia(t,i){var r,a={},s={};for(r in t)r in i?a[r]=vn(t[r],i[r]):s[r]=t[r];for(r in i)r in t||(s[r]=i[r]);return function(u){for(r in a)s[r]=a[r](u);return s}}function en(t,i){return t=+t,i=+i,function(r){return t*(1-r)+i*r}}function Ra(t,i){var r,a,s,u=Br.lastIndex=Ur.lastIndex=0,f=-1,h=[],d=[];for(t+="",i+="";(r=Br.exec(t))&&(a=Ur.exec(i));)(s=a.index)>u&&(s=i.slice(u,s),h[f]?h[f]+=s:h[++f]=s),(r=r[0])===(a=a[0])?h[f]?h[f]+=a:h[++f]=a:(h[++f]=null,d.push({i:f,x:en(r,a)})),u=Ur.lastIndex;return u<i.length&&(s=i.slice(u),h[f]?h[f]+=s:h[++f]=s),h.length<2?d[0]?(i=d[0].x,function(g){return i(g)+""}):function(){return i}:(i=d.length,function(g){for(var m,v=0;v<i;++v)h[(m=d[v]).i]=m.x(g);return h.join("")})}function vn(t,i){for(var r,a=b.interpolators.length;--a>=0&&!(r=b.interpolators[a](t,i)););return r}function di(t,i){var r,a=[],s=[],u=t.length,f=i.length,h=Math.min(t.length,i.length);for(r=0;r<h;++r)a.push(vn(t[r],i[r]));for(;r<u;++r)s[r]=t[r];for(;r<f;++r)s[r]=i[r];return function(d){for(r=0;r<h;++r)s[r]=a[r](d);return s}}function ja(t){return function(i){return 1-t(1-i)}}function Na(t){return function(i){return .5*(i<.5?t(2*i):2-t(2-2*i))}}function Ds(t){return t*t}function Os(t){return t*t*t}function Vs(t){return 1-Math.cos(t*Me)}function Gs(t){return Math.pow(2,10*(t-1))}function Is(t){return 1-Math.sqrt(1-t*t)}function Rs(t){return t<1/2.75?7.5625*t*t:t<2/2.75?7.5625*(t-=1.5/2.75)*t+.75:t<2.5/2.75?7.5625*(t-=2.25/2.75)*t+.9375:7.5625*(t-=2.625/2.75)*t+.984375}function Fa(t,i){return i-=t,function(r){return Math.round(t+i*r)}}function za(t){var i=[t.a,t.b],r=[t.c,t.d],a=Ya(i),s=Ha(i,r),u=Ya((function(f,h,d){return f[0]+=d*h[0],f[1]+=d*h[1],f})(r,i,-s))||0;i[0]*r[1]<r[0]*i[1]&&(i[0]*=-1,i[1]*=-1,a*=-1,s*=-1),this.rotate=(a?Math.atan2(i[1],i[0]):Math.atan2(-r[0],r[1]))*be,this.translate=[t.e,t.f],this.scale=[a,u],this.skew=u?Math.atan2(s,u)*be:0}function Ha(t,i){return t[0]*i[0]+t[1]*i[1]}function Ya(t){var i=Math.sqrt(Ha(t,t));return i&&(t[0]/=i,t[1]/=i),i}function Ln(t){return t.length?t.pop()+",":""}function Xa(t,i){var r=[],a=[];return t=b.transform(t),i=b.transform(i),(function(s,u,f,h){if(s[0]!==u[0]||s[1]!==u[1]){var d=f.push("translate(",null,",",null,")");h.push({i:d-4,x:en(s[0],u[0])},{i:d-2,x:en(s[1],u[1])})}else(u[0]||u[1])&&f.push("translate("+u+")")})(t.translate,i.translate,r,a),(function(s,u,f,h){s!==u?(s-u>180?u+=360:u-s>180&&(s+=360),h.push({i:f.push(Ln(f)+"rotate(",null,")")-2,x:en(s,u)})):u&&f.push(Ln(f)+"rotate("+u+")")})(t.rotate,i.rotate,r,a),(function(s,u,f,h){s!==u?h.push({i:f.push(Ln(f)+"skewX(",null,")")-2,x:en(s,u)}):u&&f.push(Ln(f)+"skewX("+u+")")})(t.skew,i.skew,r,a),(function(s,u,f,h){if(s[0]!==u[0]||s[1]!==u[1]){var d=f.push(Ln(f)+"scale(",null,",",null,")");h.push({i:d-4,x:en(s[0],u[0])},{i:d-2,x:en(s[1],u[1])})}else u[0]===1&&u[1]===1||f.push(Ln(f)+"scale("+u+")")})(t.scale,i.scale,r,a),t=i=null,function(s){for(var u,f=-1,h=a.length;++f<h;)r[(u=a[f]).i]=u.x(s);return r.join("")}}function js(t){for(var i=t.source,r=t.target,a=(function(f,h){if(f===h)return f;for(var d=Ba(f),g=Ba(h),m=d.pop(),v=g.pop(),x=null;m===v;)x=m,m=d.pop(),v=g.pop();return x})(i,r),s=[i];i!==a;)i=i.parent,s.push(i);for(var u=s.length;r!==a;)s.splice(u,0,r),r=r.parent;return s}function Ba(t){for(var i=[],r=t.parent;r!=null;)i.push(t),t=r,r=r.parent;return i.push(t),i}function Ns(t){t.fixed|=2}function Fs(t){t.fixed&=-7}function zs(t){t.fixed|=4,t.px=t.x,t.py=t.y}function Hs(t){t.fixed&=-5}function Ua(t,i,r){var a=0,s=0;if(t.charge=0,!t.leaf)for(var u,f=t.nodes,h=f.length,d=-1;++d<h;)(u=f[d])!=null&&(Ua(u,i,r),t.charge+=u.charge,a+=u.charge*u.cx,s+=u.charge*u.cy);if(t.point){t.leaf||(t.point.x+=Math.random()-.5,t.point.y+=Math.random()-.5);var g=i*r[t.point.index];t.charge+=t.pointCharge=g,a+=g*t.point.x,s+=g*t.point.y}t.cx=a/t.charge,t.cy=s/t.charge}function qn(t,i){return b.rebind(t,i,"sort","children","value"),t.nodes=t,t.links=Us,t}function Wn(t,i){for(var r=[t];(t=r.pop())!=null;)if(i(t),(s=t.children)&&(a=s.length))for(var a,s;--a>=0;)r.push(s[a])}function Ke(t,i){for(var r=[t],a=[];(t=r.pop())!=null;)if(a.push(t),(u=t.children)&&(s=u.length))for(var s,u,f=-1;++f<s;)r.push(u[f]);for(;(t=a.pop())!=null;)i(t)}function Ys(t){return t.children}function Xs(t){return t.value}function Bs(t,i){return i.value-t.value}function Us(t){return b.merge(t.map(function(i){return(i.children||[]).map(function(r){return{source:i,target:r}})}))}function qs(t){return t.x}function Ws(t){return t.y}function Qs(t,i,r){t.y0=i,t.y=r}function hr(t){return b.range(t.length)}function dr(t){for(var i=-1,r=t[0].length,a=[];++i<r;)a[i]=0;return a}function Zs(t){for(var i,r=1,a=0,s=t[0][1],u=t.length;r<u;++r)(i=t[r][1])>s&&(a=r,s=i);return a}function $s(t){return t.reduce(Ks,0)}function Ks(t,i){return t+i[1]}function Js(t,i){return qa(t,Math.ceil(Math.log(i.length)/Math.LN2+1))}function qa(t,i){for(var r=-1,a=+t[0],s=(t[1]-a)/i,u=[];++r<=i;)u[r]=s*r+a;return u}function tu(t){return[b.min(t),b.max(t)]}function eu(t,i){return t.value-i.value}function fr(t,i){var r=t._pack_next;t._pack_next=i,i._pack_prev=t,i._pack_next=r,r._pack_prev=i}function Wa(t,i){t._pack_next=i,i._pack_prev=t}function Qa(t,i){var r=i.x-t.x,a=i.y-t.y,s=t.r+i.r;return .999*s*s>r*r+a*a}function Za(t){function i(G){m=Math.min(G.x-G.r,m),v=Math.max(G.x+G.r,v),x=Math.min(G.y-G.r,x),S=Math.max(G.y+G.r,S)}if((r=t.children)&&(g=r.length)){var r,a,s,u,f,h,d,g,m=1/0,v=-1/0,x=1/0,S=-1/0;if(r.forEach(nu),a=r[0],a.x=-a.r,a.y=0,i(a),g>1&&(s=r[1],s.x=s.r,s.y=0,i(s),g>2))for(Ka(a,s,u=r[2]),i(u),fr(a,u),a._pack_prev=u,fr(u,s),s=a._pack_next,f=3;f<g;f++){Ka(a,s,u=r[f]);var A=0,E=1,M=1;for(h=s._pack_next;h!==s;h=h._pack_next,E++)if(Qa(h,u)){A=1;break}if(A==1)for(d=a._pack_prev;d!==h._pack_prev&&!Qa(d,u);d=d._pack_prev,M++);A?(E<M||E==M&&s.r<a.r?Wa(a,s=h):Wa(a=d,s),f--):(fr(a,u),s=u,i(u))}var D=(m+v)/2,O=(x+S)/2,k=0;for(f=0;f<g;f++)(u=r[f]).x-=D,u.y-=O,k=Math.max(k,u.r+Math.sqrt(u.x*u.x+u.y*u.y));t.r=k,r.forEach(iu)}}function nu(t){t._pack_next=t._pack_prev=t}function iu(t){delete t._pack_next,delete t._pack_prev}function $a(t,i,r,a){var s=t.children;if(t.x=i+=a*t.x,t.y=r+=a*t.y,t.r*=a,s)for(var u=-1,f=s.length;++u<f;)$a(s[u],i,r,a)}function Ka(t,i,r){var a=t.r+r.r,s=i.x-t.x,u=i.y-t.y;if(a&&(s||u)){var f=i.r+r.r,h=s*s+u*u,d=.5+((a*=a)-(f*=f))/(2*h),g=Math.sqrt(Math.max(0,2*f*(a+h)-(a-=h)*a-f*f))/(2*h);r.x=t.x+d*s+g*u,r.y=t.y+d*u-g*s}else r.x=t.x+a,r.y=t.y}function Ja(t,i){return t.parent==i.parent?1:2}function gr(t){var i=t.children;return i.length?i[0]:t.t}function pr(t){var i,r=t.children;return(i=r.length)?r[i-1]:t.t}function to(t){var i=t.children;return i&&i.length?to(i[0]):t}function eo(t){var i,r=t.children;return r&&(i=r.length)?eo(r[i-1]):t}function mr(t){return{x:t.x,y:t.y,dx:t.dx,dy:t.dy}}function no(t,i){var r=t.x+i[3],a=t.y+i[0],s=t.dx-i[1]-i[3],u=t.dy-i[0]-i[2];return s<0&&(r+=s/2,s=0),u<0&&(a+=u/2,u=0),{x:r,y:a,dx:s,dy:u}}function kn(t){var i=t[0],r=t[t.length-1];return i<r?[i,r]:[r,i]}function fi(t){return t.rangeExtent?t.rangeExtent():kn(t.range())}function gi(t,i){var r,a=0,s=t.length-1,u=t[a],f=t[s];return f<u&&(r=a,a=s,s=r,r=u,u=f,f=r),t[a]=i.floor(u),t[s]=i.ceil(f),t}function io(t){return t?{floor:function(i){return Math.floor(i/t)*t},ceil:function(i){return Math.ceil(i/t)*t}}:Gu}function ro(t,i,r,a){function s(){var d=Math.min(t.length,i.length)>2?function(m,v,x,S){var A=[],E=[],M=0,D=Math.min(m.length,v.length)-1;for(m[D]<m[0]&&(m=m.slice().reverse(),v=v.slice().reverse());++M<=D;)A.push(x(m[M-1],m[M])),E.push(S(v[M-1],v[M]));return function(O){var k=b.bisect(m,O,1,D)-1;return E[k](A[k](O))}}:function(m,v,x,S){var A=x(m[0],m[1]),E=S(v[0],v[1]);return function(M){return E(A(M))}},g=a?function(m,v){return v=(v-=m=+m)||1/v,function(x){return Math.max(0,Math.min(1,(x-m)/v))}}:function(m,v){return v=(v-=m=+m)||1/v,function(x){return(x-m)/v}};return f=d(t,i,g,r),h=d(i,t,g,vn),u}function u(d){return f(d)}var f,h;return u.invert=function(d){return h(d)},u.domain=function(d){return arguments.length?(t=d.map(Number),s()):t},u.range=function(d){return arguments.length?(i=d,s()):i},u.rangeRound=function(d){return u.range(d).interpolate(Fa)},u.clamp=function(d){return arguments.length?(a=d,s()):a},u.interpolate=function(d){return arguments.length?(r=d,s()):r},u.ticks=function(d){return yr(t,d)},u.tickFormat=function(d,g){return xr(t,d,g)},u.nice=function(d){return ao(t,d),s()},u.copy=function(){return ro(t,i,r,a)},s()}function vr(t,i){return b.rebind(t,i,"range","rangeRound","interpolate","clamp")}function ao(t,i){return gi(t,io(Dn(t,i)[2])),gi(t,io(Dn(t,i)[2])),t}function Dn(t,i){i==null&&(i=10);var r=kn(t),a=r[1]-r[0],s=Math.pow(10,Math.floor(Math.log(a/i)/Math.LN10)),u=i/a*s;return u<=.15?s*=10:u<=.35?s*=5:u<=.75&&(s*=2),r[0]=Math.ceil(r[0]/s)*s,r[1]=Math.floor(r[1]/s)*s+.5*s,r[2]=s,r}function yr(t,i){return b.range.apply(b,Dn(t,i))}function xr(t,i,r){var a=Dn(t,i);if(r){var s=Oo.exec(r);if(s.shift(),s[8]==="s"){var u=b.formatPrefix(Math.max(Wt(a[0]),Wt(a[1])));return s[7]||(s[7]="."+pi(u.scale(a[2]))),s[8]="f",r=b.format(s.join("")),function(f){return r(u.scale(f))+u.symbol}}s[7]||(s[7]="."+(function(f,h){var d=pi(h[2]);return f in Iu?Math.abs(d-pi(Math.max(Wt(h[0]),Wt(h[1]))))+ +(f!=="e"):d-2*(f==="%")})(s[8],a)),r=s.join("")}else r=",."+pi(a[2])+"f";return b.format(r)}function pi(t){return-Math.floor(Math.log(t)/Math.LN10+.01)}function oo(t,i,r,a){function s(h){return(r?Math.log(h<0?0:h):-Math.log(h>0?0:-h))/Math.log(i)}function u(h){return r?Math.pow(i,h):-Math.pow(i,-h)}function f(h){return t(s(h))}return f.invert=function(h){return u(t.invert(h))},f.domain=function(h){return arguments.length?(r=h[0]>=0,t.domain((a=h.map(Number)).map(s)),f):a},f.base=function(h){return arguments.length?(i=+h,t.domain(a.map(s)),f):i},f.nice=function(){var h=gi(a.map(s),r?Math:Ru);return t.domain(h),a=h.map(u),f},f.ticks=function(){var h=kn(a),d=[],g=h[0],m=h[1],v=Math.floor(s(g)),x=Math.ceil(s(m)),S=i%1?2:i;if(isFinite(x-v)){if(r){for(;v<x;v++)for(var A=1;A<S;A++)d.push(u(v)*A);d.push(u(v))}else for(d.push(u(v));v++<x;)for(A=S-1;A>0;A--)d.push(u(v)*A);for(v=0;d[v]<g;v++);for(x=d.length;d[x-1]>m;x--);d=d.slice(v,x)}return d},f.tickFormat=function(h,d){if(!arguments.length)return Qo;arguments.length<2?d=Qo:typeof d!="function"&&(d=b.format(d));var g=Math.max(1,i*h/f.ticks().length);return function(m){var v=m/u(Math.round(s(m)));return v*i<i-.5&&(v*=i),v<=g?d(m):""}},f.copy=function(){return oo(t.copy(),i,r,a)},vr(f,t)}function so(t,i,r){function a(f){return t(s(f))}var s=mi(i),u=mi(1/i);return a.invert=function(f){return u(t.invert(f))},a.domain=function(f){return arguments.length?(t.domain((r=f.map(Number)).map(s)),a):r},a.ticks=function(f){return yr(r,f)},a.tickFormat=function(f,h){return xr(r,f,h)},a.nice=function(f){return a.domain(ao(r,f))},a.exponent=function(f){return arguments.length?(s=mi(i=f),u=mi(1/i),t.domain(r.map(s)),a):i},a.copy=function(){return so(t.copy(),i,r)},vr(a,t)}function mi(t){return function(i){return i<0?-Math.pow(-i,t):Math.pow(i,t)}}function uo(t,i){function r(h){return u[((s.get(h)||(i.t==="range"?s.set(h,t.push(h)):NaN))-1)%u.length]}function a(h,d){return b.range(t.length).map(function(g){return h+d*g})}var s,u,f;return r.domain=function(h){if(!arguments.length)return t;t=[],s=new ft;for(var d,g=-1,m=h.length;++g<m;)s.has(d=h[g])||s.set(d,t.push(d));return r[i.t].apply(r,i.a)},r.range=function(h){return arguments.length?(u=h,f=0,i={t:"range",a:arguments},r):u},r.rangePoints=function(h,d){arguments.length<2&&(d=0);var g=h[0],m=h[1],v=t.length<2?(g=(g+m)/2,0):(m-g)/(t.length-1+d);return u=a(g+v*d/2,v),f=0,i={t:"rangePoints",a:arguments},r},r.rangeRoundPoints=function(h,d){arguments.length<2&&(d=0);var g=h[0],m=h[1],v=t.length<2?(g=m=Math.round((g+m)/2),0):(m-g)/(t.length-1+d)|0;return u=a(g+Math.round(v*d/2+(m-g-(t.length-1+d)*v)/2),v),f=0,i={t:"rangeRoundPoints",a:arguments},r},r.rangeBands=function(h,d,g){arguments.length<2&&(d=0),arguments.length<3&&(g=d);var m=h[1]<h[0],v=h[m-0],x=(h[1-m]-v)/(t.length-d+2*g);return u=a(v+x*g,x),m&&u.reverse(),f=x*(1-d),i={t:"rangeBands",a:arguments},r},r.rangeRoundBands=function(h,d,g){arguments.length<2&&(d=0),arguments.length<3&&(g=d);var m=h[1]<h[0],v=h[m-0],x=h[1-m],S=Math.floor((x-v)/(t.length-d+2*g));return u=a(v+Math.round((x-v-(t.length-d)*S)/2),S),m&&u.reverse(),f=Math.round(S*(1-d)),i={t:"rangeRoundBands",a:arguments},r},r.rangeBand=function(){return f},r.rangeExtent=function(){return kn(i.a[0])},r.copy=function(){return uo(t,i)},r.domain(t)}function co(t,i){function r(){var u=0,f=i.length;for(s=[];++u<f;)s[u-1]=b.quantile(t,u/f);return a}function a(u){if(!isNaN(u=+u))return i[b.bisect(s,u)]}var s;return a.domain=function(u){return arguments.length?(t=u.map(B).filter($).sort(H),r()):t},a.range=function(u){return arguments.length?(i=u,r()):i},a.quantiles=function(){return s},a.invertExtent=function(u){return(u=i.indexOf(u))<0?[NaN,NaN]:[u>0?s[u-1]:t[0],u<s.length?s[u]:t[t.length-1]]},a.copy=function(){return co(t,i)},r()}function lo(t,i,r){function a(h){return r[Math.max(0,Math.min(f,Math.floor(u*(h-t))))]}function s(){return u=r.length/(i-t),f=r.length-1,a}var u,f;return a.domain=function(h){return arguments.length?(t=+h[0],i=+h[h.length-1],s()):[t,i]},a.range=function(h){return arguments.length?(r=h,s()):r},a.invertExtent=function(h){return h=r.indexOf(h),h=h<0?NaN:h/u+t,[h,h+1/u]},a.copy=function(){return lo(t,i,r)},s()}function ho(t,i){function r(a){if(a<=a)return i[b.bisect(t,a)]}return r.domain=function(a){return arguments.length?(t=a,r):t},r.range=function(a){return arguments.length?(i=a,r):i},r.invertExtent=function(a){return a=i.indexOf(a),[t[a-1],t[a]]},r.copy=function(){return ho(t,i)},r}function fo(t){function i(r){return+r}return i.invert=i,i.domain=i.range=function(r){return arguments.length?(t=r.map(i),i):t},i.ticks=function(r){return yr(t,r)},i.tickFormat=function(r,a){return xr(t,r,a)},i.copy=function(){return fo(t)},i}function ru(){return 0}function au(t){return t.innerRadius}function ou(t){return t.outerRadius}function go(t){return t.startAngle}function po(t){return t.endAngle}function su(t){return t&&t.padAngle}function vi(t,i,r,a){return(t-r)*i-(i-a)*t>0?0:1}function yi(t,i,r,a,s){var u=t[0]-i[0],f=t[1]-i[1],h=(s?a:-a)/Math.sqrt(u*u+f*f),d=h*f,g=-h*u,m=t[0]+d,v=t[1]+g,x=i[0]+d,S=i[1]+g,A=(m+x)/2,E=(v+S)/2,M=x-m,D=S-v,O=M*M+D*D,k=r-a,G=m*S-x*v,N=(D<0?-1:1)*Math.sqrt(Math.max(0,k*k*O-G*G)),R=(G*D-M*N)/O,C=(-G*M-D*N)/O,z=(G*D+M*N)/O,et=(-G*M+D*N)/O,it=R-A,U=C-E,Z=z-A,J=et-E;return it*it+U*U>Z*Z+J*J&&(R=z,C=et),[[R-d,C-g],[R*r/k,C*r/k]]}function mo(t){function i(d){function g(){v.push("M",u(t(x),h))}for(var m,v=[],x=[],S=-1,A=d.length,E=It(r),M=It(a);++S<A;)s.call(this,m=d[S],S)?x.push([+E.call(this,m,S),+M.call(this,m,S)]):x.length&&(g(),x=[]);return x.length&&g(),v.length?v.join(""):null}var r=Pn,a=Hn,s=Nn,u=Qe,f=u.key,h=.7;return i.x=function(d){return arguments.length?(r=d,i):r},i.y=function(d){return arguments.length?(a=d,i):a},i.defined=function(d){return arguments.length?(s=d,i):s},i.interpolate=function(d){return arguments.length?(f=typeof d=="function"?u=d:(u=qr.get(d)||Qe).key,i):f},i.tension=function(d){return arguments.length?(h=d,i):h},i}function Qe(t){return t.length>1?t.join("L"):t+"Z"}function vo(t){return t.join("L")+"Z"}function _r(t){for(var i=0,r=t.length,a=t[0],s=[a[0],",",a[1]];++i<r;)s.push("V",(a=t[i])[1],"H",a[0]);return s.join("")}function wr(t){for(var i=0,r=t.length,a=t[0],s=[a[0],",",a[1]];++i<r;)s.push("H",(a=t[i])[0],"V",a[1]);return s.join("")}function xi(t,i){if(i.length<1||t.length!=i.length&&t.length!=i.length+2)return Qe(t);var r=t.length!=i.length,a="",s=t[0],u=t[1],f=i[0],h=f,d=1;if(r&&(a+="Q"+(u[0]-2*f[0]/3)+","+(u[1]-2*f[1]/3)+","+u[0]+","+u[1],s=t[1],d=2),i.length>1){h=i[1],u=t[d],d++,a+="C"+(s[0]+f[0])+","+(s[1]+f[1])+","+(u[0]-h[0])+","+(u[1]-h[1])+","+u[0]+","+u[1];for(var g=2;g<i.length;g++,d++)u=t[d],h=i[g],a+="S"+(u[0]-h[0])+","+(u[1]-h[1])+","+u[0]+","+u[1]}if(r){var m=t[d];a+="Q"+(u[0]+2*h[0]/3)+","+(u[1]+2*h[1]/3)+","+m[0]+","+m[1]}return a}function br(t,i){for(var r,a=[],s=(1-i)/2,u=t[0],f=t[1],h=1,d=t.length;++h<d;)r=u,u=f,f=t[h],a.push([s*(f[0]-r[0]),s*(f[1]-r[1])]);return a}function yo(t){if(t.length<3)return Qe(t);var i=1,r=t.length,a=t[0],s=a[0],u=a[1],f=[s,s,s,(a=t[1])[0]],h=[u,u,u,a[1]],d=[s,",",u,"L",Ue(fn,f),",",Ue(fn,h)];for(t.push(t[r-1]);++i<=r;)a=t[i],f.shift(),f.push(a[0]),h.shift(),h.push(a[1]),Sr(d,f,h);return t.pop(),d.push("L",a),d.join("")}function Ue(t,i){return t[0]*i[0]+t[1]*i[1]+t[2]*i[2]+t[3]*i[3]}function Sr(t,i,r){t.push("C",Ue(Zo,i),",",Ue(Zo,r),",",Ue($o,i),",",Ue($o,r),",",Ue(fn,i),",",Ue(fn,r))}function Tr(t,i){return(i[1]-t[1])/(i[0]-t[0])}function uu(t){for(var i,r,a,s,u=[],f=(function(g){for(var m=0,v=g.length-1,x=[],S=g[0],A=g[1],E=x[0]=Tr(S,A);++m<v;)x[m]=(E+(E=Tr(S=A,A=g[m+1])))/2;return x[m]=E,x})(t),h=-1,d=t.length-1;++h<d;)i=Tr(t[h],t[h+1]),Wt(i)<Mt?f[h]=f[h+1]=0:(s=(r=f[h]/i)*r+(a=f[h+1]/i)*a)>9&&(s=3*i/Math.sqrt(s),f[h]=s*r,f[h+1]=s*a);for(h=-1;++h<=d;)s=(t[Math.min(d,h+1)][0]-t[Math.max(0,h-1)][0])/(6*(1+f[h]*f[h])),u.push([s||0,f[h]*s||0]);return u}function xo(t){for(var i,r,a,s=-1,u=t.length;++s<u;)r=(i=t[s])[0],a=i[1]-Me,i[0]=r*Math.cos(a),i[1]=r*Math.sin(a);return t}function _o(t){function i(x){function S(){D.push("M",h(t(k),v),m,g(t(O.reverse()),v),"Z")}for(var A,E,M,D=[],O=[],k=[],G=-1,N=x.length,R=It(r),C=It(s),z=r===a?function(){return E}:It(a),et=s===u?function(){return M}:It(u);++G<N;)f.call(this,A=x[G],G)?(O.push([E=+R.call(this,A,G),M=+C.call(this,A,G)]),k.push([+z.call(this,A,G),+et.call(this,A,G)])):O.length&&(S(),O=[],k=[]);return O.length&&S(),D.length?D.join(""):null}var r=Pn,a=Pn,s=0,u=Hn,f=Nn,h=Qe,d=h.key,g=h,m="L",v=.7;return i.x=function(x){return arguments.length?(r=a=x,i):a},i.x0=function(x){return arguments.length?(r=x,i):r},i.x1=function(x){return arguments.length?(a=x,i):a},i.y=function(x){return arguments.length?(s=u=x,i):u},i.y0=function(x){return arguments.length?(s=x,i):s},i.y1=function(x){return arguments.length?(u=x,i):u},i.defined=function(x){return arguments.length?(f=x,i):f},i.interpolate=function(x){return arguments.length?(d=typeof x=="function"?h=x:(h=qr.get(x)||Qe).key,g=h.reverse||h,m=h.closed?"M":"L",i):d},i.tension=function(x){return arguments.length?(v=x,i):v},i}function cu(t){return t.radius}function wo(t){return[t.x,t.y]}function lu(){return 64}function hu(){return"circle"}function bo(t){var i=Math.sqrt(t/$t);return"M0,"+i+"A"+i+","+i+" 0 1,1 0,"+-i+"A"+i+","+i+" 0 1,1 0,"+i+"Z"}function So(t){return function(){var i,r,a;(i=this[t])&&(a=i[r=i.active])&&(a.timer.c=null,a.timer.t=NaN,--i.count?delete i[r]:delete this[t],i.active+=.5,a.event&&a.event.interrupt.call(this,this.__data__,a.index))}}function Qn(t,i,r){return bi(t,Oe),t.namespace=i,t.id=r,t}function Ar(t,i,r,a){var s=t.id,u=t.namespace;return q(t,typeof r=="function"?function(f,h,d){f[u][s].tween.set(i,a(r.call(f,f.__data__,h,d)))}:(r=a(r),function(f){f[u][s].tween.set(i,r)}))}function du(t){return t==null&&(t=""),function(){this.textContent=t}}function Cr(t){return t==null?"__transition__":"__transition_"+t+"__"}function _i(t,i,r,a,s){function u(E){var M=A.delay;if(g.t=M+d,M<=E)return f(E-M);g.c=f}function f(E){var M=S.active,D=S[M];D&&(D.timer.c=null,D.timer.t=NaN,--S.count,delete S[M],D.event&&D.event.interrupt.call(t,t.__data__,D.index));for(var O in S)if(+O<a){var k=S[O];k.timer.c=null,k.timer.t=NaN,--S.count,delete S[O]}g.c=h,Te(function(){return g.c&&h(E||1)&&(g.c=null,g.t=NaN),1},0,d),S.active=a,A.event&&A.event.start.call(t,t.__data__,i),x=[],A.tween.forEach(function(G,N){(N=N.call(t,t.__data__,i))&&x.push(N)}),v=A.ease,m=A.duration}function h(E){for(var M=E/m,D=v(M),O=x.length;O>0;)x[--O].call(t,D);if(M>=1)return A.event&&A.event.end.call(t,t.__data__,i),--S.count?delete S[a]:delete t[r],1}var d,g,m,v,x,S=t[r]||(t[r]={active:0,count:0}),A=S[a];A||(d=s.time,g=Te(u,0,d),A=S[a]={tween:new ft,time:d,timer:g,delay:s.delay,duration:s.duration,ease:s.ease,index:i},s=null,++S.count)}function fu(t,i,r){t.attr("transform",function(a){var s=i(a);return"translate("+(isFinite(s)?s:r(a))+",0)"})}function gu(t,i,r){t.attr("transform",function(a){var s=i(a);return"translate(0,"+(isFinite(s)?s:r(a))+")"})}function Pr(t){return t.toISOString()}function Mr(t,i,r){function a(u){return t(u)}function s(u,f){var h=(u[1]-u[0])/f,d=b.bisect(qi,h);return d==qi.length?[i.year,Dn(u.map(function(g){return g/31536e6}),f)[2]]:d?i[h/qi[d-1]<qi[d]/h?d-1:d]:[Bu,Dn(u,f)[2]]}return a.invert=function(u){return yn(t.invert(u))},a.domain=function(u){return arguments.length?(t.domain(u),a):t.domain().map(yn)},a.nice=function(u,f){function h(v){return!isNaN(v)&&!u.range(v,yn(+v+1),f).length}var d=a.domain(),g=kn(d),m=u==null?s(g,10):typeof u=="number"&&s(g,u);return m&&(u=m[0],f=m[1]),a.domain(gi(d,f>1?{floor:function(v){for(;h(v=u.floor(v));)v=yn(v-1);return v},ceil:function(v){for(;h(v=u.ceil(v));)v=yn(+v+1);return v}}:u))},a.ticks=function(u,f){var h=kn(a.domain()),d=u==null?s(h,10):typeof u=="number"?s(h,u):!u.range&&[{range:u},f];return d&&(u=d[0],f=d[1]),u.range(h[0],yn(+h[1]+1),f<1?1:f)},a.tickFormat=function(){return r},a.copy=function(){return Mr(t.copy(),i,r)},vr(a,t)}function yn(t){return new Date(t)}function pu(t){return JSON.parse(t.responseText)}function mu(t){var i=je.createRange();return i.selectNode(je.body),i.createContextualFragment(t.responseText)}var b={version:"3.5.17"},To=[].slice,Je=function(t){return To.call(t)},je=this.document;if(je)try{Je(je.documentElement.childNodes)[0].nodeType}catch(t){Je=function(i){for(var r=i.length,a=new Array(r);r--;)a[r]=i[r];return a}}if(Date.now||(Date.now=function(){return+new Date}),je)try{je.createElement("DIV").style.setProperty("opacity",0,"")}catch(t){var wi=this.Element.prototype,vu=wi.setAttribute,yu=wi.setAttributeNS,Ao=this.CSSStyleDeclaration.prototype,xu=Ao.setProperty;wi.setAttribute=function(i,r){vu.call(this,i,r+"")},wi.setAttributeNS=function(i,r,a){yu.call(this,i,r,a+"")},Ao.setProperty=function(i,r,a){xu.call(this,i,r+"",a)}}b.ascending=H,b.descending=function(t,i){return i<t?-1:i>t?1:i>=t?0:NaN},b.min=function(t,i){var r,a,s=-1,u=t.length;if(arguments.length===1){for(;++s<u;)if((a=t[s])!=null&&a>=a){r=a;break}for(;++s<u;)(a=t[s])!=null&&r>a&&(r=a)}else{for(;++s<u;)if((a=i.call(t,t[s],s))!=null&&a>=a){r=a;break}for(;++s<u;)(a=i.call(t,t[s],s))!=null&&r>a&&(r=a)}return r},b.max=function(t,i){var r,a,s=-1,u=t.length;if(arguments.length===1){for(;++s<u;)if((a=t[s])!=null&&a>=a){r=a;break}for(;++s<u;)(a=t[s])!=null&&a>r&&(r=a)}else{for(;++s<u;)if((a=i.call(t,t[s],s))!=null&&a>=a){r=a;break}for(;++s<u;)(a=i.call(t,t[s],s))!=null&&a>r&&(r=a)}return r},b.extent=function(t,i){var r,a,s,u=-1,f=t.length;if(arguments.length===1){for(;++u<f;)if((a=t[u])!=null&&a>=a){r=s=a;break}for(;++u<f;)(a=t[u])!=null&&(r>a&&(r=a),s<a&&(s=a))}else{for(;++u<f;)if((a=i.call(t,t[u],u))!=null&&a>=a){r=s=a;break}for(;++u<f;)(a=i.call(t,t[u],u))!=null&&(r>a&&(r=a),s<a&&(s=a))}return[r,s]},b.sum=function(t,i){var r,a=0,s=t.length,u=-1;if(arguments.length===1)for(;++u<s;)$(r=+t[u])&&(a+=r);else for(;++u<s;)$(r=+i.call(t,t[u],u))&&(a+=r);return a},b.mean=function(t,i){var r,a=0,s=t.length,u=-1,f=s;if(arguments.length===1)for(;++u<s;)$(r=B(t[u]))?a+=r:--f;else for(;++u<s;)$(r=B(i.call(t,t[u],u)))?a+=r:--f;if(f)return a/f},b.quantile=function(t,i){var r=(t.length-1)*i+1,a=Math.floor(r),s=+t[a-1],u=r-a;return u?s+u*(t[a]-s):s},b.median=function(t,i){var r,a=[],s=t.length,u=-1;if(arguments.length===1)for(;++u<s;)$(r=B(t[u]))&&a.push(r);else for(;++u<s;)$(r=B(i.call(t,t[u],u)))&&a.push(r);if(a.length)return b.quantile(a.sort(H),.5)},b.variance=function(t,i){var r,a,s=t.length,u=0,f=0,h=-1,d=0;if(arguments.length===1)for(;++h<s;)$(r=B(t[h]))&&(f+=(a=r-u)*(r-(u+=a/++d)));else for(;++h<s;)$(r=B(i.call(t,t[h],h)))&&(f+=(a=r-u)*(r-(u+=a/++d)));if(d>1)return f/(d-1)},b.deviation=function(){var t=b.variance.apply(this,arguments);return t&&Math.sqrt(t)};var Co=mt(H);b.bisectLeft=Co.left,b.bisect=b.bisectRight=Co.right,b.bisector=function(t){return mt(t.length===1?function(i,r){return H(t(i),r)}:t)},b.shuffle=function(t,i,r){(u=arguments.length)<3&&(r=t.length,u<2&&(i=0));for(var a,s,u=r-i;u;)s=Math.random()*u--|0,a=t[u+i],t[u+i]=t[s+i],t[s+i]=a;return t},b.permute=function(t,i){for(var r=i.length,a=new Array(r);r--;)a[r]=t[i[r]];return a},b.pairs=function(t){for(var i=0,r=t.length-1,a=t[0],s=new Array(r<0?0:r);i<r;)s[i]=[a,a=t[++i]];return s},b.transpose=function(t){if(!(s=t.length))return[];for(var i=-1,r=b.min(t,P),a=new Array(r);++i<r;)for(var s,u=-1,f=a[i]=new Array(s);++u<s;)f[u]=t[u][i];return a},b.zip=function(){return b.transpose(arguments)},b.keys=function(t){var i=[];for(var r in t)i.push(r);return i},b.values=function(t){var i=[];for(var r in t)i.push(t[r]);return i},b.entries=function(t){var i=[];for(var r in t)i.push({key:r,value:t[r]});return i},b.merge=function(t){for(var i,r,a,s=t.length,u=-1,f=0;++u<s;)f+=t[u].length;for(r=new Array(f);--s>=0;)for(i=(a=t[s]).length;--i>=0;)r[--f]=a[i];return r};var Wt=Math.abs;b.range=function(t,i,r){if(arguments.length<3&&(r=1,arguments.length<2&&(i=t,t=0)),(i-t)/r==1/0)throw new Error("infinite range");var a,s=[],u=(function(h){for(var d=1;h*d%1;)d*=10;return d})(Wt(r)),f=-1;if(t*=u,i*=u,(r*=u)<0)for(;(a=t+r*++f)>i;)s.push(a/u);else for(;(a=t+r*++f)<i;)s.push(a/u);return s},b.map=function(t,i){var r=new ft;if(t instanceof ft)t.forEach(function(h,d){r.set(h,d)});else if(Array.isArray(t)){var a,s=-1,u=t.length;if(arguments.length===1)for(;++s<u;)r.set(s,t[s]);else for(;++s<u;)r.set(i.call(t,a=t[s],s),a)}else for(var f in t)r.set(f,t[f]);return r};var _u="__proto__",Er="\0";Zt(ft,{has:Ht,get:function(t){return this._[ae(t)]},set:function(t,i){return this._[ae(t)]=i},remove:Ut,keys:yt,values:function(){var t=[];for(var i in this._)t.push(this._[i]);return t},entries:function(){var t=[];for(var i in this._)t.push({key:jt(i),value:this._[i]});return t},size:Lt,empty:nt,forEach:function(t){for(var i in this._)t.call(this,jt(i),this._[i])}}),b.nest=function(){function t(h,d,g){if(g>=u.length)return a?a.call(s,d):r?d.sort(r):d;for(var m,v,x,S,A=-1,E=d.length,M=u[g++],D=new ft;++A<E;)(S=D.get(m=M(v=d[A])))?S.push(v):D.set(m,[v]);return h?(v=h(),x=function(O,k){v.set(O,t(h,k,g))}):(v={},x=function(O,k){v[O]=t(h,k,g)}),D.forEach(x),v}function i(h,d){if(d>=u.length)return h;var g=[],m=f[d++];return h.forEach(function(v,x){g.push({key:v,values:i(x,d)})}),m?g.sort(function(v,x){return m(v.key,x.key)}):g}var r,a,s={},u=[],f=[];return s.map=function(h,d){return t(d,h,0)},s.entries=function(h){return i(t(b.map,h,0),0)},s.key=function(h){return u.push(h),s},s.sortKeys=function(h){return f[u.length-1]=h,s},s.sortValues=function(h){return r=h,s},s.rollup=function(h){return a=h,s},s},b.set=function(t){var i=new at;if(t)for(var r=0,a=t.length;r<a;++r)i.add(t[r]);return i},Zt(at,{has:Ht,add:function(t){return this._[ae(t+="")]=!0,t},remove:Ut,values:yt,size:Lt,empty:nt,forEach:function(t){for(var i in this._)t.call(this,jt(i))}}),b.behavior={},b.rebind=function(t,i){for(var r,a=1,s=arguments.length;++a<s;)t[r=arguments[a]]=(function(u,f,h){return function(){var d=h.apply(f,arguments);return d===f?u:d}})(t,i,i[r]);return t};var Po=["webkit","ms","moz","Moz","o","O"];b.dispatch=function(){for(var t=new Ct,i=-1,r=arguments.length;++i<r;)t[arguments[i]]=Y(t);return t},Ct.prototype.on=function(t,i){var r=t.indexOf("."),a="";if(r>=0&&(a=t.slice(r+1),t=t.slice(0,r)),t)return arguments.length<2?this[t].on(a):this[t].on(a,i);if(arguments.length===2){if(i==null)for(t in this)this.hasOwnProperty(t)&&this[t].on(a,null);return this}},b.event=null,b.requote=function(t){return t.replace(wu,"\\$&")};var wu=/[\\\^\$\*\+\?\|\[\]\(\)\.\{\}]/g,bi={}.__proto__?function(t,i){t.__proto__=i}:function(t,i){for(var r in i)t[r]=i[r]},Lr=function(t,i){return i.querySelector(t)},kr=function(t,i){return i.querySelectorAll(t)},Dr=function(t,i){var r=t.matches||t[ut(t,"matchesSelector")];return(Dr=function(a,s){return r.call(a,s)})(t,i)};typeof Sizzle=="function"&&(Lr=function(t,i){return Sizzle(t,i)[0]||null},kr=Sizzle,Dr=Sizzle.matchesSelector),b.selection=function(){return b.select(je.documentElement)};var ge=b.selection.prototype=[];ge.select=function(t){var i,r,a,s,u=[];t=bt(t);for(var f=-1,h=this.length;++f<h;){u.push(i=[]),i.parentNode=(a=this[f]).parentNode;for(var d=-1,g=a.length;++d<g;)(s=a[d])?(i.push(r=t.call(s,s.__data__,d,f)),r&&"__data__"in s&&(r.__data__=s.__data__)):i.push(null)}return ne(u)},ge.selectAll=function(t){var i,r,a=[];t=w(t);for(var s=-1,u=this.length;++s<u;)for(var f=this[s],h=-1,d=f.length;++h<d;)(r=f[h])&&(a.push(i=Je(t.call(r,r.__data__,h,s))),i.parentNode=r);return ne(a)};var Or="http://www.w3.org/1999/xhtml",Vr={svg:"http://www.w3.org/2000/svg",xhtml:Or,xlink:"http://www.w3.org/1999/xlink",xml:"http://www.w3.org/XML/1998/namespace",xmlns:"http://www.w3.org/2000/xmlns/"};b.ns={prefix:Vr,qualify:function(t){var i=t.indexOf(":"),r=t;return i>=0&&(r=t.slice(0,i))!=="xmlns"&&(t=t.slice(i+1)),Vr.hasOwnProperty(r)?{space:Vr[r],local:t}:t}},ge.attr=function(t,i){if(arguments.length<2){if(typeof t=="string"){var r=this.node();return(t=b.ns.qualify(t)).local?r.getAttributeNS(t.space,t.local):r.getAttribute(t)}for(i in t)this.each(Le(i,t[i]));return this}return this.each(Le(t,i))},ge.classed=function(t,i){if(arguments.length<2){if(typeof t=="string"){var r=this.node(),a=(t=o(t)).length,s=-1;if(i=r.classList){for(;++s<a;)if(!i.contains(t[s]))return!1}else for(i=r.getAttribute("class");++s<a;)if(!n(t[s]).test(i))return!1;return!0}for(i in t)this.each(l(i,t[i]));return this}return this.each(l(t,i))},ge.style=function(t,i,r){var a=arguments.length;if(a<3){if(typeof t!="string"){a<2&&(i="");for(r in t)this.each(y(r,t[r],i));return this}if(a<2){var s=this.node();return dt(s).getComputedStyle(s,null).getPropertyValue(t)}r=""}return this.each(y(t,i,r))},ge.property=function(t,i){if(arguments.length<2){if(typeof t=="string")return this.node()[t];for(i in t)this.each(_(i,t[i]));return this}return this.each(_(t,i))},ge.text=function(t){return arguments.length?this.each(typeof t=="function"?function(){var i=t.apply(this,arguments);this.textContent=i==null?"":i}:t==null?function(){this.textContent=""}:function(){this.textContent=t}):this.node().textContent},ge.html=function(t){return arguments.length?this.each(typeof t=="function"?function(){var i=t.apply(this,arguments);this.innerHTML=i==null?"":i}:t==null?function(){this.innerHTML=""}:function(){this.innerHTML=t}):this.node().innerHTML},ge.append=function(t){return t=T(t),this.select(function(){return this.appendChild(t.apply(this,arguments))})},ge.insert=function(t,i){return t=T(t),i=bt(i),this.select(function(){return this.insertBefore(t.apply(this,arguments),i.apply(this,arguments)||null)})},ge.remove=function(){return this.each(L)},ge.data=function(t,i){function r(m,v){var x,S,A,E=m.length,M=v.length,D=Math.min(E,M),O=new Array(M),k=new Array(M),G=new Array(E);if(i){var N,R=new ft,C=new Array(E);for(x=-1;++x<E;)(S=m[x])&&(R.has(N=i.call(S,S.__data__,x))?G[x]=S:R.set(N,S),C[x]=N);for(x=-1;++x<M;)(S=R.get(N=i.call(v,A=v[x],x)))?S!==!0&&(O[x]=S,S.__data__=A):k[x]=V(A),R.set(N,!0);for(x=-1;++x<E;)x in C&&R.get(C[x])!==!0&&(G[x]=m[x])}else{for(x=-1;++x<D;)S=m[x],A=v[x],S?(S.__data__=A,O[x]=S):k[x]=V(A);for(;x<M;++x)k[x]=V(v[x]);for(;x<E;++x)G[x]=m[x]}k.update=O,k.parentNode=O.parentNode=G.parentNode=m.parentNode,h.push(k),d.push(O),g.push(G)}var a,s,u=-1,f=this.length;if(!arguments.length){for(t=new Array(f=(a=this[0]).length);++u<f;)(s=a[u])&&(t[u]=s.__data__);return t}var h=ot([]),d=ne([]),g=ne([]);if(typeof t=="function")for(;++u<f;)r(a=this[u],t.call(a,a.parentNode.__data__,u));else for(;++u<f;)r(a=this[u],t);return d.enter=function(){return h},d.exit=function(){return g},d},ge.datum=function(t){return arguments.length?this.property("__data__",t):this.property("__data__")},ge.filter=function(t){var i,r,a,s=[];typeof t!="function"&&(t=F(t));for(var u=0,f=this.length;u<f;u++){s.push(i=[]),i.parentNode=(r=this[u]).parentNode;for(var h=0,d=r.length;h<d;h++)(a=r[h])&&t.call(a,a.__data__,h,u)&&i.push(a)}return ne(s)},ge.order=function(){for(var t=-1,i=this.length;++t<i;)for(var r,a=this[t],s=a.length-1,u=a[s];--s>=0;)(r=a[s])&&(u&&u!==r.nextSibling&&u.parentNode.insertBefore(r,u),u=r);return this},ge.sort=function(t){t=function(a){return arguments.length||(a=H),function(s,u){return s&&u?a(s.__data__,u.__data__):!s-!u}}.apply(this,arguments);for(var i=-1,r=this.length;++i<r;)this[i].sort(t);return this.order()},ge.each=function(t){return q(this,function(i,r,a){t.call(i,i.__data__,r,a)})},ge.call=function(t){var i=Je(arguments);return t.apply(i[0]=this,i),this},ge.empty=function(){return!this.node()},ge.node=function(){for(var t=0,i=this.length;t<i;t++)for(var r=this[t],a=0,s=r.length;a<s;a++){var u=r[a];if(u)return u}return null},ge.size=function(){var t=0;return q(this,function(){++t}),t};var nn=[];b.selection.enter=ot,b.selection.enter.prototype=nn,nn.append=ge.append,nn.empty=ge.empty,nn.node=ge.node,nn.call=ge.call,nn.size=ge.size,nn.select=function(t){for(var i,r,a,s,u,f=[],h=-1,d=this.length;++h<d;){a=(s=this[h]).update,f.push(i=[]),i.parentNode=s.parentNode;for(var g=-1,m=s.length;++g<m;)(u=s[g])?(i.push(a[g]=r=t.call(s.parentNode,u.__data__,g,h)),r.__data__=u.__data__):i.push(null)}return ne(f)},nn.insert=function(t,i){return arguments.length<2&&(i=(function(r){var a,s;return function(u,f,h){var d,g=r[h].update,m=g.length;for(h!=s&&(s=h,a=0),f>=a&&(a=f+1);!(d=g[a])&&++a<m;);return d}})(this)),ge.insert.call(this,t,i)},b.select=function(t){var i;return typeof t=="string"?(i=[Lr(t,je)]).parentNode=je.documentElement:(i=[t]).parentNode=st(t),ne([i])},b.selectAll=function(t){var i;return typeof t=="string"?(i=Je(kr(t,je))).parentNode=je.documentElement:(i=Je(t)).parentNode=null,ne([i])},ge.on=function(t,i,r){var a=arguments.length;if(a<3){if(typeof t!="string"){a<2&&(i=!1);for(r in t)this.each(lt(r,t[r],i));return this}if(a<2)return(a=this.node()["__on"+t])&&a._;r=!1}return this.each(lt(t,i,r))};var Gr=b.map({mouseenter:"mouseover",mouseleave:"mouseout"});je&&Gr.forEach(function(t){"on"+t in je&&Gr.remove(t)});var xn,bu=0;b.mouse=function(t){return qt(t,pt())};var Ir=this.navigator&&/WebKit/.test(this.navigator.userAgent)?-1:0;b.touch=function(t,i,r){if(arguments.length<3&&(r=i,i=pt().changedTouches),i){for(var a,s=0,u=i.length;s<u;++s)if((a=i[s]).identifier===r)return qt(t,a)}},b.behavior.drag=function(){function t(){this.on("mousedown.drag",s).on("touchstart.drag",u)}function i(f,h,d,g,m){return function(){var v,x=b.event.target.correspondingElement||b.event.target,S=this.parentNode,A=r.of(this,arguments),E=0,M=f(),D=".drag"+(M==null?"":"-"+M),O=b.select(d(x)).on(g+D,function(){var N,R,C=h(S,M);C&&(N=C[0]-G[0],R=C[1]-G[1],E|=N|R,G=C,A({type:"drag",x:C[0]+v[0],y:C[1]+v[1],dx:N,dy:R}))}).on(m+D,function(){h(S,M)&&(O.on(g+D,null).on(m+D,null),k(E),A({type:"dragend"}))}),k=Rt(x),G=h(S,M);v=a?[(v=a.apply(this,arguments)).x-G[0],v.y-G[1]]:[0,0],A({type:"dragstart"})}}var r=Xt(t,"drag","dragstart","dragend"),a=null,s=i(vt,b.mouse,dt,"mousemove","mouseup"),u=i(Jt,b.touch,K,"touchmove","touchend");return t.origin=function(f){return arguments.length?(a=f,t):a},b.rebind(t,r,"on")},b.touches=function(t,i){return arguments.length<2&&(i=pt().touches),i?Je(i).map(function(r){var a=qt(t,r);return a.identifier=r.identifier,a}):[]};var Mt=1e-6,Si=Mt*Mt,$t=Math.PI,Xe=2*$t,Su=Xe-Mt,Me=$t/2,Bt=$t/180,be=180/$t,Zn=Math.SQRT2;b.interpolateZoom=function(t,i){var r,a,s=t[0],u=t[1],f=t[2],h=i[0],d=i[1],g=i[2],m=h-s,v=d-u,x=m*m+v*v;if(x<Si)a=Math.log(g/f)/Zn,r=function(O){return[s+O*m,u+O*v,f*Math.exp(Zn*O*a)]};else{var S=Math.sqrt(x),A=(g*g-f*f+4*x)/(2*f*2*S),E=(g*g-f*f-4*x)/(2*g*2*S),M=Math.log(Math.sqrt(A*A+1)-A),D=Math.log(Math.sqrt(E*E+1)-E);a=(D-M)/Zn,r=function(O){var k=O*a,G=xt(M),N=f/(2*S)*(G*(function(R){return((R=Math.exp(2*R))-1)/(R+1)})(Zn*k+M)-(function(R){return((R=Math.exp(R))-1/R)/2})(M));return[s+N*m,u+N*v,f*G/xt(Zn*k+M)]}}return r.duration=1e3*a,r},b.behavior.zoom=function(){function t(rt){rt.on(U,g).on(Rr+".zoom",v).on("dblclick.zoom",x).on(At,m)}function i(rt){return[(rt[0]-R.x)/R.k,(rt[1]-R.y)/R.k]}function r(rt){R.k=Math.max(z[0],Math.min(z[1],rt))}function a(rt,_t){_t=(function(Dt){return[Dt[0]*R.k+R.x,Dt[1]*R.k+R.y]})(_t),R.x+=rt[0]-_t[0],R.y+=rt[1]-_t[1]}function s(rt,_t,Dt,Nt){rt.__chart__={x:R.x,y:R.y,k:R.k},r(Math.pow(2,Nt)),a(A=_t,Dt),rt=b.select(rt),et>0&&(rt=rt.transition().duration(et)),rt.call(t.event)}function u(){k&&k.domain(O.range().map(function(rt){return(rt-R.x)/R.k}).map(O.invert)),N&&N.domain(G.range().map(function(rt){return(rt-R.y)/R.k}).map(G.invert))}function f(rt){it++||rt({type:"zoomstart"})}function h(rt){u(),rt({type:"zoom",scale:R.k,translate:[R.x,R.y]})}function d(rt){--it||(rt({type:"zoomend"}),A=null)}function g(){var rt=this,_t=wt.of(rt,arguments),Dt=0,Nt=b.select(dt(rt)).on(Z,function(){Dt=1,a(b.mouse(rt),Kt),h(_t)}).on(J,function(){Nt.on(Z,null).on(J,null),ee(Dt),d(_t)}),Kt=i(b.mouse(rt)),ee=Rt(rt);Ui.call(rt),f(_t)}function m(){function rt(){var Se=b.touches(ee);return Kt=R.k,Se.forEach(function(de){de.identifier in Qt&&(Qt[de.identifier]=i(de))}),Se}function _t(){var Se=b.event.target;b.select(Se).on(_e,Dt).on(ve,Nt),ke.push(Se);for(var de=b.event.changedTouches,Ce=0,Pe=de.length;Ce<Pe;++Ce)Qt[de[Ce].identifier]=null;var De=rt(),Ie=Date.now();if(De.length===1){if(Ie-D<500){var Fe=De[0];s(ee,Fe,Qt[Fe.identifier],Math.floor(Math.log(R.k)/Math.LN2)+1),Q()}D=Ie}else if(De.length>1){Fe=De[0];var qe=De[1],gn=Fe[0]-qe[0],Kn=Fe[1]-qe[1];se=gn*gn+Kn*Kn}}function Dt(){var Se,de,Ce,Pe,De=b.touches(ee);Ui.call(ee);for(var Ie=0,Fe=De.length;Ie<Fe;++Ie,Pe=null)if(Ce=De[Ie],Pe=Qt[Ce.identifier]){if(de)break;Se=Ce,de=Pe}if(Pe){var qe=(qe=Ce[0]-Se[0])*qe+(qe=Ce[1]-Se[1])*qe,gn=se&&Math.sqrt(qe/se);Se=[(Se[0]+Ce[0])/2,(Se[1]+Ce[1])/2],de=[(de[0]+Pe[0])/2,(de[1]+Pe[1])/2],r(gn*Kt)}D=null,a(Se,de),h(ce)}function Nt(){if(b.event.touches.length){for(var Se=b.event.changedTouches,de=0,Ce=Se.length;de<Ce;++de)delete Qt[Se[de].identifier];for(var Pe in Qt)return void rt()}b.selectAll(ke).on(ue,null),Ge.on(U,g).on(At,m),Re(),d(ce)}var Kt,ee=this,ce=wt.of(ee,arguments),Qt={},se=0,ue=".zoom-"+b.event.changedTouches[0].identifier,_e="touchmove"+ue,ve="touchend"+ue,ke=[],Ge=b.select(ee),Re=Rt(ee);_t(),f(ce),Ge.on(U,null).on(At,_t)}function v(){var rt=wt.of(this,arguments);M?clearTimeout(M):(Ui.call(this),S=i(A=E||b.mouse(this)),f(rt)),M=setTimeout(function(){M=null,d(rt)},50),Q(),r(Math.pow(2,.002*Ti())*R.k),a(A,S),h(rt)}function x(){var rt=b.mouse(this),_t=Math.log(R.k)/Math.LN2;s(this,rt,i(rt),b.event.shiftKey?Math.ceil(_t)-1:Math.floor(_t)+1)}var S,A,E,M,D,O,k,G,N,R={x:0,y:0,k:1},C=[960,500],z=Mo,et=250,it=0,U="mousedown.zoom",Z="mousemove.zoom",J="mouseup.zoom",At="touchstart.zoom",wt=Xt(t,"zoomstart","zoom","zoomend");return Rr||(Rr="onwheel"in je?(Ti=function(){return-b.event.deltaY*(b.event.deltaMode?120:1)},"wheel"):"onmousewheel"in je?(Ti=function(){return b.event.wheelDelta},"mousewheel"):(Ti=function(){return-b.event.detail},"MozMousePixelScroll")),t.event=function(rt){rt.each(function(){var _t=wt.of(this,arguments),Dt=R;Tn?b.select(this).transition().each("start.zoom",function(){R=this.__chart__||{x:0,y:0,k:1},f(_t)}).tween("zoom:zoom",function(){var Nt=C[0],Kt=C[1],ee=A?A[0]:Nt/2,ce=A?A[1]:Kt/2,Qt=b.interpolateZoom([(ee-R.x)/R.k,(ce-R.y)/R.k,Nt/R.k],[(ee-Dt.x)/Dt.k,(ce-Dt.y)/Dt.k,Nt/Dt.k]);return function(se){var ue=Qt(se),_e=Nt/ue[2];this.__chart__=R={x:ee-ue[0]*_e,y:ce-ue[1]*_e,k:_e},h(_t)}}).each("interrupt.zoom",function(){d(_t)}).each("end.zoom",function(){d(_t)}):(this.__chart__=R,f(_t),h(_t),d(_t))})},t.translate=function(rt){return arguments.length?(R={x:+rt[0],y:+rt[1],k:R.k},u(),t):[R.x,R.y]},t.scale=function(rt){return arguments.length?(R={x:R.x,y:R.y,k:null},r(+rt),u(),t):R.k},t.scaleExtent=function(rt){return arguments.length?(z=rt==null?Mo:[+rt[0],+rt[1]],t):z},t.center=function(rt){return arguments.length?(E=rt&&[+rt[0],+rt[1]],t):E},t.size=function(rt){return arguments.length?(C=rt&&[+rt[0],+rt[1]],t):C},t.duration=function(rt){return arguments.length?(et=+rt,t):et},t.x=function(rt){return arguments.length?(k=rt,O=rt.copy(),R={x:0,y:0,k:1},t):k},t.y=function(rt){return arguments.length?(N=rt,G=rt.copy(),R={x:0,y:0,k:1},t):N},b.rebind(t,wt,"on")};var Ti,Rr,Mo=[0,1/0];b.color=re,re.prototype.toString=function(){return this.rgb()+""},b.hsl=zt;var jr=zt.prototype=new re;jr.brighter=function(t){return t=Math.pow(.7,arguments.length?t:1),new zt(this.h,this.s,this.l/t)},jr.darker=function(t){return t=Math.pow(.7,arguments.length?t:1),new zt(this.h,this.s,t*this.l)},jr.rgb=function(){return oe(this.h,this.s,this.l)},b.hcl=te;var Nr=te.prototype=new re;Nr.brighter=function(t){return new te(this.h,this.c,Math.min(100,this.l+Ai*(arguments.length?t:1)))},Nr.darker=function(t){return new te(this.h,this.c,Math.max(0,this.l-Ai*(arguments.length?t:1)))},Nr.rgb=function(){return Ft(this.h,this.c,this.l).rgb()},b.lab=ye;var Ai=18,Eo=.95047,Lo=1,ko=1.08883,Fr=ye.prototype=new re;Fr.brighter=function(t){return new ye(Math.min(100,this.l+Ai*(arguments.length?t:1)),this.a,this.b)},Fr.darker=function(t){return new ye(Math.max(0,this.l-Ai*(arguments.length?t:1)),this.a,this.b)},Fr.rgb=function(){return ct(this.l,this.a,this.b)},b.rgb=c;var Ci=c.prototype=new re;Ci.brighter=function(t){t=Math.pow(.7,arguments.length?t:1);var i=this.r,r=this.g,a=this.b;return i||r||a?(i&&i<30&&(i=30),r&&r<30&&(r=30),a&&a<30&&(a=30),new c(Math.min(255,i/t),Math.min(255,r/t),Math.min(255,a/t))):new c(30,30,30)},Ci.darker=function(t){return t=Math.pow(.7,arguments.length?t:1),new c(t*this.r,t*this.g,t*this.b)},Ci.hsl=function(){return Tt(this.r,this.g,this.b)},Ci.toString=function(){return"#"+W(this.r)+W(this.g)+W(this.b)};var Pi=b.map({aliceblue:15792383,antiquewhite:16444375,aqua:65535,aquamarine:8388564,azure:15794175,beige:16119260,bisque:16770244,black:0,blanchedalmond:16772045,blue:255,blueviolet:9055202,brown:10824234,burlywood:14596231,cadetblue:6266528,chartreuse:8388352,chocolate:13789470,coral:16744272,cornflowerblue:6591981,cornsilk:16775388,crimson:14423100,cyan:65535,darkblue:139,darkcyan:35723,darkgoldenrod:12092939,darkgray:11119017,darkgreen:25600,darkgrey:11119017,darkkhaki:12433259,darkmagenta:9109643,darkolivegreen:5597999,darkorange:16747520,darkorchid:10040012,darkred:9109504,darksalmon:15308410,darkseagreen:9419919,darkslateblue:4734347,darkslategray:3100495,darkslategrey:3100495,darkturquoise:52945,darkviolet:9699539,deeppink:16716947,deepskyblue:49151,dimgray:6908265,dimgrey:6908265,dodgerblue:2003199,firebrick:11674146,floralwhite:16775920,forestgreen:2263842,fuchsia:16711935,gainsboro:14474460,ghostwhite:16316671,gold:16766720,goldenrod:14329120,gray:8421504,green:32768,greenyellow:11403055,grey:8421504,honeydew:15794160,hotpink:16738740,indianred:13458524,indigo:4915330,ivory:16777200,khaki:15787660,lavender:15132410,lavenderblush:16773365,lawngreen:8190976,lemonchiffon:16775885,lightblue:11393254,lightcoral:15761536,lightcyan:14745599,lightgoldenrodyellow:16448210,lightgray:13882323,lightgreen:9498256,lightgrey:13882323,lightpink:16758465,lightsalmon:16752762,lightseagreen:2142890,lightskyblue:8900346,lightslategray:7833753,lightslategrey:7833753,lightsteelblue:11584734,lightyellow:16777184,lime:65280,limegreen:3329330,linen:16445670,magenta:16711935,maroon:8388608,mediumaquamarine:6737322,mediumblue:205,mediumorchid:12211667,mediumpurple:9662683,mediumseagreen:3978097,mediumslateblue:8087790,mediumspringgreen:64154,mediumturquoise:4772300,mediumvioletred:13047173,midnightblue:1644912,mintcream:16121850,mistyrose:16770273,moccasin:16770229,navajowhite:16768685,navy:128,oldlace:16643558,olive:8421376,olivedrab:7048739,orange:16753920,orangered:16729344,orchid:14315734,palegoldenrod:15657130,palegreen:10025880,paleturquoise:11529966,palevioletred:14381203,papayawhip:16773077,peachpuff:16767673,peru:13468991,pink:16761035,plum:14524637,powderblue:11591910,purple:8388736,rebeccapurple:6697881,red:16711680,rosybrown:12357519,royalblue:4286945,saddlebrown:9127187,salmon:16416882,sandybrown:16032864,seagreen:3050327,seashell:16774638,sienna:10506797,silver:12632256,skyblue:8900331,slateblue:6970061,slategray:7372944,slategrey:7372944,snow:16775930,springgreen:65407,steelblue:4620980,tan:13808780,teal:32896,thistle:14204888,tomato:16737095,turquoise:4251856,violet:15631086,wheat:16113331,white:16777215,whitesmoke:16119285,yellow:16776960,yellowgreen:10145074});Pi.forEach(function(t,i){Pi.set(t,I(i))}),b.functor=It,b.xhr=xe(K),b.dsv=function(t,i){function r(g,m,v){arguments.length<3&&(v=m,m=null);var x=me(g,i,m==null?a:s(m),v);return x.row=function(S){return arguments.length?x.response((m=S)==null?a:s(S)):m},x}function a(g){return r.parse(g.responseText)}function s(g){return function(m){return r.parse(m.responseText,g)}}function u(g){return g.map(f).join(t)}function f(g){return h.test(g)?'"'+g.replace(/\"/g,'""')+'"':g}var h=new RegExp('["'+t+`
+]`),d=t.charCodeAt(0);return r.parse=function(g,m){var v;return r.parseRows(g,function(x,S){if(v)return v(x,S-1);var A=new Function("d","return {"+x.map(function(E,M){return JSON.stringify(E)+": d["+M+"]"}).join(",")+"}");v=m?function(E,M){return m(A(E),M)}:A})},r.parseRows=function(g,m){function v(){if(O>=D)return E;if(S)return S=!1,A;var N=O;if(g.charCodeAt(N)===34){for(var R=N;R++<D;)if(g.charCodeAt(R)===34){if(g.charCodeAt(R+1)!==34)break;++R}return O=R+2,(C=g.charCodeAt(R+1))===13?(S=!0,g.charCodeAt(R+2)===10&&++O):C===10&&(S=!0),g.slice(N+1,R).replace(/""/g,'"')}for(;O<D;){var C,z=1;if((C=g.charCodeAt(O++))===10)S=!0;else if(C===13)S=!0,g.charCodeAt(O)===10&&(++O,++z);else if(C!==d)continue;return g.slice(N,O-z)}return g.slice(N)}for(var x,S,A={},E={},M=[],D=g.length,O=0,k=0;(x=v())!==E;){for(var G=[];x!==A&&x!==E;)G.push(x),x=v();m&&(G=m(G,k++))==null||M.push(G)}return M},r.format=function(g){if(Array.isArray(g[0]))return r.formatRows(g);var m=new at,v=[];return g.forEach(function(x){for(var S in x)m.has(S)||v.push(m.add(S))}),[v.map(f).join(t)].concat(g.map(function(x){return v.map(function(S){return f(x[S])}).join(t)})).join(`
+`)},r.formatRows=function(g){return g.map(u).join(`
+`)},r},b.csv=b.dsv(",","text/csv"),b.tsv=b.dsv("	","text/tab-separated-values");var Mi,Ei,Li,ki,Do=this[ut(this,"requestAnimationFrame")]||function(t){setTimeout(t,17)};b.timer=function(){Te.apply(this,arguments)},b.timer.flush=function(){on(),We()},b.round=function(t,i){return i?Math.round(t*(i=Math.pow(10,i)))/i:Math.round(t)};var Tu=["y","z","a","f","p","n","\xB5","m","","k","M","G","T","P","E","Z","Y"].map(function(t,i){var r=Math.pow(10,3*Wt(8-i));return{scale:i>8?function(a){return a/r}:function(a){return a*r},symbol:t}});b.formatPrefix=function(t,i){var r=0;return(t=+t)&&(t<0&&(t*=-1),i&&(t=b.round(t,sn(t,i))),r=1+Math.floor(1e-12+Math.log(t)/Math.LN10),r=Math.max(-24,Math.min(24,3*Math.floor((r-1)/3)))),Tu[8+r/3]};var Oo=/(?:([^{])?([<>=^]))?([+\- ])?([$#])?(0)?(\d+)?(,)?(\.-?\d+)?([a-z%])?/i,Au=b.map({b:function(t){return t.toString(2)},c:function(t){return String.fromCharCode(t)},o:function(t){return t.toString(8)},x:function(t){return t.toString(16)},X:function(t){return t.toString(16).toUpperCase()},g:function(t,i){return t.toPrecision(i)},e:function(t,i){return t.toExponential(i)},f:function(t,i){return t.toFixed(i)},r:function(t,i){return(t=b.round(t,sn(t,i))).toFixed(Math.max(0,Math.min(20,sn(t*(1+1e-15),i))))}}),Vt=b.time={},Ne=Date;He.prototype={getDate:function(){return this._.getUTCDate()},getDay:function(){return this._.getUTCDay()},getFullYear:function(){return this._.getUTCFullYear()},getHours:function(){return this._.getUTCHours()},getMilliseconds:function(){return this._.getUTCMilliseconds()},getMinutes:function(){return this._.getUTCMinutes()},getMonth:function(){return this._.getUTCMonth()},getSeconds:function(){return this._.getUTCSeconds()},getTime:function(){return this._.getTime()},getTimezoneOffset:function(){return 0},valueOf:function(){return this._.valueOf()},setDate:function(){rn.setUTCDate.apply(this._,arguments)},setDay:function(){rn.setUTCDay.apply(this._,arguments)},setFullYear:function(){rn.setUTCFullYear.apply(this._,arguments)},setHours:function(){rn.setUTCHours.apply(this._,arguments)},setMilliseconds:function(){rn.setUTCMilliseconds.apply(this._,arguments)},setMinutes:function(){rn.setUTCMinutes.apply(this._,arguments)},setMonth:function(){rn.setUTCMonth.apply(this._,arguments)},setSeconds:function(){rn.setUTCSeconds.apply(this._,arguments)},setTime:function(){rn.setTime.apply(this._,arguments)}};var rn=Date.prototype;Vt.year=Ye(function(t){return(t=Vt.day(t)).setMonth(0,1),t},function(t,i){t.setFullYear(t.getFullYear()+i)},function(t){return t.getFullYear()}),Vt.years=Vt.year.range,Vt.years.utc=Vt.year.utc.range,Vt.day=Ye(function(t){var i=new Ne(2e3,0);return i.setFullYear(t.getFullYear(),t.getMonth(),t.getDate()),i},function(t,i){t.setDate(t.getDate()+i)},function(t){return t.getDate()-1}),Vt.days=Vt.day.range,Vt.days.utc=Vt.day.utc.range,Vt.dayOfYear=function(t){var i=Vt.year(t);return Math.floor((t-i-6e4*(t.getTimezoneOffset()-i.getTimezoneOffset()))/864e5)},["sunday","monday","tuesday","wednesday","thursday","friday","saturday"].forEach(function(t,i){i=7-i;var r=Vt[t]=Ye(function(a){return(a=Vt.day(a)).setDate(a.getDate()-(a.getDay()+i)%7),a},function(a,s){a.setDate(a.getDate()+7*Math.floor(s))},function(a){var s=Vt.year(a).getDay();return Math.floor((Vt.dayOfYear(a)+(s+i)%7)/7)-(s!==i)});Vt[t+"s"]=r.range,Vt[t+"s"].utc=r.utc.range,Vt[t+"OfYear"]=function(a){var s=Vt.year(a).getDay();return Math.floor((Vt.dayOfYear(a)+(s+i)%7)/7)}}),Vt.week=Vt.sunday,Vt.weeks=Vt.sunday.range,Vt.weeks.utc=Vt.sunday.utc.range,Vt.weekOfYear=Vt.sundayOfYear;var Vo={"-":"",_:" ",0:"0"},Ee=/^\s*\d+/,Go=/^%/;b.locale=function(t){return{numberFormat:(function(i){var r=i.decimal,a=i.thousands,s=i.grouping,u=i.currency,f=s&&a?function(h,d){for(var g=h.length,m=[],v=0,x=s[0],S=0;g>0&&x>0&&(S+x+1>d&&(x=Math.max(1,d-S)),m.push(h.substring(g-=x,g+x)),!((S+=x+1)>d));)x=s[v=(v+1)%s.length];return m.reverse().join(a)}:K;return function(h){var d=Oo.exec(h),g=d[1]||" ",m=d[2]||">",v=d[3]||"-",x=d[4]||"",S=d[5],A=+d[6],E=d[7],M=d[8],D=d[9],O=1,k="",G="",N=!1,R=!0;switch(M&&(M=+M.substring(1)),(S||g==="0"&&m==="=")&&(S=g="0",m="="),D){case"n":E=!0,D="g";break;case"%":O=100,G="%",D="f";break;case"p":O=100,G="%",D="r";break;case"b":case"o":case"x":case"X":x==="#"&&(k="0"+D.toLowerCase());case"c":R=!1;case"d":N=!0,M=0;break;case"s":O=-1,D="r"}x==="$"&&(k=u[0],G=u[1]),D!="r"||M||(D="g"),M!=null&&(D=="g"?M=Math.max(1,Math.min(21,M)):D!="e"&&D!="f"||(M=Math.max(0,Math.min(20,M)))),D=Au.get(D)||function(z){return z+""};var C=S&&E;return function(z){var et=G;if(N&&z%1)return"";var it=z<0||z===0&&1/z<0?(z=-z,"-"):v==="-"?"":v;if(O<0){var U=b.formatPrefix(z,M);z=U.scale(z),et=U.symbol+G}else z*=O;var Z,J,At=(z=D(z,M)).lastIndexOf(".");if(At<0){var wt=R?z.lastIndexOf("e"):-1;wt<0?(Z=z,J=""):(Z=z.substring(0,wt),J=z.substring(wt))}else Z=z.substring(0,At),J=r+z.substring(At+1);!S&&E&&(Z=f(Z,1/0));var rt=k.length+Z.length+J.length+(C?0:it.length),_t=rt<A?new Array(rt=A-rt+1).join(g):"";return C&&(Z=f(_t+Z,_t.length?A-J.length:1/0)),it+=k,z=Z+J,(m==="<"?it+z+_t:m===">"?_t+it+z:m==="^"?_t.substring(0,rt>>=1)+it+z+_t.substring(rt):it+(C?z:_t+z))+et}}})(t),timeFormat:(function(i){function r(C){function z(it){for(var U,Z,J,At=[],wt=-1,rt=0;++wt<et;)C.charCodeAt(wt)===37&&(At.push(C.slice(rt,wt)),(Z=Vo[U=C.charAt(++wt)])!=null&&(U=C.charAt(++wt)),(J=N[U])&&(U=J(it,Z==null?U==="e"?" ":"0":Z)),At.push(U),rt=wt+1);return At.push(C.slice(rt,wt)),At.join("")}var et=C.length;return z.parse=function(it){var U={y:1900,m:0,d:1,H:0,M:0,S:0,L:0,Z:null};if(a(U,C,it,0)!=it.length)return null;"p"in U&&(U.H=U.H%12+12*U.p);var Z=U.Z!=null&&Ne!==He,J=new(Z?He:Ne);return"j"in U?J.setFullYear(U.y,0,U.j):"W"in U||"U"in U?("w"in U||(U.w="W"in U?1:0),J.setFullYear(U.y,0,1),J.setFullYear(U.y,0,"W"in U?(U.w+6)%7+7*U.W-(J.getDay()+5)%7:U.w+7*U.U-(J.getDay()+6)%7)):J.setFullYear(U.y,U.m,U.d),J.setHours(U.H+(U.Z/100|0),U.M+U.Z%100,U.S,U.L),Z?J._:J},z.toString=function(){return C},z}function a(C,z,et,it){for(var U,Z,J,At=0,wt=z.length,rt=et.length;At<wt;){if(it>=rt)return-1;if((U=z.charCodeAt(At++))===37){if(J=z.charAt(At++),!(Z=R[J in Vo?z.charAt(At++):J])||(it=Z(C,et,it))<0)return-1}else if(U!=et.charCodeAt(it++))return-1}return it}var s=i.dateTime,u=i.date,f=i.time,h=i.periods,d=i.days,g=i.shortDays,m=i.months,v=i.shortMonths;r.multi=(r.utc=function(C){function z(it){try{var U=new(Ne=He);return U._=it,et(U)}finally{Ne=Date}}var et=r(C);return z.parse=function(it){try{Ne=He;var U=et.parse(it);return U&&U._}finally{Ne=Date}},z.toString=et.toString,z}).multi=ys;var x=b.map(),S=Jn(d),A=ti(d),E=Jn(g),M=ti(g),D=Jn(m),O=ti(m),k=Jn(v),G=ti(v);h.forEach(function(C,z){x.set(C.toLowerCase(),z)});var N={a:function(C){return g[C.getDay()]},A:function(C){return d[C.getDay()]},b:function(C){return v[C.getMonth()]},B:function(C){return m[C.getMonth()]},c:r(s),d:function(C,z){return ze(C.getDate(),z,2)},e:function(C,z){return ze(C.getDate(),z,2)},H:function(C,z){return ze(C.getHours(),z,2)},I:function(C,z){return ze(C.getHours()%12||12,z,2)},j:function(C,z){return ze(1+Vt.dayOfYear(C),z,3)},L:function(C,z){return ze(C.getMilliseconds(),z,3)},m:function(C,z){return ze(C.getMonth()+1,z,2)},M:function(C,z){return ze(C.getMinutes(),z,2)},p:function(C){return h[+(C.getHours()>=12)]},S:function(C,z){return ze(C.getSeconds(),z,2)},U:function(C,z){return ze(Vt.sundayOfYear(C),z,2)},w:function(C){return C.getDay()},W:function(C,z){return ze(Vt.mondayOfYear(C),z,2)},x:r(u),X:r(f),y:function(C,z){return ze(C.getFullYear()%100,z,2)},Y:function(C,z){return ze(C.getFullYear()%1e4,z,4)},Z:ms,"%":function(){return"%"}},R={a:function(C,z,et){E.lastIndex=0;var it=E.exec(z.slice(et));return it?(C.w=M.get(it[0].toLowerCase()),et+it[0].length):-1},A:function(C,z,et){S.lastIndex=0;var it=S.exec(z.slice(et));return it?(C.w=A.get(it[0].toLowerCase()),et+it[0].length):-1},b:function(C,z,et){k.lastIndex=0;var it=k.exec(z.slice(et));return it?(C.m=G.get(it[0].toLowerCase()),et+it[0].length):-1},B:function(C,z,et){D.lastIndex=0;var it=D.exec(z.slice(et));return it?(C.m=O.get(it[0].toLowerCase()),et+it[0].length):-1},c:function(C,z,et){return a(C,N.c.toString(),z,et)},d:$r,e:$r,H:Kr,I:Kr,j:ds,L:ps,m:hs,M:fs,p:function(C,z,et){var it=x.get(z.slice(et,et+=2).toLowerCase());return it==null?-1:(C.p=it,et)},S:gs,U:os,w:as,W:ss,x:function(C,z,et){return a(C,N.x.toString(),z,et)},X:function(C,z,et){return a(C,N.X.toString(),z,et)},y:cs,Y:us,Z:ls,"%":vs};return r})(t)}};var Io=b.locale({decimal:".",thousands:",",grouping:[3],currency:["$",""],dateTime:"%a %b %e %X %Y",date:"%m/%d/%Y",time:"%H:%M:%S",periods:["AM","PM"],days:["Sunday","Monday","Tuesday","Wednesday","Thursday","Friday","Saturday"],shortDays:["Sun","Mon","Tue","Wed","Thu","Fri","Sat"],months:["January","February","March","April","May","June","July","August","September","October","November","December"],shortMonths:["Jan","Feb","Mar","Apr","May","Jun","Jul","Aug","Sep","Oct","Nov","Dec"]});b.format=Io.numberFormat,b.geo={},Wi.prototype={s:0,t:0,add:function(t){Jr(t,this.t,Di),Jr(Di.s,this.s,this),this.s?this.t+=Di.t:this.s=Di.t},reset:function(){this.s=this.t=0},valueOf:function(){return this.s}};var Di=new Wi;b.geo.stream=function(t,i){t&&Ro.hasOwnProperty(t.type)?Ro[t.type](t,i):ei(t,i)};var Ro={Feature:function(t,i){ei(t.geometry,i)},FeatureCollection:function(t,i){for(var r=t.features,a=-1,s=r.length;++a<s;)ei(r[a].geometry,i)}},jo={Sphere:function(t,i){i.sphere()},Point:function(t,i){t=t.coordinates,i.point(t[0],t[1],t[2])},MultiPoint:function(t,i){for(var r=t.coordinates,a=-1,s=r.length;++a<s;)t=r[a],i.point(t[0],t[1],t[2])},LineString:function(t,i){Qi(t.coordinates,i,0)},MultiLineString:function(t,i){for(var r=t.coordinates,a=-1,s=r.length;++a<s;)Qi(r[a],i,0)},Polygon:function(t,i){ta(t.coordinates,i)},MultiPolygon:function(t,i){for(var r=t.coordinates,a=-1,s=r.length;++a<s;)ta(r[a],i)},GeometryCollection:function(t,i){for(var r=t.geometries,a=-1,s=r.length;++a<s;)ei(r[a],i)}};b.geo.area=function(t){return Oi=0,b.geo.stream(t,Be),Oi};var Oi,_n=new Wi,Be={sphere:function(){Oi+=4*$t},point:vt,lineStart:vt,lineEnd:vt,polygonStart:function(){_n.reset(),Be.lineStart=xs},polygonEnd:function(){var t=2*_n;Oi+=t<0?4*$t+t:t,Be.lineStart=Be.lineEnd=Be.point=vt}};b.geo.bounds=(function(){function t(R,C){k.push(G=[m=R,x=R]),C<v&&(v=C),C>S&&(S=C)}function i(R,C){var z=pn([R*Bt,C*Bt]);if(D){var et=Cn(D,z),it=Cn([et[1],-et[0],0],et);ri(it),it=ai(it);var U=R-A,Z=U>0?1:-1,J=it[0]*be*Z,At=Wt(U)>180;if(At^(Z*A<J&&J<Z*R))(wt=it[1]*be)>S&&(S=wt);else if(J=(J+360)%360-180,At^(Z*A<J&&J<Z*R)){var wt;(wt=-it[1]*be)<v&&(v=wt)}else C<v&&(v=C),C>S&&(S=C);At?R<A?h(m,R)>h(m,x)&&(x=R):h(R,x)>h(m,x)&&(m=R):x>=m?(R<m&&(m=R),R>x&&(x=R)):R>A?h(m,R)>h(m,x)&&(x=R):h(R,x)>h(m,x)&&(m=R)}else t(R,C);D=z,A=R}function r(){N.point=i}function a(){G[0]=m,G[1]=x,N.point=t,D=null}function s(R,C){if(D){var z=R-A;O+=Wt(z)>180?z+(z>0?360:-360):z}else E=R,M=C;Be.point(R,C),i(R,C)}function u(){Be.lineStart()}function f(){s(E,M),Be.lineEnd(),Wt(O)>Mt&&(m=-(x=180)),G[0]=m,G[1]=x,D=null}function h(R,C){return(C-=R)<0?C+360:C}function d(R,C){return R[0]-C[0]}function g(R,C){return C[0]<=C[1]?C[0]<=R&&R<=C[1]:R<C[0]||C[1]<R}var m,v,x,S,A,E,M,D,O,k,G,N={point:t,lineStart:r,lineEnd:a,polygonStart:function(){N.point=s,N.lineStart=u,N.lineEnd=f,O=0,Be.polygonStart()},polygonEnd:function(){Be.polygonEnd(),N.point=t,N.lineStart=r,N.lineEnd=a,_n<0?(m=-(x=180),v=-(S=90)):O>Mt?S=90:O<-Mt&&(v=-90),G[0]=m,G[1]=x}};return function(R){if(S=x=-(m=v=1/0),k=[],b.geo.stream(R,N),it=k.length){k.sort(d);for(var C=1,z=[J=k[0]];C<it;++C)g((U=k[C])[0],J)||g(U[1],J)?(h(J[0],U[1])>h(J[0],J[1])&&(J[1]=U[1]),h(U[0],J[1])>h(J[0],J[1])&&(J[0]=U[0])):z.push(J=U);for(var et,it,U,Z=-1/0,J=(C=0,z[it=z.length-1]);C<=it;J=U,++C)U=z[C],(et=h(J[1],U[0]))>Z&&(Z=et,m=U[0],x=J[1])}return k=G=null,m===1/0||v===1/0?[[NaN,NaN],[NaN,NaN]]:[[m,v],[x,S]]}})(),b.geo.centroid=function(t){Vi=Gi=wn=bn=an=cn=ln=tn=On=Vn=hn=0,b.geo.stream(t,Ze);var i=On,r=Vn,a=hn,s=i*i+r*r+a*a;return s<Si&&(i=cn,r=ln,a=tn,Gi<Mt&&(i=wn,r=bn,a=an),(s=i*i+r*r+a*a)<Si)?[NaN,NaN]:[Math.atan2(r,i)*be,Ot(a/Math.sqrt(s))*be]};var Vi,Gi,wn,bn,an,cn,ln,tn,On,Vn,hn,Ze={sphere:vt,point:$i,lineStart:ea,lineEnd:na,polygonStart:function(){Ze.lineStart=_s},polygonEnd:function(){Ze.lineStart=ea}},No=oa(Nn,function(t){var i,r=NaN,a=NaN,s=NaN;return{lineStart:function(){t.lineStart(),i=1},point:function(u,f){var h=u>0?$t:-$t,d=Wt(u-r);Wt(d-$t)<Mt?(t.point(r,a=(a+f)/2>0?Me:-Me),t.point(s,a),t.lineEnd(),t.lineStart(),t.point(h,a),t.point(u,a),i=0):s!==h&&d>=$t&&(Wt(r-s)<Mt&&(r-=s*Mt),Wt(u-h)<Mt&&(u-=h*Mt),a=(function(g,m,v,x){var S,A,E=Math.sin(g-v);return Wt(E)>Mt?Math.atan((Math.sin(m)*(A=Math.cos(x))*Math.sin(v)-Math.sin(x)*(S=Math.cos(m))*Math.sin(g))/(S*A*E)):(m+x)/2})(r,a,u,f),t.point(s,a),t.lineEnd(),t.lineStart(),t.point(h,a),i=0),t.point(r=u,a=f),s=h},lineEnd:function(){t.lineEnd(),r=a=NaN},clean:function(){return 2-i}}},function(t,i,r,a){var s;if(t==null)s=r*Me,a.point(-$t,s),a.point(0,s),a.point($t,s),a.point($t,0),a.point($t,-s),a.point(0,-s),a.point(-$t,-s),a.point(-$t,0),a.point(-$t,s);else if(Wt(t[0]-i[0])>Mt){var u=t[0]<i[0]?$t:-$t;s=r*u/2,a.point(-u,s),a.point(0,s),a.point(u,s)}else a.point(i[0],i[1])},[-$t,-$t/2]),Ii=1e9;b.geo.clipExtent=function(){var t,i,r,a,s,u,f={stream:function(h){return s&&(s.valid=!1),s=u(h),s.valid=!0,s},extent:function(h){return arguments.length?(u=ca(t=+h[0][0],i=+h[0][1],r=+h[1][0],a=+h[1][1]),s&&(s.valid=!1,s=null),f):[[t,i],[r,a]]}};return f.extent([[0,0],[960,500]])},(b.geo.conicEqualArea=function(){return Ki(la)}).raw=la,b.geo.albers=function(){return b.geo.conicEqualArea().rotate([96,0]).center([-.6,38.7]).parallels([29.5,45.5]).scale(1070)},b.geo.albersUsa=function(){function t(g){var m=g[0],v=g[1];return i=null,r(m,v),i||(a(m,v),i)||s(m,v),i}var i,r,a,s,u=b.geo.albers(),f=b.geo.conicEqualArea().rotate([154,0]).center([-2,58.5]).parallels([55,65]),h=b.geo.conicEqualArea().rotate([157,0]).center([-3,19.9]).parallels([8,18]),d={point:function(g,m){i=[g,m]}};return t.invert=function(g){var m=u.scale(),v=u.translate(),x=(g[0]-v[0])/m,S=(g[1]-v[1])/m;return(S>=.12&&S<.234&&x>=-.425&&x<-.214?f:S>=.166&&S<.234&&x>=-.214&&x<-.115?h:u).invert(g)},t.stream=function(g){var m=u.stream(g),v=f.stream(g),x=h.stream(g);return{point:function(S,A){m.point(S,A),v.point(S,A),x.point(S,A)},sphere:function(){m.sphere(),v.sphere(),x.sphere()},lineStart:function(){m.lineStart(),v.lineStart(),x.lineStart()},lineEnd:function(){m.lineEnd(),v.lineEnd(),x.lineEnd()},polygonStart:function(){m.polygonStart(),v.polygonStart(),x.polygonStart()},polygonEnd:function(){m.polygonEnd(),v.polygonEnd(),x.polygonEnd()}}},t.precision=function(g){return arguments.length?(u.precision(g),f.precision(g),h.precision(g),t):u.precision()},t.scale=function(g){return arguments.length?(u.scale(g),f.scale(.35*g),h.scale(g),t.translate(u.translate())):u.scale()},t.translate=function(g){if(!arguments.length)return u.translate();var m=u.scale(),v=+g[0],x=+g[1];return r=u.translate(g).clipExtent([[v-.455*m,x-.238*m],[v+.455*m,x+.238*m]]).stream(d).point,a=f.translate([v-.307*m,x+.201*m]).clipExtent([[v-.425*m+Mt,x+.12*m+Mt],[v-.214*m-Mt,x+.234*m-Mt]]).stream(d).point,s=h.translate([v-.205*m,x+.212*m]).clipExtent([[v-.214*m+Mt,x+.166*m+Mt],[v-.115*m-Mt,x+.234*m-Mt]]).stream(d).point,t},t.scale(1070)};var zr,Hr,Ri,ji,Ni,Fi,dn={point:vt,lineStart:vt,lineEnd:vt,polygonStart:function(){Hr=0,dn.lineStart=Ss},polygonEnd:function(){dn.lineStart=dn.lineEnd=dn.point=vt,zr+=Wt(Hr/2)}},Cu={point:function(t,i){t<Ri&&(Ri=t),t>Ni&&(Ni=t),i<ji&&(ji=i),i>Fi&&(Fi=i)},lineStart:vt,lineEnd:vt,polygonStart:vt,polygonEnd:vt},$e={point:mn,lineStart:da,lineEnd:fa,polygonStart:function(){$e.lineStart=Ts},polygonEnd:function(){$e.point=mn,$e.lineStart=da,$e.lineEnd=fa}};b.geo.path=function(){function t(d){return d&&(typeof h=="function"&&u.pointRadius(+h.apply(this,arguments)),f&&f.valid||(f=s(u)),b.geo.stream(d,f)),u.result()}function i(){return f=null,t}var r,a,s,u,f,h=4.5;return t.area=function(d){return zr=0,b.geo.stream(d,s(dn)),zr},t.centroid=function(d){return wn=bn=an=cn=ln=tn=On=Vn=hn=0,b.geo.stream(d,s($e)),hn?[On/hn,Vn/hn]:tn?[cn/tn,ln/tn]:an?[wn/an,bn/an]:[NaN,NaN]},t.bounds=function(d){return Ni=Fi=-(Ri=ji=1/0),b.geo.stream(d,s(Cu)),[[Ri,ji],[Ni,Fi]]},t.projection=function(d){return arguments.length?(s=(r=d)?d.stream||(function(g){var m=ga(function(v,x){return g([v*be,x*be])});return function(v){return va(m(v))}})(d):K,i()):r},t.context=function(d){return arguments.length?(u=(a=d)==null?new function(){function g(D,O){E.push("M",D,",",O,A)}function m(D,O){E.push("M",D,",",O),M.point=v}function v(D,O){E.push("L",D,",",O)}function x(){M.point=g}function S(){E.push("Z")}var A=ha(4.5),E=[],M={point:g,lineStart:function(){M.point=m},lineEnd:x,polygonStart:function(){M.lineEnd=S},polygonEnd:function(){M.lineEnd=x,M.point=g},pointRadius:function(D){return A=ha(D),M},result:function(){if(E.length){var D=E.join("");return E=[],D}}};return M}:new function(g){function m(D,O){g.moveTo(D+E,O),g.arc(D,O,E,0,Xe)}function v(D,O){g.moveTo(D,O),M.point=x}function x(D,O){g.lineTo(D,O)}function S(){M.point=m}function A(){g.closePath()}var E=4.5,M={point:m,lineStart:function(){M.point=v},lineEnd:S,polygonStart:function(){M.lineEnd=A},polygonEnd:function(){M.lineEnd=S,M.point=m},pointRadius:function(D){return E=D,M},result:vt};return M}(d),typeof h!="function"&&u.pointRadius(h),i()):a},t.pointRadius=function(d){return arguments.length?(h=typeof d=="function"?d:(u.pointRadius(+d),+d),t):h},t.projection(b.geo.albersUsa()).context(null)},b.geo.transform=function(t){return{stream:function(i){var r=new pa(i);for(var a in t)r[a]=t[a];return r}}},pa.prototype={point:function(t,i){this.stream.point(t,i)},sphere:function(){this.stream.sphere()},lineStart:function(){this.stream.lineStart()},lineEnd:function(){this.stream.lineEnd()},polygonStart:function(){this.stream.polygonStart()},polygonEnd:function(){this.stream.polygonEnd()}},b.geo.projection=un,b.geo.projectionMutator=Ji,(b.geo.equirectangular=function(){return un(Fn)}).raw=Fn.invert=Fn,b.geo.rotation=function(t){function i(r){return r=t(r[0]*Bt,r[1]*Bt),r[0]*=be,r[1]*=be,r}return t=tr(t[0]%360*Bt,t[1]*Bt,t.length>2?t[2]*Bt:0),i.invert=function(r){return r=t.invert(r[0]*Bt,r[1]*Bt),r[0]*=be,r[1]*=be,r},i},ya.invert=Fn,b.geo.circle=function(){function t(){var u=typeof a=="function"?a.apply(this,arguments):a,f=tr(-u[0]*Bt,-u[1]*Bt,0).invert,h=[];return r(null,null,1,{point:function(d,g){h.push(d=f(d,g)),d[0]*=be,d[1]*=be}}),{type:"Polygon",coordinates:[h]}}var i,r,a=[0,0],s=6;return t.origin=function(u){return arguments.length?(a=u,t):a},t.angle=function(u){return arguments.length?(r=er((i=+u)*Bt,s*Bt),t):i},t.precision=function(u){return arguments.length?(r=er(i*Bt,(s=+u)*Bt),t):s},t.angle(90)},b.geo.distance=function(t,i){var r,a=(i[0]-t[0])*Bt,s=t[1]*Bt,u=i[1]*Bt,f=Math.sin(a),h=Math.cos(a),d=Math.sin(s),g=Math.cos(s),m=Math.sin(u),v=Math.cos(u);return Math.atan2(Math.sqrt((r=v*f)*r+(r=g*m-d*v*h)*r),d*m+g*v*h)},b.geo.graticule=function(){function t(){return{type:"MultiLineString",coordinates:i()}}function i(){return b.range(Math.ceil(u/M)*M,s,M).map(x).concat(b.range(Math.ceil(g/D)*D,d,D).map(S)).concat(b.range(Math.ceil(a/A)*A,r,A).filter(function(k){return Wt(k%M)>Mt}).map(m)).concat(b.range(Math.ceil(h/E)*E,f,E).filter(function(k){return Wt(k%D)>Mt}).map(v))}var r,a,s,u,f,h,d,g,m,v,x,S,A=10,E=A,M=90,D=360,O=2.5;return t.lines=function(){return i().map(function(k){return{type:"LineString",coordinates:k}})},t.outline=function(){return{type:"Polygon",coordinates:[x(u).concat(S(d).slice(1),x(s).reverse().slice(1),S(g).reverse().slice(1))]}},t.extent=function(k){return arguments.length?t.majorExtent(k).minorExtent(k):t.minorExtent()},t.majorExtent=function(k){return arguments.length?(u=+k[0][0],s=+k[1][0],g=+k[0][1],d=+k[1][1],u>s&&(k=u,u=s,s=k),g>d&&(k=g,g=d,d=k),t.precision(O)):[[u,g],[s,d]]},t.minorExtent=function(k){return arguments.length?(a=+k[0][0],r=+k[1][0],h=+k[0][1],f=+k[1][1],a>r&&(k=a,a=r,r=k),h>f&&(k=h,h=f,f=k),t.precision(O)):[[a,h],[r,f]]},t.step=function(k){return arguments.length?t.majorStep(k).minorStep(k):t.minorStep()},t.majorStep=function(k){return arguments.length?(M=+k[0],D=+k[1],t):[M,D]},t.minorStep=function(k){return arguments.length?(A=+k[0],E=+k[1],t):[A,E]},t.precision=function(k){return arguments.length?(O=+k,m=Sa(h,f,90),v=Ta(a,r,O),x=Sa(g,d,90),S=Ta(u,s,O),t):O},t.majorExtent([[-180,-90+Mt],[180,90-Mt]]).minorExtent([[-180,-80-Mt],[180,80+Mt]])},b.geo.greatArc=function(){function t(){return{type:"LineString",coordinates:[i||a.apply(this,arguments),r||s.apply(this,arguments)]}}var i,r,a=nr,s=ir;return t.distance=function(){return b.geo.distance(i||a.apply(this,arguments),r||s.apply(this,arguments))},t.source=function(u){return arguments.length?(a=u,i=typeof u=="function"?null:u,t):a},t.target=function(u){return arguments.length?(s=u,r=typeof u=="function"?null:u,t):s},t.precision=function(){return arguments.length?t:0},t},b.geo.interpolate=function(t,i){return(function(r,a,s,u){var f=Math.cos(a),h=Math.sin(a),d=Math.cos(u),g=Math.sin(u),m=f*Math.cos(r),v=f*Math.sin(r),x=d*Math.cos(s),S=d*Math.sin(s),A=2*Math.asin(Math.sqrt(St(u-a)+f*d*St(s-r))),E=1/Math.sin(A),M=A?function(D){var O=Math.sin(D*=A)*E,k=Math.sin(A-D)*E,G=k*m+O*x,N=k*v+O*S,R=k*h+O*g;return[Math.atan2(N,G)*be,Math.atan2(R,Math.sqrt(G*G+N*N))*be]}:function(){return[r*be,a*be]};return M.distance=A,M})(t[0]*Bt,t[1]*Bt,i[0]*Bt,i[1]*Bt)},b.geo.length=function(t){return Yr=0,b.geo.stream(t,Gn),Yr};var Yr,Gn={sphere:vt,point:vt,lineStart:function(){function t(s,u){var f=Math.sin(u*=Bt),h=Math.cos(u),d=Wt((s*=Bt)-i),g=Math.cos(d);Yr+=Math.atan2(Math.sqrt((d=h*Math.sin(d))*d+(d=a*f-r*h*g)*d),r*f+a*h*g),i=s,r=f,a=h}var i,r,a;Gn.point=function(s,u){i=s*Bt,r=Math.sin(u*=Bt),a=Math.cos(u),Gn.point=t},Gn.lineEnd=function(){Gn.point=Gn.lineEnd=vt}},lineEnd:vt,polygonStart:vt,polygonEnd:vt},Fo=zn(function(t){return Math.sqrt(2/(1+t))},function(t){return 2*Math.asin(t/2)});(b.geo.azimuthalEqualArea=function(){return un(Fo)}).raw=Fo;var zo=zn(function(t){var i=Math.acos(t);return i&&i/Math.sin(i)},K);(b.geo.azimuthalEquidistant=function(){return un(zo)}).raw=zo,(b.geo.conicConformal=function(){return Ki(Aa)}).raw=Aa,(b.geo.conicEquidistant=function(){return Ki(Ca)}).raw=Ca;var Ho=zn(function(t){return 1/t},Math.atan);(b.geo.gnomonic=function(){return un(Ho)}).raw=Ho,ui.invert=function(t,i){return[t,2*Math.atan(Math.exp(i))-Me]},(b.geo.mercator=function(){return Pa(ui)}).raw=ui;var Yo=zn(function(){return 1},Math.asin);(b.geo.orthographic=function(){return un(Yo)}).raw=Yo;var Xo=zn(function(t){return 1/(1+t)},function(t){return 2*Math.atan(t)});(b.geo.stereographic=function(){return un(Xo)}).raw=Xo,rr.invert=function(t,i){return[-i,2*Math.atan(Math.exp(t))-Me]},(b.geo.transverseMercator=function(){var t=Pa(rr),i=t.center,r=t.rotate;return t.center=function(a){return a?i([-a[1],a[0]]):(a=i(),[a[1],-a[0]])},t.rotate=function(a){return a?r([a[0],a[1],a.length>2?a[2]+90:90]):(a=r(),[a[0],a[1],a[2]-90])},r([0,0,90])}).raw=rr,b.geom={},b.geom.hull=function(t){function i(s){if(s.length<3)return[];var u,f=It(r),h=It(a),d=s.length,g=[],m=[];for(u=0;u<d;u++)g.push([+f.call(this,s[u],u),+h.call(this,s[u],u),u]);for(g.sort(As),u=0;u<d;u++)m.push([g[u][0],-g[u][1]]);var v=Ma(g),x=Ma(m),S=x[0]===v[0],A=x[x.length-1]===v[v.length-1],E=[];for(u=v.length-1;u>=0;--u)E.push(s[g[v[u]][2]]);for(u=+S;u<x.length-A;++u)E.push(s[g[x[u]][2]]);return E}var r=Pn,a=Hn;return arguments.length?i(t):(i.x=function(s){return arguments.length?(r=s,i):r},i.y=function(s){return arguments.length?(a=s,i):a},i)},b.geom.polygon=function(t){return bi(t,zi),t};var zi=b.geom.polygon.prototype=[];zi.area=function(){for(var t,i=-1,r=this.length,a=this[r-1],s=0;++i<r;)t=a,a=this[i],s+=t[1]*a[0]-t[0]*a[1];return .5*s},zi.centroid=function(t){var i,r,a=-1,s=this.length,u=0,f=0,h=this[s-1];for(arguments.length||(t=-1/(6*this.area()));++a<s;)i=h,h=this[a],r=i[0]*h[1]-h[0]*i[1],u+=(i[0]+h[0])*r,f+=(i[1]+h[1])*r;return[u*t,f*t]},zi.clip=function(t){for(var i,r,a,s,u,f,h=Ea(t),d=-1,g=this.length-Ea(this),m=this[g-1];++d<g;){for(i=t.slice(),t.length=0,s=this[d],u=i[(a=i.length-h)-1],r=-1;++r<a;)ar(f=i[r],m,s)?(ar(u,m,s)||t.push(or(u,f,m,s)),t.push(f)):ar(u,m,s)&&t.push(or(u,f,m,s)),u=f;h&&t.push(t[0]),m=s}return t};var In,Sn,Rn,Xr,$n,Bo=[],Uo=[];Da.prototype.prepare=function(){for(var t,i=this.edges,r=i.length;r--;)(t=i[r].edge).b&&t.a||i.splice(r,1);return i.sort(Oa),i.length},li.prototype={start:function(){return this.edge.l===this.site?this.edge.a:this.edge.b},end:function(){return this.edge.l===this.site?this.edge.b:this.edge.a}},ur.prototype={insert:function(t,i){var r,a,s;if(t){if(i.P=t,i.N=t.N,t.N&&(t.N.P=i),t.N=i,t.R){for(t=t.R;t.L;)t=t.L;t.L=i}else t.R=i;r=t}else this._?(t=Ga(this._),i.P=null,i.N=t,t.P=t.L=i,r=t):(i.P=i.N=null,this._=i,r=null);for(i.L=i.R=null,i.U=r,i.C=!0,t=i;r&&r.C;)r===(a=r.U).L?(s=a.R)&&s.C?(r.C=s.C=!1,a.C=!0,t=a):(t===r.R&&(Xn(this,r),r=(t=r).U),r.C=!1,a.C=!0,Bn(this,a)):(s=a.L)&&s.C?(r.C=s.C=!1,a.C=!0,t=a):(t===r.L&&(Bn(this,r),r=(t=r).U),r.C=!1,a.C=!0,Xn(this,a)),r=t.U;this._.C=!1},remove:function(t){t.N&&(t.N.P=t.P),t.P&&(t.P.N=t.N),t.N=t.P=null;var i,r,a,s=t.U,u=t.L,f=t.R;if(r=u?f?Ga(f):u:f,s?s.L===t?s.L=r:s.R=r:this._=r,u&&f?(a=r.C,r.C=t.C,r.L=u,u.U=r,r!==f?(s=r.U,r.U=t.U,t=r.R,s.L=t,r.R=f,f.U=r):(r.U=s,s=r,t=r.R)):(a=t.C,t=r),t&&(t.U=s),!a)if(t&&t.C)t.C=!1;else{do{if(t===this._)break;if(t===s.L){if((i=s.R).C&&(i.C=!1,s.C=!0,Xn(this,s),i=s.R),i.L&&i.L.C||i.R&&i.R.C){i.R&&i.R.C||(i.L.C=!1,i.C=!0,Bn(this,i),i=s.R),i.C=s.C,s.C=i.R.C=!1,Xn(this,s),t=this._;break}}else if((i=s.L).C&&(i.C=!1,s.C=!0,Bn(this,s),i=s.L),i.L&&i.L.C||i.R&&i.R.C){i.L&&i.L.C||(i.R.C=!1,i.C=!0,Xn(this,i),i=s.L),i.C=s.C,s.C=i.L.C=!1,Bn(this,s),t=this._;break}i.C=!0,t=s,s=s.U}while(!t.C);t&&(t.C=!1)}}},b.geom.voronoi=function(t){function i(d){var g=new Array(d.length),m=h[0][0],v=h[0][1],x=h[1][0],S=h[1][1];return cr(r(d),h).cells.forEach(function(A,E){var M=A.edges,D=A.site;(g[E]=M.length?M.map(function(O){var k=O.start();return[k.x,k.y]}):D.x>=m&&D.x<=x&&D.y>=v&&D.y<=S?[[m,S],[x,S],[x,v],[m,v]]:[]).point=d[E]}),g}function r(d){return d.map(function(g,m){return{x:Math.round(u(g,m)/Mt)*Mt,y:Math.round(f(g,m)/Mt)*Mt,i:m}})}var a=Pn,s=Hn,u=a,f=s,h=Hi;return t?i(t):(i.links=function(d){return cr(r(d)).edges.filter(function(g){return g.l&&g.r}).map(function(g){return{source:d[g.l.i],target:d[g.r.i]}})},i.triangles=function(d){var g=[];return cr(r(d)).cells.forEach(function(m,v){for(var x,S=m.site,A=m.edges.sort(Oa),E=-1,M=A.length,D=A[M-1].edge,O=D.l===S?D.r:D.l;++E<M;)x=O,O=(D=A[E].edge).l===S?D.r:D.l,v<x.i&&v<O.i&&(function(k,G,N){return(k.x-N.x)*(G.y-k.y)-(k.x-G.x)*(N.y-k.y)})(S,x,O)<0&&g.push([d[v],d[x.i],d[O.i]])}),g},i.x=function(d){return arguments.length?(u=It(a=d),i):a},i.y=function(d){return arguments.length?(f=It(s=d),i):s},i.clipExtent=function(d){return arguments.length?(h=d==null?Hi:d,i):h===Hi?null:h},i.size=function(d){return arguments.length?i.clipExtent(d&&[[0,0],d]):h===Hi?null:h&&h[1]},i)};var Hi=[[-1e6,-1e6],[1e6,1e6]];b.geom.delaunay=function(t){return b.geom.voronoi().triangles(t)},b.geom.quadtree=function(t,i,r,a,s){function u(g){function m(Z,J,At,wt,rt,_t,Dt,Nt){if(!isNaN(At)&&!isNaN(wt))if(Z.leaf){var Kt=Z.x,ee=Z.y;if(Kt!=null)if(Wt(Kt-At)+Wt(ee-wt)<.01)v(Z,J,At,wt,rt,_t,Dt,Nt);else{var ce=Z.point;Z.x=Z.y=Z.point=null,v(Z,ce,Kt,ee,rt,_t,Dt,Nt),v(Z,J,At,wt,rt,_t,Dt,Nt)}else Z.x=At,Z.y=wt,Z.point=J}else v(Z,J,At,wt,rt,_t,Dt,Nt)}function v(Z,J,At,wt,rt,_t,Dt,Nt){var Kt=.5*(rt+Dt),ee=.5*(_t+Nt),ce=At>=Kt,Qt=wt>=ee,se=Qt<<1|ce;Z.leaf=!1,Z=Z.nodes[se]||(Z.nodes[se]={leaf:!0,nodes:[],point:null,x:null,y:null}),ce?rt=Kt:Dt=Kt,Qt?_t=ee:Nt=ee,m(Z,J,At,wt,rt,_t,Dt,Nt)}var x,S,A,E,M,D,O,k,G,N=It(h),R=It(d);if(i!=null)D=i,O=r,k=a,G=s;else if(k=G=-(D=O=1/0),S=[],A=[],M=g.length,f)for(E=0;E<M;++E)(x=g[E]).x<D&&(D=x.x),x.y<O&&(O=x.y),x.x>k&&(k=x.x),x.y>G&&(G=x.y),S.push(x.x),A.push(x.y);else for(E=0;E<M;++E){var C=+N(x=g[E],E),z=+R(x,E);C<D&&(D=C),z<O&&(O=z),C>k&&(k=C),z>G&&(G=z),S.push(C),A.push(z)}var et=k-D,it=G-O;et>it?G=O+et:k=D+it;var U={leaf:!0,nodes:[],point:null,x:null,y:null};if(U.add=function(Z){m(U,Z,+N(Z,++E),+R(Z,E),D,O,k,G)},U.visit=function(Z){Un(Z,U,D,O,k,G)},U.find=function(Z){return(function(J,At,wt,rt,_t,Dt,Nt){var Kt,ee=1/0;return(function ce(Qt,se,ue,_e,ve){if(!(se>Dt||ue>Nt||_e<rt||ve<_t)){if(ke=Qt.point){var ke,Ge=At-Qt.x,Re=wt-Qt.y,Se=Ge*Ge+Re*Re;if(Se<ee){var de=Math.sqrt(ee=Se);rt=At-de,_t=wt-de,Dt=At+de,Nt=wt+de,Kt=ke}}for(var Ce=Qt.nodes,Pe=.5*(se+_e),De=.5*(ue+ve),Ie=(wt>=De)<<1|At>=Pe,Fe=Ie+4;Ie<Fe;++Ie)if(Qt=Ce[3&Ie])switch(3&Ie){case 0:ce(Qt,se,ue,Pe,De);break;case 1:ce(Qt,Pe,ue,_e,De);break;case 2:ce(Qt,se,De,Pe,ve);break;case 3:ce(Qt,Pe,De,_e,ve)}}})(J,rt,_t,Dt,Nt),Kt})(U,Z[0],Z[1],D,O,k,G)},E=-1,i==null){for(;++E<M;)m(U,g[E],S[E],A[E],D,O,k,G);--E}else g.forEach(U.add);return S=A=g=x=null,U}var f,h=Pn,d=Hn;return(f=arguments.length)?(h=Ls,d=ks,f===3&&(s=r,a=i,r=i=0),u(t)):(u.x=function(g){return arguments.length?(h=g,u):h},u.y=function(g){return arguments.length?(d=g,u):d},u.extent=function(g){return arguments.length?(g==null?i=r=a=s=null:(i=+g[0][0],r=+g[0][1],a=+g[1][0],s=+g[1][1]),u):i==null?null:[[i,r],[a,s]]},u.size=function(g){return arguments.length?(g==null?i=r=a=s=null:(i=r=0,a=+g[0],s=+g[1]),u):i==null?null:[a-i,s-r]},u)},b.interpolateRgb=lr,b.interpolateObject=Ia,b.interpolateNumber=en,b.interpolateString=Ra;var Br=/[-+]?(?:\d+\.?\d*|\.?\d+)(?:[eE][-+]?\d+)?/g,Ur=new RegExp(Br.source,"g");b.interpolate=vn,b.interpolators=[function(t,i){var r=typeof i;return(r==="string"?Pi.has(i.toLowerCase())||/^(#|rgb\(|hsl\()/i.test(i)?lr:Ra:i instanceof re?lr:Array.isArray(i)?di:r==="object"&&isNaN(i)?Ia:en)(t,i)}],b.interpolateArray=di;var qo=function(){return K},Pu=b.map({linear:qo,poly:function(t){return function(i){return Math.pow(i,t)}},quad:function(){return Ds},cubic:function(){return Os},sin:function(){return Vs},exp:function(){return Gs},circle:function(){return Is},elastic:function(t,i){var r;return arguments.length<2&&(i=.45),arguments.length?r=i/Xe*Math.asin(1/t):(t=1,r=i/4),function(a){return 1+t*Math.pow(2,-10*a)*Math.sin((a-r)*Xe/i)}},back:function(t){return t||(t=1.70158),function(i){return i*i*((t+1)*i-t)}},bounce:function(){return Rs}}),Mu=b.map({in:K,out:ja,"in-out":Na,"out-in":function(t){return Na(ja(t))}});b.ease=function(t){var i=t.indexOf("-"),r=i>=0?t.slice(0,i):t,a=i>=0?t.slice(i+1):"in";return r=Pu.get(r)||qo,a=Mu.get(a)||K,(function(s){return function(u){return u<=0?0:u>=1?1:s(u)}})(a(r.apply(null,To.call(arguments,1))))},b.interpolateHcl=function(t,i){t=b.hcl(t),i=b.hcl(i);var r=t.h,a=t.c,s=t.l,u=i.h-r,f=i.c-a,h=i.l-s;return isNaN(f)&&(f=0,a=isNaN(a)?i.c:a),isNaN(u)?(u=0,r=isNaN(r)?i.h:r):u>180?u-=360:u<-180&&(u+=360),function(d){return Ft(r+u*d,a+f*d,s+h*d)+""}},b.interpolateHsl=function(t,i){t=b.hsl(t),i=b.hsl(i);var r=t.h,a=t.s,s=t.l,u=i.h-r,f=i.s-a,h=i.l-s;return isNaN(f)&&(f=0,a=isNaN(a)?i.s:a),isNaN(u)?(u=0,r=isNaN(r)?i.h:r):u>180?u-=360:u<-180&&(u+=360),function(d){return oe(r+u*d,a+f*d,s+h*d)+""}},b.interpolateLab=function(t,i){t=b.lab(t),i=b.lab(i);var r=t.l,a=t.a,s=t.b,u=i.l-r,f=i.a-a,h=i.b-s;return function(d){return ct(r+u*d,a+f*d,s+h*d)+""}},b.interpolateRound=Fa,b.transform=function(t){var i=je.createElementNS(b.ns.prefix.svg,"g");return(b.transform=function(r){if(r!=null){i.setAttribute("transform",r);var a=i.transform.baseVal.consolidate()}return new za(a?a.matrix:Eu)})(t)},za.prototype.toString=function(){return"translate("+this.translate+")rotate("+this.rotate+")skewX("+this.skew+")scale("+this.scale+")"};var Eu={a:1,b:0,c:0,d:1,e:0,f:0};b.interpolateTransform=Xa,b.layout={},b.layout.bundle=function(){return function(t){for(var i=[],r=-1,a=t.length;++r<a;)i.push(js(t[r]));return i}},b.layout.chord=function(){function t(){var v,x,S,A,E,M={},D=[],O=b.range(u),k=[];for(r=[],a=[],v=0,A=-1;++A<u;){for(x=0,E=-1;++E<u;)x+=s[A][E];D.push(x),k.push(b.range(u)),v+=x}for(f&&O.sort(function(U,Z){return f(D[U],D[Z])}),h&&k.forEach(function(U,Z){U.sort(function(J,At){return h(s[Z][J],s[Z][At])})}),v=(Xe-m*u)/v,x=0,A=-1;++A<u;){for(S=x,E=-1;++E<u;){var G=O[A],N=k[G][E],R=s[G][N],C=x,z=x+=R*v;M[G+"-"+N]={index:G,subindex:N,startAngle:C,endAngle:z,value:R}}a[G]={index:G,startAngle:S,endAngle:x,value:D[G]},x+=m}for(A=-1;++A<u;)for(E=A-1;++E<u;){var et=M[A+"-"+E],it=M[E+"-"+A];(et.value||it.value)&&r.push(et.value<it.value?{source:it,target:et}:{source:et,target:it})}d&&i()}function i(){r.sort(function(v,x){return d((v.source.value+v.target.value)/2,(x.source.value+x.target.value)/2)})}var r,a,s,u,f,h,d,g={},m=0;return g.matrix=function(v){return arguments.length?(u=(s=v)&&s.length,r=a=null,g):s},g.padding=function(v){return arguments.length?(m=v,r=a=null,g):m},g.sortGroups=function(v){return arguments.length?(f=v,r=a=null,g):f},g.sortSubgroups=function(v){return arguments.length?(h=v,r=null,g):h},g.sortChords=function(v){return arguments.length?(d=v,r&&i(),g):d},g.chords=function(){return r||t(),r},g.groups=function(){return a||t(),a},g},b.layout.force=function(){function t(G){return function(N,R,C,z){if(N.point!==G){var et=N.cx-G.x,it=N.cy-G.y,U=z-R,Z=et*et+it*it;if(U*U/D<Z){if(Z<E){var J=N.charge/Z;G.px-=et*J,G.py-=it*J}return!0}N.point&&Z&&Z<E&&(J=N.pointCharge/Z,G.px-=et*J,G.py-=it*J)}return!N.charge}}function i(G){G.px=b.event.x,G.py=b.event.y,d.resume()}var r,a,s,u,f,h,d={},g=b.dispatch("start","tick","end"),m=[1,1],v=.9,x=Lu,S=ku,A=-30,E=Du,M=.1,D=.64,O=[],k=[];return d.tick=function(){if((s*=.99)<.005)return r=null,g.end({type:"end",alpha:s=0}),!0;var G,N,R,C,z,et,it,U,Z,J=O.length,At=k.length;for(N=0;N<At;++N)C=(R=k[N]).source,(et=(U=(z=R.target).x-C.x)*U+(Z=z.y-C.y)*Z)&&(U*=et=s*f[N]*((et=Math.sqrt(et))-u[N])/et,Z*=et,z.x-=U*(it=C.weight+z.weight?C.weight/(C.weight+z.weight):.5),z.y-=Z*it,C.x+=U*(it=1-it),C.y+=Z*it);if((it=s*M)&&(U=m[0]/2,Z=m[1]/2,N=-1,it))for(;++N<J;)(R=O[N]).x+=(U-R.x)*it,R.y+=(Z-R.y)*it;if(A)for(Ua(G=b.geom.quadtree(O),s,h),N=-1;++N<J;)(R=O[N]).fixed||G.visit(t(R));for(N=-1;++N<J;)(R=O[N]).fixed?(R.x=R.px,R.y=R.py):(R.x-=(R.px-(R.px=R.x))*v,R.y-=(R.py-(R.py=R.y))*v);g.tick({type:"tick",alpha:s})},d.nodes=function(G){return arguments.length?(O=G,d):O},d.links=function(G){return arguments.length?(k=G,d):k},d.size=function(G){return arguments.length?(m=G,d):m},d.linkDistance=function(G){return arguments.length?(x=typeof G=="function"?G:+G,d):x},d.distance=d.linkDistance,d.linkStrength=function(G){return arguments.length?(S=typeof G=="function"?G:+G,d):S},d.friction=function(G){return arguments.length?(v=+G,d):v},d.charge=function(G){return arguments.length?(A=typeof G=="function"?G:+G,d):A},d.chargeDistance=function(G){return arguments.length?(E=G*G,d):Math.sqrt(E)},d.gravity=function(G){return arguments.length?(M=+G,d):M},d.theta=function(G){return arguments.length?(D=G*G,d):Math.sqrt(D)},d.alpha=function(G){return arguments.length?(G=+G,s?G>0?s=G:(r.c=null,r.t=NaN,r=null,g.end({type:"end",alpha:s=0})):G>0&&(g.start({type:"start",alpha:s=G}),r=Te(d.tick)),d):s},d.start=function(){function G(Z,J){if(!R){for(R=new Array(z),_t=0;_t<z;++_t)R[_t]=[];for(_t=0;_t<et;++_t){var At=k[_t];R[At.source.index].push(At.target),R[At.target.index].push(At.source)}}for(var wt,rt=R[N],_t=-1,Dt=rt.length;++_t<Dt;)if(!isNaN(wt=rt[_t][Z]))return wt;return Math.random()*J}var N,R,C,z=O.length,et=k.length,it=m[0],U=m[1];for(N=0;N<z;++N)(C=O[N]).index=N,C.weight=0;for(N=0;N<et;++N)typeof(C=k[N]).source=="number"&&(C.source=O[C.source]),typeof C.target=="number"&&(C.target=O[C.target]),++C.source.weight,++C.target.weight;for(N=0;N<z;++N)C=O[N],isNaN(C.x)&&(C.x=G("x",it)),isNaN(C.y)&&(C.y=G("y",U)),isNaN(C.px)&&(C.px=C.x),isNaN(C.py)&&(C.py=C.y);if(u=[],typeof x=="function")for(N=0;N<et;++N)u[N]=+x.call(this,k[N],N);else for(N=0;N<et;++N)u[N]=x;if(f=[],typeof S=="function")for(N=0;N<et;++N)f[N]=+S.call(this,k[N],N);else for(N=0;N<et;++N)f[N]=S;if(h=[],typeof A=="function")for(N=0;N<z;++N)h[N]=+A.call(this,O[N],N);else for(N=0;N<z;++N)h[N]=A;return d.resume()},d.resume=function(){return d.alpha(.1)},d.stop=function(){return d.alpha(0)},d.drag=function(){if(a||(a=b.behavior.drag().origin(K).on("dragstart.force",Ns).on("drag.force",i).on("dragend.force",Fs)),!arguments.length)return a;this.on("mouseover.force",zs).on("mouseout.force",Hs).call(a)},b.rebind(d,g,"on")};var Lu=20,ku=1,Du=1/0;b.layout.hierarchy=function(){function t(s){var u,f=[s],h=[];for(s.depth=0;(u=f.pop())!=null;)if(h.push(u),(g=r.call(t,u,u.depth))&&(d=g.length)){for(var d,g,m;--d>=0;)f.push(m=g[d]),m.parent=u,m.depth=u.depth+1;a&&(u.value=0),u.children=g}else a&&(u.value=+a.call(t,u,u.depth)||0),delete u.children;return Ke(s,function(v){var x,S;i&&(x=v.children)&&x.sort(i),a&&(S=v.parent)&&(S.value+=v.value)}),h}var i=Bs,r=Ys,a=Xs;return t.sort=function(s){return arguments.length?(i=s,t):i},t.children=function(s){return arguments.length?(r=s,t):r},t.value=function(s){return arguments.length?(a=s,t):a},t.revalue=function(s){return a&&(Wn(s,function(u){u.children&&(u.value=0)}),Ke(s,function(u){var f;u.children||(u.value=+a.call(t,u,u.depth)||0),(f=u.parent)&&(f.value+=u.value)})),s},t},b.layout.partition=function(){function t(u,f,h,d){var g=u.children;if(u.x=f,u.y=u.depth*d,u.dx=h,u.dy=d,g&&(m=g.length)){var m,v,x,S=-1;for(h=u.value?h/u.value:0;++S<m;)t(v=g[S],f,x=v.value*h,d),f+=x}}function i(u){var f=u.children,h=0;if(f&&(d=f.length))for(var d,g=-1;++g<d;)h=Math.max(h,i(f[g]));return 1+h}function r(u,f){var h=a.call(this,u,f);return t(h[0],0,s[0],s[1]/i(h[0])),h}var a=b.layout.hierarchy(),s=[1,1];return r.size=function(u){return arguments.length?(s=u,r):s},qn(r,a)},b.layout.pie=function(){function t(f){var h,d=f.length,g=f.map(function(O,k){return+i.call(t,O,k)}),m=+(typeof a=="function"?a.apply(this,arguments):a),v=(typeof s=="function"?s.apply(this,arguments):s)-m,x=Math.min(Math.abs(v)/d,+(typeof u=="function"?u.apply(this,arguments):u)),S=x*(v<0?-1:1),A=b.sum(g),E=A?(v-d*S)/A:0,M=b.range(d),D=[];return r!=null&&M.sort(r===Wo?function(O,k){return g[k]-g[O]}:function(O,k){return r(f[O],f[k])}),M.forEach(function(O){D[O]={data:f[O],value:h=g[O],startAngle:m,endAngle:m+=h*E+S,padAngle:x}}),D}var i=Number,r=Wo,a=0,s=Xe,u=0;return t.value=function(f){return arguments.length?(i=f,t):i},t.sort=function(f){return arguments.length?(r=f,t):r},t.startAngle=function(f){return arguments.length?(a=f,t):a},t.endAngle=function(f){return arguments.length?(s=f,t):s},t.padAngle=function(f){return arguments.length?(u=f,t):u},t};var Wo={};b.layout.stack=function(){function t(h,d){if(!(x=h.length))return h;var g=h.map(function(O,k){return i.call(t,O,k)}),m=g.map(function(O){return O.map(function(k,G){return[u.call(t,k,G),f.call(t,k,G)]})}),v=r.call(t,m,d);g=b.permute(g,v),m=b.permute(m,v);var x,S,A,E,M=a.call(t,m,d),D=g[0].length;for(A=0;A<D;++A)for(s.call(t,g[0][A],E=M[A],m[0][A][1]),S=1;S<x;++S)s.call(t,g[S][A],E+=m[S-1][A][1],m[S][A][1]);return h}var i=K,r=hr,a=dr,s=Qs,u=qs,f=Ws;return t.values=function(h){return arguments.length?(i=h,t):i},t.order=function(h){return arguments.length?(r=typeof h=="function"?h:Ou.get(h)||hr,t):r},t.offset=function(h){return arguments.length?(a=typeof h=="function"?h:Vu.get(h)||dr,t):a},t.x=function(h){return arguments.length?(u=h,t):u},t.y=function(h){return arguments.length?(f=h,t):f},t.out=function(h){return arguments.length?(s=h,t):s},t};var Ou=b.map({"inside-out":function(t){var i,r,a=t.length,s=t.map(Zs),u=t.map($s),f=b.range(a).sort(function(v,x){return s[v]-s[x]}),h=0,d=0,g=[],m=[];for(i=0;i<a;++i)r=f[i],h<d?(h+=u[r],g.push(r)):(d+=u[r],m.push(r));return m.reverse().concat(g)},reverse:function(t){return b.range(t.length).reverse()},default:hr}),Vu=b.map({silhouette:function(t){var i,r,a,s=t.length,u=t[0].length,f=[],h=0,d=[];for(r=0;r<u;++r){for(i=0,a=0;i<s;i++)a+=t[i][r][1];a>h&&(h=a),f.push(a)}for(r=0;r<u;++r)d[r]=(h-f[r])/2;return d},wiggle:function(t){var i,r,a,s,u,f,h,d,g,m=t.length,v=t[0],x=v.length,S=[];for(S[0]=d=g=0,r=1;r<x;++r){for(i=0,s=0;i<m;++i)s+=t[i][r][1];for(i=0,u=0,h=v[r][0]-v[r-1][0];i<m;++i){for(a=0,f=(t[i][r][1]-t[i][r-1][1])/(2*h);a<i;++a)f+=(t[a][r][1]-t[a][r-1][1])/h;u+=f*t[i][r][1]}S[r]=d-=s?u/s*h:0,d<g&&(g=d)}for(r=0;r<x;++r)S[r]-=g;return S},expand:function(t){var i,r,a,s=t.length,u=t[0].length,f=1/s,h=[];for(r=0;r<u;++r){for(i=0,a=0;i<s;i++)a+=t[i][r][1];if(a)for(i=0;i<s;i++)t[i][r][1]/=a;else for(i=0;i<s;i++)t[i][r][1]=f}for(r=0;r<u;++r)h[r]=0;return h},zero:dr});b.layout.histogram=function(){function t(u,f){for(var h,d,g=[],m=u.map(r,this),v=a.call(this,m,f),x=s.call(this,v,m,f),S=(f=-1,m.length),A=x.length-1,E=i?1:1/S;++f<A;)(h=g[f]=[]).dx=x[f+1]-(h.x=x[f]),h.y=0;if(A>0)for(f=-1;++f<S;)(d=m[f])>=v[0]&&d<=v[1]&&((h=g[b.bisect(x,d,1,A)-1]).y+=E,h.push(u[f]));return g}var i=!0,r=Number,a=tu,s=Js;return t.value=function(u){return arguments.length?(r=u,t):r},t.range=function(u){return arguments.length?(a=It(u),t):a},t.bins=function(u){return arguments.length?(s=typeof u=="number"?function(f){return qa(f,u)}:It(u),t):s},t.frequency=function(u){return arguments.length?(i=!!u,t):i},t},b.layout.pack=function(){function t(u,f){var h=r.call(this,u,f),d=h[0],g=s[0],m=s[1],v=i==null?Math.sqrt:typeof i=="function"?i:function(){return i};if(d.x=d.y=0,Ke(d,function(S){S.r=+v(S.value)}),Ke(d,Za),a){var x=a*(i?1:Math.max(2*d.r/g,2*d.r/m))/2;Ke(d,function(S){S.r+=x}),Ke(d,Za),Ke(d,function(S){S.r-=x})}return $a(d,g/2,m/2,i?1:1/Math.max(2*d.r/g,2*d.r/m)),h}var i,r=b.layout.hierarchy().sort(eu),a=0,s=[1,1];return t.size=function(u){return arguments.length?(s=u,t):s},t.radius=function(u){return arguments.length?(i=u==null||typeof u=="function"?u:+u,t):i},t.padding=function(u){return arguments.length?(a=+u,t):a},qn(t,r)},b.layout.tree=function(){function t(d,g){var m=s.call(this,d,g),v=m[0],x=(function(k){for(var G,N={A:null,children:[k]},R=[N];(G=R.pop())!=null;)for(var C,z=G.children,et=0,it=z.length;et<it;++et)R.push((z[et]=C={_:z[et],parent:G,children:(C=z[et].children)&&C.slice()||[],A:null,a:null,z:0,m:0,c:0,s:0,t:null,i:et}).a=C);return N.children[0]})(v);if(Ke(x,i),x.parent.m=-x.z,Wn(x,r),h)Wn(v,a);else{var S=v,A=v,E=v;Wn(v,function(k){k.x<S.x&&(S=k),k.x>A.x&&(A=k),k.depth>E.depth&&(E=k)});var M=u(S,A)/2-S.x,D=f[0]/(A.x+u(A,S)/2+M),O=f[1]/(E.depth||1);Wn(v,function(k){k.x=(k.x+M)*D,k.y=k.depth*O})}return m}function i(d){var g=d.children,m=d.parent.children,v=d.i?m[d.i-1]:null;if(g.length){(function(S){for(var A,E=0,M=0,D=S.children,O=D.length;--O>=0;)(A=D[O]).z+=E,A.m+=E,E+=A.s+(M+=A.c)})(d);var x=(g[0].z+g[g.length-1].z)/2;v?(d.z=v.z+u(d._,v._),d.m=d.z-x):d.z=x}else v&&(d.z=v.z+u(d._,v._));d.parent.A=(function(S,A,E){if(A){for(var M,D=S,O=S,k=A,G=D.parent.children[0],N=D.m,R=O.m,C=k.m,z=G.m;k=pr(k),D=gr(D),k&&D;)G=gr(G),(O=pr(O)).a=S,(M=k.z+C-D.z-N+u(k._,D._))>0&&((function(et,it,U){var Z=U/(it.i-et.i);it.c-=Z,it.s+=U,et.c+=Z,it.z+=U,it.m+=U})((function(et,it,U){return et.a.parent===it.parent?et.a:U})(k,S,E),S,M),N+=M,R+=M),C+=k.m,N+=D.m,z+=G.m,R+=O.m;k&&!pr(O)&&(O.t=k,O.m+=C-R),D&&!gr(G)&&(G.t=D,G.m+=N-z,E=S)}return E})(d,v,d.parent.A||m[0])}function r(d){d._.x=d.z+d.parent.m,d.m+=d.parent.m}function a(d){d.x*=f[0],d.y=d.depth*f[1]}var s=b.layout.hierarchy().sort(null).value(null),u=Ja,f=[1,1],h=null;return t.separation=function(d){return arguments.length?(u=d,t):u},t.size=function(d){return arguments.length?(h=(f=d)==null?a:null,t):h?null:f},t.nodeSize=function(d){return arguments.length?(h=(f=d)==null?null:a,t):h?f:null},qn(t,s)},b.layout.cluster=function(){function t(u,f){var h,d=i.call(this,u,f),g=d[0],m=0;Ke(g,function(E){var M=E.children;M&&M.length?(E.x=(function(D){return D.reduce(function(O,k){return O+k.x},0)/D.length})(M),E.y=(function(D){return 1+b.max(D,function(O){return O.y})})(M)):(E.x=h?m+=r(E,h):0,E.y=0,h=E)});var v=to(g),x=eo(g),S=v.x-r(v,x)/2,A=x.x+r(x,v)/2;return Ke(g,s?function(E){E.x=(E.x-g.x)*a[0],E.y=(g.y-E.y)*a[1]}:function(E){E.x=(E.x-S)/(A-S)*a[0],E.y=(1-(g.y?E.y/g.y:1))*a[1]}),d}var i=b.layout.hierarchy().sort(null).value(null),r=Ja,a=[1,1],s=!1;return t.separation=function(u){return arguments.length?(r=u,t):r},t.size=function(u){return arguments.length?(s=(a=u)==null,t):s?null:a},t.nodeSize=function(u){return arguments.length?(s=(a=u)!=null,t):s?a:null},qn(t,i)},b.layout.treemap=function(){function t(A,E){for(var M,D,O=-1,k=A.length;++O<k;)D=(M=A[O]).value*(E<0?0:E),M.area=isNaN(D)||D<=0?0:D}function i(A){var E=A.children;if(E&&E.length){var M,D,O,k=m(A),G=[],N=E.slice(),R=1/0,C=x==="slice"?k.dx:x==="dice"?k.dy:x==="slice-dice"?1&A.depth?k.dy:k.dx:Math.min(k.dx,k.dy);for(t(N,k.dx*k.dy/A.value),G.area=0;(O=N.length)>0;)G.push(M=N[O-1]),G.area+=M.area,x!=="squarify"||(D=(function(z,et){for(var it,U=z.area,Z=0,J=1/0,At=-1,wt=z.length;++At<wt;)(it=z[At].area)&&(it<J&&(J=it),it>Z&&(Z=it));return U*=U,et*=et,U?Math.max(et*Z*S/U,U/(et*J*S)):1/0})(G,C))<=R?(N.pop(),R=D):(G.area-=G.pop().area,a(G,C,k,!1),C=Math.min(k.dx,k.dy),G.length=G.area=0,R=1/0);G.length&&(a(G,C,k,!0),G.length=G.area=0),E.forEach(i)}}function r(A){var E=A.children;if(E&&E.length){var M,D=m(A),O=E.slice(),k=[];for(t(O,D.dx*D.dy/A.value),k.area=0;M=O.pop();)k.push(M),k.area+=M.area,M.z!=null&&(a(k,M.z?D.dx:D.dy,D,!O.length),k.length=k.area=0);E.forEach(r)}}function a(A,E,M,D){var O,k=-1,G=A.length,N=M.x,R=M.y,C=E?h(A.area/E):0;if(E==M.dx){for((D||C>M.dy)&&(C=M.dy);++k<G;)(O=A[k]).x=N,O.y=R,O.dy=C,N+=O.dx=Math.min(M.x+M.dx-N,C?h(O.area/C):0);O.z=!0,O.dx+=M.x+M.dx-N,M.y+=C,M.dy-=C}else{for((D||C>M.dx)&&(C=M.dx);++k<G;)(O=A[k]).x=N,O.y=R,O.dx=C,R+=O.dy=Math.min(M.y+M.dy-R,C?h(O.area/C):0);O.z=!1,O.dy+=M.y+M.dy-R,M.x+=C,M.dx-=C}}function s(A){var E=u||f(A),M=E[0];return M.x=M.y=0,M.value?(M.dx=d[0],M.dy=d[1]):M.dx=M.dy=0,u&&f.revalue(M),t([M],M.dx*M.dy/M.value),(u?r:i)(M),v&&(u=E),E}var u,f=b.layout.hierarchy(),h=Math.round,d=[1,1],g=null,m=mr,v=!1,x="squarify",S=.5*(1+Math.sqrt(5));return s.size=function(A){return arguments.length?(d=A,s):d},s.padding=function(A){function E(D){return no(D,A)}if(!arguments.length)return g;var M;return m=(g=A)==null?mr:(M=typeof A)=="function"?function(D){var O=A.call(s,D,D.depth);return O==null?mr(D):no(D,typeof O=="number"?[O,O,O,O]:O)}:(M==="number"&&(A=[A,A,A,A]),E),s},s.round=function(A){return arguments.length?(h=A?Math.round:Number,s):h!=Number},s.sticky=function(A){return arguments.length?(v=A,u=null,s):v},s.ratio=function(A){return arguments.length?(S=A,s):S},s.mode=function(A){return arguments.length?(x=A+"",s):x},qn(s,f)},b.random={normal:function(t,i){var r=arguments.length;return r<2&&(i=1),r<1&&(t=0),function(){var a,s,u;do u=(a=2*Math.random()-1)*a+(s=2*Math.random()-1)*s;while(!u||u>1);return t+i*a*Math.sqrt(-2*Math.log(u)/u)}},logNormal:function(){var t=b.random.normal.apply(b,arguments);return function(){return Math.exp(t())}},bates:function(t){var i=b.random.irwinHall(t);return function(){return i()/t}},irwinHall:function(t){return function(){for(var i=0,r=0;r<t;r++)i+=Math.random();return i}}},b.scale={};var Gu={floor:K,ceil:K};b.scale.linear=function(){return ro([0,1],[0,1],vn,!1)};var Iu={s:1,g:1,p:1,r:1,e:1};b.scale.log=function(){return oo(b.scale.linear().domain([0,1]),10,!0,[1,10])};var Qo=b.format(".0e"),Ru={floor:function(t){return-Math.ceil(-t)},ceil:function(t){return-Math.floor(-t)}};b.scale.pow=function(){return so(b.scale.linear(),1,[0,1])},b.scale.sqrt=function(){return b.scale.pow().exponent(.5)},b.scale.ordinal=function(){return uo([],{t:"range",a:[[]]})},b.scale.category10=function(){return b.scale.ordinal().range(ju)},b.scale.category20=function(){return b.scale.ordinal().range(Nu)},b.scale.category20b=function(){return b.scale.ordinal().range(Fu)},b.scale.category20c=function(){return b.scale.ordinal().range(zu)};var ju=[2062260,16744206,2924588,14034728,9725885,9197131,14907330,8355711,12369186,1556175].map(X),Nu=[2062260,11454440,16744206,16759672,2924588,10018698,14034728,16750742,9725885,12955861,9197131,12885140,14907330,16234194,8355711,13092807,12369186,14408589,1556175,10410725].map(X),Fu=[3750777,5395619,7040719,10264286,6519097,9216594,11915115,13556636,9202993,12426809,15186514,15190932,8666169,11356490,14049643,15177372,8077683,10834324,13528509,14589654].map(X),zu=[3244733,7057110,10406625,13032431,15095053,16616764,16625259,16634018,3253076,7652470,10607003,13101504,7695281,10394312,12369372,14342891,6513507,9868950,12434877,14277081].map(X);b.scale.quantile=function(){return co([],[])},b.scale.quantize=function(){return lo(0,1,[0,1])},b.scale.threshold=function(){return ho([.5],[0,1])},b.scale.identity=function(){return fo([0,1])},b.svg={},b.svg.arc=function(){function t(){var g=Math.max(0,+r.apply(this,arguments)),m=Math.max(0,+a.apply(this,arguments)),v=f.apply(this,arguments)-Me,x=h.apply(this,arguments)-Me,S=Math.abs(x-v),A=v>x?0:1;if(m<g&&(E=m,m=g,g=E),S>=Su)return i(m,A)+(g?i(g,1-A):"")+"Z";var E,M,D,O,k,G,N,R,C,z,et,it,U=0,Z=0,J=[];if((O=(+d.apply(this,arguments)||0)/2)&&(D=u===Yi?Math.sqrt(g*g+m*m):+u.apply(this,arguments),A||(Z*=-1),m&&(Z=Ot(D/m*Math.sin(O))),g&&(U=Ot(D/g*Math.sin(O)))),m){k=m*Math.cos(v+Z),G=m*Math.sin(v+Z),N=m*Math.cos(x-Z),R=m*Math.sin(x-Z);var At=Math.abs(x-v-2*Z)<=$t?0:1;if(Z&&vi(k,G,N,R)===A^At){var wt=(v+x)/2;k=m*Math.cos(wt),G=m*Math.sin(wt),N=R=null}}else k=G=0;if(g){C=g*Math.cos(x-U),z=g*Math.sin(x-U),et=g*Math.cos(v+U),it=g*Math.sin(v+U);var rt=Math.abs(v-x+2*U)<=$t?0:1;if(U&&vi(C,z,et,it)===1-A^rt){var _t=(v+x)/2;C=g*Math.cos(_t),z=g*Math.sin(_t),et=it=null}}else C=z=0;if(S>Mt&&(E=Math.min(Math.abs(m-g)/2,+s.apply(this,arguments)))>.001){M=g<m^A?0:1;var Dt=E,Nt=E;if(S<$t){var Kt=et==null?[C,z]:N==null?[k,G]:or([k,G],[et,it],[N,R],[C,z]),ee=k-Kt[0],ce=G-Kt[1],Qt=N-Kt[0],se=R-Kt[1],ue=1/Math.sin(Math.acos((ee*Qt+ce*se)/(Math.sqrt(ee*ee+ce*ce)*Math.sqrt(Qt*Qt+se*se)))/2),_e=Math.sqrt(Kt[0]*Kt[0]+Kt[1]*Kt[1]);Nt=Math.min(E,(g-_e)/(ue-1)),Dt=Math.min(E,(m-_e)/(ue+1))}if(N!=null){var ve=yi(et==null?[C,z]:[et,it],[k,G],m,Dt,A),ke=yi([N,R],[C,z],m,Dt,A);E===Dt?J.push("M",ve[0],"A",Dt,",",Dt," 0 0,",M," ",ve[1],"A",m,",",m," 0 ",1-A^vi(ve[1][0],ve[1][1],ke[1][0],ke[1][1]),",",A," ",ke[1],"A",Dt,",",Dt," 0 0,",M," ",ke[0]):J.push("M",ve[0],"A",Dt,",",Dt," 0 1,",M," ",ke[0])}else J.push("M",k,",",G);if(et!=null){var Ge=yi([k,G],[et,it],g,-Nt,A),Re=yi([C,z],N==null?[k,G]:[N,R],g,-Nt,A);E===Nt?J.push("L",Re[0],"A",Nt,",",Nt," 0 0,",M," ",Re[1],"A",g,",",g," 0 ",A^vi(Re[1][0],Re[1][1],Ge[1][0],Ge[1][1]),",",1-A," ",Ge[1],"A",Nt,",",Nt," 0 0,",M," ",Ge[0]):J.push("L",Re[0],"A",Nt,",",Nt," 0 0,",M," ",Ge[0])}else J.push("L",C,",",z)}else J.push("M",k,",",G),N!=null&&J.push("A",m,",",m," 0 ",At,",",A," ",N,",",R),J.push("L",C,",",z),et!=null&&J.push("A",g,",",g," 0 ",rt,",",1-A," ",et,",",it);return J.push("Z"),J.join("")}function i(g,m){return"M0,"+g+"A"+g+","+g+" 0 1,"+m+" 0,"+-g+"A"+g+","+g+" 0 1,"+m+" 0,"+g}var r=au,a=ou,s=ru,u=Yi,f=go,h=po,d=su;return t.innerRadius=function(g){return arguments.length?(r=It(g),t):r},t.outerRadius=function(g){return arguments.length?(a=It(g),t):a},t.cornerRadius=function(g){return arguments.length?(s=It(g),t):s},t.padRadius=function(g){return arguments.length?(u=g==Yi?Yi:It(g),t):u},t.startAngle=function(g){return arguments.length?(f=It(g),t):f},t.endAngle=function(g){return arguments.length?(h=It(g),t):h},t.padAngle=function(g){return arguments.length?(d=It(g),t):d},t.centroid=function(){var g=(+r.apply(this,arguments)+ +a.apply(this,arguments))/2,m=(+f.apply(this,arguments)+ +h.apply(this,arguments))/2-Me;return[Math.cos(m)*g,Math.sin(m)*g]},t};var Yi="auto";b.svg.line=function(){return mo(K)};var qr=b.map({linear:Qe,"linear-closed":vo,step:function(t){for(var i=0,r=t.length,a=t[0],s=[a[0],",",a[1]];++i<r;)s.push("H",(a[0]+(a=t[i])[0])/2,"V",a[1]);return r>1&&s.push("H",a[0]),s.join("")},"step-before":_r,"step-after":wr,basis:yo,"basis-open":function(t){if(t.length<4)return Qe(t);for(var i,r=[],a=-1,s=t.length,u=[0],f=[0];++a<3;)i=t[a],u.push(i[0]),f.push(i[1]);for(r.push(Ue(fn,u)+","+Ue(fn,f)),--a;++a<s;)i=t[a],u.shift(),u.push(i[0]),f.shift(),f.push(i[1]),Sr(r,u,f);return r.join("")},"basis-closed":function(t){for(var i,r,a=-1,s=t.length,u=s+4,f=[],h=[];++a<4;)r=t[a%s],f.push(r[0]),h.push(r[1]);for(i=[Ue(fn,f),",",Ue(fn,h)],--a;++a<u;)r=t[a%s],f.shift(),f.push(r[0]),h.shift(),h.push(r[1]),Sr(i,f,h);return i.join("")},bundle:function(t,i){var r=t.length-1;if(r)for(var a,s,u=t[0][0],f=t[0][1],h=t[r][0]-u,d=t[r][1]-f,g=-1;++g<=r;)s=g/r,(a=t[g])[0]=i*a[0]+(1-i)*(u+s*h),a[1]=i*a[1]+(1-i)*(f+s*d);return yo(t)},cardinal:function(t,i){return t.length<3?Qe(t):t[0]+xi(t,br(t,i))},"cardinal-open":function(t,i){return t.length<4?Qe(t):t[1]+xi(t.slice(1,-1),br(t,i))},"cardinal-closed":function(t,i){return t.length<3?vo(t):t[0]+xi((t.push(t[0]),t),br([t[t.length-2]].concat(t,[t[1]]),i))},monotone:function(t){return t.length<3?Qe(t):t[0]+xi(t,uu(t))}});qr.forEach(function(t,i){i.key=t,i.closed=/-closed$/.test(t)});var Zo=[0,2/3,1/3,0],$o=[0,1/3,2/3,0],fn=[0,1/6,2/3,1/6];b.svg.line.radial=function(){var t=mo(xo);return t.radius=t.x,delete t.x,t.angle=t.y,delete t.y,t},_r.reverse=wr,wr.reverse=_r,b.svg.area=function(){return _o(K)},b.svg.area.radial=function(){var t=_o(xo);return t.radius=t.x,delete t.x,t.innerRadius=t.x0,delete t.x0,t.outerRadius=t.x1,delete t.x1,t.angle=t.y,delete t.y,t.startAngle=t.y0,delete t.y0,t.endAngle=t.y1,delete t.y1,t},b.svg.chord=function(){function t(g,m){var v=i(this,s,g,m),x=i(this,u,g,m);return"M"+v.p0+r(v.r,v.p1,v.a1-v.a0)+((function(S,A){return S.a0==A.a0&&S.a1==A.a1})(v,x)?a(v.r,v.p1,v.r,v.p0):a(v.r,v.p1,x.r,x.p0)+r(x.r,x.p1,x.a1-x.a0)+a(x.r,x.p1,v.r,v.p0))+"Z"}function i(g,m,v,x){var S=m.call(g,v,x),A=f.call(g,S,x),E=h.call(g,S,x)-Me,M=d.call(g,S,x)-Me;return{r:A,a0:E,a1:M,p0:[A*Math.cos(E),A*Math.sin(E)],p1:[A*Math.cos(M),A*Math.sin(M)]}}function r(g,m,v){return"A"+g+","+g+" 0 "+ +(v>$t)+",1 "+m}function a(g,m,v,x){return"Q 0,0 "+x}var s=nr,u=ir,f=cu,h=go,d=po;return t.radius=function(g){return arguments.length?(f=It(g),t):f},t.source=function(g){return arguments.length?(s=It(g),t):s},t.target=function(g){return arguments.length?(u=It(g),t):u},t.startAngle=function(g){return arguments.length?(h=It(g),t):h},t.endAngle=function(g){return arguments.length?(d=It(g),t):d},t},b.svg.diagonal=function(){function t(s,u){var f=i.call(this,s,u),h=r.call(this,s,u),d=(f.y+h.y)/2,g=[f,{x:f.x,y:d},{x:h.x,y:d},h];return"M"+(g=g.map(a))[0]+"C"+g[1]+" "+g[2]+" "+g[3]}var i=nr,r=ir,a=wo;return t.source=function(s){return arguments.length?(i=It(s),t):i},t.target=function(s){return arguments.length?(r=It(s),t):r},t.projection=function(s){return arguments.length?(a=s,t):a},t},b.svg.diagonal.radial=function(){var t=b.svg.diagonal(),i=wo,r=t.projection;return t.projection=function(a){return arguments.length?r((function(s){return function(){var u=s.apply(this,arguments),f=u[0],h=u[1]-Me;return[f*Math.cos(h),f*Math.sin(h)]}})(i=a)):i},t},b.svg.symbol=function(){function t(a,s){return(Ko.get(i.call(this,a,s))||bo)(r.call(this,a,s))}var i=hu,r=lu;return t.type=function(a){return arguments.length?(i=It(a),t):i},t.size=function(a){return arguments.length?(r=It(a),t):r},t};var Ko=b.map({circle:bo,cross:function(t){var i=Math.sqrt(t/5)/2;return"M"+-3*i+","+-i+"H"+-i+"V"+-3*i+"H"+i+"V"+-i+"H"+3*i+"V"+i+"H"+i+"V"+3*i+"H"+-i+"V"+i+"H"+-3*i+"Z"},diamond:function(t){var i=Math.sqrt(t/(2*Jo)),r=i*Jo;return"M0,"+-i+"L"+r+",0 0,"+i+" "+-r+",0Z"},square:function(t){var i=Math.sqrt(t)/2;return"M"+-i+","+-i+"L"+i+","+-i+" "+i+","+i+" "+-i+","+i+"Z"},"triangle-down":function(t){var i=Math.sqrt(t/Xi),r=i*Xi/2;return"M0,"+r+"L"+i+","+-r+" "+-i+","+-r+"Z"},"triangle-up":function(t){var i=Math.sqrt(t/Xi),r=i*Xi/2;return"M0,"+-r+"L"+i+","+r+" "+-i+","+r+"Z"}});b.svg.symbolTypes=Ko.keys();var Xi=Math.sqrt(3),Jo=Math.tan(30*Bt);ge.transition=function(t){for(var i,r,a=Tn||++ts,s=Cr(t),u=[],f=Bi||{time:Date.now(),ease:function(x){if(x<=0)return 0;if(x>=1)return 1;var S=x*x,A=S*x;return 4*(x<.5?A:3*(x-S)+A-.75)},delay:0,duration:250},h=-1,d=this.length;++h<d;){u.push(i=[]);for(var g=this[h],m=-1,v=g.length;++m<v;)(r=g[m])&&_i(r,m,s,a,f),i.push(r)}return Qn(u,s,a)},ge.interrupt=function(t){return this.each(t==null?Ui:So(Cr(t)))};var Tn,Bi,Ui=So(Cr()),Oe=[],ts=0;Oe.call=ge.call,Oe.empty=ge.empty,Oe.node=ge.node,Oe.size=ge.size,b.transition=function(t,i){return t&&t.transition?Tn?t.transition(i):t:b.selection().transition(t)},b.transition.prototype=Oe,Oe.select=function(t){var i,r,a,s=this.id,u=this.namespace,f=[];t=bt(t);for(var h=-1,d=this.length;++h<d;){f.push(i=[]);for(var g=this[h],m=-1,v=g.length;++m<v;)(a=g[m])&&(r=t.call(a,a.__data__,m,h))?("__data__"in a&&(r.__data__=a.__data__),_i(r,m,u,s,a[u][s]),i.push(r)):i.push(null)}return Qn(f,u,s)},Oe.selectAll=function(t){var i,r,a,s,u,f=this.id,h=this.namespace,d=[];t=w(t);for(var g=-1,m=this.length;++g<m;)for(var v=this[g],x=-1,S=v.length;++x<S;)if(a=v[x]){u=a[h][f],r=t.call(a,a.__data__,x,g),d.push(i=[]);for(var A=-1,E=r.length;++A<E;)(s=r[A])&&_i(s,A,h,f,u),i.push(s)}return Qn(d,h,f)},Oe.filter=function(t){var i,r,a=[];typeof t!="function"&&(t=F(t));for(var s=0,u=this.length;s<u;s++){a.push(i=[]);for(var f,h=0,d=(f=this[s]).length;h<d;h++)(r=f[h])&&t.call(r,r.__data__,h,s)&&i.push(r)}return Qn(a,this.namespace,this.id)},Oe.tween=function(t,i){var r=this.id,a=this.namespace;return arguments.length<2?this.node()[a][r].tween.get(t):q(this,i==null?function(s){s[a][r].tween.remove(t)}:function(s){s[a][r].tween.set(t,i)})},Oe.attr=function(t,i){if(arguments.length<2){for(i in t)this.attr(i,t[i]);return this}var r=t=="transform"?Xa:vn,a=b.ns.qualify(t);return Ar(this,"attr."+t,i,a.local?function(s){return s==null?function(){this.removeAttributeNS(a.space,a.local)}:(s+="",function(){var u,f=this.getAttributeNS(a.space,a.local);return f!==s&&(u=r(f,s),function(h){this.setAttributeNS(a.space,a.local,u(h))})})}:function(s){return s==null?function(){this.removeAttribute(a)}:(s+="",function(){var u,f=this.getAttribute(a);return f!==s&&(u=r(f,s),function(h){this.setAttribute(a,u(h))})})})},Oe.attrTween=function(t,i){var r=b.ns.qualify(t);return this.tween("attr."+t,r.local?function(a,s){var u=i.call(this,a,s,this.getAttributeNS(r.space,r.local));return u&&function(f){this.setAttributeNS(r.space,r.local,u(f))}}:function(a,s){var u=i.call(this,a,s,this.getAttribute(r));return u&&function(f){this.setAttribute(r,u(f))}})},Oe.style=function(t,i,r){var a=arguments.length;if(a<3){if(typeof t!="string"){a<2&&(i="");for(r in t)this.style(r,t[r],i);return this}r=""}return Ar(this,"style."+t,i,function(s){return s==null?function(){this.style.removeProperty(t)}:(s+="",function(){var u,f=dt(this).getComputedStyle(this,null).getPropertyValue(t);return f!==s&&(u=vn(f,s),function(h){this.style.setProperty(t,u(h),r)})})})},Oe.styleTween=function(t,i,r){return arguments.length<3&&(r=""),this.tween("style."+t,function(a,s){var u=i.call(this,a,s,dt(this).getComputedStyle(this,null).getPropertyValue(t));return u&&function(f){this.style.setProperty(t,u(f),r)}})},Oe.text=function(t){return Ar(this,"text",t,du)},Oe.remove=function(){var t=this.namespace;return this.each("end.transition",function(){var i;this[t].count<2&&(i=this.parentNode)&&i.removeChild(this)})},Oe.ease=function(t){var i=this.id,r=this.namespace;return arguments.length<1?this.node()[r][i].ease:(typeof t!="function"&&(t=b.ease.apply(b,arguments)),q(this,function(a){a[r][i].ease=t}))},Oe.delay=function(t){var i=this.id,r=this.namespace;return arguments.length<1?this.node()[r][i].delay:q(this,typeof t=="function"?function(a,s,u){a[r][i].delay=+t.call(a,a.__data__,s,u)}:(t=+t,function(a){a[r][i].delay=t}))},Oe.duration=function(t){var i=this.id,r=this.namespace;return arguments.length<1?this.node()[r][i].duration:q(this,typeof t=="function"?function(a,s,u){a[r][i].duration=Math.max(1,t.call(a,a.__data__,s,u))}:(t=Math.max(1,t),function(a){a[r][i].duration=t}))},Oe.each=function(t,i){var r=this.id,a=this.namespace;if(arguments.length<2){var s=Bi,u=Tn;try{Tn=r,q(this,function(f,h,d){Bi=f[a][r],t.call(f,f.__data__,h,d)})}finally{Bi=s,Tn=u}}else q(this,function(f){var h=f[a][r];(h.event||(h.event=b.dispatch("start","end","interrupt"))).on(t,i)});return this},Oe.transition=function(){for(var t,i,r,a=this.id,s=++ts,u=this.namespace,f=[],h=0,d=this.length;h<d;h++){f.push(t=[]);for(var g,m=0,v=(g=this[h]).length;m<v;m++)(i=g[m])&&_i(i,m,u,s,{time:(r=i[u][a]).time,ease:r.ease,delay:r.delay+r.duration,duration:r.duration}),t.push(i)}return Qn(f,u,s)},b.svg.axis=function(){function t(g){g.each(function(){var m,v=b.select(this),x=this.__chart__||r,S=this.__chart__=r.copy(),A=d==null?S.ticks?S.ticks.apply(S,h):S.domain():d,E=i==null?S.tickFormat?S.tickFormat.apply(S,h):K:i,M=v.selectAll(".tick").data(A,S),D=M.enter().insert("g",".domain").attr("class","tick").style("opacity",Mt),O=b.transition(M.exit()).style("opacity",Mt).remove(),k=b.transition(M.order()).style("opacity",1),G=Math.max(s,0)+f,N=fi(S),R=v.selectAll(".domain").data([0]),C=(R.enter().append("path").attr("class","domain"),b.transition(R));D.append("line"),D.append("text");var z,et,it,U,Z=D.select("line"),J=k.select("line"),At=M.select("text").text(E),wt=D.select("text"),rt=k.select("text"),_t=a==="top"||a==="left"?-1:1;if(a==="bottom"||a==="top"?(m=fu,z="x",it="y",et="x2",U="y2",At.attr("dy",_t<0?"0em":".71em").style("text-anchor","middle"),C.attr("d","M"+N[0]+","+_t*u+"V0H"+N[1]+"V"+_t*u)):(m=gu,z="y",it="x",et="y2",U="x2",At.attr("dy",".32em").style("text-anchor",_t<0?"end":"start"),C.attr("d","M"+_t*u+","+N[0]+"H0V"+N[1]+"H"+_t*u)),Z.attr(U,_t*s),wt.attr(it,_t*G),J.attr(et,0).attr(U,_t*s),rt.attr(z,0).attr(it,_t*G),S.rangeBand){var Dt=S,Nt=Dt.rangeBand()/2;x=S=function(Kt){return Dt(Kt)+Nt}}else x.rangeBand?x=S:O.call(m,S,x);D.call(m,x,S),k.call(m,S,S)})}var i,r=b.scale.linear(),a=es,s=6,u=6,f=3,h=[10],d=null;return t.scale=function(g){return arguments.length?(r=g,t):r},t.orient=function(g){return arguments.length?(a=g in Hu?g+"":es,t):a},t.ticks=function(){return arguments.length?(h=Je(arguments),t):h},t.tickValues=function(g){return arguments.length?(d=g,t):d},t.tickFormat=function(g){return arguments.length?(i=g,t):i},t.tickSize=function(g){var m=arguments.length;return m?(s=+g,u=+arguments[m-1],t):s},t.innerTickSize=function(g){return arguments.length?(s=+g,t):s},t.outerTickSize=function(g){return arguments.length?(u=+g,t):u},t.tickPadding=function(g){return arguments.length?(f=+g,t):f},t.tickSubdivide=function(){return arguments.length&&t},t};var es="bottom",Hu={top:1,right:1,bottom:1,left:1};b.svg.brush=function(){function t(E){E.each(function(){var M=b.select(this).style("pointer-events","all").style("-webkit-tap-highlight-color","rgba(0,0,0,0)").on("mousedown.brush",s).on("touchstart.brush",s),D=M.selectAll(".background").data([0]);D.enter().append("rect").attr("class","background").style("visibility","hidden").style("cursor","crosshair"),M.selectAll(".extent").data([0]).enter().append("rect").attr("class","extent").style("cursor","move");var O=M.selectAll(".resize").data(A,K);O.exit().remove(),O.enter().append("g").attr("class",function(R){return"resize "+R}).style("cursor",function(R){return Yu[R]}).append("rect").attr("x",function(R){return/[ew]$/.test(R)?-3:null}).attr("y",function(R){return/^[ns]/.test(R)?-3:null}).attr("width",6).attr("height",6).style("visibility","hidden"),O.style("display",t.empty()?"none":null);var k,G=b.transition(M),N=b.transition(D);d&&(k=fi(d),N.attr("x",k[0]).attr("width",k[1]-k[0]),r(G)),g&&(k=fi(g),N.attr("y",k[0]).attr("height",k[1]-k[0]),a(G)),i(G)})}function i(E){E.selectAll(".resize").attr("transform",function(M){return"translate("+m[+/e$/.test(M)]+","+v[+/^s/.test(M)]+")"})}function r(E){E.select(".extent").attr("x",m[0]),E.selectAll(".extent,.n>rect,.s>rect").attr("width",m[1]-m[0])}function a(E){E.select(".extent").attr("y",v[0]),E.selectAll(".extent,.e>rect,.w>rect").attr("height",v[1]-v[0])}function s(){function E(){var _t=b.mouse(G),Dt=!1;k&&(_t[0]+=k[0],_t[1]+=k[1]),U||(b.event.altKey?(O||(O=[(m[0]+m[1])/2,(v[0]+v[1])/2]),J[0]=m[+(_t[0]<O[0])],J[1]=v[+(_t[1]<O[1])]):O=null),et&&M(_t,d,0)&&(r(C),Dt=!0),it&&M(_t,g,1)&&(a(C),Dt=!0),Dt&&(i(C),R({type:"brush",mode:U?"move":"resize"}))}function M(_t,Dt,Nt){var Kt,ee,ce=fi(Dt),Qt=ce[0],se=ce[1],ue=J[Nt],_e=Nt?v:m,ve=_e[1]-_e[0];if(U&&(Qt-=ue,se-=ve+ue),Kt=(Nt?S:x)?Math.max(Qt,Math.min(se,_t[Nt])):_t[Nt],U?ee=(Kt+=ue)+ve:(O&&(ue=Math.max(Qt,Math.min(se,2*O[Nt]-Kt))),ue<Kt?(ee=Kt,Kt=ue):ee=ue),_e[0]!=Kt||_e[1]!=ee)return Nt?f=null:u=null,_e[0]=Kt,_e[1]=ee,!0}function D(){E(),C.style("pointer-events","all").selectAll(".resize").style("display",t.empty()?"none":null),b.select("body").style("cursor",null),At.on("mousemove.brush",null).on("mouseup.brush",null).on("touchmove.brush",null).on("touchend.brush",null).on("keydown.brush",null).on("keyup.brush",null),Z(),R({type:"brushend"})}var O,k,G=this,N=b.select(b.event.target),R=h.of(G,arguments),C=b.select(G),z=N.datum(),et=!/^(n|s)$/.test(z)&&d,it=!/^(e|w)$/.test(z)&&g,U=N.classed("extent"),Z=Rt(G),J=b.mouse(G),At=b.select(dt(G)).on("keydown.brush",function(){b.event.keyCode==32&&(U||(O=null,J[0]-=m[1],J[1]-=v[1],U=2),Q())}).on("keyup.brush",function(){b.event.keyCode==32&&U==2&&(J[0]+=m[1],J[1]+=v[1],U=0,Q())});if(b.event.changedTouches?At.on("touchmove.brush",E).on("touchend.brush",D):At.on("mousemove.brush",E).on("mouseup.brush",D),C.interrupt().selectAll("*").interrupt(),U)J[0]=m[0]-J[0],J[1]=v[0]-J[1];else if(z){var wt=+/w$/.test(z),rt=+/^n/.test(z);k=[m[1-wt]-J[0],v[1-rt]-J[1]],J[0]=m[wt],J[1]=v[rt]}else b.event.altKey&&(O=J.slice());C.style("pointer-events","none").selectAll(".resize").style("display",null),b.select("body").style("cursor",N.style("cursor")),R({type:"brushstart"}),E()}var u,f,h=Xt(t,"brushstart","brush","brushend"),d=null,g=null,m=[0,0],v=[0,0],x=!0,S=!0,A=Wr[0];return t.event=function(E){E.each(function(){var M=h.of(this,arguments),D={x:m,y:v,i:u,j:f},O=this.__chart__||D;this.__chart__=D,Tn?b.select(this).transition().each("start.brush",function(){u=O.i,f=O.j,m=O.x,v=O.y,M({type:"brushstart"})}).tween("brush:brush",function(){var k=di(m,D.x),G=di(v,D.y);return u=f=null,function(N){m=D.x=k(N),v=D.y=G(N),M({type:"brush",mode:"resize"})}}).each("end.brush",function(){u=D.i,f=D.j,M({type:"brush",mode:"resize"}),M({type:"brushend"})}):(M({type:"brushstart"}),M({type:"brush",mode:"resize"}),M({type:"brushend"}))})},t.x=function(E){return arguments.length?(d=E,A=Wr[!d<<1|!g],t):d},t.y=function(E){return arguments.length?(g=E,A=Wr[!d<<1|!g],t):g},t.clamp=function(E){return arguments.length?(d&&g?(x=!!E[0],S=!!E[1]):d?x=!!E:g&&(S=!!E),t):d&&g?[x,S]:d?x:g?S:null},t.extent=function(E){var M,D,O,k,G;return arguments.length?(d&&(M=E[0],D=E[1],g&&(M=M[0],D=D[0]),u=[M,D],d.invert&&(M=d(M),D=d(D)),D<M&&(G=M,M=D,D=G),M==m[0]&&D==m[1]||(m=[M,D])),g&&(O=E[0],k=E[1],d&&(O=O[1],k=k[1]),f=[O,k],g.invert&&(O=g(O),k=g(k)),k<O&&(G=O,O=k,k=G),O==v[0]&&k==v[1]||(v=[O,k])),t):(d&&(u?(M=u[0],D=u[1]):(M=m[0],D=m[1],d.invert&&(M=d.invert(M),D=d.invert(D)),D<M&&(G=M,M=D,D=G))),g&&(f?(O=f[0],k=f[1]):(O=v[0],k=v[1],g.invert&&(O=g.invert(O),k=g.invert(k)),k<O&&(G=O,O=k,k=G))),d&&g?[[M,O],[D,k]]:d?[M,D]:g&&[O,k])},t.clear=function(){return t.empty()||(m=[0,0],v=[0,0],u=f=null),t},t.empty=function(){return!!d&&m[0]==m[1]||!!g&&v[0]==v[1]},b.rebind(t,h,"on")};var Yu={n:"ns-resize",e:"ew-resize",s:"ns-resize",w:"ew-resize",nw:"nwse-resize",ne:"nesw-resize",se:"nwse-resize",sw:"nesw-resize"},Wr=[["n","e","s","w","nw","ne","se","sw"],["e","w"],["n","s"],[]],Qr=Vt.format=Io.timeFormat,ns=Qr.utc,is=ns("%Y-%m-%dT%H:%M:%S.%LZ");Qr.iso=Date.prototype.toISOString&&+new Date("2000-01-01T00:00:00.000Z")?Pr:is,Pr.parse=function(t){var i=new Date(t);return isNaN(i)?null:i},Pr.toString=is.toString,Vt.second=Ye(function(t){return new Ne(1e3*Math.floor(t/1e3))},function(t,i){t.setTime(t.getTime()+1e3*Math.floor(i))},function(t){return t.getSeconds()}),Vt.seconds=Vt.second.range,Vt.seconds.utc=Vt.second.utc.range,Vt.minute=Ye(function(t){return new Ne(6e4*Math.floor(t/6e4))},function(t,i){t.setTime(t.getTime()+6e4*Math.floor(i))},function(t){return t.getMinutes()}),Vt.minutes=Vt.minute.range,Vt.minutes.utc=Vt.minute.utc.range,Vt.hour=Ye(function(t){var i=t.getTimezoneOffset()/60;return new Ne(36e5*(Math.floor(t/36e5-i)+i))},function(t,i){t.setTime(t.getTime()+36e5*Math.floor(i))},function(t){return t.getHours()}),Vt.hours=Vt.hour.range,Vt.hours.utc=Vt.hour.utc.range,Vt.month=Ye(function(t){return(t=Vt.day(t)).setDate(1),t},function(t,i){t.setMonth(t.getMonth()+i)},function(t){return t.getMonth()}),Vt.months=Vt.month.range,Vt.months.utc=Vt.month.utc.range;var qi=[1e3,5e3,15e3,3e4,6e4,3e5,9e5,18e5,36e5,108e5,216e5,432e5,864e5,1728e5,6048e5,2592e6,7776e6,31536e6],Zr=[[Vt.second,1],[Vt.second,5],[Vt.second,15],[Vt.second,30],[Vt.minute,1],[Vt.minute,5],[Vt.minute,15],[Vt.minute,30],[Vt.hour,1],[Vt.hour,3],[Vt.hour,6],[Vt.hour,12],[Vt.day,1],[Vt.day,2],[Vt.week,1],[Vt.month,1],[Vt.month,3],[Vt.year,1]],Xu=Qr.multi([[".%L",function(t){return t.getMilliseconds()}],[":%S",function(t){return t.getSeconds()}],["%I:%M",function(t){return t.getMinutes()}],["%I %p",function(t){return t.getHours()}],["%a %d",function(t){return t.getDay()&&t.getDate()!=1}],["%b %d",function(t){return t.getDate()!=1}],["%B",function(t){return t.getMonth()}],["%Y",Nn]]),Bu={range:function(t,i,r){return b.range(Math.ceil(t/r)*r,+i,r).map(yn)},floor:K,ceil:K};Zr.year=Vt.year,Vt.scale=function(){return Mr(b.scale.linear(),Zr,Xu)};var rs=Zr.map(function(t){return[t[0].utc,t[1]]}),Uu=ns.multi([[".%L",function(t){return t.getUTCMilliseconds()}],[":%S",function(t){return t.getUTCSeconds()}],["%I:%M",function(t){return t.getUTCMinutes()}],["%I %p",function(t){return t.getUTCHours()}],["%a %d",function(t){return t.getUTCDay()&&t.getUTCDate()!=1}],["%b %d",function(t){return t.getUTCDate()!=1}],["%B",function(t){return t.getUTCMonth()}],["%Y",Nn]]);rs.year=Vt.year.utc,Vt.scale.utc=function(){return Mr(b.scale.linear(),rs,Uu)},b.text=xe(function(t){return t.responseText}),b.json=function(t,i){return me(t,"application/json",pu,i)},b.html=function(t,i){return me(t,"text/html",mu,i)},b.xml=xe(function(t){return t.responseXML}),typeof define=="function"&&define.amd?(this.d3=b,define(b)):typeof pe=="object"&&pe.exports?pe.exports=b:this.d3=b})()},{}],4:[function(fe,pe,le){(function(st,dt){typeof le=="object"&&pe!==void 0?pe.exports=dt():typeof define=="function"&&define.amd?define(dt):st.flatpickr=dt()})(this,function(){"use strict";function st(Y,Q,pt){return pt!==!1?new Date(Y.getTime()).setHours(0,0,0,0)-new Date(Q.getTime()).setHours(0,0,0,0):Y.getTime()-Q.getTime()}function dt(Y,Q,pt){pt===void 0&&(pt=!1);var Xt;return function(){var ne=this,bt=arguments;Xt!==null&&clearTimeout(Xt),Xt=window.setTimeout(function(){Xt=null,pt||Y.apply(ne,bt)},Q),pt&&!Xt&&Y.apply(ne,bt)}}function H(Y,Q,pt){if(pt===!0)return Y.classList.add(Q);Y.classList.remove(Q)}function B(Y,Q,pt){var Xt=window.document.createElement(Y);return Q=Q||"",pt=pt||"",Xt.className=Q,pt!==void 0&&(Xt.textContent=pt),Xt}function $(Y,Q){return Q(Y)?Y:Y.parentNode?$(Y.parentNode,Q):void 0}function mt(Y){var Q=B("div","numInputWrapper"),pt=B("input","numInput "+Y),Xt=B("span","arrowUp"),ne=B("span","arrowDown");return pt.type="text",pt.pattern="\\d*",Q.appendChild(pt),Q.appendChild(Xt),Q.appendChild(ne),Q}function P(Y,Q){function pt(I){return I.bind(c)}function Xt(I){if(c.config.noCalendar&&c.selectedDates.length===0){var X=c.config.minDate;c.setDate(new Date().setHours(X?X.getHours():c.config.defaultHour,X?X.getMinutes():c.config.defaultMinute,X&&c.config.enableSeconds?X.getSeconds():c.config.defaultSeconds),!1),ne(),we()}(function(W){W.preventDefault();var gt=W.type==="keydown",Tt=W.target;c.amPM!==void 0&&W.target===c.amPM&&(c.amPM.textContent=c.l10n.amPM[Lt(c.amPM.textContent===c.l10n.amPM[0])]);var Gt=Number(Tt.min),Et=Number(Tt.max),ie=Number(Tt.step),It=parseInt(Tt.value,10),xe=W.delta||(gt?W.which===38?1:-1:Math.max(-1,Math.min(1,W.wheelDelta||-W.deltaY))||0),me=It+ie*xe;if(Tt.value!==void 0&&Tt.value.length===2){var Te=Tt===c.hourElement,Ae=Tt===c.minuteElement;me<Gt?(me=Et+me+Lt(!Te)+(Lt(Te)&&Lt(!c.amPM)),Ae&&L(void 0,-1,c.hourElement)):me>Et&&(me=Tt===c.hourElement?me-Et-Lt(!c.amPM):Gt,Ae&&L(void 0,1,c.hourElement)),c.amPM&&Te&&(ie===1?me+It===23:Math.abs(me-It)>ie)&&(c.amPM.textContent=c.l10n.amPM[Lt(c.amPM.textContent===c.l10n.amPM[0])]),Tt.value=yt(me)}})(I),c.selectedDates.length!==0&&(!c.minDateHasTime||I.type!=="input"||I.target.value.length>=2?(ne(),we()):setTimeout(function(){ne(),we()},1e3))}function ne(){if(c.hourElement!==void 0&&c.minuteElement!==void 0){var I=(parseInt(c.hourElement.value.slice(-2),10)||0)%24,X=(parseInt(c.minuteElement.value,10)||0)%60,W=c.secondElement!==void 0?(parseInt(c.secondElement.value,10)||0)%60:0;c.amPM!==void 0&&(I=(function(gt,Tt){return gt%12+12*Lt(Tt===c.l10n.amPM[1])})(I,c.amPM.textContent)),c.config.minDate&&c.minDateHasTime&&c.latestSelectedDateObj&&st(c.latestSelectedDateObj,c.config.minDate)===0&&(I=Math.max(I,c.config.minDate.getHours()))===c.config.minDate.getHours()&&(X=Math.max(X,c.config.minDate.getMinutes())),c.config.maxDate&&c.maxDateHasTime&&c.latestSelectedDateObj&&st(c.latestSelectedDateObj,c.config.maxDate)===0&&(I=Math.min(I,c.config.maxDate.getHours()))===c.config.maxDate.getHours()&&(X=Math.min(X,c.config.maxDate.getMinutes())),w(I,X,W)}}function bt(I){var X=I||c.latestSelectedDateObj;X&&w(X.getHours(),X.getMinutes(),X.getSeconds())}function w(I,X,W){c.latestSelectedDateObj!==void 0&&c.latestSelectedDateObj.setHours(I%24,X,W||0,0),c.hourElement&&c.minuteElement&&!c.isMobile&&(c.hourElement.value=yt(c.config.time_24hr?I:(12+I)%12+12*Lt(I%12==0)),c.minuteElement.value=yt(X),c.amPM!==void 0&&(c.amPM.textContent=c.l10n.amPM[Lt(I>=12)]),c.secondElement!==void 0&&(c.secondElement.value=yt(W)))}function Le(I){var X=parseInt(I.target.value)+(I.delta||0);X.toString().length!==4&&I.key!=="Enter"||(c.currentYearElement.blur(),/[^\d]/.test(X.toString())||qt(X))}function e(I,X,W){return X instanceof Array?X.forEach(function(gt){return e(I,gt,W)}):I instanceof Array?I.forEach(function(gt){return e(gt,X,W)}):(I.addEventListener(X,W),void c._handlers.push({element:I,event:X,handler:W}))}function n(I){return function(X){X.which===1&&I(X)}}function o(){Ft("onChange")}function l(){c._animationLoop.forEach(function(I){return I()}),c._animationLoop=[]}function p(I){if(c.daysContainer&&c.daysContainer.childNodes.length>1)switch(I.animationName){case"fpSlideLeft":c.daysContainer.lastChild&&c.daysContainer.lastChild.classList.remove("slideLeftNew"),c.daysContainer.removeChild(c.daysContainer.firstChild),c.days=c.daysContainer.firstChild,l();break;case"fpSlideRight":c.daysContainer.firstChild&&c.daysContainer.firstChild.classList.remove("slideRightNew"),c.daysContainer.removeChild(c.daysContainer.lastChild),c.days=c.daysContainer.firstChild,l()}}function y(I){switch(I.animationName){case"fpSlideLeftNew":case"fpSlideRightNew":c.navigationCurrentMonth.classList.remove("slideLeftNew"),c.navigationCurrentMonth.classList.remove("slideRightNew");for(var X=c.navigationCurrentMonth;X.nextSibling&&/curr/.test(X.nextSibling.className);)c.monthNav.removeChild(X.nextSibling);for(;X.previousSibling&&/curr/.test(X.previousSibling.className);)c.monthNav.removeChild(X.previousSibling);c.oldCurMonth=void 0}}function _(I){var X=I!==void 0?te(I):c.latestSelectedDateObj||(c.config.minDate&&c.config.minDate>c.now?c.config.minDate:c.config.maxDate&&c.config.maxDate<c.now?c.config.maxDate:c.now);try{X!==void 0&&(c.currentYear=X.getFullYear(),c.currentMonth=X.getMonth())}catch(W){W.message="Invalid date supplied: "+X,c.config.errorHandler(W)}c.redraw()}function T(I){~I.target.className.indexOf("arrow")&&L(I,I.target.classList.contains("arrowUp")?1:-1)}function L(I,X,W){var gt=I&&I.target,Tt=W||gt&&gt.parentNode&&gt.parentNode.firstChild,Gt=ye("increment");Gt.delta=X,Tt&&Tt.dispatchEvent(Gt)}function V(I,X,W,gt){var Tt=Jt(X,!0),Gt=B("span","flatpickr-day "+I,X.getDate().toString());return Gt.dateObj=X,Gt.$i=gt,Gt.setAttribute("aria-label",c.formatDate(X,c.config.ariaDateFormat)),st(X,c.now)===0&&(c.todayDateElem=Gt,Gt.classList.add("today")),Tt?(Gt.tabIndex=-1,ct(X)&&(Gt.classList.add("selected"),c.selectedDateElem=Gt,c.config.mode==="range"&&(H(Gt,"startRange",c.selectedDates[0]&&st(X,c.selectedDates[0])===0),H(Gt,"endRange",c.selectedDates[1]&&st(X,c.selectedDates[1])===0)))):(Gt.classList.add("disabled"),c.selectedDates[0]&&c.minRangeDate&&X>c.minRangeDate&&X<c.selectedDates[0]?c.minRangeDate=X:c.selectedDates[0]&&c.maxRangeDate&&X<c.maxRangeDate&&X>c.selectedDates[0]&&(c.maxRangeDate=X)),c.config.mode==="range"&&((function(Et){return!(c.config.mode!=="range"||c.selectedDates.length<2)&&st(Et,c.selectedDates[0])>=0&&st(Et,c.selectedDates[1])<=0})(X)&&!ct(X)&&Gt.classList.add("inRange"),c.selectedDates.length===1&&c.minRangeDate!==void 0&&c.maxRangeDate!==void 0&&(X<c.minRangeDate||X>c.maxRangeDate)&&Gt.classList.add("notAllowed")),c.weekNumbers&&I!=="prevMonthDay"&&W%7==1&&c.weekNumbers.insertAdjacentHTML("beforeend","<span class='disabled flatpickr-day'>"+c.config.getWeek(X)+"</span>"),Ft("onDayCreate",Gt),Gt}function F(I,X){var W=I+X||0,gt=I!==void 0?c.days.childNodes[W]:c.selectedDateElem||c.todayDateElem||c.days.childNodes[0],Tt=function(){(gt=gt||c.days.childNodes[W]).focus(),c.config.mode==="range"&&j(gt)};if(gt===void 0&&X!==0)return X>0?(c.changeMonth(1,!0,void 0,!0),W%=42):X<0&&(c.changeMonth(-1,!0,void 0,!0),W+=42),q(Tt);Tt()}function q(I){c.config.animate===!0?c._animationLoop.push(I):I()}function ot(I){if(c.daysContainer!==void 0){var X=(new Date(c.currentYear,c.currentMonth,1).getDay()-c.l10n.firstDayOfWeek+7)%7,W=c.config.mode==="range",gt=c.utils.getDaysInMonth((c.currentMonth-1+12)%12),Tt=c.utils.getDaysInMonth(),Gt=window.document.createDocumentFragment(),Et=gt+1-X,ie=0;for(c.weekNumbers&&c.weekNumbers.firstChild&&(c.weekNumbers.textContent=""),W&&(c.minRangeDate=new Date(c.currentYear,c.currentMonth-1,Et),c.maxRangeDate=new Date(c.currentYear,c.currentMonth+1,(42-X)%Tt));Et<=gt;Et++,ie++)Gt.appendChild(V("prevMonthDay",new Date(c.currentYear,c.currentMonth-1,Et),Et,ie));for(Et=1;Et<=Tt;Et++,ie++)Gt.appendChild(V("",new Date(c.currentYear,c.currentMonth,Et),Et,ie));for(var It=Tt+1;It<=42-X;It++,ie++)Gt.appendChild(V("nextMonthDay",new Date(c.currentYear,c.currentMonth+1,It%Tt),It,ie));W&&c.selectedDates.length===1&&Gt.childNodes[0]?(c._hidePrevMonthArrow=c._hidePrevMonthArrow||!!c.minRangeDate&&c.minRangeDate>Gt.childNodes[0].dateObj,c._hideNextMonthArrow=c._hideNextMonthArrow||!!c.maxRangeDate&&c.maxRangeDate<new Date(c.currentYear,c.currentMonth+1,1)):Ve();var xe=B("div","dayContainer");if(xe.appendChild(Gt),c.config.animate&&I!==void 0)for(;c.daysContainer.childNodes.length>1;)c.daysContainer.removeChild(c.daysContainer.firstChild);else(function(me){for(;me.firstChild;)me.removeChild(me.firstChild)})(c.daysContainer);I&&I>=0?c.daysContainer.appendChild(xe):c.daysContainer.insertBefore(xe,c.daysContainer.firstChild),c.days=c.daysContainer.childNodes[0]}}function lt(){c.weekdayContainer||(c.weekdayContainer=B("div","flatpickr-weekdays"));var I=c.l10n.firstDayOfWeek,X=c.l10n.weekdays.shorthand.slice();return I>0&&I<X.length&&(X=X.splice(I,X.length).concat(X.splice(0,I))),c.weekdayContainer.innerHTML=`
+    <span class=flatpickr-weekday>
+      `+X.join("</span><span class=flatpickr-weekday>")+`
+    </span>
+    `,c.weekdayContainer}function ht(I,X,W,gt){X===void 0&&(X=!0),W===void 0&&(W=c.config.animate),gt===void 0&&(gt=!1);var Tt=X?I:I-c.currentMonth;if(!(Tt<0&&c._hidePrevMonthArrow||Tt>0&&c._hideNextMonthArrow)){if(c.currentMonth+=Tt,(c.currentMonth<0||c.currentMonth>11)&&(c.currentYear+=c.currentMonth>11?1:-1,c.currentMonth=(c.currentMonth+12)%12,Ft("onYearChange")),ot(W?Tt:void 0),!W)return Ft("onMonthChange"),Ve();var Gt=c.navigationCurrentMonth;if(Tt<0)for(;Gt.nextSibling&&/curr/.test(Gt.nextSibling.className);)c.monthNav.removeChild(Gt.nextSibling);else if(Tt>0)for(;Gt.previousSibling&&/curr/.test(Gt.previousSibling.className);)c.monthNav.removeChild(Gt.previousSibling);c.oldCurMonth=c.navigationCurrentMonth,c.navigationCurrentMonth=c.monthNav.insertBefore(c.oldCurMonth.cloneNode(!0),Tt>0?c.oldCurMonth.nextSibling:c.oldCurMonth);var Et=c.daysContainer;if(Et.firstChild&&Et.lastChild&&(Tt>0?(Et.firstChild.classList.add("slideLeft"),Et.lastChild.classList.add("slideLeftNew"),c.oldCurMonth.classList.add("slideLeft"),c.navigationCurrentMonth.classList.add("slideLeftNew")):Tt<0&&(Et.firstChild.classList.add("slideRightNew"),Et.lastChild.classList.add("slideRight"),c.oldCurMonth.classList.add("slideRight"),c.navigationCurrentMonth.classList.add("slideRightNew"))),c.currentMonthElement=c.navigationCurrentMonth.firstChild,c.currentYearElement=c.navigationCurrentMonth.lastChild.childNodes[0],Ve(),c.oldCurMonth.firstChild&&(c.oldCurMonth.firstChild.textContent=ae(c.currentMonth-Tt,c.config.shorthandCurrentMonth,c.l10n)),q(function(){return Ft("onMonthChange")}),gt&&document.activeElement&&document.activeElement.$i){var ie=document.activeElement.$i;q(function(){F(ie,0)})}}}function kt(I){return!(!c.config.appendTo||!c.config.appendTo.contains(I))||c.calendarContainer.contains(I)}function Rt(I){if(c.isOpen&&!c.config.inline){var X=kt(I.target),W=I.target===c.input||I.target===c.altInput||c.element.contains(I.target)||I.path&&I.path.indexOf&&(~I.path.indexOf(c.input)||~I.path.indexOf(c.altInput));(I.type==="blur"?W&&I.relatedTarget&&!kt(I.relatedTarget):!W&&!X)&&c.config.ignoredFocusElements.indexOf(I.target)===-1&&(c.close(),c.config.mode==="range"&&c.selectedDates.length===1&&(c.clear(!1),c.redraw()))}}function qt(I){if(!(!I||c.currentYearElement.min&&I<parseInt(c.currentYearElement.min)||c.currentYearElement.max&&I>parseInt(c.currentYearElement.max))){var X=I,W=c.currentYear!==X;c.currentYear=X||c.currentYear,c.config.maxDate&&c.currentYear===c.config.maxDate.getFullYear()?c.currentMonth=Math.min(c.config.maxDate.getMonth(),c.currentMonth):c.config.minDate&&c.currentYear===c.config.minDate.getFullYear()&&(c.currentMonth=Math.max(c.config.minDate.getMonth(),c.currentMonth)),W&&(c.redraw(),Ft("onYearChange"))}}function Jt(I,X){X===void 0&&(X=!0);var W=c.parseDate(I,void 0,X);if(c.config.minDate&&W&&st(W,c.config.minDate,X!==void 0?X:!c.minDateHasTime)<0||c.config.maxDate&&W&&st(W,c.config.maxDate,X!==void 0?X:!c.maxDateHasTime)>0)return!1;if(!c.config.enable.length&&!c.config.disable.length)return!0;if(W===void 0)return!1;for(var gt=c.config.enable.length>0,Tt=gt?c.config.enable:c.config.disable,Gt=0,Et=void 0;Gt<Tt.length;Gt++){if(typeof(Et=Tt[Gt])=="function"&&Et(W)||Et instanceof Date&&W!==void 0&&Et.getTime()===W.getTime())return gt;if(typeof Et=="string"&&W!==void 0){var ie=c.parseDate(Et,void 0,!0);return ie&&ie.getTime()===W.getTime()?gt:!gt}if(typeof Et=="object"&&W!==void 0&&Et.from&&Et.to&&W.getTime()>=Et.from.getTime()&&W.getTime()<=Et.to.getTime())return gt}return!gt}function Yt(I){var X=I.target===c._input,W=kt(I.target),gt=c.config.allowInput,Tt=c.isOpen&&(!gt||!X),Gt=c.config.inline&&X&&!gt;if(I.key==="Enter"&&X){if(gt)return c.setDate(c._input.value,!0,I.target===c.altInput?c.config.altFormat:c.config.dateFormat),I.target.blur();c.open()}else if(W||Tt||Gt){var Et=!!c.timeContainer&&c.timeContainer.contains(I.target);switch(I.key){case"Enter":Et?we():re(I);break;case"Escape":I.preventDefault(),c.close();break;case"Backspace":case"Delete":X&&!c.config.allowInput&&c.clear();break;case"ArrowLeft":case"ArrowRight":if(Et)c.hourElement&&c.hourElement.focus();else if(I.preventDefault(),c.daysContainer){var ie=I.key==="ArrowRight"?1:-1;I.ctrlKey?ht(ie,!0,void 0,!0):F(I.target.$i,ie)}break;case"ArrowUp":case"ArrowDown":I.preventDefault();var It=I.key==="ArrowDown"?1:-1;c.daysContainer&&I.target.$i!==void 0?I.ctrlKey?(qt(c.currentYear-It),F(I.target.$i,0)):Et||F(I.target.$i,7*It):c.config.enableTime&&(!Et&&c.hourElement&&c.hourElement.focus(),Xt(I),c._debouncedChange());break;case"Tab":I.target===c.hourElement?(I.preventDefault(),c.minuteElement.select()):I.target===c.minuteElement&&(c.secondElement||c.amPM)?(I.preventDefault(),c.secondElement!==void 0?c.secondElement.focus():c.amPM!==void 0&&c.amPM.focus()):I.target===c.secondElement&&c.amPM&&(I.preventDefault(),c.amPM.focus());break;case c.l10n.amPM[0].charAt(0):c.amPM!==void 0&&I.target===c.amPM&&(c.amPM.textContent=c.l10n.amPM[0],ne(),we());break;case c.l10n.amPM[1].charAt(0):c.amPM!==void 0&&I.target===c.amPM&&(c.amPM.textContent=c.l10n.amPM[1],ne(),we())}Ft("onKeyDown",I)}}function j(I){if(c.selectedDates.length===1&&I.classList.contains("flatpickr-day")&&c.minRangeDate!==void 0&&c.maxRangeDate!==void 0){for(var X=I.dateObj,W=c.parseDate(c.selectedDates[0],void 0,!0),gt=Math.min(X.getTime(),c.selectedDates[0].getTime()),Tt=Math.max(X.getTime(),c.selectedDates[0].getTime()),Gt=!1,Et=gt;Et<Tt;Et+=jt.DAY)if(!Jt(new Date(Et))){Gt=!0;break}for(var ie=function(me,Te){var Ae=Te.getTime(),on=Ae<c.minRangeDate.getTime()||Ae>c.maxRangeDate.getTime(),We=c.days.childNodes[me];if(on)return We.classList.add("notAllowed"),["inRange","startRange","endRange"].forEach(function(Ye){We.classList.remove(Ye)}),"continue";if(Gt&&!on)return"continue";["startRange","inRange","endRange","notAllowed"].forEach(function(Ye){We.classList.remove(Ye)});var sn=Math.max(c.minRangeDate.getTime(),gt),He=Math.min(c.maxRangeDate.getTime(),Tt);I.classList.add(X<c.selectedDates[0]?"startRange":"endRange"),W<X&&Ae===W.getTime()?We.classList.add("startRange"):W>X&&Ae===W.getTime()&&We.classList.add("endRange"),Ae>=sn&&Ae<=He&&We.classList.add("inRange")},It=0,xe=c.days.childNodes[It].dateObj;It<42;It++,xe=c.days.childNodes[It]&&c.days.childNodes[It].dateObj)ie(It,xe)}}function tt(){!c.isOpen||c.config.static||c.config.inline||xt()}function Ot(I){return function(X){var W=c.config["_"+I+"Date"]=c.parseDate(X),gt=c.config["_"+(I==="min"?"max":"min")+"Date"];W!==void 0&&(c[I==="min"?"minDateHasTime":"maxDateHasTime"]=W.getHours()>0||W.getMinutes()>0||W.getSeconds()>0),c.selectedDates&&(c.selectedDates=c.selectedDates.filter(function(Tt){return Jt(Tt)}),c.selectedDates.length||I!=="min"||bt(W),we()),c.daysContainer&&(St(),W!==void 0?c.currentYearElement[I]=W.getFullYear().toString():c.currentYearElement.removeAttribute(I),c.currentYearElement.disabled=!!gt&&W!==void 0&&gt.getFullYear()===W.getFullYear())}}function xt(I){if(I===void 0&&(I=c._positionElement),c.calendarContainer!==void 0){var X=c.calendarContainer.offsetHeight,W=c.calendarContainer.offsetWidth,gt=c.config.position,Tt=I.getBoundingClientRect(),Gt=window.innerHeight-Tt.bottom,Et=gt==="above"||gt!=="below"&&Gt<X&&Tt.top>X,ie=window.pageYOffset+Tt.top+(Et?-X-2:I.offsetHeight+2);if(H(c.calendarContainer,"arrowTop",!Et),H(c.calendarContainer,"arrowBottom",Et),!c.config.inline){var It=window.pageXOffset+Tt.left,xe=window.document.body.offsetWidth-Tt.right,me=It+W>window.document.body.offsetWidth;H(c.calendarContainer,"rightMost",me),c.config.static||(c.calendarContainer.style.top=ie+"px",me?(c.calendarContainer.style.left="auto",c.calendarContainer.style.right=xe+"px"):(c.calendarContainer.style.left=It+"px",c.calendarContainer.style.right="auto"))}}}function St(){c.config.noCalendar||c.isMobile||(lt(),Ve(),ot())}function re(I){I.preventDefault(),I.stopPropagation();var X=$(I.target,function(xe){return xe.classList&&xe.classList.contains("flatpickr-day")&&!xe.classList.contains("disabled")&&!xe.classList.contains("notAllowed")});if(X!==void 0){var W=X,gt=c.latestSelectedDateObj=new Date(W.dateObj.getTime()),Tt=gt.getMonth()!==c.currentMonth&&c.config.mode!=="range";if(c.selectedDateElem=W,c.config.mode==="single")c.selectedDates=[gt];else if(c.config.mode==="multiple"){var Gt=ct(gt);Gt?c.selectedDates.splice(parseInt(Gt),1):c.selectedDates.push(gt)}else c.config.mode==="range"&&(c.selectedDates.length===2&&c.clear(),c.selectedDates.push(gt),st(gt,c.selectedDates[0],!0)!==0&&c.selectedDates.sort(function(xe,me){return xe.getTime()-me.getTime()}));if(ne(),Tt){var Et=c.currentYear!==gt.getFullYear();c.currentYear=gt.getFullYear(),c.currentMonth=gt.getMonth(),Et&&Ft("onYearChange"),Ft("onMonthChange")}if(ot(),c.config.minDate&&c.minDateHasTime&&c.config.enableTime&&st(gt,c.config.minDate)===0&&bt(c.config.minDate),we(),c.config.enableTime&&setTimeout(function(){return c.showTimeInput=!0},50),c.config.mode==="range"&&(c.selectedDates.length===1?(j(W),c._hidePrevMonthArrow=c._hidePrevMonthArrow||c.minRangeDate!==void 0&&c.minRangeDate>c.days.childNodes[0].dateObj,c._hideNextMonthArrow=c._hideNextMonthArrow||c.maxRangeDate!==void 0&&c.maxRangeDate<new Date(c.currentYear,c.currentMonth+1,1)):Ve()),Ft("onChange"),Tt?q(function(){return c.selectedDateElem&&c.selectedDateElem.focus()}):F(W.$i,0),c.hourElement!==void 0&&setTimeout(function(){return c.hourElement!==void 0&&c.hourElement.select()},451),c.config.closeOnSelect){var ie=c.config.mode==="single"&&!c.config.enableTime,It=c.config.mode==="range"&&c.selectedDates.length===2&&!c.config.enableTime;(ie||It)&&c.close()}}}function zt(I,X){var W=[];if(I instanceof Array)W=I.map(function(gt){return c.parseDate(gt,X)});else if(I instanceof Date||typeof I=="number")W=[c.parseDate(I,X)];else if(typeof I=="string")switch(c.config.mode){case"single":W=[c.parseDate(I,X)];break;case"multiple":W=I.split(c.config.conjunction).map(function(gt){return c.parseDate(gt,X)});break;case"range":W=I.split(c.l10n.rangeSeparator).map(function(gt){return c.parseDate(gt,X)})}else c.config.errorHandler(new Error("Invalid date supplied: "+JSON.stringify(I)));c.selectedDates=W.filter(function(gt){return gt instanceof Date&&Jt(gt,!1)}),c.selectedDates.sort(function(gt,Tt){return gt.getTime()-Tt.getTime()})}function oe(I){return I.map(function(X){return typeof X=="string"||typeof X=="number"||X instanceof Date?c.parseDate(X,void 0,!0):X&&typeof X=="object"&&X.from&&X.to?{from:c.parseDate(X.from,void 0),to:c.parseDate(X.to,void 0)}:X}).filter(function(X){return X})}function te(I,X,W){if(I===0||I){var gt,Tt=I;if(I instanceof Date)gt=new Date(I.getTime());else if(typeof I!="string"&&I.toFixed!==void 0)gt=new Date(I);else if(typeof I=="string"){var Gt=X||(c.config||Ct.defaultConfig).dateFormat,Et=String(I).trim();if(Et==="today")gt=new Date,W=!0;else if(/Z$/.test(Et)||/GMT$/.test(Et))gt=new Date(I);else if(c.config&&c.config.parseDate)gt=c.config.parseDate(I,Gt);else{gt=c.config&&c.config.noCalendar?new Date(new Date().setHours(0,0,0,0)):new Date(new Date().getFullYear(),0,1,0,0,0,0);for(var ie=void 0,It=[],xe=0,me=0,Te="";xe<Gt.length;xe++){var Ae=Gt[xe],on=Ae==="\\",We=Gt[xe-1]==="\\"||on;if(ut[Ae]&&!We){Te+=ut[Ae];var sn=new RegExp(Te).exec(I);sn&&(ie=!0)&&It[Ae!=="Y"?"push":"unshift"]({fn:K[Ae],val:sn[++me]})}else on||(Te+=".");It.forEach(function(He){var Ye=He.fn,An=He.val;return gt=Ye(gt,An,c.l10n)||gt})}gt=ie?gt:void 0}}if(gt instanceof Date)return W===!0&&gt.setHours(0,0,0,0),gt;c.config.errorHandler(new Error("Invalid date provided: "+Tt))}}function Ft(I,X){var W=c.config[I];if(W!==void 0&&W.length>0)for(var gt=0;W[gt]&&gt<W.length;gt++)W[gt](c.selectedDates,c.input.value,c,X);I==="onChange"&&(c.input.dispatchEvent(ye("change")),c.input.dispatchEvent(ye("input")))}function ye(I){var X=document.createEvent("Event");return X.initEvent(I,!0,!0),X}function ct(I){for(var X=0;X<c.selectedDates.length;X++)if(st(c.selectedDates[X],I)===0)return""+X;return!1}function Ve(){c.config.noCalendar||c.isMobile||!c.monthNav||(c.currentMonthElement.textContent=ae(c.currentMonth,c.config.shorthandCurrentMonth,c.l10n)+" ",c.currentYearElement.value=c.currentYear.toString(),c._hidePrevMonthArrow=c.config.minDate!==void 0&&(c.currentYear===c.config.minDate.getFullYear()?c.currentMonth<=c.config.minDate.getMonth():c.currentYear<c.config.minDate.getFullYear()),c._hideNextMonthArrow=c.config.maxDate!==void 0&&(c.currentYear===c.config.maxDate.getFullYear()?c.currentMonth+1>c.config.maxDate.getMonth():c.currentYear>c.config.maxDate.getFullYear()))}function we(I){if(I===void 0&&(I=!0),!c.selectedDates.length)return c.clear(I);c.mobileInput!==void 0&&c.mobileFormatStr&&(c.mobileInput.value=c.latestSelectedDateObj!==void 0?c.formatDate(c.latestSelectedDateObj,c.mobileFormatStr):"");var X=c.config.mode!=="range"?c.config.conjunction:c.l10n.rangeSeparator;c.input.value=c.selectedDates.map(function(W){return c.formatDate(W,c.config.dateFormat)}).join(X),c.altInput!==void 0&&(c.altInput.value=c.selectedDates.map(function(W){return c.formatDate(W,c.config.altFormat)}).join(X)),I!==!1&&Ft("onValueUpdate")}function he(I){I.preventDefault();var X=c.currentYearElement.parentNode&&c.currentYearElement.parentNode.contains(I.target);if(I.target===c.currentMonthElement||X){var W=(function(gt){return(gt.wheelDelta||-gt.deltaY)>=0?1:-1})(I);X?(qt(c.currentYear+W),I.target.value=c.currentYear.toString()):c.changeMonth(W,!0,!1)}}function Pt(I){var X=c.prevMonthNav.contains(I.target),W=c.nextMonthNav.contains(I.target);X||W?ht(X?-1:1):I.target===c.currentYearElement?(I.preventDefault(),c.currentYearElement.select()):I.target.className==="arrowUp"?c.changeYear(c.currentYear+1):I.target.className==="arrowDown"&&c.changeYear(c.currentYear-1)}var c={};return c.parseDate=te,c.formatDate=function(I,X){return c.config!==void 0&&c.config.formatDate!==void 0?c.config.formatDate(I,X):X.split("").map(function(W,gt,Tt){return vt[W]&&Tt[gt-1]!=="\\"?vt[W](I,c.l10n,c.config):W!=="\\"?W:""}).join("")},c._animationLoop=[],c._handlers=[],c._bind=e,c._setHoursFromDate=bt,c.changeMonth=ht,c.changeYear=qt,c.clear=function(I){I===void 0&&(I=!0),c.input.value="",c.altInput&&(c.altInput.value=""),c.mobileInput&&(c.mobileInput.value=""),c.selectedDates=[],c.latestSelectedDateObj=void 0,c.showTimeInput=!1,c.redraw(),I&&Ft("onChange")},c.close=function(){c.isOpen=!1,c.isMobile||(c.calendarContainer.classList.remove("open"),c._input.classList.remove("active")),Ft("onClose")},c._createElement=B,c.destroy=function(){c.config!==void 0&&Ft("onDestroy");for(var I=c._handlers.length;I--;){var X=c._handlers[I];X.element.removeEventListener(X.event,X.handler)}c._handlers=[],c.mobileInput?(c.mobileInput.parentNode&&c.mobileInput.parentNode.removeChild(c.mobileInput),c.mobileInput=void 0):c.calendarContainer&&c.calendarContainer.parentNode&&c.calendarContainer.parentNode.removeChild(c.calendarContainer),c.altInput&&(c.input.type="text",c.altInput.parentNode&&c.altInput.parentNode.removeChild(c.altInput),delete c.altInput),c.input&&(c.input.type=c.input._type,c.input.classList.remove("flatpickr-input"),c.input.removeAttribute("readonly"),c.input.value=""),["_showTimeInput","latestSelectedDateObj","_hideNextMonthArrow","_hidePrevMonthArrow","__hideNextMonthArrow","__hidePrevMonthArrow","isMobile","isOpen","selectedDateElem","minDateHasTime","maxDateHasTime","days","daysContainer","_input","_positionElement","innerContainer","rContainer","monthNav","todayDateElem","calendarContainer","weekdayContainer","prevMonthNav","nextMonthNav","currentMonthElement","currentYearElement","navigationCurrentMonth","selectedDateElem","config"].forEach(function(W){try{delete c[W]}catch(gt){}})},c.isEnabled=Jt,c.jumpToDate=_,c.open=function(I,X){if(X===void 0&&(X=c._input),c.isMobile)return I&&(I.preventDefault(),I.target&&I.target.blur()),setTimeout(function(){c.mobileInput!==void 0&&c.mobileInput.click()},0),void Ft("onOpen");if(!c._input.disabled&&!c.config.inline){var W=c.isOpen;c.isOpen=!0,xt(X),c.calendarContainer.classList.add("open"),c._input.classList.add("active"),!W&&Ft("onOpen")}},c.redraw=St,c.set=function(I,X){I!==null&&typeof I=="object"?Object.assign(c.config,I):c.config[I]=X,c.redraw(),_()},c.setDate=function(I,X,W){if(X===void 0&&(X=!1),I!==0&&!I)return c.clear(X);zt(I,W),c.showTimeInput=c.selectedDates.length>0,c.latestSelectedDateObj=c.selectedDates[0],c.redraw(),_(),bt(),we(X),X&&Ft("onChange")},c.toggle=function(){if(c.isOpen)return c.close();c.open()},c.element=c.input=Y,c.isOpen=!1,(function(){var I=["wrap","weekNumbers","allowInput","clickOpens","time_24hr","enableTime","noCalendar","altInput","shorthandCurrentMonth","inline","static","enableSeconds","disableMobile"],X=["onChange","onClose","onDayCreate","onDestroy","onKeyDown","onMonthChange","onOpen","onParseConfig","onReady","onValueUpdate","onYearChange"];c.config=ft({},Ct.defaultConfig);var W=ft({},Q,JSON.parse(JSON.stringify(Y.dataset||{}))),gt={};Object.defineProperty(c.config,"enable",{get:function(){return c.config._enable||[]},set:function(ie){c.config._enable=oe(ie)}}),Object.defineProperty(c.config,"disable",{get:function(){return c.config._disable||[]},set:function(ie){c.config._disable=oe(ie)}}),!W.dateFormat&&W.enableTime&&(gt.dateFormat=W.noCalendar?"H:i"+(W.enableSeconds?":S":""):Ct.defaultConfig.dateFormat+" H:i"+(W.enableSeconds?":S":"")),W.altInput&&W.enableTime&&!W.altFormat&&(gt.altFormat=W.noCalendar?"h:i"+(W.enableSeconds?":S K":" K"):Ct.defaultConfig.altFormat+" h:i"+(W.enableSeconds?":S":"")+" K"),Object.defineProperty(c.config,"minDate",{get:function(){return c.config._minDate},set:Ot("min")}),Object.defineProperty(c.config,"maxDate",{get:function(){return c.config._maxDate},set:Ot("max")}),Object.assign(c.config,gt,W);for(var Tt=0;Tt<I.length;Tt++)c.config[I[Tt]]=c.config[I[Tt]]===!0||c.config[I[Tt]]==="true";for(var Tt=X.length;Tt--;)c.config[X[Tt]]!==void 0&&(c.config[X[Tt]]=nt(c.config[X[Tt]]||[]).map(pt));for(var Tt=0;Tt<c.config.plugins.length;Tt++){var Gt=c.config.plugins[Tt](c)||{};for(var Et in Gt)~X.indexOf(Et)?c.config[Et]=nt(Gt[Et]).map(pt).concat(c.config[Et]):W[Et]===void 0&&(c.config[Et]=Gt[Et])}c.isMobile=!c.config.disableMobile&&!c.config.inline&&c.config.mode==="single"&&!c.config.disable.length&&!c.config.enable.length&&!c.config.weekNumbers&&/Android|webOS|iPhone|iPad|iPod|BlackBerry|IEMobile|Opera Mini/i.test(navigator.userAgent),Ft("onParseConfig")})(),typeof c.config.locale!="object"&&Ct.l10ns[c.config.locale]===void 0&&c.config.errorHandler(new Error("flatpickr: invalid locale "+c.config.locale)),c.l10n=ft({},Ct.l10ns.default,typeof c.config.locale=="object"?c.config.locale:c.config.locale!=="default"?Ct.l10ns[c.config.locale]:void 0),ut.K="("+c.l10n.amPM[0]+"|"+c.l10n.amPM[1]+"|"+c.l10n.amPM[0].toLowerCase()+"|"+c.l10n.amPM[1].toLowerCase()+")",c.input=c.config.wrap?Y.querySelector("[data-input]"):Y,c.input?(c.input._type=c.input.type,c.input.type="text",c.input.classList.add("flatpickr-input"),c._input=c.input,c.config.altInput&&(c.altInput=B(c.input.nodeName,c.input.className+" "+c.config.altInputClass),c._input=c.altInput,c.altInput.placeholder=c.input.placeholder,c.altInput.disabled=c.input.disabled,c.altInput.required=c.input.required,c.altInput.type="text",c.input.type="hidden",!c.config.static&&c.input.parentNode&&c.input.parentNode.insertBefore(c.altInput,c.input.nextSibling)),c.config.allowInput||c._input.setAttribute("readonly","readonly"),c._positionElement=c.config.positionElement||c._input):c.config.errorHandler(new Error("Invalid input element specified")),(function(){c.selectedDates=[],c.now=new Date;var I=c.config.defaultDate||c.input.value;I&&zt(I,c.config.dateFormat);var X=c.selectedDates.length?c.selectedDates[0]:c.config.minDate&&c.config.minDate.getTime()>c.now.getTime()?c.config.minDate:c.config.maxDate&&c.config.maxDate.getTime()<c.now.getTime()?c.config.maxDate:c.now;c.currentYear=X.getFullYear(),c.currentMonth=X.getMonth(),c.selectedDates.length&&(c.latestSelectedDateObj=c.selectedDates[0]),c.minDateHasTime=!!c.config.minDate&&(c.config.minDate.getHours()>0||c.config.minDate.getMinutes()>0||c.config.minDate.getSeconds()>0),c.maxDateHasTime=!!c.config.maxDate&&(c.config.maxDate.getHours()>0||c.config.maxDate.getMinutes()>0||c.config.maxDate.getSeconds()>0),Object.defineProperty(c,"showTimeInput",{get:function(){return c._showTimeInput},set:function(W){c._showTimeInput=W,c.calendarContainer&&H(c.calendarContainer,"showTimeInput",W),xt()}})})(),c.utils={getDaysInMonth:function(I,X){return I===void 0&&(I=c.currentMonth),X===void 0&&(X=c.currentYear),I===1&&(X%4==0&&X%100!=0||X%400==0)?29:c.l10n.daysInMonth[I]}},c.isMobile||(function(){var I=window.document.createDocumentFragment();if(c.calendarContainer=B("div","flatpickr-calendar"),c.calendarContainer.tabIndex=-1,!c.config.noCalendar){if(I.appendChild((function(){var Et=window.document.createDocumentFragment();c.monthNav=B("div","flatpickr-month"),c.prevMonthNav=B("span","flatpickr-prev-month"),c.prevMonthNav.innerHTML=c.config.prevArrow,c.currentMonthElement=B("span","cur-month"),c.currentMonthElement.title=c.l10n.scrollTitle;var ie=mt("cur-year");return c.currentYearElement=ie.childNodes[0],c.currentYearElement.title=c.l10n.scrollTitle,c.config.minDate&&(c.currentYearElement.min=c.config.minDate.getFullYear().toString()),c.config.maxDate&&(c.currentYearElement.max=c.config.maxDate.getFullYear().toString(),c.currentYearElement.disabled=!!c.config.minDate&&c.config.minDate.getFullYear()===c.config.maxDate.getFullYear()),c.nextMonthNav=B("span","flatpickr-next-month"),c.nextMonthNav.innerHTML=c.config.nextArrow,c.navigationCurrentMonth=B("div","flatpickr-current-month"),c.navigationCurrentMonth.appendChild(c.currentMonthElement),c.navigationCurrentMonth.appendChild(ie),Et.appendChild(c.prevMonthNav),Et.appendChild(c.navigationCurrentMonth),Et.appendChild(c.nextMonthNav),c.monthNav.appendChild(Et),Object.defineProperty(c,"_hidePrevMonthArrow",{get:function(){return c.__hidePrevMonthArrow},set:function(It){c.__hidePrevMonthArrow!==It&&(c.prevMonthNav.style.display=It?"none":"block"),c.__hidePrevMonthArrow=It}}),Object.defineProperty(c,"_hideNextMonthArrow",{get:function(){return c.__hideNextMonthArrow},set:function(It){c.__hideNextMonthArrow!==It&&(c.nextMonthNav.style.display=It?"none":"block"),c.__hideNextMonthArrow=It}}),Ve(),c.monthNav})()),c.innerContainer=B("div","flatpickr-innerContainer"),c.config.weekNumbers){var X=(function(){c.calendarContainer.classList.add("hasWeeks");var Et=B("div","flatpickr-weekwrapper");Et.appendChild(B("span","flatpickr-weekday",c.l10n.weekAbbreviation));var ie=B("div","flatpickr-weeks");return Et.appendChild(ie),{weekWrapper:Et,weekNumbers:ie}})(),W=X.weekWrapper,gt=X.weekNumbers;c.innerContainer.appendChild(W),c.weekNumbers=gt,c.weekWrapper=W}c.rContainer=B("div","flatpickr-rContainer"),c.rContainer.appendChild(lt()),c.daysContainer||(c.daysContainer=B("div","flatpickr-days"),c.daysContainer.tabIndex=-1),ot(),c.rContainer.appendChild(c.daysContainer),c.innerContainer.appendChild(c.rContainer),I.appendChild(c.innerContainer)}c.config.enableTime&&I.appendChild((function(){c.calendarContainer.classList.add("hasTime"),c.config.noCalendar&&c.calendarContainer.classList.add("noCalendar"),c.timeContainer=B("div","flatpickr-time"),c.timeContainer.tabIndex=-1;var Et=B("span","flatpickr-time-separator",":"),ie=mt("flatpickr-hour");c.hourElement=ie.childNodes[0];var It=mt("flatpickr-minute");if(c.minuteElement=It.childNodes[0],c.hourElement.tabIndex=c.minuteElement.tabIndex=-1,c.hourElement.value=yt(c.latestSelectedDateObj?c.latestSelectedDateObj.getHours():c.config.time_24hr?c.config.defaultHour:(function(me){switch(me%24){case 0:case 12:return 12;default:return me%12}})(c.config.defaultHour)),c.minuteElement.value=yt(c.latestSelectedDateObj?c.latestSelectedDateObj.getMinutes():c.config.defaultMinute),c.hourElement.step=c.config.hourIncrement.toString(),c.minuteElement.step=c.config.minuteIncrement.toString(),c.hourElement.min=c.config.time_24hr?"0":"1",c.hourElement.max=c.config.time_24hr?"23":"12",c.minuteElement.min="0",c.minuteElement.max="59",c.hourElement.title=c.minuteElement.title=c.l10n.scrollTitle,c.timeContainer.appendChild(ie),c.timeContainer.appendChild(Et),c.timeContainer.appendChild(It),c.config.time_24hr&&c.timeContainer.classList.add("time24hr"),c.config.enableSeconds){c.timeContainer.classList.add("hasSeconds");var xe=mt("flatpickr-second");c.secondElement=xe.childNodes[0],c.secondElement.value=yt(c.latestSelectedDateObj?c.latestSelectedDateObj.getSeconds():c.config.defaultSeconds),c.secondElement.step=c.minuteElement.step,c.secondElement.min=c.minuteElement.min,c.secondElement.max=c.minuteElement.max,c.timeContainer.appendChild(B("span","flatpickr-time-separator",":")),c.timeContainer.appendChild(xe)}return c.config.time_24hr||(c.amPM=B("span","flatpickr-am-pm",c.l10n.amPM[Lt((c.latestSelectedDateObj?c.hourElement.value:c.config.defaultHour)>11)]),c.amPM.title=c.l10n.toggleTitle,c.amPM.tabIndex=-1,c.timeContainer.appendChild(c.amPM)),c.timeContainer})()),H(c.calendarContainer,"rangeMode",c.config.mode==="range"),H(c.calendarContainer,"animate",c.config.animate),c.calendarContainer.appendChild(I);var Tt=c.config.appendTo!==void 0&&c.config.appendTo.nodeType;if((c.config.inline||c.config.static)&&(c.calendarContainer.classList.add(c.config.inline?"inline":"static"),c.config.inline&&(!Tt&&c.element.parentNode?c.element.parentNode.insertBefore(c.calendarContainer,c._input.nextSibling):c.config.appendTo!==void 0&&c.config.appendTo.appendChild(c.calendarContainer)),c.config.static)){var Gt=B("div","flatpickr-wrapper");c.element.parentNode&&c.element.parentNode.insertBefore(Gt,c.element),Gt.appendChild(c.element),c.altInput&&Gt.appendChild(c.altInput),Gt.appendChild(c.calendarContainer)}c.config.static||c.config.inline||(c.config.appendTo!==void 0?c.config.appendTo:window.document.body).appendChild(c.calendarContainer)})(),(function(){if(c.config.wrap&&["open","close","toggle","clear"].forEach(function(W){Array.prototype.forEach.call(c.element.querySelectorAll("[data-"+W+"]"),function(gt){return e(gt,"click",c[W])})}),c.isMobile)(function(){var W=c.config.enableTime?c.config.noCalendar?"time":"datetime-local":"date";c.mobileInput=B("input",c.input.className+" flatpickr-mobile"),c.mobileInput.step=c.input.getAttribute("step")||"any",c.mobileInput.tabIndex=1,c.mobileInput.type=W,c.mobileInput.disabled=c.input.disabled,c.mobileInput.placeholder=c.input.placeholder,c.mobileFormatStr=W==="datetime-local"?"Y-m-d\\TH:i:S":W==="date"?"Y-m-d":"H:i:S",c.selectedDates.length&&(c.mobileInput.defaultValue=c.mobileInput.value=c.formatDate(c.selectedDates[0],c.mobileFormatStr)),c.config.minDate&&(c.mobileInput.min=c.formatDate(c.config.minDate,"Y-m-d")),c.config.maxDate&&(c.mobileInput.max=c.formatDate(c.config.maxDate,"Y-m-d")),c.input.type="hidden",c.altInput!==void 0&&(c.altInput.type="hidden");try{c.input.parentNode&&c.input.parentNode.insertBefore(c.mobileInput,c.input.nextSibling)}catch(gt){}e(c.mobileInput,"change",function(gt){c.setDate(gt.target.value,!1,c.mobileFormatStr),Ft("onChange"),Ft("onClose")})})();else{var I=dt(tt,50);if(c._debouncedChange=dt(o,300),c.config.mode==="range"&&c.daysContainer&&!/iPhone|iPad|iPod/i.test(navigator.userAgent)&&e(c.daysContainer,"mouseover",function(W){return j(W.target)}),e(window.document.body,"keydown",Yt),c.config.static||e(c._input,"keydown",Yt),c.config.inline||c.config.static||e(window,"resize",I),window.ontouchstart!==void 0&&e(window.document.body,"touchstart",Rt),e(window.document.body,"mousedown",n(Rt)),e(c._input,"blur",Rt),c.config.clickOpens===!0&&(e(c._input,"focus",c.open),e(c._input,"mousedown",n(c.open))),c.daysContainer!==void 0&&(c.monthNav.addEventListener("wheel",function(W){return W.preventDefault()}),e(c.monthNav,"wheel",dt(he,10)),e(c.monthNav,"mousedown",n(Pt)),e(c.monthNav,["keyup","increment"],Le),e(c.daysContainer,"mousedown",n(re)),c.config.animate&&(e(c.daysContainer,["webkitAnimationEnd","animationend"],p),e(c.monthNav,["webkitAnimationEnd","animationend"],y))),c.timeContainer!==void 0&&c.minuteElement!==void 0&&c.hourElement!==void 0){var X=function(W){return W.target.select()};e(c.timeContainer,["wheel","input","increment"],Xt),e(c.timeContainer,"mousedown",n(T)),e(c.timeContainer,["wheel","increment"],c._debouncedChange),e(c.timeContainer,"input",o),e([c.hourElement,c.minuteElement],["focus","click"],X),c.secondElement!==void 0&&e(c.secondElement,"focus",function(){return c.secondElement&&c.secondElement.select()}),c.amPM!==void 0&&e(c.amPM,"mousedown",n(function(W){Xt(W),o()}))}}})(),(c.selectedDates.length||c.config.noCalendar)&&(c.config.enableTime&&bt(c.config.noCalendar?c.latestSelectedDateObj||c.config.minDate:void 0),we(!1)),c.showTimeInput=c.selectedDates.length>0||c.config.noCalendar,c.weekWrapper!==void 0&&c.daysContainer!==void 0&&(c.calendarContainer.style.width=c.daysContainer.offsetWidth+c.weekWrapper.offsetWidth+"px"),c.isMobile||xt(),Ft("onReady"),c}function Zt(Y,Q){for(var pt=Array.prototype.slice.call(Y),Xt=[],ne=0;ne<pt.length;ne++){var bt=pt[ne];try{if(bt.getAttribute("data-fp-omit")!==null)continue;bt._flatpickr!==void 0&&(bt._flatpickr.destroy(),bt._flatpickr=void 0),bt._flatpickr=P(bt,Q||{}),Xt.push(bt._flatpickr)}catch(w){console.error(w)}}return Xt.length===1?Xt[0]:Xt}var ft=Object.assign||function(Y){for(var Q,pt=1,Xt=arguments.length;pt<Xt;pt++){Q=arguments[pt];for(var ne in Q)Object.prototype.hasOwnProperty.call(Q,ne)&&(Y[ne]=Q[ne])}return Y},ae=function(Y,Q,pt){return pt.months[Q?"shorthand":"longhand"][Y]},jt={DAY:864e5},Ht={_disable:[],_enable:[],allowInput:!1,altFormat:"F j, Y",altInput:!1,altInputClass:"form-control input",animate:typeof window=="object"&&window.navigator.userAgent.indexOf("MSIE")===-1,ariaDateFormat:"F j, Y",clickOpens:!0,closeOnSelect:!0,conjunction:", ",dateFormat:"Y-m-d",defaultHour:12,defaultMinute:0,defaultSeconds:0,disable:[],disableMobile:!1,enable:[],enableSeconds:!1,enableTime:!1,errorHandler:console.warn,getWeek:function(Y){var Q=new Date(Y.getFullYear(),0,1);return Math.ceil(((Y.getTime()-Q.getTime())/864e5+Q.getDay()+1)/7)},hourIncrement:1,ignoredFocusElements:[],inline:!1,locale:"default",minuteIncrement:5,mode:"single",nextArrow:"<svg version='1.1' xmlns='http://www.w3.org/2000/svg' xmlns:xlink='http://www.w3.org/1999/xlink' viewBox='0 0 17 17'><g></g><path d='M13.207 8.472l-7.854 7.854-0.707-0.707 7.146-7.146-7.146-7.148 0.707-0.707 7.854 7.854z' /></svg>",noCalendar:!1,onChange:[],onClose:[],onDayCreate:[],onDestroy:[],onKeyDown:[],onMonthChange:[],onOpen:[],onParseConfig:[],onReady:[],onValueUpdate:[],onYearChange:[],plugins:[],position:"auto",positionElement:void 0,prevArrow:"<svg version='1.1' xmlns='http://www.w3.org/2000/svg' xmlns:xlink='http://www.w3.org/1999/xlink' viewBox='0 0 17 17'><g></g><path d='M5.207 8.471l7.146 7.147-0.707 0.707-7.853-7.854 7.854-7.853 0.707 0.707-7.147 7.146z' /></svg>",shorthandCurrentMonth:!1,static:!1,time_24hr:!1,weekNumbers:!1,wrap:!1},Ut={weekdays:{shorthand:["Sun","Mon","Tue","Wed","Thu","Fri","Sat"],longhand:["Sunday","Monday","Tuesday","Wednesday","Thursday","Friday","Saturday"]},months:{shorthand:["Jan","Feb","Mar","Apr","May","Jun","Jul","Aug","Sep","Oct","Nov","Dec"],longhand:["January","February","March","April","May","June","July","August","September","October","November","December"]},daysInMonth:[31,28,31,30,31,30,31,31,30,31,30,31],firstDayOfWeek:0,ordinal:function(Y){var Q=Y%100;if(Q>3&&Q<21)return"th";switch(Q%10){case 1:return"st";case 2:return"nd";case 3:return"rd";default:return"th"}},rangeSeparator:" to ",weekAbbreviation:"Wk",scrollTitle:"Scroll to increment",toggleTitle:"Click to toggle",amPM:["AM","PM"]},yt=function(Y){return("0"+Y).slice(-2)},Lt=function(Y){return Y===!0?1:0},nt=function(Y){return Y instanceof Array?Y:[Y]},at=function(){},K={D:at,F:function(Y,Q,pt){Y.setMonth(pt.months.longhand.indexOf(Q))},G:function(Y,Q){Y.setHours(parseFloat(Q))},H:function(Y,Q){Y.setHours(parseFloat(Q))},J:function(Y,Q){Y.setDate(parseFloat(Q))},K:function(Y,Q,pt){Y.setHours(Y.getHours()%12+12*Lt(new RegExp(pt.amPM[1],"i").test(Q)))},M:function(Y,Q,pt){Y.setMonth(pt.months.shorthand.indexOf(Q))},S:function(Y,Q){Y.setSeconds(parseFloat(Q))},U:function(Y,Q){return new Date(1e3*parseFloat(Q))},W:function(Y,Q){var pt=parseInt(Q);return new Date(Y.getFullYear(),0,2+7*(pt-1),0,0,0,0)},Y:function(Y,Q){Y.setFullYear(parseFloat(Q))},Z:function(Y,Q){return new Date(Q)},d:function(Y,Q){Y.setDate(parseFloat(Q))},h:function(Y,Q){Y.setHours(parseFloat(Q))},i:function(Y,Q){Y.setMinutes(parseFloat(Q))},j:function(Y,Q){Y.setDate(parseFloat(Q))},l:at,m:function(Y,Q){Y.setMonth(parseFloat(Q)-1)},n:function(Y,Q){Y.setMonth(parseFloat(Q)-1)},s:function(Y,Q){Y.setSeconds(parseFloat(Q))},w:at,y:function(Y,Q){Y.setFullYear(2e3+parseFloat(Q))}},ut={D:"(\\w+)",F:"(\\w+)",G:"(\\d\\d|\\d)",H:"(\\d\\d|\\d)",J:"(\\d\\d|\\d)\\w+",K:"",M:"(\\w+)",S:"(\\d\\d|\\d)",U:"(.+)",W:"(\\d\\d|\\d)",Y:"(\\d{4})",Z:"(.+)",d:"(\\d\\d|\\d)",h:"(\\d\\d|\\d)",i:"(\\d\\d|\\d)",j:"(\\d\\d|\\d)",l:"(\\w+)",m:"(\\d\\d|\\d)",n:"(\\d\\d|\\d)",s:"(\\d\\d|\\d)",w:"(\\d\\d|\\d)",y:"(\\d{2})"},vt={Z:function(Y){return Y.toISOString()},D:function(Y,Q,pt){return Q.weekdays.shorthand[vt.w(Y,Q,pt)]},F:function(Y,Q,pt){return ae(vt.n(Y,Q,pt)-1,!1,Q)},G:function(Y,Q,pt){return yt(vt.h(Y,Q,pt))},H:function(Y){return yt(Y.getHours())},J:function(Y,Q){return Q.ordinal!==void 0?Y.getDate()+Q.ordinal(Y.getDate()):Y.getDate()},K:function(Y,Q){return Q.amPM[Lt(Y.getHours()>11)]},M:function(Y,Q){return ae(Y.getMonth(),!0,Q)},S:function(Y){return yt(Y.getSeconds())},U:function(Y){return Y.getTime()/1e3},W:function(Y,Q,pt){return pt.getWeek(Y)},Y:function(Y){return Y.getFullYear()},d:function(Y){return yt(Y.getDate())},h:function(Y){return Y.getHours()%12?Y.getHours()%12:12},i:function(Y){return yt(Y.getMinutes())},j:function(Y){return Y.getDate()},l:function(Y,Q){return Q.weekdays.longhand[Y.getDay()]},m:function(Y){return yt(Y.getMonth()+1)},n:function(Y){return Y.getMonth()+1},s:function(Y){return Y.getSeconds()},w:function(Y){return Y.getDay()},y:function(Y){return String(Y.getFullYear()).substring(2)}};typeof Object.assign!="function"&&(Object.assign=function(Y){for(var Q=[],pt=1;pt<arguments.length;pt++)Q[pt-1]=arguments[pt];if(!Y)throw TypeError("Cannot convert undefined or null to object");for(var Xt=function(w){w&&Object.keys(w).forEach(function(Le){return Y[Le]=w[Le]})},ne=0,bt=Q;ne<bt.length;ne++)Xt(bt[ne]);return Y}),typeof HTMLElement!="undefined"&&(HTMLCollection.prototype.flatpickr=NodeList.prototype.flatpickr=function(Y){return Zt(this,Y)},HTMLElement.prototype.flatpickr=function(Y){return Zt([this],Y)});var Ct;return Ct=function(Y,Q){return Y instanceof NodeList?Zt(Y,Q):Zt(typeof Y=="string"?window.document.querySelectorAll(Y):[Y],Q)},typeof window=="object"&&(window.flatpickr=Ct),Ct.defaultConfig=Ht,Ct.l10ns={en:ft({},Ut),default:ft({},Ut)},Ct.localize=function(Y){Ct.l10ns.default=ft({},Ct.l10ns.default,Y)},Ct.setDefaults=function(Y){Ct.defaultConfig=ft({},Ct.defaultConfig,Y)},typeof jQuery!="undefined"&&(jQuery.fn.flatpickr=function(Y){return Zt(this,Y)}),Date.prototype.fp_incr=function(Y){return new Date(this.getFullYear(),this.getMonth(),this.getDate()+(typeof Y=="string"?parseInt(Y,10):Y))},Ct})},{}],5:[function(fe,pe,le){pe.exports=(function(st){function dt(B){if(H[B])return H[B].exports;var $=H[B]={i:B,l:!1,exports:{}};return st[B].call($.exports,$,$.exports,dt),$.l=!0,$.exports}var H={};return dt.m=st,dt.c=H,dt.i=function(B){return B},dt.d=function(B,$,mt){dt.o(B,$)||Object.defineProperty(B,$,{configurable:!1,enumerable:!0,get:mt})},dt.n=function(B){var $=B&&B.__esModule?function(){return B.default}:function(){return B};return dt.d($,"a",$),$},dt.o=function(B,$){return Object.prototype.hasOwnProperty.call(B,$)},dt.p="dist",dt(dt.s=6)})([function(st,dt,H){"use strict";Object.defineProperty(dt,"__esModule",{value:!0});var B=H(5),$=H(4),mt=(H.n($),/(^\d{1,4}[\.|\\\/|-]\d{1,2}[\.|\\\/|-]\d{1,4})(\s*(?:0?[1-9]:[0-5]|1(?=[012])\d:[0-5])\d\s*[ap]m)?$/),P=/\d{2}:\d{2}:\d{2} GMT-\d{4}/,Zt=/\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}.\d{3}Z/,ft=window.requestAnimationFrame||function(Ht){return Ht(),0},ae={hoverPreviewEnabled:!1,hoverPreviewArrayCount:100,hoverPreviewFieldCount:5,animateOpen:!0,animateClose:!0,theme:null},jt=(function(){function Ht(Ut,yt,Lt,nt){yt===void 0&&(yt=1),Lt===void 0&&(Lt=ae),this.json=Ut,this.open=yt,this.config=Lt,this.key=nt,this._isOpen=null,this.config.hoverPreviewEnabled===void 0&&(this.config.hoverPreviewEnabled=ae.hoverPreviewEnabled),this.config.hoverPreviewArrayCount===void 0&&(this.config.hoverPreviewArrayCount=ae.hoverPreviewArrayCount),this.config.hoverPreviewFieldCount===void 0&&(this.config.hoverPreviewFieldCount=ae.hoverPreviewFieldCount)}return Object.defineProperty(Ht.prototype,"isOpen",{get:function(){return this._isOpen!==null?this._isOpen:this.open>0},set:function(Ut){this._isOpen=Ut},enumerable:!0,configurable:!0}),Object.defineProperty(Ht.prototype,"isDate",{get:function(){return this.type==="string"&&(mt.test(this.json)||Zt.test(this.json)||P.test(this.json))},enumerable:!0,configurable:!0}),Object.defineProperty(Ht.prototype,"isUrl",{get:function(){return this.type==="string"&&this.json.indexOf("http")===0},enumerable:!0,configurable:!0}),Object.defineProperty(Ht.prototype,"isArray",{get:function(){return Array.isArray(this.json)},enumerable:!0,configurable:!0}),Object.defineProperty(Ht.prototype,"isObject",{get:function(){return H.i(B.a)(this.json)},enumerable:!0,configurable:!0}),Object.defineProperty(Ht.prototype,"isEmptyObject",{get:function(){return!this.keys.length&&!this.isArray},enumerable:!0,configurable:!0}),Object.defineProperty(Ht.prototype,"isEmpty",{get:function(){return this.isEmptyObject||this.keys&&!this.keys.length&&this.isArray},enumerable:!0,configurable:!0}),Object.defineProperty(Ht.prototype,"hasKey",{get:function(){return this.key!==void 0},enumerable:!0,configurable:!0}),Object.defineProperty(Ht.prototype,"constructorName",{get:function(){return H.i(B.b)(this.json)},enumerable:!0,configurable:!0}),Object.defineProperty(Ht.prototype,"type",{get:function(){return H.i(B.c)(this.json)},enumerable:!0,configurable:!0}),Object.defineProperty(Ht.prototype,"keys",{get:function(){return this.isObject?Object.keys(this.json).map(function(Ut){return Ut||'""'}):[]},enumerable:!0,configurable:!0}),Ht.prototype.toggleOpen=function(){this.isOpen=!this.isOpen,this.element&&(this.isOpen?this.appendChildren(this.config.animateOpen):this.removeChildren(this.config.animateClose),this.element.classList.toggle(H.i(B.d)("open")))},Ht.prototype.openAtDepth=function(Ut){Ut===void 0&&(Ut=1),Ut<0||(this.open=Ut,this.isOpen=Ut!==0,this.element&&(this.removeChildren(!1),Ut===0?this.element.classList.remove(H.i(B.d)("open")):(this.appendChildren(this.config.animateOpen),this.element.classList.add(H.i(B.d)("open")))))},Ht.prototype.getInlinepreview=function(){var Ut=this;if(this.isArray)return this.json.length>this.config.hoverPreviewArrayCount?"Array["+this.json.length+"]":"["+this.json.map(B.e).join(", ")+"]";var yt=this.keys,Lt=yt.slice(0,this.config.hoverPreviewFieldCount).map(function(at){return at+":"+H.i(B.e)(Ut.json[at])}),nt=yt.length>=this.config.hoverPreviewFieldCount?"\u2026":"";return"{"+Lt.join(", ")+nt+"}"},Ht.prototype.render=function(){this.element=H.i(B.f)("div","row");var Ut=H.i(B.f)("a","toggler-link");if(this.isObject&&Ut.appendChild(H.i(B.f)("span","toggler")),this.hasKey&&Ut.appendChild(H.i(B.f)("span","key",this.key+":")),this.isObject){var yt=H.i(B.f)("span","value"),Lt=H.i(B.f)("span"),nt=H.i(B.f)("span","constructor-name",this.constructorName);if(Lt.appendChild(nt),this.isArray){var at=H.i(B.f)("span");at.appendChild(H.i(B.f)("span","bracket","[")),at.appendChild(H.i(B.f)("span","number",this.json.length)),at.appendChild(H.i(B.f)("span","bracket","]")),Lt.appendChild(at)}yt.appendChild(Lt),Ut.appendChild(yt)}else{(yt=this.isUrl?H.i(B.f)("a"):H.i(B.f)("span")).classList.add(H.i(B.d)(this.type)),this.isDate&&yt.classList.add(H.i(B.d)("date")),this.isUrl&&(yt.classList.add(H.i(B.d)("url")),yt.setAttribute("href",this.json));var K=H.i(B.g)(this.json,this.json);yt.appendChild(document.createTextNode(K)),Ut.appendChild(yt)}if(this.isObject&&this.config.hoverPreviewEnabled){var ut=H.i(B.f)("span","preview-text");ut.appendChild(document.createTextNode(this.getInlinepreview())),Ut.appendChild(ut)}var vt=H.i(B.f)("div","children");return this.isObject&&vt.classList.add(H.i(B.d)("object")),this.isArray&&vt.classList.add(H.i(B.d)("array")),this.isEmpty&&vt.classList.add(H.i(B.d)("empty")),this.config&&this.config.theme&&this.element.classList.add(H.i(B.d)(this.config.theme)),this.isOpen&&this.element.classList.add(H.i(B.d)("open")),this.element.appendChild(Ut),this.element.appendChild(vt),this.isObject&&this.isOpen&&this.appendChildren(),this.isObject&&Ut.addEventListener("click",this.toggleOpen.bind(this)),this.element},Ht.prototype.appendChildren=function(Ut){var yt=this;Ut===void 0&&(Ut=!1);var Lt=this.element.querySelector("div."+H.i(B.d)("children"));if(Lt&&!this.isEmpty)if(Ut){var nt=0,at=function(){var K=yt.keys[nt],ut=new Ht(yt.json[K],yt.open-1,yt.config,K);Lt.appendChild(ut.render()),(nt+=1)<yt.keys.length&&(nt>10?at():ft(at))};ft(at)}else this.keys.forEach(function(K){var ut=new Ht(yt.json[K],yt.open-1,yt.config,K);Lt.appendChild(ut.render())})},Ht.prototype.removeChildren=function(Ut){Ut===void 0&&(Ut=!1);var yt=this.element.querySelector("div."+H.i(B.d)("children"));if(Ut){var Lt=0,nt=function(){yt&&yt.children.length&&(yt.removeChild(yt.children[0]),(Lt+=1)>10?nt():ft(nt))};ft(nt)}else yt&&(yt.innerHTML="")},Ht})();dt.default=jt},function(st,dt,H){(st.exports=H(2)()).push([st.i,`.json-formatter-row {
+  font-family: monospace;
+}
+.json-formatter-row,
+.json-formatter-row a,
+.json-formatter-row a:hover {
+  color: black;
+  text-decoration: none;
+}
+.json-formatter-row .json-formatter-row {
+  margin-left: 1rem;
+}
+.json-formatter-row .json-formatter-children.json-formatter-empty {
+  opacity: 0.5;
+  margin-left: 1rem;
+}
+.json-formatter-row .json-formatter-children.json-formatter-empty:after {
+  display: none;
+}
+.json-formatter-row .json-formatter-children.json-formatter-empty.json-formatter-object:after {
+  content: "No properties";
+}
+.json-formatter-row .json-formatter-children.json-formatter-empty.json-formatter-array:after {
+  content: "[]";
+}
+.json-formatter-row .json-formatter-string {
+  color: green;
+  white-space: pre;
+  word-wrap: break-word;
+}
+.json-formatter-row .json-formatter-number {
+  color: blue;
+}
+.json-formatter-row .json-formatter-boolean {
+  color: red;
+}
+.json-formatter-row .json-formatter-null {
+  color: #855A00;
+}
+.json-formatter-row .json-formatter-undefined {
+  color: #ca0b69;
+}
+.json-formatter-row .json-formatter-function {
+  color: #FF20ED;
+}
+.json-formatter-row .json-formatter-date {
+  background-color: rgba(0, 0, 0, 0.05);
+}
+.json-formatter-row .json-formatter-url {
+  text-decoration: underline;
+  color: blue;
+  cursor: pointer;
+}
+.json-formatter-row .json-formatter-bracket {
+  color: blue;
+}
+.json-formatter-row .json-formatter-key {
+  color: #00008B;
+  cursor: pointer;
+  padding-right: 0.2rem;
+}
+.json-formatter-row .json-formatter-constructor-name {
+  cursor: pointer;
+}
+.json-formatter-row .json-formatter-toggler {
+  line-height: 1.2rem;
+  font-size: 0.7rem;
+  vertical-align: middle;
+  opacity: 0.6;
+  cursor: pointer;
+  padding-right: 0.2rem;
+}
+.json-formatter-row .json-formatter-toggler:after {
+  display: inline-block;
+  transition: transform 100ms ease-in;
+  content: "\\25BA";
+}
+.json-formatter-row > a > .json-formatter-preview-text {
+  opacity: 0;
+  transition: opacity 0.15s ease-in;
+  font-style: italic;
+}
+.json-formatter-row:hover > a > .json-formatter-preview-text {
+  opacity: 0.6;
+}
+.json-formatter-row.json-formatter-open > .json-formatter-toggler-link .json-formatter-toggler:after {
+  transform: rotate(90deg);
+}
+.json-formatter-row.json-formatter-open > .json-formatter-children:after {
+  display: inline-block;
+}
+.json-formatter-row.json-formatter-open > a > .json-formatter-preview-text {
+  display: none;
+}
+.json-formatter-row.json-formatter-open.json-formatter-empty:after {
+  display: block;
+}
+.json-formatter-dark.json-formatter-row {
+  font-family: monospace;
+}
+.json-formatter-dark.json-formatter-row,
+.json-formatter-dark.json-formatter-row a,
+.json-formatter-dark.json-formatter-row a:hover {
+  color: white;
+  text-decoration: none;
+}
+.json-formatter-dark.json-formatter-row .json-formatter-row {
+  margin-left: 1rem;
+}
+.json-formatter-dark.json-formatter-row .json-formatter-children.json-formatter-empty {
+  opacity: 0.5;
+  margin-left: 1rem;
+}
+.json-formatter-dark.json-formatter-row .json-formatter-children.json-formatter-empty:after {
+  display: none;
+}
+.json-formatter-dark.json-formatter-row .json-formatter-children.json-formatter-empty.json-formatter-object:after {
+  content: "No properties";
+}
+.json-formatter-dark.json-formatter-row .json-formatter-children.json-formatter-empty.json-formatter-array:after {
+  content: "[]";
+}
+.json-formatter-dark.json-formatter-row .json-formatter-string {
+  color: #31F031;
+  white-space: pre;
+  word-wrap: break-word;
+}
+.json-formatter-dark.json-formatter-row .json-formatter-number {
+  color: #66C2FF;
+}
+.json-formatter-dark.json-formatter-row .json-formatter-boolean {
+  color: #EC4242;
+}
+.json-formatter-dark.json-formatter-row .json-formatter-null {
+  color: #EEC97D;
+}
+.json-formatter-dark.json-formatter-row .json-formatter-undefined {
+  color: #ef8fbe;
+}
+.json-formatter-dark.json-formatter-row .json-formatter-function {
+  color: #FD48CB;
+}
+.json-formatter-dark.json-formatter-row .json-formatter-date {
+  background-color: rgba(255, 255, 255, 0.05);
+}
+.json-formatter-dark.json-formatter-row .json-formatter-url {
+  text-decoration: underline;
+  color: #027BFF;
+  cursor: pointer;
+}
+.json-formatter-dark.json-formatter-row .json-formatter-bracket {
+  color: #9494FF;
+}
+.json-formatter-dark.json-formatter-row .json-formatter-key {
+  color: #23A0DB;
+  cursor: pointer;
+  padding-right: 0.2rem;
+}
+.json-formatter-dark.json-formatter-row .json-formatter-constructor-name {
+  cursor: pointer;
+}
+.json-formatter-dark.json-formatter-row .json-formatter-toggler {
+  line-height: 1.2rem;
+  font-size: 0.7rem;
+  vertical-align: middle;
+  opacity: 0.6;
+  cursor: pointer;
+  padding-right: 0.2rem;
+}
+.json-formatter-dark.json-formatter-row .json-formatter-toggler:after {
+  display: inline-block;
+  transition: transform 100ms ease-in;
+  content: "\\25BA";
+}
+.json-formatter-dark.json-formatter-row > a > .json-formatter-preview-text {
+  opacity: 0;
+  transition: opacity 0.15s ease-in;
+  font-style: italic;
+}
+.json-formatter-dark.json-formatter-row:hover > a > .json-formatter-preview-text {
+  opacity: 0.6;
+}
+.json-formatter-dark.json-formatter-row.json-formatter-open > .json-formatter-toggler-link .json-formatter-toggler:after {
+  transform: rotate(90deg);
+}
+.json-formatter-dark.json-formatter-row.json-formatter-open > .json-formatter-children:after {
+  display: inline-block;
+}
+.json-formatter-dark.json-formatter-row.json-formatter-open > a > .json-formatter-preview-text {
+  display: none;
+}
+.json-formatter-dark.json-formatter-row.json-formatter-open.json-formatter-empty:after {
+  display: block;
+}
+`,""])},function(st,dt){st.exports=function(){var H=[];return H.toString=function(){for(var B=[],$=0;$<this.length;$++){var mt=this[$];mt[2]?B.push("@media "+mt[2]+"{"+mt[1]+"}"):B.push(mt[1])}return B.join("")},H.i=function(B,$){typeof B=="string"&&(B=[[null,B,""]]);for(var mt={},P=0;P<this.length;P++){var Zt=this[P][0];typeof Zt=="number"&&(mt[Zt]=!0)}for(P=0;P<B.length;P++){var ft=B[P];typeof ft[0]=="number"&&mt[ft[0]]||($&&!ft[2]?ft[2]=$:$&&(ft[2]="("+ft[2]+") and ("+$+")"),H.push(ft))}},H}},function(st,dt){function H(K,ut){for(var vt=0;vt<K.length;vt++){var Ct=K[vt],Y=ae[Ct.id];if(Y){Y.refs++;for(var Q=0;Q<Y.parts.length;Q++)Y.parts[Q](Ct.parts[Q]);for(;Q<Ct.parts.length;Q++)Y.parts.push(Zt(Ct.parts[Q],ut))}else{var pt=[];for(Q=0;Q<Ct.parts.length;Q++)pt.push(Zt(Ct.parts[Q],ut));ae[Ct.id]={id:Ct.id,refs:1,parts:pt}}}}function B(K){for(var ut=[],vt={},Ct=0;Ct<K.length;Ct++){var Y=K[Ct],Q=Y[0],pt={css:Y[1],media:Y[2],sourceMap:Y[3]};vt[Q]?vt[Q].parts.push(pt):ut.push(vt[Q]={id:Q,parts:[pt]})}return ut}function $(K,ut){var vt=Ut(),Ct=nt[nt.length-1];if(K.insertAt==="top")Ct?Ct.nextSibling?vt.insertBefore(ut,Ct.nextSibling):vt.appendChild(ut):vt.insertBefore(ut,vt.firstChild),nt.push(ut);else{if(K.insertAt!=="bottom")throw new Error("Invalid value for parameter 'insertAt'. Must be 'top' or 'bottom'.");vt.appendChild(ut)}}function mt(K){K.parentNode.removeChild(K);var ut=nt.indexOf(K);ut>=0&&nt.splice(ut,1)}function P(K){var ut=document.createElement("style");return ut.type="text/css",$(K,ut),ut}function Zt(K,ut){var vt,Ct,Y;if(ut.singleton){var Q=Lt++;vt=yt||(yt=P(ut)),Ct=ft.bind(null,vt,Q,!1),Y=ft.bind(null,vt,Q,!0)}else K.sourceMap&&typeof URL=="function"&&typeof URL.createObjectURL=="function"&&typeof URL.revokeObjectURL=="function"&&typeof Blob=="function"&&typeof btoa=="function"?(vt=(function(pt){var Xt=document.createElement("link");return Xt.rel="stylesheet",$(pt,Xt),Xt})(ut),Ct=function(pt,Xt){var ne=Xt.css,bt=Xt.sourceMap;bt&&(ne+=`
+/*# sourceMappingURL=data:application/json;base64,`+btoa(unescape(encodeURIComponent(JSON.stringify(bt))))+" */");var w=new Blob([ne],{type:"text/css"}),Le=pt.href;pt.href=URL.createObjectURL(w),Le&&URL.revokeObjectURL(Le)}.bind(null,vt),Y=function(){mt(vt),vt.href&&URL.revokeObjectURL(vt.href)}):(vt=P(ut),Ct=function(pt,Xt){var ne=Xt.css,bt=Xt.media;if(bt&&pt.setAttribute("media",bt),pt.styleSheet)pt.styleSheet.cssText=ne;else{for(;pt.firstChild;)pt.removeChild(pt.firstChild);pt.appendChild(document.createTextNode(ne))}}.bind(null,vt),Y=function(){mt(vt)});return Ct(K),function(pt){if(pt){if(pt.css===K.css&&pt.media===K.media&&pt.sourceMap===K.sourceMap)return;Ct(K=pt)}else Y()}}function ft(K,ut,vt,Ct){var Y=vt?"":Ct.css;if(K.styleSheet)K.styleSheet.cssText=at(ut,Y);else{var Q=document.createTextNode(Y),pt=K.childNodes;pt[ut]&&K.removeChild(pt[ut]),pt.length?K.insertBefore(Q,pt[ut]):K.appendChild(Q)}}var ae={},jt=function(K){var ut;return function(){return ut===void 0&&(ut=K.apply(this,arguments)),ut}},Ht=jt(function(){return/msie [6-9]\b/.test(window.navigator.userAgent.toLowerCase())}),Ut=jt(function(){return document.head||document.getElementsByTagName("head")[0]}),yt=null,Lt=0,nt=[];st.exports=function(K,ut){if(typeof DEBUG!="undefined"&&DEBUG&&typeof document!="object")throw new Error("The style-loader cannot be used in a non-browser environment");(ut=ut||{}).singleton===void 0&&(ut.singleton=Ht()),ut.insertAt===void 0&&(ut.insertAt="bottom");var vt=B(K);return H(vt,ut),function(Ct){for(var Y=[],Q=0;Q<vt.length;Q++){var pt=vt[Q];(Xt=ae[pt.id]).refs--,Y.push(Xt)}for(Ct&&H(B(Ct),ut),Q=0;Q<Y.length;Q++){var Xt;if((Xt=Y[Q]).refs===0){for(var ne=0;ne<Xt.parts.length;ne++)Xt.parts[ne]();delete ae[Xt.id]}}}};var at=(function(){var K=[];return function(ut,vt){return K[ut]=vt,K.filter(Boolean).join(`
+`)}})()},function(st,dt,H){var B=H(1);typeof B=="string"&&(B=[[st.i,B,""]]),H(3)(B,{}),B.locals&&(st.exports=B.locals)},function(st,dt,H){"use strict";function B(ft){return!!ft&&typeof ft=="object"}function $(ft){if(ft===void 0)return"";if(ft===null||typeof ft=="object"&&!ft.constructor)return"Object";var ae=/function ([^(]*)/.exec(ft.constructor.toString());return ae&&ae.length>1?ae[1]:""}function mt(ft){return ft===null?"null":typeof ft}function P(ft,ae){var jt=mt(ft);return jt==="null"||jt==="undefined"?jt:(jt==="string"&&(ae='"'+(function(Ht){return Ht.replace('"','"')})(ae)+'"'),jt==="function"?ft.toString().replace(/[\r\n]/g,"").replace(/\{.*\}/,"")+"{\u2026}":ae)}function Zt(ft){return"json-formatter-"+ft}dt.a=B,dt.b=$,dt.c=mt,dt.g=P,dt.e=function(ft){var ae="";return B(ft)?(ae=$(ft),Array.isArray(ft)&&(ae+="["+ft.length+"]")):ae=P(ft,ft),ae},dt.d=Zt,dt.f=function(ft,ae,jt){var Ht=document.createElement(ft);return ae&&Ht.classList.add(Zt(ae)),jt!==void 0&&(jt instanceof Node?Ht.appendChild(jt):Ht.appendChild(document.createTextNode(String(jt)))),Ht}},function(st,dt,H){st.exports=H(0)}])},{}],6:[function(fe,pe,le){function st(nt){if(!jt(nt))return nt;var at=[];for(var K in nt)dt(at,K,nt[K]);return at.join("&")}function dt(nt,at,K){if(K!=null)if(Array.isArray(K))K.forEach(function(vt){dt(nt,at,vt)});else if(jt(K))for(var ut in K)dt(nt,at+"["+ut+"]",K[ut]);else nt.push(encodeURIComponent(at)+"="+encodeURIComponent(K));else K===null&&nt.push(encodeURIComponent(at))}function H(nt){for(var at,K,ut={},vt=nt.split("&"),Ct=0,Y=vt.length;Ct<Y;++Ct)(K=(at=vt[Ct]).indexOf("="))==-1?ut[decodeURIComponent(at)]="":ut[decodeURIComponent(at.slice(0,K))]=decodeURIComponent(at.slice(K+1));return ut}function B(nt){return/[\/+]json\b/.test(nt)}function $(nt){this.req=nt,this.xhr=this.req.xhr,this.text=this.req.method!="HEAD"&&(this.xhr.responseType===""||this.xhr.responseType==="text")||this.xhr.responseType===void 0?this.xhr.responseText:null,this.statusText=this.req.xhr.statusText;var at=this.xhr.status;at===1223&&(at=204),this._setStatusProperties(at),this.header=this.headers=(function(K){var ut,vt,Ct,Y,Q=K.split(/\r?\n/),pt={};Q.pop();for(var Xt=0,ne=Q.length;Xt<ne;++Xt)ut=(vt=Q[Xt]).indexOf(":"),Ct=vt.slice(0,ut).toLowerCase(),Y=Lt(vt.slice(ut+1)),pt[Ct]=Y;return pt})(this.xhr.getAllResponseHeaders()),this.header["content-type"]=this.xhr.getResponseHeader("content-type"),this._setHeaderProperties(this.header),this.text===null&&nt._responseType?this.body=this.xhr.response:this.body=this.req.method!="HEAD"?this._parseBody(this.text?this.text:this.xhr.response):null}function mt(nt,at){var K=this;this._query=this._query||[],this.method=nt,this.url=at,this.header={},this._header={},this.on("end",function(){var ut=null,vt=null;try{vt=new $(K)}catch(Y){return ut=new Error("Parser is unable to parse the response"),ut.parse=!0,ut.original=Y,K.xhr?(ut.rawResponse=K.xhr.responseType===void 0?K.xhr.responseText:K.xhr.response,ut.status=K.xhr.status?K.xhr.status:null,ut.statusCode=ut.status):(ut.rawResponse=null,ut.status=null),K.callback(ut)}K.emit("response",vt);var Ct;try{K._isResponseOK(vt)||((Ct=new Error(vt.statusText||"Unsuccessful HTTP response")).original=ut,Ct.response=vt,Ct.status=vt.status)}catch(Y){Ct=Y}Ct?K.callback(Ct,vt):K.callback(null,vt)})}function P(nt,at,K){var ut=yt("DELETE",nt);return typeof at=="function"&&(K=at,at=null),at&&ut.send(at),K&&ut.end(K),ut}var Zt;typeof window!="undefined"?Zt=window:typeof self!="undefined"?Zt=self:(console.warn("Using browser-only version of superagent in non-browser environment"),Zt=this);var ft=fe("component-emitter"),ae=fe("./request-base"),jt=fe("./is-object"),Ht=fe("./response-base"),Ut=fe("./should-retry"),yt=le=pe.exports=function(nt,at){return typeof at=="function"?new le.Request("GET",nt).end(at):arguments.length==1?new le.Request("GET",nt):new le.Request(nt,at)};le.Request=mt,yt.getXHR=function(){if(!(!Zt.XMLHttpRequest||Zt.location&&Zt.location.protocol=="file:"&&Zt.ActiveXObject))return new XMLHttpRequest;try{return new ActiveXObject("Microsoft.XMLHTTP")}catch(nt){}try{return new ActiveXObject("Msxml2.XMLHTTP.6.0")}catch(nt){}try{return new ActiveXObject("Msxml2.XMLHTTP.3.0")}catch(nt){}try{return new ActiveXObject("Msxml2.XMLHTTP")}catch(nt){}throw Error("Browser-only version of superagent could not find XHR")};var Lt="".trim?function(nt){return nt.trim()}:function(nt){return nt.replace(/(^\s*|\s*$)/g,"")};yt.serializeObject=st,yt.parseString=H,yt.types={html:"text/html",json:"application/json",xml:"text/xml",urlencoded:"application/x-www-form-urlencoded",form:"application/x-www-form-urlencoded","form-data":"application/x-www-form-urlencoded"},yt.serialize={"application/x-www-form-urlencoded":st,"application/json":JSON.stringify},yt.parse={"application/x-www-form-urlencoded":H,"application/json":JSON.parse},Ht($.prototype),$.prototype._parseBody=function(nt){var at=yt.parse[this.type];return this.req._parser?this.req._parser(this,nt):(!at&&B(this.type)&&(at=yt.parse["application/json"]),at&&nt&&(nt.length||nt instanceof Object)?at(nt):null)},$.prototype.toError=function(){var nt=this.req,at=nt.method,K=nt.url,ut="cannot "+at+" "+K+" ("+this.status+")",vt=new Error(ut);return vt.status=this.status,vt.method=at,vt.url=K,vt},yt.Response=$,ft(mt.prototype),ae(mt.prototype),mt.prototype.type=function(nt){return this.set("Content-Type",yt.types[nt]||nt),this},mt.prototype.accept=function(nt){return this.set("Accept",yt.types[nt]||nt),this},mt.prototype.auth=function(nt,at,K){switch(typeof at=="object"&&at!==null&&(K=at),K||(K={type:typeof btoa=="function"?"basic":"auto"}),K.type){case"basic":this.set("Authorization","Basic "+btoa(nt+":"+at));break;case"auto":this.username=nt,this.password=at;break;case"bearer":this.set("Authorization","Bearer "+nt)}return this},mt.prototype.query=function(nt){return typeof nt!="string"&&(nt=st(nt)),nt&&this._query.push(nt),this},mt.prototype.attach=function(nt,at,K){if(at){if(this._data)throw Error("superagent can't mix .send() and .attach()");this._getFormData().append(nt,at,K||at.name)}return this},mt.prototype._getFormData=function(){return this._formData||(this._formData=new Zt.FormData),this._formData},mt.prototype.callback=function(nt,at){if(this._maxRetries&&this._retries++<this._maxRetries&&Ut(nt,at))return this._retry();var K=this._callback;this.clearTimeout(),nt&&(this._maxRetries&&(nt.retries=this._retries-1),this.emit("error",nt)),K(nt,at)},mt.prototype.crossDomainError=function(){var nt=new Error(`Request has been terminated
+Possible causes: the network is offline, Origin is not allowed by Access-Control-Allow-Origin, the page is being unloaded, etc.`);nt.crossDomain=!0,nt.status=this.status,nt.method=this.method,nt.url=this.url,this.callback(nt)},mt.prototype.buffer=mt.prototype.ca=mt.prototype.agent=function(){return console.warn("This is not supported in browser version of superagent"),this},mt.prototype.pipe=mt.prototype.write=function(){throw Error("Streaming is not supported in browser version of superagent")},mt.prototype._isHost=function(nt){return nt&&typeof nt=="object"&&!Array.isArray(nt)&&Object.prototype.toString.call(nt)!=="[object Object]"},mt.prototype.end=function(nt){return this._endCalled&&console.warn("Warning: .end() was called twice. This is not supported in superagent"),this._endCalled=!0,this._callback=nt||function(){},this._finalizeQueryString(),this._end()},mt.prototype._end=function(){var nt=this,at=this.xhr=yt.getXHR(),K=this._formData||this._data;this._setTimeouts(),at.onreadystatechange=function(){var Q=at.readyState;if(Q>=2&&nt._responseTimeoutTimer&&clearTimeout(nt._responseTimeoutTimer),Q==4){var pt;try{pt=at.status}catch(Xt){pt=0}if(!pt)return nt.timedout||nt._aborted?void 0:nt.crossDomainError();nt.emit("end")}};var ut=function(Q,pt){pt.total>0&&(pt.percent=pt.loaded/pt.total*100),pt.direction=Q,nt.emit("progress",pt)};if(this.hasListeners("progress"))try{at.onprogress=ut.bind(null,"download"),at.upload&&(at.upload.onprogress=ut.bind(null,"upload"))}catch(Q){}try{this.username&&this.password?at.open(this.method,this.url,!0,this.username,this.password):at.open(this.method,this.url,!0)}catch(Q){return this.callback(Q)}if(this._withCredentials&&(at.withCredentials=!0),!this._formData&&this.method!="GET"&&this.method!="HEAD"&&typeof K!="string"&&!this._isHost(K)){var vt=this._header["content-type"],Ct=this._serializer||yt.serialize[vt?vt.split(";")[0]:""];!Ct&&B(vt)&&(Ct=yt.serialize["application/json"]),Ct&&(K=Ct(K))}for(var Y in this.header)this.header[Y]!=null&&this.header.hasOwnProperty(Y)&&at.setRequestHeader(Y,this.header[Y]);return this._responseType&&(at.responseType=this._responseType),this.emit("request",this),at.send(K!==void 0?K:null),this},yt.get=function(nt,at,K){var ut=yt("GET",nt);return typeof at=="function"&&(K=at,at=null),at&&ut.query(at),K&&ut.end(K),ut},yt.head=function(nt,at,K){var ut=yt("HEAD",nt);return typeof at=="function"&&(K=at,at=null),at&&ut.query(at),K&&ut.end(K),ut},yt.options=function(nt,at,K){var ut=yt("OPTIONS",nt);return typeof at=="function"&&(K=at,at=null),at&&ut.send(at),K&&ut.end(K),ut},yt.del=P,yt.delete=P,yt.patch=function(nt,at,K){var ut=yt("PATCH",nt);return typeof at=="function"&&(K=at,at=null),at&&ut.send(at),K&&ut.end(K),ut},yt.post=function(nt,at,K){var ut=yt("POST",nt);return typeof at=="function"&&(K=at,at=null),at&&ut.send(at),K&&ut.end(K),ut},yt.put=function(nt,at,K){var ut=yt("PUT",nt);return typeof at=="function"&&(K=at,at=null),at&&ut.send(at),K&&ut.end(K),ut}},{"./is-object":7,"./request-base":8,"./response-base":9,"./should-retry":10,"component-emitter":2}],7:[function(fe,pe,le){pe.exports=function(st){return st!==null&&typeof st=="object"}},{}],8:[function(fe,pe,le){function st(H){if(H)return(function(B){for(var $ in st.prototype)B[$]=st.prototype[$];return B})(H)}var dt=fe("./is-object");pe.exports=st,st.prototype.clearTimeout=function(){return clearTimeout(this._timer),clearTimeout(this._responseTimeoutTimer),delete this._timer,delete this._responseTimeoutTimer,this},st.prototype.parse=function(H){return this._parser=H,this},st.prototype.responseType=function(H){return this._responseType=H,this},st.prototype.serialize=function(H){return this._serializer=H,this},st.prototype.timeout=function(H){if(!H||typeof H!="object")return this._timeout=H,this._responseTimeout=0,this;for(var B in H)switch(B){case"deadline":this._timeout=H.deadline;break;case"response":this._responseTimeout=H.response;break;default:console.warn("Unknown timeout option",B)}return this},st.prototype.retry=function(H){return arguments.length!==0&&H!==!0||(H=1),H<=0&&(H=0),this._maxRetries=H,this._retries=0,this},st.prototype._retry=function(){return this.clearTimeout(),this.req&&(this.req=null,this.req=this.request()),this._aborted=!1,this.timedout=!1,this._end()},st.prototype.then=function(H,B){if(!this._fullfilledPromise){var $=this;this._endCalled&&console.warn("Warning: superagent request was sent twice, because both .end() and .then() were called. Never call .end() if you use promises"),this._fullfilledPromise=new Promise(function(mt,P){$.end(function(Zt,ft){Zt?P(Zt):mt(ft)})})}return this._fullfilledPromise.then(H,B)},st.prototype.catch=function(H){return this.then(void 0,H)},st.prototype.use=function(H){return H(this),this},st.prototype.ok=function(H){if(typeof H!="function")throw Error("Callback required");return this._okCallback=H,this},st.prototype._isResponseOK=function(H){return!!H&&(this._okCallback?this._okCallback(H):H.status>=200&&H.status<300)},st.prototype.get=function(H){return this._header[H.toLowerCase()]},st.prototype.getHeader=st.prototype.get,st.prototype.set=function(H,B){if(dt(H)){for(var $ in H)this.set($,H[$]);return this}return this._header[H.toLowerCase()]=B,this.header[H]=B,this},st.prototype.unset=function(H){return delete this._header[H.toLowerCase()],delete this.header[H],this},st.prototype.field=function(H,B){if(H==null)throw new Error(".field(name, val) name can not be empty");if(this._data&&console.error(".field() can't be used if .send() is used. Please use only .send() or only .field() & .attach()"),dt(H)){for(var $ in H)this.field($,H[$]);return this}if(Array.isArray(B)){for(var mt in B)this.field(H,B[mt]);return this}if(B==null)throw new Error(".field(name, val) val can not be empty");return typeof B=="boolean"&&(B=""+B),this._getFormData().append(H,B),this},st.prototype.abort=function(){return this._aborted?this:(this._aborted=!0,this.xhr&&this.xhr.abort(),this.req&&this.req.abort(),this.clearTimeout(),this.emit("abort"),this)},st.prototype.withCredentials=function(H){return H==null&&(H=!0),this._withCredentials=H,this},st.prototype.redirects=function(H){return this._maxRedirects=H,this},st.prototype.toJSON=function(){return{method:this.method,url:this.url,data:this._data,headers:this._header}},st.prototype.send=function(H){var B=dt(H),$=this._header["content-type"];if(this._formData&&console.error(".send() can't be used if .attach() or .field() is used. Please use only .send() or only .field() & .attach()"),B&&!this._data)Array.isArray(H)?this._data=[]:this._isHost(H)||(this._data={});else if(H&&this._data&&this._isHost(this._data))throw Error("Can't merge these send calls");if(B&&dt(this._data))for(var mt in H)this._data[mt]=H[mt];else typeof H=="string"?($||this.type("form"),$=this._header["content-type"],this._data=$=="application/x-www-form-urlencoded"?this._data?this._data+"&"+H:H:(this._data||"")+H):this._data=H;return!B||this._isHost(H)?this:($||this.type("json"),this)},st.prototype.sortQuery=function(H){return this._sort=H===void 0||H,this},st.prototype._finalizeQueryString=function(){var H=this._query.join("&");if(H&&(this.url+=(this.url.indexOf("?")>=0?"&":"?")+H),this._query.length=0,this._sort){var B=this.url.indexOf("?");if(B>=0){var $=this.url.substring(B+1).split("&");typeof this._sort=="function"?$.sort(this._sort):$.sort(),this.url=this.url.substring(0,B)+"?"+$.join("&")}}},st.prototype._appendQueryString=function(){console.trace("Unsupported")},st.prototype._timeoutError=function(H,B,$){if(!this._aborted){var mt=new Error(H+B+"ms exceeded");mt.timeout=B,mt.code="ECONNABORTED",mt.errno=$,this.timedout=!0,this.abort(),this.callback(mt)}},st.prototype._setTimeouts=function(){var H=this;this._timeout&&!this._timer&&(this._timer=setTimeout(function(){H._timeoutError("Timeout of ",H._timeout,"ETIME")},this._timeout)),this._responseTimeout&&!this._responseTimeoutTimer&&(this._responseTimeoutTimer=setTimeout(function(){H._timeoutError("Response timeout of ",H._responseTimeout,"ETIMEDOUT")},this._responseTimeout))}},{"./is-object":7}],9:[function(fe,pe,le){function st(H){if(H)return(function(B){for(var $ in st.prototype)B[$]=st.prototype[$];return B})(H)}var dt=fe("./utils");pe.exports=st,st.prototype.get=function(H){return this.header[H.toLowerCase()]},st.prototype._setHeaderProperties=function(H){var B=H["content-type"]||"";this.type=dt.type(B);var $=dt.params(B);for(var mt in $)this[mt]=$[mt];this.links={};try{H.link&&(this.links=dt.parseLinks(H.link))}catch(P){}},st.prototype._setStatusProperties=function(H){var B=H/100|0;this.status=this.statusCode=H,this.statusType=B,this.info=B==1,this.ok=B==2,this.redirect=B==3,this.clientError=B==4,this.serverError=B==5,this.error=(B==4||B==5)&&this.toError(),this.accepted=H==202,this.noContent=H==204,this.badRequest=H==400,this.unauthorized=H==401,this.notAcceptable=H==406,this.forbidden=H==403,this.notFound=H==404}},{"./utils":11}],10:[function(fe,pe,le){var st=["ECONNRESET","ETIMEDOUT","EADDRINFO","ESOCKETTIMEDOUT"];pe.exports=function(dt,H){return!!(dt&&dt.code&&~st.indexOf(dt.code))||!!(H&&H.status&&H.status>=500)||!!(dt&&"timeout"in dt&&dt.code=="ECONNABORTED")||!!(dt&&"crossDomain"in dt)}},{}],11:[function(fe,pe,le){le.type=function(st){return st.split(/ *; */).shift()},le.params=function(st){return st.split(/ *; */).reduce(function(dt,H){var B=H.split(/ *= */),$=B.shift(),mt=B.shift();return $&&mt&&(dt[$]=mt),dt},{})},le.parseLinks=function(st){return st.split(/ *, */).reduce(function(dt,H){var B=H.split(/ *; */),$=B[0].slice(1,-1);return dt[B[1].split(/ *= */)[1].slice(1,-1)]=$,dt},{})},le.cleanHeader=function(st,dt){return delete st["content-type"],delete st["content-length"],delete st["transfer-encoding"],delete st.host,dt&&delete st.cookie,st}},{}],12:[function(fe,pe,le){"use strict";Object.defineProperty(le,"__esModule",{value:!0});var st=Object.defineProperty,dt=(j,tt,Ot)=>tt in j?st(j,tt,{enumerable:!0,configurable:!0,writable:!0,value:Ot}):j[tt]=Ot,H=(j,tt,Ot)=>dt(j,typeof tt!="symbol"?tt+"":tt,Ot),B=(j,tt,Ot)=>new Promise((xt,St)=>{var re=te=>{try{oe(Ot.next(te))}catch(Ft){St(Ft)}},zt=te=>{try{oe(Ot.throw(te))}catch(Ft){St(Ft)}},oe=te=>te.done?xt(te.value):Promise.resolve(te.value).then(re,zt);oe((Ot=Ot.apply(j,tt)).next())});const $=fe("superagent"),mt=fe("c3"),P=fe("flatpickr"),Zt=fe("json-formatter-js").default,{t:ft}=fe("./i18n"),{csrfToken:ae}=fe("./csrf"),{apiToken:jt}=fe("./token");var Ht=(j=>(j.Week="week",j.Month="month",j.All="all",j))(Ht||{});function Ut(j){var tt;if(j==null||typeof j!="object")return j;if(j instanceof Date)return tt=new Date,tt.setTime(j.getTime()),tt;if(j instanceof Array){tt=[];for(var Ot=0,xt=j.length;Ot<xt;Ot++)tt[Ot]=Ut(j[Ot]);return tt}if(j instanceof Object){tt={};for(var St in j)j.hasOwnProperty(St)&&(tt[St]=Ut(j[St]));return tt}throw new Error("Unable to copy obj! Its type isn't supported.")}class yt{constructor(){H(this,"_datePtn",/^([0-9]{4}-[0-9]{2}-[0-9]{2})T([0-9]{2}:[0-9]{2}:[0-9]{2})\+[0-9]{2}:[0-9]{2}$/)}get token(){return jt()}get datePtn(){return this._datePtn}AddTag(){let Ot=document.getElementById("tag_name").value;$.post("/api/v1/tags").set("Content-Type","application/json").set("Authorization",`Bearer ${jt()}`).set("X-CSRF-Token",ae()).send({tag_name:Ot}).end(function(xt,St){xt?St&&St.body&&St.body.reason?(alert(`${St.body.reason}`),window.location.reload(!0)):alert(ft("js.http_error",xt)):(alert(ft("js.tag_added")),window.location.reload(!0))})}DataFetch(tt){return $.get(`/api/v1/tags/${encodeURIComponent(tt.Tag)}/data`).set("Authorization",`Bearer ${jt()}`).ok(Ot=>Ot.status<500).query({page:tt.Page,span:tt.Span,limit:tt.Limit,start_at:tt.StartAt,end_at:tt.EndAt})}Graph(tt,Ot){let xt=Ot.map(te=>{let Ft=JSON.parse(te.payload),ye=te.updated_at;return Ft.date=ye.replace(Lt.datePtn,"$1 $2"),Ft}),St=Object.keys(xt[0]),re=`#${tt}chart`,zt=document.getElementById(`${tt}json`);this.InitializeDom(tt);let oe=mt.generate({bindto:re,data:{x:"date",xFormat:"%Y-%m-%d %H:%M:%S",json:xt.reverse(),keys:{x:"date",value:St},onmouseover:function(te){let Ft=Ot[te.index];Ft.payload=xt[te.index],delete Ft.updated_at,zt.childElementCount>0&&zt.removeChild(zt.firstChild);let ye=new Zt(Ft,1/0);zt.appendChild(ye.render())}},axis:{x:{type:"timeseries",tick:{format:"%Y-%m-%d"}}}})}InitializeDom(tt){let Ot=document.getElementById(`${tt}chart`);for(;Ot.firstChild;)Ot.removeChild(Ot.firstChild);let xt=document.getElementById(`${tt}json`);xt.childElementCount>0&&xt.removeChild(xt.firstChild)}}var Lt=new yt,nt=new Date,at=document.getElementById("action"),K=document.getElementById("tagname"),ut=at.value;function vt(){return at.options[at.selectedIndex].text}var Ct,Y,Q;function pt(){return j=>{Lt.InitializeDom("week-");let tt=j.body;if(tt!==void 0){if(tt.data===void 0)throw new Error(ft("js.fetch_failed_reason",ft("js.span.week"),tt.reason));tt.data.length!=0&&(Ct=tt.data,Lt.Graph("week-",tt.data))}}}function Xt(){return j=>{Lt.InitializeDom("month-");let tt=j.body;if(tt!==void 0){if(tt.data===void 0)throw new Error(ft("js.fetch_failed_reason",ft("js.span.month"),tt.reason));tt.data.length!=0&&(Y=tt.data,Lt.Graph("month-",tt.data))}}}function ne(){return j=>{Lt.InitializeDom("");let tt=j.body;if(tt===void 0)throw new Error(ft("js.fetch_failed",ft("js.span.all")));if(tt.data===void 0)throw new Error(ft("js.fetch_failed_reason",ft("js.span.all"),tt.reason));if(tt.data.length==0)throw new Error(ft("js.no_data",ft("js.span.all")));Q=tt.data,Lt.Graph("",tt.data)}}var bt=document.querySelector(".calendar");function w(){let j=bt.value.split(" to ");return j.length!=2?[]:j}var Le=document.getElementById("input-week"),e=document.getElementById("input-week-value");e.textContent=Le.value,Le.addEventListener("input",j=>{j.preventDefault(),e.textContent=Le.value});var n=document.getElementById("input-month"),o=document.getElementById("input-month-value");o.textContent=n.value,n.addEventListener("input",j=>{j.preventDefault(),o.textContent=n.value});var l=document.getElementById("input-all"),p=document.getElementById("input-all-value");p.textContent=l.value,l.addEventListener("input",j=>{j.preventDefault(),p.textContent=l.value});var y={button:document.querySelector("#week-pagination .reload"),error:new Error},_=0,T={button:document.querySelector("#week-pagination .prev"),error:new Error};T.button.disabled=!0;var L={button:document.querySelector("#week-pagination .next"),error:new Error};y.button.addEventListener("mouseover",j=>{j.preventDefault(),y.data=null;let tt=vt(),Ot=Number(Le.value);Lt.DataFetch({Tag:tt,Page:0,Span:"week",Limit:Ot,StartAt:"",EndAt:""}).then(xt=>{let St=xt.body;if(St===void 0)throw new Error(ft("js.fetch_failed",ft("js.span.reload_week")));if(St.data===void 0)throw new Error(ft("js.fetch_failed_reason",ft("js.span.reload_week"),St.reason));if(St.data.length==0)throw new Error(ft("js.no_data",ft("js.span.reload_week")));y.data=St.data}).catch(xt=>{y.data=null,y.error=xt})}),y.button.addEventListener("click",j=>{if(j.stopImmediatePropagation(),_=0,L.button.disabled=!0,T.button.disabled=!1,y.data==null){alert(y.error);return}Lt.Graph("week-",y.data)}),L.button.addEventListener("mouseover",j=>{j.stopImmediatePropagation(),L.data=null,qt(L,_-1,"week")}),L.button.addEventListener("click",j=>{if(j.stopImmediatePropagation(),!L.button.disabled){if(L.data==null){if(L.error.message!=""){alert(L.error);return}qt(L,_-1,"week");return}T.button.disabled&&(T.button.disabled=!1),_--,_<=0&&(_=0,L.button.disabled=!0),Lt.Graph("week-",L.data),L.data=null,qt(L,_-1,"week")}}),T.button.addEventListener("mouseover",j=>{j.stopImmediatePropagation(),T.data=null,Jt(T,_+1,"week")}),T.button.addEventListener("click",j=>{if(j.stopImmediatePropagation(),!T.button.disabled){if(L.button.disabled&&(L.button.disabled=!1),T.data==null){if(T.error.message!=""){alert(T.error);return}Jt(T,_+1,"week");return}_++,Lt.Graph("week-",T.data),T.data=null,Jt(T,_+1,"week")}});var V={button:document.querySelector("#month-pagination .reload"),error:new Error},F=0,q={button:document.querySelector("#month-pagination .prev"),error:new Error};q.button.disabled=!0;var ot={button:document.querySelector("#month-pagination .next"),error:new Error};V.button.addEventListener("mouseover",j=>{j.stopImmediatePropagation(),V.data=null;let tt=vt(),Ot=Number(n.value);Lt.DataFetch({Tag:tt,Page:0,Span:"month",Limit:Ot,StartAt:"",EndAt:""}).then(xt=>{let St=xt.body;if(St===void 0)throw new Error(ft("js.fetch_failed",ft("js.span.reload_month")));if(St.data===void 0)throw new Error(ft("js.fetch_failed_reason",ft("js.span.reload_month"),St.reason));if(St.data.length==0)throw new Error(ft("js.no_data",ft("js.span.reload_month")));V.data=St.data}).catch(xt=>{V.data=null,V.error=xt})}),V.button.addEventListener("click",j=>{if(j.stopImmediatePropagation(),F=0,ot.button.disabled=!0,q.button.disabled=!1,V.data==null){alert(V.error);return}Lt.Graph("month-",V.data)}),ot.button.addEventListener("mouseover",j=>{j.stopImmediatePropagation(),ot.data=null,qt(ot,F-1,"month")}),ot.button.addEventListener("click",j=>{if(j.stopImmediatePropagation(),!ot.button.disabled){if(ot.data==null){if(ot.error.message!=""){alert(ot.error);return}qt(ot,F-1,"month");return}q.button.disabled&&(q.button.disabled=!1),F--,F<=0&&(F=0,ot.button.disabled=!0),Lt.Graph("month-",ot.data),ot.data=null,qt(ot,F-1,"month")}}),q.button.addEventListener("mouseover",j=>{j.stopImmediatePropagation(),q.data=null,Jt(q,F+1,"month")}),q.button.addEventListener("click",j=>{if(j.stopImmediatePropagation(),!q.button.disabled){if(ot.button.disabled&&(ot.button.disabled=!1),q.data==null){if(q.error.message!=""){alert(q.error);return}Jt(q,F+1,"month");return}F++,Lt.Graph("month-",q.data),q.data=null,Jt(q,F+1,"month")}});var lt={button:document.querySelector("#all-pagination .reload"),error:new Error},ht=0,kt={button:document.querySelector("#all-pagination .prev"),error:new Error};kt.button.disabled=!0;var Rt={button:document.querySelector("#all-pagination .next"),error:new Error};lt.button.addEventListener("mouseover",j=>{j.stopImmediatePropagation(),lt.data=null;let tt=vt(),Ot=Number(l.value),xt=bt.value.split(" to "),St=xt[0],re=xt[1]||"";Lt.DataFetch({Tag:tt,Page:0,Span:"all",Limit:Ot,StartAt:St,EndAt:re}).then(zt=>{let oe=zt.body;if(oe===void 0)throw new Error(ft("js.fetch_failed",ft("js.span.reload_all")));if(oe.data===void 0)throw new Error(ft("js.fetch_failed_reason",ft("js.span.reload_all"),oe.reason));if(oe.data.length==0)throw new Error(ft("js.no_data",ft("js.span.reload_all")));lt.data=oe.data}).catch(zt=>{lt.data=null,lt.error=zt})}),lt.button.addEventListener("click",j=>{if(j.stopImmediatePropagation(),ht=0,Rt.button.disabled=!0,kt.button.disabled=!1,lt.data==null){alert(lt.error);return}Lt.Graph("",lt.data)}),Rt.button.addEventListener("mouseover",j=>{j.stopImmediatePropagation(),Rt.data=null,qt(Rt,ht-1,"all")}),Rt.button.addEventListener("click",j=>{if(j.stopImmediatePropagation(),!Rt.button.disabled){if(Rt.data==null){if(Rt.error.message!=""){alert(Rt.error);return}qt(Rt,ht-1,"all");return}kt.button.disabled&&(kt.button.disabled=!1),ht--,ht<=0&&(ht=0,Rt.button.disabled=!0),Lt.Graph("",Rt.data),Rt.data=null,qt(Rt,ht-1,"all")}}),kt.button.addEventListener("mouseover",j=>{j.stopImmediatePropagation(),kt.data=null,Jt(kt,ht+1,"all")}),kt.button.addEventListener("click",j=>{if(j.stopImmediatePropagation(),!kt.button.disabled){if(Rt.button.disabled&&(Rt.button.disabled=!1),kt.data==null){if(kt.error.message!=""){alert(kt.error);return}Jt(kt,ht+1,"all");return}ht++,Lt.Graph("",kt.data),kt.data=null,Jt(kt,ht+1,"all")}});function qt(j,tt,Ot){let xt=["",""];Ot=="all"&&(xt=w()),tt<0&&(tt=0);let St=vt(),re=Number(l.value);Lt.DataFetch({Tag:St,Page:tt,Span:Ot,Limit:re,StartAt:xt[0],EndAt:xt[1]}).then(zt=>{let oe=zt.body;if(oe===void 0)throw new Error(ft("js.fetch_failed",ft("js.span.next")));if(oe.data===void 0)throw new Error(ft("js.fetch_failed_reason",ft("js.span.next"),oe.reason));if(oe.data.length==0)throw j.button.disabled=!0,new Error(ft("js.no_data",ft("js.span.next")));j.data=oe.data}).catch(zt=>{j.data=null,j.error=zt})}function Jt(j,tt,Ot){let xt=["",""];Ot=="all"&&(xt=w());let St=vt(),re=Number(l.value);Lt.DataFetch({Tag:St,Page:tt,Span:Ot,Limit:re,StartAt:xt[0],EndAt:xt[1]}).then(zt=>{let oe=zt.body;if(oe===void 0)throw new Error(ft("js.fetch_failed",ft("js.span.prev")));if(oe.data===void 0)throw new Error(ft("js.fetch_failed_reason",ft("js.span.prev"),oe.reason));if(oe.data.length==0){j.button.disabled=!0;return}j.data=oe.data}).catch(zt=>{j.data=null,j.error=zt})}at.addEventListener("change",j=>B(null,null,function*(){if(j.preventDefault(),at.value=="")return;let tt=vt(),Ot=Number(Le.value),xt=Number(n.value),St=Number(l.value),re=bt.value.split(" to "),zt=re[0],oe=re[1]||"",te=!1;yield Promise.all([Lt.DataFetch({Tag:tt,Page:0,Span:"week",Limit:Ot,StartAt:"",EndAt:""}).then(pt(),Ft=>Ft),Lt.DataFetch({Tag:tt,Page:0,Span:"month",Limit:xt,StartAt:"",EndAt:""}).then(Xt(),Ft=>Ft),Lt.DataFetch({Tag:tt,Page:0,Span:"all",Limit:St,StartAt:zt,EndAt:oe}).then(ne(),Ft=>Ft)]).catch(function(Ft){alert(ft("js.switch_failed")+`
+`+Ft),te=!0,at.value=ut,Ct!=null&&Lt.Graph("week-",Ut(Ct)),Y!=null&&Lt.Graph("month-",Ut(Y)),Q!=null&&Lt.Graph("",Ut(Q))}),!te&&(Q!=null&&Q.length>0&&P(bt,{mode:"range",maxDate:Q[0].updated_at}),ht=0,T.button.disabled=!1,L.button.disabled=!0,q.button.disabled=!1,ot.button.disabled=!0,kt.button.disabled=!1,Rt.button.disabled=!0,K.textContent=ft("js.graph_title",at[at.selectedIndex].text),ut=at.value)}));var Yt=document.getElementById("add-tag");Yt.addEventListener("click",j=>{j.preventDefault(),Lt.AddTag()})},{superagent:6,c3:1,flatpickr:4,"json-formatter-js":5,"./i18n":13,"./csrf":14,"./token":15}],13:[function(fe,pe,le){"use strict";Object.defineProperty(le,"__esModule",{value:!0}),le.t=H;let st={};const dt=document.getElementById("messages");if(dt&&dt.textContent)try{st=JSON.parse(dt.textContent)}catch(B){st={}}function H(B,...$){let mt=st[B]||B;for(const P of $)mt=mt.replace(/%[sv]/,String(P));return mt}},{}],14:[function(fe,pe,le){"use strict";Object.defineProperty(le,"__esModule",{value:!0}),le.csrfToken=st;function st(){const dt=document.getElementById("csrf-token");return dt?dt.value:""}},{}],15:[function(fe,pe,le){"use strict";Object.defineProperty(le,"__esModule",{value:!0}),le.apiToken=ae;const st=fe("superagent"),{t:dt}=fe("./i18n"),{csrfToken:H}=fe("./csrf"),B=60*1e3;let $="",mt=0,P,Zt=!1,ft=!1;function ae(){return $}function jt(yt){P!==void 0&&window.clearTimeout(P),P=window.setTimeout(Ht,Math.max(yt,0))}function Ht(){P!==void 0&&(window.clearTimeout(P),P=void 0),!(Zt||ft)&&(Zt=!0,st.post("/api/v1/token/refresh").set("X-CSRF-Token",H()).send().end(function(yt,Lt){Zt=!1,yt?Lt&&Lt.status==401?(ft=!0,alert(dt("js.login_expired"))):jt(10*1e3):($=Lt.body.token,mt=Date.parse(Lt.body.expires_at),jt(mt-B-Date.now()))}))}const Ut=document.getElementById("api-token");Ut&&($=Ut.value,mt=Number(Ut.dataset.expiresAt)*1e3,jt(mt-B-Date.now()),document.addEventListener("visibilitychange",()=>{!document.hidden&&Date.now()>mt-B&&Ht()}))},{superagent:6,"./i18n":13,"./csrf":14}]},{},[12]);
@@ -1 +1,3 @@
-!function t(e,r,s){function i(n,a){if(!r[n]){if(!e[n]){var u="function"==typeof require&&require;if(!a&&u)return u(n,!0);if(o)return o(n,!0);throw new Error("Cannot find module '"+n+"'")}var h=r[n]={exports:{}};e[n][0].call(h.exports,function(t){var r=e[n][1][t];return i(r||t)},h,h.exports,t,e,r,s)}return r[n].exports}for(var o="function"==typeof require&&require,n=0;n<s.length;n++)i(s[n]);return i}({1:[function(t,e,r){function s(t){if(t)return function(t){for(var e in s.prototype)t[e]=s.prototype[e];return t}(t)}void 0!==e&&(e.exports=s),s.prototype.on=s.prototype.addEventListener=function(t,e){return this._callbacks=this._callbacks||{},(this._callbacks["$"+t]=this._callbacks["$"+t]||[]).push(e),this},s.prototype.once=function(t,e){function r(){this.off(t,r),e.apply(this,arguments)}return r.fn=e,this.on(t,r),this},s.prototype.off=s.prototype.removeListener=s.prototype.removeAllListeners=s.prototype.removeEventListener=function(t,e){if(this._callbacks=this._callbacks||{},0==arguments.length)return this._callbacks={},this;var r=this._callbacks["$"+t];if(!r)return this;if(1==arguments.length)return delete this._callbacks["$"+t],this;for(var s,i=0;i<r.length;i++)if((s=r[i])===e||s.fn===e){r.splice(i,1);break}return this},s.prototype.emit=function(t){this._callbacks=this._callbacks||{};var e=[].slice.call(arguments,1),r=this._callbacks["$"+t];if(r)for(var s=0,i=(r=r.slice(0)).length;s<i;++s)r[s].apply(this,e);return this},s.prototype.listeners=function(t){return this._callbacks=this._callbacks||{},this._callbacks["$"+t]||[]},s.prototype.hasListeners=function(t){return!!this.listeners(t).length}},{}],2:[function(t,e,r){function s(t){if(!d(t))return t;var e=[];for(var r in t)i(e,r,t[r]);return e.join("&")}function i(t,e,r){if(null!=r)if(Array.isArray(r))r.forEach(function(r){i(t,e,r)});else if(d(r))for(var s in r)i(t,e+"["+s+"]",r[s]);else t.push(encodeURIComponent(e)+"="+encodeURIComponent(r));else null===r&&t.push(encodeURIComponent(e))}function o(t){for(var e,r,s={},i=t.split("&"),o=0,n=i.length;o<n;++o)-1==(r=(e=i[o]).indexOf("="))?s[decodeURIComponent(e)]="":s[decodeURIComponent(e.slice(0,r))]=decodeURIComponent(e.slice(r+1));return s}function n(t){return/[\/+]json\b/.test(t)}function a(t){this.req=t,this.xhr=this.req.xhr,this.text="HEAD"!=this.req.method&&(""===this.xhr.responseType||"text"===this.xhr.responseType)||void 0===this.xhr.responseType?this.xhr.responseText:null,this.statusText=this.req.xhr.statusText;var e=this.xhr.status;1223===e&&(e=204),this._setStatusProperties(e),this.header=this.headers=function(t){var e,r,s,i,o=t.split(/\r?\n/),n={};o.pop();for(var a=0,u=o.length;a<u;++a)e=(r=o[a]).indexOf(":"),s=r.slice(0,e).toLowerCase(),i=_(r.slice(e+1)),n[s]=i;return n}(this.xhr.getAllResponseHeaders()),this.header["content-type"]=this.xhr.getResponseHeader("content-type"),this._setHeaderProperties(this.header),null===this.text&&t._responseType?this.body=this.xhr.response:this.body="HEAD"!=this.req.method?this._parseBody(this.text?this.text:this.xhr.response):null}function u(t,e){var r=this;this._query=this._query||[],this.method=t,this.url=e,this.header={},this._header={},this.on("end",function(){var t=null,e=null;try{e=new a(r)}catch(e){return t=new Error("Parser is unable to parse the response"),t.parse=!0,t.original=e,r.xhr?(t.rawResponse=void 0===r.xhr.responseType?r.xhr.responseText:r.xhr.response,t.status=r.xhr.status?r.xhr.status:null,t.statusCode=t.status):(t.rawResponse=null,t.status=null),r.callback(t)}r.emit("response",e);var s;try{r._isResponseOK(e)||((s=new Error(e.statusText||"Unsuccessful HTTP response")).original=t,s.response=e,s.status=e.status)}catch(t){s=t}s?r.callback(s,e):r.callback(null,e)})}function h(t,e,r){var s=m("DELETE",t);return"function"==typeof e&&(r=e,e=null),e&&s.send(e),r&&s.end(r),s}var p;"undefined"!=typeof window?p=window:"undefined"!=typeof self?p=self:(console.warn("Using browser-only version of superagent in non-browser environment"),p=this);var c=t("component-emitter"),l=t("./request-base"),d=t("./is-object"),f=t("./response-base"),y=t("./should-retry"),m=r=e.exports=function(t,e){return"function"==typeof e?new r.Request("GET",t).end(e):1==arguments.length?new r.Request("GET",t):new r.Request(t,e)};r.Request=u,m.getXHR=function(){if(!(!p.XMLHttpRequest||p.location&&"file:"==p.location.protocol&&p.ActiveXObject))return new XMLHttpRequest;try{return new ActiveXObject("Microsoft.XMLHTTP")}catch(t){}try{return new ActiveXObject("Msxml2.XMLHTTP.6.0")}catch(t){}try{return new ActiveXObject("Msxml2.XMLHTTP.3.0")}catch(t){}try{return new ActiveXObject("Msxml2.XMLHTTP")}catch(t){}throw Error("Browser-only version of superagent could not find XHR")};var _="".trim?function(t){return t.trim()}:function(t){return t.replace(/(^\s*|\s*$)/g,"")};m.serializeObject=s,m.parseString=o,m.types={html:"text/html",json:"application/json",xml:"text/xml",urlencoded:"application/x-www-form-urlencoded",form:"application/x-www-form-urlencoded","form-data":"application/x-www-form-urlencoded"},m.serialize={"application/x-www-form-urlencoded":s,"application/json":JSON.stringify},m.parse={"application/x-www-form-urlencoded":o,"application/json":JSON.parse},f(a.prototype),a.prototype._parseBody=function(t){var e=m.parse[this.type];return this.req._parser?this.req._parser(this,t):(!e&&n(this.type)&&(e=m.parse["application/json"]),e&&t&&(t.length||t instanceof Object)?e(t):null)},a.prototype.toError=function(){var t=this.req,e=t.method,r=t.url,s="cannot "+e+" "+r+" ("+this.status+")",i=new Error(s);return i.status=this.status,i.method=e,i.url=r,i},m.Response=a,c(u.prototype),l(u.prototype),u.prototype.type=function(t){return this.set("Content-Type",m.types[t]||t),this},u.prototype.accept=function(t){return this.set("Accept",m.types[t]||t),this},u.prototype.auth=function(t,e,r){switch("object"==typeof e&&null!==e&&(r=e),r||(r={type:"function"==typeof btoa?"basic":"auto"}),r.type){case"basic":this.set("Authorization","Basic "+btoa(t+":"+e));break;case"auto":this.username=t,this.password=e;break;case"bearer":this.set("Authorization","Bearer "+t)}return this},u.prototype.query=function(t){return"string"!=typeof t&&(t=s(t)),t&&this._query.push(t),this},u.prototype.attach=function(t,e,r){if(e){if(this._data)throw Error("superagent can't mix .send() and .attach()");this._getFormData().append(t,e,r||e.name)}return this},u.prototype._getFormData=function(){return this._formData||(this._formData=new p.FormData),this._formData},u.prototype.callback=function(t,e){if(this._maxRetries&&this._retries++<this._maxRetries&&y(t,e))return this._retry();var r=this._callback;this.clearTimeout(),t&&(this._maxRetries&&(t.retries=this._retries-1),this.emit("error",t)),r(t,e)},u.prototype.crossDomainError=function(){var t=new Error("Request has been terminated\nPossible causes: the network is offline, Origin is not allowed by Access-Control-Allow-Origin, the page is being unloaded, etc.");t.crossDomain=!0,t.status=this.status,t.method=this.method,t.url=this.url,this.callback(t)},u.prototype.buffer=u.prototype.ca=u.prototype.agent=function(){return console.warn("This is not supported in browser version of superagent"),this},u.prototype.pipe=u.prototype.write=function(){throw Error("Streaming is not supported in browser version of superagent")},u.prototype._isHost=function(t){return t&&"object"==typeof t&&!Array.isArray(t)&&"[object Object]"!==Object.prototype.toString.call(t)},u.prototype.end=function(t){return this._endCalled&&console.warn("Warning: .end() was called twice. This is not supported in superagent"),this._endCalled=!0,this._callback=t||function(){},this._finalizeQueryString(),this._end()},u.prototype._end=function(){var t=this,e=this.xhr=m.getXHR(),r=this._formData||this._data;this._setTimeouts(),e.onreadystatechange=function(){var r=e.readyState;if(r>=2&&t._responseTimeoutTimer&&clearTimeout(t._responseTimeoutTimer),4==r){var s;try{s=e.status}catch(t){s=0}if(!s){if(t.timedout||t._aborted)return;return t.crossDomainError()}t.emit("end")}};var s=function(e,r){r.total>0&&(r.percent=r.loaded/r.total*100),r.direction=e,t.emit("progress",r)};if(this.hasListeners("progress"))try{e.onprogress=s.bind(null,"download"),e.upload&&(e.upload.onprogress=s.bind(null,"upload"))}catch(t){}try{this.username&&this.password?e.open(this.method,this.url,!0,this.username,this.password):e.open(this.method,this.url,!0)}catch(t){return this.callback(t)}if(this._withCredentials&&(e.withCredentials=!0),!this._formData&&"GET"!=this.method&&"HEAD"!=this.method&&"string"!=typeof r&&!this._isHost(r)){var i=this._header["content-type"],o=this._serializer||m.serialize[i?i.split(";")[0]:""];!o&&n(i)&&(o=m.serialize["application/json"]),o&&(r=o(r))}for(var a in this.header)null!=this.header[a]&&this.header.hasOwnProperty(a)&&e.setRequestHeader(a,this.header[a]);return this._responseType&&(e.responseType=this._responseType),this.emit("request",this),e.send(void 0!==r?r:null),this},m.get=function(t,e,r){var s=m("GET",t);return"function"==typeof e&&(r=e,e=null),e&&s.query(e),r&&s.end(r),s},m.head=function(t,e,r){var s=m("HEAD",t);return"function"==typeof e&&(r=e,e=null),e&&s.query(e),r&&s.end(r),s},m.options=function(t,e,r){var s=m("OPTIONS",t);return"function"==typeof e&&(r=e,e=null),e&&s.send(e),r&&s.end(r),s},m.del=h,m.delete=h,m.patch=function(t,e,r){var s=m("PATCH",t);return"function"==typeof e&&(r=e,e=null),e&&s.send(e),r&&s.end(r),s},m.post=function(t,e,r){var s=m("POST",t);return"function"==typeof e&&(r=e,e=null),e&&s.send(e),r&&s.end(r),s},m.put=function(t,e,r){var s=m("PUT",t);return"function"==typeof e&&(r=e,e=null),e&&s.send(e),r&&s.end(r),s}},{"./is-object":3,"./request-base":4,"./response-base":5,"./should-retry":6,"component-emitter":1}],3:[function(t,e,r){e.exports=function(t){return null!==t&&"object"==typeof t}},{}],4:[function(t,e,r){function s(t){if(t)return function(t){for(var e in s.prototype)t[e]=s.prototype[e];return t}(t)}var i=t("./is-object");e.exports=s,s.prototype.clearTimeout=function(){return clearTimeout(this._timer),clearTimeout(this._responseTimeoutTimer),delete this._timer,delete this._responseTimeoutTimer,this},s.prototype.parse=function(t){return this._parser=t,this},s.prototype.responseType=function(t){return this._responseType=t,this},s.prototype.serialize=function(t){return this._serializer=t,this},s.prototype.timeout=function(t){if(!t||"object"!=typeof t)return this._timeout=t,this._responseTimeout=0,this;for(var e in t)switch(e){case"deadline":this._timeout=t.deadline;break;case"response":this._responseTimeout=t.response;break;default:console.warn("Unknown timeout option",e)}return this},s.prototype.retry=function(t){return 0!==arguments.length&&!0!==t||(t=1),t<=0&&(t=0),this._maxRetries=t,this._retries=0,this},s.prototype._retry=function(){return this.clearTimeout(),this.req&&(this.req=null,this.req=this.request()),this._aborted=!1,this.timedout=!1,this._end()},s.prototype.then=function(t,e){if(!this._fullfilledPromise){var r=this;this._endCalled&&console.warn("Warning: superagent request was sent twice, because both .end() and .then() were called. Never call .end() if you use promises"),this._fullfilledPromise=new Promise(function(t,e){r.end(function(r,s){r?e(r):t(s)})})}return this._fullfilledPromise.then(t,e)},s.prototype.catch=function(t){return this.then(void 0,t)},s.prototype.use=function(t){return t(this),this},s.prototype.ok=function(t){if("function"!=typeof t)throw Error("Callback required");return this._okCallback=t,this},s.prototype._isResponseOK=function(t){return!!t&&(this._okCallback?this._okCallback(t):t.status>=200&&t.status<300)},s.prototype.get=function(t){return this._header[t.toLowerCase()]},s.prototype.getHeader=s.prototype.get,s.prototype.set=function(t,e){if(i(t)){for(var r in t)this.set(r,t[r]);return this}return this._header[t.toLowerCase()]=e,this.header[t]=e,this},s.prototype.unset=function(t){return delete this._header[t.toLowerCase()],delete this.header[t],this},s.prototype.field=function(t,e){if(null===t||void 0===t)throw new Error(".field(name, val) name can not be empty");if(this._data&&console.error(".field() can't be used if .send() is used. Please use only .send() or only .field() & .attach()"),i(t)){for(var r in t)this.field(r,t[r]);return this}if(Array.isArray(e)){for(var s in e)this.field(t,e[s]);return this}if(null===e||void 0===e)throw new Error(".field(name, val) val can not be empty");return"boolean"==typeof e&&(e=""+e),this._getFormData().append(t,e),this},s.prototype.abort=function(){return this._aborted?this:(this._aborted=!0,this.xhr&&this.xhr.abort(),this.req&&this.req.abort(),this.clearTimeout(),this.emit("abort"),this)},s.prototype.withCredentials=function(t){return void 0==t&&(t=!0),this._withCredentials=t,this},s.prototype.redirects=function(t){return this._maxRedirects=t,this},s.prototype.toJSON=function(){return{method:this.method,url:this.url,data:this._data,headers:this._header}},s.prototype.send=function(t){var e=i(t),r=this._header["content-type"];if(this._formData&&console.error(".send() can't be used if .attach() or .field() is used. Please use only .send() or only .field() & .attach()"),e&&!this._data)Array.isArray(t)?this._data=[]:this._isHost(t)||(this._data={});else if(t&&this._data&&this._isHost(this._data))throw Error("Can't merge these send calls");if(e&&i(this._data))for(var s in t)this._data[s]=t[s];else"string"==typeof t?(r||this.type("form"),r=this._header["content-type"],this._data="application/x-www-form-urlencoded"==r?this._data?this._data+"&"+t:t:(this._data||"")+t):this._data=t;return!e||this._isHost(t)?this:(r||this.type("json"),this)},s.prototype.sortQuery=function(t){return this._sort=void 0===t||t,this},s.prototype._finalizeQueryString=function(){var t=this._query.join("&");if(t&&(this.url+=(this.url.indexOf("?")>=0?"&":"?")+t),this._query.length=0,this._sort){var e=this.url.indexOf("?");if(e>=0){var r=this.url.substring(e+1).split("&");"function"==typeof this._sort?r.sort(this._sort):r.sort(),this.url=this.url.substring(0,e)+"?"+r.join("&")}}},s.prototype._appendQueryString=function(){console.trace("Unsupported")},s.prototype._timeoutError=function(t,e,r){if(!this._aborted){var s=new Error(t+e+"ms exceeded");s.timeout=e,s.code="ECONNABORTED",s.errno=r,this.timedout=!0,this.abort(),this.callback(s)}},s.prototype._setTimeouts=function(){var t=this;this._timeout&&!this._timer&&(this._timer=setTimeout(function(){t._timeoutError("Timeout of ",t._timeout,"ETIME")},this._timeout)),this._responseTimeout&&!this._responseTimeoutTimer&&(this._responseTimeoutTimer=setTimeout(function(){t._timeoutError("Response timeout of ",t._responseTimeout,"ETIMEDOUT")},this._responseTimeout))}},{"./is-object":3}],5:[function(t,e,r){function s(t){if(t)return function(t){for(var e in s.prototype)t[e]=s.prototype[e];return t}(t)}var i=t("./utils");e.exports=s,s.prototype.get=function(t){return this.header[t.toLowerCase()]},s.prototype._setHeaderProperties=function(t){var e=t["content-type"]||"";this.type=i.type(e);var r=i.params(e);for(var s in r)this[s]=r[s];this.links={};try{t.link&&(this.links=i.parseLinks(t.link))}catch(t){}},s.prototype._setStatusProperties=function(t){var e=t/100|0;this.status=this.statusCode=t,this.statusType=e,this.info=1==e,this.ok=2==e,this.redirect=3==e,this.clientError=4==e,this.serverError=5==e,this.error=(4==e||5==e)&&this.toError(),this.accepted=202==t,this.noContent=204==t,this.badRequest=400==t,this.unauthorized=401==t,this.notAcceptable=406==t,this.forbidden=403==t,this.notFound=404==t}},{"./utils":7}],6:[function(t,e,r){var s=["ECONNRESET","ETIMEDOUT","EADDRINFO","ESOCKETTIMEDOUT"];e.exports=function(t,e){return!!(t&&t.code&&~s.indexOf(t.code))||(!!(e&&e.status&&e.status>=500)||(!!(t&&"timeout"in t&&"ECONNABORTED"==t.code)||!!(t&&"crossDomain"in t)))}},{}],7:[function(t,e,r){r.type=function(t){return t.split(/ *; */).shift()},r.params=function(t){return t.split(/ *; */).reduce(function(t,e){var r=e.split(/ *= */),s=r.shift(),i=r.shift();return s&&i&&(t[s]=i),t},{})},r.parseLinks=function(t){return t.split(/ *, */).reduce(function(t,e){var r=e.split(/ *; */),s=r[0].slice(1,-1);return t[r[1].split(/ *= */)[1].slice(1,-1)]=s,t},{})},r.cleanHeader=function(t,e){return delete t["content-type"],delete t["content-length"],delete t["transfer-encoding"],delete t.host,e&&delete t.cookie,t}},{}],8:[function(t,e,r){"use strict";Object.defineProperty(r,"__esModule",{value:!0});const s=t("superagent");var i=new class{constructor(){this._token="";let t=document.getElementById("api-token");this._token=t.value}RegenerateToken(){s.patch("/mypage/api/regenerate").set("Content-Type","application/json").set("Authorization",`Bearer ${this._token}`).send().end(function(t,e){if(t||!e.ok)alert("http error: "+t);else{let t=e.body;t.is_success?(alert("アクセストークンを更新しました"),window.location.reload(!0)):(alert(`${t.reason}`),window.location.reload(!0))}})}RegisterPassword(){let t=document.getElementById("password"),e=document.getElementById("password-verify"),r=t.value,i=e.value;""!=r&&""!=i?r==i?s.post("/mypage/api/reregister_password").set("Content-Type","application/json").set("Authorization",`Bearer ${this._token}`).send({password:r,verify_password:i}).end(function(t,e){if(t||!e.ok)alert("http error: "+t);else{let t=e.body;t.is_success?(alert("パスワードを更新しました"),window.location.reload(!0)):(alert(`パスワードの更新に失敗しました: ${t.reason}`),window.location.reload(!0))}}):alert("パスワードが一致していません"):alert("パスワードが入力されていません")}};document.getElementById("regen-token").addEventListener("click",t=>{t.preventDefault(),i.RegenerateToken()});document.getElementById("reregister-password").addEventListener("click",t=>{t.preventDefault(),i.RegisterPassword()})},{superagent:2}]},{},[8]);
\ No newline at end of file
+(function f(m,d,r){function n(u,l){if(!d[u]){if(!m[u]){var v=typeof require=="function"&&require;if(!l&&v)return v(u,!0);if(e)return e(u,!0);throw new Error("Cannot find module '"+u+"'")}var _=d[u]={exports:{}};m[u][0].call(_.exports,function(k){var x=m[u][1][k];return n(x||k)},_,_.exports,f,m,d,r)}return d[u].exports}for(var e=typeof require=="function"&&require,s=0;s<r.length;s++)n(r[s]);return n})({1:[function(f,m,d){function r(n){if(n)return(function(e){for(var s in r.prototype)e[s]=r.prototype[s];return e})(n)}m!==void 0&&(m.exports=r),r.prototype.on=r.prototype.addEventListener=function(n,e){return this._callbacks=this._callbacks||{},(this._callbacks["$"+n]=this._callbacks["$"+n]||[]).push(e),this},r.prototype.once=function(n,e){function s(){this.off(n,s),e.apply(this,arguments)}return s.fn=e,this.on(n,s),this},r.prototype.off=r.prototype.removeListener=r.prototype.removeAllListeners=r.prototype.removeEventListener=function(n,e){if(this._callbacks=this._callbacks||{},arguments.length==0)return this._callbacks={},this;var s=this._callbacks["$"+n];if(!s)return this;if(arguments.length==1)return delete this._callbacks["$"+n],this;for(var u,l=0;l<s.length;l++)if((u=s[l])===e||u.fn===e){s.splice(l,1);break}return this},r.prototype.emit=function(n){this._callbacks=this._callbacks||{};var e=[].slice.call(arguments,1),s=this._callbacks["$"+n];if(s)for(var u=0,l=(s=s.slice(0)).length;u<l;++u)s[u].apply(this,e);return this},r.prototype.listeners=function(n){return this._callbacks=this._callbacks||{},this._callbacks["$"+n]||[]},r.prototype.hasListeners=function(n){return!!this.listeners(n).length}},{}],2:[function(f,m,d){function r(t){if(!j(t))return t;var o=[];for(var i in t)n(o,i,t[i]);return o.join("&")}function n(t,o,i){if(i!=null)if(Array.isArray(i))i.forEach(function(y){n(t,o,y)});else if(j(i))for(var c in i)n(t,o+"["+c+"]",i[c]);else t.push(encodeURIComponent(o)+"="+encodeURIComponent(i));else i===null&&t.push(encodeURIComponent(o))}function e(t){for(var o,i,c={},y=t.split("&"),b=0,T=y.length;b<T;++b)(i=(o=y[b]).indexOf("="))==-1?c[decodeURIComponent(o)]="":c[decodeURIComponent(o.slice(0,i))]=decodeURIComponent(o.slice(i+1));return c}function s(t){return/[\/+]json\b/.test(t)}function u(t){this.req=t,this.xhr=this.req.xhr,this.text=this.req.method!="HEAD"&&(this.xhr.responseType===""||this.xhr.responseType==="text")||this.xhr.responseType===void 0?this.xhr.responseText:null,this.statusText=this.req.xhr.statusText;var o=this.xhr.status;o===1223&&(o=204),this._setStatusProperties(o),this.header=this.headers=(function(i){var c,y,b,T,E=i.split(/\r?\n/),g={};E.pop();for(var C=0,R=E.length;C<R;++C)c=(y=E[C]).indexOf(":"),b=y.slice(0,c).toLowerCase(),T=p(y.slice(c+1)),g[b]=T;return g})(this.xhr.getAllResponseHeaders()),this.header["content-type"]=this.xhr.getResponseHeader("content-type"),this._setHeaderProperties(this.header),this.text===null&&t._responseType?this.body=this.xhr.response:this.body=this.req.method!="HEAD"?this._parseBody(this.text?this.text:this.xhr.response):null}function l(t,o){var i=this;this._query=this._query||[],this.method=t,this.url=o,this.header={},this._header={},this.on("end",function(){var c=null,y=null;try{y=new u(i)}catch(T){return c=new Error("Parser is unable to parse the response"),c.parse=!0,c.original=T,i.xhr?(c.rawResponse=i.xhr.responseType===void 0?i.xhr.responseText:i.xhr.response,c.status=i.xhr.status?i.xhr.status:null,c.statusCode=c.status):(c.rawResponse=null,c.status=null),i.callback(c)}i.emit("response",y);var b;try{i._isResponseOK(y)||((b=new Error(y.statusText||"Unsuccessful HTTP response")).original=c,b.response=y,b.status=y.status)}catch(T){b=T}b?i.callback(b,y):i.callback(null,y)})}function v(t,o,i){var c=a("DELETE",t);return typeof o=="function"&&(i=o,o=null),o&&c.send(o),i&&c.end(i),c}var _;typeof window!="undefined"?_=window:typeof self!="undefined"?_=self:(console.warn("Using browser-only version of superagent in non-browser environment"),_=this);var k=f("component-emitter"),x=f("./request-base"),j=f("./is-object"),w=f("./response-base"),h=f("./should-retry"),a=d=m.exports=function(t,o){return typeof o=="function"?new d.Request("GET",t).end(o):arguments.length==1?new d.Request("GET",t):new d.Request(t,o)};d.Request=l,a.getXHR=function(){if(!(!_.XMLHttpRequest||_.location&&_.location.protocol=="file:"&&_.ActiveXObject))return new XMLHttpRequest;try{return new ActiveXObject("Microsoft.XMLHTTP")}catch(t){}try{return new ActiveXObject("Msxml2.XMLHTTP.6.0")}catch(t){}try{return new ActiveXObject("Msxml2.XMLHTTP.3.0")}catch(t){}try{return new ActiveXObject("Msxml2.XMLHTTP")}catch(t){}throw Error("Browser-only version of superagent could not find XHR")};var p="".trim?function(t){return t.trim()}:function(t){return t.replace(/(^\s*|\s*$)/g,"")};a.serializeObject=r,a.parseString=e,a.types={html:"text/html",json:"application/json",xml:"text/xml",urlencoded:"application/x-www-form-urlencoded",form:"application/x-www-form-urlencoded","form-data":"application/x-www-form-urlencoded"},a.serialize={"application/x-www-form-urlencoded":r,"application/json":JSON.stringify},a.parse={"application/x-www-form-urlencoded":e,"application/json":JSON.parse},w(u.prototype),u.prototype._parseBody=function(t){var o=a.parse[this.type];return this.req._parser?this.req._parser(this,t):(!o&&s(this.type)&&(o=a.parse["application/json"]),o&&t&&(t.length||t instanceof Object)?o(t):null)},u.prototype.toError=function(){var t=this.req,o=t.method,i=t.url,c="cannot "+o+" "+i+" ("+this.status+")",y=new Error(c);return y.status=this.status,y.method=o,y.url=i,y},a.Response=u,k(l.prototype),x(l.prototype),l.prototype.type=function(t){return this.set("Content-Type",a.types[t]||t),this},l.prototype.accept=function(t){return this.set("Accept",a.types[t]||t),this},l.prototype.auth=function(t,o,i){switch(typeof o=="object"&&o!==null&&(i=o),i||(i={type:typeof btoa=="function"?"basic":"auto"}),i.type){case"basic":this.set("Authorization","Basic "+btoa(t+":"+o));break;case"auto":this.username=t,this.password=o;break;case"bearer":this.set("Authorization","Bearer "+t)}return this},l.prototype.query=function(t){return typeof t!="string"&&(t=r(t)),t&&this._query.push(t),this},l.prototype.attach=function(t,o,i){if(o){if(this._data)throw Error("superagent can't mix .send() and .attach()");this._getFormData().append(t,o,i||o.name)}return this},l.prototype._getFormData=function(){return this._formData||(this._formData=new _.FormData),this._formData},l.prototype.callback=function(t,o){if(this._maxRetries&&this._retries++<this._maxRetries&&h(t,o))return this._retry();var i=this._callback;this.clearTimeout(),t&&(this._maxRetries&&(t.retries=this._retries-1),this.emit("error",t)),i(t,o)},l.prototype.crossDomainError=function(){var t=new Error(`Request has been terminated
+Possible causes: the network is offline, Origin is not allowed by Access-Control-Allow-Origin, the page is being unloaded, etc.`);t.crossDomain=!0,t.status=this.status,t.method=this.method,t.url=this.url,this.callback(t)},l.prototype.buffer=l.prototype.ca=l.prototype.agent=function(){return console.warn("This is not supported in browser version of superagent"),this},l.prototype.pipe=l.prototype.write=function(){throw Error("Streaming is not supported in browser version of superagent")},l.prototype._isHost=function(t){return t&&typeof t=="object"&&!Array.isArray(t)&&Object.prototype.toString.call(t)!=="[object Object]"},l.prototype.end=function(t){return this._endCalled&&console.warn("Warning: .end() was called twice. This is not supported in superagent"),this._endCalled=!0,this._callback=t||function(){},this._finalizeQueryString(),this._end()},l.prototype._end=function(){var t=this,o=this.xhr=a.getXHR(),i=this._formData||this._data;this._setTimeouts(),o.onreadystatechange=function(){var E=o.readyState;if(E>=2&&t._responseTimeoutTimer&&clearTimeout(t._responseTimeoutTimer),E==4){var g;try{g=o.status}catch(C){g=0}if(!g)return t.timedout||t._aborted?void 0:t.crossDomainError();t.emit("end")}};var c=function(E,g){g.total>0&&(g.percent=g.loaded/g.total*100),g.direction=E,t.emit("progress",g)};if(this.hasListeners("progress"))try{o.onprogress=c.bind(null,"download"),o.upload&&(o.upload.onprogress=c.bind(null,"upload"))}catch(E){}try{this.username&&this.password?o.open(this.method,this.url,!0,this.username,this.password):o.open(this.method,this.url,!0)}catch(E){return this.callback(E)}if(this._withCredentials&&(o.withCredentials=!0),!this._formData&&this.method!="GET"&&this.method!="HEAD"&&typeof i!="string"&&!this._isHost(i)){var y=this._header["content-type"],b=this._serializer||a.serialize[y?y.split(";")[0]:""];!b&&s(y)&&(b=a.serialize["application/json"]),b&&(i=b(i))}for(var T in this.header)this.header[T]!=null&&this.header.hasOwnProperty(T)&&o.setRequestHeader(T,this.header[T]);return this._responseType&&(o.responseType=this._responseType),this.emit("request",this),o.send(i!==void 0?i:null),this},a.get=function(t,o,i){var c=a("GET",t);return typeof o=="function"&&(i=o,o=null),o&&c.query(o),i&&c.end(i),c},a.head=function(t,o,i){var c=a("HEAD",t);return typeof o=="function"&&(i=o,o=null),o&&c.query(o),i&&c.end(i),c},a.options=function(t,o,i){var c=a("OPTIONS",t);return typeof o=="function"&&(i=o,o=null),o&&c.send(o),i&&c.end(i),c},a.del=v,a.delete=v,a.patch=function(t,o,i){var c=a("PATCH",t);return typeof o=="function"&&(i=o,o=null),o&&c.send(o),i&&c.end(i),c},a.post=function(t,o,i){var c=a("POST",t);return typeof o=="function"&&(i=o,o=null),o&&c.send(o),i&&c.end(i),c},a.put=function(t,o,i){var c=a("PUT",t);return typeof o=="function"&&(i=o,o=null),o&&c.send(o),i&&c.end(i),c}},{"./is-object":3,"./request-base":4,"./response-base":5,"./should-retry":6,"component-emitter":1}],3:[function(f,m,d){m.exports=function(r){return r!==null&&typeof r=="object"}},{}],4:[function(f,m,d){function r(e){if(e)return(function(s){for(var u in r.prototype)s[u]=r.prototype[u];return s})(e)}var n=f("./is-object");m.exports=r,r.prototype.clearTimeout=function(){return clearTimeout(this._timer),clearTimeout(this._responseTimeoutTimer),delete this._timer,delete this._responseTimeoutTimer,this},r.prototype.parse=function(e){return this._parser=e,this},r.prototype.responseType=function(e){return this._responseType=e,this},r.prototype.serialize=function(e){return this._serializer=e,this},r.prototype.timeout=function(e){if(!e||typeof e!="object")return this._timeout=e,this._responseTimeout=0,this;for(var s in e)switch(s){case"deadline":this._timeout=e.deadline;break;case"response":this._responseTimeout=e.response;break;default:console.warn("Unknown timeout option",s)}return this},r.prototype.retry=function(e){return arguments.length!==0&&e!==!0||(e=1),e<=0&&(e=0),this._maxRetries=e,this._retries=0,this},r.prototype._retry=function(){return this.clearTimeout(),this.req&&(this.req=null,this.req=this.request()),this._aborted=!1,this.timedout=!1,this._end()},r.prototype.then=function(e,s){if(!this._fullfilledPromise){var u=this;this._endCalled&&console.warn("Warning: superagent request was sent twice, because both .end() and .then() were called. Never call .end() if you use promises"),this._fullfilledPromise=new Promise(function(l,v){u.end(function(_,k){_?v(_):l(k)})})}return this._fullfilledPromise.then(e,s)},r.prototype.catch=function(e){return this.then(void 0,e)},r.prototype.use=function(e){return e(this),this},r.prototype.ok=function(e){if(typeof e!="function")throw Error("Callback required");return this._okCallback=e,this},r.prototype._isResponseOK=function(e){return!!e&&(this._okCallback?this._okCallback(e):e.status>=200&&e.status<300)},r.prototype.get=function(e){return this._header[e.toLowerCase()]},r.prototype.getHeader=r.prototype.get,r.prototype.set=function(e,s){if(n(e)){for(var u in e)this.set(u,e[u]);return this}return this._header[e.toLowerCase()]=s,this.header[e]=s,this},r.prototype.unset=function(e){return delete this._header[e.toLowerCase()],delete this.header[e],this},r.prototype.field=function(e,s){if(e==null)throw new Error(".field(name, val) name can not be empty");if(this._data&&console.error(".field() can't be used if .send() is used. Please use only .send() or only .field() & .attach()"),n(e)){for(var u in e)this.field(u,e[u]);return this}if(Array.isArray(s)){for(var l in s)this.field(e,s[l]);return this}if(s==null)throw new Error(".field(name, val) val can not be empty");return typeof s=="boolean"&&(s=""+s),this._getFormData().append(e,s),this},r.prototype.abort=function(){return this._aborted?this:(this._aborted=!0,this.xhr&&this.xhr.abort(),this.req&&this.req.abort(),this.clearTimeout(),this.emit("abort"),this)},r.prototype.withCredentials=function(e){return e==null&&(e=!0),this._withCredentials=e,this},r.prototype.redirects=function(e){return this._maxRedirects=e,this},r.prototype.toJSON=function(){return{method:this.method,url:this.url,data:this._data,headers:this._header}},r.prototype.send=function(e){var s=n(e),u=this._header["content-type"];if(this._formData&&console.error(".send() can't be used if .attach() or .field() is used. Please use only .send() or only .field() & .attach()"),s&&!this._data)Array.isArray(e)?this._data=[]:this._isHost(e)||(this._data={});else if(e&&this._data&&this._isHost(this._data))throw Error("Can't merge these send calls");if(s&&n(this._data))for(var l in e)this._data[l]=e[l];else typeof e=="string"?(u||this.type("form"),u=this._header["content-type"],this._data=u=="application/x-www-form-urlencoded"?this._data?this._data+"&"+e:e:(this._data||"")+e):this._data=e;return!s||this._isHost(e)?this:(u||this.type("json"),this)},r.prototype.sortQuery=function(e){return this._sort=e===void 0||e,this},r.prototype._finalizeQueryString=function(){var e=this._query.join("&");if(e&&(this.url+=(this.url.indexOf("?")>=0?"&":"?")+e),this._query.length=0,this._sort){var s=this.url.indexOf("?");if(s>=0){var u=this.url.substring(s+1).split("&");typeof this._sort=="function"?u.sort(this._sort):u.sort(),this.url=this.url.substring(0,s)+"?"+u.join("&")}}},r.prototype._appendQueryString=function(){console.trace("Unsupported")},r.prototype._timeoutError=function(e,s,u){if(!this._aborted){var l=new Error(e+s+"ms exceeded");l.timeout=s,l.code="ECONNABORTED",l.errno=u,this.timedout=!0,this.abort(),this.callback(l)}},r.prototype._setTimeouts=function(){var e=this;this._timeout&&!this._timer&&(this._timer=setTimeout(function(){e._timeoutError("Timeout of ",e._timeout,"ETIME")},this._timeout)),this._responseTimeout&&!this._responseTimeoutTimer&&(this._responseTimeoutTimer=setTimeout(function(){e._timeoutError("Response timeout of ",e._responseTimeout,"ETIMEDOUT")},this._responseTimeout))}},{"./is-object":3}],5:[function(f,m,d){function r(e){if(e)return(function(s){for(var u in r.prototype)s[u]=r.prototype[u];return s})(e)}var n=f("./utils");m.exports=r,r.prototype.get=function(e){return this.header[e.toLowerCase()]},r.prototype._setHeaderProperties=function(e){var s=e["content-type"]||"";this.type=n.type(s);var u=n.params(s);for(var l in u)this[l]=u[l];this.links={};try{e.link&&(this.links=n.parseLinks(e.link))}catch(v){}},r.prototype._setStatusProperties=function(e){var s=e/100|0;this.status=this.statusCode=e,this.statusType=s,this.info=s==1,this.ok=s==2,this.redirect=s==3,this.clientError=s==4,this.serverError=s==5,this.error=(s==4||s==5)&&this.toError(),this.accepted=e==202,this.noContent=e==204,this.badRequest=e==400,this.unauthorized=e==401,this.notAcceptable=e==406,this.forbidden=e==403,this.notFound=e==404}},{"./utils":7}],6:[function(f,m,d){var r=["ECONNRESET","ETIMEDOUT","EADDRINFO","ESOCKETTIMEDOUT"];m.exports=function(n,e){return!!(n&&n.code&&~r.indexOf(n.code))||!!(e&&e.status&&e.status>=500)||!!(n&&"timeout"in n&&n.code=="ECONNABORTED")||!!(n&&"crossDomain"in n)}},{}],7:[function(f,m,d){d.type=function(r){return r.split(/ *; */).shift()},d.params=function(r){return r.split(/ *; */).reduce(function(n,e){var s=e.split(/ *= */),u=s.shift(),l=s.shift();return u&&l&&(n[u]=l),n},{})},d.parseLinks=function(r){return r.split(/ *, */).reduce(function(n,e){var s=e.split(/ *; */),u=s[0].slice(1,-1);return n[s[1].split(/ *= */)[1].slice(1,-1)]=u,n},{})},d.cleanHeader=function(r,n){return delete r["content-type"],delete r["content-length"],delete r["transfer-encoding"],delete r.host,n&&delete r.cookie,r}},{}],8:[function(f,m,d){"use strict";Object.defineProperty(d,"__esModule",{value:!0});const r=f("superagent"),{t:n}=f("./i18n"),{csrfToken:e}=f("./csrf"),{apiToken:s}=f("./token");class u{RegenerateToken(){r.post("/api/v1/users/me/token").set("Content-Type","application/json").set("Authorization",`Bearer ${s()}`).set("X-CSRF-Token",e()).send().end(function(h,a){h?a&&a.body&&a.body.reason?(alert(`${a.body.reason}`),window.location.reload(!0)):alert(n("js.http_error",h)):(alert(n("js.token_regenerated")),window.location.reload(!0))})}RegisterPassword(){let h=document.getElementById("password"),a=document.getElementById("password-verify"),p=h.value,t=a.value;if(p==""||t==""){alert(n("js.password_empty"));return}if(p!=t){alert(n("js.password_mismatch"));return}r.put("/api/v1/users/me/password").set("Content-Type","application/json").set("Authorization",`Bearer ${s()}`).set("X-CSRF-Token",e()).send({password:p,verify_password:t}).end(function(o,i){o?i&&i.body&&i.body.reason?(alert(n("js.password_update_failed",i.body.reason)),window.location.reload(!0)):alert(n("js.http_error",o)):(alert(n("js.password_updated")),window.location.reload(!0))})}SaveLocale(){let h=document.getElementById("locale");r.patch("/api/v1/users/me").set("Content-Type","application/json").set("Authorization",`Bearer ${s()}`).set("X-CSRF-Token",e()).send({locale:h.value}).end(function(a,p){a?p&&p.body&&p.body.reason?alert(n("js.locale_save_failed",p.body.reason)):alert(n("js.http_error",a)):(alert(n("js.locale_saved")),window.location.reload(!0))})}RevokeSession(h){confirm(n("js.session_revoke_confirm"))&&r.delete(`/api/v1/users/me/sessions/${h}`).set("Authorization",`Bearer ${s()}`).set("X-CSRF-Token",e()).end(function(a,p){a?p&&p.body&&p.body.reason?alert(n("js.session_revoke_failed",p.body.reason)):alert(n("js.http_error",a)):(alert(n("js.session_revoked")),window.location.reload(!0))})}StartTOTP(){r.post("/api/v1/users/me/totp").set("Authorization",`Bearer ${s()}`).set("X-CSRF-Token",e()).end(function(h,a){h?a&&a.body&&a.body.reason?alert(n("js.totp_failed",a.body.reason)):alert(n("js.http_error",h)):(document.getElementById("totp-qr").src=a.body.qr_code,document.getElementById("totp-secret").textContent=a.body.secret,document.getElementById("totp-enroll").style.display="block",document.getElementById("totp-start").style.display="none")})}EnableTOTP(){let h=document.getElementById("totp-code").value;r.put("/api/v1/users/me/totp").set("Content-Type","application/json").set("Authorization",`Bearer ${s()}`).set("X-CSRF-Token",e()).send({code:h}).end(function(a,p){a?p&&p.body&&p.body.reason?alert(n("js.totp_failed",p.body.reason)):alert(n("js.http_error",a)):(document.getElementById("totp-enroll").style.display="none",u.showRecoveryCodes(p.body.recovery_codes),alert(n("js.totp_enabled")))})}DisableTOTP(){if(!confirm(n("js.totp_disable_confirm")))return;let h=document.getElementById("totp-current-code").value;r.delete("/api/v1/users/me/totp").set("Content-Type","application/json").set("Authorization",`Bearer ${s()}`).set("X-CSRF-Token",e()).send({code:h}).end(function(a,p){a?p&&p.body&&p.body.reason?alert(n("js.totp_failed",p.body.reason)):alert(n("js.http_error",a)):(alert(n("js.totp_disabled")),window.location.reload(!0))})}RegenerateRecoveryCodes(){let h=document.getElementById("totp-current-code").value;r.post("/api/v1/users/me/totp/recovery-codes").set("Content-Type","application/json").set("Authorization",`Bearer ${s()}`).set("X-CSRF-Token",e()).send({code:h}).end(function(a,p){a?p&&p.body&&p.body.reason?alert(n("js.totp_failed",p.body.reason)):alert(n("js.http_error",a)):u.showRecoveryCodes(p.body.recovery_codes)})}static showRecoveryCodes(h){document.getElementById("recovery-codes-list").textContent=h.join(`
+`),document.getElementById("recovery-codes").style.display="block"}}var l=new u,v=document.getElementById("regen-token");v.addEventListener("click",w=>{w.preventDefault(),l.RegenerateToken()});var _=document.getElementById("reregister-password");_.addEventListener("click",w=>{w.preventDefault(),l.RegisterPassword()});var k=document.getElementById("save-locale");k.addEventListener("click",w=>{w.preventDefault(),l.SaveLocale()});var x=document.getElementsByClassName("revoke-session");for(let w=0;w<x.length;w++){let h=x[w];h.addEventListener("click",a=>{a.preventDefault(),l.RevokeSession(h.dataset.id)})}let j=[["totp-start",()=>l.StartTOTP()],["totp-enable",()=>l.EnableTOTP()],["totp-disable",()=>l.DisableTOTP()],["totp-recovery",()=>l.RegenerateRecoveryCodes()]];for(let[w,h]of j){let a=document.getElementById(w);a&&a.addEventListener("click",p=>{p.preventDefault(),h()})}},{superagent:2,"./i18n":9,"./csrf":10,"./token":11}],9:[function(f,m,d){"use strict";Object.defineProperty(d,"__esModule",{value:!0}),d.t=e;let r={};const n=document.getElementById("messages");if(n&&n.textContent)try{r=JSON.parse(n.textContent)}catch(s){r={}}function e(s,...u){let l=r[s]||s;for(const v of u)l=l.replace(/%[sv]/,String(v));return l}},{}],10:[function(f,m,d){"use strict";Object.defineProperty(d,"__esModule",{value:!0}),d.csrfToken=r;function r(){const n=document.getElementById("csrf-token");return n?n.value:""}},{}],11:[function(f,m,d){"use strict";Object.defineProperty(d,"__esModule",{value:!0}),d.apiToken=x;const r=f("superagent"),{t:n}=f("./i18n"),{csrfToken:e}=f("./csrf"),s=60*1e3;let u="",l=0,v,_=!1,k=!1;function x(){return u}function j(a){v!==void 0&&window.clearTimeout(v),v=window.setTimeout(w,Math.max(a,0))}function w(){v!==void 0&&(window.clearTimeout(v),v=void 0),!(_||k)&&(_=!0,r.post("/api/v1/token/refresh").set("X-CSRF-Token",e()).send().end(function(a,p){_=!1,a?p&&p.status==401?(k=!0,alert(n("js.login_expired"))):j(10*1e3):(u=p.body.token,l=Date.parse(p.body.expires_at),j(l-s-Date.now()))}))}const h=document.getElementById("api-token");h&&(u=h.value,l=Number(h.dataset.expiresAt)*1e3,j(l-s-Date.now()),document.addEventListener("visibilitychange",()=>{!document.hidden&&Date.now()>l-s&&w()}))},{superagent:2,"./i18n":9,"./csrf":10}]},{},[8]);
@@ -0,0 +1,48 @@
+package vegeta
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+	"unicode"
+)
+
+// pageScripts are built from frontend/ts by gulp, and checked in because
+// bindata is made from assets. They must be rebuilt when the sources change.
+var pageScripts = []string{"admin.js", "mypage.js", "settings.js"}
+
+func readPageScript(t *testing.T, name string) string {
+	t.Helper()
+	b, err := ioutil.ReadFile(filepath.Join("assets", "js", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(b)
+}
+
+func TestPageScriptsRefreshToken(t *testing.T) {
+	for _, name := range pageScripts {
+		js := readPageScript(t, name)
+		for _, want := range []string{refreshPath, csrfHeader} {
+			if !strings.Contains(js, want) {
+				t.Errorf("%s does not use %s. Rebuild it from frontend/ts", name, want)
+			}
+		}
+	}
+}
+
+// Messages of scripts are read from the catalog which pages embed.
+func TestPageScriptsMessages(t *testing.T) {
+	for _, name := range pageScripts {
+		js := readPageScript(t, name)
+		if !strings.Contains(js, `"messages"`) {
+			t.Errorf("%s does not read the messages. Rebuild it from frontend/ts", name)
+		}
+		if i := strings.IndexFunc(js, func(r rune) bool {
+			return unicode.In(r, unicode.Hiragana, unicode.Katakana, unicode.Han)
+		}); i >= 0 {
+			t.Errorf("%s has a message which is not in the catalog: %.30q", name, js[i:])
+		}
+	}
+}
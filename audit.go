@@ -0,0 +1,290 @@
+package vegeta
+
+import (
+	"encoding/csv"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Code-Hex/vegeta/html"
+	"github.com/Code-Hex/vegeta/internal/model"
+	"github.com/labstack/echo"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// auditPageSize is how many logs are shown on a page, and auditMaxPageSize
+// is the most which can be asked at once. The export has all logs which
+// match the filter, and reads them in pages of auditMaxPageSize.
+const (
+	auditPageSize    = 200
+	auditMaxPageSize = 1000
+)
+
+const auditDateLayout = "2006-01-02"
+
+// audit records the action by actor. The action is already done,
+// so a failure is only logged.
+func (c *Context) audit(actor *model.User, action, target, detail string) {
+	log := &model.AuditLog{
+		Action:    action,
+		Target:    target,
+		Detail:    detail,
+		IPAddress: c.PeerAddr(),
+		CreatedAt: c.Now(),
+	}
+	if actor != nil {
+		log.ActorID = actor.ID
+		log.Actor = actor.Name
+	}
+	if err := model.Audit(c.DB, log); err != nil {
+		c.Zap.Error("Failed to write audit log",
+			zap.String("action", action),
+			zap.String("target", target),
+			zap.Error(err),
+		)
+	}
+}
+
+func adminDetail(admin bool) string {
+	return "admin=" + strconv.FormatBool(admin)
+}
+
+type auditQuery struct {
+	Actor  string `query:"actor"`
+	Action string `query:"action"`
+	Target string `query:"target"`
+	// Since and Until are dates as 2006-01-02. Until includes the day.
+	Since string `query:"since"`
+	Until string `query:"until"`
+	// Before is the id of the last log of the previous page.
+	Before uint `query:"before"`
+	// Limit is 200 if it is not set.
+	Limit int `query:"limit" validate:"min=0,max=1000"`
+}
+
+// auditFilter reads the filter and the size of a page from the query.
+// Invalid dates are ignored.
+func (c *Context) auditFilter() (model.AuditFilter, int, error) {
+	q := new(auditQuery)
+	if err := c.BindValidate(q); err != nil {
+		return model.AuditFilter{}, 0, err
+	}
+	f := model.AuditFilter{
+		Actor:    q.Actor,
+		Action:   q.Action,
+		Target:   q.Target,
+		BeforeID: q.Before,
+	}
+	loc := c.Now().Location()
+	if t, err := time.ParseInLocation(auditDateLayout, q.Since, loc); err == nil {
+		f.Since = t
+	}
+	if t, err := time.ParseInLocation(auditDateLayout, q.Until, loc); err == nil {
+		f.Until = t.AddDate(0, 0, 1)
+	}
+	limit := q.Limit
+	if limit == 0 {
+		limit = auditPageSize
+	}
+	return f, limit, nil
+}
+
+// auditPage returns a page of logs, and the id of the last one if
+// there are more.
+func (c *Context) auditPage(f model.AuditFilter, limit int) ([]*model.AuditLog, uint, error) {
+	logs, err := model.GetAuditLogs(c.DB, f, limit+1)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(logs) <= limit {
+		return logs, 0, nil
+	}
+	logs = logs[:limit]
+	return logs, logs[limit-1].ID, nil
+}
+
+type auditArgs struct {
+	html.Args
+	logs   []*model.AuditLog
+	filter model.AuditFilter
+	limit  int
+	next   uint
+}
+
+func (a *auditArgs) Logs() []*model.AuditLog { return a.logs }
+func (auditArgs) Actions() []string          { return model.AuditActions }
+func (a *auditArgs) Actor() string           { return a.filter.Actor }
+func (a *auditArgs) Action() string          { return a.filter.Action }
+func (a *auditArgs) Target() string          { return a.filter.Target }
+
+func (a *auditArgs) Since() string {
+	if a.filter.Since.IsZero() {
+		return ""
+	}
+	return a.filter.Since.Format(auditDateLayout)
+}
+
+func (a *auditArgs) Until() string {
+	if a.filter.Until.IsZero() {
+		return ""
+	}
+	return a.filter.Until.AddDate(0, 0, -1).Format(auditDateLayout)
+}
+
+// ExportQuery is the query of the export with the same filter.
+func (a *auditArgs) ExportQuery() string {
+	return a.filterQuery().Encode()
+}
+
+// NextQuery is the query of the next page, or empty on the last page.
+func (a *auditArgs) NextQuery() string {
+	if a.next == 0 {
+		return ""
+	}
+	q := a.filterQuery()
+	q.Set("before", strconv.FormatUint(uint64(a.next), 10))
+	if a.limit != auditPageSize {
+		q.Set("limit", strconv.Itoa(a.limit))
+	}
+	return q.Encode()
+}
+
+func (a *auditArgs) filterQuery() url.Values {
+	q := url.Values{}
+	for k, v := range map[string]string{
+		"actor":  a.Actor(),
+		"action": a.Action(),
+		"target": a.Target(),
+		"since":  a.Since(),
+		"until":  a.Until(),
+	} {
+		if v != "" {
+			q.Set(k, v)
+		}
+	}
+	return q
+}
+
+func AuditLogs() echo.HandlerFunc {
+	return call(func(c *Context) error {
+		filter, limit, err := c.auditFilter()
+		if err != nil {
+			return err
+		}
+		logs, next, err := c.auditPage(filter, limit)
+		if err != nil {
+			return apiError(err, "")
+		}
+		args := &auditArgs{
+			Args:   c.GetUserStatus(),
+			logs:   logs,
+			filter: filter,
+			limit:  limit,
+			next:   next,
+		}
+		html.AuditLogs(args, c.Response())
+		return nil
+	})
+}
+
+// ExportAuditLogs downloads the logs as CSV.
+func ExportAuditLogs() echo.HandlerFunc {
+	return call(func(c *Context) error {
+		filter, _, err := c.auditFilter()
+		if err != nil {
+			return err
+		}
+		logs, next, err := c.auditPage(filter, auditMaxPageSize)
+		if err != nil {
+			return apiError(err, "")
+		}
+		h := c.Response().Header()
+		h.Set(echo.HeaderContentType, "text/csv; charset=utf-8")
+		h.Set(echo.HeaderContentDisposition, `attachment; filename="audit-`+c.Now().Format("20060102")+`.csv"`)
+		c.Response().WriteHeader(http.StatusOK)
+
+		w := csv.NewWriter(c.Response())
+		w.Write([]string{"id", "time", "actor_id", "actor", "action", "target", "detail", "ip"})
+		for {
+			for _, l := range logs {
+				w.Write([]string{
+					strconv.FormatUint(uint64(l.ID), 10),
+					l.CreatedAt.Format(time.RFC3339),
+					strconv.FormatUint(uint64(l.ActorID), 10),
+					csvText(l.Actor),
+					l.Action,
+					csvText(l.Target),
+					csvText(l.Detail),
+					l.IPAddress,
+				})
+			}
+			w.Flush()
+			if err := w.Error(); err != nil || next == 0 {
+				return err
+			}
+			filter.BeforeID = next
+			if logs, next, err = c.auditPage(filter, auditMaxPageSize); err != nil {
+				return errors.Wrap(err, "Failed to get audit logs")
+			}
+		}
+	})
+}
+
+// csvText keeps spreadsheets from evaluating names as formulas.
+func csvText(s string) string {
+	if s != "" && strings.ContainsRune("=+-@\t\r", rune(s[0])) {
+		return "'" + s
+	}
+	return s
+}
+
+type auditLogJSON struct {
+	ID uint `json:"id"`
+	// ActorID is 0 for the command line.
+	ActorID   uint      `json:"actor_id"`
+	Actor     string    `json:"actor"`
+	Action    string    `json:"action"`
+	Target    string    `json:"target"`
+	Detail    string    `json:"detail,omitempty"`
+	IPAddress string    `json:"ip"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type auditLogsJSON struct {
+	Logs []*auditLogJSON `json:"logs"`
+	// Next is the before of the next page. It is omitted on the last page.
+	Next uint `json:"next,omitempty"`
+}
+
+func GetAuditLogs() echo.HandlerFunc {
+	return call(func(c *Context) error {
+		filter, limit, err := c.auditFilter()
+		if err != nil {
+			return err
+		}
+		logs, next, err := c.auditPage(filter, limit)
+		if err != nil {
+			return apiError(err, "")
+		}
+		result := &auditLogsJSON{
+			Logs: make([]*auditLogJSON, len(logs)),
+			Next: next,
+		}
+		for i, l := range logs {
+			result.Logs[i] = &auditLogJSON{
+				ID:        l.ID,
+				ActorID:   l.ActorID,
+				Actor:     l.Actor,
+				Action:    l.Action,
+				Target:    l.Target,
+				Detail:    l.Detail,
+				IPAddress: l.IPAddress,
+				CreatedAt: l.CreatedAt,
+			}
+		}
+		return c.JSON(http.StatusOK, result)
+	})
+}
@@ -0,0 +1,116 @@
+package vegeta
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Code-Hex/vegeta/internal/model"
+)
+
+// addAuditLogs appends n logs which are newer than others by a second.
+func (s *testServer) addAuditLogs(n int) {
+	s.t.Helper()
+	var count int
+	if err := s.DB.Model(&model.AuditLog{}).Count(&count).Error; err != nil {
+		s.t.Fatal(err)
+	}
+	base := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	tx := s.DB.Begin()
+	for i := count; i < count+n; i++ {
+		err := model.Audit(tx, &model.AuditLog{
+			Actor:     "root",
+			Action:    model.AuditUserCreate,
+			Target:    "user" + strconv.Itoa(i),
+			CreatedAt: base.Add(time.Duration(i) * time.Second),
+		})
+		if err != nil {
+			tx.Rollback()
+			s.t.Fatal(err)
+		}
+	}
+	tx.Commit()
+}
+
+func TestAuditLogsPages(t *testing.T) {
+	s := newTestServer(t)
+	s.createUser("root", "root-password1", true)
+	s.addAuditLogs(5)
+	c := s.loggedIn("root", "root-password1")
+	token := c.pageToken("/mypage/settings")
+
+	var targets []string
+	path := "/api/v1/audit-logs?limit=2"
+	for pages := 0; ; pages++ {
+		if pages > 3 {
+			t.Fatal("too many pages")
+		}
+		resp, body := c.api(http.MethodGet, path, token, "", nil)
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("status = %d: %s", resp.StatusCode, body)
+		}
+		var result auditLogsJSON
+		if err := json.Unmarshal([]byte(body), &result); err != nil {
+			t.Fatal(err)
+		}
+		for _, l := range result.Logs {
+			targets = append(targets, l.Target)
+		}
+		if result.Next == 0 {
+			break
+		}
+		// A new log does not shift the next page.
+		s.addAuditLogs(1)
+		path = "/api/v1/audit-logs?limit=2&before=" + strconv.FormatUint(uint64(result.Next), 10)
+	}
+	if got, want := strings.Join(targets, ","), "user4,user3,user2,user1,user0"; got != want {
+		t.Errorf("logs = %s, want %s", got, want)
+	}
+
+	for _, path := range []string{
+		"/api/v1/audit-logs?limit=1001",
+		"/api/v1/audit-logs?limit=-1",
+		"/api/v1/audit-logs?before=100000",
+	} {
+		if resp, body := c.api(http.MethodGet, path, token, "", nil); resp.StatusCode != http.StatusBadRequest {
+			t.Errorf("%s: status = %d, want %d: %s", path, resp.StatusCode, http.StatusBadRequest, body)
+		}
+	}
+}
+
+func TestAuditLogsPageLink(t *testing.T) {
+	s := newTestServer(t)
+	s.createUser("root", "root-password1", true)
+	s.addAuditLogs(auditPageSize + 1)
+	c := s.loggedIn("root", "root-password1")
+	_, body := c.get("/mypage/admin/audit?target=user1")
+	if strings.Contains(body, "before=") {
+		t.Error("the last page has the link to older logs")
+	}
+	_, body = c.get("/mypage/admin/audit")
+	if !strings.Contains(body, `href="/mypage/admin/audit?before=`) {
+		t.Error("the page has no link to older logs")
+	}
+}
+
+func TestExportAuditLogsReadsAllPages(t *testing.T) {
+	s := newTestServer(t)
+	s.createUser("root", "root-password1", true)
+	n := auditMaxPageSize*2 + 1
+	s.addAuditLogs(n)
+	c := s.loggedIn("root", "root-password1")
+	resp, body := c.get("/mypage/admin/audit/export?action=" + model.AuditUserCreate)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("status = %d", resp.StatusCode)
+	}
+	lines := strings.Split(strings.TrimSpace(body), "\n")
+	if got := len(lines) - 1; got != n {
+		t.Errorf("exported %d logs, want %d", got, n)
+	}
+	if !strings.Contains(lines[len(lines)-1], ",user0,") {
+		t.Errorf("the last line is %s, want the oldest", lines[len(lines)-1])
+	}
+}
@@ -0,0 +1,203 @@
+// Package client provides a Go client for the vegeta JSON API.
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Code-Hex/vegeta/internal/common"
+	"github.com/pkg/errors"
+)
+
+const authScheme = "Bearer"
+
+// Client sends requests to the /api/v1 endpoints of a vegeta server.
+type Client struct {
+	// HTTPClient is used to send requests. http.DefaultClient is used if nil.
+	HTTPClient *http.Client
+
+	baseURL *url.URL
+	token   string
+}
+
+// New creates a client for the server at baseURL which authenticates
+// with the user token.
+func New(baseURL, token string) (*Client, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to parse base url")
+	}
+	if u.Scheme == "" || u.Host == "" {
+		return nil, errors.Errorf("Invalid base url: %s", baseURL)
+	}
+	return &Client{
+		baseURL: u,
+		token:   token,
+	}, nil
+}
+
+// Tags returns the names of tags owned by the user.
+func (c *Client) Tags(ctx context.Context) ([]string, error) {
+	var result struct {
+		Tags []string `json:"tags"`
+	}
+	if err := c.do(ctx, http.MethodGet, "/api/v1/tags", nil, nil, &result); err != nil {
+		return nil, err
+	}
+	return result.Tags, nil
+}
+
+// AddTag creates a new tag.
+func (c *Client) AddTag(ctx context.Context, name string) error {
+	body := &tagJSON{TagName: name}
+	return c.do(ctx, http.MethodPost, "/api/v1/tags", nil, body, nil)
+}
+
+// DeleteTag removes the tag.
+func (c *Client) DeleteTag(ctx context.Context, name string) error {
+	path := "/api/v1/tags/" + url.PathEscape(name)
+	return c.do(ctx, http.MethodDelete, path, nil, nil, nil)
+}
+
+// PostData sends a data to the tag.
+func (c *Client) PostData(ctx context.Context, tag string, data *Data) error {
+	path := "/api/v1/tags/" + url.PathEscape(tag) + "/data"
+	return c.do(ctx, http.MethodPost, path, nil, data.toJSON(tag), nil)
+}
+
+// MaxBatchSize is the most data which the server accepts in a batch.
+const MaxBatchSize = common.MaxBatchSize
+
+// PostDataBatch sends some data in a single request. The server stores
+// all of them or none of them. It sends at most MaxBatchSize data.
+func (c *Client) PostDataBatch(ctx context.Context, tag string, someData []*Data) error {
+	if len(someData) > MaxBatchSize {
+		return errors.Errorf("Too many data in a batch: %d, at most %d", len(someData), MaxBatchSize)
+	}
+	body := &postDataBatchJSON{
+		Data: make([]*postDataJSON, len(someData)),
+	}
+	for i, d := range someData {
+		body.Data[i] = d.toJSON(tag)
+	}
+	return c.do(ctx, http.MethodPost, "/api/v1/data", nil, body, nil)
+}
+
+// FindData returns data of the tag which matches the query.
+func (c *Client) FindData(ctx context.Context, q *Query) ([]*Data, error) {
+	var result struct {
+		Data []*Data `json:"data"`
+	}
+	path := "/api/v1/tags/" + url.PathEscape(q.Tag) + "/data"
+	if err := c.do(ctx, http.MethodGet, path, q.values(), nil, &result); err != nil {
+		return nil, err
+	}
+	return result.Data, nil
+}
+
+// makeURL joins path to the path of the base url, so that servers
+// under a prefix such as https://example.com/vegeta work.
+func (c *Client) makeURL(path string, query url.Values) (string, error) {
+	ref, err := url.Parse(path)
+	if err != nil {
+		return "", err
+	}
+	u := *c.baseURL
+	u.Path = strings.TrimSuffix(c.baseURL.Path, "/") + ref.Path
+	u.RawPath = strings.TrimSuffix(c.baseURL.EscapedPath(), "/") + ref.EscapedPath()
+	u.RawQuery = ""
+	if query != nil {
+		u.RawQuery = query.Encode()
+	}
+	return u.String(), nil
+}
+
+func (c *Client) httpClient() *http.Client {
+	if c.HTTPClient != nil {
+		return c.HTTPClient
+	}
+	return http.DefaultClient
+}
+
+// do sends a request and decodes the response into v. If v is nil,
+// the response is expected to be empty or a result json.
+func (c *Client) do(ctx context.Context, method, path string, query url.Values, body, v interface{}) error {
+	url, err := c.makeURL(path, query)
+	if err != nil {
+		return errors.Wrap(err, "Failed to make URL")
+	}
+	var r io.Reader
+	if body != nil {
+		buf := new(bytes.Buffer)
+		if err := json.NewEncoder(buf).Encode(body); err != nil {
+			return errors.Wrap(err, "Failed to encode request body")
+		}
+		r = buf
+	}
+	req, err := http.NewRequest(method, url, r)
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	req.Header.Set("Authorization", authScheme+" "+c.token)
+
+	resp, err := c.httpClient().Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || 300 <= resp.StatusCode {
+		return newError(resp)
+	}
+	if resp.StatusCode == http.StatusNoContent {
+		return nil
+	}
+	if v != nil {
+		if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+			return errors.Wrap(err, "Failed to decode response")
+		}
+		return nil
+	}
+	result := new(resultJSON)
+	if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+		return errors.Wrap(err, "Failed to decode response")
+	}
+	if !result.IsSuccess {
+		return &Error{
+			StatusCode: resp.StatusCode,
+			Code:       result.Code,
+			Reason:     result.Reason,
+			RequestID:  result.RequestID,
+		}
+	}
+	return nil
+}
+
+func (q *Query) values() url.Values {
+	v := url.Values{}
+	span := q.Span
+	if span == "" {
+		span = SpanAll
+	}
+	v.Set("span", span)
+	v.Set("limit", strconv.FormatUint(uint64(q.Limit), 10))
+	if q.Page > 0 {
+		v.Set("page", strconv.FormatUint(uint64(q.Page), 10))
+	}
+	if !q.StartAt.IsZero() {
+		v.Set("start_at", q.StartAt.Format(time.RFC3339))
+	}
+	if !q.EndAt.IsZero() {
+		v.Set("end_at", q.EndAt.Format(time.RFC3339))
+	}
+	return v
+}
@@ -0,0 +1,82 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestMakeURL(t *testing.T) {
+	tests := []struct {
+		base, path string
+		query      url.Values
+		want       string
+	}{
+		{"https://example.com", "/api/v1/tags", nil, "https://example.com/api/v1/tags"},
+		{"https://example.com/", "/api/v1/tags", nil, "https://example.com/api/v1/tags"},
+		{"https://example.com/vegeta", "/api/v1/tags", nil, "https://example.com/vegeta/api/v1/tags"},
+		{"https://example.com/vegeta/", "/api/v1/tags", nil, "https://example.com/vegeta/api/v1/tags"},
+		{"https://example.com/vegeta", "/api/v1/tags/" + url.PathEscape("a/b") + "/data", url.Values{"limit": {"10"}},
+			"https://example.com/vegeta/api/v1/tags/a%2Fb/data?limit=10"},
+	}
+	for _, tt := range tests {
+		c, err := New(tt.base, "token")
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := c.makeURL(tt.path, tt.query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tt.want {
+			t.Errorf("makeURL(%q) of %s = %s, want %s", tt.path, tt.base, got, tt.want)
+		}
+	}
+}
+
+func TestClientUnderPrefix(t *testing.T) {
+	var got string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.URL.EscapedPath()
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+	c, err := New(ts.URL+"/vegeta/", "token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := c.DeleteTag(context.Background(), "room"); err != nil {
+		t.Fatal(err)
+	}
+	if want := "/vegeta/api/v1/tags/room"; got != want {
+		t.Errorf("path = %s, want %s", got, want)
+	}
+}
+
+func TestPostDataBatchTooLarge(t *testing.T) {
+	sent := false
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sent = true
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+	c, err := New(ts.URL, "token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	someData := make([]*Data, MaxBatchSize+1)
+	for i := range someData {
+		someData[i] = &Data{Payload: "{}"}
+	}
+	if err := c.PostDataBatch(context.Background(), "room", someData); err == nil {
+		t.Error("batch larger than MaxBatchSize is sent")
+	}
+	if sent {
+		t.Error("request is sent")
+	}
+	if err := c.PostDataBatch(context.Background(), "room", someData[:MaxBatchSize]); err != nil {
+		t.Error(err)
+	}
+}
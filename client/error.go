@@ -0,0 +1,128 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/Code-Hex/vegeta/internal/common"
+)
+
+// Error codes which are returned from the server. Use them to branch
+// on errors instead of the reason.
+const (
+	CodeInvalidRequest   = common.CodeInvalidRequest
+	CodeValidationFailed = common.CodeValidationFailed
+	CodePasswordPolicy   = common.CodePasswordPolicy
+	CodeUnauthorized     = common.CodeUnauthorized
+	CodeForbidden        = common.CodeForbidden
+	CodeTOTPRequired     = common.CodeTOTPRequired
+	CodeNotFound         = common.CodeNotFound
+	CodeMethodNotAllowed = common.CodeMethodNotAllowed
+	CodeAlreadyExists    = common.CodeAlreadyExists
+	CodeLocked           = common.CodeLocked
+	CodeSuspended        = common.CodeSuspended
+	CodeUnavailable      = common.CodeUnavailable
+	CodeInternal         = common.CodeInternal
+)
+
+// Error is returned when the server rejects a request.
+type Error struct {
+	StatusCode int
+	Code       string
+	Reason     string
+	// Details has additional information for the code. For example,
+	// it has a list of invalid fields for validation_failed.
+	Details   json.RawMessage
+	RequestID string
+}
+
+func (e *Error) Error() string {
+	reason := e.Reason
+	if reason == "" {
+		reason = http.StatusText(e.StatusCode)
+	}
+	if e.Code == "" {
+		return fmt.Sprintf("%d: %s", e.StatusCode, reason)
+	}
+	return fmt.Sprintf("%d %s: %s", e.StatusCode, e.Code, reason)
+}
+
+// ErrorCode returns the error code of err. It returns an empty string
+// if err is not returned from the server.
+func ErrorCode(err error) string {
+	if e, ok := err.(*Error); ok {
+		return e.Code
+	}
+	return ""
+}
+
+func is(err error, code string, status int) bool {
+	e, ok := err.(*Error)
+	if !ok {
+		return false
+	}
+	if e.Code != "" {
+		return e.Code == code
+	}
+	return e.StatusCode == status
+}
+
+// IsUnauthorized reports whether err is caused by an invalid token.
+func IsUnauthorized(err error) bool {
+	return is(err, CodeUnauthorized, http.StatusUnauthorized)
+}
+
+// IsForbidden reports whether err is caused by lack of permission.
+func IsForbidden(err error) bool {
+	return is(err, CodeForbidden, http.StatusForbidden)
+}
+
+// IsSuspended reports whether err is caused by the user who is suspended.
+func IsSuspended(err error) bool {
+	e, ok := err.(*Error)
+	return ok && e.Code == CodeSuspended
+}
+
+// IsNotFound reports whether err is caused by a missing resource.
+func IsNotFound(err error) bool {
+	return is(err, CodeNotFound, http.StatusNotFound)
+}
+
+// IsAlreadyExists reports whether err is caused by a resource which already exists.
+func IsAlreadyExists(err error) bool {
+	return is(err, CodeAlreadyExists, http.StatusConflict)
+}
+
+// IsBadRequest reports whether err is caused by invalid parameters.
+func IsBadRequest(err error) bool {
+	e, ok := err.(*Error)
+	return ok && e.StatusCode == http.StatusBadRequest
+}
+
+func newError(resp *http.Response) *Error {
+	e := &Error{StatusCode: resp.StatusCode}
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return e
+	}
+	// result json has "reason", older servers return "message" from echo's error handler.
+	var v struct {
+		Code      string          `json:"code"`
+		Reason    string          `json:"reason"`
+		Message   string          `json:"message"`
+		Details   json.RawMessage `json:"details"`
+		RequestID string          `json:"request_id"`
+	}
+	if json.Unmarshal(body, &v) == nil {
+		e.Code = v.Code
+		e.Reason = v.Reason
+		if e.Reason == "" {
+			e.Reason = v.Message
+		}
+		e.Details = v.Details
+		e.RequestID = v.RequestID
+	}
+	return e
+}
@@ -0,0 +1,157 @@
+package client
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// maxLineSize is the maximum size of a line in the stream.
+const maxLineSize = 1 << 20
+
+// defaultRetry is the time to wait before reconnecting. The server can
+// change it by the retry field.
+const defaultRetry = 3 * time.Second
+
+// Tail calls fn with data of the tag as soon as it is ingested. If hostname
+// is not empty, only data sent from the host is received. Tail blocks until
+// ctx is canceled or fn returns an error. When the stream is closed or
+// broken, Tail reconnects after the retry time. Errors of the first
+// connection and client errors of the server are returned without retrying.
+func (c *Client) Tail(ctx context.Context, tag, hostname string, fn func(*Data) error) error {
+	path := "/api/v1/tags/" + url.PathEscape(tag) + "/data/stream"
+	query := url.Values{}
+	if hostname != "" {
+		query.Set("hostname", hostname)
+	}
+	url, err := c.makeURL(path, query)
+	if err != nil {
+		return errors.Wrap(err, "Failed to make URL")
+	}
+	s := &stream{url: url, retry: defaultRetry, fn: fn}
+	for {
+		err := c.tail(ctx, s)
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if !s.connected || !s.retryable(err) {
+			return err
+		}
+		timer := time.NewTimer(s.retry)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// stream keeps the state of Tail across reconnections.
+type stream struct {
+	url       string
+	retry     time.Duration
+	connected bool
+	fn        func(*Data) error
+}
+
+// streamError is returned when the stream is closed or broken.
+type streamError struct {
+	err error
+}
+
+func (e *streamError) Error() string {
+	if e.err == nil {
+		return "Stream is closed"
+	}
+	return "Stream is broken: " + e.err.Error()
+}
+
+func (s *stream) retryable(err error) bool {
+	switch e := err.(type) {
+	case *streamError:
+		return true
+	case *Error:
+		// Proxies return them while the server is restarted.
+		return e.StatusCode >= http.StatusInternalServerError
+	case *url.Error:
+		return true
+	}
+	return false
+}
+
+// tail reads events until the stream ends.
+func (c *Client) tail(ctx context.Context, s *stream) error {
+	req, err := http.NewRequest(http.MethodGet, s.url, nil)
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Accept", "text/event-stream")
+	req.Header.Set("Authorization", authScheme+" "+c.token)
+
+	resp, err := c.httpClient().Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return newError(resp)
+	}
+	s.connected = true
+
+	var event string
+	buf := new(bytes.Buffer)
+	sc := bufio.NewScanner(resp.Body)
+	sc.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), maxLineSize)
+	for sc.Scan() {
+		line := sc.Bytes()
+		if len(line) == 0 { // dispatch the event
+			if event == "data" && buf.Len() > 0 {
+				data := new(Data)
+				if err := json.Unmarshal(bytes.TrimSuffix(buf.Bytes(), []byte("\n")), data); err != nil {
+					return errors.Wrap(err, "Failed to decode data")
+				}
+				if err := s.fn(data); err != nil {
+					return err
+				}
+			}
+			event = ""
+			buf.Reset()
+			continue
+		}
+		if line[0] == ':' { // comment
+			continue
+		}
+		field, value := line, []byte(nil)
+		if i := bytes.IndexByte(line, ':'); i >= 0 {
+			field, value = line[:i], bytes.TrimPrefix(line[i+1:], []byte(" "))
+		}
+		switch string(field) {
+		case "event":
+			event = string(value)
+		case "data":
+			// Lines of data are joined by newlines.
+			buf.Write(value)
+			buf.WriteByte('\n')
+		case "retry":
+			if ms, err := strconv.Atoi(string(value)); err == nil && ms >= 0 {
+				s.retry = time.Duration(ms) * time.Millisecond
+			}
+		}
+	}
+	if err := sc.Err(); err == bufio.ErrTooLong {
+		return errors.Wrap(err, "Failed to read stream")
+	} else if err != nil {
+		return &streamError{err: err}
+	}
+	return &streamError{}
+}
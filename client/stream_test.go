@@ -0,0 +1,148 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+var errStop = errors.New("stop")
+
+// collect returns fn for Tail which keeps payloads, and stops after n.
+func collect(payloads *[]string, n int) func(*Data) error {
+	return func(data *Data) error {
+		*payloads = append(*payloads, data.Hostname+" "+data.Payload)
+		if len(*payloads) == n {
+			return errStop
+		}
+		return nil
+	}
+}
+
+func TestTailParsesEvents(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.EscapedPath(); got != "/api/v1/tags/a%2Fb/data/stream" {
+			t.Errorf("path = %s", got)
+		}
+		if got := r.URL.Query().Get("hostname"); got != "sensor" {
+			t.Errorf("hostname = %s", got)
+		}
+		if got := r.Header.Get("Authorization"); got != "Bearer token" {
+			t.Errorf("authorization = %s", got)
+		}
+		w.Header().Set("Content-Type", "text/event-stream")
+		fmt.Fprint(w, ": keep-alive\n\n")
+		fmt.Fprint(w, "event: data\ndata: {\"hostname\":\"one\",\"payload\":\"1\"}\n\n")
+		// Lines of data are joined, and comments between them are skipped.
+		fmt.Fprint(w, "event: data\ndata: {\"hostname\":\"two\",\n: keep-alive\ndata:\"payload\":\"2\"}\n\n")
+		// Events of other types are skipped.
+		fmt.Fprint(w, "event: other\ndata: {\"hostname\":\"other\"}\n\n")
+		fmt.Fprint(w, "event: data\r\ndata: {\"hostname\":\"three\",\"payload\":\"3\"}\r\n\r\n")
+	}))
+	defer ts.Close()
+	c, err := New(ts.URL, "token")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	if err := c.Tail(context.Background(), "a/b", "sensor", collect(&got, 3)); err != errStop {
+		t.Fatalf("Tail returns %v", err)
+	}
+	want := []string{"one 1", "two 2", "three 3"}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestTailReconnects(t *testing.T) {
+	var (
+		mu    sync.Mutex
+		count int
+	)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		count++
+		n := count
+		mu.Unlock()
+		switch n {
+		case 2:
+			// Proxies return it while the server is restarted.
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		case 4:
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, `{"code":"unauthorized","reason":"invalid token"}`)
+			return
+		}
+		w.Header().Set("Content-Type", "text/event-stream")
+		fmt.Fprintf(w, "retry: 10\nevent: data\ndata: {\"hostname\":\"conn\",\"payload\":\"%d\"}\n\n", n)
+		// An incomplete event is discarded when the stream is closed.
+		fmt.Fprint(w, "event: data\ndata: {\"hostname\":\"lost\"}\n")
+	}))
+	defer ts.Close()
+	c, err := New(ts.URL, "token")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	err = c.Tail(context.Background(), "room", "", collect(&got, 10))
+	if code := ErrorCode(err); code != CodeUnauthorized {
+		t.Errorf("Tail returns %v, want %s", err, CodeUnauthorized)
+	}
+	want := []string{"conn 1", "conn 3"}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if count != 4 {
+		t.Errorf("connected %d times, want 4", count)
+	}
+}
+
+func TestTailDoesNotRetryFirstConnection(t *testing.T) {
+	var count int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		count++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer ts.Close()
+	c, err := New(ts.URL, "token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = c.Tail(context.Background(), "room", "", func(*Data) error { return nil })
+	if e, ok := err.(*Error); !ok || e.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("Tail returns %v", err)
+	}
+	if count != 1 {
+		t.Errorf("connected %d times", count)
+	}
+}
+
+func TestTailStopsByContext(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		// The stream is closed at once, and Tail waits to reconnect.
+		fmt.Fprint(w, "retry: 60000\n\n")
+	}))
+	defer ts.Close()
+	c, err := New(ts.URL, "token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	if err := c.Tail(ctx, "room", "", func(*Data) error { return nil }); err != context.DeadlineExceeded {
+		t.Errorf("Tail returns %v", err)
+	}
+	if d := time.Since(start); d > 5*time.Second {
+		t.Errorf("Tail returns after %s", d)
+	}
+}
@@ -0,0 +1,64 @@
+package client
+
+import "time"
+
+// Span of data to find.
+const (
+	SpanWeek  = "week"
+	SpanMonth = "month"
+	SpanAll   = "all"
+)
+
+// Data is a piece of data which is sent from a device. Payload is a json
+// string. RemoteAddr is the address reported by the device, and PeerAddr is
+// the address which the server observed. PeerAddr is ignored on sending.
+type Data struct {
+	UpdatedAt  time.Time `json:"updated_at"`
+	RemoteAddr string    `json:"remote_addr"`
+	PeerAddr   string    `json:"peer_addr"`
+	Hostname   string    `json:"hostname"`
+	Payload    string    `json:"payload"`
+}
+
+// Query is the condition to find data.
+type Query struct {
+	Tag   string
+	Span  string
+	Limit uint
+	// Page starts from 0.
+	Page uint
+	// StartAt and EndAt are used only if Span is SpanAll.
+	// Zero values are not sent.
+	StartAt, EndAt time.Time
+}
+
+type resultJSON struct {
+	IsSuccess bool   `json:"is_success"`
+	Code      string `json:"code"`
+	Reason    string `json:"reason"`
+	RequestID string `json:"request_id"`
+}
+
+type tagJSON struct {
+	TagName string `json:"tag_name"`
+}
+
+type postDataJSON struct {
+	Payload    string `json:"payload"`
+	Hostname   string `json:"hostname"`
+	RemoteAddr string `json:"remote_addr"`
+	TagName    string `json:"tag_name"`
+}
+
+type postDataBatchJSON struct {
+	Data []*postDataJSON `json:"data"`
+}
+
+func (d *Data) toJSON(tag string) *postDataJSON {
+	return &postDataJSON{
+		Payload:    d.Payload,
+		Hostname:   d.Hostname,
+		RemoteAddr: d.RemoteAddr,
+		TagName:    tag,
+	}
+}
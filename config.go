@@ -0,0 +1,575 @@
+package vegeta
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"github.com/Code-Hex/exit"
+	"github.com/Code-Hex/vegeta/internal/model"
+	"github.com/Code-Hex/vegeta/internal/password"
+	"github.com/pkg/errors"
+	"go.uber.org/zap/zapcore"
+)
+
+// Config is loaded from the file which is specified by --config or $VEGETA_CONFIG.
+// Environment variables override the file, and command line options of
+// app/vegeta override both.
+//
+//	stage = "production"
+//	secret = "..."
+//
+//	[server]
+//	port = 3000
+//	external_url = "https://vegeta.example.com"
+//	trusted_proxies = ["127.0.0.1"]
+//	shutdown_timeout = "30s"
+//	session_max_age = "720h"
+//
+//	[database]
+//	username = "vegeta"
+//	password = "..."
+//	database = "vegeta"
+//	host = "localhost:3306"
+//
+//	[log]
+//	output = "both"
+//	level = "info"
+//	encoding = "json"
+//	dir = "log"
+//	name = "vegeta_log"
+//	rotation_time = "1h"
+//	max_age = "24h"
+//
+//	[tls]
+//	cert_file = "/etc/vegeta/server.crt"
+//	key_file = "/etc/vegeta/server.key"
+//	client_ca_file = "/etc/vegeta/devices-ca.crt"
+//
+//	[tls.users]
+//	"sensor-01" = "alice"
+//
+//	[password]
+//	algorithm = "argon2id"
+//	argon2_time = 1
+//	argon2_memory = 65536
+//	argon2_threads = 4
+//	bcrypt_cost = 10
+//	min_length = 8
+//	max_length = 72
+//	require_letter = true
+//	require_digit = true
+//
+//	[login]
+//	free_attempts = 3
+//	base_delay = "1s"
+//	max_delay = "15m"
+//	ip_free_attempts = 20
+//	ip_window = "15m"
+//	require_admin_totp = true
+//
+//	[jwt]
+//	access_token_ttl = "15m"
+//	refresh_token_ttl = "24h"
+//	signing_key = "2024-10"
+//
+//	[[jwt.keys]]
+//	id = "2024-10"
+//	secret = "..."
+//
+//	[oidc]
+//	issuer = "https://idp.example.ac.jp"
+//	client_id = "vegeta"
+//	client_secret = "..."
+//	redirect_url = "https://vegeta.example.com/auth/oidc/callback"
+//	display_name = "University ID"
+//	scopes = ["openid", "profile", "groups"]
+//	username_claim = "preferred_username"
+//	groups_claim = "groups"
+//	admin_group = "vegeta-admins"
+//	link_by_name = false
+//
+//	[features]
+//	stream = true
+//	metrics = true
+//	metrics_token = "..."
+//	metrics_max_tags = 100
+type Config struct {
+	Stage    string         `toml:"stage"`
+	Secret   string         `toml:"secret"`
+	Server   ServerConfig   `toml:"server"`
+	Database DatabaseConfig `toml:"database"`
+	Log      LogConfig      `toml:"log"`
+	TLS      TLSConfig      `toml:"tls"`
+	Password PasswordConfig `toml:"password"`
+	Login    LoginConfig    `toml:"login"`
+	JWT      JWTConfig      `toml:"jwt"`
+	OIDC     OIDCConfig     `toml:"oidc"`
+	Features FeatureConfig  `toml:"features"`
+}
+
+type ServerConfig struct {
+	Port int `toml:"port"`
+	// ExternalURL is the URL which users open, and is used to make links
+	// such as invites. The host of requests is used if it is empty.
+	ExternalURL    string   `toml:"external_url"`
+	TrustedProxies []string `toml:"trusted_proxies"`
+	// ShutdownTimeout is how long to wait for in-flight requests on shutdown.
+	ShutdownTimeout Duration `toml:"shutdown_timeout"`
+	// SessionMaxAge is how long a login session lasts.
+	SessionMaxAge Duration `toml:"session_max_age"`
+}
+
+// Duration is decoded from a string such as "30s".
+type Duration struct {
+	time.Duration
+}
+
+func (d Duration) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d *Duration) UnmarshalText(text []byte) error {
+	var err error
+	d.Duration, err = time.ParseDuration(string(text))
+	return err
+}
+
+type DatabaseConfig struct {
+	Username string `toml:"username"`
+	Password string `toml:"password"`
+	Database string `toml:"database"`
+	// Host is "host:port". Connect via the default unix socket if it is empty.
+	Host string `toml:"host"`
+}
+
+// TLSConfig enables TLS if CertFile and KeyFile are set. If ClientCAFile is set,
+// devices can authenticate to /api by client certificates signed by the CA.
+type TLSConfig struct {
+	CertFile     string `toml:"cert_file"`
+	KeyFile      string `toml:"key_file"`
+	ClientCAFile string `toml:"client_ca_file"`
+	// Users maps the common name of a client certificate to the user name.
+	Users map[string]string `toml:"users"`
+}
+
+func (c *TLSConfig) enabled() bool {
+	return c.CertFile != "" && c.KeyFile != ""
+}
+
+// LogConfig decides where and how to write logs. Output is "file", "stdout"
+// or "both". Encoding is "json" or "console". Level is debug, info, warn or error,
+// and defaults to info in production and debug in the others. Rotation settings
+// are used only for the file output.
+type LogConfig struct {
+	Output       string   `toml:"output"`
+	Level        string   `toml:"level"`
+	Encoding     string   `toml:"encoding"`
+	Dir          string   `toml:"dir"`
+	Name         string   `toml:"name"`
+	RotationTime Duration `toml:"rotation_time"`
+	MaxAge       Duration `toml:"max_age"`
+}
+
+func (c *LogConfig) toFile() bool {
+	return c.Output == "file" || c.Output == "both"
+}
+
+func (c *LogConfig) toStdout() bool {
+	return c.Output == "stdout" || c.Output == "both"
+}
+
+// PasswordConfig decides how passwords are hashed and which passwords are
+// accepted. Algorithm is "argon2id" or "bcrypt". Argon2Memory is in KiB.
+// Hashes by the other algorithm or params are upgraded on login.
+type PasswordConfig struct {
+	Algorithm     string `toml:"algorithm"`
+	Argon2Time    uint32 `toml:"argon2_time"`
+	Argon2Memory  uint32 `toml:"argon2_memory"`
+	Argon2Threads uint8  `toml:"argon2_threads"`
+	BcryptCost    int    `toml:"bcrypt_cost"`
+	MinLength     int    `toml:"min_length"`
+	MaxLength     int    `toml:"max_length"`
+	RequireLetter bool   `toml:"require_letter"`
+	RequireDigit  bool   `toml:"require_digit"`
+}
+
+func (c *PasswordConfig) params() password.Params {
+	return password.Params{
+		Algorithm:     c.Algorithm,
+		Argon2Time:    c.Argon2Time,
+		Argon2Memory:  c.Argon2Memory,
+		Argon2Threads: c.Argon2Threads,
+		BcryptCost:    c.BcryptCost,
+	}
+}
+
+// Apply uses the config to hash and check passwords. It is applied to
+// the whole process, not only to a server.
+func (c *PasswordConfig) Apply() error {
+	return password.Configure(c.params(), password.Policy{
+		MinLength:     c.MinLength,
+		MaxLength:     c.MaxLength,
+		RequireLetter: c.RequireLetter,
+		RequireDigit:  c.RequireDigit,
+	})
+}
+
+// LoginConfig throttles failed logins. After FreeAttempts failures of a user,
+// the user can not log in for BaseDelay, which doubles on each failure up to
+// MaxDelay. Failures from an address in IPWindow are throttled the same way
+// after IPFreeAttempts. If RequireAdminTOTP is true, admins can not use admin
+// pages and APIs until they enable TOTP and log in with it. Admins who have
+// enabled TOTP need it in the session anyway.
+type LoginConfig struct {
+	FreeAttempts     int      `toml:"free_attempts"`
+	BaseDelay        Duration `toml:"base_delay"`
+	MaxDelay         Duration `toml:"max_delay"`
+	IPFreeAttempts   int      `toml:"ip_free_attempts"`
+	IPWindow         Duration `toml:"ip_window"`
+	RequireAdminTOTP bool     `toml:"require_admin_totp"`
+}
+
+// loginPolicy is the login config which handlers use.
+type loginPolicy struct {
+	throttle         *model.Throttle
+	requireAdminTOTP bool
+}
+
+func (c *LoginConfig) policy() loginPolicy {
+	return loginPolicy{
+		throttle: &model.Throttle{
+			FreeAttempts:   c.FreeAttempts,
+			BaseDelay:      c.BaseDelay.Duration,
+			MaxDelay:       c.MaxDelay.Duration,
+			IPFreeAttempts: c.IPFreeAttempts,
+			IPWindow:       c.IPWindow.Duration,
+		},
+		requireAdminTOTP: c.RequireAdminTOTP,
+	}
+}
+
+// JWTConfig decides the tokens which pages use to call the API. Access tokens
+// live AccessTokenTTL, and scripts renew them by the refresh token in the
+// cookie, which expires RefreshTokenTTL after it is issued or renewed.
+//
+// Tokens are signed by the key of SigningKey and verified by any of Keys,
+// so that keys are rotated by adding a new one, signing by it, and removing
+// the old one after its tokens expire. secret is the key "default".
+type JWTConfig struct {
+	AccessTokenTTL  Duration `toml:"access_token_ttl"`
+	RefreshTokenTTL Duration `toml:"refresh_token_ttl"`
+	SigningKey      string   `toml:"signing_key"`
+	Keys            []JWTKey `toml:"keys"`
+}
+
+type JWTKey struct {
+	ID     string `toml:"id"`
+	Secret string `toml:"secret"`
+}
+
+// jwtPolicy is the jwt config which handlers use.
+type jwtPolicy struct {
+	keys       *keyring
+	accessTTL  time.Duration
+	refreshTTL time.Duration
+}
+
+// policy makes the keyring with secret as the key "default".
+func (c *JWTConfig) policy(secret []byte) (jwtPolicy, error) {
+	keys := map[string][]byte{}
+	if len(secret) > 0 {
+		keys[defaultKeyID] = secret
+	}
+	for _, k := range c.Keys {
+		if k.ID == "" || k.Secret == "" {
+			return jwtPolicy{}, errors.New("jwt.keys need both id and secret")
+		}
+		if _, ok := keys[k.ID]; ok {
+			return jwtPolicy{}, errors.Errorf("Duplicate key id in jwt.keys: %s", k.ID)
+		}
+		keys[k.ID] = []byte(k.Secret)
+	}
+	if _, ok := keys[c.SigningKey]; !ok {
+		if c.SigningKey == defaultKeyID {
+			return jwtPolicy{}, errors.New("secret is required (VEGETA_SECRET)")
+		}
+		return jwtPolicy{}, errors.Errorf("jwt.signing_key is not in jwt.keys: %s", c.SigningKey)
+	}
+	return jwtPolicy{
+		keys: &keyring{
+			signing: c.SigningKey,
+			keys:    keys,
+		},
+		accessTTL:  c.AccessTokenTTL.Duration,
+		refreshTTL: c.RefreshTokenTTL.Duration,
+	}, nil
+}
+
+// OIDCConfig enables login by an OpenID Connect provider if Issuer is set.
+// Users are provisioned on the first login with the name in UsernameClaim.
+// If AdminGroup is set, admin rights follow whether GroupsClaim has it on
+// each login. If LinkByName is true, existing users of the same name are
+// linked to the provider instead of refusing the login. Admins are never
+// linked by name.
+type OIDCConfig struct {
+	Issuer        string   `toml:"issuer"`
+	ClientID      string   `toml:"client_id"`
+	ClientSecret  string   `toml:"client_secret"`
+	RedirectURL   string   `toml:"redirect_url"`
+	DisplayName   string   `toml:"display_name"`
+	Scopes        []string `toml:"scopes"`
+	UsernameClaim string   `toml:"username_claim"`
+	GroupsClaim   string   `toml:"groups_claim"`
+	AdminGroup    string   `toml:"admin_group"`
+	LinkByName    bool     `toml:"link_by_name"`
+}
+
+func (c *OIDCConfig) enabled() bool {
+	return c.Issuer != ""
+}
+
+// FeatureConfig toggles optional features.
+type FeatureConfig struct {
+	// Stream enables GET /api/data/stream.
+	Stream bool `toml:"stream"`
+	// Metrics enables GET /metrics in Prometheus format.
+	Metrics bool `toml:"metrics"`
+	// MetricsToken is the bearer token which scrapers must send to
+	// /metrics. It is required in production.
+	MetricsToken string `toml:"metrics_token"`
+	// MetricsMaxTags is how many tags have their own series of ingestion.
+	// Data of the other tags are counted together as tag_id="other".
+	MetricsMaxTags int `toml:"metrics_max_tags"`
+}
+
+// DefaultConfig returns the config which is used if nothing is set.
+func DefaultConfig() *Config {
+	params, policy := password.DefaultParams(), password.DefaultPolicy()
+	return &Config{
+		Server: ServerConfig{
+			Port:            3000,
+			ShutdownTimeout: Duration{30 * time.Second},
+			SessionMaxAge:   Duration{30 * 24 * time.Hour},
+		},
+		Log: LogConfig{
+			Output:       "file",
+			Encoding:     "json",
+			Dir:          "log",
+			Name:         "vegeta_log",
+			RotationTime: Duration{time.Hour},
+			MaxAge:       Duration{24 * time.Hour},
+		},
+		Password: PasswordConfig{
+			Algorithm:     params.Algorithm,
+			Argon2Time:    params.Argon2Time,
+			Argon2Memory:  params.Argon2Memory,
+			Argon2Threads: params.Argon2Threads,
+			BcryptCost:    params.BcryptCost,
+			MinLength:     policy.MinLength,
+			MaxLength:     policy.MaxLength,
+		},
+		Login: LoginConfig{
+			FreeAttempts:   3,
+			BaseDelay:      Duration{time.Second},
+			MaxDelay:       Duration{15 * time.Minute},
+			IPFreeAttempts: 20,
+			IPWindow:       Duration{15 * time.Minute},
+		},
+		JWT: JWTConfig{
+			AccessTokenTTL:  Duration{15 * time.Minute},
+			RefreshTokenTTL: Duration{24 * time.Hour},
+			SigningKey:      defaultKeyID,
+		},
+		OIDC: OIDCConfig{
+			DisplayName:   "SSO",
+			Scopes:        []string{"openid", "profile"},
+			UsernameClaim: "preferred_username",
+			GroupsClaim:   "groups",
+		},
+		Features: FeatureConfig{
+			Stream:         true,
+			MetricsMaxTags: 100,
+		},
+	}
+}
+
+// LoadConfig reads the config file and overrides it by environment
+// variables. $VEGETA_CONFIG is read if path is empty. It is not
+// validated so that the caller can override it more.
+func LoadConfig(path string) (*Config, error) {
+	c := DefaultConfig()
+	if path == "" {
+		path = os.Getenv("VEGETA_CONFIG")
+	}
+	if path != "" {
+		md, err := toml.DecodeFile(path, c)
+		if err != nil {
+			return nil, exit.MakeConfig(errors.Wrapf(err, "Failed to read config file: %s", path))
+		}
+		if undecoded := md.Undecoded(); len(undecoded) > 0 {
+			return nil, exit.MakeConfig(errors.Errorf("Unknown keys in config file %s: %v", path, undecoded))
+		}
+	}
+	if err := c.overrideByEnv(); err != nil {
+		return nil, exit.MakeConfig(err)
+	}
+	return c, nil
+}
+
+func (c *Config) overrideByEnv() error {
+	envs := []struct {
+		key string
+		dst *string
+	}{
+		{"STAGE", &c.Stage},
+		{"VEGETA_SECRET", &c.Secret},
+		{"VEGETA_EXTERNAL_URL", &c.Server.ExternalURL},
+		{"MYSQL_USERNAME", &c.Database.Username},
+		{"MYSQL_PASSWORD", &c.Database.Password},
+		{"MYSQL_DATABASE", &c.Database.Database},
+		{"MYSQL_HOST", &c.Database.Host},
+		{"VEGETA_OIDC_CLIENT_SECRET", &c.OIDC.ClientSecret},
+		{"VEGETA_METRICS_TOKEN", &c.Features.MetricsToken},
+		{"VEGETA_LOG_DIR", &c.Log.Dir},
+		{"VEGETA_LOG_OUTPUT", &c.Log.Output},
+		{"VEGETA_LOG_LEVEL", &c.Log.Level},
+		{"VEGETA_LOG_ENCODING", &c.Log.Encoding},
+	}
+	for _, env := range envs {
+		if v, ok := os.LookupEnv(env.key); ok {
+			*env.dst = v
+		}
+	}
+	if v := os.Getenv("VEGETA_PORT"); v != "" {
+		port, err := strconv.Atoi(v)
+		if err != nil {
+			return errors.Wrapf(err, "Invalid VEGETA_PORT: %s", v)
+		}
+		c.Server.Port = port
+	}
+	return nil
+}
+
+// Validate reports all problems at once.
+func (c *Config) Validate() error {
+	var problems []string
+	if _, err := c.JWT.policy([]byte(c.Secret)); err != nil {
+		problems = append(problems, err.Error())
+	}
+	if c.JWT.AccessTokenTTL.Duration <= 0 || c.JWT.RefreshTokenTTL.Duration <= 0 {
+		problems = append(problems, "jwt.access_token_ttl and jwt.refresh_token_ttl must be positive")
+	}
+	if c.Database.Database == "" {
+		problems = append(problems, "database.database is required (MYSQL_DATABASE)")
+	}
+	if c.Database.Username == "" {
+		problems = append(problems, "database.username is required (MYSQL_USERNAME)")
+	}
+	if c.Server.Port < 1 || 65535 < c.Server.Port {
+		problems = append(problems, fmt.Sprintf("server.port must be in 1-65535: %d", c.Server.Port))
+	}
+	if c.Server.ExternalURL != "" {
+		u, err := url.Parse(c.Server.ExternalURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" || u.RawQuery != "" || u.Fragment != "" {
+			problems = append(problems, fmt.Sprintf("server.external_url must be an http or https url without query: %s", c.Server.ExternalURL))
+		}
+	}
+	if c.Server.ShutdownTimeout.Duration <= 0 {
+		problems = append(problems, "server.shutdown_timeout must be positive")
+	}
+	if c.Server.SessionMaxAge.Duration <= 0 {
+		problems = append(problems, "server.session_max_age must be positive")
+	}
+	if _, err := parseTrustedProxies(c.Server.TrustedProxies); err != nil {
+		problems = append(problems, "server.trusted_proxies: "+err.Error())
+	}
+	if (c.TLS.CertFile == "") != (c.TLS.KeyFile == "") {
+		problems = append(problems, "tls.cert_file and tls.key_file must be set together")
+	}
+	if c.TLS.ClientCAFile != "" && !c.TLS.enabled() {
+		problems = append(problems, "tls.client_ca_file requires tls.cert_file and tls.key_file")
+	}
+	if err := c.Password.params().Validate(); err != nil {
+		problems = append(problems, "password: "+err.Error())
+	}
+	if c.Password.MinLength < 1 || (c.Password.MaxLength != 0 && c.Password.MaxLength < c.Password.MinLength) {
+		problems = append(problems, "password.min_length must be positive and not more than password.max_length")
+	}
+	if c.Password.Algorithm == password.Bcrypt && (c.Password.MaxLength == 0 || 72 < c.Password.MaxLength) {
+		problems = append(problems, "password.max_length must be 72 or less for bcrypt")
+	}
+	if c.Login.FreeAttempts < 0 || c.Login.IPFreeAttempts < 0 {
+		problems = append(problems, "login.free_attempts and login.ip_free_attempts must not be negative")
+	}
+	if c.Login.BaseDelay.Duration <= 0 || c.Login.MaxDelay.Duration < c.Login.BaseDelay.Duration {
+		problems = append(problems, "login.base_delay must be positive and not more than login.max_delay")
+	}
+	if c.Login.IPWindow.Duration <= 0 {
+		problems = append(problems, "login.ip_window must be positive")
+	}
+	if c.OIDC.enabled() {
+		if c.OIDC.ClientID == "" || c.OIDC.RedirectURL == "" {
+			problems = append(problems, "oidc.client_id and oidc.redirect_url are required with oidc.issuer")
+		}
+		if c.OIDC.UsernameClaim == "" {
+			problems = append(problems, "oidc.username_claim must not be empty")
+		}
+		if c.OIDC.AdminGroup != "" && c.OIDC.GroupsClaim == "" {
+			problems = append(problems, "oidc.admin_group requires oidc.groups_claim")
+		}
+	}
+	if c.Features.Metrics && c.Features.MetricsToken == "" && c.isProduction() {
+		problems = append(problems, "features.metrics_token is required for features.metrics in production (VEGETA_METRICS_TOKEN)")
+	}
+	if c.Features.MetricsMaxTags < 0 {
+		problems = append(problems, "features.metrics_max_tags must not be negative")
+	}
+	if !c.Log.toFile() && !c.Log.toStdout() {
+		problems = append(problems, fmt.Sprintf(`log.output must be "file", "stdout" or "both": %q`, c.Log.Output))
+	}
+	if c.Log.Encoding != "json" && c.Log.Encoding != "console" {
+		problems = append(problems, fmt.Sprintf(`log.encoding must be "json" or "console": %q`, c.Log.Encoding))
+	}
+	if c.Log.Level != "" {
+		var l zapcore.Level
+		if err := l.UnmarshalText([]byte(c.Log.Level)); err != nil {
+			problems = append(problems, fmt.Sprintf("log.level is invalid: %q", c.Log.Level))
+		}
+	}
+	if c.Log.toFile() {
+		if c.Log.Dir == "" || c.Log.Name == "" {
+			problems = append(problems, "log.dir and log.name must not be empty")
+		}
+		if c.Log.RotationTime.Duration <= 0 || c.Log.MaxAge.Duration <= 0 {
+			problems = append(problems, "log.rotation_time and log.max_age must be positive")
+		}
+	}
+	if len(problems) > 0 {
+		return errors.New("Invalid config:\n    " + strings.Join(problems, "\n    "))
+	}
+	return nil
+}
+
+func (c *Config) isProduction() bool {
+	return c.Stage == "production"
+}
+
+// DSN returns the data source name for MySQL.
+func (c *DatabaseConfig) DSN() string {
+	host := ""
+	if c.Host != "" {
+		host = "tcp(" + c.Host + ")"
+	}
+	return fmt.Sprintf(
+		"%s:%s@%s/%s?charset=utf8&parseTime=True&loc=Local",
+		c.Username,
+		c.Password,
+		host,
+		c.Database,
+	)
+}
@@ -0,0 +1,192 @@
+package vegeta
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// clearConfigEnv unsets variables which override the config, so that
+// the environment of the test does not change results.
+func clearConfigEnv(t *testing.T) {
+	for _, key := range []string{
+		"VEGETA_CONFIG", "STAGE", "VEGETA_SECRET", "VEGETA_EXTERNAL_URL", "VEGETA_PORT",
+		"MYSQL_USERNAME", "MYSQL_PASSWORD", "MYSQL_DATABASE", "MYSQL_HOST",
+		"VEGETA_OIDC_CLIENT_SECRET", "VEGETA_METRICS_TOKEN",
+		"VEGETA_LOG_DIR", "VEGETA_LOG_OUTPUT", "VEGETA_LOG_LEVEL", "VEGETA_LOG_ENCODING",
+	} {
+		t.Setenv(key, "")
+		os.Unsetenv(key)
+	}
+}
+
+func writeConfig(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "vegeta.toml")
+	if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadConfigPrecedence(t *testing.T) {
+	clearConfigEnv(t)
+	path := writeConfig(t, `
+secret = "file-secret"
+
+[server]
+port = 4000
+shutdown_timeout = "10s"
+
+[database]
+username = "file-user"
+database = "file-db"
+
+[log]
+dir = "file-log"
+`)
+	t.Setenv("VEGETA_SECRET", "env-secret")
+	t.Setenv("MYSQL_DATABASE", "env-db")
+	t.Setenv("VEGETA_PORT", "5000")
+
+	c, err := LoadConfig(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name      string
+		got, want interface{}
+	}{
+		{"default", c.Log.Name, "vegeta_log"},
+		{"default", c.Server.SessionMaxAge.Duration, 30 * 24 * time.Hour},
+		{"file", c.Database.Username, "file-user"},
+		{"file", c.Log.Dir, "file-log"},
+		{"file", c.Server.ShutdownTimeout.Duration, 10 * time.Second},
+		{"env over file", c.Secret, "env-secret"},
+		{"env over file", c.Database.Database, "env-db"},
+		{"env over file", c.Server.Port, 5000},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("%s: got %v, want %v", tt.name, tt.got, tt.want)
+		}
+	}
+
+	// $VEGETA_CONFIG is read without the path.
+	t.Setenv("VEGETA_CONFIG", path)
+	c, err = LoadConfig("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Database.Username != "file-user" {
+		t.Errorf("$VEGETA_CONFIG is not read: %q", c.Database.Username)
+	}
+}
+
+func TestLoadConfigErrors(t *testing.T) {
+	clearConfigEnv(t)
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{"unknown key", "[server]\nprot = 3000\n", "Unknown keys"},
+		{"syntax", "[server\n", "Failed to read config file"},
+		{"duration", "[server]\nshutdown_timeout = \"soon\"\n", "Failed to read config file"},
+	}
+	for _, tt := range tests {
+		_, err := LoadConfig(writeConfig(t, tt.content))
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("%s: err = %v, want %q", tt.name, err, tt.want)
+		}
+	}
+	t.Setenv("VEGETA_PORT", "port")
+	if _, err := LoadConfig(""); err == nil || !strings.Contains(err.Error(), "Invalid VEGETA_PORT") {
+		t.Errorf("invalid port in env: err = %v", err)
+	}
+}
+
+func TestValidateReportsAllProblems(t *testing.T) {
+	c := DefaultConfig()
+	c.Stage = "production"
+	c.Server.Port = 0
+	c.TLS.CertFile = "server.crt"
+	c.Password.MinLength = 0
+	c.Log.Output = "syslog"
+	c.Features.Metrics = true
+	err := c.Validate()
+	if err == nil {
+		t.Fatal("invalid config is valid")
+	}
+	for _, want := range []string{
+		"secret is required (VEGETA_SECRET)",
+		"database.database is required (MYSQL_DATABASE)",
+		"database.username is required (MYSQL_USERNAME)",
+		"server.port must be in 1-65535: 0",
+		"tls.cert_file and tls.key_file must be set together",
+		"password.min_length must be positive",
+		`log.output must be "file", "stdout" or "both": "syslog"`,
+		"features.metrics_token is required for features.metrics in production",
+	} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("%q is not reported:\n%v", want, err)
+		}
+	}
+
+	c = DefaultConfig()
+	c.Secret = "secret"
+	c.Database.Database = "vegeta"
+	c.Database.Username = "vegeta"
+	if err := c.Validate(); err != nil {
+		t.Errorf("default config with the required values: %v", err)
+	}
+}
+
+func TestValidateExternalURL(t *testing.T) {
+	tests := []struct {
+		url string
+		ok  bool
+	}{
+		{"", true},
+		{"https://vegeta.example.com", true},
+		{"http://vegeta.example.com/prefix/", true},
+		{"vegeta.example.com", false},
+		{"ftp://vegeta.example.com", false},
+		{"https://vegeta.example.com/?a=b", false},
+	}
+	for _, tt := range tests {
+		config := DefaultConfig()
+		config.Secret = "secret"
+		config.Database.Database = "vegeta"
+		config.Database.Username = "vegeta"
+		config.Server.ExternalURL = tt.url
+		err := config.Validate()
+		if tt.ok != (err == nil) {
+			t.Errorf("%q: err = %v", tt.url, err)
+		}
+		if err != nil && !strings.Contains(err.Error(), "server.external_url") {
+			t.Errorf("%q: err = %v, want a problem of server.external_url", tt.url, err)
+		}
+	}
+}
+
+func TestDurationText(t *testing.T) {
+	d := Duration{90 * time.Second}
+	b, err := d.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got Duration
+	if err := got.UnmarshalText(b); err != nil {
+		t.Fatal(err)
+	}
+	if got != d {
+		t.Errorf("%s is decoded to %s", b, got)
+	}
+	if err := got.UnmarshalText([]byte("90")); err == nil {
+		t.Error("duration without unit is decoded")
+	}
+}
@@ -1,13 +1,17 @@
 package vegeta
 
 import (
+	"encoding/json"
+	"net"
 	"net/http"
 	"time"
 
 	"github.com/Code-Hex/vegeta/html"
 	"github.com/Code-Hex/vegeta/internal/common"
+	"github.com/Code-Hex/vegeta/internal/i18n"
 	"github.com/Code-Hex/vegeta/internal/model"
 	"github.com/Code-Hex/vegeta/internal/session"
+	"github.com/Code-Hex/vegeta/internal/stream"
 	jwt "github.com/dgrijalva/jwt-go"
 	"github.com/jinzhu/gorm"
 	"github.com/labstack/echo"
@@ -17,21 +21,63 @@ import (
 
 type Context struct {
 	echo.Context
-	DB  *gorm.DB
-	Zap *zap.Logger
+	DB     *gorm.DB
+	Zap    *zap.Logger
+	Broker *stream.Broker
+
+	trustedProxies []*net.IPNet
+	login          loginPolicy
+	metrics        *metrics
+	certs          *certReloader
+	oidc           *oidcProvider
+	requestID      string
+	externalURL    string
+	jwt            jwtPolicy
+	now            func() time.Time
 }
 
-type baseArg struct{ Authed, Admin bool }
+type baseArg struct {
+	Authed, Admin bool
+	lang          string
+	year          int
+	csrf          string
+}
 
 func (b *baseArg) IsAuthed() bool { return b.Authed }
 func (b *baseArg) IsAdmin() bool  { return b.Admin }
-func (baseArg) Year() int         { return time.Now().Year() }
+func (b *baseArg) Year() int      { return b.year }
+func (b *baseArg) Lang() string   { return b.lang }
+
+func (b *baseArg) CSRFToken() string { return b.csrf }
+
+func (b *baseArg) T(key string) string { return i18n.T(b.lang, key) }
+
+// Messages returns messages for scripts as JSON.
+func (b *baseArg) Messages() string {
+	m, err := json.Marshal(i18n.Messages(b.lang, "js."))
+	if err != nil {
+		return "{}"
+	}
+	return string(m)
+}
 
 func (v *Vegeta) NewContext(ctx echo.Context) (*Context, error) {
+	id := requestID(ctx)
 	c := &Context{
 		Context: ctx,
 		DB:      v.DB,
-		Zap:     v.Logger,
+		Zap:     v.Logger.With(zap.String(requestIDKey, id)),
+		Broker:  v.broker,
+
+		trustedProxies: v.getTrustedProxies(),
+		login:          v.getLoginPolicy(),
+		metrics:        v.metrics,
+		certs:          v.certs,
+		oidc:           v.oidc,
+		requestID:      id,
+		externalURL:    v.config.Server.ExternalURL,
+		jwt:            v.getJWTPolicy(),
+		now:            v.now,
 	}
 	return c, nil
 }
@@ -47,46 +93,115 @@ func call(h callFunc) echo.HandlerFunc {
 
 func (c *Context) GetUserStatus() html.Args {
 	var isAuthed, isAdmin bool
-	s := session.Get(c)
-	u, ok := s.Get("user").(*model.User)
-	if ok {
+	if u := c.SessionUser(); u != nil {
 		isAuthed = true
 		isAdmin = u.Admin
 	}
 	return &baseArg{
 		Authed: isAuthed,
 		Admin:  isAdmin,
+		lang:   c.Lang(),
+		year:   c.Now().Year(),
+		csrf:   c.csrfToken(),
+	}
+}
+
+// SessionUser returns the user who is logged in, or nil. The user
+// is read from the database on each request.
+func (c *Context) SessionUser() *model.User {
+	return sessionUser(c)
+}
+
+func sessionUser(c echo.Context) *model.User {
+	if s := session.Get(c); s != nil {
+		return s.User()
+	}
+	return nil
+}
+
+// Lang returns the language of the response. The locale of the user
+// is preferred over Accept-Language.
+func (c *Context) Lang() string {
+	lang := requestLang(c)
+	c.Set("lang", lang)
+	return lang
+}
+
+// requestLang is also used by ErrorHandler, which does not have Context.
+func requestLang(c echo.Context) string {
+	if lang, ok := c.Get("lang").(string); ok {
+		return lang
+	}
+	if u, ok := c.Get("user").(*model.User); ok && u.Locale != "" {
+		return u.Locale
+	}
+	if u := sessionUser(c); u != nil && u.Locale != "" {
+		return u.Locale
+	}
+	return i18n.Match(c.Request().Header.Get("Accept-Language"))
+}
+
+// Now returns the current time by the clock of the server.
+func (c *Context) Now() time.Time {
+	return c.now()
+}
+
+// needsTOTP reports whether the admin must pass TOTP in the session
+// before using admin pages and APIs. It is needed if TOTP is required
+// for admins, or if the admin has enabled it.
+func (c *Context) needsTOTP(u *model.User) bool {
+	if !u.Admin || !(c.login.requireAdminTOTP || u.TOTPEnabled) {
+		return false
+	}
+	return !session.Get(c).SecondFactor()
+}
+
+// PeerAddr returns the address of the connected client.
+func (c *Context) PeerAddr() string {
+	return peerAddr(c.Request(), c.trustedProxies)
+}
+
+// JSON sets the request id to failed results so that clients can tell it.
+func (c *Context) JSON(code int, i interface{}) error {
+	if r, ok := i.(*common.ResultJSON); ok && !r.IsSuccess {
+		r.RequestID = c.requestID
 	}
+	return c.Context.JSON(code, i)
 }
 
-func (c *Context) CreateAPIToken(username string) (string, error) {
-	tm := time.Now().Add(time.Hour * 24)
+// CreateAPIToken returns the short-lived access token of the user and
+// when it expires.
+func (c *Context) CreateAPIToken(username string) (string, time.Time, error) {
+	tm := c.Now().Add(c.jwt.accessTTL)
 	claims := &apiVegetaClaims{
 		Name: username,
 		StandardClaims: jwt.StandardClaims{
 			ExpiresAt: tm.Unix(),
 		},
 	}
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	t, err := token.SignedString(secret)
+	t, err := c.jwt.keys.sign(claims)
 	if err != nil {
-		return "", errors.New("Failed to get api jwt")
+		return "", time.Time{}, errors.New("Failed to get api jwt")
 	}
-	return t, nil
+	return t, tm, nil
 }
 
 func (c *Context) BindValidate(i interface{}) error {
 	if err := c.Bind(i); err != nil {
-		c.Zap.Error("Failed to bind from json", zap.Error(err))
-		return c.JSON(http.StatusOK, &common.ResultJSON{
-			Reason: "リクエスト内容を取得できませんでした: " + err.Error(),
-		})
+		c.Zap.Info("Failed to bind from json", zap.Error(err))
+		if he, ok := err.(*echo.HTTPError); ok {
+			return newAPIError(
+				http.StatusBadRequest,
+				common.CodeInvalidRequest,
+				"error.invalid_request_detail",
+				he.Message,
+			).wrap(err)
+		}
+		return newAPIError(http.StatusBadRequest, common.CodeInvalidRequest, "error.invalid_request").wrap(err)
 	}
 	if err := c.Validate(i); err != nil {
-		c.Zap.Error("Failed to validate json", zap.Error(err))
-		return c.JSON(http.StatusOK, &common.ResultJSON{
-			Reason: "入力に誤りがあります: " + err.Error(),
-		})
+		c.Zap.Info("Failed to validate json", zap.Error(err))
+		return validationError(err)
 	}
 	return nil
 }
@@ -1,17 +1,17 @@
 package vegeta
 
 import (
-	"encoding/gob"
 	"net/http"
-	"os"
+	"strings"
+	"time"
 
 	"github.com/Code-Hex/vegeta/html"
+	"github.com/Code-Hex/vegeta/internal/common"
+	"github.com/Code-Hex/vegeta/internal/i18n"
 	"github.com/Code-Hex/vegeta/internal/model"
 	"github.com/Code-Hex/vegeta/internal/session"
 	jwt "github.com/dgrijalva/jwt-go"
-	"github.com/gorilla/sessions"
 	"github.com/labstack/echo"
-	"github.com/labstack/echo/middleware"
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
 )
@@ -25,43 +25,49 @@ type jwtVegetaClaims struct {
 
 const authScheme = "Bearer"
 
-var secret []byte
-
-func init() {
-	secret = []byte(os.Getenv("VEGETA_SECRET"))
-	gob.Register(&model.User{}) // Register for session
-}
+// loginHistorySize is how many logins are shown to the user.
+const loginHistorySize = 20
 
 func (v *Vegeta) registerRoutes() {
-	store := sessions.NewCookieStore(secret)
-	store.Options.HttpOnly = true
-	v.Use(session.Middleware("vegeta-session", store))
+	v.Use(session.Middleware(&session.Store{
+		DB:         v.DB,
+		Logger:     v.Logger,
+		CookieName: "vegeta-session",
+		MaxAge:     v.config.Server.SessionMaxAge.Duration,
+		Now:        v.now,
+		PeerAddr: func(c echo.Context) string {
+			return c.(*Context).PeerAddr()
+		},
+	}))
+	v.GET("/healthz", Healthz())
+	v.GET("/readyz", Readyz())
+	if v.metrics != nil {
+		v.GET("/metrics", v.metrics.handler())
+	}
 	v.GET("/", Index())
 	v.GET("/login", Login())
-	v.POST("/auth", Auth())
+	v.POST("/auth", Auth(), CSRF("/login?error=csrf"))
+	v.GET("/login/totp", LoginTOTP())
+	v.POST("/auth/totp", AuthTOTP(), CSRF("/login/totp?error=csrf"))
+	v.GET("/register", Register())
+	v.POST("/register", PostRegister())
+	if v.oidc != nil {
+		v.GET("/auth/oidc", OIDCLogin())
+		v.GET("/auth/oidc/callback", OIDCCallback())
+	}
 
+	v.registerAPIv1()
+
+	// The routes below are deprecated by /api/v1.
 	api := v.Group("/api")
-	api.Use(
-		func(next echo.HandlerFunc) echo.HandlerFunc {
-			return call(func(c *Context) error {
-				req := c.Request()
-				token := req.Header.Get("Authorization")
-				l := len(authScheme)
-				if len(token) > l+1 && token[:l] == authScheme {
-					user, err := model.TokenAuth(c.DB, token[l+1:])
-					if err != nil {
-						return errors.Wrap(err, "Failed to auth by token")
-					}
-					c.Set("user", user)
-					return next(c)
-				}
-				return errors.New("Incorrect authorization header")
-			})
-		},
-	)
+	api.Use(Deprecated(), APIAuth())
 	api.GET("/data", GetDataList())
+	if v.config.Features.Stream {
+		api.GET("/data/stream", StreamData())
+	}
 	api.GET("/tags", GetTagList())
 	api.POST("/data", PostData())
+	api.POST("/data/batch", PostDataBatch())
 	api.POST("/tag", PostTag())
 	api.DELETE("/tag/:name", DeleteTag())
 
@@ -71,7 +77,10 @@ func (v *Vegeta) registerRoutes() {
 			return func(c echo.Context) error {
 				err := next(c)
 				if err != nil {
-					if herr, ok := err.(*echo.HTTPError); ok && herr.Code == 404 {
+					if herr, ok := err.(*echo.HTTPError); ok && (herr.Code == 404 || isJSONAPI(c)) {
+						return err
+					}
+					if _, ok := err.(*APIError); ok {
 						return err
 					}
 					v.Logger.Error("Error on restricted group", zap.Error(err))
@@ -84,25 +93,25 @@ func (v *Vegeta) registerRoutes() {
 			return call(func(c *Context) error {
 				status := c.GetUserStatus()
 				if !status.IsAuthed() {
+					if isJSONAPI(c) {
+						return newAPIError(http.StatusUnauthorized, common.CodeUnauthorized, "error.login_required")
+					}
 					return c.Redirect(http.StatusFound, "/login")
 				}
 				return next(c)
 			})
 		},
 	)
+	auth.Use(CSRF(""))
 	auth.GET("", MyPage())
-	auth.GET("/logout", Logout())
+	auth.POST("/logout", Logout())
 	auth.GET("/mypage", MyPage())
 	auth.GET("/settings", Settings())
 
 	authAPI := auth.Group("/api")
 	authAPI.Use(
-		middleware.JWTWithConfig(middleware.JWTConfig{
-			Claims:      &apiVegetaClaims{},
-			SigningKey:  secret,
-			TokenLookup: "header:Authorization",
-			ContextKey:  "auth_api",
-		}),
+		Deprecated(),
+		PageToken("auth_api"),
 	)
 	authAPI.PATCH("/regenerate", RegenerateToken())
 	authAPI.POST("/reregister_password", ReRegisterPassword())
@@ -116,59 +125,83 @@ func (v *Vegeta) registerRoutes() {
 			return call(func(c *Context) error {
 				status := c.GetUserStatus()
 				if !status.IsAdmin() {
+					if isJSONAPI(c) {
+						return newAPIError(http.StatusForbidden, common.CodeForbidden, "error.admin_required")
+					}
 					return c.Redirect(http.StatusFound, "/login")
 				}
+				if c.needsTOTP(c.SessionUser()) {
+					if isJSONAPI(c) {
+						return newAPIError(http.StatusForbidden, common.CodeTOTPRequired, "error.totp_required")
+					}
+					return c.Redirect(http.StatusFound, "/mypage/settings")
+				}
 				return next(c)
 			})
 		},
 	)
 	admin.GET("", Admin())
+	admin.GET("/audit", AuditLogs())
+	admin.GET("/audit/export", ExportAuditLogs())
+	admin.GET("/users/:id/archive", ExportUser())
+	admin.GET("/invites", Invites())
+	admin.POST("/invites", CreateInvite())
+	admin.POST("/invites/:id/revoke", RevokeInvite())
 
 	adminAPI := admin.Group("/api")
 	adminAPI.Use(
-		middleware.JWTWithConfig(middleware.JWTConfig{
-			Claims:      &apiVegetaClaims{},
-			SigningKey:  secret,
-			TokenLookup: "header:Authorization",
-			ContextKey:  "admin_api",
-		}),
+		Deprecated(),
+		PageToken("admin_api"),
 	)
 	adminAPI.POST("/create", JSONCreateUser())
 	adminAPI.POST("/edit", JSONEditUser())
 	adminAPI.POST("/delete", JSONDeleteUser())
 }
 
+// isJSONAPI reports whether the route under /mypage is called from scripts.
+// Errors of them are returned as JSON instead of redirecting to /login.
+func isJSONAPI(c echo.Context) bool {
+	return strings.HasPrefix(c.Path(), "/mypage/api/") ||
+		strings.HasPrefix(c.Path(), "/mypage/admin/api/")
+}
+
 type adminArgs struct {
 	html.Args
 	token        string
+	expiresAt    time.Time
 	users        model.Users
 	isCreated    bool
 	failedReason string
+	now          time.Time
 }
 
-func (a *adminArgs) Token() string      { return a.token }
-func (a *adminArgs) Users() model.Users { return a.users }
-func (a *adminArgs) IsCreated() bool    { return a.isCreated }
-func (a *adminArgs) Reason() string     { return a.failedReason }
+func (a *adminArgs) Token() string         { return a.token }
+func (a *adminArgs) TokenExpiresAt() int64 { return a.expiresAt.Unix() }
+func (a *adminArgs) Users() model.Users    { return a.users }
+func (a *adminArgs) IsCreated() bool       { return a.isCreated }
+func (a *adminArgs) Reason() string        { return a.failedReason }
+
+func (a *adminArgs) IsLocked(u *model.User) bool { return u.IsLocked(a.now) }
 
 func Admin() echo.HandlerFunc {
 	return call(func(c *Context) error {
-		s := session.Get(c)
-		user := s.Get("user").(*model.User)
+		user := c.SessionUser()
 		users, err := model.GetUsers(c.DB)
 		if err != nil {
 			c.Zap.Error("Failed to get user list", zap.Error(err))
 			return c.Redirect(http.StatusFound, "/mypage")
 		}
-		token, err := c.CreateAPIToken(user.Name)
+		token, expiresAt, err := c.pageToken(user)
 		if err != nil {
 			c.Zap.Error("Failed to create api token", zap.Error(err))
 			return c.Redirect(http.StatusFound, "/mypage")
 		}
 		args := &adminArgs{
-			Args:  c.GetUserStatus(),
-			token: token,
-			users: users,
+			Args:      c.GetUserStatus(),
+			token:     token,
+			expiresAt: expiresAt,
+			users:     users,
+			now:       c.Now(),
 		}
 		html.Admin(args, c.Response())
 		return nil
@@ -177,29 +210,30 @@ func Admin() echo.HandlerFunc {
 
 type mypageArgs struct {
 	html.Args
-	user  *model.User
-	token string
+	user      *model.User
+	token     string
+	expiresAt time.Time
 }
 
-func (m *mypageArgs) Token() string     { return m.token }
-func (m *mypageArgs) User() *model.User { return m.user }
+func (m *mypageArgs) Token() string         { return m.token }
+func (m *mypageArgs) TokenExpiresAt() int64 { return m.expiresAt.Unix() }
+func (m *mypageArgs) User() *model.User     { return m.user }
 
 func MyPage() echo.HandlerFunc {
 	return call(func(c *Context) error {
-		s := session.Get(c)
-		cu := s.Get("user").(*model.User)
-		user, err := model.FindUserByName(c.DB, cu.Name)
+		user, err := model.FindUserByName(c.DB, c.SessionUser().Name)
 		if err != nil {
 			return errors.Wrap(err, "Failed to find user")
 		}
-		t, err := c.CreateAPIToken(user.Name)
+		t, expiresAt, err := c.pageToken(user)
 		if err != nil {
 			return errors.Wrap(err, "Failed to create api token at mypage")
 		}
 		args := &mypageArgs{
-			Args:  c.GetUserStatus(),
-			user:  user,
-			token: t,
+			Args:      c.GetUserStatus(),
+			user:      user,
+			token:     t,
+			expiresAt: expiresAt,
 		}
 		html.MyPage(args, c.Response())
 		return nil
@@ -208,29 +242,59 @@ func MyPage() echo.HandlerFunc {
 
 type settingsArgs struct {
 	html.Args
-	user  *model.User
-	token string
+	user           *model.User
+	token          string
+	expiresAt      time.Time
+	sessions       []*model.Session
+	currentSession uint
+	loginAttempts  []*model.LoginAttempt
+	totpRequired   bool
+	recoveryLeft   int
 }
 
-func (s *settingsArgs) Token() string     { return s.token }
-func (s *settingsArgs) User() *model.User { return s.user }
+func (s *settingsArgs) Token() string              { return s.token }
+func (s *settingsArgs) TokenExpiresAt() int64      { return s.expiresAt.Unix() }
+func (s *settingsArgs) User() *model.User          { return s.user }
+func (settingsArgs) Languages() []string           { return i18n.Languages() }
+func (s *settingsArgs) Sessions() []*model.Session { return s.sessions }
+func (s *settingsArgs) IsCurrent(id uint) bool     { return s.currentSession == id }
+
+func (s *settingsArgs) LoginAttempts() []*model.LoginAttempt { return s.loginAttempts }
+func (s *settingsArgs) TOTPRequired() bool                   { return s.totpRequired }
+func (s *settingsArgs) RecoveryCodesLeft() int               { return s.recoveryLeft }
 
 func Settings() echo.HandlerFunc {
 	return call(func(c *Context) error {
-		s := session.Get(c)
-		cu := s.Get("user").(*model.User)
-		user, err := model.FindUserByName(c.DB, cu.Name)
+		user, err := model.FindUserByName(c.DB, c.SessionUser().Name)
 		if err != nil {
 			return errors.Wrap(err, "Failed to find user")
 		}
-		t, err := c.CreateAPIToken(user.Name)
+		t, expiresAt, err := c.pageToken(user)
 		if err != nil {
 			return errors.Wrap(err, "Failed to create api token at mypage")
 		}
+		sessions, err := model.GetSessions(c.DB, user.ID, c.Now())
+		if err != nil {
+			return errors.Wrap(err, "Failed to get sessions")
+		}
+		attempts, err := model.GetLoginAttempts(c.DB, user.ID, loginHistorySize)
+		if err != nil {
+			return errors.Wrap(err, "Failed to get login history")
+		}
+		left, err := user.RecoveryCodesLeft(c.DB)
+		if err != nil {
+			return errors.Wrap(err, "Failed to count recovery codes")
+		}
 		args := &settingsArgs{
-			Args:  c.GetUserStatus(),
-			user:  user,
-			token: t,
+			Args:           c.GetUserStatus(),
+			user:           user,
+			token:          t,
+			expiresAt:      expiresAt,
+			sessions:       sessions,
+			currentSession: session.Get(c).ID(),
+			loginAttempts:  attempts,
+			totpRequired:   c.needsTOTP(user),
+			recoveryLeft:   left,
 		}
 		html.Settings(args, c.Response())
 		return nil
@@ -247,13 +311,36 @@ func Logout() echo.HandlerFunc {
 	})
 }
 
+type loginArgs struct {
+	html.Args
+	reason   string
+	oidcName string
+}
+
+func (l *loginArgs) Reason() string { return l.reason }
+
+func (l *loginArgs) OIDCButton() string {
+	if l.oidcName == "" {
+		return ""
+	}
+	return i18n.T(l.Lang(), "login.oidc", l.oidcName)
+}
+
 func Login() echo.HandlerFunc {
 	return call(func(c *Context) error {
 		arg := c.GetUserStatus()
 		if arg.IsAuthed() {
 			return c.Redirect(http.StatusFound, "/mypage")
 		}
-		html.Login(arg, c.Response())
+		args := &loginArgs{Args: arg}
+		switch reason := c.QueryParam("error"); reason {
+		case "invalid", "locked", "suspended", "csrf", "oidc", "oidc_conflict":
+			args.reason = reason
+		}
+		if c.oidc != nil {
+			args.oidcName = c.oidc.config.DisplayName
+		}
+		html.Login(args, c.Response())
 		return nil
 	})
 }
@@ -262,14 +349,36 @@ func Auth() echo.HandlerFunc {
 	return call(func(c *Context) error {
 		username := c.FormValue("username")
 		password := c.FormValue("password")
-		user, err := model.BasicAuth(c.DB, username, password)
+		user, err := model.Login(
+			c.DB,
+			c.login.throttle,
+			username,
+			password,
+			c.PeerAddr(),
+			c.Request().UserAgent(),
+			c.Now(),
+		)
 		if err != nil {
-			c.Zap.Error("Failed to auth user", zap.String("username", username))
-			return c.Redirect(http.StatusFound, "/login")
+			c.Zap.Warn("Failed to auth user",
+				zap.String("username", username),
+				zap.String("ip", c.PeerAddr()),
+				zap.Error(err),
+			)
+			switch errors.Cause(err) {
+			case model.ErrLocked:
+				return c.Redirect(http.StatusFound, "/login?error=locked")
+			case model.ErrSuspended:
+				return c.Redirect(http.StatusFound, "/login?error=suspended")
+			}
+			return c.Redirect(http.StatusFound, "/login?error=invalid")
 		}
-		s := session.Get(c)
-		s.Set("user", user)
-		if err := s.Save(); err != nil {
+		if user.TOTPEnabled {
+			if err := c.setPendingLogin(user); err != nil {
+				return err
+			}
+			return c.Redirect(http.StatusFound, "/login/totp")
+		}
+		if err := session.Get(c).Login(user); err != nil {
 			return err
 		}
 		return c.Redirect(http.StatusFound, "/mypage")
@@ -0,0 +1,92 @@
+package vegeta
+
+import (
+	"crypto/subtle"
+	"net/http"
+
+	"github.com/Code-Hex/vegeta/internal/common"
+	"github.com/Code-Hex/vegeta/internal/utils"
+	"github.com/labstack/echo"
+	"go.uber.org/zap"
+)
+
+// The CSRF token is a double submit cookie. Pages embed the token of the
+// cookie, and requests which change state must send it back in the header
+// or the form. Other sites can not read the cookie to forge them.
+const (
+	csrfCookieName = "vegeta-csrf"
+	csrfHeader     = "X-CSRF-Token"
+	csrfField      = "csrf_token"
+	csrfKey        = "csrf"
+)
+
+var errCSRF = newAPIError(
+	http.StatusForbidden,
+	common.CodeCSRFFailed,
+	"error.csrf",
+)
+
+// csrfToken returns the token of the cookie. A new one is set
+// if the cookie is missing.
+func (c *Context) csrfToken() string {
+	if token, ok := c.Get(csrfKey).(string); ok {
+		return token
+	}
+	token := ""
+	if cookie, err := c.Cookie(csrfCookieName); err == nil {
+		token = cookie.Value
+	}
+	if token == "" {
+		token = utils.RandomToken()
+		c.SetCookie(&http.Cookie{
+			Name:     csrfCookieName,
+			Value:    token,
+			Path:     "/",
+			Secure:   c.Request().TLS != nil,
+			HttpOnly: true,
+			SameSite: http.SameSiteLaxMode,
+		})
+	}
+	c.Set(csrfKey, token)
+	return token
+}
+
+// checkCSRF verifies the token of requests which change state.
+func (c *Context) checkCSRF() error {
+	switch c.Request().Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace:
+		return nil
+	}
+	cookie, err := c.Cookie(csrfCookieName)
+	if err != nil || cookie.Value == "" {
+		return errCSRF
+	}
+	token := c.Request().Header.Get(csrfHeader)
+	if token == "" {
+		token = c.FormValue(csrfField)
+	}
+	if subtle.ConstantTimeCompare([]byte(token), []byte(cookie.Value)) != 1 {
+		return errCSRF
+	}
+	return nil
+}
+
+// CSRF rejects requests without the token. Forms are redirected to
+// failure, and scripts get the error if failure is empty.
+func CSRF(failure string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return call(func(c *Context) error {
+			if err := c.checkCSRF(); err != nil {
+				c.Zap.Warn("CSRF token mismatch",
+					zap.String("path", c.Path()),
+					zap.String("ip", c.PeerAddr()),
+				)
+				if failure != "" {
+					return c.Redirect(http.StatusFound, failure)
+				}
+				return err
+			}
+			return next(c)
+		})
+	}
+}
@@ -0,0 +1,155 @@
+package vegeta
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Code-Hex/vegeta/internal/model"
+)
+
+// csrfCases are the tokens which are sent along with the cookie.
+var csrfCases = []struct {
+	name  string
+	token func(c *testClient) string
+	ok    bool
+}{
+	{"missing", func(*testClient) string { return "" }, false},
+	{"mismatch", func(*testClient) string { return "0123456789abcdef" }, false},
+	{"valid", (*testClient).csrfCookie, true},
+}
+
+func TestCSRFAuth(t *testing.T) {
+	s := newTestServer(t)
+	s.createUser("alice", "alice-password1", false)
+	for _, tt := range csrfCases {
+		c := s.client()
+		c.formCSRF("/login")
+		resp, _ := c.postForm("/auth", url.Values{
+			"username":   {"alice"},
+			"password":   {"alice-password1"},
+			"csrf_token": {tt.token(c)},
+		})
+		want := "/login?error=csrf"
+		if tt.ok {
+			want = "/mypage"
+		}
+		if loc := resp.Header.Get("Location"); loc != want {
+			t.Errorf("%s: goes to %q, want %q", tt.name, loc, want)
+		}
+	}
+}
+
+func TestCSRFAuthTOTP(t *testing.T) {
+	s := newTestServer(t)
+	codes := s.enableTOTP(s.createUser("alice", "alice-password1", false))
+	for i, tt := range csrfCases {
+		c := s.client()
+		c.login("alice", "alice-password1", "/login/totp")
+		resp, _ := c.postForm("/auth/totp", url.Values{
+			"code":       {codes[i]},
+			"csrf_token": {tt.token(c)},
+		})
+		want := "/login/totp?error=csrf"
+		if tt.ok {
+			want = "/mypage"
+		}
+		if loc := resp.Header.Get("Location"); loc != want {
+			t.Errorf("%s: goes to %q, want %q", tt.name, loc, want)
+		}
+	}
+}
+
+func TestCSRFRegister(t *testing.T) {
+	s := newTestServer(t)
+	root := s.createUser("root", "root-password1", true)
+	now := time.Now()
+	_, token, err := model.CreateInvite(s.DB, root, false, len(csrfCases), now.Add(time.Hour), now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, tt := range csrfCases {
+		c := s.client()
+		c.formCSRF("/register?token=" + url.QueryEscape(token))
+		name := "user" + string(rune('a'+i))
+		resp, body := c.postForm("/register", url.Values{
+			"token":           {token},
+			"username":        {name},
+			"password":        {"user-password1"},
+			"verify_password": {"user-password1"},
+			"csrf_token":      {tt.token(c)},
+		})
+		if tt.ok {
+			if loc := resp.Header.Get("Location"); loc != "/mypage" {
+				t.Errorf("%s: goes to %q, want /mypage", tt.name, loc)
+			}
+			continue
+		}
+		if resp.StatusCode != http.StatusOK || !strings.Contains(body, "The page has expired") {
+			t.Errorf("%s: status = %d, want the form with the error", tt.name, resp.StatusCode)
+		}
+		if _, err := model.FindUserByName(s.DB, name); err == nil {
+			t.Errorf("%s: %s is registered", tt.name, name)
+		}
+	}
+}
+
+func TestCSRFPageToken(t *testing.T) {
+	s := newTestServer(t)
+	s.createUser("alice", "alice-password1", false)
+	c := s.loggedIn("alice", "alice-password1")
+	token := c.pageToken("/mypage/settings")
+	for i, tt := range csrfCases {
+		resp, body := c.api(http.MethodPost, "/api/v1/tags", token, tt.token(c), &addTag{
+			Name: "tag" + string(rune('a'+i)),
+		})
+		want := http.StatusForbidden
+		if tt.ok {
+			want = http.StatusCreated
+		}
+		if resp.StatusCode != want {
+			t.Errorf("%s: status = %d, want %d: %s", tt.name, resp.StatusCode, want, body)
+		}
+	}
+}
+
+func TestCSRFLogout(t *testing.T) {
+	s := newTestServer(t)
+	s.createUser("alice", "alice-password1", false)
+	for _, tt := range csrfCases {
+		c := s.loggedIn("alice", "alice-password1")
+		resp, _ := c.postForm("/mypage/logout", url.Values{
+			"csrf_token": {tt.token(c)},
+		})
+		if tt.ok {
+			if loc := resp.Header.Get("Location"); loc != "/login" {
+				t.Errorf("%s: goes to %q, want %q", tt.name, loc, "/login")
+			}
+		} else if resp.StatusCode != http.StatusForbidden {
+			t.Errorf("%s: status = %d, want %d", tt.name, resp.StatusCode, http.StatusForbidden)
+		}
+		resp, _ = c.get("/mypage")
+		if loggedIn := resp.StatusCode == http.StatusOK; loggedIn == tt.ok {
+			t.Errorf("%s: logged in = %v after logout", tt.name, loggedIn)
+		}
+	}
+}
+
+func TestLogoutByGet(t *testing.T) {
+	s := newTestServer(t)
+	s.createUser("alice", "alice-password1", false)
+	c := s.loggedIn("alice", "alice-password1")
+	// Links and images of other sites must not log out.
+	if resp, _ := c.get("/mypage/logout"); resp.StatusCode != http.StatusMethodNotAllowed {
+		t.Errorf("GET /mypage/logout: status = %d, want %d", resp.StatusCode, http.StatusMethodNotAllowed)
+	}
+	if resp, _ := c.get("/mypage"); resp.StatusCode != http.StatusOK {
+		t.Errorf("logged out by GET: status of /mypage = %d", resp.StatusCode)
+	}
+	_, body := c.get("/mypage")
+	if !strings.Contains(body, `action="/mypage/logout" method="POST"`) {
+		t.Error("the page has no logout form")
+	}
+}
@@ -1,78 +1,152 @@
 import * as request from 'superagent'
+import { t } from './i18n'
+import { csrfToken } from './csrf'
+import { apiToken } from './token'
 
 class Validator {
     public static CheckPassword(): void {
         var password = <HTMLInputElement>document.getElementById("password")
         var verify_password = <HTMLInputElement>document.getElementById("verify-password")
         if (password.value != verify_password.value) {
-            verify_password.setCustomValidity("一致するパスワードを入力してください。");
+            verify_password.setCustomValidity(t('js.password_validity'));
         } else {
             verify_password.setCustomValidity('');
         }
     }
+
+    // CheckDeleteName asks to type the name of the user to delete, because
+    // tags and data can not be restored.
+    public static CheckDeleteName(): void {
+        var username = <HTMLInputElement>$('#deleteModal').find('#username').get(0)
+        var confirm_name = <HTMLInputElement>document.getElementById("confirm-name")
+        if (username.value != confirm_name.value) {
+            confirm_name.setCustomValidity(t('js.delete_mismatch'));
+        } else {
+            confirm_name.setCustomValidity('');
+        }
+    }
 }
 
 class Actions {
-    private _token: string = ""
-    constructor() {
-        let e = <HTMLInputElement>document.getElementById('api-token')
-        this._token = e.value
-    }
-    
     public get token(): string {
-        return this._token
+        return apiToken()
     }
 
     public DeleteUser(parent: JQuery<HTMLElement>): void {
         let id = parent.find("#user-id").val()
-        request.post('/mypage/admin/api/delete')
-        .set('Content-Type', 'application/json')
-        .set('Authorization', `Bearer ${ this._token }`)
-        .send({ id: id })
+        let confirm_name = parent.find("#confirm-name").val()
+        request.delete(`/api/v1/users/${ id }`)
+        .query({ confirm: confirm_name })
+        .set('Authorization', `Bearer ${ apiToken() }`)
+        .set('X-CSRF-Token', csrfToken())
         .end(function(err, res){
-            if (err || !res.ok) {
-                alert('http error: ' + err);
+            if (!err) {
+                alert(t('js.user_deleted'))
+                window.location.reload(true)
+            } else if (res && res.body && res.body.reason) {
+                alert(t('js.user_delete_failed', res.body.reason))
+                window.location.reload(true)
             } else {
-                let json = res.body
-                if (json.is_success) {
-                    alert('ユーザーを削除しました。')
-                    window.location.reload(true)
-                } else {
-                    alert(`ユーザーの削除に失敗しました: ${ json.reason }`)
-                    window.location.reload(true)
-                }
+                alert(t('js.http_error', err));
             }
         })
     }
     
+    public UnlockUser(id: string): void {
+        request.post(`/api/v1/users/${ id }/unlock`)
+        .set('Authorization', `Bearer ${ apiToken() }`)
+        .set('X-CSRF-Token', csrfToken())
+        .end(function(err, res){
+            if (!err) {
+                alert(t('js.user_unlocked'))
+                window.location.reload(true)
+            } else if (res && res.body && res.body.reason) {
+                alert(t('js.user_unlock_failed', res.body.reason))
+            } else {
+                alert(t('js.http_error', err));
+            }
+        })
+    }
+
+    public SuspendUser(id: string, name: string): void {
+        if (!confirm(t('js.user_suspend_confirm', name))) {
+            return;
+        }
+        request.post(`/api/v1/users/${ id }/suspend`)
+        .set('Authorization', `Bearer ${ apiToken() }`)
+        .set('X-CSRF-Token', csrfToken())
+        .end(function(err, res){
+            if (!err) {
+                alert(t('js.user_suspended'))
+                window.location.reload(true)
+            } else if (res && res.body && res.body.reason) {
+                alert(t('js.user_suspend_failed', res.body.reason))
+            } else {
+                alert(t('js.http_error', err));
+            }
+        })
+    }
+
+    public ResumeUser(id: string): void {
+        request.post(`/api/v1/users/${ id }/resume`)
+        .set('Authorization', `Bearer ${ apiToken() }`)
+        .set('X-CSRF-Token', csrfToken())
+        .end(function(err, res){
+            if (!err) {
+                alert(t('js.user_resumed'))
+                window.location.reload(true)
+            } else if (res && res.body && res.body.reason) {
+                alert(t('js.user_resume_failed', res.body.reason))
+            } else {
+                alert(t('js.http_error', err));
+            }
+        })
+    }
+
+    public ResetTOTP(id: string, name: string): void {
+        if (!confirm(t('js.totp_reset_confirm', name))) {
+            return;
+        }
+        request.delete(`/api/v1/users/${ id }/totp`)
+        .set('Authorization', `Bearer ${ apiToken() }`)
+        .set('X-CSRF-Token', csrfToken())
+        .end(function(err, res){
+            if (!err) {
+                alert(t('js.totp_reset'))
+                window.location.reload(true)
+            } else if (res && res.body && res.body.reason) {
+                alert(t('js.totp_reset_failed', res.body.reason))
+            } else {
+                alert(t('js.http_error', err));
+            }
+        })
+    }
+
     public EditUser(parent: JQuery<HTMLElement>): void {
         let id = parent.find("#user-id").val()
         let is_admin: boolean = parent.find('#is-admin').is(':checked')
         let is_reset_password: boolean = parent.find('#is-reset-password').is(':checked')
-        request.post('/mypage/admin/api/edit')
+        request.patch(`/api/v1/users/${ id }`)
             .set('Content-Type', 'application/json')
-            .set('Authorization', `Bearer ${ this._token }`)
+            .set('Authorization', `Bearer ${ apiToken() }`)
+            .set('X-CSRF-Token', csrfToken())
             .send({
-                id: id,
                 is_admin: is_admin,
-                is_reset_password: is_reset_password,
+                reset_password: is_reset_password,
             })    
             .end(function(err, res){
-                if (err || !res.ok) {
-                    alert('http error: ' + err);
-                } else {
-                    let json = res.body
-                    if (json.is_success) {
-                        let msg = 'ユーザーを編集しました。'
-                        if (is_reset_password) {
-                            msg += `\nリセット後のパスワードは ${ json.reason } です。`
-                        }
-                        alert(msg)
-                        window.location.reload(true)
-                    } else {
-                        alert(`ユーザーの編集に失敗しました: ${ json.reason }`)
-                        window.location.reload(true)
+                if (!err) {
+                    let msg = t('js.user_edited')
+                    if (is_reset_password) {
+                        msg += '\n' + t('js.password_reset', res.body.password)
                     }
+                    alert(msg)
+                    window.location.reload(true)
+                } else if (res && res.body && res.body.reason) {
+                    alert(t('js.user_edit_failed', res.body.reason))
+                    window.location.reload(true)
+                } else {
+                    alert(t('js.http_error', err));
                 }
             })
 
@@ -83,9 +157,10 @@ class Actions {
         let password = parent.find("#password").val()
         let verify_password = parent.find("#verify-password").val()
         let is_admin: boolean = parent.find('#is-admin').is(':checked')
-        request.post('/mypage/admin/api/create')
+        request.post('/api/v1/users')
             .set('Content-Type', 'application/json')
-            .set('Authorization', `Bearer ${ this._token }`)
+            .set('Authorization', `Bearer ${ apiToken() }`)
+            .set('X-CSRF-Token', csrfToken())
             .send({
                 name: username,
                 password: password,
@@ -93,17 +168,14 @@ class Actions {
                 is_admin: is_admin
             })    
             .end(function(err, res){
-                if (err || !res.ok) {
-                    alert('http error: ' + err);
+                if (!err) {
+                    alert(t('js.user_created'))
+                    window.location.reload(true)
+                } else if (res && res.body && res.body.reason) {
+                    alert(t('js.user_create_failed', res.body.reason))
+                    window.location.reload(true)
                 } else {
-                    let json = res.body
-                    if (json.is_success) {
-                        alert('ユーザーを作成しました。')
-                        window.location.reload(true)
-                    } else {
-                        alert(`ユーザーの作成に失敗しました: ${ json.reason }`)
-                        window.location.reload(true)
-                    }
+                    alert(t('js.http_error', err));
                 }
             })
     }
@@ -120,6 +192,9 @@ $(document).ready(function() {
     $('#verify-password').keyup(function() {
         Validator.CheckPassword()
     })
+    $('#confirm-name').keyup(function() {
+        Validator.CheckDeleteName()
+    })
 })
 
 $('#editModal').on('show.bs.modal', function (e) {
@@ -142,6 +217,8 @@ $('#deleteModal').on('show.bs.modal', function (e) {
     let modal = $(this)
     modal.find('#username').val(name)
     modal.find('#user-id').val(id)
+    modal.find('#confirm-name').val('')
+    Validator.CheckDeleteName()
 })
 
 var actions = new Actions()
@@ -165,4 +242,40 @@ deleteElem.addEventListener('submit', (e) => {
     e.preventDefault()
     actions.DeleteUser($("#delete-user-validation"))
     // console.log(actions.token)
-})
\ No newline at end of file
+})
+
+var unlockElems = document.getElementsByClassName('unlock-user')
+for (let i = 0; i < unlockElems.length; i++) {
+    let elem = <HTMLButtonElement>unlockElems[i]
+    elem.addEventListener('click', (e) => {
+        e.preventDefault()
+        actions.UnlockUser(elem.dataset.id)
+    })
+}
+
+var suspendElems = document.getElementsByClassName('suspend-user')
+for (let i = 0; i < suspendElems.length; i++) {
+    let elem = <HTMLButtonElement>suspendElems[i]
+    elem.addEventListener('click', (e) => {
+        e.preventDefault()
+        actions.SuspendUser(elem.dataset.id, elem.dataset.name)
+    })
+}
+
+var resumeElems = document.getElementsByClassName('resume-user')
+for (let i = 0; i < resumeElems.length; i++) {
+    let elem = <HTMLButtonElement>resumeElems[i]
+    elem.addEventListener('click', (e) => {
+        e.preventDefault()
+        actions.ResumeUser(elem.dataset.id)
+    })
+}
+
+var resetTOTPElems = document.getElementsByClassName('reset-totp')
+for (let i = 0; i < resetTOTPElems.length; i++) {
+    let elem = <HTMLButtonElement>resetTOTPElems[i]
+    elem.addEventListener('click', (e) => {
+        e.preventDefault()
+        actions.ResetTOTP(elem.dataset.id, elem.dataset.name)
+    })
+}
@@ -0,0 +1,6 @@
+// csrfToken returns the token which is embedded in pages by the server.
+// Requests which change state must send it as X-CSRF-Token.
+export function csrfToken(): string {
+    const e = <HTMLInputElement>document.getElementById('csrf-token')
+    return e ? e.value : ''
+}
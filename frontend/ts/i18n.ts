@@ -0,0 +1,20 @@
+// Messages are embedded in pages by the server as JSON.
+let messages: { [key: string]: string } = {}
+const elem = document.getElementById('messages')
+if (elem && elem.textContent) {
+    try {
+        messages = JSON.parse(elem.textContent)
+    } catch (e) {
+        messages = {}
+    }
+}
+
+// t returns the message of key formatted with args. The key is
+// returned if the message is not found.
+export function t(key: string, ...args: any[]): string {
+    let msg = messages[key] || key
+    for (const arg of args) {
+        msg = msg.replace(/%[sv]/, String(arg))
+    }
+    return msg
+}
@@ -2,6 +2,9 @@ import * as request from 'superagent';
 import * as c3 from 'c3';
 import * as flatpickr from 'flatpickr';
 import JSONFormatter from 'json-formatter-js';
+import { t } from './i18n';
+import { csrfToken } from './csrf';
+import { apiToken } from './token';
 
 enum RenderSpan {
     Week  = "week",
@@ -39,7 +42,7 @@ function deepCopy(obj: any): any {
 }
 
 interface FetchParam {
-    ID:       Number
+    Tag:      string
     Page:     Number
     Limit:    Number
     Span:     RenderSpan
@@ -48,15 +51,9 @@ interface FetchParam {
 }
 
 class Render {
-    private _token: string = ""
     private _datePtn = /^([0-9]{4}-[0-9]{2}-[0-9]{2})T([0-9]{2}:[0-9]{2}:[0-9]{2})\+[0-9]{2}:[0-9]{2}$/
-    constructor() {
-        let e = <HTMLInputElement>document.getElementById('api-token')
-        this._token = e.value
-    }
-    
     public get token(): string {
-        return this._token
+        return apiToken()
     }
     public get datePtn(): RegExp {
         return this._datePtn
@@ -65,33 +62,31 @@ class Render {
     public AddTag(): void {
         let name_input = <HTMLInputElement>document.getElementById('tag_name')
         let name = name_input.value
-        request.put('/mypage/api/add_tag')
+        request.post('/api/v1/tags')
         .set('Content-Type', 'application/json')
-        .set('Authorization', `Bearer ${ this._token }`)
+        .set('Authorization', `Bearer ${ apiToken() }`)
+        .set('X-CSRF-Token', csrfToken())
         .send({ tag_name: name })
         .end(function(err, res) {
-            if (err || !res.ok) {
-                alert('http error: ' + err);
+            if (!err) {
+                alert(t('js.tag_added'))
+                window.location.reload(true)
+            } else if (res && res.body && res.body.reason) {
+                alert(`${ res.body.reason }`)
+                window.location.reload(true)
             } else {
-                let json = res.body
-                if (json.is_success) {
-                    alert('タグを追加しました')
-                    window.location.reload(true)
-                } else {
-                    alert(`${ json.reason }`)
-                    window.location.reload(true)
-                }
+                alert(t('js.http_error', err));
             }
         })
     }
     
     // page numbers are like these: 0, 1, 2...
     public DataFetch(param: FetchParam): Promise<request.Response> {
-        return request.post('/mypage/api/data')
-        .set('Content-Type', 'application/json')
-        .set('Authorization', `Bearer ${ this._token }`)
-        .send({
-            tag_id:   param.ID,
+        return request.get(`/api/v1/tags/${ encodeURIComponent(param.Tag) }/data`)
+        .set('Authorization', `Bearer ${ apiToken() }`)
+        // failed results have the reason in the body
+        .ok((res) => res.status < 500)
+        .query({
             page:     param.Page,
             span:     param.Span,
             limit:    param.Limit,
@@ -167,6 +162,11 @@ var action = <HTMLSelectElement>document.getElementById('action')
 var title = <HTMLHtmlElement>document.getElementById('tagname')
 var preval = action.value
 
+// tag names are shown in the pull down, and values are tag ids
+function selectedTag(): string {
+    return action.options[action.selectedIndex].text
+}
+
 var prevWeekdata: any,
     prevMonthdata: any,
     prevAlldata: any
@@ -176,8 +176,8 @@ function GraphWeek(): (response: any) => void {
         render.InitializeDom('week-')
         let json = response.body
         if (json === undefined) return
-        if (!json.is_success) {
-            throw new Error(`直近1週間分のデータの取得に失敗しました: ${ json.reason }`)
+        if (json.data === undefined) {
+            throw new Error(t('js.fetch_failed_reason', t('js.span.week'), json.reason))
         }
         if (json.data.length == 0) return
         prevWeekdata = json.data
@@ -190,8 +190,8 @@ function GraphMonth(): (response: any) => void {
         render.InitializeDom('month-')
         let json = response.body
         if (json === undefined) return
-        if (!json.is_success) {
-            throw new Error(`直近1ヶ月分のデータの取得に失敗しました: ${ json.reason }`)
+        if (json.data === undefined) {
+            throw new Error(t('js.fetch_failed_reason', t('js.span.month'), json.reason))
         }
         if (json.data.length == 0) return
         prevMonthdata = json.data
@@ -204,13 +204,13 @@ function GraphAll(): (response: any) => void {
         render.InitializeDom('')
         let json = response.body
         if (json === undefined) {
-            throw new Error(`全期間のデータの取得に失敗しました`)
+            throw new Error(t('js.fetch_failed', t('js.span.all')))
         }
-        if (!json.is_success) {
-            throw new Error(`全期間のデータの取得に失敗しました: ${ json.reason }`)
+        if (json.data === undefined) {
+            throw new Error(t('js.fetch_failed_reason', t('js.span.all'), json.reason))
         }
         if (json.data.length == 0) {
-            throw new Error(`全期間のデータが存在しませんでした`)
+            throw new Error(t('js.no_data', t('js.span.all')))
         }
         prevAlldata = json.data
         render.Graph('', json.data) // #chart
@@ -281,11 +281,11 @@ var weekNext: PreFetchTrigger = {
 weekReload.button.addEventListener('mouseover', (e) => {
     e.preventDefault()
     weekReload.data = null
-    let id        = Number(action.value)
+    let tag       = selectedTag()
     let weeklimit  = Number(weekSlider.value)
 
     render.DataFetch({
-        ID:      id,
+        Tag:     tag,
         Page:    0,
         Span:    RenderSpan.Week,
         Limit:   weeklimit,
@@ -295,13 +295,13 @@ weekReload.button.addEventListener('mouseover', (e) => {
     .then((response: any) => {
         let json = response.body
         if (json === undefined) {
-            throw new Error(`更新すべき1週間のデータの取得に失敗しました`)
+            throw new Error(t('js.fetch_failed', t('js.span.reload_week')))
         }
-        if (!json.is_success) {
-            throw new Error(`更新すべき1週間のデータの取得に失敗しました: ${ json.reason }`)
+        if (json.data === undefined) {
+            throw new Error(t('js.fetch_failed_reason', t('js.span.reload_week'), json.reason))
         }
         if (json.data.length == 0) {
-            throw new Error(`更新すべき1週間のデータが存在しませんでした`)
+            throw new Error(t('js.no_data', t('js.span.reload_week')))
         }
         weekReload.data = json.data
     })
@@ -416,11 +416,11 @@ var monthNext: PreFetchTrigger = {
 monthReload.button.addEventListener('mouseover', (e) => {
     e.stopImmediatePropagation()
     monthReload.data = null
-    let id        = Number(action.value)
+    let tag       = selectedTag()
     let monthlimit  = Number(monthSlider.value)
 
     render.DataFetch({
-        ID:      id,
+        Tag:     tag,
         Page:    0,
         Span:    RenderSpan.Month,
         Limit:   monthlimit,
@@ -430,13 +430,13 @@ monthReload.button.addEventListener('mouseover', (e) => {
     .then((response: any) => {
         let json = response.body
         if (json === undefined) {
-            throw new Error(`更新すべき1ヶ月のデータの取得に失敗しました`)
+            throw new Error(t('js.fetch_failed', t('js.span.reload_month')))
         }
-        if (!json.is_success) {
-            throw new Error(`更新すべき1ヶ月のデータの取得に失敗しました: ${ json.reason }`)
+        if (json.data === undefined) {
+            throw new Error(t('js.fetch_failed_reason', t('js.span.reload_month'), json.reason))
         }
         if (json.data.length == 0) {
-            throw new Error(`更新すべき1ヶ月のデータが存在しませんでした`)
+            throw new Error(t('js.no_data', t('js.span.reload_month')))
         }
         monthReload.data = json.data
     })
@@ -551,14 +551,14 @@ var allNext: PreFetchTrigger = {
 allReload.button.addEventListener('mouseover', (e) => {
     e.stopImmediatePropagation()
     allReload.data = null
-    let id        = Number(action.value)
+    let tag       = selectedTag()
     let alllimit  = Number(allSlider.value)
 
     let calendar  = allSpan.value.split(' to ')
     let start_at = calendar[0]
     let end_at   = calendar[1] || ""
     render.DataFetch({
-        ID:      id,
+        Tag:     tag,
         Page:    0,
         Span:    RenderSpan.All,
         Limit:   alllimit,
@@ -568,13 +568,13 @@ allReload.button.addEventListener('mouseover', (e) => {
     .then((response: any) => {
         let json = response.body
         if (json === undefined) {
-            throw new Error(`更新すべき全期間のデータの取得に失敗しました`)
+            throw new Error(t('js.fetch_failed', t('js.span.reload_all')))
         }
-        if (!json.is_success) {
-            throw new Error(`更新すべき全期間のデータの取得に失敗しました: ${ json.reason }`)
+        if (json.data === undefined) {
+            throw new Error(t('js.fetch_failed_reason', t('js.span.reload_all'), json.reason))
         }
         if (json.data.length == 0) {
-            throw new Error(`更新すべき全期間のデータが存在しませんでした`)
+            throw new Error(t('js.no_data', t('js.span.reload_all')))
         }
         allReload.data = json.data
     })
@@ -673,11 +673,11 @@ function prevFetch(prev: PreFetchTrigger, page: Number, span: RenderSpan) {
     }
     if (page < 0) page = 0
 
-    let id    = Number(action.value)
+    let tag   = selectedTag()
     let limit = Number(allSlider.value)
 
     render.DataFetch({
-        ID:      id,
+        Tag:     tag,
         Page:    page,
         Span:    span,
         Limit:   limit,
@@ -687,14 +687,14 @@ function prevFetch(prev: PreFetchTrigger, page: Number, span: RenderSpan) {
     .then((response: any) => {
         let json = response.body
         if (json === undefined) {
-            throw new Error(`これより以後のデータの取得に失敗しました`)
+            throw new Error(t('js.fetch_failed', t('js.span.next')))
         }
-        if (!json.is_success) {
-            throw new Error(`これより以後のデータの取得に失敗しました: ${ json.reason }`)
+        if (json.data === undefined) {
+            throw new Error(t('js.fetch_failed_reason', t('js.span.next'), json.reason))
         }
         if (json.data.length == 0) {
             prev.button.disabled = true
-            throw new Error(`これより以後のデータが存在しませんでした`)
+            throw new Error(t('js.no_data', t('js.span.next')))
         }
         prev.data = json.data
     })
@@ -710,11 +710,11 @@ function nextFetch(next: PreFetchTrigger, page: Number, span: RenderSpan) {
         between = getBetween()
     }
 
-    let id    = Number(action.value)
+    let tag   = selectedTag()
     let limit = Number(allSlider.value)
 
     render.DataFetch({
-        ID:      id,
+        Tag:     tag,
         Page:    page,
         Span:    span,
         Limit:   limit,
@@ -724,10 +724,10 @@ function nextFetch(next: PreFetchTrigger, page: Number, span: RenderSpan) {
     .then((response: any) => {
         let json = response.body
         if (json === undefined) {
-            throw new Error(`これより以前のデータの取得に失敗しました`)
+            throw new Error(t('js.fetch_failed', t('js.span.prev')))
         }
-        if (!json.is_success) {
-            throw new Error(`これより以前のデータの取得に失敗しました: ${ json.reason }`)
+        if (json.data === undefined) {
+            throw new Error(t('js.fetch_failed_reason', t('js.span.prev'), json.reason))
         }
         if (json.data.length == 0) {
             next.button.disabled = true
@@ -745,7 +745,7 @@ action.addEventListener('change', async (e) => {
     e.preventDefault()
     if (action.value == "") return
 
-    let id         = Number(action.value)
+    let tag        = selectedTag()
     let weeklimit  = Number(weekSlider.value)
     let monthlimit = Number(monthSlider.value)
     let alllimit   = Number(allSlider.value)
@@ -758,7 +758,7 @@ action.addEventListener('change', async (e) => {
 
     await Promise.all([
         render.DataFetch({
-            ID:      id,
+            Tag:     tag,
             Page:    0,
             Span:    RenderSpan.Week,
             Limit:   weeklimit,
@@ -766,7 +766,7 @@ action.addEventListener('change', async (e) => {
             EndAt:   ''
         }).then(GraphWeek(), (e) => e),
         render.DataFetch({
-            ID:      id,
+            Tag:     tag,
             Page:    0,
             Span:    RenderSpan.Month,
             Limit:   monthlimit,
@@ -774,7 +774,7 @@ action.addEventListener('change', async (e) => {
             EndAt:   ''
         }).then(GraphMonth(), (e) => e),
         render.DataFetch({
-            ID:      id,
+            Tag:     tag,
             Page:    0,
             Span:    RenderSpan.All,
             Limit:   alllimit,
@@ -782,7 +782,7 @@ action.addEventListener('change', async (e) => {
             EndAt:   end_at,
         }).then(GraphAll(), (e) => e)
     ]).catch(function(error) {
-        alert('タグの切り替え時にエラーが発生しました\n' + error)
+        alert(t('js.switch_failed') + '\n' + error)
         isCaught = true
         action.value = preval
         if (prevWeekdata != null)  render.Graph('week-', deepCopy(prevWeekdata))
@@ -810,7 +810,7 @@ action.addEventListener('change', async (e) => {
     allNext.button.disabled = true
 
     // title change
-    title.textContent = `タグ${action[action.selectedIndex].text }のグラフ`
+    title.textContent = t('js.graph_title', action[action.selectedIndex].text)
     preval = action.value // to restore pull down
 })
 
@@ -1,29 +1,24 @@
 import * as request from 'superagent';
+import { t } from './i18n';
+import { csrfToken } from './csrf';
+import { apiToken } from './token';
 
 class Settings {
-    private _token: string = ""
-    constructor() {
-        let e = <HTMLInputElement>document.getElementById('api-token')
-        this._token = e.value
-    }
-
     public RegenerateToken(): void {
-        request.patch('/mypage/api/regenerate')
+        request.post('/api/v1/users/me/token')
         .set('Content-Type', 'application/json')
-        .set('Authorization', `Bearer ${ this._token }`)
+        .set('Authorization', `Bearer ${ apiToken() }`)
+        .set('X-CSRF-Token', csrfToken())
         .send()
         .end(function(err, res){
-            if (err || !res.ok) {
-                alert('http error: ' + err);
+            if (!err) {
+                alert(t('js.token_regenerated'))
+                window.location.reload(true)
+            } else if (res && res.body && res.body.reason) {
+                alert(`${ res.body.reason }`)
+                window.location.reload(true)
             } else {
-                let json = res.body
-                if (json.is_success) {
-                    alert('アクセストークンを更新しました')
-                    window.location.reload(true)
-                } else {
-                    alert(`${ json.reason }`)
-                    window.location.reload(true)
-                }
+                alert(t('js.http_error', err));
             }
         })
     }
@@ -34,32 +29,150 @@ class Settings {
         let password = passwdElem.value
         let password_verify = passwdVerifyElem.value
         if (password == "" || password_verify == "") {
-            alert("パスワードが入力されていません")
+            alert(t('js.password_empty'))
             return;
         }
         if (password != password_verify) {
-            alert("パスワードが一致していません")
+            alert(t('js.password_mismatch'))
             return;
         }
-        request.post('/mypage/api/reregister_password')
+        request.put('/api/v1/users/me/password')
         .set('Content-Type', 'application/json')
-        .set('Authorization', `Bearer ${ this._token }`)
+        .set('Authorization', `Bearer ${ apiToken() }`)
+        .set('X-CSRF-Token', csrfToken())
         .send({ password: password, verify_password: password_verify })
         .end(function(err, res){
-            if (err || !res.ok) {
-                alert('http error: ' + err);
+            if (!err) {
+                alert(t('js.password_updated'))
+                window.location.reload(true)
+            } else if (res && res.body && res.body.reason) {
+                alert(t('js.password_update_failed', res.body.reason))
+                window.location.reload(true)
+            } else {
+                alert(t('js.http_error', err));
+            }
+        })
+    }
+
+    public SaveLocale(): void {
+        let localeElem = <HTMLSelectElement>document.getElementById('locale')
+        request.patch('/api/v1/users/me')
+        .set('Content-Type', 'application/json')
+        .set('Authorization', `Bearer ${ apiToken() }`)
+        .set('X-CSRF-Token', csrfToken())
+        .send({ locale: localeElem.value })
+        .end(function(err, res){
+            if (!err) {
+                alert(t('js.locale_saved'))
+                window.location.reload(true)
+            } else if (res && res.body && res.body.reason) {
+                alert(t('js.locale_save_failed', res.body.reason))
+            } else {
+                alert(t('js.http_error', err));
+            }
+        })
+    }
+
+    public RevokeSession(id: string): void {
+        if (!confirm(t('js.session_revoke_confirm'))) {
+            return;
+        }
+        request.delete(`/api/v1/users/me/sessions/${ id }`)
+        .set('Authorization', `Bearer ${ apiToken() }`)
+        .set('X-CSRF-Token', csrfToken())
+        .end(function(err, res){
+            if (!err) {
+                alert(t('js.session_revoked'))
+                window.location.reload(true)
+            } else if (res && res.body && res.body.reason) {
+                alert(t('js.session_revoke_failed', res.body.reason))
+            } else {
+                alert(t('js.http_error', err));
+            }
+        })
+    }
+    public StartTOTP(): void {
+        request.post('/api/v1/users/me/totp')
+        .set('Authorization', `Bearer ${ apiToken() }`)
+        .set('X-CSRF-Token', csrfToken())
+        .end(function(err, res){
+            if (!err) {
+                (<HTMLImageElement>document.getElementById('totp-qr')).src = res.body.qr_code
+                document.getElementById('totp-secret').textContent = res.body.secret
+                document.getElementById('totp-enroll').style.display = 'block'
+                document.getElementById('totp-start').style.display = 'none'
+            } else if (res && res.body && res.body.reason) {
+                alert(t('js.totp_failed', res.body.reason))
+            } else {
+                alert(t('js.http_error', err));
+            }
+        })
+    }
+
+    public EnableTOTP(): void {
+        let code = (<HTMLInputElement>document.getElementById('totp-code')).value
+        request.put('/api/v1/users/me/totp')
+        .set('Content-Type', 'application/json')
+        .set('Authorization', `Bearer ${ apiToken() }`)
+        .set('X-CSRF-Token', csrfToken())
+        .send({ code: code })
+        .end(function(err, res){
+            if (!err) {
+                document.getElementById('totp-enroll').style.display = 'none'
+                Settings.showRecoveryCodes(res.body.recovery_codes)
+                alert(t('js.totp_enabled'))
+            } else if (res && res.body && res.body.reason) {
+                alert(t('js.totp_failed', res.body.reason))
+            } else {
+                alert(t('js.http_error', err));
+            }
+        })
+    }
+
+    public DisableTOTP(): void {
+        if (!confirm(t('js.totp_disable_confirm'))) {
+            return;
+        }
+        let code = (<HTMLInputElement>document.getElementById('totp-current-code')).value
+        request.delete('/api/v1/users/me/totp')
+        .set('Content-Type', 'application/json')
+        .set('Authorization', `Bearer ${ apiToken() }`)
+        .set('X-CSRF-Token', csrfToken())
+        .send({ code: code })
+        .end(function(err, res){
+            if (!err) {
+                alert(t('js.totp_disabled'))
+                window.location.reload(true)
+            } else if (res && res.body && res.body.reason) {
+                alert(t('js.totp_failed', res.body.reason))
+            } else {
+                alert(t('js.http_error', err));
+            }
+        })
+    }
+
+    public RegenerateRecoveryCodes(): void {
+        let code = (<HTMLInputElement>document.getElementById('totp-current-code')).value
+        request.post('/api/v1/users/me/totp/recovery-codes')
+        .set('Content-Type', 'application/json')
+        .set('Authorization', `Bearer ${ apiToken() }`)
+        .set('X-CSRF-Token', csrfToken())
+        .send({ code: code })
+        .end(function(err, res){
+            if (!err) {
+                Settings.showRecoveryCodes(res.body.recovery_codes)
+            } else if (res && res.body && res.body.reason) {
+                alert(t('js.totp_failed', res.body.reason))
             } else {
-                let json = res.body
-                if (json.is_success) {
-                    alert('パスワードを更新しました')
-                    window.location.reload(true)
-                } else {
-                    alert(`パスワードの更新に失敗しました: ${ json.reason }`)
-                    window.location.reload(true)
-                }
+                alert(t('js.http_error', err));
             }
         })
     }
+
+    private static showRecoveryCodes(codes: string[]): void {
+        document.getElementById('recovery-codes-list').textContent = codes.join('\n')
+        document.getElementById('recovery-codes').style.display = 'block'
+    }
 }
 
 var settings = new Settings()
@@ -74,4 +187,35 @@ var reregister = <HTMLInputElement>document.getElementById("reregister-password"
 reregister.addEventListener('click', (e) => {
     e.preventDefault()
     settings.RegisterPassword()
-})
\ No newline at end of file
+})
+var saveLocale = <HTMLInputElement>document.getElementById("save-locale")
+saveLocale.addEventListener('click', (e) => {
+    e.preventDefault()
+    settings.SaveLocale()
+})
+
+var revokeElems = document.getElementsByClassName('revoke-session')
+for (let i = 0; i < revokeElems.length; i++) {
+    let elem = <HTMLButtonElement>revokeElems[i]
+    elem.addEventListener('click', (e) => {
+        e.preventDefault()
+        settings.RevokeSession(elem.dataset.id)
+    })
+}
+
+// Only the buttons for the current state of TOTP are rendered.
+let totpButtons: [string, () => void][] = [
+    ['totp-start', () => settings.StartTOTP()],
+    ['totp-enable', () => settings.EnableTOTP()],
+    ['totp-disable', () => settings.DisableTOTP()],
+    ['totp-recovery', () => settings.RegenerateRecoveryCodes()],
+]
+for (let [id, action] of totpButtons) {
+    let elem = document.getElementById(id)
+    if (elem) {
+        elem.addEventListener('click', (e) => {
+            e.preventDefault()
+            action()
+        })
+    }
+}
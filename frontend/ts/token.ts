@@ -0,0 +1,69 @@
+import * as request from 'superagent'
+import { t } from './i18n'
+import { csrfToken } from './csrf'
+
+// The access token for the API is embedded in pages by the server. It is
+// short-lived, so it is renewed by the refresh token in the cookie a minute
+// before it expires.
+const refreshMargin = 60 * 1000
+
+let token = ''
+let expiresAt = 0
+let timer: number | undefined
+let refreshing = false
+let expired = false
+
+// apiToken returns the current access token.
+export function apiToken(): string {
+    return token
+}
+
+function schedule(delay: number): void {
+    if (timer !== undefined) {
+        window.clearTimeout(timer)
+    }
+    timer = window.setTimeout(refresh, Math.max(delay, 0))
+}
+
+function refresh(): void {
+    if (timer !== undefined) {
+        window.clearTimeout(timer)
+        timer = undefined
+    }
+    if (refreshing || expired) {
+        return
+    }
+    refreshing = true
+    request.post('/api/v1/token/refresh')
+    .set('X-CSRF-Token', csrfToken())
+    .send()
+    .end(function(err, res) {
+        refreshing = false
+        if (!err) {
+            token = res.body.token
+            expiresAt = Date.parse(res.body.expires_at)
+            schedule(expiresAt - refreshMargin - Date.now())
+        } else if (res && res.status == 401) {
+            // The login session is over. Keep the page as it is so that
+            // nothing which is typed is lost.
+            expired = true
+            alert(t('js.login_expired'))
+        } else {
+            // Try again later on network errors.
+            schedule(10 * 1000)
+        }
+    })
+}
+
+const elem = <HTMLInputElement>document.getElementById('api-token')
+if (elem) {
+    token = elem.value
+    expiresAt = Number(elem.dataset.expiresAt) * 1000
+    schedule(expiresAt - refreshMargin - Date.now())
+    // Timers are delayed while the computer sleeps.
+    document.addEventListener('visibilitychange', () => {
+        if (!document.hidden && Date.now() > expiresAt - refreshMargin) {
+            refresh()
+        }
+    })
+}
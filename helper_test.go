@@ -0,0 +1,221 @@
+package vegeta
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Code-Hex/vegeta/internal/model"
+	"github.com/jinzhu/gorm"
+	_ "github.com/jinzhu/gorm/dialects/sqlite"
+	"go.uber.org/zap"
+)
+
+type testServer struct {
+	*httptest.Server
+	t  *testing.T
+	V  *Vegeta
+	DB *gorm.DB
+}
+
+// newTestServer serves all routes with an in-memory database. opts are
+// applied after the defaults for tests.
+func newTestServer(t *testing.T, opts ...Option) *testServer {
+	t.Helper()
+	db, err := gorm.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Each connection of :memory: has its own database.
+	db.DB().SetMaxOpenConns(1)
+	db.LogMode(false)
+	for _, m := range []interface{}{
+		&model.User{},
+		&model.Tag{},
+		&model.Data{},
+		&model.Session{},
+		&model.LoginAttempt{},
+		&model.RecoveryCode{},
+		&model.AuditLog{},
+		&model.RefreshToken{},
+		&model.Invite{},
+		&model.InviteUse{},
+	} {
+		// Names of indexes are shared by all tables in SQLite, so the
+		// second idx_name fails. Indexes are not needed in tests.
+		db.AutoMigrate(m)
+	}
+	v, err := New(append([]Option{
+		WithDB(db),
+		WithLogger(zap.NewNop()),
+		WithSecret([]byte("secret")),
+		WithConfig(DefaultConfig()),
+		WithClock(time.Now),
+	}, opts...)...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &testServer{Server: httptest.NewServer(v.Handler()), t: t, V: v, DB: db}
+	t.Cleanup(func() {
+		s.Close()
+		db.Close()
+	})
+	return s
+}
+
+func (s *testServer) createUser(name, pass string, admin bool) *model.User {
+	s.t.Helper()
+	u, err := model.CreateUser(s.DB, name, pass, admin)
+	if err != nil {
+		s.t.Fatal(err)
+	}
+	return u
+}
+
+// testClient keeps cookies like browsers, and does not follow redirects
+// so that tests can check where they go.
+type testClient struct {
+	*http.Client
+	srv *testServer
+}
+
+func (s *testServer) client() *testClient {
+	jar, _ := cookiejar.New(nil)
+	return &testClient{
+		Client: &http.Client{
+			Jar: jar,
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		srv: s,
+	}
+}
+
+func (c *testClient) do(req *http.Request) (*http.Response, string) {
+	c.srv.t.Helper()
+	resp, err := c.Do(req)
+	if err != nil {
+		c.srv.t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		c.srv.t.Fatal(err)
+	}
+	return resp, string(b)
+}
+
+func (c *testClient) newRequest(method, path string, body io.Reader) *http.Request {
+	c.srv.t.Helper()
+	req, err := http.NewRequest(method, c.srv.URL+path, body)
+	if err != nil {
+		c.srv.t.Fatal(err)
+	}
+	return req
+}
+
+func (c *testClient) get(path string) (*http.Response, string) {
+	c.srv.t.Helper()
+	return c.do(c.newRequest(http.MethodGet, path, nil))
+}
+
+func (c *testClient) postForm(path string, form url.Values) (*http.Response, string) {
+	c.srv.t.Helper()
+	req := c.newRequest(http.MethodPost, path, strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	return c.do(req)
+}
+
+// api calls the route with the bearer token and the CSRF token of the
+// cookie. Empty values are not sent.
+func (c *testClient) api(method, path, token, csrf string, body interface{}) (*http.Response, string) {
+	c.srv.t.Helper()
+	var r io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			c.srv.t.Fatal(err)
+		}
+		r = bytes.NewReader(b)
+	}
+	req := c.newRequest(method, path, r)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if token != "" {
+		req.Header.Set("Authorization", authScheme+" "+token)
+	}
+	if csrf != "" {
+		req.Header.Set(csrfHeader, csrf)
+	}
+	return c.do(req)
+}
+
+// csrfCookie returns the CSRF token which is set to the cookie.
+func (c *testClient) csrfCookie() string {
+	u, _ := url.Parse(c.srv.URL)
+	for _, cookie := range c.Jar.Cookies(u) {
+		if cookie.Name == csrfCookieName {
+			return cookie.Value
+		}
+	}
+	return ""
+}
+
+var (
+	csrfFieldRe = regexp.MustCompile(`name="csrf_token" value="([^"]+)"`)
+	apiTokenRe  = regexp.MustCompile(`id="api-token" value="([^"]+)"`)
+)
+
+// formCSRF returns the CSRF token of the form in the page.
+func (c *testClient) formCSRF(path string) string {
+	c.srv.t.Helper()
+	_, body := c.get(path)
+	m := csrfFieldRe.FindStringSubmatch(body)
+	if m == nil {
+		c.srv.t.Fatalf("%s has no CSRF token", path)
+	}
+	return m[1]
+}
+
+// login logs in by the password, and fails unless it goes to next.
+func (c *testClient) login(name, pass, next string) {
+	c.srv.t.Helper()
+	resp, _ := c.postForm("/auth", url.Values{
+		"username":   {name},
+		"password":   {pass},
+		"csrf_token": {c.formCSRF("/login")},
+	})
+	if loc := resp.Header.Get("Location"); loc != next {
+		c.srv.t.Fatalf("login of %s goes to %q, want %q", name, loc, next)
+	}
+}
+
+// loggedIn returns a new client of the user who has logged in.
+func (s *testServer) loggedIn(name, pass string) *testClient {
+	s.t.Helper()
+	c := s.client()
+	c.login(name, pass, "/mypage")
+	return c
+}
+
+// pageToken returns the token which the page embeds for scripts.
+func (c *testClient) pageToken(path string) string {
+	c.srv.t.Helper()
+	_, body := c.get(path)
+	m := apiTokenRe.FindStringSubmatch(body)
+	if m == nil {
+		c.srv.t.Fatalf("%s has no api token", path)
+	}
+	return m[1]
+}
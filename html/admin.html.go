@@ -13,11 +13,18 @@ func Admin(args AdminArgs, w io.Writer) {
 	_buffer := hero.GetBuffer()
 	defer hero.PutBuffer(_buffer)
 	_buffer.WriteString(`<!DOCTYPE html>
-<html lang="ja">
+<html lang="`)
+	hero.EscapeHTML(args.Lang(), _buffer)
+	_buffer.WriteString(`">
 <head>
   <meta charset="utf-8">
   <meta name="viewport" content="width=device-width, initial-scale=1.0">
-  <meta name="description" content="IoTを用いた栽培中の植物のデータを管理するプロジェクトです。">
+  <meta name="description" content="`)
+	hero.EscapeHTML(args.T("site.description"), _buffer)
+	_buffer.WriteString(`">
+  <script type="application/json" id="messages">`)
+	_buffer.WriteString(args.Messages())
+	_buffer.WriteString(`</script>
   <link href="/assets/css/main.css" rel="stylesheet">
   <link href="https://maxcdn.bootstrapcdn.com/font-awesome/4.7.0/css/font-awesome.min.css" rel="stylesheet" integrity="sha384-wvfXpqpZZVQGK6TAh5PVlGOfQNHSoD2xbE+QkPxCAFlNEevoEH3Sl0sibVcOQVnN" crossorigin="anonymous">
   <link rel="stylesheet" href="/assets/css/bootstrap.css">
@@ -44,35 +51,54 @@ func Admin(args AdminArgs, w io.Writer) {
     <a class="navbar-brand" href="/">Vegeta</a>
     <div id="navbarResponsive" class="collapse navbar-collapse">
       <ul class="navbar-nav mr-auto">
-        <li class="nav-item"><a class="nav-link" href="/contact">問い合わせ</a></li>
+        <li class="nav-item"><a class="nav-link" href="/contact">`)
+	hero.EscapeHTML(args.T("nav.contact"), _buffer)
+	_buffer.WriteString(`</a></li>
       </ul>
       <ul class="navbar-nav">
         `)
 	if args.IsAuthed() {
 		_buffer.WriteString(`
           <li class="nav-item dropdown">
-            <a class="nav-link dropdown-toggle dropdown-toggle-split" href="" data-toggle="dropdown" aria-haspopup="true" aria-expanded="false"><i class="fa fa-user" aria-hidden="true"></i> ユーザー</a>
+            <a class="nav-link dropdown-toggle dropdown-toggle-split" href="" data-toggle="dropdown" aria-haspopup="true" aria-expanded="false"><i class="fa fa-user" aria-hidden="true"></i> `)
+		hero.EscapeHTML(args.T("nav.user"), _buffer)
+		_buffer.WriteString(`</a>
             <div class="dropdown-menu">
-              <a class="dropdown-item" href="/mypage"><i class="fa fa-pagelines" aria-hidden="true"></i> 観察</a>
+              <a class="dropdown-item" href="/mypage"><i class="fa fa-pagelines" aria-hidden="true"></i> `)
+		hero.EscapeHTML(args.T("nav.mypage"), _buffer)
+		_buffer.WriteString(`</a>
               <div class="dropdown-divider"></div>
-              <a class="dropdown-item" href="/mypage/settings"><i class="fa fa-cog" aria-hidden="true"></i> 設定</a>
+              <a class="dropdown-item" href="/mypage/settings"><i class="fa fa-cog" aria-hidden="true"></i> `)
+		hero.EscapeHTML(args.T("nav.settings"), _buffer)
+		_buffer.WriteString(`</a>
               `)
 		if args.IsAdmin() {
 			_buffer.WriteString(`
-                <a class="dropdown-item" href="/mypage/admin"><i class="fa fa-lock" aria-hidden="true"></i> ユーザー管理パネル</a>
+                <a class="dropdown-item" href="/mypage/admin"><i class="fa fa-lock" aria-hidden="true"></i> `)
+			hero.EscapeHTML(args.T("nav.admin"), _buffer)
+			_buffer.WriteString(`</a>
               `)
 		}
 		_buffer.WriteString(`
             </div>
           </li>
           <li class="nav-item">
-            <a class="nav-link" href="/mypage/logout"><i class="fa fa-sign-out" aria-hidden="true"></i> ログアウト</a>
+            <form class="form-inline" action="/mypage/logout" method="POST">
+              <input type="hidden" name="csrf_token" value="`)
+		hero.EscapeHTML(args.CSRFToken(), _buffer)
+		_buffer.WriteString(`">
+              <button type="submit" class="btn btn-link nav-link"><i class="fa fa-sign-out" aria-hidden="true"></i> `)
+		hero.EscapeHTML(args.T("nav.logout"), _buffer)
+		_buffer.WriteString(`</button>
+            </form>
           </li>
         `)
 	} else {
 		_buffer.WriteString(`
           <li class="nav-item">
-            <a class="nav-link" href="/login"><i class="fa fa-sign-in" aria-hidden="true"></i> ログイン</a>
+            <a class="nav-link" href="/login"><i class="fa fa-sign-in" aria-hidden="true"></i> `)
+		hero.EscapeHTML(args.T("nav.login"), _buffer)
+		_buffer.WriteString(`</a>
           </li>
         `)
 	}
@@ -86,13 +112,26 @@ func Admin(args AdminArgs, w io.Writer) {
 	_buffer.WriteString(`
 <input type="hidden" id="api-token" value="`)
 	hero.EscapeHTML(adminArgs.Token(), _buffer)
+	_buffer.WriteString(`" data-expires-at="`)
+	hero.FormatInt(int64(adminArgs.TokenExpiresAt()), _buffer)
+	_buffer.WriteString(`">
+<input type="hidden" id="csrf-token" value="`)
+	hero.EscapeHTML(args.CSRFToken(), _buffer)
 	_buffer.WriteString(`">
 <div class="admin-content">
   <div class="admin-wrapper">
     <div class="container-fluid">
       <div class="row">
           <div class="col col-sm-11 col-md-11 col-lg-11 text-right">
-            <button type="button" class="btn btn-md btn-primary btn-create" class="btn btn-primary" data-toggle="modal" data-target="#createModal">ユーザー作成</button>
+            <button type="button" class="btn btn-md btn-primary btn-create" class="btn btn-primary" data-toggle="modal" data-target="#createModal">`)
+	hero.EscapeHTML(args.T("admin.create"), _buffer)
+	_buffer.WriteString(`</button>
+            <a class="btn btn-md btn-secondary" href="/mypage/admin/invites">`)
+	hero.EscapeHTML(args.T("admin.invites"), _buffer)
+	_buffer.WriteString(`</a>
+            <a class="btn btn-md btn-secondary" href="/mypage/admin/audit">`)
+	hero.EscapeHTML(args.T("admin.audit"), _buffer)
+	_buffer.WriteString(`</a>
           </div>
         </div>
     </div>
@@ -102,9 +141,18 @@ func Admin(args AdminArgs, w io.Writer) {
       <thead>
         <tr>
           <th>ID</th>
-          <th>ユーザー名</th>
-          <th>管理者</th>
-          <th>アクション</th>
+          <th>`)
+	hero.EscapeHTML(args.T("login.username"), _buffer)
+	_buffer.WriteString(`</th>
+          <th>`)
+	hero.EscapeHTML(args.T("admin.admin"), _buffer)
+	_buffer.WriteString(`</th>
+          <th>`)
+	hero.EscapeHTML(args.T("admin.status"), _buffer)
+	_buffer.WriteString(`</th>
+          <th>`)
+	hero.EscapeHTML(args.T("admin.action"), _buffer)
+	_buffer.WriteString(`</th>
         </tr>
       </thead>
       <tbody>
@@ -121,6 +169,52 @@ func Admin(args AdminArgs, w io.Writer) {
             <td>`)
 		hero.FormatBool(user.Admin, _buffer)
 		_buffer.WriteString(`</td>
+            <td>
+              `)
+		if adminArgs.IsLocked(user) {
+			_buffer.WriteString(`
+                `)
+			hero.EscapeHTML(args.T("admin.locked"), _buffer)
+			_buffer.WriteString(`
+                <button type="button" class="btn btn-sm btn-warning unlock-user" data-id="`)
+			hero.FormatUint(uint64(user.ID), _buffer)
+			_buffer.WriteString(`"><i class="fa fa-unlock"></i> `)
+			hero.EscapeHTML(args.T("admin.unlock"), _buffer)
+			_buffer.WriteString(`</button>
+              `)
+		}
+		_buffer.WriteString(`
+              `)
+		if user.TOTPEnabled {
+			_buffer.WriteString(`
+                `)
+			hero.EscapeHTML(args.T("admin.totp"), _buffer)
+			_buffer.WriteString(`
+                <button type="button" class="btn btn-sm btn-secondary reset-totp" data-id="`)
+			hero.FormatUint(uint64(user.ID), _buffer)
+			_buffer.WriteString(`" data-name="`)
+			hero.EscapeHTML(user.Name, _buffer)
+			_buffer.WriteString(`">`)
+			hero.EscapeHTML(args.T("admin.reset_totp"), _buffer)
+			_buffer.WriteString(`</button>
+              `)
+		}
+		_buffer.WriteString(`
+              `)
+		if user.IsSuspended() {
+			_buffer.WriteString(`
+                `)
+			hero.EscapeHTML(args.T("admin.suspended"), _buffer)
+			_buffer.WriteString(`
+                <button type="button" class="btn btn-sm btn-success resume-user" data-id="`)
+			hero.FormatUint(uint64(user.ID), _buffer)
+			_buffer.WriteString(`"><i class="fa fa-play"></i> `)
+			hero.EscapeHTML(args.T("admin.resume"), _buffer)
+			_buffer.WriteString(`</button>
+              `)
+		}
+		_buffer.WriteString(`
+            </td>
             <td align="center">
               <button type="button" class="btn btn-info" data-toggle="modal" data-target="#editModal" data-id="`)
 		hero.FormatUint(uint64(user.ID), _buffer)
@@ -129,9 +223,27 @@ func Admin(args AdminArgs, w io.Writer) {
 		_buffer.WriteString(`" data-is-admin="`)
 		hero.FormatBool(user.Admin, _buffer)
 		_buffer.WriteString(`"><i class="fa fa-pencil"></i></button>
+              <a class="btn btn-secondary" href="/mypage/admin/users/`)
+		hero.FormatUint(uint64(user.ID), _buffer)
+		_buffer.WriteString(`/archive" title="`)
+		hero.EscapeHTML(args.T("admin.export"), _buffer)
+		_buffer.WriteString(`"><i class="fa fa-download"></i></a>
               `)
 		if user.ID > 1 {
 			_buffer.WriteString(`
+                `)
+			if !user.IsSuspended() {
+				_buffer.WriteString(`
+                  <button type="button" class="btn btn-warning suspend-user" data-id="`)
+				hero.FormatUint(uint64(user.ID), _buffer)
+				_buffer.WriteString(`" data-name="`)
+				hero.EscapeHTML(user.Name, _buffer)
+				_buffer.WriteString(`" title="`)
+				hero.EscapeHTML(args.T("admin.suspend"), _buffer)
+				_buffer.WriteString(`"><i class="fa fa-ban"></i></button>
+                `)
+			}
+			_buffer.WriteString(`
                 <button type="button" class="btn btn-danger" data-toggle="modal" data-target="#deleteModal" data-id="`)
 			hero.FormatUint(uint64(user.ID), _buffer)
 			_buffer.WriteString(`" data-name="`)
@@ -152,7 +264,9 @@ func Admin(args AdminArgs, w io.Writer) {
     <div class="modal-dialog" role="document">
       <div class="modal-content">
         <div class="modal-header">
-          <h5 class="modal-title" id="createModalLabel">新しいユーザーの作成</h5>
+          <h5 class="modal-title" id="createModalLabel">`)
+	hero.EscapeHTML(args.T("admin.create.title"), _buffer)
+	_buffer.WriteString(`</h5>
           <button type="button" class="close" data-dismiss="modal" aria-label="Close">
             <span aria-hidden="true">&times;</span>
           </button>
@@ -160,26 +274,38 @@ func Admin(args AdminArgs, w io.Writer) {
         <form id="create-user-validation">
           <div class="modal-body">
             <div class="form-group">
-              <label for="username" class="form-control-label">ユーザー名:</label>
+              <label for="username" class="form-control-label">`)
+	hero.EscapeHTML(args.T("admin.username_label"), _buffer)
+	_buffer.WriteString(`</label>
               <input type="text" name="username" class="form-control" id="username" required>
             </div>
             <div class="form-group">
-              <label for="password" class="form-control-label">パスワード:</label>
+              <label for="password" class="form-control-label">`)
+	hero.EscapeHTML(args.T("admin.password_label"), _buffer)
+	_buffer.WriteString(`</label>
               <input type="password" name="password" class="form-control" id="password" required>
             </div>
             <div class="form-group">
-              <label for="verify-password" class="form-control-label">パスワードの再確認:</label>
+              <label for="verify-password" class="form-control-label">`)
+	hero.EscapeHTML(args.T("admin.verify_password_label"), _buffer)
+	_buffer.WriteString(`</label>
               <input type="password" name="verify-password" class="form-control" id="verify-password" data-match="#password" data-match-error="Whoops, these don't match" required>
             </div>
             <div class="form-check form-check-inline">
               <label for="is-admin" class="form-check-label">
-                  <input type="checkbox" name="is-admin" class="form-check-input" id="is-admin"> 管理者にする
+                  <input type="checkbox" name="is-admin" class="form-check-input" id="is-admin"> `)
+	hero.EscapeHTML(args.T("admin.make_admin"), _buffer)
+	_buffer.WriteString(`
               </label>
             </div>
           </div>
           <div class="modal-footer">
-            <button type="button" class="btn btn-secondary" data-dismiss="modal">閉じる</button>
-            <button type="submit" id="create" class="btn btn-primary">ユーザーの作成</button>
+            <button type="button" class="btn btn-secondary" data-dismiss="modal">`)
+	hero.EscapeHTML(args.T("common.close"), _buffer)
+	_buffer.WriteString(`</button>
+            <button type="submit" id="create" class="btn btn-primary">`)
+	hero.EscapeHTML(args.T("admin.create.submit"), _buffer)
+	_buffer.WriteString(`</button>
           </div>
         </form>
       </div>
@@ -189,7 +315,9 @@ func Admin(args AdminArgs, w io.Writer) {
     <div class="modal-dialog" role="document">
       <div class="modal-content">
         <div class="modal-header">
-          <h5 class="modal-title" id="editModalLabel">ユーザーの編集</h5>
+          <h5 class="modal-title" id="editModalLabel">`)
+	hero.EscapeHTML(args.T("admin.edit.title"), _buffer)
+	_buffer.WriteString(`</h5>
           <button type="button" class="close" data-dismiss="modal" aria-label="Close">
             <span aria-hidden="true">&times;</span>
           </button>
@@ -197,24 +325,34 @@ func Admin(args AdminArgs, w io.Writer) {
         <form id="edit-user-validation">
           <div class="modal-body">
             <div class="form-group">
-              <label for="username" class="form-control-label">ユーザー名:</label>
+              <label for="username" class="form-control-label">`)
+	hero.EscapeHTML(args.T("admin.username_label"), _buffer)
+	_buffer.WriteString(`</label>
               <input type="text" class="form-control" id="username" readonly="readonly">
               <input type="hidden" class="form-control" id="user-id">
             </div>
             <div class="form-check form-check-inline">
               <label for="is-admin" class="form-check-label">
-                  <input type="checkbox" name="is-admin" class="form-check-input" id="is-admin"> 管理者にする
+                  <input type="checkbox" name="is-admin" class="form-check-input" id="is-admin"> `)
+	hero.EscapeHTML(args.T("admin.make_admin"), _buffer)
+	_buffer.WriteString(`
               </label>
             </div>
             <div class="form-check form-check-inline">
               <label for="is-reset-password" class="form-check-label">
-                  <input type="checkbox" name="is-reset-password" class="form-check-input" id="is-reset-password"> パスワードをリセットする
+                  <input type="checkbox" name="is-reset-password" class="form-check-input" id="is-reset-password"> `)
+	hero.EscapeHTML(args.T("admin.reset_password"), _buffer)
+	_buffer.WriteString(`
               </label>
             </div>
           </div>
           <div class="modal-footer">
-            <button type="button" class="btn btn-secondary" data-dismiss="modal">閉じる</button>
-            <button type="submit" id="edit" class="btn btn-primary">編集を完了する</button>
+            <button type="button" class="btn btn-secondary" data-dismiss="modal">`)
+	hero.EscapeHTML(args.T("common.close"), _buffer)
+	_buffer.WriteString(`</button>
+            <button type="submit" id="edit" class="btn btn-primary">`)
+	hero.EscapeHTML(args.T("admin.edit.submit"), _buffer)
+	_buffer.WriteString(`</button>
           </div>
         </form>
       </div>
@@ -224,7 +362,9 @@ func Admin(args AdminArgs, w io.Writer) {
       <div class="modal-dialog" role="document">
         <div class="modal-content">
           <div class="modal-header">
-            <h5 class="modal-title" id="deleteModalLabel">ユーザーの削除</h5>
+            <h5 class="modal-title" id="deleteModalLabel">`)
+	hero.EscapeHTML(args.T("admin.delete.title"), _buffer)
+	_buffer.WriteString(`</h5>
             <button type="button" class="close" data-dismiss="modal" aria-label="Close">
               <span aria-hidden="true">&times;</span>
             </button>
@@ -232,14 +372,29 @@ func Admin(args AdminArgs, w io.Writer) {
           <form id="delete-user-validation">
             <div class="modal-body">
               <div class="form-group">
-                <label for="username" class="form-control-label">ユーザー名:</label>
+                <label for="username" class="form-control-label">`)
+	hero.EscapeHTML(args.T("admin.username_label"), _buffer)
+	_buffer.WriteString(`</label>
                 <input type="text" class="form-control" id="username" readonly="readonly">
                 <input type="hidden" class="form-control" id="user-id">
               </div>
+              <p class="text-danger">`)
+	hero.EscapeHTML(args.T("admin.delete.warning"), _buffer)
+	_buffer.WriteString(`</p>
+              <div class="form-group">
+                <label for="confirm-name" class="form-control-label">`)
+	hero.EscapeHTML(args.T("admin.delete.confirm_label"), _buffer)
+	_buffer.WriteString(`</label>
+                <input type="text" class="form-control" id="confirm-name" autocomplete="off" required>
+              </div>
             </div>
             <div class="modal-footer">
-              <button type="button" class="btn btn-secondary" data-dismiss="modal">閉じる</button>
-              <button type="submit" id="delete" class="btn btn-danger">削除する</button>
+              <button type="button" class="btn btn-secondary" data-dismiss="modal">`)
+	hero.EscapeHTML(args.T("common.close"), _buffer)
+	_buffer.WriteString(`</button>
+              <button type="submit" id="delete" class="btn btn-danger">`)
+	hero.EscapeHTML(args.T("admin.delete.submit"), _buffer)
+	_buffer.WriteString(`</button>
             </div>
           </form>
         </div>
@@ -0,0 +1,238 @@
+// Code generated by hero.
+// source: /Users/codehex/Desktop/go/src/github.com/Code-Hex/vegeta/template/admin_audit.html
+// DO NOT EDIT!
+package html
+
+import (
+	"io"
+
+	"github.com/shiyanhui/hero"
+)
+
+func AuditLogs(args AuditArgs, w io.Writer) {
+	_buffer := hero.GetBuffer()
+	defer hero.PutBuffer(_buffer)
+	_buffer.WriteString(`<!DOCTYPE html>
+<html lang="`)
+	hero.EscapeHTML(args.Lang(), _buffer)
+	_buffer.WriteString(`">
+<head>
+  <meta charset="utf-8">
+  <meta name="viewport" content="width=device-width, initial-scale=1.0">
+  <meta name="description" content="`)
+	hero.EscapeHTML(args.T("site.description"), _buffer)
+	_buffer.WriteString(`">
+  <script type="application/json" id="messages">`)
+	_buffer.WriteString(args.Messages())
+	_buffer.WriteString(`</script>
+  <link href="/assets/css/main.css" rel="stylesheet">
+  <link href="https://maxcdn.bootstrapcdn.com/font-awesome/4.7.0/css/font-awesome.min.css" rel="stylesheet" integrity="sha384-wvfXpqpZZVQGK6TAh5PVlGOfQNHSoD2xbE+QkPxCAFlNEevoEH3Sl0sibVcOQVnN" crossorigin="anonymous">
+  <link rel="stylesheet" href="/assets/css/bootstrap.css">
+  <script src="/assets/js/jquery.min.js"></script>
+  <script src="/assets/js/tether.min.js"></script>
+  <script src="/assets/js/bootstrap.min.js"></script>
+  `)
+	_buffer.WriteString(`
+  <title>`)
+	_buffer.WriteString(`audit`)
+
+	_buffer.WriteString(`</title>
+</head>
+<body class="d-flex flex-column" style="min-height: 100vh">
+  <nav class="navbar navbar-toggleable-md navbar-expand-lg navbar-light static-top v-navbar">
+    <button class="navbar-toggler navbar-toggler-right" type="button" data-toggle="collapse" data-target="#navbarResponsive" aria-controls="navbarResponsive" aria-expanded="false" aria-label="Toggle navigation">
+      <i class="fa fa-bars"></i>
+    </button>
+    <a class="navbar-brand" href="/">Vegeta</a>
+    <div id="navbarResponsive" class="collapse navbar-collapse">
+      <ul class="navbar-nav mr-auto">
+        <li class="nav-item"><a class="nav-link" href="/contact">`)
+	hero.EscapeHTML(args.T("nav.contact"), _buffer)
+	_buffer.WriteString(`</a></li>
+      </ul>
+      <ul class="navbar-nav">
+        `)
+	if args.IsAuthed() {
+		_buffer.WriteString(`
+          <li class="nav-item dropdown">
+            <a class="nav-link dropdown-toggle dropdown-toggle-split" href="" data-toggle="dropdown" aria-haspopup="true" aria-expanded="false"><i class="fa fa-user" aria-hidden="true"></i> `)
+		hero.EscapeHTML(args.T("nav.user"), _buffer)
+		_buffer.WriteString(`</a>
+            <div class="dropdown-menu">
+              <a class="dropdown-item" href="/mypage"><i class="fa fa-pagelines" aria-hidden="true"></i> `)
+		hero.EscapeHTML(args.T("nav.mypage"), _buffer)
+		_buffer.WriteString(`</a>
+              <div class="dropdown-divider"></div>
+              <a class="dropdown-item" href="/mypage/settings"><i class="fa fa-cog" aria-hidden="true"></i> `)
+		hero.EscapeHTML(args.T("nav.settings"), _buffer)
+		_buffer.WriteString(`</a>
+              `)
+		if args.IsAdmin() {
+			_buffer.WriteString(`
+                <a class="dropdown-item" href="/mypage/admin"><i class="fa fa-lock" aria-hidden="true"></i> `)
+			hero.EscapeHTML(args.T("nav.admin"), _buffer)
+			_buffer.WriteString(`</a>
+              `)
+		}
+		_buffer.WriteString(`
+            </div>
+          </li>
+          <li class="nav-item">
+            <form class="form-inline" action="/mypage/logout" method="POST">
+              <input type="hidden" name="csrf_token" value="`)
+		hero.EscapeHTML(args.CSRFToken(), _buffer)
+		_buffer.WriteString(`">
+              <button type="submit" class="btn btn-link nav-link"><i class="fa fa-sign-out" aria-hidden="true"></i> `)
+		hero.EscapeHTML(args.T("nav.logout"), _buffer)
+		_buffer.WriteString(`</button>
+            </form>
+          </li>
+        `)
+	} else {
+		_buffer.WriteString(`
+          <li class="nav-item">
+            <a class="nav-link" href="/login"><i class="fa fa-sign-in" aria-hidden="true"></i> `)
+		hero.EscapeHTML(args.T("nav.login"), _buffer)
+		_buffer.WriteString(`</a>
+          </li>
+        `)
+	}
+	_buffer.WriteString(`
+      </ul>
+    </div>
+  </nav>
+  <main class="mb-auto">
+    `)
+	_buffer.WriteString(`
+<div class="admin-content">
+  <div class="container">
+    <h3>`)
+	hero.EscapeHTML(args.T("audit.title"), _buffer)
+	_buffer.WriteString(`</h3>
+    <form class="form-inline mb-3" action="/mypage/admin/audit" method="GET">
+      <input type="text" class="form-control mr-2" name="actor" value="`)
+	hero.EscapeHTML(args.Actor(), _buffer)
+	_buffer.WriteString(`" placeholder="`)
+	hero.EscapeHTML(args.T("audit.actor"), _buffer)
+	_buffer.WriteString(`">
+      <select class="form-control mr-2" name="action">
+        <option value="">`)
+	hero.EscapeHTML(args.T("audit.all_actions"), _buffer)
+	_buffer.WriteString(`</option>
+        `)
+	for _, action := range args.Actions() {
+		_buffer.WriteString(`
+          <option value="`)
+		hero.EscapeHTML(action, _buffer)
+		_buffer.WriteString(`"`)
+		if action == args.Action() {
+			_buffer.WriteString(` selected`)
+		}
+		_buffer.WriteString(`>`)
+		hero.EscapeHTML(args.T("audit.action."+action), _buffer)
+		_buffer.WriteString(`</option>
+        `)
+	}
+	_buffer.WriteString(`
+      </select>
+      <input type="text" class="form-control mr-2" name="target" value="`)
+	hero.EscapeHTML(args.Target(), _buffer)
+	_buffer.WriteString(`" placeholder="`)
+	hero.EscapeHTML(args.T("audit.target"), _buffer)
+	_buffer.WriteString(`">
+      <input type="date" class="form-control mr-2" name="since" value="`)
+	hero.EscapeHTML(args.Since(), _buffer)
+	_buffer.WriteString(`">
+      <input type="date" class="form-control mr-2" name="until" value="`)
+	hero.EscapeHTML(args.Until(), _buffer)
+	_buffer.WriteString(`">
+      <button type="submit" class="btn btn-primary mr-2">`)
+	hero.EscapeHTML(args.T("audit.filter"), _buffer)
+	_buffer.WriteString(`</button>
+      <a class="btn btn-secondary" href="/mypage/admin/audit/export?`)
+	hero.EscapeHTML(args.ExportQuery(), _buffer)
+	_buffer.WriteString(`">`)
+	hero.EscapeHTML(args.T("audit.export"), _buffer)
+	_buffer.WriteString(`</a>
+    </form>
+    <table class="table table-striped table-bordered" cellspacing="0" width="100%">
+      <thead>
+        <tr>
+          <th>`)
+	hero.EscapeHTML(args.T("audit.time"), _buffer)
+	_buffer.WriteString(`</th>
+          <th>`)
+	hero.EscapeHTML(args.T("audit.actor"), _buffer)
+	_buffer.WriteString(`</th>
+          <th>`)
+	hero.EscapeHTML(args.T("audit.action"), _buffer)
+	_buffer.WriteString(`</th>
+          <th>`)
+	hero.EscapeHTML(args.T("audit.target"), _buffer)
+	_buffer.WriteString(`</th>
+          <th>`)
+	hero.EscapeHTML(args.T("audit.detail"), _buffer)
+	_buffer.WriteString(`</th>
+          <th>`)
+	hero.EscapeHTML(args.T("settings.sessions.ip"), _buffer)
+	_buffer.WriteString(`</th>
+        </tr>
+      </thead>
+      <tbody>
+        `)
+	for _, l := range args.Logs() {
+		_buffer.WriteString(`
+          <tr>
+            <td>`)
+		hero.EscapeHTML(l.CreatedAt.Format("2006-01-02 15:04:05"), _buffer)
+		_buffer.WriteString(`</td>
+            <td>`)
+		hero.EscapeHTML(l.Actor, _buffer)
+		_buffer.WriteString(`</td>
+            <td>`)
+		hero.EscapeHTML(args.T("audit.action."+l.Action), _buffer)
+		_buffer.WriteString(`</td>
+            <td>`)
+		hero.EscapeHTML(l.Target, _buffer)
+		_buffer.WriteString(`</td>
+            <td>`)
+		hero.EscapeHTML(l.Detail, _buffer)
+		_buffer.WriteString(`</td>
+            <td>`)
+		hero.EscapeHTML(l.IPAddress, _buffer)
+		_buffer.WriteString(`</td>
+          </tr>
+        `)
+	}
+	_buffer.WriteString(`
+      </tbody>
+    </table>
+    `)
+	if args.NextQuery() != "" {
+		_buffer.WriteString(`
+      <a class="btn btn-secondary" href="/mypage/admin/audit?`)
+		hero.EscapeHTML(args.NextQuery(), _buffer)
+		_buffer.WriteString(`">`)
+		hero.EscapeHTML(args.T("audit.older"), _buffer)
+		_buffer.WriteString(`</a>
+    `)
+	}
+	_buffer.WriteString(`
+  </div>
+</div>
+`)
+
+	_buffer.WriteString(`
+  </main>
+  <footer class="footer">
+    <p>© `)
+	hero.FormatInt(int64(args.Year()), _buffer)
+	_buffer.WriteString(` <a class="text-white" href="https://twitter.com/CodeHex">CodeHex</a></p>
+  </footer>
+  `)
+	_buffer.WriteString(`
+</body>
+</html>`)
+	w.Write(_buffer.Bytes())
+
+}
@@ -0,0 +1,286 @@
+// Code generated by hero.
+// source: /Users/codehex/Desktop/go/src/github.com/Code-Hex/vegeta/template/admin_invites.html
+// DO NOT EDIT!
+package html
+
+import (
+	"io"
+
+	"github.com/shiyanhui/hero"
+)
+
+func Invites(args InvitesArgs, w io.Writer) {
+	_buffer := hero.GetBuffer()
+	defer hero.PutBuffer(_buffer)
+	_buffer.WriteString(`<!DOCTYPE html>
+<html lang="`)
+	hero.EscapeHTML(args.Lang(), _buffer)
+	_buffer.WriteString(`">
+<head>
+  <meta charset="utf-8">
+  <meta name="viewport" content="width=device-width, initial-scale=1.0">
+  <meta name="description" content="`)
+	hero.EscapeHTML(args.T("site.description"), _buffer)
+	_buffer.WriteString(`">
+  <script type="application/json" id="messages">`)
+	_buffer.WriteString(args.Messages())
+	_buffer.WriteString(`</script>
+  <link href="/assets/css/main.css" rel="stylesheet">
+  <link href="https://maxcdn.bootstrapcdn.com/font-awesome/4.7.0/css/font-awesome.min.css" rel="stylesheet" integrity="sha384-wvfXpqpZZVQGK6TAh5PVlGOfQNHSoD2xbE+QkPxCAFlNEevoEH3Sl0sibVcOQVnN" crossorigin="anonymous">
+  <link rel="stylesheet" href="/assets/css/bootstrap.css">
+  <script src="/assets/js/jquery.min.js"></script>
+  <script src="/assets/js/tether.min.js"></script>
+  <script src="/assets/js/bootstrap.min.js"></script>
+  `)
+	_buffer.WriteString(`
+  <title>`)
+	_buffer.WriteString(`invites`)
+
+	_buffer.WriteString(`</title>
+</head>
+<body class="d-flex flex-column" style="min-height: 100vh">
+  <nav class="navbar navbar-toggleable-md navbar-expand-lg navbar-light static-top v-navbar">
+    <button class="navbar-toggler navbar-toggler-right" type="button" data-toggle="collapse" data-target="#navbarResponsive" aria-controls="navbarResponsive" aria-expanded="false" aria-label="Toggle navigation">
+      <i class="fa fa-bars"></i>
+    </button>
+    <a class="navbar-brand" href="/">Vegeta</a>
+    <div id="navbarResponsive" class="collapse navbar-collapse">
+      <ul class="navbar-nav mr-auto">
+        <li class="nav-item"><a class="nav-link" href="/contact">`)
+	hero.EscapeHTML(args.T("nav.contact"), _buffer)
+	_buffer.WriteString(`</a></li>
+      </ul>
+      <ul class="navbar-nav">
+        `)
+	if args.IsAuthed() {
+		_buffer.WriteString(`
+          <li class="nav-item dropdown">
+            <a class="nav-link dropdown-toggle dropdown-toggle-split" href="" data-toggle="dropdown" aria-haspopup="true" aria-expanded="false"><i class="fa fa-user" aria-hidden="true"></i> `)
+		hero.EscapeHTML(args.T("nav.user"), _buffer)
+		_buffer.WriteString(`</a>
+            <div class="dropdown-menu">
+              <a class="dropdown-item" href="/mypage"><i class="fa fa-pagelines" aria-hidden="true"></i> `)
+		hero.EscapeHTML(args.T("nav.mypage"), _buffer)
+		_buffer.WriteString(`</a>
+              <div class="dropdown-divider"></div>
+              <a class="dropdown-item" href="/mypage/settings"><i class="fa fa-cog" aria-hidden="true"></i> `)
+		hero.EscapeHTML(args.T("nav.settings"), _buffer)
+		_buffer.WriteString(`</a>
+              `)
+		if args.IsAdmin() {
+			_buffer.WriteString(`
+                <a class="dropdown-item" href="/mypage/admin"><i class="fa fa-lock" aria-hidden="true"></i> `)
+			hero.EscapeHTML(args.T("nav.admin"), _buffer)
+			_buffer.WriteString(`</a>
+              `)
+		}
+		_buffer.WriteString(`
+            </div>
+          </li>
+          <li class="nav-item">
+            <form class="form-inline" action="/mypage/logout" method="POST">
+              <input type="hidden" name="csrf_token" value="`)
+		hero.EscapeHTML(args.CSRFToken(), _buffer)
+		_buffer.WriteString(`">
+              <button type="submit" class="btn btn-link nav-link"><i class="fa fa-sign-out" aria-hidden="true"></i> `)
+		hero.EscapeHTML(args.T("nav.logout"), _buffer)
+		_buffer.WriteString(`</button>
+            </form>
+          </li>
+        `)
+	} else {
+		_buffer.WriteString(`
+          <li class="nav-item">
+            <a class="nav-link" href="/login"><i class="fa fa-sign-in" aria-hidden="true"></i> `)
+		hero.EscapeHTML(args.T("nav.login"), _buffer)
+		_buffer.WriteString(`</a>
+          </li>
+        `)
+	}
+	_buffer.WriteString(`
+      </ul>
+    </div>
+  </nav>
+  <main class="mb-auto">
+    `)
+	_buffer.WriteString(`
+<div class="admin-content">
+  <div class="container">
+    <h3>`)
+	hero.EscapeHTML(args.T("invite.title"), _buffer)
+	_buffer.WriteString(`</h3>
+    `)
+	if args.Reason() != "" {
+		_buffer.WriteString(`
+      <div class="alert alert-danger" role="alert">`)
+		hero.EscapeHTML(args.T("invite.error."+args.Reason()), _buffer)
+		_buffer.WriteString(`</div>
+    `)
+	}
+	_buffer.WriteString(`
+    `)
+	if args.CreatedURL() != "" {
+		_buffer.WriteString(`
+      <div class="alert alert-success" role="alert">
+        `)
+		hero.EscapeHTML(args.T("invite.created"), _buffer)
+		_buffer.WriteString(`
+        <input type="text" class="form-control mt-2" value="`)
+		hero.EscapeHTML(args.CreatedURL(), _buffer)
+		_buffer.WriteString(`" readonly onclick="this.select()">
+      </div>
+    `)
+	}
+	_buffer.WriteString(`
+    <form class="form-inline mb-3" action="/mypage/admin/invites" method="POST">
+      <input type="hidden" name="csrf_token" value="`)
+	hero.EscapeHTML(args.CSRFToken(), _buffer)
+	_buffer.WriteString(`">
+      <label class="mr-2" for="invite-role">`)
+	hero.EscapeHTML(args.T("invite.role"), _buffer)
+	_buffer.WriteString(`</label>
+      <select class="form-control mr-2" id="invite-role" name="admin">
+        <option value="false">`)
+	hero.EscapeHTML(args.T("invite.role.user"), _buffer)
+	_buffer.WriteString(`</option>
+        <option value="true">`)
+	hero.EscapeHTML(args.T("invite.role.admin"), _buffer)
+	_buffer.WriteString(`</option>
+      </select>
+      <label class="mr-2" for="invite-max-uses">`)
+	hero.EscapeHTML(args.T("invite.max_uses"), _buffer)
+	_buffer.WriteString(`</label>
+      <input type="number" class="form-control mr-2" id="invite-max-uses" name="max_uses" value="1" min="1" max="1000">
+      <label class="mr-2" for="invite-expires-in">`)
+	hero.EscapeHTML(args.T("invite.expires_in"), _buffer)
+	_buffer.WriteString(`</label>
+      <select class="form-control mr-2" id="invite-expires-in" name="expires_in">
+        <option value="24">`)
+	hero.EscapeHTML(args.T("invite.expires_in.day"), _buffer)
+	_buffer.WriteString(`</option>
+        <option value="168" selected>`)
+	hero.EscapeHTML(args.T("invite.expires_in.week"), _buffer)
+	_buffer.WriteString(`</option>
+        <option value="720">`)
+	hero.EscapeHTML(args.T("invite.expires_in.month"), _buffer)
+	_buffer.WriteString(`</option>
+      </select>
+      <button type="submit" class="btn btn-primary">`)
+	hero.EscapeHTML(args.T("invite.submit"), _buffer)
+	_buffer.WriteString(`</button>
+    </form>
+    <table class="table table-striped table-bordered" cellspacing="0" width="100%">
+      <thead>
+        <tr>
+          <th>`)
+	hero.EscapeHTML(args.T("invite.created_at"), _buffer)
+	_buffer.WriteString(`</th>
+          <th>`)
+	hero.EscapeHTML(args.T("invite.creator"), _buffer)
+	_buffer.WriteString(`</th>
+          <th>`)
+	hero.EscapeHTML(args.T("invite.role"), _buffer)
+	_buffer.WriteString(`</th>
+          <th>`)
+	hero.EscapeHTML(args.T("invite.uses"), _buffer)
+	_buffer.WriteString(`</th>
+          <th>`)
+	hero.EscapeHTML(args.T("invite.expires_at"), _buffer)
+	_buffer.WriteString(`</th>
+          <th>`)
+	hero.EscapeHTML(args.T("invite.status"), _buffer)
+	_buffer.WriteString(`</th>
+          <th>`)
+	hero.EscapeHTML(args.T("invite.history"), _buffer)
+	_buffer.WriteString(`</th>
+          <th>`)
+	hero.EscapeHTML(args.T("admin.action"), _buffer)
+	_buffer.WriteString(`</th>
+        </tr>
+      </thead>
+      <tbody>
+        `)
+	for _, invite := range args.Invites() {
+		_buffer.WriteString(`
+          <tr>
+            <td>`)
+		hero.EscapeHTML(invite.CreatedAt.Format("2006-01-02 15:04"), _buffer)
+		_buffer.WriteString(`</td>
+            <td>`)
+		hero.EscapeHTML(invite.Creator, _buffer)
+		_buffer.WriteString(`</td>
+            <td>`)
+		if invite.Admin {
+			hero.EscapeHTML(args.T("invite.role.admin"), _buffer)
+		} else {
+			hero.EscapeHTML(args.T("invite.role.user"), _buffer)
+		}
+		_buffer.WriteString(`</td>
+            <td>`)
+		hero.FormatInt(int64(invite.Uses), _buffer)
+		_buffer.WriteString(` / `)
+		hero.FormatInt(int64(invite.MaxUses), _buffer)
+		_buffer.WriteString(`</td>
+            <td>`)
+		hero.EscapeHTML(invite.ExpiresAt.Format("2006-01-02 15:04"), _buffer)
+		_buffer.WriteString(`</td>
+            <td>`)
+		hero.EscapeHTML(args.T("invite.status."+args.Status(invite)), _buffer)
+		_buffer.WriteString(`</td>
+            <td>
+              `)
+		for _, use := range invite.History {
+			_buffer.WriteString(`
+                <div>`)
+			hero.EscapeHTML(use.Name, _buffer)
+			_buffer.WriteString(` (`)
+			hero.EscapeHTML(use.CreatedAt.Format("2006-01-02 15:04"), _buffer)
+			_buffer.WriteString(`, `)
+			hero.EscapeHTML(use.IPAddress, _buffer)
+			_buffer.WriteString(`)</div>
+              `)
+		}
+		_buffer.WriteString(`
+            </td>
+            <td>
+              `)
+		if args.Status(invite) == "active" {
+			_buffer.WriteString(`
+                <form action="/mypage/admin/invites/`)
+			hero.FormatUint(uint64(invite.ID), _buffer)
+			_buffer.WriteString(`/revoke" method="POST">
+                  <input type="hidden" name="csrf_token" value="`)
+			hero.EscapeHTML(args.CSRFToken(), _buffer)
+			_buffer.WriteString(`">
+                  <button type="submit" class="btn btn-sm btn-danger">`)
+			hero.EscapeHTML(args.T("invite.revoke"), _buffer)
+			_buffer.WriteString(`</button>
+                </form>
+              `)
+		}
+		_buffer.WriteString(`
+            </td>
+          </tr>
+        `)
+	}
+	_buffer.WriteString(`
+      </tbody>
+    </table>
+  </div>
+</div>
+`)
+
+	_buffer.WriteString(`
+  </main>
+  <footer class="footer">
+    <p>© `)
+	hero.FormatInt(int64(args.Year()), _buffer)
+	_buffer.WriteString(` <a class="text-white" href="https://twitter.com/CodeHex">CodeHex</a></p>
+  </footer>
+  `)
+	_buffer.WriteString(`
+</body>
+</html>`)
+	w.Write(_buffer.Bytes())
+
+}
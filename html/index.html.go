@@ -13,11 +13,18 @@ func Index(args Args, w io.Writer) {
 	_buffer := hero.GetBuffer()
 	defer hero.PutBuffer(_buffer)
 	_buffer.WriteString(`<!DOCTYPE html>
-<html lang="ja">
+<html lang="`)
+	hero.EscapeHTML(args.Lang(), _buffer)
+	_buffer.WriteString(`">
 <head>
   <meta charset="utf-8">
   <meta name="viewport" content="width=device-width, initial-scale=1.0">
-  <meta name="description" content="IoTを用いた栽培中の植物のデータを管理するプロジェクトです。">
+  <meta name="description" content="`)
+	hero.EscapeHTML(args.T("site.description"), _buffer)
+	_buffer.WriteString(`">
+  <script type="application/json" id="messages">`)
+	_buffer.WriteString(args.Messages())
+	_buffer.WriteString(`</script>
   <link href="/assets/css/main.css" rel="stylesheet">
   <link href="https://maxcdn.bootstrapcdn.com/font-awesome/4.7.0/css/font-awesome.min.css" rel="stylesheet" integrity="sha384-wvfXpqpZZVQGK6TAh5PVlGOfQNHSoD2xbE+QkPxCAFlNEevoEH3Sl0sibVcOQVnN" crossorigin="anonymous">
   <link rel="stylesheet" href="/assets/css/bootstrap.css">
@@ -37,35 +44,54 @@ func Index(args Args, w io.Writer) {
     <a class="navbar-brand" href="/">Vegeta</a>
     <div id="navbarResponsive" class="collapse navbar-collapse">
       <ul class="navbar-nav mr-auto">
-        <li class="nav-item"><a class="nav-link" href="/contact">問い合わせ</a></li>
+        <li class="nav-item"><a class="nav-link" href="/contact">`)
+	hero.EscapeHTML(args.T("nav.contact"), _buffer)
+	_buffer.WriteString(`</a></li>
       </ul>
       <ul class="navbar-nav">
         `)
 	if args.IsAuthed() {
 		_buffer.WriteString(`
           <li class="nav-item dropdown">
-            <a class="nav-link dropdown-toggle dropdown-toggle-split" href="" data-toggle="dropdown" aria-haspopup="true" aria-expanded="false"><i class="fa fa-user" aria-hidden="true"></i> ユーザー</a>
+            <a class="nav-link dropdown-toggle dropdown-toggle-split" href="" data-toggle="dropdown" aria-haspopup="true" aria-expanded="false"><i class="fa fa-user" aria-hidden="true"></i> `)
+		hero.EscapeHTML(args.T("nav.user"), _buffer)
+		_buffer.WriteString(`</a>
             <div class="dropdown-menu">
-              <a class="dropdown-item" href="/mypage"><i class="fa fa-pagelines" aria-hidden="true"></i> 観察</a>
+              <a class="dropdown-item" href="/mypage"><i class="fa fa-pagelines" aria-hidden="true"></i> `)
+		hero.EscapeHTML(args.T("nav.mypage"), _buffer)
+		_buffer.WriteString(`</a>
               <div class="dropdown-divider"></div>
-              <a class="dropdown-item" href="/mypage/settings"><i class="fa fa-cog" aria-hidden="true"></i> 設定</a>
+              <a class="dropdown-item" href="/mypage/settings"><i class="fa fa-cog" aria-hidden="true"></i> `)
+		hero.EscapeHTML(args.T("nav.settings"), _buffer)
+		_buffer.WriteString(`</a>
               `)
 		if args.IsAdmin() {
 			_buffer.WriteString(`
-                <a class="dropdown-item" href="/mypage/admin"><i class="fa fa-lock" aria-hidden="true"></i> ユーザー管理パネル</a>
+                <a class="dropdown-item" href="/mypage/admin"><i class="fa fa-lock" aria-hidden="true"></i> `)
+			hero.EscapeHTML(args.T("nav.admin"), _buffer)
+			_buffer.WriteString(`</a>
               `)
 		}
 		_buffer.WriteString(`
             </div>
           </li>
           <li class="nav-item">
-            <a class="nav-link" href="/mypage/logout"><i class="fa fa-sign-out" aria-hidden="true"></i> ログアウト</a>
+            <form class="form-inline" action="/mypage/logout" method="POST">
+              <input type="hidden" name="csrf_token" value="`)
+		hero.EscapeHTML(args.CSRFToken(), _buffer)
+		_buffer.WriteString(`">
+              <button type="submit" class="btn btn-link nav-link"><i class="fa fa-sign-out" aria-hidden="true"></i> `)
+		hero.EscapeHTML(args.T("nav.logout"), _buffer)
+		_buffer.WriteString(`</button>
+            </form>
           </li>
         `)
 	} else {
 		_buffer.WriteString(`
           <li class="nav-item">
-            <a class="nav-link" href="/login"><i class="fa fa-sign-in" aria-hidden="true"></i> ログイン</a>
+            <a class="nav-link" href="/login"><i class="fa fa-sign-in" aria-hidden="true"></i> `)
+		hero.EscapeHTML(args.T("nav.login"), _buffer)
+		_buffer.WriteString(`</a>
           </li>
         `)
 	}
@@ -79,7 +105,9 @@ func Index(args Args, w io.Writer) {
 <header class="page-heading">
   <div class="container">
     <h1>Vegeta</h1>
-    <p>IoTを用いた栽培中の植物のデータを管理するプロジェクトです。</p>
+    <p>`)
+	hero.EscapeHTML(args.T("site.description"), _buffer)
+	_buffer.WriteString(`</p>
   </div>
 </header>
 <div class="content">
@@ -90,8 +118,12 @@ func Index(args Args, w io.Writer) {
           <span>1</span>
         </div>
         <div class="bullet-content">
-          <h2>ユーザーの登録</h2>
-          <p>まずはユーザー登録を行う必要があります。</p>
+          <h2>`)
+	hero.EscapeHTML(args.T("index.step1.title"), _buffer)
+	_buffer.WriteString(`</h2>
+          <p>`)
+	hero.EscapeHTML(args.T("index.step1.body"), _buffer)
+	_buffer.WriteString(`</p>
           </div>
       </li>  
       <li class="bullet">
@@ -99,8 +131,12 @@ func Index(args Args, w io.Writer) {
           <span>2</span>
         </div>
         <div class="bullet-content">
-          <h2>インストール</h2>
-          <p>IoTデバイスにサーバーへ情報を送るためのツールをインストールします。</p>
+          <h2>`)
+	hero.EscapeHTML(args.T("index.step2.title"), _buffer)
+	_buffer.WriteString(`</h2>
+          <p>`)
+	hero.EscapeHTML(args.T("index.step2.body"), _buffer)
+	_buffer.WriteString(`</p>
         </div>
       </li>
       <li class="bullet">
@@ -108,8 +144,12 @@ func Index(args Args, w io.Writer) {
           <span>3</span>
         </div>
         <div class="bullet-content">
-          <h2>情報を集める</h2>
-          <p>デバイスのセンサーから読み取った情報を、インストールしたツールへ渡してあげるだけで簡単にサーバへ送ってくれます。</p>
+          <h2>`)
+	hero.EscapeHTML(args.T("index.step3.title"), _buffer)
+	_buffer.WriteString(`</h2>
+          <p>`)
+	hero.EscapeHTML(args.T("index.step3.body"), _buffer)
+	_buffer.WriteString(`</p>
         </div>
       </li> 
     </ul>
@@ -9,25 +9,89 @@ type (
 		IsAuthed() bool
 		IsAdmin() bool
 		Year() int
+		Lang() string
+		T(key string) string
+		// Messages returns messages for scripts as JSON.
+		Messages() string
+		// CSRFToken must be sent by forms and scripts which change state.
+		CSRFToken() string
 	}
 
 	AdminArgs interface {
 		Args
 		Token() string
+		// TokenExpiresAt is when Token expires in Unix time.
+		TokenExpiresAt() int64
 		Users() model.Users
 		IsCreated() bool
 		Reason() string
+		IsLocked(user *model.User) bool
+	}
+
+	AuditArgs interface {
+		Args
+		Logs() []*model.AuditLog
+		// Actions are the choices of the action filter.
+		Actions() []string
+		Actor() string
+		Action() string
+		Target() string
+		Since() string
+		Until() string
+		ExportQuery() string
+		// NextQuery is the query of the next page, or empty on the last page.
+		NextQuery() string
+	}
+
+	InvitesArgs interface {
+		Args
+		Invites() []*model.Invite
+		// CreatedURL is the link of the invite which is just created.
+		CreatedURL() string
+		Reason() string
+		// Status is "active", "revoked", "used_up" or "expired".
+		Status(invite *model.Invite) string
+	}
+
+	LoginArgs interface {
+		Args
+		// Reason is why the last login failed, or empty.
+		Reason() string
+		// OIDCButton is the label of the button to log in by
+		// OpenID Connect, or empty if it is disabled.
+		OIDCButton() string
+	}
+
+	RegisterArgs interface {
+		Args
+		InviteToken() string
+		Name() string
+		// Reason is the translated message of why the registration failed.
+		Reason() string
+		// IsValid reports whether the invite can be used.
+		IsValid() bool
 	}
 
 	MyPageArgs interface {
 		Args
 		User() *model.User
 		Token() string
+		// TokenExpiresAt is when Token expires in Unix time.
+		TokenExpiresAt() int64
 	}
 
 	SettingsArgs interface {
 		Args
 		User() *model.User
 		Token() string
+		// TokenExpiresAt is when Token expires in Unix time.
+		TokenExpiresAt() int64
+		Languages() []string
+		Sessions() []*model.Session
+		IsCurrent(sessionID uint) bool
+		LoginAttempts() []*model.LoginAttempt
+		// TOTPRequired reports whether the user must enable TOTP to use admin pages.
+		TOTPRequired() bool
+		RecoveryCodesLeft() int
 	}
 )
@@ -9,15 +9,22 @@ import (
 	"github.com/shiyanhui/hero"
 )
 
-func Login(args Args, w io.Writer) {
+func Login(args LoginArgs, w io.Writer) {
 	_buffer := hero.GetBuffer()
 	defer hero.PutBuffer(_buffer)
 	_buffer.WriteString(`<!DOCTYPE html>
-<html lang="ja">
+<html lang="`)
+	hero.EscapeHTML(args.Lang(), _buffer)
+	_buffer.WriteString(`">
 <head>
   <meta charset="utf-8">
   <meta name="viewport" content="width=device-width, initial-scale=1.0">
-  <meta name="description" content="IoTを用いた栽培中の植物のデータを管理するプロジェクトです。">
+  <meta name="description" content="`)
+	hero.EscapeHTML(args.T("site.description"), _buffer)
+	_buffer.WriteString(`">
+  <script type="application/json" id="messages">`)
+	_buffer.WriteString(args.Messages())
+	_buffer.WriteString(`</script>
   <link href="/assets/css/main.css" rel="stylesheet">
   <link href="https://maxcdn.bootstrapcdn.com/font-awesome/4.7.0/css/font-awesome.min.css" rel="stylesheet" integrity="sha384-wvfXpqpZZVQGK6TAh5PVlGOfQNHSoD2xbE+QkPxCAFlNEevoEH3Sl0sibVcOQVnN" crossorigin="anonymous">
   <link rel="stylesheet" href="/assets/css/bootstrap.css">
@@ -37,35 +44,54 @@ func Login(args Args, w io.Writer) {
     <a class="navbar-brand" href="/">Vegeta</a>
     <div id="navbarResponsive" class="collapse navbar-collapse">
       <ul class="navbar-nav mr-auto">
-        <li class="nav-item"><a class="nav-link" href="/contact">問い合わせ</a></li>
+        <li class="nav-item"><a class="nav-link" href="/contact">`)
+	hero.EscapeHTML(args.T("nav.contact"), _buffer)
+	_buffer.WriteString(`</a></li>
       </ul>
       <ul class="navbar-nav">
         `)
 	if args.IsAuthed() {
 		_buffer.WriteString(`
           <li class="nav-item dropdown">
-            <a class="nav-link dropdown-toggle dropdown-toggle-split" href="" data-toggle="dropdown" aria-haspopup="true" aria-expanded="false"><i class="fa fa-user" aria-hidden="true"></i> ユーザー</a>
+            <a class="nav-link dropdown-toggle dropdown-toggle-split" href="" data-toggle="dropdown" aria-haspopup="true" aria-expanded="false"><i class="fa fa-user" aria-hidden="true"></i> `)
+		hero.EscapeHTML(args.T("nav.user"), _buffer)
+		_buffer.WriteString(`</a>
             <div class="dropdown-menu">
-              <a class="dropdown-item" href="/mypage"><i class="fa fa-pagelines" aria-hidden="true"></i> 観察</a>
+              <a class="dropdown-item" href="/mypage"><i class="fa fa-pagelines" aria-hidden="true"></i> `)
+		hero.EscapeHTML(args.T("nav.mypage"), _buffer)
+		_buffer.WriteString(`</a>
               <div class="dropdown-divider"></div>
-              <a class="dropdown-item" href="/mypage/settings"><i class="fa fa-cog" aria-hidden="true"></i> 設定</a>
+              <a class="dropdown-item" href="/mypage/settings"><i class="fa fa-cog" aria-hidden="true"></i> `)
+		hero.EscapeHTML(args.T("nav.settings"), _buffer)
+		_buffer.WriteString(`</a>
               `)
 		if args.IsAdmin() {
 			_buffer.WriteString(`
-                <a class="dropdown-item" href="/mypage/admin"><i class="fa fa-lock" aria-hidden="true"></i> ユーザー管理パネル</a>
+                <a class="dropdown-item" href="/mypage/admin"><i class="fa fa-lock" aria-hidden="true"></i> `)
+			hero.EscapeHTML(args.T("nav.admin"), _buffer)
+			_buffer.WriteString(`</a>
               `)
 		}
 		_buffer.WriteString(`
             </div>
           </li>
           <li class="nav-item">
-            <a class="nav-link" href="/mypage/logout"><i class="fa fa-sign-out" aria-hidden="true"></i> ログアウト</a>
+            <form class="form-inline" action="/mypage/logout" method="POST">
+              <input type="hidden" name="csrf_token" value="`)
+		hero.EscapeHTML(args.CSRFToken(), _buffer)
+		_buffer.WriteString(`">
+              <button type="submit" class="btn btn-link nav-link"><i class="fa fa-sign-out" aria-hidden="true"></i> `)
+		hero.EscapeHTML(args.T("nav.logout"), _buffer)
+		_buffer.WriteString(`</button>
+            </form>
           </li>
         `)
 	} else {
 		_buffer.WriteString(`
           <li class="nav-item">
-            <a class="nav-link" href="/login"><i class="fa fa-sign-in" aria-hidden="true"></i> ログイン</a>
+            <a class="nav-link" href="/login"><i class="fa fa-sign-in" aria-hidden="true"></i> `)
+		hero.EscapeHTML(args.T("nav.login"), _buffer)
+		_buffer.WriteString(`</a>
           </li>
         `)
 	}
@@ -80,10 +106,39 @@ func Login(args Args, w io.Writer) {
   <div class="container-fluid">
     <div class="wrapper">
       <form class="form-signin" action="/auth" method="POST">       
-        <h2 class="form-signin-heading">ログイン</h2>
-        <input type="text" class="form-control" name="username" placeholder="ユーザー名" required="true" autofocus="" />
-        <input type="password" class="form-control" name="password" placeholder="パスワード" required="true"/>      
-        <button class="btn btn-lg btn-primary btn-block" type="submit">ログインする</button>   
+        <input type="hidden" name="csrf_token" value="`)
+	hero.EscapeHTML(args.CSRFToken(), _buffer)
+	_buffer.WriteString(`">
+        <h2 class="form-signin-heading">`)
+	hero.EscapeHTML(args.T("nav.login"), _buffer)
+	_buffer.WriteString(`</h2>
+        `)
+	if args.Reason() != "" {
+		_buffer.WriteString(`
+          <div class="alert alert-danger" role="alert">`)
+		hero.EscapeHTML(args.T("login.error."+args.Reason()), _buffer)
+		_buffer.WriteString(`</div>
+        `)
+	}
+	_buffer.WriteString(`
+        <input type="text" class="form-control" name="username" placeholder="`)
+	hero.EscapeHTML(args.T("login.username"), _buffer)
+	_buffer.WriteString(`" required="true" autofocus="" />
+        <input type="password" class="form-control" name="password" placeholder="`)
+	hero.EscapeHTML(args.T("login.password"), _buffer)
+	_buffer.WriteString(`" required="true"/>      
+        <button class="btn btn-lg btn-primary btn-block" type="submit">`)
+	hero.EscapeHTML(args.T("login.submit"), _buffer)
+	_buffer.WriteString(`</button>   
+        `)
+	if args.OIDCButton() != "" {
+		_buffer.WriteString(`
+          <a class="btn btn-lg btn-secondary btn-block" href="/auth/oidc">`)
+		hero.EscapeHTML(args.OIDCButton(), _buffer)
+		_buffer.WriteString(`</a>
+        `)
+	}
+	_buffer.WriteString(`
       </form>
     </div>
   </div>
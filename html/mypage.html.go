@@ -13,11 +13,18 @@ func MyPage(args MyPageArgs, w io.Writer) {
 	_buffer := hero.GetBuffer()
 	defer hero.PutBuffer(_buffer)
 	_buffer.WriteString(`<!DOCTYPE html>
-<html lang="ja">
+<html lang="`)
+	hero.EscapeHTML(args.Lang(), _buffer)
+	_buffer.WriteString(`">
 <head>
   <meta charset="utf-8">
   <meta name="viewport" content="width=device-width, initial-scale=1.0">
-  <meta name="description" content="IoTを用いた栽培中の植物のデータを管理するプロジェクトです。">
+  <meta name="description" content="`)
+	hero.EscapeHTML(args.T("site.description"), _buffer)
+	_buffer.WriteString(`">
+  <script type="application/json" id="messages">`)
+	_buffer.WriteString(args.Messages())
+	_buffer.WriteString(`</script>
   <link href="/assets/css/main.css" rel="stylesheet">
   <link href="https://maxcdn.bootstrapcdn.com/font-awesome/4.7.0/css/font-awesome.min.css" rel="stylesheet" integrity="sha384-wvfXpqpZZVQGK6TAh5PVlGOfQNHSoD2xbE+QkPxCAFlNEevoEH3Sl0sibVcOQVnN" crossorigin="anonymous">
   <link rel="stylesheet" href="/assets/css/bootstrap.css">
@@ -44,35 +51,54 @@ func MyPage(args MyPageArgs, w io.Writer) {
     <a class="navbar-brand" href="/">Vegeta</a>
     <div id="navbarResponsive" class="collapse navbar-collapse">
       <ul class="navbar-nav mr-auto">
-        <li class="nav-item"><a class="nav-link" href="/contact">問い合わせ</a></li>
+        <li class="nav-item"><a class="nav-link" href="/contact">`)
+	hero.EscapeHTML(args.T("nav.contact"), _buffer)
+	_buffer.WriteString(`</a></li>
       </ul>
       <ul class="navbar-nav">
         `)
 	if args.IsAuthed() {
 		_buffer.WriteString(`
           <li class="nav-item dropdown">
-            <a class="nav-link dropdown-toggle dropdown-toggle-split" href="" data-toggle="dropdown" aria-haspopup="true" aria-expanded="false"><i class="fa fa-user" aria-hidden="true"></i> ユーザー</a>
+            <a class="nav-link dropdown-toggle dropdown-toggle-split" href="" data-toggle="dropdown" aria-haspopup="true" aria-expanded="false"><i class="fa fa-user" aria-hidden="true"></i> `)
+		hero.EscapeHTML(args.T("nav.user"), _buffer)
+		_buffer.WriteString(`</a>
             <div class="dropdown-menu">
-              <a class="dropdown-item" href="/mypage"><i class="fa fa-pagelines" aria-hidden="true"></i> 観察</a>
+              <a class="dropdown-item" href="/mypage"><i class="fa fa-pagelines" aria-hidden="true"></i> `)
+		hero.EscapeHTML(args.T("nav.mypage"), _buffer)
+		_buffer.WriteString(`</a>
               <div class="dropdown-divider"></div>
-              <a class="dropdown-item" href="/mypage/settings"><i class="fa fa-cog" aria-hidden="true"></i> 設定</a>
+              <a class="dropdown-item" href="/mypage/settings"><i class="fa fa-cog" aria-hidden="true"></i> `)
+		hero.EscapeHTML(args.T("nav.settings"), _buffer)
+		_buffer.WriteString(`</a>
               `)
 		if args.IsAdmin() {
 			_buffer.WriteString(`
-                <a class="dropdown-item" href="/mypage/admin"><i class="fa fa-lock" aria-hidden="true"></i> ユーザー管理パネル</a>
+                <a class="dropdown-item" href="/mypage/admin"><i class="fa fa-lock" aria-hidden="true"></i> `)
+			hero.EscapeHTML(args.T("nav.admin"), _buffer)
+			_buffer.WriteString(`</a>
               `)
 		}
 		_buffer.WriteString(`
             </div>
           </li>
           <li class="nav-item">
-            <a class="nav-link" href="/mypage/logout"><i class="fa fa-sign-out" aria-hidden="true"></i> ログアウト</a>
+            <form class="form-inline" action="/mypage/logout" method="POST">
+              <input type="hidden" name="csrf_token" value="`)
+		hero.EscapeHTML(args.CSRFToken(), _buffer)
+		_buffer.WriteString(`">
+              <button type="submit" class="btn btn-link nav-link"><i class="fa fa-sign-out" aria-hidden="true"></i> `)
+		hero.EscapeHTML(args.T("nav.logout"), _buffer)
+		_buffer.WriteString(`</button>
+            </form>
           </li>
         `)
 	} else {
 		_buffer.WriteString(`
           <li class="nav-item">
-            <a class="nav-link" href="/login"><i class="fa fa-sign-in" aria-hidden="true"></i> ログイン</a>
+            <a class="nav-link" href="/login"><i class="fa fa-sign-in" aria-hidden="true"></i> `)
+		hero.EscapeHTML(args.T("nav.login"), _buffer)
+		_buffer.WriteString(`</a>
           </li>
         `)
 	}
@@ -89,6 +115,11 @@ func MyPage(args MyPageArgs, w io.Writer) {
 	_buffer.WriteString(`
 <input type="hidden" id="api-token" value="`)
 	hero.EscapeHTML(mypageArgs.Token(), _buffer)
+	_buffer.WriteString(`" data-expires-at="`)
+	hero.FormatInt(int64(mypageArgs.TokenExpiresAt()), _buffer)
+	_buffer.WriteString(`">
+<input type="hidden" id="csrf-token" value="`)
+	hero.EscapeHTML(args.CSRFToken(), _buffer)
 	_buffer.WriteString(`">
 <div class="content">
   <div class="container">
@@ -98,7 +129,9 @@ func MyPage(args MyPageArgs, w io.Writer) {
       <div class="row float-right">
         <div class="col">
           <select id="action" class="form-control tag-select">
-            <option value="">タグ一覧</option>
+            <option value="">`)
+		hero.EscapeHTML(args.T("mypage.tags"), _buffer)
+		_buffer.WriteString(`</option>
             `)
 		for _, tag := range user.Tags {
 			_buffer.WriteString(`
@@ -113,12 +146,18 @@ func MyPage(args MyPageArgs, w io.Writer) {
           </select>
         </div>
         <div class="col">
-            <button type="button" id="reregister-password" data-toggle="modal" data-target="#addModal" class="btn btn-primary">タグを追加する</button>
+            <button type="button" id="reregister-password" data-toggle="modal" data-target="#addModal" class="btn btn-primary">`)
+		hero.EscapeHTML(args.T("mypage.add_tag"), _buffer)
+		_buffer.WriteString(`</button>
         </div>
       </div>
-      <div class="h2" id="tagname">観察ページ</div>
+      <div class="h2" id="tagname">`)
+		hero.EscapeHTML(args.T("mypage.title"), _buffer)
+		_buffer.WriteString(`</div>
       <hr>
-      <div class="h3 sub">直近 1 週間の様子</div>
+      <div class="h3 sub">`)
+		hero.EscapeHTML(args.T("mypage.week"), _buffer)
+		_buffer.WriteString(`</div>
       <div class="row">
         <div class="col-xs-12 col-md-8"><div id="week-chart"></div></div>
         <div class="col-xs-12 col-md-4 json" id="week-json"></div>
@@ -137,7 +176,9 @@ func MyPage(args MyPageArgs, w io.Writer) {
         <span id="input-week-value"></span>
       </div>
       <hr>
-      <div class="h3 sub">直近 1 ヶ月の様子</div>
+      <div class="h3 sub">`)
+		hero.EscapeHTML(args.T("mypage.month"), _buffer)
+		_buffer.WriteString(`</div>
       <div class="row">
         <div class="col-xs-12 col-md-8"><div id="month-chart"></div></div>
         <div class="col-xs-12 col-md-4 json" id="month-json"></div>
@@ -158,10 +199,16 @@ func MyPage(args MyPageArgs, w io.Writer) {
       <hr>
       <div class="sub"></div>
       <div class="row">
-        <div class="col-4 h3">全期間の様子</div>
+        <div class="col-4 h3">`)
+		hero.EscapeHTML(args.T("mypage.all"), _buffer)
+		_buffer.WriteString(`</div>
         <div class="col-8" style="text-align: right;">
-          <bold>表示する期間を指定 </bold>
-          <input class="calendar" type="text" placeholder="ここをクリックして期間を指定する" readonly="readonly"/>
+          <bold>`)
+		hero.EscapeHTML(args.T("mypage.span"), _buffer)
+		_buffer.WriteString(` </bold>
+          <input class="calendar" type="text" placeholder="`)
+		hero.EscapeHTML(args.T("mypage.span_placeholder"), _buffer)
+		_buffer.WriteString(`" readonly="readonly"/>
         </div>
       </div>
       <div class="row">
@@ -191,20 +238,30 @@ func MyPage(args MyPageArgs, w io.Writer) {
   <div class="modal-dialog" role="document">
     <div class="modal-content">
       <div class="modal-header">
-        <h5 class="modal-title" id="addModalLabel">新規タグの追加</h5>
+        <h5 class="modal-title" id="addModalLabel">`)
+	hero.EscapeHTML(args.T("mypage.new_tag"), _buffer)
+	_buffer.WriteString(`</h5>
         <button type="button" class="close" data-dismiss="modal" aria-label="Close">
           <span aria-hidden="true">&times;</span>
         </button>
       </div>
       <div class="modal-body">
         <div class="form-group">
-          <label for="username" class="form-control-label">タグの名前:</label>
-          <input type="text" class="form-control" id="tag_name" placeholder="タグの名前">
+          <label for="username" class="form-control-label">`)
+	hero.EscapeHTML(args.T("mypage.tag_name_label"), _buffer)
+	_buffer.WriteString(`</label>
+          <input type="text" class="form-control" id="tag_name" placeholder="`)
+	hero.EscapeHTML(args.T("mypage.tag_name"), _buffer)
+	_buffer.WriteString(`">
         </div>
       </div>
       <div class="modal-footer">
-        <button type="button" class="btn btn-secondary" data-dismiss="modal">閉じる</button>
-        <button type="button" id="add-tag" class="btn btn-primary">追加する</button>
+        <button type="button" class="btn btn-secondary" data-dismiss="modal">`)
+	hero.EscapeHTML(args.T("common.close"), _buffer)
+	_buffer.WriteString(`</button>
+        <button type="button" id="add-tag" class="btn btn-primary">`)
+	hero.EscapeHTML(args.T("mypage.add"), _buffer)
+	_buffer.WriteString(`</button>
       </div>
     </div>
   </div>
@@ -0,0 +1,174 @@
+// Code generated by hero.
+// source: /Users/codehex/Desktop/go/src/github.com/Code-Hex/vegeta/template/register.html
+// DO NOT EDIT!
+package html
+
+import (
+	"io"
+
+	"github.com/shiyanhui/hero"
+)
+
+func Register(args RegisterArgs, w io.Writer) {
+	_buffer := hero.GetBuffer()
+	defer hero.PutBuffer(_buffer)
+	_buffer.WriteString(`<!DOCTYPE html>
+<html lang="`)
+	hero.EscapeHTML(args.Lang(), _buffer)
+	_buffer.WriteString(`">
+<head>
+  <meta charset="utf-8">
+  <meta name="viewport" content="width=device-width, initial-scale=1.0">
+  <meta name="description" content="`)
+	hero.EscapeHTML(args.T("site.description"), _buffer)
+	_buffer.WriteString(`">
+  <script type="application/json" id="messages">`)
+	_buffer.WriteString(args.Messages())
+	_buffer.WriteString(`</script>
+  <link href="/assets/css/main.css" rel="stylesheet">
+  <link href="https://maxcdn.bootstrapcdn.com/font-awesome/4.7.0/css/font-awesome.min.css" rel="stylesheet" integrity="sha384-wvfXpqpZZVQGK6TAh5PVlGOfQNHSoD2xbE+QkPxCAFlNEevoEH3Sl0sibVcOQVnN" crossorigin="anonymous">
+  <link rel="stylesheet" href="/assets/css/bootstrap.css">
+  <script src="/assets/js/jquery.min.js"></script>
+  <script src="/assets/js/tether.min.js"></script>
+  <script src="/assets/js/bootstrap.min.js"></script>
+  `)
+	_buffer.WriteString(`
+  <title>`)
+	_buffer.WriteString(`register`)
+
+	_buffer.WriteString(`</title>
+</head>
+<body class="d-flex flex-column" style="min-height: 100vh">
+  <nav class="navbar navbar-toggleable-md navbar-expand-lg navbar-light static-top v-navbar">
+    <button class="navbar-toggler navbar-toggler-right" type="button" data-toggle="collapse" data-target="#navbarResponsive" aria-controls="navbarResponsive" aria-expanded="false" aria-label="Toggle navigation">
+      <i class="fa fa-bars"></i>
+    </button>
+    <a class="navbar-brand" href="/">Vegeta</a>
+    <div id="navbarResponsive" class="collapse navbar-collapse">
+      <ul class="navbar-nav mr-auto">
+        <li class="nav-item"><a class="nav-link" href="/contact">`)
+	hero.EscapeHTML(args.T("nav.contact"), _buffer)
+	_buffer.WriteString(`</a></li>
+      </ul>
+      <ul class="navbar-nav">
+        `)
+	if args.IsAuthed() {
+		_buffer.WriteString(`
+          <li class="nav-item dropdown">
+            <a class="nav-link dropdown-toggle dropdown-toggle-split" href="" data-toggle="dropdown" aria-haspopup="true" aria-expanded="false"><i class="fa fa-user" aria-hidden="true"></i> `)
+		hero.EscapeHTML(args.T("nav.user"), _buffer)
+		_buffer.WriteString(`</a>
+            <div class="dropdown-menu">
+              <a class="dropdown-item" href="/mypage"><i class="fa fa-pagelines" aria-hidden="true"></i> `)
+		hero.EscapeHTML(args.T("nav.mypage"), _buffer)
+		_buffer.WriteString(`</a>
+              <div class="dropdown-divider"></div>
+              <a class="dropdown-item" href="/mypage/settings"><i class="fa fa-cog" aria-hidden="true"></i> `)
+		hero.EscapeHTML(args.T("nav.settings"), _buffer)
+		_buffer.WriteString(`</a>
+              `)
+		if args.IsAdmin() {
+			_buffer.WriteString(`
+                <a class="dropdown-item" href="/mypage/admin"><i class="fa fa-lock" aria-hidden="true"></i> `)
+			hero.EscapeHTML(args.T("nav.admin"), _buffer)
+			_buffer.WriteString(`</a>
+              `)
+		}
+		_buffer.WriteString(`
+            </div>
+          </li>
+          <li class="nav-item">
+            <form class="form-inline" action="/mypage/logout" method="POST">
+              <input type="hidden" name="csrf_token" value="`)
+		hero.EscapeHTML(args.CSRFToken(), _buffer)
+		_buffer.WriteString(`">
+              <button type="submit" class="btn btn-link nav-link"><i class="fa fa-sign-out" aria-hidden="true"></i> `)
+		hero.EscapeHTML(args.T("nav.logout"), _buffer)
+		_buffer.WriteString(`</button>
+            </form>
+          </li>
+        `)
+	} else {
+		_buffer.WriteString(`
+          <li class="nav-item">
+            <a class="nav-link" href="/login"><i class="fa fa-sign-in" aria-hidden="true"></i> `)
+		hero.EscapeHTML(args.T("nav.login"), _buffer)
+		_buffer.WriteString(`</a>
+          </li>
+        `)
+	}
+	_buffer.WriteString(`
+      </ul>
+    </div>
+  </nav>
+  <main class="mb-auto">
+    `)
+	_buffer.WriteString(`
+<div class="content">
+  <div class="container-fluid">
+    <div class="wrapper">
+      <form class="form-signin" action="/register" method="POST">
+        <input type="hidden" name="csrf_token" value="`)
+	hero.EscapeHTML(args.CSRFToken(), _buffer)
+	_buffer.WriteString(`">
+        <input type="hidden" name="token" value="`)
+	hero.EscapeHTML(args.InviteToken(), _buffer)
+	_buffer.WriteString(`">
+        <h2 class="form-signin-heading">`)
+	hero.EscapeHTML(args.T("register.title"), _buffer)
+	_buffer.WriteString(`</h2>
+        `)
+	if args.Reason() != "" {
+		_buffer.WriteString(`
+          <div class="alert alert-danger" role="alert">`)
+		hero.EscapeHTML(args.Reason(), _buffer)
+		_buffer.WriteString(`</div>
+        `)
+	}
+	_buffer.WriteString(`
+        `)
+	if args.IsValid() {
+		_buffer.WriteString(`
+          <input type="text" class="form-control" name="username" value="`)
+		hero.EscapeHTML(args.Name(), _buffer)
+		_buffer.WriteString(`" placeholder="`)
+		hero.EscapeHTML(args.T("login.username"), _buffer)
+		_buffer.WriteString(`" required="true" autofocus="" />
+          <input type="password" class="form-control" name="password" placeholder="`)
+		hero.EscapeHTML(args.T("login.password"), _buffer)
+		_buffer.WriteString(`" required="true"/>
+          <input type="password" class="form-control" name="verify_password" placeholder="`)
+		hero.EscapeHTML(args.T("register.verify_password"), _buffer)
+		_buffer.WriteString(`" required="true"/>
+          <button class="btn btn-lg btn-primary btn-block" type="submit">`)
+		hero.EscapeHTML(args.T("register.submit"), _buffer)
+		_buffer.WriteString(`</button>
+        `)
+	} else {
+		_buffer.WriteString(`
+          <a class="btn btn-lg btn-secondary btn-block" href="/login">`)
+		hero.EscapeHTML(args.T("nav.login"), _buffer)
+		_buffer.WriteString(`</a>
+        `)
+	}
+	_buffer.WriteString(`
+      </form>
+    </div>
+  </div>
+</div>
+`)
+
+	_buffer.WriteString(`
+  </main>
+  <footer class="footer">
+    <p>© `)
+	hero.FormatInt(int64(args.Year()), _buffer)
+	_buffer.WriteString(` <a class="text-white" href="https://twitter.com/CodeHex">CodeHex</a></p>
+  </footer>
+  `)
+	_buffer.WriteString(`
+</body>
+</html>`)
+	w.Write(_buffer.Bytes())
+
+}
@@ -13,11 +13,18 @@ func Settings(args SettingsArgs, w io.Writer) {
 	_buffer := hero.GetBuffer()
 	defer hero.PutBuffer(_buffer)
 	_buffer.WriteString(`<!DOCTYPE html>
-<html lang="ja">
+<html lang="`)
+	hero.EscapeHTML(args.Lang(), _buffer)
+	_buffer.WriteString(`">
 <head>
   <meta charset="utf-8">
   <meta name="viewport" content="width=device-width, initial-scale=1.0">
-  <meta name="description" content="IoTを用いた栽培中の植物のデータを管理するプロジェクトです。">
+  <meta name="description" content="`)
+	hero.EscapeHTML(args.T("site.description"), _buffer)
+	_buffer.WriteString(`">
+  <script type="application/json" id="messages">`)
+	_buffer.WriteString(args.Messages())
+	_buffer.WriteString(`</script>
   <link href="/assets/css/main.css" rel="stylesheet">
   <link href="https://maxcdn.bootstrapcdn.com/font-awesome/4.7.0/css/font-awesome.min.css" rel="stylesheet" integrity="sha384-wvfXpqpZZVQGK6TAh5PVlGOfQNHSoD2xbE+QkPxCAFlNEevoEH3Sl0sibVcOQVnN" crossorigin="anonymous">
   <link rel="stylesheet" href="/assets/css/bootstrap.css">
@@ -43,35 +50,54 @@ func Settings(args SettingsArgs, w io.Writer) {
     <a class="navbar-brand" href="/">Vegeta</a>
     <div id="navbarResponsive" class="collapse navbar-collapse">
       <ul class="navbar-nav mr-auto">
-        <li class="nav-item"><a class="nav-link" href="/contact">問い合わせ</a></li>
+        <li class="nav-item"><a class="nav-link" href="/contact">`)
+	hero.EscapeHTML(args.T("nav.contact"), _buffer)
+	_buffer.WriteString(`</a></li>
       </ul>
       <ul class="navbar-nav">
         `)
 	if args.IsAuthed() {
 		_buffer.WriteString(`
           <li class="nav-item dropdown">
-            <a class="nav-link dropdown-toggle dropdown-toggle-split" href="" data-toggle="dropdown" aria-haspopup="true" aria-expanded="false"><i class="fa fa-user" aria-hidden="true"></i> ユーザー</a>
+            <a class="nav-link dropdown-toggle dropdown-toggle-split" href="" data-toggle="dropdown" aria-haspopup="true" aria-expanded="false"><i class="fa fa-user" aria-hidden="true"></i> `)
+		hero.EscapeHTML(args.T("nav.user"), _buffer)
+		_buffer.WriteString(`</a>
             <div class="dropdown-menu">
-              <a class="dropdown-item" href="/mypage"><i class="fa fa-pagelines" aria-hidden="true"></i> 観察</a>
+              <a class="dropdown-item" href="/mypage"><i class="fa fa-pagelines" aria-hidden="true"></i> `)
+		hero.EscapeHTML(args.T("nav.mypage"), _buffer)
+		_buffer.WriteString(`</a>
               <div class="dropdown-divider"></div>
-              <a class="dropdown-item" href="/mypage/settings"><i class="fa fa-cog" aria-hidden="true"></i> 設定</a>
+              <a class="dropdown-item" href="/mypage/settings"><i class="fa fa-cog" aria-hidden="true"></i> `)
+		hero.EscapeHTML(args.T("nav.settings"), _buffer)
+		_buffer.WriteString(`</a>
               `)
 		if args.IsAdmin() {
 			_buffer.WriteString(`
-                <a class="dropdown-item" href="/mypage/admin"><i class="fa fa-lock" aria-hidden="true"></i> ユーザー管理パネル</a>
+                <a class="dropdown-item" href="/mypage/admin"><i class="fa fa-lock" aria-hidden="true"></i> `)
+			hero.EscapeHTML(args.T("nav.admin"), _buffer)
+			_buffer.WriteString(`</a>
               `)
 		}
 		_buffer.WriteString(`
             </div>
           </li>
           <li class="nav-item">
-            <a class="nav-link" href="/mypage/logout"><i class="fa fa-sign-out" aria-hidden="true"></i> ログアウト</a>
+            <form class="form-inline" action="/mypage/logout" method="POST">
+              <input type="hidden" name="csrf_token" value="`)
+		hero.EscapeHTML(args.CSRFToken(), _buffer)
+		_buffer.WriteString(`">
+              <button type="submit" class="btn btn-link nav-link"><i class="fa fa-sign-out" aria-hidden="true"></i> `)
+		hero.EscapeHTML(args.T("nav.logout"), _buffer)
+		_buffer.WriteString(`</button>
+            </form>
           </li>
         `)
 	} else {
 		_buffer.WriteString(`
           <li class="nav-item">
-            <a class="nav-link" href="/login"><i class="fa fa-sign-in" aria-hidden="true"></i> ログイン</a>
+            <a class="nav-link" href="/login"><i class="fa fa-sign-in" aria-hidden="true"></i> `)
+		hero.EscapeHTML(args.T("nav.login"), _buffer)
+		_buffer.WriteString(`</a>
           </li>
         `)
 	}
@@ -88,19 +114,30 @@ func Settings(args SettingsArgs, w io.Writer) {
 	_buffer.WriteString(`
 <input type="hidden" id="api-token" value="`)
 	hero.EscapeHTML(settingsArgs.Token(), _buffer)
+	_buffer.WriteString(`" data-expires-at="`)
+	hero.FormatInt(int64(settingsArgs.TokenExpiresAt()), _buffer)
+	_buffer.WriteString(`">
+<input type="hidden" id="csrf-token" value="`)
+	hero.EscapeHTML(args.CSRFToken(), _buffer)
 	_buffer.WriteString(`">
 <div class="app-details">
   <div class="container">
     <div class="row">
       <div class="col-xs-12 col-md-6">
-        <h3>アクセストークンの変更</h3>
+        <h3>`)
+	hero.EscapeHTML(args.T("settings.token.title"), _buffer)
+	_buffer.WriteString(`</h3>
         <div class="form-group">
-          <label for="access-token">アクセストークン</label>
+          <label for="access-token">`)
+	hero.EscapeHTML(args.T("settings.token"), _buffer)
+	_buffer.WriteString(`</label>
           <input type="text" class="form-control" id="access-token" value="`)
 	_buffer.WriteString(user.Token)
 	_buffer.WriteString(`" readonly>
         </div>
-        <button type="button" id="regen-token" class="btn btn-primary float-right">アクセストークンを更新する</button>
+        <button type="button" id="regen-token" class="btn btn-primary float-right">`)
+	hero.EscapeHTML(args.T("settings.token.submit"), _buffer)
+	_buffer.WriteString(`</button>
       </div>
     </div>
   </div>
@@ -109,16 +146,273 @@ func Settings(args SettingsArgs, w io.Writer) {
   <div class="container">
     <div class="row">
       <div class="col-xs-12 col-md-6">
-        <h3>パスワードの変更</h3>
+        <h3>`)
+	hero.EscapeHTML(args.T("settings.password.title"), _buffer)
+	_buffer.WriteString(`</h3>
         <div class="form-group">
-          <label for="password">パスワード</label>
+          <label for="password">`)
+	hero.EscapeHTML(args.T("login.password"), _buffer)
+	_buffer.WriteString(`</label>
           <input type="password" class="form-control" id="password" required>
         </div>
         <div class="form-group">
-          <label for="password-verify">パスワードの再確認</label>
+          <label for="password-verify">`)
+	hero.EscapeHTML(args.T("settings.password.verify"), _buffer)
+	_buffer.WriteString(`</label>
           <input type="password" class="form-control" id="password-verify" required>
         </div>
-        <button type="button" id="reregister-password" class="btn btn-primary float-right">パスワードを変更する</button>
+        <button type="button" id="reregister-password" class="btn btn-primary float-right">`)
+	hero.EscapeHTML(args.T("settings.password.submit"), _buffer)
+	_buffer.WriteString(`</button>
+      </div>
+    </div>
+  </div>
+</div>
+<div class="app-details">
+  <div class="container">
+    <div class="row">
+      <div class="col-xs-12 col-md-6">
+        <h3>`)
+	hero.EscapeHTML(args.T("settings.locale.title"), _buffer)
+	_buffer.WriteString(`</h3>
+        <div class="form-group">
+          <select class="form-control" id="locale">
+            <option value="">`)
+	hero.EscapeHTML(args.T("settings.locale.auto"), _buffer)
+	_buffer.WriteString(`</option>
+            `)
+	for _, lang := range settingsArgs.Languages() {
+		_buffer.WriteString(`
+            <option value="`)
+		hero.EscapeHTML(lang, _buffer)
+		_buffer.WriteString(`"`)
+		if lang == user.Locale {
+			_buffer.WriteString(` selected`)
+		}
+		_buffer.WriteString(`>`)
+		hero.EscapeHTML(args.T("lang."+lang), _buffer)
+		_buffer.WriteString(`</option>
+            `)
+	}
+	_buffer.WriteString(`
+          </select>
+        </div>
+        <button type="button" id="save-locale" class="btn btn-primary float-right">`)
+	hero.EscapeHTML(args.T("settings.locale.submit"), _buffer)
+	_buffer.WriteString(`</button>
+      </div>
+    </div>
+  </div>
+</div>
+<div class="app-details">
+  <div class="container">
+    <div class="row">
+      <div class="col-xs-12 col-md-6">
+        <h3>`)
+	hero.EscapeHTML(args.T("settings.totp.title"), _buffer)
+	_buffer.WriteString(`</h3>
+        `)
+	if settingsArgs.TOTPRequired() {
+		_buffer.WriteString(`
+          <div class="alert alert-warning" role="alert">`)
+		hero.EscapeHTML(args.T("settings.totp.required"), _buffer)
+		_buffer.WriteString(`</div>
+        `)
+	}
+	_buffer.WriteString(`
+        `)
+	if user.TOTPEnabled {
+		_buffer.WriteString(`
+          <p>`)
+		hero.EscapeHTML(args.T("settings.totp.enabled"), _buffer)
+		_buffer.WriteString(`</p>
+          <p>`)
+		hero.EscapeHTML(args.T("settings.totp.recovery_left"), _buffer)
+		_buffer.WriteString(` `)
+		hero.FormatInt(int64(settingsArgs.RecoveryCodesLeft()), _buffer)
+		_buffer.WriteString(`</p>
+          <div class="form-group">
+            <label for="totp-current-code">`)
+		hero.EscapeHTML(args.T("settings.totp.current_code"), _buffer)
+		_buffer.WriteString(`</label>
+            <input type="text" class="form-control" id="totp-current-code" autocomplete="one-time-code">
+          </div>
+          <button type="button" id="totp-disable" class="btn btn-danger float-right">`)
+		hero.EscapeHTML(args.T("settings.totp.disable"), _buffer)
+		_buffer.WriteString(`</button>
+          <button type="button" id="totp-recovery" class="btn btn-secondary float-right mr-2">`)
+		hero.EscapeHTML(args.T("settings.totp.regenerate"), _buffer)
+		_buffer.WriteString(`</button>
+        `)
+	} else {
+		_buffer.WriteString(`
+          <p>`)
+		hero.EscapeHTML(args.T("settings.totp.disabled"), _buffer)
+		_buffer.WriteString(`</p>
+          <button type="button" id="totp-start" class="btn btn-primary float-right">`)
+		hero.EscapeHTML(args.T("settings.totp.start"), _buffer)
+		_buffer.WriteString(`</button>
+          <div id="totp-enroll" style="display: none">
+            <p>`)
+		hero.EscapeHTML(args.T("settings.totp.scan"), _buffer)
+		_buffer.WriteString(`</p>
+            <img id="totp-qr" alt="QR code">
+            <p><code id="totp-secret"></code></p>
+            <div class="form-group">
+              <label for="totp-code">`)
+		hero.EscapeHTML(args.T("settings.totp.code"), _buffer)
+		_buffer.WriteString(`</label>
+              <input type="text" class="form-control" id="totp-code" autocomplete="one-time-code">
+            </div>
+            <button type="button" id="totp-enable" class="btn btn-primary float-right">`)
+		hero.EscapeHTML(args.T("settings.totp.enable"), _buffer)
+		_buffer.WriteString(`</button>
+          </div>
+        `)
+	}
+	_buffer.WriteString(`
+        <div id="recovery-codes" style="display: none">
+          <p>`)
+	hero.EscapeHTML(args.T("settings.totp.save_codes"), _buffer)
+	_buffer.WriteString(`</p>
+          <pre id="recovery-codes-list"></pre>
+        </div>
+      </div>
+    </div>
+  </div>
+</div>
+<div class="app-details">
+  <div class="container">
+    <div class="row">
+      <div class="col-xs-12">
+        <h3>`)
+	hero.EscapeHTML(args.T("settings.sessions.title"), _buffer)
+	_buffer.WriteString(`</h3>
+        <table class="table table-striped table-bordered" cellspacing="0" width="100%">
+          <thead>
+            <tr>
+              <th>`)
+	hero.EscapeHTML(args.T("settings.sessions.device"), _buffer)
+	_buffer.WriteString(`</th>
+              <th>`)
+	hero.EscapeHTML(args.T("settings.sessions.ip"), _buffer)
+	_buffer.WriteString(`</th>
+              <th>`)
+	hero.EscapeHTML(args.T("settings.sessions.created"), _buffer)
+	_buffer.WriteString(`</th>
+              <th>`)
+	hero.EscapeHTML(args.T("settings.sessions.last_seen"), _buffer)
+	_buffer.WriteString(`</th>
+              <th>`)
+	hero.EscapeHTML(args.T("admin.action"), _buffer)
+	_buffer.WriteString(`</th>
+            </tr>
+          </thead>
+          <tbody>
+            `)
+	for _, s := range settingsArgs.Sessions() {
+		_buffer.WriteString(`
+              <tr>
+                <td>`)
+		hero.EscapeHTML(s.UserAgent, _buffer)
+		_buffer.WriteString(`</td>
+                <td>`)
+		hero.EscapeHTML(s.IPAddress, _buffer)
+		_buffer.WriteString(`</td>
+                <td>`)
+		hero.EscapeHTML(s.CreatedAt.Format("2006-01-02 15:04"), _buffer)
+		_buffer.WriteString(`</td>
+                <td>`)
+		hero.EscapeHTML(s.LastSeenAt.Format("2006-01-02 15:04"), _buffer)
+		_buffer.WriteString(`</td>
+                <td align="center">
+                  `)
+		if settingsArgs.IsCurrent(s.ID) {
+			_buffer.WriteString(`
+                    `)
+			hero.EscapeHTML(args.T("settings.sessions.current"), _buffer)
+			_buffer.WriteString(`
+                  `)
+		} else {
+			_buffer.WriteString(`
+                    <button type="button" class="btn btn-danger revoke-session" data-id="`)
+			hero.FormatUint(uint64(s.ID), _buffer)
+			_buffer.WriteString(`">`)
+			hero.EscapeHTML(args.T("settings.sessions.revoke"), _buffer)
+			_buffer.WriteString(`</button>
+                  `)
+		}
+		_buffer.WriteString(`
+                </td>
+              </tr>
+            `)
+	}
+	_buffer.WriteString(`
+          </tbody>
+        </table>
+      </div>
+    </div>
+  </div>
+</div>
+<div class="app-details">
+  <div class="container">
+    <div class="row">
+      <div class="col-xs-12">
+        <h3>`)
+	hero.EscapeHTML(args.T("settings.logins.title"), _buffer)
+	_buffer.WriteString(`</h3>
+        <table class="table table-striped table-bordered" cellspacing="0" width="100%">
+          <thead>
+            <tr>
+              <th>`)
+	hero.EscapeHTML(args.T("settings.logins.time"), _buffer)
+	_buffer.WriteString(`</th>
+              <th>`)
+	hero.EscapeHTML(args.T("settings.sessions.ip"), _buffer)
+	_buffer.WriteString(`</th>
+              <th>`)
+	hero.EscapeHTML(args.T("settings.sessions.device"), _buffer)
+	_buffer.WriteString(`</th>
+              <th>`)
+	hero.EscapeHTML(args.T("settings.logins.result"), _buffer)
+	_buffer.WriteString(`</th>
+            </tr>
+          </thead>
+          <tbody>
+            `)
+	for _, a := range settingsArgs.LoginAttempts() {
+		_buffer.WriteString(`
+              <tr>
+                <td>`)
+		hero.EscapeHTML(a.CreatedAt.Format("2006-01-02 15:04:05"), _buffer)
+		_buffer.WriteString(`</td>
+                <td>`)
+		hero.EscapeHTML(a.IPAddress, _buffer)
+		_buffer.WriteString(`</td>
+                <td>`)
+		hero.EscapeHTML(a.UserAgent, _buffer)
+		_buffer.WriteString(`</td>
+                `)
+		if a.Success {
+			_buffer.WriteString(`
+                  <td class="text-success">`)
+			hero.EscapeHTML(args.T("settings.logins.success"), _buffer)
+			_buffer.WriteString(`</td>
+                `)
+		} else {
+			_buffer.WriteString(`
+                  <td class="text-danger">`)
+			hero.EscapeHTML(args.T("settings.logins."+a.Reason), _buffer)
+			_buffer.WriteString(`</td>
+                `)
+		}
+		_buffer.WriteString(`
+              </tr>
+            `)
+	}
+	_buffer.WriteString(`
+          </tbody>
+        </table>
       </div>
     </div>
   </div>
@@ -0,0 +1,27 @@
+package common
+
+// Error codes in ResultJSON. They are stable so that clients can
+// branch on them instead of the reason which may be changed.
+const (
+	CodeInvalidRequest   = "invalid_request"
+	CodeValidationFailed = "validation_failed"
+	CodePasswordMismatch = "password_mismatch"
+	CodePasswordPolicy   = "password_policy"
+	CodeUnauthorized     = "unauthorized"
+	CodeForbidden        = "forbidden"
+	CodeTOTPRequired     = "totp_required"
+	CodeCSRFFailed       = "csrf_failed"
+	CodeNotFound         = "not_found"
+	CodeMethodNotAllowed = "method_not_allowed"
+	CodeAlreadyExists    = "already_exists"
+	CodeLocked           = "locked"
+	CodeSuspended        = "suspended"
+	CodeUnavailable      = "unavailable"
+	CodeInternal         = "internal_error"
+)
+
+// FieldError describes an invalid field in details of validation_failed.
+type FieldError struct {
+	Field string `json:"field"`
+	Rule  string `json:"rule"`
+}
@@ -1,8 +1,11 @@
 package common
 
 type ResultJSON struct {
-	IsSuccess bool   `json:"is_success"`
-	Reason    string `json:"reason"`
+	IsSuccess bool        `json:"is_success"`
+	Code      string      `json:"code,omitempty"`
+	Reason    string      `json:"reason"`
+	Details   interface{} `json:"details,omitempty"`
+	RequestID string      `json:"request_id,omitempty"`
 }
 
 type PostDataJSON struct {
@@ -12,6 +15,13 @@ type PostDataJSON struct {
 	TagName    string `json:"tag_name"`
 }
 
+// MaxBatchSize is the most data in a PostDataBatchJSON.
+const MaxBatchSize = 1000
+
+type PostDataBatchJSON struct {
+	Data []PostDataJSON `json:"data"`
+}
+
 type TagJSON struct {
 	TagName string `json:"tag_name"`
 }
@@ -0,0 +1,279 @@
+package i18n
+
+// en is the English catalog. It has all keys.
+var en = map[string]string{
+	"site.description": "A project to manage data of growing plants with IoT.",
+
+	"nav.contact":  "Contact",
+	"nav.user":     "User",
+	"nav.mypage":   "Observation",
+	"nav.settings": "Settings",
+	"nav.admin":    "User management",
+	"nav.logout":   "Log out",
+	"nav.login":    "Log in",
+
+	"index.step1.title": "Register a user",
+	"index.step1.body":  "First, you need to register a user.",
+	"index.step2.title": "Install",
+	"index.step2.body":  "Install the tool which sends information to the server on your IoT devices.",
+	"index.step3.title": "Collect information",
+	"index.step3.body":  "Just pass information read from the sensors of your device to the tool, and it sends them to the server.",
+
+	"login.username": "User name",
+	"login.password": "Password",
+	"login.submit":   "Log in",
+
+	"login.error.invalid":       "The user name or the password is wrong",
+	"login.error.locked":        "Too many failed logins. Please try again later",
+	"login.error.csrf":          "The page has expired. Please try again",
+	"login.error.oidc":          "Failed to log in by single sign-on",
+	"login.error.oidc_conflict": "The user name is already used by another account",
+	"login.error.suspended":     "The account is suspended. Please ask the administrator",
+	"login.oidc":                "Log in with %s",
+
+	"login.totp.title":         "Two-factor authentication",
+	"login.totp.help":          "Enter the code from your authenticator app, or one of your recovery codes.",
+	"login.totp.code":          "Code",
+	"login.totp.submit":        "Verify",
+	"login.totp.error.invalid": "The code is wrong",
+	"login.totp.error.csrf":    "The page has expired. Please try again",
+
+	"register.title":                   "Register",
+	"register.verify_password":         "Confirm the password",
+	"register.submit":                  "Register",
+	"register.error.invite":            "The invite is invalid, used up or expired",
+	"register.error.csrf":              "The page has expired. Please try again",
+	"register.error.empty":             "The user name and the password are required",
+	"register.error.password_mismatch": "The passwords do not match",
+	"register.error.exists":            "The user name is already used",
+
+	"mypage.tags":             "Tags",
+	"mypage.add_tag":          "Add a tag",
+	"mypage.title":            "Observation",
+	"mypage.week":             "Last week",
+	"mypage.month":            "Last month",
+	"mypage.all":              "All time",
+	"mypage.span":             "Period",
+	"mypage.span_placeholder": "Click here to select a period",
+	"mypage.new_tag":          "Add a new tag",
+	"mypage.tag_name_label":   "Tag name:",
+	"mypage.tag_name":         "Tag name",
+
+	"common.close": "Close",
+
+	"mypage.add": "Add",
+
+	"settings.token.title":     "Access token",
+	"settings.token":           "Access token",
+	"settings.token.submit":    "Regenerate the access token",
+	"settings.password.title":  "Change the password",
+	"settings.password.verify": "Confirm the password",
+	"settings.password.submit": "Change the password",
+
+	"admin.create":                      "Create a user",
+	"admin.admin":                       "Admin",
+	"admin.action":                      "Actions",
+	"admin.create.title":                "Create a new user",
+	"admin.username_label":              "User name:",
+	"admin.password_label":              "Password:",
+	"admin.verify_password_label":       "Confirm the password:",
+	"admin.make_admin":                  "Admin",
+	"admin.create.submit":               "Create",
+	"admin.edit.title":                  "Edit the user",
+	"admin.reset_password":              "Reset the password",
+	"admin.edit.submit":                 "Save",
+	"admin.delete.title":                "Delete the user",
+	"admin.delete.submit":               "Delete",
+	"admin.status":                      "Status",
+	"admin.locked":                      "Locked",
+	"admin.unlock":                      "Unlock",
+	"admin.totp":                        "2FA",
+	"admin.reset_totp":                  "Reset 2FA",
+	"admin.suspended":                   "Suspended",
+	"admin.suspend":                     "Suspend",
+	"admin.resume":                      "Resume",
+	"admin.export":                      "Export tags and data",
+	"admin.delete.warning":              "The user is deleted with all tags and data, which can not be restored. Export them first if they are needed.",
+	"admin.delete.confirm_label":        "Type the user name to confirm:",
+	"admin.audit":                       "Audit log",
+	"admin.invites":                     "Invites",
+	"invite.title":                      "Invites",
+	"invite.role":                       "Role",
+	"invite.role.user":                  "User",
+	"invite.role.admin":                 "Admin",
+	"invite.max_uses":                   "Max uses",
+	"invite.uses":                       "Uses",
+	"invite.expires_in":                 "Expires in",
+	"invite.expires_in.day":             "1 day",
+	"invite.expires_in.week":            "7 days",
+	"invite.expires_in.month":           "30 days",
+	"invite.submit":                     "Create an invite",
+	"invite.created":                    "Share this link with the invitee. It is shown only once.",
+	"invite.created_at":                 "Created",
+	"invite.creator":                    "Created by",
+	"invite.expires_at":                 "Expires",
+	"invite.status":                     "Status",
+	"invite.status.active":              "Active",
+	"invite.status.revoked":             "Revoked",
+	"invite.status.used_up":             "Used up",
+	"invite.status.expired":             "Expired",
+	"invite.history":                    "Registered users",
+	"invite.revoke":                     "Revoke",
+	"invite.error.invalid":              "The settings of the invite are invalid",
+	"audit.title":                       "Audit log",
+	"audit.time":                        "Time",
+	"audit.actor":                       "Actor",
+	"audit.action":                      "Action",
+	"audit.target":                      "Target",
+	"audit.detail":                      "Detail",
+	"audit.all_actions":                 "All actions",
+	"audit.filter":                      "Filter",
+	"audit.export":                      "Export CSV",
+	"audit.older":                       "Older",
+	"audit.action.user.create":          "Create user",
+	"audit.action.user.edit":            "Edit user",
+	"audit.action.user.delete":          "Delete user",
+	"audit.action.user.unlock":          "Unlock user",
+	"audit.action.user.password_reset":  "Reset password",
+	"audit.action.user.password_change": "Change password",
+	"audit.action.user.totp_enable":     "Enable 2FA",
+	"audit.action.user.totp_disable":    "Disable 2FA",
+	"audit.action.user.totp_reset":      "Reset 2FA",
+	"audit.action.token.regenerate":     "Regenerate token",
+	"audit.action.token.refresh_revoke": "Revoke refresh tokens",
+	"audit.action.tag.delete":           "Delete tag",
+	"audit.action.user.register":        "Register by invite",
+	"audit.action.invite.create":        "Create invite",
+	"audit.action.invite.revoke":        "Revoke invite",
+	"audit.action.user.suspend":         "Suspend user",
+	"audit.action.user.resume":          "Resume user",
+	"audit.action.user.export":          "Export user",
+
+	"settings.locale.title":       "Language",
+	"settings.locale.auto":        "Browser default",
+	"settings.locale.submit":      "Save the language",
+	"settings.sessions.title":     "Active sessions",
+	"settings.sessions.device":    "Device",
+	"settings.sessions.ip":        "IP address",
+	"settings.sessions.created":   "Logged in",
+	"settings.sessions.last_seen": "Last access",
+	"settings.sessions.current":   "This session",
+	"settings.sessions.revoke":    "Revoke",
+
+	"settings.logins.title":             "Login history",
+	"settings.logins.time":              "Time",
+	"settings.logins.result":            "Result",
+	"settings.logins.success":           "Succeeded",
+	"settings.logins.password_mismatch": "Wrong password",
+	"settings.logins.locked":            "Refused while locked",
+	"settings.logins.ip_locked":         "Refused for too many failures from the address",
+	"settings.logins.unknown_user":      "Unknown user",
+	"settings.logins.totp_required":     "Password accepted, waiting for the code",
+	"settings.logins.totp_mismatch":     "Wrong two-factor code",
+	"settings.logins.oidc_conflict":     "Single sign-on name conflict",
+
+	"settings.totp.title":         "Two-factor authentication",
+	"settings.totp.required":      "Administrators must enable two-factor authentication and log in with it to use the admin page.",
+	"settings.totp.enabled":       "Two-factor authentication is enabled.",
+	"settings.totp.disabled":      "Two-factor authentication is not enabled.",
+	"settings.totp.recovery_left": "Recovery codes left:",
+	"settings.totp.current_code":  "Code from the authenticator app or a recovery code",
+	"settings.totp.disable":       "Disable",
+	"settings.totp.regenerate":    "New recovery codes",
+	"settings.totp.start":         "Set up",
+	"settings.totp.scan":          "Scan the QR code with your authenticator app, or enter the key below.",
+	"settings.totp.code":          "Code from the authenticator app",
+	"settings.totp.enable":        "Enable",
+	"settings.totp.save_codes":    "Save these recovery codes. Each of them can be used once if you lose your authenticator. They are not shown again.",
+
+	"lang.en": "English",
+	"lang.ja": "日本語",
+
+	"error.invalid_request":            "Failed to read the request",
+	"error.invalid_request_detail":     "Failed to read the request: %v",
+	"error.validation_failed":          "Invalid input",
+	"error.validation_failed_detail":   "Invalid input: %s",
+	"error.password_mismatch":          "The password and the confirmation do not match.",
+	"error.password_policy.min_length": "The password must be at least %d characters",
+	"error.password_policy.max_length": "The password must be at most %d characters",
+	"error.password_policy.letter":     "The password must contain a letter",
+	"error.password_policy.digit":      "The password must contain a digit",
+	"error.password_policy.username":   "The password must not be the same as the username",
+	"error.no_claims":                  "The API token has no user information",
+	"error.regenerate_token":           "Failed to regenerate the token",
+	"error.update_password":            "Failed to update the password",
+	"error.fetch_data":                 "Failed to get data",
+	"error.create_user":                "Failed to create the user.",
+	"error.edit_user":                  "Failed to edit the user.",
+	"error.delete_user":                "Failed to delete the user.",
+	"error.login_required":             "Please log in",
+	"error.admin_required":             "Admin permission is required",
+	"error.totp_required":              "Log in with two-factor authentication to use admin features",
+	"error.totp_invalid_code":          "The two-factor code is wrong",
+	"error.csrf":                       "The page has expired. Please reload it",
+	"error.auth_client_cert":           "Failed to auth by client certificate",
+	"error.auth_token":                 "Failed to auth by token",
+	"error.auth_page_token":            "Only the token of the page can be used",
+	"error.refresh_token":              "The login has expired. Please log in again",
+	"error.auth_header":                "Incorrect authorization header",
+	"error.invalid_locale":             "Unsupported locale: %s",
+	"error.unauthorized":               "Unauthorized",
+	"error.forbidden":                  "Forbidden",
+	"error.not_found":                  "Not found",
+	"error.method_not_allowed":         "Method not allowed",
+	"error.unavailable":                "Service unavailable",
+	"error.internal":                   "Internal server error",
+	"error.revoke_session":             "Failed to revoke the session",
+	"error.suspended":                  "The user is suspended",
+	"error.batch_too_large":            "At most %d data can be sent at once",
+
+	"js.http_error":             "HTTP error: %s",
+	"js.password_validity":      "Please enter the same password.",
+	"js.user_deleted":           "The user was deleted.",
+	"js.user_delete_failed":     "Failed to delete the user: %s",
+	"js.user_edited":            "The user was edited.",
+	"js.password_reset":         "The new password is %s.",
+	"js.user_edit_failed":       "Failed to edit the user: %s",
+	"js.user_created":           "The user was created.",
+	"js.user_create_failed":     "Failed to create the user: %s",
+	"js.tag_added":              "The tag was added",
+	"js.fetch_failed":           "Failed to get data of %s",
+	"js.fetch_failed_reason":    "Failed to get data of %s: %s",
+	"js.no_data":                "There is no data of %s",
+	"js.span.week":              "the last week",
+	"js.span.month":             "the last month",
+	"js.span.all":               "all time",
+	"js.span.reload_week":       "the week to update",
+	"js.span.reload_month":      "the month to update",
+	"js.span.reload_all":        "all time to update",
+	"js.span.next":              "later pages",
+	"js.span.prev":              "earlier pages",
+	"js.switch_failed":          "An error occurred while switching the tag",
+	"js.graph_title":            "Graph of tag %s",
+	"js.token_regenerated":      "The access token was regenerated",
+	"js.password_empty":         "The password is empty",
+	"js.password_mismatch":      "The passwords do not match",
+	"js.password_updated":       "The password was updated",
+	"js.password_update_failed": "Failed to update the password: %s",
+	"js.locale_saved":           "The language was saved",
+	"js.locale_save_failed":     "Failed to save the language: %s",
+	"js.session_revoke_confirm": "Revoke this session?",
+	"js.session_revoked":        "The session was revoked",
+	"js.session_revoke_failed":  "Failed to revoke the session: %s",
+	"js.user_unlocked":          "The user was unlocked",
+	"js.user_unlock_failed":     "Failed to unlock the user: %s",
+	"js.user_suspend_confirm":   "Suspend %s? The user is logged out and can not use tokens until resumed.",
+	"js.user_suspended":         "The user was suspended",
+	"js.user_suspend_failed":    "Failed to suspend the user: %s",
+	"js.user_resumed":           "The user was resumed",
+	"js.user_resume_failed":     "Failed to resume the user: %s",
+	"js.delete_mismatch":        "The user name does not match",
+	"js.totp_enabled":           "Two-factor authentication was enabled",
+	"js.totp_disabled":          "Two-factor authentication was disabled",
+	"js.totp_disable_confirm":   "Disable two-factor authentication?",
+	"js.totp_reset_confirm":     "Disable two-factor authentication of %s?",
+	"js.totp_reset":             "Two-factor authentication of the user was disabled",
+	"js.totp_reset_failed":      "Failed to disable two-factor authentication: %s",
+	"js.totp_failed":            "Failed to change two-factor authentication: %s",
+	"js.login_expired":          "The login has expired. Please log in again",
+}
@@ -0,0 +1,85 @@
+// Package i18n provides message catalogs for the UI and the API.
+package i18n
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+// Supported languages. The first one is the default.
+const (
+	English  = "en"
+	Japanese = "ja"
+)
+
+var (
+	catalogs = map[string]map[string]string{
+		English:  en,
+		Japanese: ja,
+	}
+	matcher = language.NewMatcher([]language.Tag{
+		language.English,
+		language.Japanese,
+	})
+	langs = []string{English, Japanese}
+)
+
+// Languages returns supported languages.
+func Languages() []string {
+	return append([]string(nil), langs...)
+}
+
+// Supported reports whether lang has a catalog.
+func Supported(lang string) bool {
+	_, ok := catalogs[lang]
+	return ok
+}
+
+// Match chooses the language by the value of Accept-Language header.
+// English is used if nothing matches.
+func Match(acceptLanguage string) string {
+	tags, _, err := language.ParseAcceptLanguage(acceptLanguage)
+	if err != nil || len(tags) == 0 {
+		return English
+	}
+	_, i, confidence := matcher.Match(tags...)
+	if confidence == language.No {
+		return English
+	}
+	return langs[i]
+}
+
+// T returns the message of key in lang which is formatted with args.
+// The English message is used if lang does not have it.
+func T(lang, key string, args ...interface{}) string {
+	msg, ok := catalogs[lang][key]
+	if !ok {
+		msg, ok = en[key]
+	}
+	if !ok {
+		msg = key
+	}
+	if len(args) > 0 {
+		return fmt.Sprintf(msg, args...)
+	}
+	return msg
+}
+
+// Messages returns messages in lang whose keys start with prefix.
+// They are passed to scripts on pages.
+func Messages(lang, prefix string) map[string]string {
+	m := make(map[string]string)
+	for key, msg := range en {
+		if strings.HasPrefix(key, prefix) {
+			m[key] = msg
+		}
+	}
+	for key, msg := range catalogs[lang] {
+		if strings.HasPrefix(key, prefix) {
+			m[key] = msg
+		}
+	}
+	return m
+}
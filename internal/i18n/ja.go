@@ -0,0 +1,279 @@
+package i18n
+
+// ja is the Japanese catalog.
+var ja = map[string]string{
+	"site.description": "IoTを用いた栽培中の植物のデータを管理するプロジェクトです。",
+
+	"nav.contact":  "問い合わせ",
+	"nav.user":     "ユーザー",
+	"nav.mypage":   "観察",
+	"nav.settings": "設定",
+	"nav.admin":    "ユーザー管理パネル",
+	"nav.logout":   "ログアウト",
+	"nav.login":    "ログイン",
+
+	"index.step1.title": "ユーザーの登録",
+	"index.step1.body":  "まずはユーザー登録を行う必要があります。",
+	"index.step2.title": "インストール",
+	"index.step2.body":  "IoTデバイスにサーバーへ情報を送るためのツールをインストールします。",
+	"index.step3.title": "情報を集める",
+	"index.step3.body":  "デバイスのセンサーから読み取った情報を、インストールしたツールへ渡してあげるだけで簡単にサーバへ送ってくれます。",
+
+	"login.username": "ユーザー名",
+	"login.password": "パスワード",
+	"login.submit":   "ログインする",
+
+	"login.error.invalid":       "ユーザー名またはパスワードが違います",
+	"login.error.locked":        "ログインの失敗が多すぎます。しばらくしてから再度お試しください",
+	"login.error.csrf":          "ページの有効期限が切れました。もう一度お試しください",
+	"login.error.oidc":          "シングルサインオンでのログインに失敗しました",
+	"login.error.oidc_conflict": "このユーザー名は他のアカウントで使われています",
+	"login.error.suspended":     "アカウントは停止されています。管理者にお問い合わせください",
+	"login.oidc":                "%sでログイン",
+
+	"login.totp.title":         "2段階認証",
+	"login.totp.help":          "認証アプリに表示されたコード、またはリカバリーコードを入力してください。",
+	"login.totp.code":          "コード",
+	"login.totp.submit":        "確認する",
+	"login.totp.error.invalid": "コードが違います",
+	"login.totp.error.csrf":    "ページの有効期限が切れました。もう一度お試しください",
+
+	"register.title":                   "ユーザー登録",
+	"register.verify_password":         "パスワード (確認)",
+	"register.submit":                  "登録",
+	"register.error.invite":            "招待が無効か、使用済みか、期限切れです",
+	"register.error.csrf":              "ページの有効期限が切れました。もう一度お試しください",
+	"register.error.empty":             "ユーザー名とパスワードを入力してください",
+	"register.error.password_mismatch": "パスワードが一致しません",
+	"register.error.exists":            "このユーザー名は既に使われています",
+
+	"mypage.tags":             "タグ一覧",
+	"mypage.add_tag":          "タグを追加する",
+	"mypage.title":            "観察ページ",
+	"mypage.week":             "直近 1 週間の様子",
+	"mypage.month":            "直近 1 ヶ月の様子",
+	"mypage.all":              "全期間の様子",
+	"mypage.span":             "表示する期間を指定",
+	"mypage.span_placeholder": "ここをクリックして期間を指定する",
+	"mypage.new_tag":          "新規タグの追加",
+	"mypage.tag_name_label":   "タグの名前:",
+	"mypage.tag_name":         "タグの名前",
+
+	"common.close": "閉じる",
+
+	"mypage.add": "追加する",
+
+	"settings.token.title":     "アクセストークンの変更",
+	"settings.token":           "アクセストークン",
+	"settings.token.submit":    "アクセストークンを更新する",
+	"settings.password.title":  "パスワードの変更",
+	"settings.password.verify": "パスワードの再確認",
+	"settings.password.submit": "パスワードを変更する",
+
+	"admin.create":                      "ユーザー作成",
+	"admin.admin":                       "管理者",
+	"admin.action":                      "アクション",
+	"admin.create.title":                "新しいユーザーの作成",
+	"admin.username_label":              "ユーザー名:",
+	"admin.password_label":              "パスワード:",
+	"admin.verify_password_label":       "パスワードの再確認:",
+	"admin.make_admin":                  "管理者にする",
+	"admin.create.submit":               "ユーザーの作成",
+	"admin.edit.title":                  "ユーザーの編集",
+	"admin.reset_password":              "パスワードをリセットする",
+	"admin.edit.submit":                 "編集を完了する",
+	"admin.delete.title":                "ユーザーの削除",
+	"admin.status":                      "状態",
+	"admin.locked":                      "ロック中",
+	"admin.unlock":                      "ロック解除",
+	"admin.totp":                        "2段階認証",
+	"admin.reset_totp":                  "2段階認証を解除",
+	"admin.suspended":                   "停止中",
+	"admin.suspend":                     "停止",
+	"admin.resume":                      "再開",
+	"admin.export":                      "タグとデータのエクスポート",
+	"admin.delete.warning":              "ユーザーはすべてのタグとデータと共に削除され、元に戻せません。必要であれば先にエクスポートしてください。",
+	"admin.delete.confirm_label":        "確認のためユーザー名を入力してください:",
+	"admin.audit":                       "監査ログ",
+	"admin.invites":                     "招待",
+	"invite.title":                      "招待",
+	"invite.role":                       "権限",
+	"invite.role.user":                  "ユーザー",
+	"invite.role.admin":                 "管理者",
+	"invite.max_uses":                   "使用回数の上限",
+	"invite.uses":                       "使用回数",
+	"invite.expires_in":                 "有効期間",
+	"invite.expires_in.day":             "1日",
+	"invite.expires_in.week":            "7日",
+	"invite.expires_in.month":           "30日",
+	"invite.submit":                     "招待を作成",
+	"invite.created":                    "このリンクを招待する人に共有してください。一度しか表示されません。",
+	"invite.created_at":                 "作成日時",
+	"invite.creator":                    "作成者",
+	"invite.expires_at":                 "有効期限",
+	"invite.status":                     "状態",
+	"invite.status.active":              "有効",
+	"invite.status.revoked":             "取り消し済み",
+	"invite.status.used_up":             "使用済み",
+	"invite.status.expired":             "期限切れ",
+	"invite.history":                    "登録したユーザー",
+	"invite.revoke":                     "取り消す",
+	"invite.error.invalid":              "招待の設定が正しくありません",
+	"audit.title":                       "監査ログ",
+	"audit.time":                        "日時",
+	"audit.actor":                       "実行者",
+	"audit.action":                      "操作",
+	"audit.target":                      "対象",
+	"audit.detail":                      "詳細",
+	"audit.all_actions":                 "すべての操作",
+	"audit.filter":                      "絞り込み",
+	"audit.export":                      "CSVで出力",
+	"audit.older":                       "さらに古いログ",
+	"audit.action.user.create":          "ユーザー作成",
+	"audit.action.user.edit":            "ユーザー編集",
+	"audit.action.user.delete":          "ユーザー削除",
+	"audit.action.user.unlock":          "ロック解除",
+	"audit.action.user.password_reset":  "パスワードリセット",
+	"audit.action.user.password_change": "パスワード変更",
+	"audit.action.user.totp_enable":     "2段階認証の有効化",
+	"audit.action.user.totp_disable":    "2段階認証の無効化",
+	"audit.action.user.totp_reset":      "2段階認証のリセット",
+	"audit.action.token.regenerate":     "トークン再発行",
+	"audit.action.token.refresh_revoke": "リフレッシュトークン失効",
+	"audit.action.tag.delete":           "タグ削除",
+	"audit.action.user.register":        "招待による登録",
+	"audit.action.invite.create":        "招待作成",
+	"audit.action.invite.revoke":        "招待取り消し",
+	"audit.action.user.suspend":         "ユーザー停止",
+	"audit.action.user.resume":          "ユーザー再開",
+	"audit.action.user.export":          "ユーザーのエクスポート",
+	"admin.delete.submit":               "削除する",
+
+	"settings.locale.title":       "表示言語",
+	"settings.locale.auto":        "ブラウザの設定に従う",
+	"settings.locale.submit":      "言語を保存する",
+	"settings.sessions.title":     "ログイン中のセッション",
+	"settings.sessions.device":    "端末",
+	"settings.sessions.ip":        "IPアドレス",
+	"settings.sessions.created":   "ログイン日時",
+	"settings.sessions.last_seen": "最終アクセス",
+	"settings.sessions.current":   "このセッション",
+	"settings.sessions.revoke":    "ログアウトさせる",
+
+	"settings.logins.title":             "ログイン履歴",
+	"settings.logins.time":              "日時",
+	"settings.logins.result":            "結果",
+	"settings.logins.success":           "成功",
+	"settings.logins.password_mismatch": "パスワード誤り",
+	"settings.logins.locked":            "ロック中のため拒否",
+	"settings.logins.ip_locked":         "このアドレスからの失敗が多いため拒否",
+	"settings.logins.unknown_user":      "存在しないユーザー",
+	"settings.logins.totp_required":     "パスワード確認済み、コード待ち",
+	"settings.logins.totp_mismatch":     "2段階認証のコード誤り",
+	"settings.logins.oidc_conflict":     "シングルサインオンのユーザー名重複",
+
+	"settings.totp.title":         "2段階認証",
+	"settings.totp.required":      "管理者は管理ページを使うために2段階認証を有効にしてログインする必要があります。",
+	"settings.totp.enabled":       "2段階認証は有効です。",
+	"settings.totp.disabled":      "2段階認証は有効になっていません。",
+	"settings.totp.recovery_left": "残りのリカバリーコード:",
+	"settings.totp.current_code":  "認証アプリのコードまたはリカバリーコード",
+	"settings.totp.disable":       "無効にする",
+	"settings.totp.regenerate":    "リカバリーコードを作り直す",
+	"settings.totp.start":         "設定する",
+	"settings.totp.scan":          "認証アプリでQRコードを読み取るか、下のキーを入力してください。",
+	"settings.totp.code":          "認証アプリのコード",
+	"settings.totp.enable":        "有効にする",
+	"settings.totp.save_codes":    "このリカバリーコードを保存してください。認証アプリを失ったときにそれぞれ1回だけ使えます。再表示はできません。",
+
+	"lang.en": "English",
+	"lang.ja": "日本語",
+
+	"error.invalid_request":            "リクエスト内容を取得できませんでした",
+	"error.invalid_request_detail":     "リクエスト内容を取得できませんでした: %v",
+	"error.validation_failed":          "入力に誤りがあります",
+	"error.validation_failed_detail":   "入力に誤りがあります: %s",
+	"error.password_mismatch":          "入力したパスワードと確認用のパスワードが一致しませんでした。",
+	"error.password_policy.min_length": "パスワードは %d 文字以上にしてください",
+	"error.password_policy.max_length": "パスワードは %d 文字以下にしてください",
+	"error.password_policy.letter":     "パスワードには英字を含めてください",
+	"error.password_policy.digit":      "パスワードには数字を含めてください",
+	"error.password_policy.username":   "ユーザー名と同じパスワードは使えません",
+	"error.no_claims":                  "APIトークンにユーザーの情報がありませんでした",
+	"error.regenerate_token":           "トークンの更新に失敗しました",
+	"error.update_password":            "パスワードの更新に失敗しました",
+	"error.fetch_data":                 "データを取得するときにエラーが発生しました",
+	"error.create_user":                "ユーザー作成時にエラーが発生しました。",
+	"error.edit_user":                  "ユーザー編集時にエラーが発生しました。",
+	"error.delete_user":                "ユーザー削除時にエラーが発生しました。",
+	"error.login_required":             "ログインしてください",
+	"error.admin_required":             "管理者権限がありません",
+	"error.totp_required":              "管理機能を使うには2段階認証でログインしてください",
+	"error.totp_invalid_code":          "2段階認証のコードが違います",
+	"error.csrf":                       "ページの有効期限が切れました。再読み込みしてください",
+	"error.auth_client_cert":           "クライアント証明書による認証に失敗しました",
+	"error.auth_token":                 "トークンによる認証に失敗しました",
+	"error.auth_page_token":            "ページのトークンのみ使用できます",
+	"error.refresh_token":              "ログインの有効期限が切れました。再度ログインしてください",
+	"error.auth_header":                "Authorization ヘッダーが正しくありません",
+	"error.invalid_locale":             "対応していない言語です: %s",
+	"error.unauthorized":               "認証が必要です",
+	"error.forbidden":                  "権限がありません",
+	"error.not_found":                  "見つかりませんでした",
+	"error.method_not_allowed":         "許可されていないメソッドです",
+	"error.unavailable":                "サービスを利用できません",
+	"error.internal":                   "サーバー内部でエラーが発生しました",
+	"error.revoke_session":             "セッションの無効化に失敗しました",
+	"error.suspended":                  "ユーザーは停止されています",
+	"error.batch_too_large":            "一度に送れるデータは %d 件までです",
+
+	"js.http_error":             "通信エラー: %s",
+	"js.password_validity":      "一致するパスワードを入力してください。",
+	"js.user_deleted":           "ユーザーを削除しました。",
+	"js.user_delete_failed":     "ユーザーの削除に失敗しました: %s",
+	"js.user_edited":            "ユーザーを編集しました。",
+	"js.password_reset":         "リセット後のパスワードは %s です。",
+	"js.user_edit_failed":       "ユーザーの編集に失敗しました: %s",
+	"js.user_created":           "ユーザーを作成しました。",
+	"js.user_create_failed":     "ユーザーの作成に失敗しました: %s",
+	"js.tag_added":              "タグを追加しました",
+	"js.fetch_failed":           "%sのデータの取得に失敗しました",
+	"js.fetch_failed_reason":    "%sのデータの取得に失敗しました: %s",
+	"js.no_data":                "%sのデータが存在しませんでした",
+	"js.span.week":              "直近1週間分",
+	"js.span.month":             "直近1ヶ月分",
+	"js.span.all":               "全期間",
+	"js.span.reload_week":       "更新すべき1週間",
+	"js.span.reload_month":      "更新すべき1ヶ月",
+	"js.span.reload_all":        "更新すべき全期間",
+	"js.span.next":              "これより以後",
+	"js.span.prev":              "これより以前",
+	"js.switch_failed":          "タグの切り替え時にエラーが発生しました",
+	"js.graph_title":            "タグ%sのグラフ",
+	"js.token_regenerated":      "アクセストークンを更新しました",
+	"js.password_empty":         "パスワードが入力されていません",
+	"js.password_mismatch":      "パスワードが一致していません",
+	"js.password_updated":       "パスワードを更新しました",
+	"js.password_update_failed": "パスワードの更新に失敗しました: %s",
+	"js.locale_saved":           "言語の設定を保存しました",
+	"js.locale_save_failed":     "言語の設定の保存に失敗しました: %s",
+	"js.session_revoke_confirm": "このセッションをログアウトさせますか?",
+	"js.session_revoked":        "セッションを無効にしました",
+	"js.session_revoke_failed":  "セッションの無効化に失敗しました: %s",
+	"js.user_unlocked":          "ユーザーのロックを解除しました",
+	"js.user_unlock_failed":     "ユーザーのロック解除に失敗しました: %s",
+	"js.user_suspend_confirm":   "%s を停止しますか? 再開するまでログインやトークンが使えなくなります。",
+	"js.user_suspended":         "ユーザーを停止しました",
+	"js.user_suspend_failed":    "ユーザーの停止に失敗しました: %s",
+	"js.user_resumed":           "ユーザーを再開しました",
+	"js.user_resume_failed":     "ユーザーの再開に失敗しました: %s",
+	"js.delete_mismatch":        "ユーザー名が一致しません",
+	"js.totp_enabled":           "2段階認証を有効にしました",
+	"js.totp_disabled":          "2段階認証を無効にしました",
+	"js.totp_disable_confirm":   "2段階認証を無効にしますか?",
+	"js.totp_reset_confirm":     "%s の2段階認証を無効にしますか?",
+	"js.totp_reset":             "ユーザーの2段階認証を無効にしました",
+	"js.totp_reset_failed":      "2段階認証の無効化に失敗しました: %s",
+	"js.totp_failed":            "2段階認証の変更に失敗しました: %s",
+	"js.login_expired":          "ログインの有効期限が切れました。再度ログインしてください",
+}
@@ -0,0 +1,112 @@
+package mockoidc
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+const redirectURI = "http://client.test/callback"
+
+func newProvider(t *testing.T) (*Provider, *httptest.Server) {
+	t.Helper()
+	var p *Provider
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		p.ServeHTTP(w, r)
+	}))
+	t.Cleanup(ts.Close)
+	p, err := New(ts.URL, "client", "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.User = "alice"
+	return p, ts
+}
+
+// authorize returns the code for the challenge.
+func authorize(t *testing.T, ts *httptest.Server, challenge string) string {
+	t.Helper()
+	q := url.Values{
+		"client_id":             {"client"},
+		"response_type":         {"code"},
+		"redirect_uri":          {redirectURI},
+		"state":                 {"state"},
+		"nonce":                 {"nonce"},
+		"code_challenge":        {challenge},
+		"code_challenge_method": {"S256"},
+	}
+	c := &http.Client{
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	resp, err := c.Get(ts.URL + "/authorize?" + q.Encode())
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	u, err := url.Parse(resp.Header.Get("Location"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(u.String(), redirectURI) || u.Query().Get("state") != "state" {
+		t.Fatalf("authorize redirects to %s", u)
+	}
+	return u.Query().Get("code")
+}
+
+func exchange(t *testing.T, ts *httptest.Server, code, verifier, secret string) (int, map[string]interface{}) {
+	t.Helper()
+	resp, err := http.PostForm(ts.URL+"/token", url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {redirectURI},
+		"code_verifier": {verifier},
+		"client_id":     {"client"},
+		"client_secret": {secret},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	var body map[string]interface{}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		t.Fatal(err)
+	}
+	return resp.StatusCode, body
+}
+
+func TestToken(t *testing.T) {
+	_, ts := newProvider(t)
+	verifier := "verifier-of-the-client-which-is-long-enough"
+
+	tests := []struct {
+		name             string
+		verifier, secret string
+		want             string
+	}{
+		{"wrong verifier", "other-verifier", "secret", "invalid_grant"},
+		{"wrong secret", verifier, "wrong", "invalid_client"},
+		{"success", verifier, "secret", ""},
+	}
+	for _, tt := range tests {
+		code := authorize(t, ts, challenge(verifier))
+		status, body := exchange(t, ts, code, tt.verifier, tt.secret)
+		if tt.want == "" {
+			if status != http.StatusOK || body["id_token"] == nil {
+				t.Errorf("%s: status = %d, body = %v", tt.name, status, body)
+			}
+			// A code can be exchanged only once.
+			if _, body := exchange(t, ts, code, tt.verifier, tt.secret); body["error"] != "invalid_grant" {
+				t.Errorf("%s: reused code: %v", tt.name, body)
+			}
+			continue
+		}
+		if body["error"] != tt.want {
+			t.Errorf("%s: status = %d, error = %v, want %s", tt.name, status, body["error"], tt.want)
+		}
+	}
+}
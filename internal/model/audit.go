@@ -0,0 +1,123 @@
+package model
+
+import (
+	"time"
+
+	"github.com/jinzhu/gorm"
+)
+
+// Actions in AuditLog.
+const (
+	AuditUserCreate      = "user.create"
+	AuditUserEdit        = "user.edit"
+	AuditUserDelete      = "user.delete"
+	AuditUserUnlock      = "user.unlock"
+	AuditUserSuspend     = "user.suspend"
+	AuditUserResume      = "user.resume"
+	AuditUserExport      = "user.export"
+	AuditUserRegister    = "user.register"
+	AuditPasswordReset   = "user.password_reset"
+	AuditPasswordChange  = "user.password_change"
+	AuditTOTPEnable      = "user.totp_enable"
+	AuditTOTPDisable     = "user.totp_disable"
+	AuditTOTPReset       = "user.totp_reset"
+	AuditTokenRegenerate = "token.regenerate"
+	AuditRefreshRevoke   = "token.refresh_revoke"
+	AuditTagDelete       = "tag.delete"
+	AuditInviteCreate    = "invite.create"
+	AuditInviteRevoke    = "invite.revoke"
+)
+
+// AuditActions are all actions in the order of the filter on the page.
+var AuditActions = []string{
+	AuditUserCreate,
+	AuditUserEdit,
+	AuditUserDelete,
+	AuditUserUnlock,
+	AuditUserSuspend,
+	AuditUserResume,
+	AuditUserExport,
+	AuditUserRegister,
+	AuditPasswordReset,
+	AuditPasswordChange,
+	AuditTOTPEnable,
+	AuditTOTPDisable,
+	AuditTOTPReset,
+	AuditTokenRegenerate,
+	AuditRefreshRevoke,
+	AuditTagDelete,
+	AuditInviteCreate,
+	AuditInviteRevoke,
+}
+
+// AuditLog records who did an administrative or security action. Names are
+// copied so that the log is readable after the users are deleted. ActorID
+// is 0 for the command line, whose actor is "cli:" and the OS user.
+// Logs can not be updated or deleted.
+type AuditLog struct {
+	ID        uint      `gorm:"primary_key"`
+	ActorID   uint      `gorm:"not null;index:idx_audit_actor"`
+	Actor     string    `gorm:"not null"`
+	Action    string    `gorm:"not null;index:idx_audit_action"`
+	Target    string    `gorm:"not null"`
+	Detail    string    `gorm:"not null"`
+	IPAddress string    `gorm:"not null"`
+	CreatedAt time.Time `gorm:"not null;index:idx_audit_created"`
+}
+
+func (*AuditLog) BeforeUpdate() error {
+	return newError(ErrForbidden, "Audit log is append-only")
+}
+
+func (*AuditLog) BeforeDelete() error {
+	return newError(ErrForbidden, "Audit log is append-only")
+}
+
+// Audit appends the log.
+func Audit(db *gorm.DB, log *AuditLog) error {
+	return db.Create(log).Error
+}
+
+// AuditFilter selects logs. Empty fields match everything.
+type AuditFilter struct {
+	Actor  string
+	Action string
+	Target string
+	Since  time.Time
+	Until  time.Time
+	// BeforeID selects logs which are older than the log, so that the
+	// next page does not shift when new logs are added.
+	BeforeID uint
+}
+
+// GetAuditLogs returns at most limit latest logs which match the filter.
+func GetAuditLogs(db *gorm.DB, f AuditFilter, limit int) ([]*AuditLog, error) {
+	q := db.Model(&AuditLog{})
+	if f.BeforeID != 0 {
+		before := new(AuditLog)
+		if db.First(before, f.BeforeID).RecordNotFound() {
+			return nil, newError(ErrInvalid, "Audit log: %d is not found", f.BeforeID)
+		}
+		q = q.Where("created_at < ? OR (created_at = ? AND id < ?)", before.CreatedAt, before.CreatedAt, before.ID)
+	}
+	if f.Actor != "" {
+		q = q.Where("actor = ?", f.Actor)
+	}
+	if f.Action != "" {
+		q = q.Where("action = ?", f.Action)
+	}
+	if f.Target != "" {
+		q = q.Where("target = ?", f.Target)
+	}
+	if !f.Since.IsZero() {
+		q = q.Where("created_at >= ?", f.Since)
+	}
+	if !f.Until.IsZero() {
+		q = q.Where("created_at < ?", f.Until)
+	}
+	var logs []*AuditLog
+	if err := q.Order("created_at desc, id desc").Limit(limit).Find(&logs).Error; err != nil {
+		return nil, err
+	}
+	return logs, nil
+}
@@ -0,0 +1,34 @@
+package model
+
+import "fmt"
+
+// Kinds of errors. Use errors.Cause to get the kind of an error
+// which is returned from this package.
+var (
+	ErrNotFound      = kind("not found")
+	ErrAlreadyExists = kind("already exists")
+	ErrInvalid       = kind("invalid")
+	ErrForbidden     = kind("forbidden")
+	ErrUnauthorized  = kind("unauthorized")
+	ErrLocked        = kind("locked")
+	ErrSuspended     = kind("suspended")
+)
+
+type kind string
+
+func (k kind) Error() string { return string(k) }
+
+type modelError struct {
+	kind error
+	msg  string
+}
+
+func (e *modelError) Error() string { return e.msg }
+func (e *modelError) Cause() error  { return e.kind }
+
+func newError(kind error, format string, args ...interface{}) error {
+	return &modelError{
+		kind: kind,
+		msg:  fmt.Sprintf(format, args...),
+	}
+}
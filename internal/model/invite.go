@@ -0,0 +1,152 @@
+package model
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/Code-Hex/vegeta/internal/utils"
+	"github.com/jinzhu/gorm"
+)
+
+// Invite lets people register themselves up to MaxUses times until it
+// expires. The link has only the token, and the hash of it is stored.
+// Users who register by it are admin if Admin is true.
+type Invite struct {
+	ID        uint      `gorm:"primary_key"`
+	Hash      string    `gorm:"not null;unique_index:idx_invite_hash"`
+	CreatorID uint      `gorm:"not null"`
+	Creator   string    `gorm:"not null"`
+	Admin     bool      `gorm:"not null"`
+	MaxUses   int       `gorm:"not null"`
+	Uses      int       `gorm:"not null"`
+	ExpiresAt time.Time `gorm:"not null"`
+	RevokedAt *time.Time
+	CreatedAt time.Time    `gorm:"not null"`
+	History   []*InviteUse `gorm:"foreignkey:InviteID"`
+}
+
+// InviteUse records a registration by an invite. Name is copied so that
+// it is readable after the user is deleted.
+type InviteUse struct {
+	ID        uint      `gorm:"primary_key"`
+	InviteID  uint      `gorm:"not null;index:idx_invite_use_invite"`
+	UserID    uint      `gorm:"not null"`
+	Name      string    `gorm:"not null"`
+	IPAddress string    `gorm:"not null"`
+	UserAgent string    `gorm:"not null"`
+	CreatedAt time.Time `gorm:"not null"`
+}
+
+// IsUsable reports whether someone can register by the invite at now.
+func (i *Invite) IsUsable(now time.Time) bool {
+	return i.RevokedAt == nil && now.Before(i.ExpiresAt) && i.Uses < i.MaxUses
+}
+
+// CreateInvite returns the invite and the token for the link.
+func CreateInvite(db *gorm.DB, creator *User, admin bool, maxUses int, expiresAt, now time.Time) (*Invite, string, error) {
+	if maxUses < 1 {
+		return nil, "", newError(ErrInvalid, "Invite must be usable at least once")
+	}
+	if !now.Before(expiresAt) {
+		return nil, "", newError(ErrInvalid, "Invite must expire in the future")
+	}
+	token := utils.RandomToken()
+	invite := &Invite{
+		Hash:      hashToken(token),
+		CreatorID: creator.ID,
+		Creator:   creator.Name,
+		Admin:     admin,
+		MaxUses:   maxUses,
+		ExpiresAt: expiresAt,
+		CreatedAt: now,
+	}
+	if err := db.Create(invite).Error; err != nil {
+		return nil, "", err
+	}
+	return invite, token, nil
+}
+
+// FindInvite returns the invite of the token which is usable at now.
+func FindInvite(db *gorm.DB, token string, now time.Time) (*Invite, error) {
+	invite := new(Invite)
+	if db.First(invite, "hash = ?", hashToken(token)).RecordNotFound() || !invite.IsUsable(now) {
+		return nil, newError(ErrNotFound, "Invite is not found or expired")
+	}
+	return invite, nil
+}
+
+// Register creates the user by the invite of the token, and records the use.
+func Register(db *gorm.DB, token, name, password, ip, userAgent string, now time.Time) (*User, *Invite, error) {
+	invite, err := FindInvite(db, token, now)
+	if err != nil {
+		return nil, nil, err
+	}
+	user, err := newUser(db, name, password, invite.Admin)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tx := db.Begin()
+	// The condition keeps concurrent registrations within MaxUses, and
+	// checks the invite again because hashing the password takes a while.
+	res := tx.Model(invite).
+		Where("uses < max_uses AND revoked_at IS NULL AND expires_at > ?", now).
+		UpdateColumn("uses", gorm.Expr("uses + 1"))
+	if res.Error != nil {
+		tx.Rollback()
+		return nil, nil, res.Error
+	}
+	if res.RowsAffected == 0 {
+		tx.Rollback()
+		return nil, nil, newError(ErrNotFound, "Invite is used up, revoked or expired")
+	}
+	if err := tx.Create(user).Error; err != nil {
+		tx.Rollback()
+		return nil, nil, err
+	}
+	use := &InviteUse{
+		InviteID:  invite.ID,
+		UserID:    user.ID,
+		Name:      user.Name,
+		IPAddress: ip,
+		UserAgent: userAgent,
+		CreatedAt: now,
+	}
+	if err := tx.Create(use).Error; err != nil {
+		tx.Rollback()
+		return nil, nil, err
+	}
+	tx.Commit()
+	return user, invite, nil
+}
+
+// GetInvites returns all invites and their uses from the latest.
+func GetInvites(db *gorm.DB) ([]*Invite, error) {
+	var invites []*Invite
+	err := db.Preload("History", func(db *gorm.DB) *gorm.DB {
+		return db.Order("created_at")
+	}).Order("created_at desc, id desc").Find(&invites).Error
+	if err != nil {
+		return nil, err
+	}
+	return invites, nil
+}
+
+// RevokeInvite stops the invite from being used.
+func RevokeInvite(db *gorm.DB, inviteID string, now time.Time) (*Invite, error) {
+	id, err := strconv.ParseUint(inviteID, 10, 64)
+	if err != nil {
+		return nil, newError(ErrInvalid, "Invalid invite id: %s", inviteID)
+	}
+	invite := new(Invite)
+	if db.First(invite, id).RecordNotFound() {
+		return nil, newError(ErrNotFound, "Invite: %d is not found", id)
+	}
+	if invite.RevokedAt == nil {
+		if err := db.Model(invite).UpdateColumn("revoked_at", now).Error; err != nil {
+			return nil, err
+		}
+		invite.RevokedAt = &now
+	}
+	return invite, nil
+}
@@ -0,0 +1,48 @@
+package model
+
+import (
+	"io/ioutil"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/jinzhu/gorm"
+)
+
+func TestRegisterChecksExpiryWhenUsed(t *testing.T) {
+	db := newTestDB(t)
+	root, err := CreateUser(db, "root", "root-password1", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	invite, token, err := CreateInvite(db, root, false, 5, now.Add(time.Hour), now)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The invite expires while the user is made, after it is found.
+	db.SetLogger(gorm.Logger{LogWriter: log.New(ioutil.Discard, "", 0)})
+	expired := false
+	db.Callback().Query().After("gorm:query").Register("test:expire_invite", func(scope *gorm.Scope) {
+		if scope.TableName() == "users" && !expired {
+			expired = true
+			db.Model(invite).UpdateColumn("expires_at", now.Add(-time.Minute))
+		}
+	})
+	if _, _, err := Register(db, token, "alice", "alice-password1", "127.0.0.1", "test", now); err == nil {
+		t.Fatal("registered by the expired invite")
+	}
+	db.Callback().Query().Remove("test:expire_invite")
+
+	if !db.Where("name = ?", "alice").First(&User{}).RecordNotFound() {
+		t.Error("alice is created")
+	}
+	used := new(Invite)
+	if err := db.First(used, invite.ID).Error; err != nil {
+		t.Fatal(err)
+	}
+	if used.Uses != 0 {
+		t.Errorf("uses = %d, want 0", used.Uses)
+	}
+}
@@ -0,0 +1,414 @@
+package model
+
+import (
+	"strconv"
+	"time"
+
+	pw "github.com/Code-Hex/vegeta/internal/password"
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+)
+
+// Reasons of failed logins in LoginAttempt.
+const (
+	LoginUnknownUser      = "unknown_user"
+	LoginPasswordMismatch = "password_mismatch"
+	LoginLocked           = "locked"
+	LoginIPLocked         = "ip_locked"
+	// LoginTOTPRequired is recorded when the password is correct and
+	// the second factor is asked. It is not a failure.
+	LoginTOTPRequired = "totp_required"
+	LoginTOTPMismatch = "totp_mismatch"
+	// LoginOIDCConflict is recorded when the name from the provider
+	// is used by another user.
+	LoginOIDCConflict = "oidc_conflict"
+	LoginSuspended    = "suspended"
+)
+
+// LoginAttempt is the audit trail of logins. UserID is 0 if the name
+// does not match any user.
+type LoginAttempt struct {
+	ID        uint      `gorm:"primary_key"`
+	UserID    uint      `gorm:"not null;index:idx_login_user"`
+	Name      string    `gorm:"not null"`
+	IPAddress string    `gorm:"not null;index:idx_login_ip"`
+	UserAgent string    `gorm:"not null"`
+	Success   bool      `gorm:"not null"`
+	Reason    string    `gorm:"not null"`
+	CreatedAt time.Time `gorm:"not null"`
+}
+
+// start records the attempt as a failure before it is checked, so that
+// concurrent attempts from the address count each other.
+func (a *LoginAttempt) start(db *gorm.DB) error {
+	if err := db.Create(a).Error; err != nil {
+		return errors.Wrap(err, "Failed to record login")
+	}
+	return nil
+}
+
+func (a *LoginAttempt) record(db *gorm.DB, user *User, err error) (*User, error) {
+	if e := db.Save(a).Error; e != nil {
+		return nil, errors.Wrap(e, "Failed to record login")
+	}
+	return user, err
+}
+
+// Throttle decides how long logins are refused after failures. Failures
+// more than the free attempts double the delay up to MaxDelay, which is
+// a temporary lockout. Failures from an address are counted in IPWindow.
+type Throttle struct {
+	FreeAttempts   int
+	BaseDelay      time.Duration
+	MaxDelay       time.Duration
+	IPFreeAttempts int
+	IPWindow       time.Duration
+}
+
+func (t *Throttle) delay(failures, free int) time.Duration {
+	if failures < free {
+		return 0
+	}
+	d := t.BaseDelay
+	for i := free; i < failures && d < t.MaxDelay; i++ {
+		d *= 2
+	}
+	if d > t.MaxDelay {
+		d = t.MaxDelay
+	}
+	return d
+}
+
+// ipLockedUntil returns when the address of the attempt can try again.
+// Other attempts in progress are counted as failures.
+func (t *Throttle) ipLockedUntil(db *gorm.DB, attempt *LoginAttempt, now time.Time) (time.Time, error) {
+	q := db.Model(&LoginAttempt{}).Where(
+		"id <> ? AND ip_address = ? AND success = ? AND reason NOT IN (?) AND created_at > ?",
+		attempt.ID, attempt.IPAddress, false, []string{LoginIPLocked, LoginTOTPRequired}, now.Add(-t.IPWindow),
+	)
+	var n int
+	if err := q.Count(&n).Error; err != nil {
+		return time.Time{}, err
+	}
+	if n < t.IPFreeAttempts {
+		return time.Time{}, nil
+	}
+	last := new(LoginAttempt)
+	if err := q.Order("created_at desc").First(last).Error; err != nil {
+		return time.Time{}, err
+	}
+	return last.CreatedAt.Add(t.delay(n, t.IPFreeAttempts)), nil
+}
+
+func (t *Throttle) checkIP(db *gorm.DB, attempt *LoginAttempt, now time.Time) error {
+	until, err := t.ipLockedUntil(db, attempt, now)
+	if err != nil {
+		return err
+	}
+	if now.Before(until) {
+		attempt.Reason = LoginIPLocked
+		return newError(ErrLocked, "Too many failed logins from %s", attempt.IPAddress)
+	}
+	return nil
+}
+
+// Login authenticates the user by the password unless the user or the address
+// is locked by failures. Every attempt is recorded. If the user has TOTP,
+// the login must be finished by LoginSecondFactor.
+func Login(db *gorm.DB, t *Throttle, name, pass, ip, userAgent string, now time.Time) (*User, error) {
+	attempt := &LoginAttempt{
+		Name:      name,
+		IPAddress: ip,
+		UserAgent: userAgent,
+		CreatedAt: now,
+	}
+	if err := attempt.start(db); err != nil {
+		return nil, err
+	}
+	user, err := login(db, t, attempt, pass, now)
+	return attempt.record(db, user, err)
+}
+
+func login(db *gorm.DB, t *Throttle, attempt *LoginAttempt, pass string, now time.Time) (*User, error) {
+	if err := t.checkIP(db, attempt, now); err != nil {
+		return nil, err
+	}
+	user := new(User)
+	if db.First(user, "name = ?", attempt.Name).RecordNotFound() {
+		pw.VerifyDummy(pass)
+		attempt.Reason = LoginUnknownUser
+		return nil, newError(ErrUnauthorized, "Invalid user: Username mismatch")
+	}
+	attempt.UserID = user.ID
+	r, err := user.reserve(db, t, now)
+	if err != nil {
+		if errors.Cause(err) == ErrLocked {
+			attempt.Reason = LoginLocked
+		}
+		return nil, err
+	}
+	authed, err := BasicAuth(db, attempt.Name, pass)
+	if errors.Cause(err) == ErrUnauthorized {
+		attempt.Reason = LoginPasswordMismatch
+		return nil, err
+	}
+	if err != nil {
+		return nil, r.cancel(db, err)
+	}
+	// The password is checked first, so that the state is not told to
+	// others.
+	if authed.IsSuspended() {
+		attempt.Reason = LoginSuspended
+		return nil, r.cancel(db, newError(ErrSuspended, "User %s is suspended", authed.Name))
+	}
+	if authed.TOTPEnabled {
+		// Failures are not reset until the second factor passes.
+		attempt.Reason = LoginTOTPRequired
+		return authed, r.cancel(db, nil)
+	}
+	attempt.Success = true
+	return authed, authed.succeed(db)
+}
+
+// LoginSecondFactor finishes the login of the user who has passed Login
+// by a TOTP code or a recovery code. Failures are throttled together with
+// failures of the password.
+func LoginSecondFactor(db *gorm.DB, t *Throttle, userID uint, code, ip, userAgent string, now time.Time) (*User, error) {
+	attempt := &LoginAttempt{
+		UserID:    userID,
+		IPAddress: ip,
+		UserAgent: userAgent,
+		CreatedAt: now,
+	}
+	if err := attempt.start(db); err != nil {
+		return nil, err
+	}
+	user, err := loginSecondFactor(db, t, attempt, code, now)
+	return attempt.record(db, user, err)
+}
+
+func loginSecondFactor(db *gorm.DB, t *Throttle, attempt *LoginAttempt, code string, now time.Time) (*User, error) {
+	if err := t.checkIP(db, attempt, now); err != nil {
+		return nil, err
+	}
+	user, err := FindUser(db, attempt.UserID)
+	if err != nil {
+		attempt.Reason = LoginUnknownUser
+		return nil, newError(ErrUnauthorized, "Invalid user: %d", attempt.UserID)
+	}
+	attempt.Name = user.Name
+	if user.IsSuspended() {
+		attempt.Reason = LoginSuspended
+		return nil, newError(ErrSuspended, "User %s is suspended", user.Name)
+	}
+	r, err := user.reserve(db, t, now)
+	if err != nil {
+		if errors.Cause(err) == ErrLocked {
+			attempt.Reason = LoginLocked
+		}
+		return nil, err
+	}
+	err = user.VerifySecondFactor(db, code, now)
+	if errors.Cause(err) == ErrUnauthorized {
+		attempt.Reason = LoginTOTPMismatch
+		return nil, err
+	}
+	if err != nil {
+		return nil, r.cancel(db, err)
+	}
+	attempt.Success = true
+	return user, user.succeed(db)
+}
+
+// ConfirmSecondFactor verifies the code before a change of the second
+// factor. Failures are throttled as failed logins.
+func (u *User) ConfirmSecondFactor(db *gorm.DB, t *Throttle, code string, now time.Time) error {
+	r, err := u.reserve(db, t, now)
+	if err != nil {
+		return err
+	}
+	err = u.VerifySecondFactor(db, code, now)
+	if errors.Cause(err) == ErrUnauthorized {
+		return err
+	}
+	return r.cancel(db, err)
+}
+
+// reservation is a failure which is counted before the password or the
+// code is verified. It is cancelled if they are correct.
+type reservation struct {
+	user        *User
+	failures    int
+	lockedUntil *time.Time
+}
+
+// reserve counts a failure of the user unless the user is locked, so that
+// concurrent guesses can not pass the lock together. The row is locked by
+// the update until the new lock is saved.
+func (u *User) reserve(db *gorm.DB, t *Throttle, now time.Time) (*reservation, error) {
+	r := &reservation{user: u, lockedUntil: u.LockedUntil}
+	tx := db.Begin()
+	res := tx.Model(u).
+		Where("locked_until IS NULL OR locked_until <= ?", now).
+		UpdateColumn("failed_logins", gorm.Expr("failed_logins + 1"))
+	if res.Error != nil {
+		tx.Rollback()
+		return nil, res.Error
+	}
+	if res.RowsAffected == 0 {
+		tx.Rollback()
+		return nil, newError(ErrLocked, "User %s is locked", u.Name)
+	}
+	row := tx.Model(&User{}).Where("id = ?", u.ID).Select("failed_logins").Row()
+	if err := row.Scan(&r.failures); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	u.FailedLogins = r.failures
+	if d := t.delay(r.failures, t.FreeAttempts); d > 0 {
+		lockedUntil := now.Add(d)
+		if err := tx.Model(u).UpdateColumn("locked_until", lockedUntil).Error; err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+		u.LockedUntil = &lockedUntil
+	}
+	if err := tx.Commit().Error; err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// cancel takes back the failure, and returns err if it succeeds. The lock
+// is restored unless other failures are counted after the reservation.
+func (r *reservation) cancel(db *gorm.DB, err error) error {
+	res := db.Model(r.user).Where("failed_logins = ?", r.failures).UpdateColumns(map[string]interface{}{
+		"failed_logins": r.failures - 1,
+		"locked_until":  r.lockedUntil,
+	})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		e := db.Model(r.user).UpdateColumn("failed_logins", gorm.Expr("failed_logins - 1")).Error
+		if e != nil {
+			return e
+		}
+	}
+	return err
+}
+
+// succeed resets failures of the user.
+func (u *User) succeed(db *gorm.DB) error {
+	if u.FailedLogins == 0 && u.LockedUntil == nil {
+		return nil
+	}
+	u.FailedLogins = 0
+	u.LockedUntil = nil
+	return u.updateLock(db, nil)
+}
+
+// updateLock saves the lock state, and returns err if it succeeds.
+func (u *User) updateLock(db *gorm.DB, err error) error {
+	e := db.Model(u).UpdateColumns(map[string]interface{}{
+		"failed_logins": u.FailedLogins,
+		"locked_until":  u.LockedUntil,
+	}).Error
+	if e != nil {
+		return e
+	}
+	return err
+}
+
+// IsLocked reports whether the user can not log in at now.
+func (u *User) IsLocked(now time.Time) bool {
+	return u.LockedUntil != nil && now.Before(*u.LockedUntil)
+}
+
+// UnlockUser clears failed logins of the user.
+func UnlockUser(db *gorm.DB, userID string) (*User, error) {
+	id, err := strconv.ParseInt(userID, 10, 64)
+	if err != nil {
+		return nil, newError(ErrInvalid, "Invalid user id: %s", userID)
+	}
+	user := &User{}
+	if db.First(user, id).RecordNotFound() {
+		return nil, newError(ErrNotFound, "UserID: %d is not found", id)
+	}
+	user.FailedLogins = 0
+	user.LockedUntil = nil
+	if err := user.updateLock(db, nil); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// IsSuspended reports whether the user is suspended.
+func (u *User) IsSuspended() bool {
+	return u.SuspendedAt != nil
+}
+
+// SuspendUser stops the user from logging in and using tokens. Sessions
+// and refresh tokens of the user are revoked.
+func SuspendUser(db *gorm.DB, userID string, now time.Time) (*User, error) {
+	id, err := strconv.ParseInt(userID, 10, 64)
+	if err != nil {
+		return nil, newError(ErrInvalid, "Invalid user id: %s", userID)
+	}
+	if id == 1 {
+		return nil, newError(ErrForbidden, "Can not suspend UserID: 1")
+	}
+	user := &User{}
+	if db.First(user, id).RecordNotFound() {
+		return nil, newError(ErrNotFound, "UserID: %d is not found", id)
+	}
+	if user.IsSuspended() {
+		return user, nil
+	}
+	tx := db.Begin()
+	if err := tx.Model(user).UpdateColumn("suspended_at", now).Error; err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if err := RevokeSessions(tx, user.ID); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if err := RevokeRefreshTokens(tx, user.ID, now); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	tx.Commit()
+	user.SuspendedAt = &now
+	return user, nil
+}
+
+// ResumeUser lets the suspended user log in again.
+func ResumeUser(db *gorm.DB, userID string) (*User, error) {
+	id, err := strconv.ParseInt(userID, 10, 64)
+	if err != nil {
+		return nil, newError(ErrInvalid, "Invalid user id: %s", userID)
+	}
+	user := &User{}
+	if db.First(user, id).RecordNotFound() {
+		return nil, newError(ErrNotFound, "UserID: %d is not found", id)
+	}
+	if err := db.Model(user).UpdateColumn("suspended_at", nil).Error; err != nil {
+		return nil, err
+	}
+	user.SuspendedAt = nil
+	return user, nil
+}
+
+// GetLoginAttempts returns the latest logins of the user.
+func GetLoginAttempts(db *gorm.DB, userID uint, limit int) ([]*LoginAttempt, error) {
+	var attempts []*LoginAttempt
+	err := db.Where("user_id = ?", userID).
+		Order("created_at desc").
+		Limit(limit).
+		Find(&attempts).Error
+	if err != nil {
+		return nil, err
+	}
+	return attempts, nil
+}
@@ -0,0 +1,137 @@
+package model
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	_ "github.com/jinzhu/gorm/dialects/sqlite"
+)
+
+func newTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	db.LogMode(false)
+	// Each connection of :memory: has its own database.
+	db.DB().SetMaxOpenConns(1)
+	for _, m := range []interface{}{
+		&User{},
+		&Tag{},
+		&Data{},
+		&Session{},
+		&LoginAttempt{},
+		&RecoveryCode{},
+		&AuditLog{},
+		&RefreshToken{},
+		&Invite{},
+		&InviteUse{},
+	} {
+		// Names of indexes are shared by all tables in SQLite, so the
+		// second idx_name fails. Indexes are not needed in tests.
+		db.AutoMigrate(m)
+	}
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+func countAttempts(t *testing.T, db *gorm.DB, reason string) int {
+	t.Helper()
+	var n int
+	if err := db.Model(&LoginAttempt{}).Where("reason = ?", reason).Count(&n).Error; err != nil {
+		t.Fatal(err)
+	}
+	return n
+}
+
+// loginAtOnce tries n logins at once. attempt returns the name and
+// the address of each.
+func loginAtOnce(db *gorm.DB, th *Throttle, n int, attempt func(i int) (string, string), now time.Time) {
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			name, ip := attempt(i)
+			Login(db, th, name, "wrong-password", ip, "test", now)
+		}(i)
+	}
+	wg.Wait()
+}
+
+func TestLoginConcurrentGuessesOfUser(t *testing.T) {
+	db := newTestDB(t)
+	if _, err := CreateUser(db, "alice", "alice-password1", false); err != nil {
+		t.Fatal(err)
+	}
+	th := &Throttle{FreeAttempts: 3, BaseDelay: time.Minute, MaxDelay: time.Hour, IPFreeAttempts: 100, IPWindow: time.Hour}
+	now := time.Now()
+
+	loginAtOnce(db, th, 20, func(i int) (string, string) {
+		return "alice", fmt.Sprintf("192.0.2.%d", i)
+	}, now)
+
+	if n := countAttempts(t, db, LoginPasswordMismatch); n != th.FreeAttempts {
+		t.Errorf("%d passwords are verified, want %d", n, th.FreeAttempts)
+	}
+	user, err := FindUserByName(db, "alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if user.FailedLogins != th.FreeAttempts {
+		t.Errorf("failed logins = %d, want %d", user.FailedLogins, th.FreeAttempts)
+	}
+	if _, err := Login(db, th, "alice", "alice-password1", "192.0.2.100", "test", now); err == nil {
+		t.Error("locked user can log in")
+	}
+}
+
+func TestLoginConcurrentGuessesFromIP(t *testing.T) {
+	db := newTestDB(t)
+	if _, err := CreateUser(db, "alice", "alice-password1", false); err != nil {
+		t.Fatal(err)
+	}
+	th := &Throttle{FreeAttempts: 100, BaseDelay: time.Minute, MaxDelay: time.Hour, IPFreeAttempts: 3, IPWindow: time.Hour}
+
+	loginAtOnce(db, th, 20, func(i int) (string, string) {
+		return "alice", "192.0.2.1"
+	}, time.Now())
+
+	if n := countAttempts(t, db, LoginPasswordMismatch); n > th.IPFreeAttempts {
+		t.Errorf("%d attempts are checked, want at most %d", n, th.IPFreeAttempts)
+	}
+	if n := countAttempts(t, db, LoginIPLocked); n < 20-th.IPFreeAttempts {
+		t.Errorf("%d attempts are refused, want at least %d", n, 20-th.IPFreeAttempts)
+	}
+}
+
+// The correct password of users who have TOTP does not count as a failure.
+func TestLoginTOTPRequiredIsNotFailure(t *testing.T) {
+	db := newTestDB(t)
+	user, err := CreateUser(db, "alice", "alice-password1", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Model(user).UpdateColumn("totp_enabled", true).Error; err != nil {
+		t.Fatal(err)
+	}
+	th := &Throttle{FreeAttempts: 3, BaseDelay: time.Minute, MaxDelay: time.Hour, IPFreeAttempts: 100, IPWindow: time.Hour}
+	now := time.Now()
+	for i := 0; i < th.FreeAttempts-1; i++ {
+		Login(db, th, "alice", "wrong-password", "192.0.2.1", "test", now)
+	}
+	if _, err := Login(db, th, "alice", "alice-password1", "192.0.2.1", "test", now); err != nil {
+		t.Fatal(err)
+	}
+	user, err = FindUserByName(db, "alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if user.FailedLogins != th.FreeAttempts-1 || user.IsLocked(now) {
+		t.Errorf("failed logins = %d, locked = %v", user.FailedLogins, user.IsLocked(now))
+	}
+}
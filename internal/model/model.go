@@ -1,13 +1,12 @@
 package model
 
 import (
-	"crypto/sha256"
 	"fmt"
 	"strconv"
 	"time"
 	"unicode"
 
-	"github.com/Code-Hex/saltissimo"
+	pw "github.com/Code-Hex/vegeta/internal/password"
 	"github.com/Code-Hex/vegeta/internal/utils"
 	"github.com/jinzhu/gorm"
 	"github.com/pkg/errors"
@@ -28,9 +27,31 @@ type User struct {
 	Admin    bool   `gorm:"not null"`
 	Name     string `gorm:"not null;index:idx_name"`
 	Password string `gorm:"not null"`
-	Salt     string `gorm:"not null"`
-	Token    string `gorm:"not null"`
-	Tags     []Tag  `gorm:"ForeignKey:UserID"`
+	// PasswordAlgorithm is the algorithm of Password. Salt is used
+	// only by the legacy algorithm, which is empty here.
+	PasswordAlgorithm string `gorm:"not null;default:''"`
+	Salt              string `gorm:"not null"`
+	Token             string `gorm:"not null"`
+	// FailedLogins counts failed logins since the last success, and
+	// the user can not log in until LockedUntil.
+	FailedLogins int `gorm:"not null;default:0"`
+	LockedUntil  *time.Time
+	// SuspendedAt is set while the user can not log in or use tokens.
+	// The data is kept until the user is deleted.
+	SuspendedAt *time.Time
+	// TOTPSecret is set when enrollment of TOTP starts, and TOTPEnabled
+	// when it is finished. TOTPLastStep refuses a code to be used twice.
+	TOTPSecret   string `gorm:"not null;default:''"`
+	TOTPEnabled  bool   `gorm:"not null;default:false"`
+	TOTPLastStep int64  `gorm:"not null;default:0"`
+	// OIDCIssuer and OIDCSubject link the user to the account of
+	// an OpenID Connect provider.
+	OIDCIssuer  string `gorm:"column:oidc_issuer;not null;default:'';index:idx_oidc"`
+	OIDCSubject string `gorm:"column:oidc_subject;not null;default:'';index:idx_oidc"`
+	// Locale is the language which the user prefers. It is chosen by
+	// Accept-Language if empty.
+	Locale string `gorm:"not null;default:''"`
+	Tags   []Tag  `gorm:"ForeignKey:UserID"`
 }
 
 type Tag struct {
@@ -48,26 +69,34 @@ type Data struct {
 
 	TagID      uint   `json:"-" gorm:"not null"`
 	RemoteAddr string `json:"remote_addr" gorm:"not null"`
+	PeerAddr   string `json:"peer_addr" gorm:"not null"`
 	Hostname   string `json:"hostname" gorm:"not null"`
 	Payload    string `json:"payload" gorm:"not null" sql:"type:text;"`
 }
 
+// Migrate creates or updates tables for all models.
+func Migrate(db *gorm.DB) error {
+	return db.AutoMigrate(
+		&User{},
+		&Tag{},
+		&Data{},
+		&Session{},
+		&LoginAttempt{},
+		&RecoveryCode{},
+		&AuditLog{},
+		&RefreshToken{},
+		&Invite{},
+		&InviteUse{},
+	).Error
+}
+
 // Completed modeles
 
 func CreateUser(db *gorm.DB, name, password string, isAdmin bool) (*User, error) {
-	user := &User{}
-	if user.AlreadyExist(db, name) {
-		return nil, errors.New("User " + name + " already exist")
-	}
-	hashed, key, err := saltissimo.HexHash(sha256.New, password)
+	user, err := newUser(db, name, password, isAdmin)
 	if err != nil {
 		return nil, err
 	}
-	user.Name = name
-	user.Password = hashed
-	user.Salt = key
-	user.Token = utils.GenerateUUID()
-	user.Admin = isAdmin
 
 	tx := db.Begin()
 	if err := tx.Create(user).Error; err != nil {
@@ -79,24 +108,43 @@ func CreateUser(db *gorm.DB, name, password string, isAdmin bool) (*User, error)
 	return user, nil
 }
 
+// newUser checks the name and the password, and returns the user
+// which is not saved yet.
+func newUser(db *gorm.DB, name, password string, isAdmin bool) (*User, error) {
+	user := &User{}
+	if user.AlreadyExist(db, name) {
+		return nil, newError(ErrAlreadyExists, "User %s already exist", name)
+	}
+	if err := pw.Check(name, password); err != nil {
+		return nil, err
+	}
+	user.Name = name
+	if err := user.setPassword(password); err != nil {
+		return nil, err
+	}
+	user.Token = utils.GenerateUUID()
+	user.Admin = isAdmin
+	return user, nil
+}
+
 func EditUser(db *gorm.DB, userID string, isAdmin bool, resetPassword string) (*User, error) {
 	id, err := strconv.ParseInt(userID, 10, 64)
 	if err != nil {
-		return nil, errors.Wrap(err, "Failed to validate user-id")
+		return nil, newError(ErrInvalid, "Invalid user id: %s", userID)
 	}
 	user := &User{}
 	if db.First(user, id).RecordNotFound() {
-		return nil, errors.Errorf("UserID: %d is not found", id)
+		return nil, newError(ErrNotFound, "UserID: %d is not found", id)
 	}
 	if id != 1 {
 		user.Admin = isAdmin
 	}
 
+	// The password is generated, so that the policy is not checked.
 	if resetPassword != "" {
-		if _, err := user.UpdatePassword(db, resetPassword); err != nil {
+		if err := user.setPassword(resetPassword); err != nil {
 			return nil, err
 		}
-		return user, nil
 	}
 
 	tx := db.Begin()
@@ -109,20 +157,46 @@ func EditUser(db *gorm.DB, userID string, isAdmin bool, resetPassword string) (*
 	return user, nil
 }
 
-func DeleteUser(db *gorm.DB, userID string) (*User, error) {
+// DeleteUser removes the user with tags, data and everything of the user,
+// which can not be restored. confirm must be the name of the user. Audit
+// logs and uses of invites are kept. Users who were soft-deleted by older
+// versions are removed too.
+func DeleteUser(db *gorm.DB, userID, confirm string) (*User, error) {
 	id, err := strconv.ParseInt(userID, 10, 64)
 	if err != nil {
-		return nil, errors.Wrap(err, "Failed to validate user-id")
+		return nil, newError(ErrInvalid, "Invalid user id: %s", userID)
 	}
 	if id == 1 {
-		return nil, errors.New("Can not delete UserID: 1")
+		return nil, newError(ErrForbidden, "Can not delete UserID: 1")
 	}
 	user := &User{}
-	if db.First(user, id).RecordNotFound() {
-		return nil, errors.Errorf("UserID: %d is not found", id)
+	if db.Unscoped().First(user, id).RecordNotFound() {
+		return nil, newError(ErrNotFound, "UserID: %d is not found", id)
+	}
+	if confirm != user.Name {
+		return nil, newError(ErrInvalid, "Confirmation does not match the name of UserID: %d", id)
 	}
+
 	tx := db.Begin()
-	if err := db.Delete(user).Error; err != nil {
+	// Tags are selected in the same statement so that data of a tag which
+	// is added meanwhile are not left.
+	if err := tx.Unscoped().Delete(&Data{}, "tag_id IN (SELECT id FROM tags WHERE user_id = ?)", user.ID).Error; err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	for _, m := range []interface{}{
+		&Tag{},
+		&Session{},
+		&RecoveryCode{},
+		&RefreshToken{},
+		&LoginAttempt{},
+	} {
+		if err := tx.Unscoped().Delete(m, "user_id = ?", user.ID).Error; err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+	if err := tx.Unscoped().Delete(user).Error; err != nil {
 		tx.Rollback()
 		return nil, err
 	}
@@ -135,7 +209,7 @@ func TokenAuth(db *gorm.DB, uuid string) (*User, error) {
 	user := new(User)
 	result := db.First(user, "token = ?", uuid)
 	if err := result.Related(&user.Tags, "Tags").Error; err != nil {
-		return nil, errors.Errorf("Failed to authenticate token: %s", uuid)
+		return nil, newError(ErrUnauthorized, "Failed to authenticate token")
 	}
 	return user, nil
 }
@@ -144,15 +218,26 @@ func BasicAuth(db *gorm.DB, name, pass string) (*User, error) {
 	user := new(User)
 	result := db.First(user, "name = ?", name)
 	if result.RecordNotFound() {
-		return nil, errors.Wrap(errors.New("Username mismatch"), "Invalid user")
+		pw.VerifyDummy(pass)
+		return nil, newError(ErrUnauthorized, "Invalid user: Username mismatch")
 	}
-	hash, key := user.Password, user.Salt
-	ok, err := saltissimo.CompareHexHash(sha256.New, pass, hash, key)
+	ok, err := pw.Verify(user.PasswordAlgorithm, user.Password, user.Salt, pass)
 	if err != nil {
 		return nil, errors.Wrap(err, "Invalid user")
 	}
 	if !ok {
-		return nil, errors.Wrap(errors.New("Password mismatch"), "Invalid user")
+		return nil, newError(ErrUnauthorized, "Invalid user: Password mismatch")
+	}
+	// Upgrade the hash while the password is known. The login is not
+	// failed even if it can not be saved.
+	if pw.NeedsRehash(user.PasswordAlgorithm, user.Password) {
+		if err := user.setPassword(pass); err == nil {
+			db.Model(user).Updates(map[string]interface{}{
+				"password":           user.Password,
+				"password_algorithm": user.PasswordAlgorithm,
+				"salt":               user.Salt,
+			})
+		}
 	}
 	if err := db.Model(user).Related(&user.Tags).Error; err != nil {
 		return nil, err
@@ -160,13 +245,14 @@ func BasicAuth(db *gorm.DB, name, pass string) (*User, error) {
 	return user, nil
 }
 
+// UpdatePassword sets the password which satisfies the policy.
 func (u *User) UpdatePassword(db *gorm.DB, password string) (*User, error) {
-	hashed, key, err := saltissimo.HexHash(sha256.New, password)
-	if err != nil {
+	if err := pw.Check(u.Name, password); err != nil {
+		return nil, err
+	}
+	if err := u.setPassword(password); err != nil {
 		return nil, err
 	}
-	u.Password = hashed
-	u.Salt = key
 
 	tx := db.Begin()
 	if err := tx.Save(u).Error; err != nil {
@@ -178,6 +264,24 @@ func (u *User) UpdatePassword(db *gorm.DB, password string) (*User, error) {
 	return u, nil
 }
 
+func (u *User) setPassword(password string) error {
+	algorithm, hashed, err := pw.Hash(password)
+	if err != nil {
+		return err
+	}
+	u.Password = hashed
+	u.PasswordAlgorithm = algorithm
+	u.Salt = ""
+	return nil
+}
+
+func (u *User) UpdateLocale(db *gorm.DB, locale string) (*User, error) {
+	if err := db.Model(u).Update("locale", locale).Error; err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
 func (u *User) AlreadyExist(db *gorm.DB, name string) bool {
 	return !db.First(u, "name = ?", name).RecordNotFound()
 }
@@ -185,7 +289,7 @@ func (u *User) AlreadyExist(db *gorm.DB, name string) bool {
 func FindUserByName(db *gorm.DB, name string) (*User, error) {
 	user := new(User)
 	if !user.AlreadyExist(db, name) {
-		return nil, errors.New("Not found: " + name)
+		return nil, newError(ErrNotFound, "Not found: %s", name)
 	}
 	if err := db.Model(user).Related(&user.Tags, "Tags").Error; err != nil {
 		return nil, err
@@ -193,6 +297,31 @@ func FindUserByName(db *gorm.DB, name string) (*User, error) {
 	return user, nil
 }
 
+func FindUserByID(db *gorm.DB, userID string) (*User, error) {
+	id, err := strconv.ParseInt(userID, 10, 64)
+	if err != nil {
+		return nil, newError(ErrInvalid, "Invalid user id: %s", userID)
+	}
+	user := new(User)
+	if db.First(user, id).RecordNotFound() {
+		return nil, newError(ErrNotFound, "UserID: %d is not found", id)
+	}
+	if err := db.Model(user).Related(&user.Tags, "Tags").Error; err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// FindUser returns the user without tags. It is used on each request
+// to check the user of the session.
+func FindUser(db *gorm.DB, id uint) (*User, error) {
+	user := new(User)
+	if db.First(user, id).RecordNotFound() {
+		return nil, newError(ErrNotFound, "UserID: %d is not found", id)
+	}
+	return user, nil
+}
+
 func isValidString(str string) bool {
 	if str == "" {
 		return false
@@ -219,10 +348,10 @@ func (u *User) ReGenerateUserToken(db *gorm.DB) (*User, error) {
 func (u *User) AddTag(db *gorm.DB, name string) error {
 	tag := &Tag{Name: name}
 	if !isValidString(tag.Name) {
-		return errors.Errorf("Invalid tag name: %s", tag.Name)
+		return newError(ErrInvalid, "Invalid tag name: %s", tag.Name)
 	}
 	if !db.Find(&Tag{}, "name = ? and user_id = ?", tag.Name, u.ID).RecordNotFound() {
-		return errors.Errorf("Tag %s is already exist", tag.Name)
+		return newError(ErrAlreadyExists, "Tag %s is already exist", tag.Name)
 	}
 	tx := db.Begin()
 	asn := tx.Model(u).Association("Tags")
@@ -240,10 +369,10 @@ func (u *User) AddTag(db *gorm.DB, name string) error {
 
 func (u *User) RemoveTag(db *gorm.DB, name string) error {
 	if !isValidString(name) {
-		return errors.Errorf("Invalid tag name: %s", name)
+		return newError(ErrInvalid, "Invalid tag name: %s", name)
 	}
 	if db.Find(&Tag{}, "name = ? and user_id = ?", name, u.ID).RecordNotFound() {
-		return errors.Errorf("Tag %s is not found", name)
+		return newError(ErrNotFound, "Tag %s is not found", name)
 	}
 	tx := db.Begin()
 	if err := tx.Delete(&Tag{}, "name = ? and user_id = ?", name, u.ID).Error; err != nil {
@@ -258,7 +387,7 @@ func (u *User) FindByTagName(db *gorm.DB, name string) (*Tag, error) {
 	tag := &Tag{}
 	result := db.Model(u).Related(&u.Tags, "Tags").Where("name = ?", name).Find(tag)
 	if result.RecordNotFound() {
-		return nil, errors.Errorf(`User %s's tag "%s" is not found`, u.Name, name)
+		return nil, newError(ErrNotFound, `User %s's tag "%s" is not found`, u.Name, name)
 	}
 	return tag, nil
 }
@@ -274,31 +403,57 @@ func FindTagByID(db *gorm.DB, id uint) (*Tag, error) {
 	return tag, nil
 }
 
+// EachData calls fn with data of the tag in order of ID. Rows are read by
+// a cursor, so that all data of the tag are not kept in memory.
+func (t *Tag) EachData(db *gorm.DB, fn func(*Data) error) error {
+	rows, err := db.Model(&Data{}).Where("tag_id = ?", t.ID).Order("id").Rows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		data := new(Data)
+		if err := db.ScanRows(rows, data); err != nil {
+			return err
+		}
+		if err := fn(data); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
 type FindDataParam struct {
-	ID, Page, Limit      uint
-	Span, StartAt, EndAt string
-	Asc                  bool
+	ID, Page, Limit uint
+	Span            string
+	// StartAt and EndAt limit the span "all" if they are not zero.
+	StartAt, EndAt time.Time
+	Asc            bool
 }
 
 func FindDataByTagID(db *gorm.DB, param FindDataParam) ([]Data, error) {
 	tag := new(Tag)
 	if db.First(tag, param.ID).RecordNotFound() {
-		return nil, errors.Errorf("Tag id: %d is not found", param.ID)
+		return nil, newError(ErrNotFound, "Tag id: %d is not found", param.ID)
 	}
 
-	var termCondition string
+	var (
+		termCondition string
+		args          = []interface{}{param.ID}
+	)
 	switch param.Span {
 	case week:
 		termCondition = "and d.updated_at > date_sub(now(), INTERVAL 1 week)\n"
 	case month:
 		termCondition = "and d.updated_at > date_sub(now(), INTERVAL 1 month)\n"
 	default: // all
-		if param.StartAt != "" && param.EndAt != "" {
-			termCondition = fmt.Sprintf(
-				"and d.updated_at between '%s' and '%s'\n",
-				param.StartAt,
-				param.EndAt,
-			)
+		if !param.StartAt.IsZero() {
+			termCondition += "and d.updated_at >= ?\n"
+			args = append(args, param.StartAt)
+		}
+		if !param.EndAt.IsZero() {
+			termCondition += "and d.updated_at <= ?\n"
+			args = append(args, param.EndAt)
 		}
 	}
 
@@ -312,6 +467,7 @@ d.id,
 d.created_at,
 d.updated_at,
 d.remote_addr,
+d.peer_addr,
 d.hostname,
 d.payload from data as d
 left join tags as t on d.tag_id = t.id
@@ -320,7 +476,7 @@ order by d.updated_at %s limit ? offset ?`, termCondition, orderBy)
 
 	offset := param.Page * param.Limit
 	someData := make([]Data, 0, param.Limit)
-	rows, err := db.Raw(query, param.ID, param.Limit, offset).Rows()
+	rows, err := db.Raw(query, append(args, param.Limit, offset)...).Rows()
 	if err != nil {
 		return nil, err
 	}
@@ -333,6 +489,7 @@ order by d.updated_at %s limit ? offset ?`, termCondition, orderBy)
 			&data.CreatedAt,
 			&data.UpdatedAt,
 			&data.RemoteAddr,
+			&data.PeerAddr,
 			&data.Hostname,
 			&data.Payload,
 		)
@@ -343,10 +500,10 @@ order by d.updated_at %s limit ? offset ?`, termCondition, orderBy)
 
 func (t *Tag) AddData(db *gorm.DB, data Data) error {
 	if !utils.IsValidIPAddress(data.RemoteAddr) {
-		return errors.Errorf("Invalid ip address format: %s", data.RemoteAddr)
+		return newError(ErrInvalid, "Invalid ip address format: %s", data.RemoteAddr)
 	}
 	if !utils.IsValidJSON(data.Payload) {
-		return errors.Errorf("Invalid json format: %s", data.Payload)
+		return newError(ErrInvalid, "Invalid json format: %s", data.Payload)
 	}
 	tx := db.Begin()
 	asn := tx.Model(t).Association("SomeData")
@@ -362,6 +519,39 @@ func (t *Tag) AddData(db *gorm.DB, data Data) error {
 	return nil
 }
 
+// AddDataList stores data which has already been bound to its tag
+// in a single transaction.
+func AddDataList(db *gorm.DB, someData []Data) error {
+	for _, data := range someData {
+		if data.TagID == 0 {
+			return newError(ErrInvalid, "Data is not bound to any tag")
+		}
+		if !utils.IsValidIPAddress(data.RemoteAddr) {
+			return newError(ErrInvalid, "Invalid ip address format: %s", data.RemoteAddr)
+		}
+		if !utils.IsValidJSON(data.Payload) {
+			return newError(ErrInvalid, "Invalid json format: %s", data.Payload)
+		}
+	}
+	tx := db.Begin()
+	for i := range someData {
+		if err := tx.Create(&someData[i]).Error; err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+	tx.Commit()
+	return nil
+}
+
+func GetTags(db *gorm.DB) ([]*Tag, error) {
+	var tags []*Tag
+	if err := db.Order("user_id, name").Find(&tags).Error; err != nil {
+		return nil, err
+	}
+	return tags, nil
+}
+
 func GetUsers(db *gorm.DB) ([]*User, error) {
 	var users []*User
 	result := db.Find(&users)
@@ -0,0 +1,136 @@
+package model
+
+import (
+	"crypto/sha256"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/Code-Hex/saltissimo"
+	pw "github.com/Code-Hex/vegeta/internal/password"
+	"github.com/jinzhu/gorm"
+)
+
+func TestDeleteUserRemovesData(t *testing.T) {
+	db := newTestDB(t)
+	if _, err := CreateUser(db, "root", "root-password1", true); err != nil {
+		t.Fatal(err)
+	}
+	var tagIDs [2]uint
+	for i, name := range []string{"alice", "bob"} {
+		u, err := CreateUser(db, name, name+"-password1", false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := u.AddTag(db, "room"); err != nil {
+			t.Fatal(err)
+		}
+		tag, err := u.FindByTagName(db, "room")
+		if err != nil {
+			t.Fatal(err)
+		}
+		tagIDs[i] = tag.ID
+		if err := db.Create(&Data{TagID: tag.ID, RemoteAddr: "127.0.0.1", Payload: "{}"}).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	alice, err := FindUserByName(db, "alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := DeleteUser(db, strconv.FormatUint(uint64(alice.ID), 10), "alice"); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, want := range []int{0, 1} {
+		var n int
+		if err := db.Unscoped().Model(&Data{}).Where("tag_id = ?", tagIDs[i]).Count(&n).Error; err != nil {
+			t.Fatal(err)
+		}
+		if n != want {
+			t.Errorf("tag %d has %d data, want %d", tagIDs[i], n, want)
+		}
+	}
+}
+
+// legacyUser stores the password of the user by saltissimo, as users
+// before argon2id were.
+func legacyUser(t *testing.T, db *gorm.DB, name, pass string) *User {
+	t.Helper()
+	u, err := CreateUser(db, name, pass, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hash, salt, err := saltissimo.HexHash(sha256.New, pass)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = db.Model(u).Updates(map[string]interface{}{
+		"password":           hash,
+		"password_algorithm": "",
+		"salt":               salt,
+	}).Error
+	if err != nil {
+		t.Fatal(err)
+	}
+	return u
+}
+
+func storedPassword(t *testing.T, db *gorm.DB, id uint) *User {
+	t.Helper()
+	u := new(User)
+	if err := db.First(u, id).Error; err != nil {
+		t.Fatal(err)
+	}
+	return u
+}
+
+func TestBasicAuthRehashesLegacyPassword(t *testing.T) {
+	db := newTestDB(t)
+	u := legacyUser(t, db, "alice", "alice-password1")
+	before := storedPassword(t, db, u.ID)
+
+	if _, err := BasicAuth(db, "alice", "wrong-password1"); err == nil {
+		t.Fatal("wrong password is accepted")
+	}
+	if got := storedPassword(t, db, u.ID); got.Password != before.Password || got.PasswordAlgorithm != "" {
+		t.Errorf("wrong password rehashes to %q", got.PasswordAlgorithm)
+	}
+
+	if _, err := BasicAuth(db, "alice", "alice-password1"); err != nil {
+		t.Fatal(err)
+	}
+	got := storedPassword(t, db, u.ID)
+	if got.PasswordAlgorithm != pw.Argon2id || got.Salt != "" {
+		t.Errorf("algorithm = %q, salt = %q after login, want %s without salt", got.PasswordAlgorithm, got.Salt, pw.Argon2id)
+	}
+	if _, err := BasicAuth(db, "alice", "alice-password1"); err != nil {
+		t.Errorf("login after rehash: %v", err)
+	}
+}
+
+func TestUpdatePasswordChecksPolicy(t *testing.T) {
+	db := newTestDB(t)
+	u, err := CreateUser(db, "alice", "alice-password1", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	before := storedPassword(t, db, u.ID).Password
+	for _, pass := range []string{"short", "alice", strings.Repeat("a", 73)} {
+		if _, err := u.UpdatePassword(db, pass); err == nil {
+			t.Errorf("%q is accepted", pass)
+		} else if _, ok := err.(*pw.PolicyError); !ok {
+			t.Errorf("%q: err = %v, want a policy error", pass, err)
+		}
+	}
+	if storedPassword(t, db, u.ID).Password != before {
+		t.Error("password is changed by a rejected one")
+	}
+	if _, err := u.UpdatePassword(db, "new-password1"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := BasicAuth(db, "alice", "new-password1"); err != nil {
+		t.Errorf("login by the new password: %v", err)
+	}
+}
@@ -0,0 +1,107 @@
+package model
+
+import (
+	"time"
+
+	"github.com/Code-Hex/vegeta/internal/utils"
+	"github.com/jinzhu/gorm"
+)
+
+// Identity is the user which an OpenID Connect provider asserts.
+type Identity struct {
+	Issuer  string
+	Subject string
+	Name    string
+	// Admin is nil if the provider does not decide admin rights.
+	Admin *bool
+}
+
+// LoginOIDC returns the user who is linked to the identity. A new user is
+// provisioned on the first login. If linkByName is true, an existing user
+// of the same name who is not linked yet is linked instead, unless the
+// user is an admin.
+func LoginOIDC(db *gorm.DB, id Identity, linkByName bool, ip, userAgent string, now time.Time) (*User, error) {
+	attempt := &LoginAttempt{
+		Name:      id.Name,
+		IPAddress: ip,
+		UserAgent: userAgent,
+		CreatedAt: now,
+	}
+	user, err := loginOIDC(db, id, linkByName, attempt)
+	return attempt.record(db, user, err)
+}
+
+func loginOIDC(db *gorm.DB, id Identity, linkByName bool, attempt *LoginAttempt) (*User, error) {
+	if id.Issuer == "" || id.Subject == "" || id.Name == "" {
+		return nil, newError(ErrInvalid, "Issuer, subject and name are required")
+	}
+	user := new(User)
+	q := db.First(user, "oidc_issuer = ? AND oidc_subject = ?", id.Issuer, id.Subject)
+	switch {
+	case q.RecordNotFound():
+		var err error
+		if user, err = linkOIDC(db, id, linkByName, attempt); err != nil {
+			return nil, err
+		}
+	case q.Error != nil:
+		return nil, q.Error
+	}
+	attempt.UserID = user.ID
+	if user.IsSuspended() {
+		attempt.Reason = LoginSuspended
+		return nil, newError(ErrSuspended, "User %s is suspended", user.Name)
+	}
+	// The first user stays admin so that vegeta can be managed.
+	if id.Admin != nil && user.Admin != *id.Admin && user.ID != 1 {
+		if err := db.Model(user).UpdateColumn("admin", *id.Admin).Error; err != nil {
+			return nil, err
+		}
+		user.Admin = *id.Admin
+	}
+	if user.TOTPEnabled {
+		attempt.Reason = LoginTOTPRequired
+		return user, nil
+	}
+	attempt.Success = true
+	return user, nil
+}
+
+// linkOIDC links the user of the same name, or creates a new user.
+func linkOIDC(db *gorm.DB, id Identity, linkByName bool, attempt *LoginAttempt) (*User, error) {
+	user := new(User)
+	if !db.First(user, "name = ?", id.Name).RecordNotFound() {
+		// Anyone who can register the name at the provider would take
+		// over the account, so admins are never linked by name.
+		if !linkByName || user.OIDCSubject != "" || user.Admin {
+			attempt.UserID = user.ID
+			attempt.Reason = LoginOIDCConflict
+			return nil, newError(ErrAlreadyExists, "User %s already exist", id.Name)
+		}
+		err := db.Model(user).UpdateColumns(map[string]interface{}{
+			"oidc_issuer":  id.Issuer,
+			"oidc_subject": id.Subject,
+		}).Error
+		if err != nil {
+			return nil, err
+		}
+		user.OIDCIssuer = id.Issuer
+		user.OIDCSubject = id.Subject
+		return user, nil
+	}
+	user = &User{
+		Name:        id.Name,
+		Token:       utils.GenerateUUID(),
+		OIDCIssuer:  id.Issuer,
+		OIDCSubject: id.Subject,
+		Admin:       id.Admin != nil && *id.Admin,
+	}
+	// Nobody knows the password, so the user logs in only by the provider
+	// until a password is set on the settings page.
+	if err := user.setPassword(utils.RandomToken()); err != nil {
+		return nil, err
+	}
+	if err := db.Create(user).Error; err != nil {
+		return nil, err
+	}
+	return user, nil
+}
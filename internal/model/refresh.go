@@ -0,0 +1,124 @@
+package model
+
+import (
+	"time"
+
+	"github.com/Code-Hex/vegeta/internal/utils"
+	"github.com/jinzhu/gorm"
+)
+
+// Pages of the same session share the cookie and may refresh at the same
+// time, so a token can be used again for this interval after its first use.
+const refreshReuseInterval = 10 * time.Second
+
+// RefreshToken renews the short-lived access tokens of pages. It belongs
+// to a login session and is replaced on each use by a token of the same
+// family. If a replaced token is used again, it is likely stolen, so the
+// whole family is revoked.
+type RefreshToken struct {
+	ID        uint      `gorm:"primary_key"`
+	UserID    uint      `gorm:"not null;index:idx_refresh_user"`
+	SessionID uint      `gorm:"not null;index:idx_refresh_session"`
+	Family    string    `gorm:"not null;index:idx_refresh_family"`
+	Hash      string    `gorm:"not null;unique_index:idx_refresh_hash"`
+	CreatedAt time.Time `gorm:"not null"`
+	ExpiresAt time.Time `gorm:"not null"`
+	UsedAt    *time.Time
+	RevokedAt *time.Time
+}
+
+// CreateRefreshToken starts a new family for the session and returns the token
+// for the cookie. Expired tokens of the user are removed at the same time.
+func CreateRefreshToken(db *gorm.DB, userID, sessionID uint, now time.Time, ttl time.Duration) (string, error) {
+	tx := db.Begin()
+	if err := tx.Where("user_id = ? AND expires_at <= ?", userID, now).Delete(RefreshToken{}).Error; err != nil {
+		tx.Rollback()
+		return "", err
+	}
+	token, err := createRefreshToken(tx, userID, sessionID, utils.RandomToken(), now, ttl)
+	if err != nil {
+		tx.Rollback()
+		return "", err
+	}
+	tx.Commit()
+	return token, nil
+}
+
+func createRefreshToken(db *gorm.DB, userID, sessionID uint, family string, now time.Time, ttl time.Duration) (string, error) {
+	token := utils.RandomToken()
+	rt := &RefreshToken{
+		UserID:    userID,
+		SessionID: sessionID,
+		Family:    family,
+		Hash:      hashToken(token),
+		CreatedAt: now,
+		ExpiresAt: now.Add(ttl),
+	}
+	if err := db.Create(rt).Error; err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// IsValidRefreshToken reports whether the token of the session can be used.
+func IsValidRefreshToken(db *gorm.DB, token string, sessionID uint, now time.Time) bool {
+	return !db.Where(
+		"hash = ? AND session_id = ? AND used_at IS NULL AND revoked_at IS NULL AND expires_at > ?",
+		hashToken(token), sessionID, now,
+	).First(&RefreshToken{}).RecordNotFound()
+}
+
+// UseRefreshToken replaces the token by a new one of the same family, and
+// returns the user and the new token. The session of the token must not
+// be expired or revoked.
+func UseRefreshToken(db *gorm.DB, token string, now time.Time, ttl time.Duration) (*User, string, error) {
+	rt := new(RefreshToken)
+	if db.Where("hash = ?", hashToken(token)).First(rt).RecordNotFound() {
+		return nil, "", newError(ErrUnauthorized, "Refresh token is not found")
+	}
+	if rt.RevokedAt != nil || !now.Before(rt.ExpiresAt) {
+		return nil, "", newError(ErrUnauthorized, "Refresh token is revoked or expired")
+	}
+	if rt.UsedAt != nil && now.Sub(*rt.UsedAt) > refreshReuseInterval {
+		if err := revokeRefreshTokens(db.Where("family = ?", rt.Family), now); err != nil {
+			return nil, "", err
+		}
+		return nil, "", newError(ErrUnauthorized, "Refresh token is reused, and the family is revoked")
+	}
+	if db.Where("id = ? AND expires_at > ?", rt.SessionID, now).First(&Session{}).RecordNotFound() {
+		if err := revokeRefreshTokens(db.Where("family = ?", rt.Family), now); err != nil {
+			return nil, "", err
+		}
+		return nil, "", newError(ErrUnauthorized, "Session of the refresh token is expired")
+	}
+	user, err := FindUser(db, rt.UserID)
+	if err != nil {
+		return nil, "", err
+	}
+	if user.IsSuspended() {
+		return nil, "", newError(ErrUnauthorized, "User %s is suspended", user.Name)
+	}
+
+	tx := db.Begin()
+	// The first use is kept for the reuse interval.
+	if err := tx.Model(rt).Where("used_at IS NULL").UpdateColumn("used_at", now).Error; err != nil {
+		tx.Rollback()
+		return nil, "", err
+	}
+	next, err := createRefreshToken(tx, rt.UserID, rt.SessionID, rt.Family, now, ttl)
+	if err != nil {
+		tx.Rollback()
+		return nil, "", err
+	}
+	tx.Commit()
+	return user, next, nil
+}
+
+// RevokeRefreshTokens revokes all refresh tokens of the user.
+func RevokeRefreshTokens(db *gorm.DB, userID uint, now time.Time) error {
+	return revokeRefreshTokens(db.Where("user_id = ?", userID), now)
+}
+
+func revokeRefreshTokens(q *gorm.DB, now time.Time) error {
+	return q.Model(&RefreshToken{}).Where("revoked_at IS NULL").UpdateColumn("revoked_at", now).Error
+}
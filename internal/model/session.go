@@ -0,0 +1,131 @@
+package model
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"time"
+
+	"github.com/Code-Hex/vegeta/internal/utils"
+	"github.com/jinzhu/gorm"
+)
+
+// Session is a login session. The cookie has only the token, and the hash
+// of it is stored so that sessions cannot be taken from the database.
+type Session struct {
+	ID         uint      `gorm:"primary_key"`
+	UserID     uint      `gorm:"not null;index:idx_session_user"`
+	Hash       string    `gorm:"not null;unique_index:idx_session_hash"`
+	UserAgent  string    `gorm:"not null"`
+	IPAddress  string    `gorm:"not null"`
+	CreatedAt  time.Time `gorm:"not null"`
+	LastSeenAt time.Time `gorm:"not null"`
+	ExpiresAt  time.Time `gorm:"not null"`
+	// SecondFactor is true if TOTP or a recovery code was verified in the session.
+	SecondFactor bool `gorm:"not null;default:false"`
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// CreateSession starts a session of the user and returns the token for the cookie.
+// Expired sessions of the user are removed at the same time.
+func CreateSession(db *gorm.DB, user *User, userAgent, ip string, now time.Time, maxAge time.Duration) (*Session, string, error) {
+	token := utils.RandomToken()
+	s := &Session{
+		UserID:     user.ID,
+		Hash:       hashToken(token),
+		UserAgent:  userAgent,
+		IPAddress:  ip,
+		CreatedAt:  now,
+		LastSeenAt: now,
+		ExpiresAt:  now.Add(maxAge),
+	}
+	tx := db.Begin()
+	if err := tx.Where("user_id = ? AND expires_at <= ?", user.ID, now).Delete(Session{}).Error; err != nil {
+		tx.Rollback()
+		return nil, "", err
+	}
+	if err := tx.Create(s).Error; err != nil {
+		tx.Rollback()
+		return nil, "", err
+	}
+	tx.Commit()
+	return s, token, nil
+}
+
+// FindSession returns the session of the token which is not expired.
+func FindSession(db *gorm.DB, token string, now time.Time) (*Session, error) {
+	s := new(Session)
+	if db.Where("hash = ? AND expires_at > ?", hashToken(token), now).First(s).RecordNotFound() {
+		return nil, newError(ErrNotFound, "Session is not found")
+	}
+	return s, nil
+}
+
+// Touch records that the session is used at now.
+func (s *Session) Touch(db *gorm.DB, now time.Time) error {
+	s.LastSeenAt = now
+	return db.Model(s).UpdateColumn("last_seen_at", now).Error
+}
+
+// VerifySecondFactor records that the second factor was verified in the session.
+func (s *Session) VerifySecondFactor(db *gorm.DB) error {
+	s.SecondFactor = true
+	return db.Model(s).UpdateColumn("second_factor", true).Error
+}
+
+// Delete removes the session.
+func (s *Session) Delete(db *gorm.DB) error {
+	return db.Delete(s).Error
+}
+
+// GetSessions returns sessions of the user which are not expired, from the latest used.
+func GetSessions(db *gorm.DB, userID uint, now time.Time) ([]*Session, error) {
+	var sessions []*Session
+	err := db.Where("user_id = ? AND expires_at > ?", userID, now).
+		Order("last_seen_at desc").
+		Find(&sessions).Error
+	if err != nil {
+		return nil, err
+	}
+	return sessions, nil
+}
+
+// RevokeSession removes the session of the user.
+func RevokeSession(db *gorm.DB, userID uint, sessionID string) error {
+	id, err := strconv.ParseUint(sessionID, 10, 64)
+	if err != nil {
+		return newError(ErrInvalid, "Invalid session id: %s", sessionID)
+	}
+	res := db.Where("id = ? AND user_id = ?", id, userID).Delete(Session{})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return newError(ErrNotFound, "Session: %d is not found", id)
+	}
+	return nil
+}
+
+// RevokeSessions removes all sessions of the user.
+func RevokeSessions(db *gorm.DB, userID uint) error {
+	return db.Where("user_id = ?", userID).Delete(Session{}).Error
+}
+
+// DeleteExpiredSessions removes expired sessions and refresh tokens of all
+// users. Users who never log in again would keep them otherwise.
+func DeleteExpiredSessions(db *gorm.DB, now time.Time) error {
+	tx := db.Begin()
+	if err := tx.Where("expires_at <= ?", now).Delete(Session{}).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err := tx.Where("expires_at <= ?", now).Delete(RefreshToken{}).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit().Error
+}
@@ -0,0 +1,214 @@
+package model
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base32"
+	"strings"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+	"github.com/pquerna/otp"
+	"github.com/pquerna/otp/totp"
+)
+
+const (
+	totpPeriod = 30
+	// totpSkew is how many steps before and after now are accepted.
+	totpSkew          = 1
+	recoveryCodeCount = 10
+)
+
+var recoveryEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// RecoveryCode is a one time code to pass the second factor without
+// the authenticator. Only the hash is stored.
+type RecoveryCode struct {
+	ID     uint   `gorm:"primary_key"`
+	UserID uint   `gorm:"not null;index:idx_recovery_user"`
+	Hash   string `gorm:"not null"`
+	UsedAt *time.Time
+}
+
+// StartTOTP makes a new secret of the user. TOTP is not enabled
+// until EnableTOTP verifies a code from the secret.
+func (u *User) StartTOTP(db *gorm.DB, issuer string) (*otp.Key, error) {
+	if u.TOTPEnabled {
+		return nil, newError(ErrAlreadyExists, "TOTP is already enabled")
+	}
+	key, err := totp.Generate(totp.GenerateOpts{
+		Issuer:      issuer,
+		AccountName: u.Name,
+		Period:      totpPeriod,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to generate TOTP secret")
+	}
+	if err := db.Model(u).UpdateColumn("totp_secret", key.Secret()).Error; err != nil {
+		return nil, err
+	}
+	u.TOTPSecret = key.Secret()
+	return key, nil
+}
+
+// EnableTOTP enables TOTP if the code is made from the secret by StartTOTP,
+// and returns new recovery codes.
+func (u *User) EnableTOTP(db *gorm.DB, code string, now time.Time) ([]string, error) {
+	if u.TOTPEnabled {
+		return nil, newError(ErrAlreadyExists, "TOTP is already enabled")
+	}
+	if u.TOTPSecret == "" {
+		return nil, newError(ErrInvalid, "TOTP enrollment is not started")
+	}
+	step, ok := u.verifyTOTP(normalizeCode(code), now)
+	if !ok {
+		return nil, newError(ErrUnauthorized, "Invalid TOTP code")
+	}
+	tx := db.Begin()
+	err := tx.Model(u).UpdateColumns(map[string]interface{}{
+		"totp_enabled":   true,
+		"totp_last_step": step,
+	}).Error
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	codes, err := u.resetRecoveryCodes(tx)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	tx.Commit()
+	u.TOTPEnabled = true
+	u.TOTPLastStep = step
+	return codes, nil
+}
+
+// DisableTOTP removes the secret and the recovery codes of the user.
+func (u *User) DisableTOTP(db *gorm.DB) error {
+	tx := db.Begin()
+	err := tx.Model(u).UpdateColumns(map[string]interface{}{
+		"totp_secret":    "",
+		"totp_enabled":   false,
+		"totp_last_step": 0,
+	}).Error
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err := tx.Delete(&RecoveryCode{}, "user_id = ?", u.ID).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+	tx.Commit()
+	u.TOTPSecret = ""
+	u.TOTPEnabled = false
+	u.TOTPLastStep = 0
+	return nil
+}
+
+// RegenerateRecoveryCodes replaces the recovery codes of the user.
+func (u *User) RegenerateRecoveryCodes(db *gorm.DB) ([]string, error) {
+	if !u.TOTPEnabled {
+		return nil, newError(ErrInvalid, "TOTP is not enabled")
+	}
+	tx := db.Begin()
+	codes, err := u.resetRecoveryCodes(tx)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	tx.Commit()
+	return codes, nil
+}
+
+// RecoveryCodesLeft returns how many recovery codes are not used yet.
+func (u *User) RecoveryCodesLeft(db *gorm.DB) (int, error) {
+	var n int
+	err := db.Model(&RecoveryCode{}).
+		Where("user_id = ? AND used_at IS NULL", u.ID).
+		Count(&n).Error
+	return n, err
+}
+
+// VerifySecondFactor accepts a TOTP code or an unused recovery code.
+// Each of them can be used only once.
+func (u *User) VerifySecondFactor(db *gorm.DB, code string, now time.Time) error {
+	if !u.TOTPEnabled {
+		return newError(ErrInvalid, "TOTP is not enabled")
+	}
+	code = normalizeCode(code)
+	if step, ok := u.verifyTOTP(code, now); ok {
+		// The condition refuses the same step which is used concurrently.
+		q := db.Model(u).Where("totp_last_step < ?", step).UpdateColumn("totp_last_step", step)
+		if q.Error != nil {
+			return q.Error
+		}
+		if q.RowsAffected == 1 {
+			u.TOTPLastStep = step
+			return nil
+		}
+	}
+	q := db.Model(&RecoveryCode{}).
+		Where("user_id = ? AND hash = ? AND used_at IS NULL", u.ID, hashToken(code)).
+		UpdateColumn("used_at", now)
+	if q.Error != nil {
+		return q.Error
+	}
+	if q.RowsAffected == 1 {
+		return nil
+	}
+	return newError(ErrUnauthorized, "Invalid TOTP code")
+}
+
+// verifyTOTP returns the step of the code if it is valid and not used yet.
+func (u *User) verifyTOTP(code string, now time.Time) (int64, bool) {
+	if len(code) != int(otp.DigitsSix) {
+		return 0, false
+	}
+	current := now.Unix() / totpPeriod
+	for s := current - totpSkew; s <= current+totpSkew; s++ {
+		if s <= u.TOTPLastStep {
+			continue
+		}
+		want, err := totp.GenerateCodeCustom(u.TOTPSecret, time.Unix(s*totpPeriod, 0), totp.ValidateOpts{
+			Period:    totpPeriod,
+			Digits:    otp.DigitsSix,
+			Algorithm: otp.AlgorithmSHA1,
+		})
+		if err != nil {
+			return 0, false
+		}
+		if subtle.ConstantTimeCompare([]byte(want), []byte(code)) == 1 {
+			return s, true
+		}
+	}
+	return 0, false
+}
+
+func (u *User) resetRecoveryCodes(tx *gorm.DB) ([]string, error) {
+	if err := tx.Delete(&RecoveryCode{}, "user_id = ?", u.ID).Error; err != nil {
+		return nil, err
+	}
+	codes := make([]string, recoveryCodeCount)
+	for i := range codes {
+		b := make([]byte, 5)
+		if _, err := rand.Read(b); err != nil {
+			return nil, errors.Wrap(err, "Failed to make recovery code")
+		}
+		code := strings.ToLower(recoveryEncoding.EncodeToString(b))
+		rc := &RecoveryCode{UserID: u.ID, Hash: hashToken(code)}
+		if err := tx.Create(rc).Error; err != nil {
+			return nil, err
+		}
+		// Shown as xxxx-xxxx for readability.
+		codes[i] = code[:4] + "-" + code[4:]
+	}
+	return codes, nil
+}
+
+// normalizeCode removes spaces and hyphens which users may type.
+func normalizeCode(code string) string {
+	return strings.ToLower(strings.NewReplacer(" ", "", "-", "").Replace(code))
+}
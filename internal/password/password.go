@@ -0,0 +1,279 @@
+// Package password hashes and verifies passwords of users.
+package password
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/Code-Hex/saltissimo"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Algorithms which are stored with the hash of each user.
+const (
+	Argon2id = "argon2id"
+	Bcrypt   = "bcrypt"
+	// SHA256 is the legacy salted sha256 by saltissimo. It is only verified,
+	// and upgraded to the current algorithm on login. Users whose algorithm
+	// is empty have it.
+	SHA256 = "sha256"
+)
+
+const (
+	saltLen = 16
+	keyLen  = 32
+)
+
+// Params decides how new hashes are made.
+type Params struct {
+	Algorithm  string
+	Argon2Time uint32
+	// Argon2Memory is in KiB.
+	Argon2Memory  uint32
+	Argon2Threads uint8
+	BcryptCost    int
+}
+
+// Policy is checked when a password is set.
+type Policy struct {
+	MinLength     int
+	MaxLength     int
+	RequireLetter bool
+	RequireDigit  bool
+}
+
+// PolicyError tells which rule of the policy is violated.
+// Param is the length for min_length and max_length.
+type PolicyError struct {
+	Rule  string
+	Param int
+}
+
+func (e *PolicyError) Error() string {
+	switch e.Rule {
+	case "min_length":
+		return fmt.Sprintf("Password must be at least %d characters", e.Param)
+	case "max_length":
+		return fmt.Sprintf("Password must be at most %d characters", e.Param)
+	case "letter":
+		return "Password must contain a letter"
+	case "digit":
+		return "Password must contain a digit"
+	case "username":
+		return "Password must not be the same as the username"
+	}
+	return "Password violates the policy: " + e.Rule
+}
+
+func DefaultParams() Params {
+	return Params{
+		Algorithm:     Argon2id,
+		Argon2Time:    1,
+		Argon2Memory:  64 * 1024,
+		Argon2Threads: 4,
+		BcryptCost:    bcrypt.DefaultCost,
+	}
+}
+
+func DefaultPolicy() Policy {
+	return Policy{
+		MinLength: 8,
+		MaxLength: 72,
+	}
+}
+
+var (
+	mu     sync.RWMutex
+	params = DefaultParams()
+	policy = DefaultPolicy()
+)
+
+// Configure replaces the params and the policy.
+func Configure(p Params, pol Policy) error {
+	if err := p.Validate(); err != nil {
+		return err
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	params, policy = p, pol
+	return nil
+}
+
+func (p Params) Validate() error {
+	switch p.Algorithm {
+	case Argon2id:
+		if p.Argon2Time < 1 || p.Argon2Memory < 8*uint32(p.Argon2Threads) || p.Argon2Threads < 1 {
+			return errors.New("argon2 params must be positive, and memory must be at least 8 KiB per thread")
+		}
+	case Bcrypt:
+		if p.BcryptCost < bcrypt.MinCost || bcrypt.MaxCost < p.BcryptCost {
+			return errors.Errorf("bcrypt cost must be in %d-%d", bcrypt.MinCost, bcrypt.MaxCost)
+		}
+	default:
+		return errors.Errorf("Unsupported password algorithm: %q", p.Algorithm)
+	}
+	return nil
+}
+
+func current() (Params, Policy) {
+	mu.RLock()
+	defer mu.RUnlock()
+	return params, policy
+}
+
+// Check reports the first rule of the policy which password violates.
+func Check(name, password string) error {
+	_, pol := current()
+	n := len([]rune(password))
+	if n < pol.MinLength {
+		return &PolicyError{Rule: "min_length", Param: pol.MinLength}
+	}
+	if 0 < pol.MaxLength && pol.MaxLength < n {
+		return &PolicyError{Rule: "max_length", Param: pol.MaxLength}
+	}
+	if pol.RequireLetter && strings.IndexFunc(password, unicode.IsLetter) < 0 {
+		return &PolicyError{Rule: "letter"}
+	}
+	if pol.RequireDigit && strings.IndexFunc(password, unicode.IsDigit) < 0 {
+		return &PolicyError{Rule: "digit"}
+	}
+	if name != "" && strings.EqualFold(name, password) {
+		return &PolicyError{Rule: "username"}
+	}
+	return nil
+}
+
+// Hash hashes password by the current algorithm.
+func Hash(password string) (algorithm, hash string, err error) {
+	p, _ := current()
+	switch p.Algorithm {
+	case Bcrypt:
+		b, err := bcrypt.GenerateFromPassword([]byte(password), p.BcryptCost)
+		if err != nil {
+			return "", "", errors.Wrap(err, "Failed to hash password")
+		}
+		return Bcrypt, string(b), nil
+	default:
+		salt := make([]byte, saltLen)
+		if _, err := rand.Read(salt); err != nil {
+			return "", "", errors.Wrap(err, "Failed to make salt")
+		}
+		key := argon2.IDKey([]byte(password), salt, p.Argon2Time, p.Argon2Memory, p.Argon2Threads, keyLen)
+		return Argon2id, encodeArgon2(p, salt, key), nil
+	}
+}
+
+// Verify reports whether password matches the hash. salt is used only by SHA256.
+func Verify(algorithm, hash, salt, password string) (bool, error) {
+	switch algorithm {
+	case Argon2id:
+		p, salt, key, err := decodeArgon2(hash)
+		if err != nil {
+			return false, err
+		}
+		other := argon2.IDKey([]byte(password), salt, p.Argon2Time, p.Argon2Memory, p.Argon2Threads, uint32(len(key)))
+		return subtle.ConstantTimeCompare(key, other) == 1, nil
+	case Bcrypt:
+		err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
+		if err == bcrypt.ErrMismatchedHashAndPassword {
+			return false, nil
+		}
+		return err == nil, err
+	case SHA256, "":
+		return saltissimo.CompareHexHash(sha256.New, password, hash, salt)
+	}
+	return false, errors.Errorf("Unknown password algorithm: %q", algorithm)
+}
+
+// dummy is the hash which VerifyDummy verifies. It is made again when
+// the params are changed.
+var dummy struct {
+	sync.Mutex
+	params          Params
+	algorithm, hash string
+}
+
+// VerifyDummy takes as long as Verify of a hash by the current params.
+// It is called if the user is not found, so that the time does not tell
+// whether the name exists.
+func VerifyDummy(password string) {
+	p, _ := current()
+	dummy.Lock()
+	if dummy.hash == "" || dummy.params != p {
+		algorithm, hash, err := Hash("dummy-password")
+		if err != nil {
+			dummy.Unlock()
+			return
+		}
+		dummy.params, dummy.algorithm, dummy.hash = p, algorithm, hash
+	}
+	algorithm, hash := dummy.algorithm, dummy.hash
+	dummy.Unlock()
+	Verify(algorithm, hash, "", password)
+}
+
+// NeedsRehash reports whether the hash is not made by the current
+// algorithm and params.
+func NeedsRehash(algorithm, hash string) bool {
+	p, _ := current()
+	if algorithm != p.Algorithm {
+		return true
+	}
+	switch algorithm {
+	case Argon2id:
+		hp, _, _, err := decodeArgon2(hash)
+		return err != nil ||
+			hp.Argon2Time != p.Argon2Time ||
+			hp.Argon2Memory != p.Argon2Memory ||
+			hp.Argon2Threads != p.Argon2Threads
+	case Bcrypt:
+		cost, err := bcrypt.Cost([]byte(hash))
+		return err != nil || cost != p.BcryptCost
+	}
+	return false
+}
+
+// encodeArgon2 uses the PHC string format such as
+// $argon2id$v=19$m=65536,t=1,p=4$<salt>$<key>
+func encodeArgon2(p Params, salt, key []byte) string {
+	return fmt.Sprintf(
+		"$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2.Version,
+		p.Argon2Memory,
+		p.Argon2Time,
+		p.Argon2Threads,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	)
+}
+
+func decodeArgon2(hash string) (p Params, salt, key []byte, err error) {
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 || parts[1] != Argon2id {
+		return p, nil, nil, errors.New("Invalid argon2id hash")
+	}
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return p, nil, nil, errors.New("Unsupported argon2 version")
+	}
+	_, err = fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &p.Argon2Memory, &p.Argon2Time, &p.Argon2Threads)
+	if err != nil {
+		return p, nil, nil, errors.Wrap(err, "Invalid argon2id params")
+	}
+	p.Algorithm = Argon2id
+	if salt, err = base64.RawStdEncoding.DecodeString(parts[4]); err != nil {
+		return p, nil, nil, errors.Wrap(err, "Invalid argon2id salt")
+	}
+	if key, err = base64.RawStdEncoding.DecodeString(parts[5]); err != nil {
+		return p, nil, nil, errors.Wrap(err, "Invalid argon2id key")
+	}
+	return p, salt, key, nil
+}
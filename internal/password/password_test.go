@@ -0,0 +1,158 @@
+package password
+
+import (
+	"crypto/sha256"
+	"strings"
+	"testing"
+
+	"github.com/Code-Hex/saltissimo"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestVerifyDummyFollowsParams(t *testing.T) {
+	defer Configure(DefaultParams(), DefaultPolicy())
+
+	VerifyDummy("password")
+	if dummy.algorithm != Argon2id {
+		t.Fatalf("dummy is hashed by %s, want %s", dummy.algorithm, Argon2id)
+	}
+	p := DefaultParams()
+	p.Algorithm, p.BcryptCost = Bcrypt, bcrypt.MinCost
+	if err := Configure(p, DefaultPolicy()); err != nil {
+		t.Fatal(err)
+	}
+	VerifyDummy("password")
+	if dummy.algorithm != Bcrypt {
+		t.Errorf("dummy is hashed by %s after the params are changed, want %s", dummy.algorithm, Bcrypt)
+	}
+}
+
+// fastParams keeps tests quick.
+func fastParams(algorithm string) Params {
+	return Params{
+		Algorithm:     algorithm,
+		Argon2Time:    1,
+		Argon2Memory:  8 * 1024,
+		Argon2Threads: 1,
+		BcryptCost:    bcrypt.MinCost,
+	}
+}
+
+func TestHashRoundTrip(t *testing.T) {
+	defer Configure(DefaultParams(), DefaultPolicy())
+
+	tests := []struct {
+		algorithm string
+		prefix    string
+	}{
+		{Argon2id, "$argon2id$v=19$m=8192,t=1,p=1$"},
+		{Bcrypt, "$2a$04$"},
+	}
+	for _, tt := range tests {
+		if err := Configure(fastParams(tt.algorithm), DefaultPolicy()); err != nil {
+			t.Fatal(err)
+		}
+		algorithm, hash, err := Hash("correct horse")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if algorithm != tt.algorithm || !strings.HasPrefix(hash, tt.prefix) {
+			t.Errorf("%s: hash = %s %s, want the prefix %s", tt.algorithm, algorithm, hash, tt.prefix)
+		}
+		if ok, err := Verify(algorithm, hash, "", "correct horse"); !ok || err != nil {
+			t.Errorf("%s: verify = %v, %v", tt.algorithm, ok, err)
+		}
+		if ok, err := Verify(algorithm, hash, "", "wrong horse"); ok || err != nil {
+			t.Errorf("%s: verify of a wrong password = %v, %v", tt.algorithm, ok, err)
+		}
+		if NeedsRehash(algorithm, hash) {
+			t.Errorf("%s: a hash by the current params needs rehash", tt.algorithm)
+		}
+
+		p := fastParams(tt.algorithm)
+		p.Argon2Time, p.BcryptCost = 2, bcrypt.MinCost+1
+		if err := Configure(p, DefaultPolicy()); err != nil {
+			t.Fatal(err)
+		}
+		if !NeedsRehash(algorithm, hash) {
+			t.Errorf("%s: a hash by old params does not need rehash", tt.algorithm)
+		}
+		if ok, _ := Verify(algorithm, hash, "", "correct horse"); !ok {
+			t.Errorf("%s: a hash by old params is not verified", tt.algorithm)
+		}
+	}
+}
+
+func TestDecodeArgon2(t *testing.T) {
+	p := fastParams(Argon2id)
+	salt, key := []byte("0123456789abcdef"), []byte("0123456789abcdef0123456789abcdef")
+	got, gotSalt, gotKey, err := decodeArgon2(encodeArgon2(p, salt, key))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Argon2Time != p.Argon2Time || got.Argon2Memory != p.Argon2Memory || got.Argon2Threads != p.Argon2Threads {
+		t.Errorf("params = %+v, want %+v", got, p)
+	}
+	if string(gotSalt) != string(salt) || string(gotKey) != string(key) {
+		t.Errorf("salt and key are not kept")
+	}
+	for _, hash := range []string{
+		"",
+		"$argon2i$v=19$m=8192,t=1,p=1$c2FsdA$a2V5",
+		"$argon2id$v=16$m=8192,t=1,p=1$c2FsdA$a2V5",
+		"$argon2id$v=19$m=8192$c2FsdA$a2V5",
+	} {
+		if _, _, _, err := decodeArgon2(hash); err == nil {
+			t.Errorf("%q is decoded", hash)
+		}
+	}
+}
+
+func TestVerifyLegacySHA256(t *testing.T) {
+	hash, salt, err := saltissimo.HexHash(sha256.New, "legacy-password1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, algorithm := range []string{SHA256, ""} {
+		if ok, err := Verify(algorithm, hash, salt, "legacy-password1"); !ok || err != nil {
+			t.Errorf("%q: verify = %v, %v", algorithm, ok, err)
+		}
+		if ok, _ := Verify(algorithm, hash, salt, "wrong"); ok {
+			t.Errorf("%q: a wrong password is verified", algorithm)
+		}
+		if !NeedsRehash(algorithm, hash) {
+			t.Errorf("%q: a legacy hash does not need rehash", algorithm)
+		}
+	}
+}
+
+func TestCheck(t *testing.T) {
+	defer Configure(DefaultParams(), DefaultPolicy())
+	err := Configure(DefaultParams(), Policy{MinLength: 8, MaxLength: 12, RequireLetter: true, RequireDigit: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		password string
+		rule     string
+	}{
+		{"abcdef12", ""},
+		{"abc12", "min_length"},
+		{"abcdefghij123", "max_length"},
+		{"12345678", "letter"},
+		{"abcdefgh", "digit"},
+		{"Alice123", "username"},
+	}
+	for _, tt := range tests {
+		err := Check("alice123", tt.password)
+		if tt.rule == "" {
+			if err != nil {
+				t.Errorf("%q: %v", tt.password, err)
+			}
+			continue
+		}
+		if perr, ok := err.(*PolicyError); !ok || perr.Rule != tt.rule {
+			t.Errorf("%q: err = %v, want the rule %s", tt.password, err, tt.rule)
+		}
+	}
+}
@@ -2,29 +2,47 @@ package session
 
 import (
 	"net/http"
+	"time"
 
-	"github.com/gorilla/context"
-	"github.com/gorilla/sessions"
+	"github.com/Code-Hex/vegeta/internal/model"
+	"github.com/jinzhu/gorm"
 	"github.com/labstack/echo"
+	"go.uber.org/zap"
 )
 
-type session struct {
-	name    string
-	session *sessions.Session
-	store   sessions.Store
-	req     *http.Request
-	res     http.ResponseWriter
-	written bool
-}
+// touchInterval is how often the last access of a session is recorded.
+const touchInterval = time.Minute
 
 var Name = "session"
 
-func Middleware(name string, store sessions.Store) echo.MiddlewareFunc {
+// Store keeps sessions in the database. The cookie has only the token.
+type Store struct {
+	DB         *gorm.DB
+	Logger     *zap.Logger
+	CookieName string
+	MaxAge     time.Duration
+	Now        func() time.Time
+	// PeerAddr returns the address of the client which is recorded with the session.
+	PeerAddr func(echo.Context) string
+}
+
+type session struct {
+	store   *Store
+	ctx     echo.Context
+	session *model.Session
+	user    *model.User
+}
+
+// Middleware loads the session and its user from the database on each request,
+// so that changes of the user such as demotion or deletion take effect at once.
+func Middleware(store *Store) echo.MiddlewareFunc {
 	return func(h echo.HandlerFunc) echo.HandlerFunc {
 		return func(ctx echo.Context) error {
-			req, res := ctx.Request(), ctx.Response()
-			ctx.Set(Name, &session{name, nil, store, req, res, false})
-			defer context.Clear(req)
+			s := &session{store: store, ctx: ctx}
+			if err := s.load(); err != nil {
+				store.Logger.Error("Failed to load session", zap.Error(err))
+			}
+			ctx.Set(Name, s)
 			return h(ctx)
 		}
 	}
@@ -38,54 +56,109 @@ func Get(ctx echo.Context) *session {
 	return value.(*session)
 }
 
-func (s *session) Save() error {
-	if s.written {
-		e := s.Session().Save(s.req, s.res)
-		if e == nil {
-			s.written = false
+func (s *session) load() error {
+	cookie, err := s.ctx.Cookie(s.store.CookieName)
+	if err != nil || cookie.Value == "" {
+		return nil
+	}
+	now := s.store.Now()
+	sess, err := model.FindSession(s.store.DB, cookie.Value, now)
+	if err != nil {
+		s.clearCookie()
+		return nil
+	}
+	user, err := model.FindUser(s.store.DB, sess.UserID)
+	if err != nil || user.IsSuspended() {
+		s.clearCookie()
+		return nil
+	}
+	if now.Sub(sess.LastSeenAt) >= touchInterval {
+		if err := sess.Touch(s.store.DB, now); err != nil {
+			return err
 		}
-		return e
 	}
+	s.session = sess
+	s.user = user
 	return nil
 }
 
-func (s *session) Session() *sessions.Session {
-	if s.session == nil {
-		var err error
-		s.session, err = s.store.Get(s.req, s.name)
-		if err != nil {
-			panic(err)
-		}
-	}
-	return s.session
+// User returns the user of the session, or nil if not logged in.
+func (s *session) User() *model.User {
+	return s.user
 }
 
-func (s *session) Get(key interface{}) interface{} {
-	return s.Session().Values[key]
+// ID returns the id of the session, or 0 if not logged in.
+func (s *session) ID() uint {
+	if s.session == nil {
+		return 0
+	}
+	return s.session.ID
 }
 
-func (s *session) Set(key interface{}, val interface{}) {
-	s.Session().Values[key] = val
-	s.written = true
+// SecondFactor reports whether the second factor was verified in the session.
+func (s *session) SecondFactor() bool {
+	return s.session != nil && s.session.SecondFactor
 }
 
-func (s *session) Delete(key interface{}) {
-	delete(s.Session().Values, key)
-	s.written = true
+// VerifySecondFactor records that the user of the session has passed the
+// second factor.
+func (s *session) VerifySecondFactor() error {
+	if s.session == nil {
+		return nil
+	}
+	return s.session.VerifySecondFactor(s.store.DB)
 }
 
-func (s *session) Clear() {
-	for key := range s.Session().Values {
-		s.Delete(key)
+// Login starts a new session of the user. The current session is
+// removed so that the token is not fixed before login.
+func (s *session) Login(user *model.User) error {
+	if err := s.Expire(); err != nil {
+		return err
 	}
+	req := s.ctx.Request()
+	sess, token, err := model.CreateSession(
+		s.store.DB,
+		user,
+		req.UserAgent(),
+		s.store.PeerAddr(s.ctx),
+		s.store.Now(),
+		s.store.MaxAge,
+	)
+	if err != nil {
+		return err
+	}
+	s.session = sess
+	s.user = user
+	s.ctx.SetCookie(&http.Cookie{
+		Name:     s.store.CookieName,
+		Value:    token,
+		Path:     "/",
+		MaxAge:   int(s.store.MaxAge / time.Second),
+		Secure:   req.TLS != nil,
+		HttpOnly: true,
+	})
+	return nil
 }
 
-func (s *session) Options(options *sessions.Options) {
-	s.Session().Options = options
+// Expire removes the session, that is logout.
+func (s *session) Expire() error {
+	if s.session != nil {
+		if err := s.session.Delete(s.store.DB); err != nil {
+			return err
+		}
+		s.session = nil
+		s.user = nil
+	}
+	s.clearCookie()
+	return nil
 }
 
-func (s *session) Expire() error {
-	s.Session().Options.MaxAge = -1
-	s.written = true
-	return s.Save()
+func (s *session) clearCookie() {
+	s.ctx.SetCookie(&http.Cookie{
+		Name:     s.store.CookieName,
+		Value:    "",
+		Path:     "/",
+		MaxAge:   -1,
+		HttpOnly: true,
+	})
 }
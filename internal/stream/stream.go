@@ -0,0 +1,103 @@
+package stream
+
+import (
+	"sync"
+	"time"
+
+	"github.com/Code-Hex/vegeta/internal/model"
+)
+
+// bufferSize is the number of data which a subscriber can hold.
+// Data is dropped for slow subscribers rather than blocking ingestion.
+const bufferSize = 64
+
+// Broker delivers ingested data to subscribers of the tag.
+type Broker struct {
+	mu     sync.RWMutex
+	subs   map[uint]map[chan model.Data]struct{}
+	closed bool
+}
+
+func NewBroker() *Broker {
+	return &Broker{
+		subs: make(map[uint]map[chan model.Data]struct{}),
+	}
+}
+
+// Subscribe returns a channel which receives data of the tag and
+// a function to stop the subscription.
+func (b *Broker) Subscribe(tagID uint) (<-chan model.Data, func()) {
+	ch := make(chan model.Data, bufferSize)
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		close(ch)
+		return ch, func() {}
+	}
+	if b.subs[tagID] == nil {
+		b.subs[tagID] = make(map[chan model.Data]struct{})
+	}
+	b.subs[tagID][ch] = struct{}{}
+
+	return ch, func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		// The channel may be already closed by Close.
+		if _, ok := b.subs[tagID][ch]; !ok {
+			return
+		}
+		delete(b.subs[tagID], ch)
+		if len(b.subs[tagID]) == 0 {
+			delete(b.subs, tagID)
+		}
+		close(ch)
+	}
+}
+
+// Close closes channels of all subscribers so that they can finish
+// streaming. Subscriptions after Close receive a closed channel.
+func (b *Broker) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for tagID, subs := range b.subs {
+		for ch := range subs {
+			close(ch)
+		}
+		delete(b.subs, tagID)
+	}
+	b.closed = true
+}
+
+// Subscribers returns the number of subscribers of all tags.
+func (b *Broker) Subscribers() int {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	n := 0
+	for _, subs := range b.subs {
+		n += len(subs)
+	}
+	return n
+}
+
+// Publish sends data to subscribers of the tag. UpdatedAt is set to
+// the current time if it is zero.
+func (b *Broker) Publish(tagID uint, someData ...model.Data) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	subs := b.subs[tagID]
+	if len(subs) == 0 {
+		return
+	}
+	now := time.Now()
+	for _, data := range someData {
+		if data.UpdatedAt.IsZero() {
+			data.UpdatedAt = now
+		}
+		for ch := range subs {
+			select {
+			case ch <- data:
+			default: // slow subscriber
+			}
+		}
+	}
+}
@@ -2,6 +2,7 @@ package utils
 
 import (
 	"crypto/rand"
+	"encoding/base64"
 	"encoding/binary"
 	"encoding/json"
 	"errors"
@@ -45,15 +46,33 @@ func Exists(path string) (bool, error) {
 }
 
 func GetIPAddress() (string, error) {
-	ifaces, err := net.Interfaces()
-	if err != nil {
-		return "", err
+	return FindIPAddress("", false)
+}
+
+// FindIPAddress returns an address of the named interface. If name is empty,
+// all interfaces which are up and not loopback are searched. An IPv4 address is
+// preferred unless preferIPv6 is true, and the other family is used as fallback.
+func FindIPAddress(name string, preferIPv6 bool) (string, error) {
+	var ifaces []net.Interface
+	if name != "" {
+		iface, err := net.InterfaceByName(name)
+		if err != nil {
+			return "", err
+		}
+		ifaces = []net.Interface{*iface}
+	} else {
+		var err error
+		ifaces, err = net.Interfaces()
+		if err != nil {
+			return "", err
+		}
 	}
+	var ips []net.IP
 	for _, iface := range ifaces {
 		if iface.Flags&net.FlagUp == 0 {
 			continue // interface down
 		}
-		if iface.Flags&net.FlagLoopback != 0 {
+		if name == "" && iface.Flags&net.FlagLoopback != 0 {
 			continue // loopback interface
 		}
 		addrs, err := iface.Addrs()
@@ -61,23 +80,48 @@ func GetIPAddress() (string, error) {
 			return "", err
 		}
 		for _, addr := range addrs {
-			var ip net.IP
 			switch v := addr.(type) {
 			case *net.IPNet:
-				ip = v.IP
+				ips = append(ips, v.IP)
 			case *net.IPAddr:
-				ip = v.IP
-			}
-			if ip == nil || ip.IsLoopback() {
-				continue
+				ips = append(ips, v.IP)
 			}
-			ip = ip.To4()
-			if ip == nil {
-				continue // not an ipv4 address
+		}
+	}
+	return chooseIPAddress(ips, name != "", preferIPv6)
+}
+
+// chooseIPAddress returns the first address of the preferred family, or
+// of the other one. Link-local addresses are skipped, because they are
+// not reachable without the zone. Loopback addresses are used only if
+// the interface is named.
+func chooseIPAddress(ips []net.IP, named, preferIPv6 bool) (string, error) {
+	var v4, v6 net.IP
+	for _, ip := range ips {
+		if ip == nil || ip.IsLinkLocalUnicast() {
+			continue
+		}
+		if !named && ip.IsLoopback() {
+			continue
+		}
+		if ip4 := ip.To4(); ip4 != nil {
+			if v4 == nil {
+				v4 = ip4
 			}
-			return ip.String(), nil
+		} else if v6 == nil {
+			v6 = ip
 		}
 	}
+	first, second := v4, v6
+	if preferIPv6 {
+		first, second = v6, v4
+	}
+	if first != nil {
+		return first.String(), nil
+	}
+	if second != nil {
+		return second.String(), nil
+	}
 	return "", errors.New("Failed to get ip address")
 }
 
@@ -86,3 +130,13 @@ func RandomString() string {
 	binary.Read(rand.Reader, binary.LittleEndian, &n)
 	return strconv.FormatUint(n, 36)
 }
+
+// RandomToken returns a url safe random string which is long enough
+// to be used as a secret.
+func RandomToken() string {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b)
+}
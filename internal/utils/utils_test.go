@@ -0,0 +1,72 @@
+package utils
+
+import (
+	"net"
+	"testing"
+)
+
+func TestChooseIPAddress(t *testing.T) {
+	ips := func(addrs ...string) []net.IP {
+		list := make([]net.IP, len(addrs))
+		for i, a := range addrs {
+			list[i] = net.ParseIP(a)
+		}
+		return list
+	}
+	tests := []struct {
+		name       string
+		ips        []net.IP
+		named      bool
+		preferIPv6 bool
+		want       string
+	}{
+		{"ipv4 first", ips("2001:db8::1", "192.0.2.1"), false, false, "192.0.2.1"},
+		{"ipv6 preferred", ips("192.0.2.1", "2001:db8::1"), false, true, "2001:db8::1"},
+		{"ipv6 fallback", ips("2001:db8::1"), false, false, "2001:db8::1"},
+		{"ipv4 fallback", ips("192.0.2.1"), false, true, "192.0.2.1"},
+		{"link local skipped", ips("fe80::1", "169.254.0.1", "2001:db8::2"), false, false, "2001:db8::2"},
+		{"loopback skipped", ips("127.0.0.1", "::1", "192.0.2.1"), false, true, "192.0.2.1"},
+		{"loopback of named interface", ips("127.0.0.1", "::1"), true, true, "::1"},
+		{"none", ips("fe80::1", "127.0.0.1"), false, false, ""},
+	}
+	for _, tt := range tests {
+		got, err := chooseIPAddress(tt.ips, tt.named, tt.preferIPv6)
+		if tt.want == "" {
+			if err == nil {
+				t.Errorf("%s: got %s, want error", tt.name, got)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("%s: got %q, %v, want %q", tt.name, got, err, tt.want)
+		}
+	}
+}
+
+func TestFindIPAddressByName(t *testing.T) {
+	ifaces, err := net.Interfaces()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var lo *net.Interface
+	for i := range ifaces {
+		if ifaces[i].Flags&net.FlagLoopback != 0 && ifaces[i].Flags&net.FlagUp != 0 {
+			lo = &ifaces[i]
+			break
+		}
+	}
+	if lo == nil {
+		t.Skip("no loopback interface")
+	}
+	// The loopback interface is used only by the name.
+	got, err := FindIPAddress(lo.Name, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ip := net.ParseIP(got); ip == nil || !ip.IsLoopback() {
+		t.Errorf("%s has %s, want a loopback address", lo.Name, got)
+	}
+	if _, err := FindIPAddress("no-such-interface0", false); err == nil {
+		t.Error("unknown interface is found")
+	}
+}
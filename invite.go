@@ -0,0 +1,318 @@
+package vegeta
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Code-Hex/vegeta/html"
+	"github.com/Code-Hex/vegeta/internal/model"
+	"github.com/Code-Hex/vegeta/internal/password"
+	"github.com/Code-Hex/vegeta/internal/session"
+	"github.com/labstack/echo"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+type createInvite struct {
+	// Admin is the role of users who register by the invite.
+	Admin   bool `json:"admin" form:"admin"`
+	MaxUses int  `json:"max_uses" form:"max_uses" validate:"min=1,max=1000"`
+	// ExpiresIn is in hours.
+	ExpiresIn int `json:"expires_in" form:"expires_in" validate:"min=1,max=8760"`
+}
+
+func inviteTarget(invite *model.Invite) string {
+	return "invite:" + strconv.FormatUint(uint64(invite.ID), 10)
+}
+
+// createInvite returns the invite and the link to register by it.
+func (c *Context) createInvite(actor *model.User, param *createInvite) (*model.Invite, string, error) {
+	now := c.Now()
+	expiresAt := now.Add(time.Duration(param.ExpiresIn) * time.Hour)
+	invite, token, err := model.CreateInvite(c.DB, actor, param.Admin, param.MaxUses, expiresAt, now)
+	if err != nil {
+		return nil, "", err
+	}
+	c.audit(actor, model.AuditInviteCreate, inviteTarget(invite),
+		adminDetail(invite.Admin)+" max_uses="+strconv.Itoa(invite.MaxUses)+" expires_at="+expiresAt.Format(time.RFC3339),
+	)
+	link := c.baseURL() + "/register?token=" + url.QueryEscape(token)
+	return invite, link, nil
+}
+
+// baseURL returns server.external_url. The host of the request is used
+// if it is not set, but it can be spoofed by clients.
+func (c *Context) baseURL() string {
+	if c.externalURL != "" {
+		return strings.TrimSuffix(c.externalURL, "/")
+	}
+	c.Zap.Warn("server.external_url is not set, so the link is made from the host of the request",
+		zap.String("host", c.Request().Host),
+	)
+	return c.Scheme() + "://" + c.Request().Host
+}
+
+type invitesArgs struct {
+	html.Args
+	invites    []*model.Invite
+	createdURL string
+	reason     string
+	now        time.Time
+}
+
+func (a *invitesArgs) Invites() []*model.Invite { return a.invites }
+func (a *invitesArgs) CreatedURL() string       { return a.createdURL }
+func (a *invitesArgs) Reason() string           { return a.reason }
+
+func (a *invitesArgs) Status(invite *model.Invite) string {
+	switch {
+	case invite.RevokedAt != nil:
+		return "revoked"
+	case invite.Uses >= invite.MaxUses:
+		return "used_up"
+	case !a.now.Before(invite.ExpiresAt):
+		return "expired"
+	}
+	return "active"
+}
+
+func (c *Context) renderInvites(createdURL, reason string) error {
+	invites, err := model.GetInvites(c.DB)
+	if err != nil {
+		return errors.Wrap(err, "Failed to get invites")
+	}
+	args := &invitesArgs{
+		Args:       c.GetUserStatus(),
+		invites:    invites,
+		createdURL: createdURL,
+		reason:     reason,
+		now:        c.Now(),
+	}
+	html.Invites(args, c.Response())
+	return nil
+}
+
+func Invites() echo.HandlerFunc {
+	return call(func(c *Context) error {
+		return c.renderInvites("", "")
+	})
+}
+
+// CreateInvite shows the link only once, because only the hash of
+// the token is stored.
+func CreateInvite() echo.HandlerFunc {
+	return call(func(c *Context) error {
+		param := new(createInvite)
+		if err := c.BindValidate(param); err != nil {
+			return c.renderInvites("", "invalid")
+		}
+		_, link, err := c.createInvite(c.SessionUser(), param)
+		if err != nil {
+			if errors.Cause(err) == model.ErrInvalid {
+				return c.renderInvites("", "invalid")
+			}
+			return errors.Wrap(err, "Failed to create invite")
+		}
+		return c.renderInvites(link, "")
+	})
+}
+
+func RevokeInvite() echo.HandlerFunc {
+	return call(func(c *Context) error {
+		invite, err := model.RevokeInvite(c.DB, c.Param("id"), c.Now())
+		if err != nil {
+			c.Zap.Info("Failed to revoke invite", zap.Error(err))
+			return c.Redirect(http.StatusFound, "/mypage/admin/invites")
+		}
+		c.audit(c.SessionUser(), model.AuditInviteRevoke, inviteTarget(invite), "")
+		return c.Redirect(http.StatusFound, "/mypage/admin/invites")
+	})
+}
+
+type registerArgs struct {
+	html.Args
+	token  string
+	name   string
+	reason string
+	valid  bool
+}
+
+func (r *registerArgs) InviteToken() string { return r.token }
+func (r *registerArgs) Name() string        { return r.name }
+func (r *registerArgs) Reason() string      { return r.reason }
+func (r *registerArgs) IsValid() bool       { return r.valid }
+
+// Register shows the form to register by an invite.
+func Register() echo.HandlerFunc {
+	return call(func(c *Context) error {
+		args := &registerArgs{
+			Args:  c.GetUserStatus(),
+			token: c.QueryParam("token"),
+		}
+		if args.IsAuthed() {
+			return c.Redirect(http.StatusFound, "/mypage")
+		}
+		if _, err := model.FindInvite(c.DB, args.token, c.Now()); err != nil {
+			args.reason = args.T("register.error.invite")
+		} else {
+			args.valid = true
+		}
+		html.Register(args, c.Response())
+		return nil
+	})
+}
+
+// PostRegister creates the user and logs in. The form is shown again
+// with the reason if it fails.
+func PostRegister() echo.HandlerFunc {
+	return call(func(c *Context) error {
+		args := &registerArgs{
+			Args:  c.GetUserStatus(),
+			token: c.FormValue("token"),
+			name:  c.FormValue("username"),
+			valid: true,
+		}
+		pass := c.FormValue("password")
+		switch {
+		case c.checkCSRF() != nil:
+			args.reason = args.T("register.error.csrf")
+		case args.name == "" || pass == "":
+			args.reason = args.T("register.error.empty")
+		case pass != c.FormValue("verify_password"):
+			args.reason = args.T("register.error.password_mismatch")
+		}
+		if args.reason != "" {
+			html.Register(args, c.Response())
+			return nil
+		}
+		user, invite, err := model.Register(c.DB, args.token, args.name, pass, c.PeerAddr(), c.Request().UserAgent(), c.Now())
+		if err != nil {
+			c.Zap.Info("Failed to register",
+				zap.String("username", args.name),
+				zap.String("ip", c.PeerAddr()),
+				zap.Error(err),
+			)
+			if _, ok := errors.Cause(err).(*password.PolicyError); ok {
+				args.reason = apiError(err, "").localize(args.Lang())
+				html.Register(args, c.Response())
+				return nil
+			}
+			switch errors.Cause(err) {
+			case model.ErrNotFound:
+				args.reason = args.T("register.error.invite")
+				args.valid = false
+			case model.ErrAlreadyExists:
+				args.reason = args.T("register.error.exists")
+			default:
+				return errors.Wrap(err, "Failed to register")
+			}
+			html.Register(args, c.Response())
+			return nil
+		}
+		c.audit(user, model.AuditUserRegister, user.Name, inviteTarget(invite)+" "+adminDetail(user.Admin))
+		if err := session.Get(c).Login(user); err != nil {
+			return err
+		}
+		return c.Redirect(http.StatusFound, "/mypage")
+	})
+}
+
+type inviteUseJSON struct {
+	UserID    uint      `json:"user_id"`
+	Name      string    `json:"name"`
+	IPAddress string    `json:"ip_address"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type inviteJSON struct {
+	ID        uint       `json:"id"`
+	Creator   string     `json:"creator"`
+	Admin     bool       `json:"admin"`
+	MaxUses   int        `json:"max_uses"`
+	Uses      int        `json:"uses"`
+	ExpiresAt time.Time  `json:"expires_at"`
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+	// URL is returned only when the invite is created.
+	URL     string           `json:"url,omitempty"`
+	History []*inviteUseJSON `json:"history"`
+}
+
+func newInviteJSON(i *model.Invite) *inviteJSON {
+	history := make([]*inviteUseJSON, len(i.History))
+	for j, u := range i.History {
+		history[j] = &inviteUseJSON{
+			UserID:    u.UserID,
+			Name:      u.Name,
+			IPAddress: u.IPAddress,
+			CreatedAt: u.CreatedAt,
+		}
+	}
+	return &inviteJSON{
+		ID:        i.ID,
+		Creator:   i.Creator,
+		Admin:     i.Admin,
+		MaxUses:   i.MaxUses,
+		Uses:      i.Uses,
+		ExpiresAt: i.ExpiresAt,
+		RevokedAt: i.RevokedAt,
+		CreatedAt: i.CreatedAt,
+		History:   history,
+	}
+}
+
+type invitesJSON struct {
+	Invites []*inviteJSON `json:"invites"`
+}
+
+func GetInvites() echo.HandlerFunc {
+	return call(func(c *Context) error {
+		invites, err := model.GetInvites(c.DB)
+		if err != nil {
+			return apiError(err, "")
+		}
+		result := &invitesJSON{Invites: make([]*inviteJSON, len(invites))}
+		for i, invite := range invites {
+			result.Invites[i] = newInviteJSON(invite)
+		}
+		return c.JSON(http.StatusOK, result)
+	})
+}
+
+func PostInvite() echo.HandlerFunc {
+	return call(func(c *Context) error {
+		param := new(createInvite)
+		if err := c.BindValidate(param); err != nil {
+			return err
+		}
+		actor, err := c.user()
+		if err != nil {
+			return err
+		}
+		invite, link, err := c.createInvite(actor, param)
+		if err != nil {
+			return apiError(err, "")
+		}
+		result := newInviteJSON(invite)
+		result.URL = link
+		return c.JSON(http.StatusCreated, result)
+	})
+}
+
+func DeleteInvite() echo.HandlerFunc {
+	return call(func(c *Context) error {
+		actor, err := c.user()
+		if err != nil {
+			return err
+		}
+		invite, err := model.RevokeInvite(c.DB, c.Param("id"), c.Now())
+		if err != nil {
+			return apiError(err, "")
+		}
+		c.audit(actor, model.AuditInviteRevoke, inviteTarget(invite), "")
+		return c.NoContent(http.StatusNoContent)
+	})
+}
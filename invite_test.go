@@ -0,0 +1,158 @@
+package vegeta
+
+import (
+	"encoding/json"
+	"html"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/Code-Hex/vegeta/internal/model"
+)
+
+var inviteURLRe = regexp.MustCompile(`value="(http[^"]+/register\?token=[^"]+)"`)
+
+// register registers the user by the link of an invite, and returns
+// where it goes.
+func (c *testClient) register(link, name, pass string) string {
+	c.srv.t.Helper()
+	u, err := url.Parse(link)
+	if err != nil {
+		c.srv.t.Fatal(err)
+	}
+	resp, _ := c.postForm("/register", url.Values{
+		"token":           {u.Query().Get("token")},
+		"username":        {name},
+		"password":        {pass},
+		"verify_password": {pass},
+		"csrf_token":      {c.formCSRF(u.RequestURI())},
+	})
+	return resp.Header.Get("Location")
+}
+
+func TestInvitePages(t *testing.T) {
+	s := newTestServer(t)
+	s.createUser("root", "root-password1", true)
+	s.createUser("alice", "alice-password1", false)
+
+	if resp, _ := s.loggedIn("alice", "alice-password1").get("/mypage/admin/invites"); resp.Header.Get("Location") != "/login" {
+		t.Errorf("invites by a user goes to %q, want /login", resp.Header.Get("Location"))
+	}
+
+	root := s.loggedIn("root", "root-password1")
+	resp, body := root.postForm("/mypage/admin/invites", url.Values{
+		"max_uses":   {"1"},
+		"expires_in": {"24"},
+		"csrf_token": {root.formCSRF("/mypage/admin/invites")},
+	})
+	m := inviteURLRe.FindStringSubmatch(body)
+	if resp.StatusCode != http.StatusOK || m == nil {
+		t.Fatalf("create invite: status = %d, no link", resp.StatusCode)
+	}
+	link := html.UnescapeString(m[1])
+
+	if loc := s.client().register(link, "bob", "bob-password1"); loc != "/mypage" {
+		t.Fatalf("register goes to %q, want /mypage", loc)
+	}
+	bob, err := model.FindUserByName(s.DB, "bob")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bob.Admin {
+		t.Error("bob is registered as admin")
+	}
+	// The invite is used up.
+	if loc := s.client().register(link, "carol", "carol-password1"); loc != "" {
+		t.Errorf("register by used invite goes to %q", loc)
+	}
+	if _, err := model.FindUserByName(s.DB, "carol"); err == nil {
+		t.Error("carol is registered by used invite")
+	}
+}
+
+func TestRevokeInvite(t *testing.T) {
+	s := newTestServer(t)
+	s.createUser("root", "root-password1", true)
+	root := s.loggedIn("root", "root-password1")
+	token := root.pageToken("/mypage/settings")
+
+	resp, body := root.api(http.MethodPost, "/api/v1/invites", token, root.csrfCookie(), &createInvite{
+		MaxUses:   5,
+		ExpiresIn: 24,
+	})
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("create invite: status = %d: %s", resp.StatusCode, body)
+	}
+	var invite inviteJSON
+	if err := json.Unmarshal([]byte(body), &invite); err != nil {
+		t.Fatal(err)
+	}
+	if invite.URL == "" {
+		t.Fatal("created invite has no url")
+	}
+	if resp, body := root.api(http.MethodPost, "/api/v1/invites", token, root.csrfCookie(), &createInvite{
+		MaxUses:   0,
+		ExpiresIn: 24,
+	}); resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("invalid invite: status = %d, want 400: %s", resp.StatusCode, body)
+	}
+
+	id := strconv.FormatUint(uint64(invite.ID), 10)
+	resp, _ = root.postForm("/mypage/admin/invites/"+id+"/revoke", url.Values{
+		"csrf_token": {root.formCSRF("/mypage/admin/invites")},
+	})
+	if loc := resp.Header.Get("Location"); loc != "/mypage/admin/invites" {
+		t.Fatalf("revoke goes to %q", loc)
+	}
+	if loc := s.client().register(invite.URL, "bob", "bob-password1"); loc != "" {
+		t.Errorf("register by revoked invite goes to %q", loc)
+	}
+
+	resp, body = root.api(http.MethodGet, "/api/v1/invites", token, "", nil)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("invites: status = %d: %s", resp.StatusCode, body)
+	}
+	var result invitesJSON
+	if err := json.Unmarshal([]byte(body), &result); err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Invites) != 1 || result.Invites[0].RevokedAt == nil || result.Invites[0].URL != "" {
+		t.Errorf("invites = %+v, want one revoked invite without url", result.Invites)
+	}
+	if resp, _ := root.api(http.MethodDelete, "/api/v1/invites/"+id+"0", token, root.csrfCookie(), nil); resp.StatusCode != http.StatusNotFound {
+		t.Errorf("revoke unknown invite: status = %d, want 404", resp.StatusCode)
+	}
+}
+
+func TestInviteLinkUsesExternalURL(t *testing.T) {
+	config := DefaultConfig()
+	config.Server.ExternalURL = "https://vegeta.example.com/"
+	s := newTestServer(t, WithConfig(config))
+	s.createUser("root", "root-password1", true)
+	root := s.loggedIn("root", "root-password1")
+
+	req := root.newRequest(http.MethodPost, "/api/v1/invites", strings.NewReader(`{"max_uses":1,"expires_in":1}`))
+	req.Host = "attacker.test"
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", authScheme+" "+root.pageToken("/mypage/settings"))
+	req.Header.Set(csrfHeader, root.csrfCookie())
+	// The jar does not send cookies to another host.
+	u, _ := url.Parse(s.URL)
+	for _, cookie := range root.Jar.Cookies(u) {
+		req.AddCookie(cookie)
+	}
+	resp, body := s.client().do(req)
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("create invite: status = %d: %s", resp.StatusCode, body)
+	}
+	var invite inviteJSON
+	if err := json.Unmarshal([]byte(body), &invite); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(invite.URL, "https://vegeta.example.com/register?token=") {
+		t.Errorf("url = %s, want the external url", invite.URL)
+	}
+}
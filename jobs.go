@@ -0,0 +1,67 @@
+package vegeta
+
+import (
+	"context"
+	"time"
+
+	"github.com/Code-Hex/vegeta/internal/model"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// cleanupInterval is how often expired sessions are removed.
+const cleanupInterval = time.Hour
+
+// job runs in the background while the server is serving. The status
+// of each run is exported as metrics.
+type job struct {
+	name     string
+	interval time.Duration
+	run      func(now time.Time) error
+}
+
+func (v *Vegeta) jobs() []job {
+	return []job{
+		{
+			name:     "cleanup_sessions",
+			interval: cleanupInterval,
+			run: func(now time.Time) error {
+				return errors.Wrap(model.DeleteExpiredSessions(v.DB, now), "Failed to delete expired sessions")
+			},
+		},
+	}
+}
+
+// startJobs runs jobs until stopJobs is called.
+func (v *Vegeta) startJobs() {
+	ctx, cancel := context.WithCancel(context.Background())
+	v.stopJobs = cancel
+	for _, j := range v.jobs() {
+		v.jobsDone.Add(1)
+		go func(j job) {
+			defer v.jobsDone.Done()
+			ticker := time.NewTicker(j.interval)
+			defer ticker.Stop()
+			for {
+				v.runJob(j)
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+				}
+			}
+		}(j)
+	}
+}
+
+func (v *Vegeta) runJob(j job) {
+	start := v.now()
+	err := j.run(start)
+	took := time.Since(start)
+	v.metrics.observeJob(j.name, start, took, err)
+	if err != nil {
+		v.Error("Failed to run job", zap.String("job", j.name), zap.Error(err))
+		return
+	}
+	v.Logger.Debug("Finished job", zap.String("job", j.name), zap.Duration("took", took))
+}
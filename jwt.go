@@ -0,0 +1,165 @@
+package vegeta
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/Code-Hex/vegeta/internal/common"
+	"github.com/Code-Hex/vegeta/internal/model"
+	"github.com/Code-Hex/vegeta/internal/session"
+	jwt "github.com/dgrijalva/jwt-go"
+	"github.com/labstack/echo"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// defaultKeyID is the id of secret. Tokens without kid, which are
+// signed before keys have ids, are verified by it.
+const defaultKeyID = "default"
+
+const (
+	refreshCookieName = "vegeta-refresh"
+	refreshPath       = apiV1Prefix + "/token/refresh"
+)
+
+// keyring signs tokens by the signing key and verifies them by the key
+// of their kid.
+type keyring struct {
+	signing string
+	keys    map[string][]byte
+}
+
+func (k *keyring) sign(claims jwt.Claims) (string, error) {
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	token.Header["kid"] = k.signing
+	return token.SignedString(k.keys[k.signing])
+}
+
+// parse verifies the signature of token. Claims such as the expiration
+// are not validated, so that callers check them by the clock of the server.
+func (k *keyring) parse(token string, claims jwt.Claims) (*jwt.Token, error) {
+	p := &jwt.Parser{
+		ValidMethods:         []string{jwt.SigningMethodHS256.Alg()},
+		SkipClaimsValidation: true,
+	}
+	return p.ParseWithClaims(token, claims, k.key)
+}
+
+func (k *keyring) key(t *jwt.Token) (interface{}, error) {
+	kid, _ := t.Header["kid"].(string)
+	if kid == "" {
+		kid = defaultKeyID
+	}
+	key, ok := k.keys[kid]
+	if !ok {
+		return nil, errors.Errorf("Unknown key id: %s", kid)
+	}
+	return key, nil
+}
+
+// parseAPIToken verifies the access token which is issued for pages.
+func (c *Context) parseAPIToken(token string) (*jwt.Token, error) {
+	claims := new(apiVegetaClaims)
+	t, err := c.jwt.keys.parse(token, claims)
+	if err != nil {
+		return nil, err
+	}
+	if !claims.VerifyExpiresAt(c.Now().Unix(), true) {
+		return nil, errors.New("Token is expired")
+	}
+	return t, nil
+}
+
+// PageToken authenticates the deprecated APIs under /mypage by the access
+// token for pages, and sets the token to the context by key.
+func PageToken(key string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return call(func(c *Context) error {
+			auth := c.Request().Header.Get(echo.HeaderAuthorization)
+			l := len(authScheme)
+			if len(auth) <= l+1 || auth[:l] != authScheme {
+				return echo.NewHTTPError(http.StatusBadRequest, "missing or malformed jwt")
+			}
+			token, err := c.parseAPIToken(auth[l+1:])
+			if err != nil {
+				return &echo.HTTPError{
+					Code:     http.StatusUnauthorized,
+					Message:  "invalid or expired jwt",
+					Internal: err,
+				}
+			}
+			c.Set(key, token)
+			return next(c)
+		})
+	}
+}
+
+// pageToken returns the access token which the page embeds. The refresh
+// token is set to the cookie too, unless the cookie has a valid one of
+// the session.
+func (c *Context) pageToken(user *model.User) (string, time.Time, error) {
+	sessionID := session.Get(c).ID()
+	cookie, err := c.Cookie(refreshCookieName)
+	if err != nil || !model.IsValidRefreshToken(c.DB, cookie.Value, sessionID, c.Now()) {
+		refresh, err := model.CreateRefreshToken(c.DB, user.ID, sessionID, c.Now(), c.jwt.refreshTTL)
+		if err != nil {
+			return "", time.Time{}, errors.Wrap(err, "Failed to create refresh token")
+		}
+		c.setRefreshCookie(refresh, c.jwt.refreshTTL)
+	}
+	return c.CreateAPIToken(user.Name)
+}
+
+// The refresh token is sent only to the refresh endpoint.
+func (c *Context) setRefreshCookie(token string, maxAge time.Duration) {
+	c.SetCookie(&http.Cookie{
+		Name:     refreshCookieName,
+		Value:    token,
+		Path:     refreshPath,
+		MaxAge:   int(maxAge / time.Second),
+		Secure:   c.Request().TLS != nil,
+		HttpOnly: true,
+		SameSite: http.SameSiteStrictMode,
+	})
+}
+
+type accessTokenJSON struct {
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// RefreshAPIToken issues a new access token by the refresh token in the
+// cookie, which is replaced by a new one.
+func RefreshAPIToken() echo.HandlerFunc {
+	return call(func(c *Context) error {
+		// The cookie is sent by browsers, so it is protected like forms.
+		if err := c.checkCSRF(); err != nil {
+			return err
+		}
+		cookie, err := c.Cookie(refreshCookieName)
+		if err != nil || cookie.Value == "" {
+			return newAPIError(http.StatusUnauthorized, common.CodeUnauthorized, "error.refresh_token")
+		}
+		user, refresh, err := model.UseRefreshToken(c.DB, cookie.Value, c.Now(), c.jwt.refreshTTL)
+		if err != nil {
+			if errors.Cause(err) != model.ErrUnauthorized {
+				return apiError(err, "")
+			}
+			c.Zap.Info("Refresh token is rejected",
+				zap.String("ip", c.PeerAddr()),
+				zap.Error(err),
+			)
+			c.setRefreshCookie("", -time.Second)
+			return newAPIError(http.StatusUnauthorized, common.CodeUnauthorized, "error.refresh_token").wrap(err)
+		}
+		c.setRefreshCookie(refresh, c.jwt.refreshTTL)
+		token, expiresAt, err := c.CreateAPIToken(user.Name)
+		if err != nil {
+			return apiError(err, "")
+		}
+		return c.JSON(http.StatusOK, &accessTokenJSON{
+			Token:     token,
+			ExpiresAt: expiresAt,
+		})
+	})
+}
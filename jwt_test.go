@@ -0,0 +1,74 @@
+package vegeta
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+	"testing"
+)
+
+// refreshCookie returns the refresh token which is set to the cookie.
+func (c *testClient) refreshCookie() string {
+	u, _ := url.Parse(c.srv.URL + refreshPath)
+	for _, cookie := range c.Jar.Cookies(u) {
+		if cookie.Name == refreshCookieName {
+			return cookie.Value
+		}
+	}
+	return ""
+}
+
+func (c *testClient) refresh() (*http.Response, *accessTokenJSON) {
+	c.srv.t.Helper()
+	resp, body := c.api(http.MethodPost, refreshPath, "", c.csrfCookie(), nil)
+	if resp.StatusCode != http.StatusOK {
+		return resp, nil
+	}
+	token := new(accessTokenJSON)
+	if err := json.Unmarshal([]byte(body), token); err != nil {
+		c.srv.t.Fatal(err)
+	}
+	return resp, token
+}
+
+func TestRefreshAPIToken(t *testing.T) {
+	s := newTestServer(t)
+	s.createUser("root", "root-password1", true)
+	alice := s.createUser("alice", "alice-password1", false)
+
+	c := s.loggedIn("alice", "alice-password1")
+	c.pageToken("/mypage/settings")
+	first := c.refreshCookie()
+	if first == "" {
+		t.Fatal("settings page sets no refresh token")
+	}
+
+	if resp, _ := c.api(http.MethodPost, refreshPath, "", "", nil); resp.StatusCode != http.StatusForbidden {
+		t.Errorf("refresh without CSRF token: status = %d, want 403", resp.StatusCode)
+	}
+	resp, token := c.refresh()
+	if token == nil {
+		t.Fatalf("refresh: status = %d", resp.StatusCode)
+	}
+	if c.refreshCookie() == first {
+		t.Error("refresh token is not replaced")
+	}
+	if resp, body := c.api(http.MethodGet, "/api/v1/users/me", token.Token, "", nil); resp.StatusCode != http.StatusOK {
+		t.Errorf("refreshed token: status = %d: %s", resp.StatusCode, body)
+	}
+
+	// Pages of alice stop renewing tokens once an admin revokes them.
+	root := s.loggedIn("root", "root-password1")
+	resp, body := root.api(http.MethodDelete, "/api/v1/users/"+strconv.Itoa(int(alice.ID))+"/refresh-tokens",
+		root.pageToken("/mypage/settings"), root.csrfCookie(), nil)
+	if resp.StatusCode != http.StatusNoContent {
+		t.Fatalf("revoke refresh tokens: status = %d: %s", resp.StatusCode, body)
+	}
+	if resp, _ := c.refresh(); resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("revoked refresh token: status = %d, want 401", resp.StatusCode)
+	}
+	if c.refreshCookie() != "" {
+		t.Error("revoked refresh token is kept in the cookie")
+	}
+}
@@ -0,0 +1,200 @@
+package vegeta
+
+import (
+	"context"
+	"crypto/subtle"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/labstack/echo"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.uber.org/zap"
+)
+
+const metricsNamespace = "vegeta"
+
+// otherTags is the tag_id label of tags over features.metrics_max_tags.
+const otherTags = "other"
+
+// readyTimeout is the deadline to ping the database on /healthz and /readyz.
+const readyTimeout = 3 * time.Second
+
+type metrics struct {
+	registry    *prometheus.Registry
+	token       string
+	requests    *prometheus.CounterVec
+	latency     *prometheus.HistogramVec
+	ingested    *prometheus.CounterVec
+	jobRuns     *prometheus.CounterVec
+	jobDuration *prometheus.GaugeVec
+	jobLastRun  *prometheus.GaugeVec
+	jobLastOK   *prometheus.GaugeVec
+
+	mu      sync.Mutex
+	maxTags int
+	tags    map[uint]string // labels of tags which have their own series
+}
+
+func (v *Vegeta) newMetrics() *metrics {
+	m := &metrics{
+		registry: prometheus.NewRegistry(),
+		token:    v.config.Features.MetricsToken,
+		maxTags:  v.config.Features.MetricsMaxTags,
+		tags:     make(map[uint]string),
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "http_requests_total",
+			Help:      "Number of HTTP requests.",
+		}, []string{"method", "path", "status"}),
+		latency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Name:      "http_request_duration_seconds",
+			Help:      "Latency of HTTP requests.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method", "path"}),
+		// Names of users and tags are personal, so tags are labeled by
+		// their IDs. The number of them is bounded by maxTags.
+		ingested: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "ingested_data_total",
+			Help:      "Number of ingested data by the tag.",
+		}, []string{"tag_id"}),
+		jobRuns: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "job_runs_total",
+			Help:      "Number of runs of background jobs by the result.",
+		}, []string{"job", "result"}),
+		jobDuration: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "job_duration_seconds",
+			Help:      "Duration of the last run of background jobs.",
+		}, []string{"job"}),
+		jobLastRun: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "job_last_run_timestamp_seconds",
+			Help:      "Time when background jobs ran last.",
+		}, []string{"job"}),
+		jobLastOK: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "job_last_success_timestamp_seconds",
+			Help:      "Time when background jobs succeeded last.",
+		}, []string{"job"}),
+	}
+	m.registry.MustRegister(
+		m.requests,
+		m.latency,
+		m.ingested,
+		m.jobRuns,
+		m.jobDuration,
+		m.jobLastRun,
+		m.jobLastOK,
+		prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "stream_subscribers",
+			Help:      "Number of clients which are subscribing the data stream.",
+		}, func() float64 { return float64(v.broker.Subscribers()) }),
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+	if v.DB != nil {
+		m.registry.MustRegister(collectors.NewDBStatsCollector(v.DB.DB(), metricsNamespace))
+	}
+	return m
+}
+
+func (m *metrics) observeRequest(method, path string, status int, latency time.Duration) {
+	if m == nil {
+		return
+	}
+	m.requests.WithLabelValues(method, path, strconv.Itoa(status)).Inc()
+	m.latency.WithLabelValues(method, path).Observe(latency.Seconds())
+}
+
+func (m *metrics) observeIngestion(tagID uint, n int) {
+	if m == nil {
+		return
+	}
+	m.ingested.WithLabelValues(m.tagLabel(tagID)).Add(float64(n))
+}
+
+// tagLabel returns the label of the tag. Tags after the first maxTags
+// ones share the label "other".
+func (m *metrics) tagLabel(tagID uint) string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if label, ok := m.tags[tagID]; ok {
+		return label
+	}
+	if len(m.tags) >= m.maxTags {
+		return otherTags
+	}
+	label := strconv.FormatUint(uint64(tagID), 10)
+	m.tags[tagID] = label
+	return label
+}
+
+func (m *metrics) observeJob(job string, start time.Time, took time.Duration, err error) {
+	if m == nil {
+		return
+	}
+	m.jobLastRun.WithLabelValues(job).Set(float64(start.Unix()))
+	m.jobDuration.WithLabelValues(job).Set(took.Seconds())
+	if err != nil {
+		m.jobRuns.WithLabelValues(job, "failure").Inc()
+		return
+	}
+	m.jobRuns.WithLabelValues(job, "success").Inc()
+	m.jobLastOK.WithLabelValues(job).Set(float64(start.Unix()))
+}
+
+// handler serves the metrics. If the token is set, scrapers must send it
+// as the bearer token.
+func (m *metrics) handler() echo.HandlerFunc {
+	h := echo.WrapHandler(promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{}))
+	if m.token == "" {
+		return h
+	}
+	want := []byte(authScheme + " " + m.token)
+	return func(c echo.Context) error {
+		got := []byte(c.Request().Header.Get(echo.HeaderAuthorization))
+		if subtle.ConstantTimeCompare(got, want) != 1 {
+			return echo.ErrUnauthorized
+		}
+		return h(c)
+	}
+}
+
+// pingDB reports whether the database answers in readyTimeout.
+func (c *Context) pingDB() bool {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), readyTimeout)
+	defer cancel()
+	if err := c.DB.DB().PingContext(ctx); err != nil {
+		c.Zap.Error("Database is not ready", zap.Error(err))
+		return false
+	}
+	return true
+}
+
+// Healthz reports whether the process is alive and can reach the database.
+func Healthz() echo.HandlerFunc {
+	return call(func(c *Context) error {
+		if !c.pingDB() {
+			return c.String(http.StatusServiceUnavailable, "database is not reachable")
+		}
+		return c.String(http.StatusOK, "ok")
+	})
+}
+
+// Readyz reports whether we can accept requests.
+func Readyz() echo.HandlerFunc {
+	return call(func(c *Context) error {
+		if !c.pingDB() {
+			return c.String(http.StatusServiceUnavailable, "database is not ready")
+		}
+		return c.String(http.StatusOK, "ok")
+	})
+}
@@ -0,0 +1,144 @@
+package vegeta
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Code-Hex/vegeta/internal/model"
+)
+
+func newMetricsServer(t *testing.T) *testServer {
+	return newTestServer(t, WithFeatures(FeatureConfig{Metrics: true, MetricsToken: "scrape"}))
+}
+
+func (c *testClient) scrape() string {
+	c.srv.t.Helper()
+	resp, body := c.api(http.MethodGet, "/metrics", "scrape", "", nil)
+	if resp.StatusCode != http.StatusOK {
+		c.srv.t.Fatalf("/metrics returns %d", resp.StatusCode)
+	}
+	return body
+}
+
+func TestMetricsDisabledByDefault(t *testing.T) {
+	s := newTestServer(t)
+	if resp, _ := s.client().get("/metrics"); resp.StatusCode != http.StatusNotFound {
+		t.Errorf("/metrics returns %d, want %d", resp.StatusCode, http.StatusNotFound)
+	}
+}
+
+func TestMetricsToken(t *testing.T) {
+	s := newMetricsServer(t)
+	c := s.client()
+	for _, token := range []string{"", "wrong"} {
+		if resp, _ := c.api(http.MethodGet, "/metrics", token, "", nil); resp.StatusCode != http.StatusUnauthorized {
+			t.Errorf("/metrics by %q returns %d, want %d", token, resp.StatusCode, http.StatusUnauthorized)
+		}
+	}
+	c.scrape()
+}
+
+func TestMetricsStatusOfErrors(t *testing.T) {
+	s := newMetricsServer(t)
+	c := s.client()
+	if resp, _ := c.get("/api/v1/tags"); resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("/api/v1/tags returns %d", resp.StatusCode)
+	}
+	want := `vegeta_http_requests_total{method="GET",path="/api/v1/tags",status="401"} 1`
+	if body := c.scrape(); !strings.Contains(body, want) {
+		t.Errorf("metrics do not have %s", want)
+	}
+}
+
+func TestMetricsIngestionPerTag(t *testing.T) {
+	s := newTestServer(t, WithFeatures(FeatureConfig{Metrics: true, MetricsToken: "scrape", MetricsMaxTags: 2}))
+	alice := s.createUser("alice", "alice-password1", false)
+	c := s.client()
+	var lines []string
+	for i, name := range []string{"kitchen", "bedroom", "garage"} {
+		if err := alice.AddTag(s.DB, name); err != nil {
+			t.Fatal(err)
+		}
+		tag, err := alice.FindByTagName(s.DB, name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp, body := c.api(http.MethodPost, "/api/v1/tags/"+name+"/data", alice.Token, "", &postData{
+			Payload:    "{}",
+			Hostname:   "host",
+			RemoteAddr: "127.0.0.1",
+		})
+		if resp.StatusCode != http.StatusNoContent {
+			t.Fatalf("post data: status = %d: %s", resp.StatusCode, body)
+		}
+		label := strconv.FormatUint(uint64(tag.ID), 10)
+		if i >= 2 {
+			label = otherTags
+		}
+		lines = append(lines, `vegeta_ingested_data_total{tag_id="`+label+`"} 1`)
+	}
+
+	body := c.scrape()
+	for _, line := range lines {
+		if !strings.Contains(body, line) {
+			t.Errorf("metrics do not have %s", line)
+		}
+	}
+	for _, personal := range []string{"alice", "kitchen"} {
+		if strings.Contains(body, personal) {
+			t.Errorf("metrics have %q", personal)
+		}
+	}
+}
+
+func TestCleanupJob(t *testing.T) {
+	s := newMetricsServer(t)
+	u := s.createUser("alice", "password1234", false)
+	now := time.Now()
+	if _, _, err := model.CreateSession(s.DB, u, "ua", "127.0.0.1", now.Add(-2*time.Hour), time.Hour); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := model.CreateSession(s.DB, u, "ua", "127.0.0.1", now, time.Hour); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, j := range s.V.jobs() {
+		s.V.runJob(j)
+	}
+
+	var n int
+	if err := s.DB.Model(&model.Session{}).Count(&n).Error; err != nil {
+		t.Fatal(err)
+	}
+	if n != 1 {
+		t.Errorf("%d sessions remain, want 1", n)
+	}
+	body := s.client().scrape()
+	for _, want := range []string{
+		`vegeta_job_runs_total{job="cleanup_sessions",result="success"} 1`,
+		`vegeta_job_last_success_timestamp_seconds{job="cleanup_sessions"}`,
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("metrics do not have %s", want)
+		}
+	}
+}
+
+func TestHealthzPingsDB(t *testing.T) {
+	s := newTestServer(t)
+	c := s.client()
+	for _, path := range []string{"/healthz", "/readyz"} {
+		if resp, _ := c.get(path); resp.StatusCode != http.StatusOK {
+			t.Errorf("%s returns %d, want %d", path, resp.StatusCode, http.StatusOK)
+		}
+	}
+	s.DB.Close()
+	for _, path := range []string{"/healthz", "/readyz"} {
+		if resp, _ := c.get(path); resp.StatusCode != http.StatusServiceUnavailable {
+			t.Errorf("%s without the database returns %d, want %d", path, resp.StatusCode, http.StatusServiceUnavailable)
+		}
+	}
+}
@@ -3,61 +3,141 @@ package vegeta
 import (
 	"fmt"
 	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
 	"time"
 
+	"github.com/Code-Hex/vegeta/internal/utils"
 	"github.com/labstack/echo"
 	"go.uber.org/zap"
 )
 
+const (
+	headerXRequestID = "X-Request-ID"
+	requestIDKey     = "request_id"
+)
+
+// validRequestID accepts request ids which are generated by proxies.
+var validRequestID = regexp.MustCompile(`^[A-Za-z0-9._-]{1,64}$`)
+
+// RequestID assigns an id to each request. The id is taken over from
+// X-Request-ID header if it is valid, and returned in the response header.
+func RequestID() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			id := c.Request().Header.Get(headerXRequestID)
+			if !validRequestID.MatchString(id) {
+				id = utils.GenerateUUID()
+			}
+			c.Set(requestIDKey, id)
+			c.Response().Header().Set(headerXRequestID, id)
+			return next(c)
+		}
+	}
+}
+
+func requestID(c echo.Context) string {
+	id, _ := c.Get(requestIDKey).(string)
+	return id
+}
+
+const redacted = "[REDACTED]"
+
+var (
+	sensitiveParams = []string{"token", "password", "secret"}
+	bearerToken     = regexp.MustCompile(`(?i)(bearer\s+)\S+`)
+	// sensitiveValue matches parameters of queries and forms, such as
+	// access_token=... and new_password=..., which are quoted in errors.
+	sensitiveValue = regexp.MustCompile(`(?i)(\w*(?:token|password|secret)\w*=)[^&\s"]+`)
+)
+
+// redactQuery masks values of parameters which may contain credentials.
+func redactQuery(query url.Values) string {
+	if len(query) == 0 {
+		return ""
+	}
+	q := make(url.Values, len(query))
+	for k, vs := range query {
+		q[k] = vs
+		for _, s := range sensitiveParams {
+			if strings.Contains(strings.ToLower(k), s) {
+				q[k] = []string{redacted}
+				break
+			}
+		}
+	}
+	return q.Encode()
+}
+
+// redact masks bearer tokens and values of sensitive parameters in a message.
+func redact(msg string) string {
+	msg = bearerToken.ReplaceAllString(msg, "${1}"+redacted)
+	return sensitiveValue.ReplaceAllString(msg, "${1}"+redacted)
+}
+
 func (v *Vegeta) LogHandler() echo.MiddlewareFunc {
 	return func(before echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
 			start := time.Now()
-			err := before(c)
+			// The status is written by the error handler, so errors are
+			// handled here before it is read.
+			if err := before(c); err != nil {
+				c.Error(err)
+			}
 			stop := time.Now()
 
 			w, r := c.Response(), c.Request()
+			v.metrics.observeRequest(r.Method, c.Path(), w.Status, stop.Sub(start))
 			v.Logger.Info(
 				"Detected access",
 				zap.String("status", fmt.Sprintf("%d: %s", w.Status, http.StatusText(w.Status))),
 				zap.String("method", r.Method),
 				zap.String("path", r.URL.Path),
+				zap.String("query", redactQuery(r.URL.Query())),
 				zap.String("useragent", r.UserAgent()),
-				zap.String("remote_ip", r.RemoteAddr),
+				zap.String("remote_ip", peerAddr(r, v.getTrustedProxies())),
 				zap.Int64("latency", stop.Sub(start).Nanoseconds()/int64(time.Microsecond)),
+				zap.String(requestIDKey, requestID(c)),
 			)
-			return err
+			return nil
 		}
 	}
 }
 
+// ErrorHandler writes errors as a ResultJSON with the error code.
 func (v *Vegeta) ErrorHandler(err error, c echo.Context) {
-	var (
-		code = http.StatusInternalServerError
-		msg  interface{}
-	)
-
-	if he, ok := err.(*echo.HTTPError); ok {
-		code = he.Code
-		msg = he.Message
-	} else {
-		msg = http.StatusText(code)
-	}
-	if _, ok := msg.(string); ok {
-		msg = echo.Map{"message": msg}
+	var e *APIError
+	switch err := err.(type) {
+	case *APIError:
+		e = err
+	case *echo.HTTPError:
+		e = httpError(err)
+	default:
+		e = apiError(err, "")
 	}
 
 	if !c.Response().Committed {
 		if c.Request().Method == echo.HEAD { // Issue #608
-			if err := c.NoContent(code); err != nil {
-				goto ERROR
-			}
+			err = c.NoContent(e.Status)
 		} else {
-			if err := c.JSON(code, msg); err != nil {
-				goto ERROR
-			}
+			result := e.result(requestLang(c))
+			result.RequestID = requestID(c)
+			err = c.JSON(e.Status, result)
 		}
+		if err != nil {
+			v.Logger.Error("Failed to write error", zap.Error(err))
+		}
+	}
+
+	log := v.Logger.Info
+	if e.Status >= http.StatusInternalServerError {
+		log = v.Logger.Error
 	}
-ERROR:
-	v.Logger.Error("Error", zap.String("reason", err.Error()))
+	log("Error",
+		zap.Int("status", e.Status),
+		zap.String("code", e.Code),
+		zap.String("reason", redact(e.Error())),
+		zap.String(requestIDKey, requestID(c)),
+	)
 }
@@ -0,0 +1,130 @@
+package vegeta
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestRedactQuery(t *testing.T) {
+	tests := []struct {
+		query string
+		want  string
+	}{
+		{"", ""},
+		{"page=2&tag=room", "page=2&tag=room"},
+		{"token=abc&page=2", "page=2&token=%5BREDACTED%5D"},
+		{"access_token=abc&Password=p&client_secret=s", "Password=%5BREDACTED%5D&access_token=%5BREDACTED%5D&client_secret=%5BREDACTED%5D"},
+		{"token=a&token=b", "token=%5BREDACTED%5D"},
+	}
+	for _, tt := range tests {
+		q, err := url.ParseQuery(tt.query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := redactQuery(q); got != tt.want {
+			t.Errorf("%q: got %q, want %q", tt.query, got, tt.want)
+		}
+	}
+}
+
+func TestRedact(t *testing.T) {
+	tests := []struct {
+		msg  string
+		want string
+	}{
+		{"Failed to parse", "Failed to parse"},
+		{"Authorization: Bearer abc.def.ghi", "Authorization: Bearer [REDACTED]"},
+		{"bearer abc is expired", "bearer [REDACTED] is expired"},
+		{`Get "https://example.com/api?access_token=abc&page=2": EOF`, `Get "https://example.com/api?access_token=[REDACTED]&page=2": EOF`},
+		{"name=alice&password=hunter2&new_password=hunter3", "name=alice&password=[REDACTED]&new_password=[REDACTED]"},
+		{"client_secret=s3cret is invalid", "client_secret=[REDACTED] is invalid"},
+	}
+	for _, tt := range tests {
+		if got := redact(tt.msg); got != tt.want {
+			t.Errorf("%q: got %q, want %q", tt.msg, got, tt.want)
+		}
+	}
+}
+
+func TestLogsHaveNoCredentials(t *testing.T) {
+	core, logs := observer.New(zapcore.DebugLevel)
+	s := newTestServer(t, WithLogger(zap.New(core)))
+	s.createUser("alice", "password1234", false)
+	c := s.client()
+
+	req := c.newRequest(http.MethodGet, "/api/v1/tags?token=secrettoken&page=2", nil)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer secretbearer")
+	if resp, body := c.do(req); resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("%d %s", resp.StatusCode, body)
+	}
+	c.postForm("/login?next=/mypage&token=secrettoken", url.Values{
+		"name":     {"alice"},
+		"password": {"secretpassword"},
+	})
+
+	var found bool
+	for _, e := range logs.All() {
+		line := fmt.Sprint(e.Message, e.ContextMap())
+		for _, secret := range []string{"secrettoken", "secretbearer", "secretpassword"} {
+			if strings.Contains(line, secret) {
+				t.Errorf("%s is logged: %s", secret, line)
+			}
+		}
+		if e.Message == "Detected access" && e.ContextMap()["path"] == "/api/v1/tags" {
+			found = true
+			if q := e.ContextMap()["query"]; q != "page=2&token=%5BREDACTED%5D" {
+				t.Errorf("query is logged as %v", q)
+			}
+		}
+	}
+	if !found {
+		t.Error("access is not logged")
+	}
+}
+
+func TestRequestIDInErrors(t *testing.T) {
+	s := newTestServer(t)
+	c := s.client()
+
+	tests := []struct {
+		header string
+		keep   bool
+	}{
+		{"req-123.abc_DEF", true},
+		{"", false},
+		{"bad id with spaces", false},
+		{strings.Repeat("a", 65), false},
+	}
+	for _, tt := range tests {
+		req := c.newRequest(http.MethodGet, "/api/v1/tags", nil)
+		if tt.header != "" {
+			req.Header.Set(headerXRequestID, tt.header)
+		}
+		resp, body := c.do(req)
+		if resp.StatusCode != http.StatusUnauthorized {
+			t.Fatalf("%d %s", resp.StatusCode, body)
+		}
+		var result struct {
+			RequestID string `json:"request_id"`
+		}
+		if err := json.Unmarshal([]byte(body), &result); err != nil {
+			t.Fatal(err)
+		}
+		id := resp.Header.Get(headerXRequestID)
+		if result.RequestID == "" || result.RequestID != id {
+			t.Errorf("%q: request id is %q in body and %q in header", tt.header, result.RequestID, id)
+		}
+		if tt.keep != (id == tt.header) {
+			t.Errorf("%q: request id is %q", tt.header, id)
+		}
+	}
+}
@@ -0,0 +1,282 @@
+package vegeta
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/Code-Hex/vegeta/internal/model"
+	"github.com/Code-Hex/vegeta/internal/session"
+	"github.com/Code-Hex/vegeta/internal/utils"
+	"github.com/coreos/go-oidc/v3/oidc"
+	jwt "github.com/dgrijalva/jwt-go"
+	"github.com/labstack/echo"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"golang.org/x/oauth2"
+)
+
+const (
+	// The state, the nonce and the PKCE verifier are kept in the cookie
+	// while the user is at the provider.
+	oidcCookieName = "vegeta-oidc"
+	oidcAudience   = "oidc"
+	oidcStateAge   = 10 * time.Minute
+)
+
+// oidcProvider logs users in by an OpenID Connect provider. The discovery
+// document is fetched on the first login so that vegeta starts even if
+// the provider is down.
+type oidcProvider struct {
+	config OIDCConfig
+	client *http.Client
+	now    func() time.Time
+
+	mu       sync.Mutex
+	oauth2   *oauth2.Config
+	verifier *oidc.IDTokenVerifier
+}
+
+func newOIDCProvider(config OIDCConfig, client *http.Client, now func() time.Time) *oidcProvider {
+	return &oidcProvider{
+		config: config,
+		client: client,
+		now:    now,
+	}
+}
+
+// context makes requests to the provider by the client of the provider.
+func (p *oidcProvider) context(ctx context.Context) context.Context {
+	if p.client != nil {
+		return oidc.ClientContext(ctx, p.client)
+	}
+	return ctx
+}
+
+func (p *oidcProvider) discover(ctx context.Context) (*oauth2.Config, *oidc.IDTokenVerifier, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.oauth2 != nil {
+		return p.oauth2, p.verifier, nil
+	}
+	provider, err := oidc.NewProvider(p.context(ctx), p.config.Issuer)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "Failed to discover OpenID Connect provider")
+	}
+	scopes := []string{oidc.ScopeOpenID}
+	for _, s := range p.config.Scopes {
+		if s != oidc.ScopeOpenID {
+			scopes = append(scopes, s)
+		}
+	}
+	p.oauth2 = &oauth2.Config{
+		ClientID:     p.config.ClientID,
+		ClientSecret: p.config.ClientSecret,
+		RedirectURL:  p.config.RedirectURL,
+		Endpoint:     provider.Endpoint(),
+		Scopes:       scopes,
+	}
+	p.verifier = provider.Verifier(&oidc.Config{
+		ClientID: p.config.ClientID,
+		Now:      p.now,
+	})
+	return p.oauth2, p.verifier, nil
+}
+
+// identity maps the claims of the ID token by the config.
+func (p *oidcProvider) identity(token *oidc.IDToken) (model.Identity, error) {
+	var claims map[string]interface{}
+	if err := token.Claims(&claims); err != nil {
+		return model.Identity{}, errors.Wrap(err, "Failed to read claims")
+	}
+	name, _ := claims[p.config.UsernameClaim].(string)
+	if name == "" {
+		return model.Identity{}, errors.Errorf("Claim %q is missing", p.config.UsernameClaim)
+	}
+	id := model.Identity{
+		Issuer:  token.Issuer,
+		Subject: token.Subject,
+		Name:    name,
+	}
+	if p.config.AdminGroup != "" {
+		admin := false
+		for _, g := range stringsClaim(claims[p.config.GroupsClaim]) {
+			if g == p.config.AdminGroup {
+				admin = true
+				break
+			}
+		}
+		id.Admin = &admin
+	}
+	return id, nil
+}
+
+// stringsClaim accepts a string or an array of strings.
+func stringsClaim(v interface{}) []string {
+	switch v := v.(type) {
+	case string:
+		return []string{v}
+	case []interface{}:
+		s := make([]string, 0, len(v))
+		for _, e := range v {
+			if str, ok := e.(string); ok {
+				s = append(s, str)
+			}
+		}
+		return s
+	}
+	return nil
+}
+
+type oidcStateClaims struct {
+	State    string `json:"state"`
+	Nonce    string `json:"nonce"`
+	Verifier string `json:"verifier"`
+	jwt.StandardClaims
+}
+
+func (c *Context) setOIDCState(state *oidcStateClaims) error {
+	token, err := c.jwt.keys.sign(state)
+	if err != nil {
+		return errors.Wrap(err, "Failed to sign state")
+	}
+	c.SetCookie(&http.Cookie{
+		Name:     oidcCookieName,
+		Value:    token,
+		Path:     "/auth/oidc",
+		MaxAge:   int(oidcStateAge / time.Second),
+		Secure:   c.Request().TLS != nil,
+		HttpOnly: true,
+	})
+	return nil
+}
+
+// oidcState returns the state which is set before the redirect to
+// the provider. It can be used only once.
+func (c *Context) oidcState() (*oidcStateClaims, error) {
+	cookie, err := c.Cookie(oidcCookieName)
+	if err != nil || cookie.Value == "" {
+		return nil, errors.New("State cookie is missing")
+	}
+	c.SetCookie(&http.Cookie{
+		Name:     oidcCookieName,
+		Value:    "",
+		Path:     "/auth/oidc",
+		MaxAge:   -1,
+		HttpOnly: true,
+	})
+	state := new(oidcStateClaims)
+	_, err = c.jwt.keys.parse(cookie.Value, state)
+	if err != nil {
+		return nil, errors.Wrap(err, "Invalid state cookie")
+	}
+	if !state.VerifyAudience(oidcAudience, true) || !state.VerifyExpiresAt(c.Now().Unix(), true) {
+		return nil, errors.New("State cookie is expired")
+	}
+	return state, nil
+}
+
+// OIDCLogin redirects to the provider.
+func OIDCLogin() echo.HandlerFunc {
+	return call(func(c *Context) error {
+		conf, _, err := c.oidc.discover(c.Request().Context())
+		if err != nil {
+			c.Zap.Error("Failed to start OpenID Connect login", zap.Error(err))
+			return c.Redirect(http.StatusFound, "/login?error=oidc")
+		}
+		state := &oidcStateClaims{
+			State:    utils.RandomToken(),
+			Nonce:    utils.RandomToken(),
+			Verifier: oauth2.GenerateVerifier(),
+			StandardClaims: jwt.StandardClaims{
+				Audience:  oidcAudience,
+				ExpiresAt: c.Now().Add(oidcStateAge).Unix(),
+			},
+		}
+		if err := c.setOIDCState(state); err != nil {
+			return err
+		}
+		url := conf.AuthCodeURL(
+			state.State,
+			oidc.Nonce(state.Nonce),
+			oauth2.S256ChallengeOption(state.Verifier),
+		)
+		return c.Redirect(http.StatusFound, url)
+	})
+}
+
+// OIDCCallback logs the user in by the ID token from the provider.
+func OIDCCallback() echo.HandlerFunc {
+	return call(func(c *Context) error {
+		user, err := c.oidcLogin()
+		if err != nil {
+			c.Zap.Warn("Failed to log in by OpenID Connect",
+				zap.String("ip", c.PeerAddr()),
+				zap.Error(err),
+			)
+			switch errors.Cause(err) {
+			case model.ErrAlreadyExists:
+				return c.Redirect(http.StatusFound, "/login?error=oidc_conflict")
+			case model.ErrSuspended:
+				return c.Redirect(http.StatusFound, "/login?error=suspended")
+			}
+			return c.Redirect(http.StatusFound, "/login?error=oidc")
+		}
+		if user.TOTPEnabled {
+			if err := c.setPendingLogin(user); err != nil {
+				return err
+			}
+			return c.Redirect(http.StatusFound, "/login/totp")
+		}
+		if err := session.Get(c).Login(user); err != nil {
+			return err
+		}
+		return c.Redirect(http.StatusFound, "/mypage")
+	})
+}
+
+func (c *Context) oidcLogin() (*model.User, error) {
+	state, err := c.oidcState()
+	if err != nil {
+		return nil, err
+	}
+	if c.QueryParam("state") != state.State {
+		return nil, errors.New("State mismatch")
+	}
+	if e := c.QueryParam("error"); e != "" {
+		return nil, errors.Errorf("Provider returned %s: %s", e, c.QueryParam("error_description"))
+	}
+	conf, verifier, err := c.oidc.discover(c.Request().Context())
+	if err != nil {
+		return nil, err
+	}
+	ctx := c.oidc.context(c.Request().Context())
+	token, err := conf.Exchange(ctx, c.QueryParam("code"), oauth2.VerifierOption(state.Verifier))
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to exchange code")
+	}
+	raw, ok := token.Extra("id_token").(string)
+	if !ok {
+		return nil, errors.New("ID token is missing")
+	}
+	idToken, err := verifier.Verify(ctx, raw)
+	if err != nil {
+		return nil, errors.Wrap(err, "Invalid ID token")
+	}
+	if idToken.Nonce != state.Nonce {
+		return nil, errors.New("Nonce mismatch")
+	}
+	id, err := c.oidc.identity(idToken)
+	if err != nil {
+		return nil, err
+	}
+	return model.LoginOIDC(
+		c.DB,
+		id,
+		c.oidc.config.LinkByName,
+		c.PeerAddr(),
+		c.Request().UserAgent(),
+		c.Now(),
+	)
+}
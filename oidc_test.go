@@ -0,0 +1,187 @@
+package vegeta
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/Code-Hex/vegeta/internal/mockoidc"
+	"github.com/Code-Hex/vegeta/internal/model"
+)
+
+// oidcRedirectURL is not served. The callback is called on the test
+// server with the query which the provider redirects to.
+const oidcRedirectURL = "http://vegeta.test/auth/oidc/callback"
+
+// newOIDCServer serves vegeta with the mock provider which logs in user.
+func newOIDCServer(t *testing.T, user string, linkByName bool) (*testServer, *mockoidc.Provider) {
+	t.Helper()
+	var p *mockoidc.Provider
+	ps := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		p.ServeHTTP(w, r)
+	}))
+	t.Cleanup(ps.Close)
+	p, err := mockoidc.New(ps.URL, "vegeta", "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.User = user
+
+	config := DefaultConfig()
+	config.OIDC = OIDCConfig{
+		Issuer:        ps.URL,
+		ClientID:      "vegeta",
+		ClientSecret:  "secret",
+		RedirectURL:   oidcRedirectURL,
+		UsernameClaim: "preferred_username",
+		GroupsClaim:   "groups",
+		AdminGroup:    "admin",
+		LinkByName:    linkByName,
+	}
+	return newTestServer(t, WithConfig(config)), p
+}
+
+// oidcLogin goes through the provider and returns where the callback
+// goes. authorize and callback may change the query on the way.
+func (c *testClient) oidcLogin(authorize, callback func(q url.Values)) string {
+	c.srv.t.Helper()
+	resp, _ := c.get("/auth/oidc")
+	u, err := url.Parse(resp.Header.Get("Location"))
+	if err != nil {
+		c.srv.t.Fatal(err)
+	}
+	if authorize != nil {
+		q := u.Query()
+		authorize(q)
+		u.RawQuery = q.Encode()
+	}
+
+	// The provider does not share the cookies of vegeta.
+	provider := &http.Client{
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	resp, err = provider.Get(u.String())
+	if err != nil {
+		c.srv.t.Fatal(err)
+	}
+	resp.Body.Close()
+	back, err := url.Parse(resp.Header.Get("Location"))
+	if err != nil {
+		c.srv.t.Fatal(err)
+	}
+	if back.Scheme+"://"+back.Host+back.Path != oidcRedirectURL {
+		c.srv.t.Fatalf("provider redirects to %s", back)
+	}
+	q := back.Query()
+	if callback != nil {
+		callback(q)
+	}
+	resp, _ = c.get("/auth/oidc/callback?" + q.Encode())
+	return resp.Header.Get("Location")
+}
+
+func TestOIDCLogin(t *testing.T) {
+	tests := []struct {
+		name                string
+		authorize, callback func(q url.Values)
+		want                string
+	}{
+		{"success", nil, nil, "/mypage"},
+		{
+			"state mismatch", nil,
+			func(q url.Values) { q.Set("state", "forged") },
+			"/login?error=oidc",
+		},
+		{
+			"nonce mismatch",
+			func(q url.Values) { q.Set("nonce", "forged") }, nil,
+			"/login?error=oidc",
+		},
+		{
+			"PKCE mismatch",
+			// The code is stolen by someone who does not know the verifier.
+			func(q url.Values) { q.Set("code_challenge", "forged") }, nil,
+			"/login?error=oidc",
+		},
+		{
+			"error from provider", nil,
+			func(q url.Values) { q.Set("error", "access_denied") },
+			"/login?error=oidc",
+		},
+	}
+	for _, tt := range tests {
+		s, _ := newOIDCServer(t, "carol", false)
+		c := s.client()
+		if got := c.oidcLogin(tt.authorize, tt.callback); got != tt.want {
+			t.Errorf("%s: goes to %q, want %q", tt.name, got, tt.want)
+		}
+		resp, _ := c.get("/mypage")
+		if loggedIn := resp.StatusCode == http.StatusOK; loggedIn != (tt.want == "/mypage") {
+			t.Errorf("%s: logged in = %v", tt.name, loggedIn)
+		}
+	}
+}
+
+func TestOIDCProvisionsUser(t *testing.T) {
+	s, p := newOIDCServer(t, "carol", false)
+	// The first user is always admin.
+	s.createUser("root", "root-password1", true)
+	p.Groups = []string{"admin"}
+	if got := s.client().oidcLogin(nil, nil); got != "/mypage" {
+		t.Fatalf("first login goes to %q", got)
+	}
+	u, err := model.FindUserByName(s.DB, "carol")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.OIDCIssuer != p.Issuer || u.OIDCSubject != "carol" || !u.Admin {
+		t.Errorf("user = issuer %q, subject %q, admin %v", u.OIDCIssuer, u.OIDCSubject, u.Admin)
+	}
+
+	// Admin rights follow the group on each login.
+	p.Groups = nil
+	if got := s.client().oidcLogin(nil, nil); got != "/mypage" {
+		t.Fatalf("second login goes to %q", got)
+	}
+	var n int
+	if err := s.DB.Model(&model.User{}).Where("name = ?", "carol").Count(&n).Error; err != nil {
+		t.Fatal(err)
+	}
+	if u, _ = model.FindUserByName(s.DB, "carol"); n != 1 || u.Admin {
+		t.Errorf("%d users of carol, admin = %v", n, u.Admin)
+	}
+}
+
+func TestOIDCLinkByName(t *testing.T) {
+	tests := []struct {
+		name       string
+		admin      bool
+		linkByName bool
+		want       string
+	}{
+		{"not linked", false, false, "/login?error=oidc_conflict"},
+		{"linked", false, true, "/mypage"},
+		// Anyone who registers the name at the provider must not
+		// take over admins.
+		{"admin", true, true, "/login?error=oidc_conflict"},
+	}
+	for _, tt := range tests {
+		s, _ := newOIDCServer(t, "dave", tt.linkByName)
+		// The first user is always admin.
+		s.createUser("root", "root-password1", true)
+		s.createUser("dave", "dave-password1", tt.admin)
+		if got := s.client().oidcLogin(nil, nil); got != tt.want {
+			t.Errorf("%s: goes to %q, want %q", tt.name, got, tt.want)
+		}
+		u, err := model.FindUserByName(s.DB, "dave")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if linked := u.OIDCSubject != ""; linked != (tt.want == "/mypage") {
+			t.Errorf("%s: linked = %v", tt.name, linked)
+		}
+	}
+}
@@ -0,0 +1,255 @@
+package vegeta
+
+import (
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Code-Hex/vegeta/internal/common"
+	"github.com/labstack/echo"
+)
+
+// apiRoute describes a route of the versioned API.
+type apiRoute struct {
+	Method  string
+	Path    string
+	Group   string
+	Summary string
+	// Admin routes are only for admin users.
+	Admin bool
+	// Public routes do not need the bearer token.
+	Public bool
+	// APIToken routes accept API tokens and client certificates. Others
+	// accept only the token which is issued for pages, with the session.
+	APIToken bool
+	// Query and Body are structs which the handler binds.
+	Query interface{}
+	Body  interface{}
+	// Status is the status on success. Result is written with it
+	// unless it is nil.
+	Status int
+	Result interface{}
+	// Stream routes write server-sent events.
+	Stream bool
+	// Download is the media type of the file which is written
+	// instead of Result.
+	Download string
+	Handler  echo.HandlerFunc
+}
+
+var (
+	timeType      = reflect.TypeOf(time.Time{})
+	queryTimeType = reflect.TypeOf(queryTime{})
+)
+
+// openAPI builds an OpenAPI 3 document from routes.
+type openAPI struct {
+	schemas echo.Map
+}
+
+func newOpenAPI(prefix string, routes []apiRoute) echo.Map {
+	b := &openAPI{schemas: echo.Map{}}
+	errorResponse := echo.Map{
+		"description": "Error",
+		"content": echo.Map{
+			echo.MIMEApplicationJSON: echo.Map{"schema": b.schema(reflect.TypeOf(common.ResultJSON{}))},
+		},
+	}
+	paths := echo.Map{}
+	for _, r := range routes {
+		path, params := openAPIPath(r.Path)
+		if r.Query != nil {
+			params = append(params, b.queryParams(reflect.TypeOf(r.Query))...)
+		}
+		op := echo.Map{
+			"summary":     r.Summary,
+			"operationId": strings.ToLower(r.Method) + strings.NewReplacer("/", "_", ":", "").Replace(r.Path),
+			"tags":        []string{r.Group},
+			"responses": echo.Map{
+				strconv.Itoa(r.Status): b.response(r),
+				"default":              errorResponse,
+			},
+		}
+		if len(params) > 0 {
+			op["parameters"] = params
+		}
+		if r.Body != nil {
+			op["requestBody"] = echo.Map{
+				"required": true,
+				"content": echo.Map{
+					echo.MIMEApplicationJSON: echo.Map{"schema": b.schema(reflect.TypeOf(r.Body))},
+				},
+			}
+		}
+		var desc []string
+		if r.Admin {
+			desc = append(desc, "Only for admin users.")
+		}
+		if !r.Public && !r.APIToken {
+			desc = append(desc, "Only by the token of pages along with the session cookie.")
+		}
+		if len(desc) > 0 {
+			op["description"] = strings.Join(desc, " ")
+		}
+		if r.Public {
+			op["security"] = []echo.Map{}
+		}
+		item, ok := paths[path].(echo.Map)
+		if !ok {
+			item = echo.Map{}
+			paths[path] = item
+		}
+		item[strings.ToLower(r.Method)] = op
+	}
+	return echo.Map{
+		"openapi": "3.0.0",
+		"info": echo.Map{
+			"title":       name,
+			"version":     version,
+			"description": msg,
+		},
+		"servers":  []echo.Map{{"url": prefix}},
+		"paths":    paths,
+		"security": []echo.Map{{"bearer": []string{}}},
+		"components": echo.Map{
+			"schemas": b.schemas,
+			"securitySchemes": echo.Map{
+				"bearer": echo.Map{
+					"type":        "http",
+					"scheme":      "bearer",
+					"description": "API token of the user, or the token which is issued for pages",
+				},
+			},
+		},
+	}
+}
+
+func (b *openAPI) response(r apiRoute) echo.Map {
+	res := echo.Map{"description": http.StatusText(r.Status)}
+	switch {
+	case r.Stream:
+		res["content"] = echo.Map{
+			"text/event-stream": echo.Map{"schema": echo.Map{"type": "string"}},
+		}
+	case r.Download != "":
+		res["content"] = echo.Map{
+			r.Download: echo.Map{"schema": echo.Map{"type": "string", "format": "binary"}},
+		}
+	case r.Result != nil:
+		res["content"] = echo.Map{
+			echo.MIMEApplicationJSON: echo.Map{"schema": b.schema(reflect.TypeOf(r.Result))},
+		}
+	}
+	return res
+}
+
+// openAPIPath converts ":name" of echo to "{name}".
+func openAPIPath(path string) (string, []echo.Map) {
+	var params []echo.Map
+	segs := strings.Split(path, "/")
+	for i, s := range segs {
+		if strings.HasPrefix(s, ":") {
+			name := s[1:]
+			segs[i] = "{" + name + "}"
+			params = append(params, echo.Map{
+				"name":     name,
+				"in":       "path",
+				"required": true,
+				"schema":   echo.Map{"type": "string"},
+			})
+		}
+	}
+	return strings.Join(segs, "/"), params
+}
+
+func (b *openAPI) queryParams(t reflect.Type) []echo.Map {
+	var params []echo.Map
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name := f.Tag.Get("query")
+		if name == "" {
+			continue
+		}
+		params = append(params, echo.Map{
+			"name":     name,
+			"in":       "query",
+			"required": isRequired(f),
+			"schema":   b.schema(f.Type),
+		})
+	}
+	return params
+}
+
+func (b *openAPI) schema(t reflect.Type) echo.Map {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == timeType || t == queryTimeType {
+		return echo.Map{"type": "string", "format": "date-time"}
+	}
+	switch t.Kind() {
+	case reflect.Bool:
+		return echo.Map{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return echo.Map{"type": "integer"}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return echo.Map{"type": "integer", "minimum": 0}
+	case reflect.Float32, reflect.Float64:
+		return echo.Map{"type": "number"}
+	case reflect.String:
+		return echo.Map{"type": "string"}
+	case reflect.Slice, reflect.Array:
+		return echo.Map{"type": "array", "items": b.schema(t.Elem())}
+	case reflect.Map:
+		return echo.Map{"type": "object", "additionalProperties": b.schema(t.Elem())}
+	case reflect.Struct:
+		name := t.Name()
+		if name == "" {
+			return b.object(t)
+		}
+		if _, ok := b.schemas[name]; !ok {
+			b.schemas[name] = echo.Map{} // for recursive types
+			b.schemas[name] = b.object(t)
+		}
+		return echo.Map{"$ref": "#/components/schemas/" + name}
+	}
+	return echo.Map{}
+}
+
+func (b *openAPI) object(t reflect.Type) echo.Map {
+	props := echo.Map{}
+	var required []string
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" { // unexported
+			continue
+		}
+		name := strings.SplitN(f.Tag.Get("json"), ",", 2)[0]
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		props[name] = b.schema(f.Type)
+		if isRequired(f) {
+			required = append(required, name)
+		}
+	}
+	o := echo.Map{"type": "object", "properties": props}
+	if len(required) > 0 {
+		o["required"] = required
+	}
+	return o
+}
+
+func isRequired(f reflect.StructField) bool {
+	for _, rule := range strings.Split(f.Tag.Get("validate"), ",") {
+		if rule == "required" {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,44 @@
+package vegeta
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestOpenAPI(t *testing.T) {
+	s := newTestServer(t)
+	resp, body := s.client().get(apiV1Prefix + "/openapi.json")
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("status = %d", resp.StatusCode)
+	}
+	var spec struct {
+		Paths map[string]map[string]struct {
+			Tags      []string               `json:"tags"`
+			Security  []interface{}          `json:"security"`
+			Responses map[string]interface{} `json:"responses"`
+		} `json:"paths"`
+	}
+	if err := json.Unmarshal([]byte(body), &spec); err != nil {
+		t.Fatal(err)
+	}
+	// Every registered route is in the document.
+	for _, r := range s.V.apiV1Routes() {
+		path, _ := openAPIPath(r.Path)
+		op, ok := spec.Paths[path][strings.ToLower(r.Method)]
+		if !ok {
+			t.Errorf("%s %s is not documented", r.Method, path)
+			continue
+		}
+		if len(op.Tags) != 1 || op.Tags[0] != r.Group {
+			t.Errorf("%s %s: tags = %v, want %s", r.Method, path, op.Tags, r.Group)
+		}
+		if r.Public != (op.Security != nil) {
+			t.Errorf("%s %s: security = %v", r.Method, path, op.Security)
+		}
+	}
+	if _, ok := spec.Paths["/tags/{name}/data"]["get"].Responses["200"]; !ok {
+		t.Error("GET /tags/{name}/data has no response of 200")
+	}
+}
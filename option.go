@@ -1,59 +1,75 @@
 package vegeta
 
 import (
-	"bytes"
-	"fmt"
+	"net"
+	"net/http"
+	"time"
 
-	"reflect"
-
-	"github.com/Code-Hex/exit"
-	flags "github.com/jessevdk/go-flags"
+	"github.com/jinzhu/gorm"
+	"go.uber.org/zap"
 )
 
-const indent = "        "
+// Option configures Vegeta on New. Options are applied in order,
+// so WithConfig should come first if it is used with WithFeatures.
+type Option func(*Vegeta)
 
-// Options struct for parse command line arguments
-type Options struct {
-	Help    bool `short:"h" long:"help" description:"show this message"`
-	Version bool `short:"v" long:"version" description:"print the version"`
+// WithConfig uses config instead of the default one.
+func WithConfig(config *Config) Option {
+	return func(v *Vegeta) {
+		c := *config
+		v.config = &c
+	}
+}
 
-	Port       int  `short:"p" long:"port" description:"specify the port number" default:"3000"`
-	Migrate    bool `long:"migrate" description:"migrate mysql for this program"`
-	StackTrace bool `long:"trace" description:"display detail error messages"`
+// WithDB uses db instead of opening MySQL by the config.
+// It is not closed by Close.
+func WithDB(db *gorm.DB) Option {
+	return func(v *Vegeta) {
+		v.DB = db
+	}
 }
 
-func (opts *Options) parse(argv []string) ([]string, error) {
-	p := flags.NewParser(opts, flags.None)
-	args, err := p.ParseArgs(argv)
-	if err != nil {
-		return nil, exit.MakeDataErr(err)
+// WithLogger uses logger instead of making it by the config.
+// The log settings are not reloaded then.
+func WithLogger(logger *zap.Logger) Option {
+	return func(v *Vegeta) {
+		v.Logger = logger
 	}
-	return args, nil
 }
 
-func (opts Options) usage() []byte {
-	buf := bytes.Buffer{}
-	fmt.Fprintf(&buf, `%s: %s
-Usage: %s [options]
-Options:
-`, version, msg, name)
+// WithSecret uses secret as the key "default" to sign tokens instead of the config.
+func WithSecret(secret []byte) Option {
+	return func(v *Vegeta) {
+		v.secret = secret
+	}
+}
 
-	t := reflect.TypeOf(opts)
-	for i := 0; i < t.NumField(); i++ {
-		tag := t.Field(i).Tag
-		desc := tag.Get("description")
-		var o string
-		if s := tag.Get("short"); s != "" {
-			o = fmt.Sprintf("-%s, --%s", tag.Get("short"), tag.Get("long"))
-		} else {
-			o = fmt.Sprintf("--%s", tag.Get("long"))
-		}
-		fmt.Fprintf(&buf, "  %-21s %s\n", o, desc)
+// WithListener makes Serve accept connections on li instead of
+// listening on the port in the config.
+func WithListener(li net.Listener) Option {
+	return func(v *Vegeta) {
+		v.listener = li
+	}
+}
+
+// WithClock replaces time.Now, which is used for tokens and pages.
+func WithClock(now func() time.Time) Option {
+	return func(v *Vegeta) {
+		v.now = now
+	}
+}
 
-		if deflt := tag.Get("default"); deflt != "" {
-			fmt.Fprintf(&buf, "  %-21s   default: --%s='%s'\n", indent, tag.Get("long"), deflt)
-		}
+// WithHTTPClient uses client to call the OpenID Connect provider
+// instead of http.DefaultClient.
+func WithHTTPClient(client *http.Client) Option {
+	return func(v *Vegeta) {
+		v.httpClient = client
 	}
+}
 
-	return buf.Bytes()
+// WithFeatures enables optional features.
+func WithFeatures(features FeatureConfig) Option {
+	return func(v *Vegeta) {
+		v.config.Features = features
+	}
 }
@@ -0,0 +1,90 @@
+package vegeta
+
+import (
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo"
+	"github.com/pkg/errors"
+)
+
+func parseTrustedProxies(list []string) ([]*net.IPNet, error) {
+	nets := make([]*net.IPNet, 0, len(list))
+	for _, s := range list {
+		if !strings.Contains(s, "/") {
+			ip := parseIP(s)
+			if ip == nil {
+				return nil, errors.Errorf("Invalid trusted proxy: %s", s)
+			}
+			if ip.To4() != nil {
+				s = ip.String() + "/32"
+			} else {
+				s = ip.String() + "/128"
+			}
+		}
+		_, n, err := net.ParseCIDR(s)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Invalid trusted proxy: %s", s)
+		}
+		nets = append(nets, n)
+	}
+	return nets, nil
+}
+
+// parseIP parses an address which may be in brackets or have the zone of
+// IPv6 such as fe80::1%eth0. The zone is dropped, because addresses are
+// recorded and compared without it.
+func parseIP(s string) net.IP {
+	s = strings.TrimSuffix(strings.TrimPrefix(s, "["), "]")
+	if i := strings.LastIndexByte(s, '%'); i >= 0 {
+		s = s[:i]
+	}
+	return net.ParseIP(s)
+}
+
+func isTrusted(ip net.IP, trusted []*net.IPNet) bool {
+	if ip == nil {
+		return false
+	}
+	for _, n := range trusted {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// peerAddr returns the address of the client which is connected to us.
+// Forwarded headers are honored only if they are sent from trusted proxies.
+func peerAddr(r *http.Request, trusted []*net.IPNet) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	peer := parseIP(host)
+	if peer == nil {
+		return host
+	}
+	if !isTrusted(peer, trusted) {
+		return peer.String()
+	}
+	if xff := r.Header.Get(echo.HeaderXForwardedFor); xff != "" {
+		addrs := strings.Split(xff, ",")
+		// The rightmost address which is not a trusted proxy is the client.
+		for i := len(addrs) - 1; i >= 0; i-- {
+			addr := strings.TrimSpace(addrs[i])
+			ip := parseIP(addr)
+			if ip == nil {
+				break
+			}
+			if !isTrusted(ip, trusted) || i == 0 {
+				return ip.String()
+			}
+		}
+	}
+	if ip := parseIP(strings.TrimSpace(r.Header.Get(echo.HeaderXRealIP))); ip != nil {
+		return ip.String()
+	}
+	return peer.String()
+}
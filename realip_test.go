@@ -0,0 +1,81 @@
+package vegeta
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestParseTrustedProxies(t *testing.T) {
+	tests := []struct {
+		list []string
+		want []string
+	}{
+		{[]string{"127.0.0.1"}, []string{"127.0.0.1/32"}},
+		{[]string{"10.0.0.0/8", "::1"}, []string{"10.0.0.0/8", "::1/128"}},
+		{[]string{"fe80::1%eth0"}, []string{"fe80::1/128"}},
+		{[]string{"[2001:db8::1]"}, []string{"2001:db8::1/128"}},
+		{[]string{"proxy.local"}, nil},
+		{[]string{"10.0.0.0/33"}, nil},
+	}
+	for _, tt := range tests {
+		nets, err := parseTrustedProxies(tt.list)
+		if tt.want == nil {
+			if err == nil {
+				t.Errorf("%v is parsed", tt.list)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%v: %v", tt.list, err)
+			continue
+		}
+		for i, n := range nets {
+			if n.String() != tt.want[i] {
+				t.Errorf("%v: got %s, want %s", tt.list, n, tt.want[i])
+			}
+		}
+	}
+}
+
+func TestPeerAddr(t *testing.T) {
+	trusted, err := parseTrustedProxies([]string{"10.0.0.1", "10.0.1.0/24", "fe80::1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name       string
+		remoteAddr string
+		xff, xrip  string
+		want       string
+	}{
+		{"direct", "192.0.2.1:1234", "", "", "192.0.2.1"},
+		{"untrusted peer with xff", "192.0.2.1:1234", "198.51.100.1", "", "192.0.2.1"},
+		{"untrusted peer with x-real-ip", "192.0.2.1:1234", "", "198.51.100.1", "192.0.2.1"},
+		{"trusted proxy", "10.0.0.1:1234", "198.51.100.1", "", "198.51.100.1"},
+		{"chain of trusted proxies", "10.0.0.1:1234", "198.51.100.1, 10.0.1.5", "", "198.51.100.1"},
+		{"spoofed hop before the client", "10.0.0.1:1234", "203.0.113.9, 198.51.100.1, 10.0.1.5", "", "198.51.100.1"},
+		{"all trusted", "10.0.0.1:1234", "10.0.1.7, 10.0.1.5", "", "10.0.1.7"},
+		{"invalid hop", "10.0.0.1:1234", "unknown, 10.0.1.5", "", "10.0.0.1"},
+		{"x-real-ip from trusted proxy", "10.0.0.1:1234", "", "198.51.100.1", "198.51.100.1"},
+		{"ipv6 peer", "[2001:db8::1]:1234", "", "", "2001:db8::1"},
+		{"ipv6 peer with zone", "[fe80::2%eth0]:1234", "198.51.100.1", "", "fe80::2"},
+		{"trusted ipv6 proxy with zone", "[fe80::1%eth0]:1234", "2001:db8::5", "", "2001:db8::5"},
+		{"ipv6 hop with zone", "10.0.0.1:1234", "fe80::3%eth1", "", "fe80::3"},
+	}
+	for _, tt := range tests {
+		r, err := http.NewRequest(http.MethodGet, "/", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		r.RemoteAddr = tt.remoteAddr
+		if tt.xff != "" {
+			r.Header.Set("X-Forwarded-For", tt.xff)
+		}
+		if tt.xrip != "" {
+			r.Header.Set("X-Real-Ip", tt.xrip)
+		}
+		if got := peerAddr(r, trusted); got != tt.want {
+			t.Errorf("%s: got %s, want %s", tt.name, got, tt.want)
+		}
+	}
+}
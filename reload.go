@@ -0,0 +1,153 @@
+package vegeta
+
+import (
+	"net"
+	"reflect"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap/zapcore"
+)
+
+// Reload applies settings of config which can be changed without
+// restarting. The listener is kept as it is. Everything which can fail is
+// prepared first, so that nothing is applied if any of them fails.
+func (v *Vegeta) Reload(config *Config) error {
+	proxies, err := parseTrustedProxies(config.Server.TrustedProxies)
+	if err != nil {
+		return err
+	}
+	jwt, err := config.JWT.policy(v.secret)
+	if err != nil {
+		return err
+	}
+	if err := config.Password.params().Validate(); err != nil {
+		return err
+	}
+	var core zapcore.Core
+	if v.logCore != nil {
+		core, err = v.newLogCore(config.Log)
+		if err != nil {
+			return errors.Wrap(err, "Failed to reload logger")
+		}
+	}
+	var certs *certSet
+	if v.certs != nil && config.TLS.enabled() {
+		certs, err = loadCerts(config.TLS)
+		if err != nil {
+			return err
+		}
+	}
+
+	if config.Server.Port != v.config.Server.Port || config.Server.ExternalURL != v.config.Server.ExternalURL ||
+		config.Database != v.config.Database || config.Secret != v.config.Secret {
+		v.Warn("Changes of server.port, server.external_url, database and secret require restart. Rotate jwt.keys instead of secret")
+	}
+	if !reflect.DeepEqual(config.OIDC, v.config.OIDC) {
+		v.Warn("Changes of oidc require restart")
+	}
+	if v.certs != nil && !config.TLS.enabled() {
+		v.Warn("Disabling tls requires restart")
+	} else if v.certs == nil && config.TLS.enabled() {
+		v.Warn("Enabling tls requires restart")
+	}
+
+	// The params are validated above.
+	config.Password.Apply()
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if core != nil {
+		v.logCore.swap(core)
+		v.config.Log = config.Log
+	}
+	v.trustedProxies = proxies
+	v.config.Server.TrustedProxies = config.Server.TrustedProxies
+	v.login = config.Login.policy()
+	v.config.Login = config.Login
+	v.jwt = jwt
+	v.config.JWT = config.JWT
+	if certs != nil {
+		v.certs.set(config.TLS, certs)
+		v.config.TLS = config.TLS
+	}
+	return nil
+}
+
+func (v *Vegeta) getTrustedProxies() []*net.IPNet {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+	return v.trustedProxies
+}
+
+func (v *Vegeta) getLoginPolicy() loginPolicy {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+	return v.login
+}
+
+func (v *Vegeta) getJWTPolicy() jwtPolicy {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+	return v.jwt
+}
+
+// logCore is a zapcore.Core whose destination can be swapped on reload
+// while loggers derived from it keep working.
+type logCore struct {
+	holder *atomic.Value // holds coreHolder
+	fields []zapcore.Field
+}
+
+// coreHolder keeps the concrete type in atomic.Value the same.
+type coreHolder struct{ zapcore.Core }
+
+func newSwappableCore(core zapcore.Core) *logCore {
+	holder := new(atomic.Value)
+	holder.Store(coreHolder{core})
+	return &logCore{holder: holder}
+}
+
+func (c *logCore) swap(core zapcore.Core) {
+	prev := c.load()
+	c.holder.Store(coreHolder{core})
+	prev.Sync()
+}
+
+func (c *logCore) load() zapcore.Core {
+	return c.holder.Load().(coreHolder).Core
+}
+
+func (c *logCore) Enabled(l zapcore.Level) bool {
+	return c.load().Enabled(l)
+}
+
+func (c *logCore) With(fields []zapcore.Field) zapcore.Core {
+	fs := make([]zapcore.Field, 0, len(c.fields)+len(fields))
+	fs = append(fs, c.fields...)
+	return &logCore{
+		holder: c.holder,
+		fields: append(fs, fields...),
+	}
+}
+
+func (c *logCore) Check(ent zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(ent.Level) {
+		return ce.AddCore(ent, c)
+	}
+	return ce
+}
+
+func (c *logCore) Write(ent zapcore.Entry, fields []zapcore.Field) error {
+	core := c.load()
+	if len(c.fields) > 0 {
+		core = core.With(c.fields)
+	}
+	return core.Write(ent, fields)
+}
+
+func (c *logCore) Sync() error {
+	return c.load().Sync()
+}
+
+var _ zapcore.Core = (*logCore)(nil)
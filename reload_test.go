@@ -0,0 +1,49 @@
+package vegeta
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestReloadAppliesNothingOnBadCert(t *testing.T) {
+	dir := t.TempDir()
+	config := DefaultConfig()
+	config.TLS = newTestCA(t).serverTLSConfig(dir)
+	config.TLS.Users = map[string]string{"device": "alice"}
+	s := newTestServer(t, WithConfig(config))
+	v := s.V
+
+	next := DefaultConfig()
+	next.Server.TrustedProxies = []string{"10.0.0.0/8"}
+	next.Login.RequireAdminTOTP = true
+	next.TLS = config.TLS
+	next.TLS.CertFile = filepath.Join(dir, "missing.crt")
+	next.TLS.Users = map[string]string{"device": "bob"}
+	if err := v.Reload(next); err == nil {
+		t.Fatal("reload by a missing certificate succeeds")
+	}
+	if proxies := v.getTrustedProxies(); len(proxies) != 0 {
+		t.Errorf("trusted proxies are applied: %v", proxies)
+	}
+	if v.getLoginPolicy().requireAdminTOTP {
+		t.Error("login policy is applied")
+	}
+	if name := v.certs.userName("device"); name != "alice" {
+		t.Errorf("device is mapped to %s, want alice", name)
+	}
+	if v.config.TLS.CertFile != config.TLS.CertFile {
+		t.Errorf("tls config is applied: %s", v.config.TLS.CertFile)
+	}
+
+	next.TLS = newTestCA(t).serverTLSConfig(t.TempDir())
+	next.TLS.Users = map[string]string{"device": "bob"}
+	if err := v.Reload(next); err != nil {
+		t.Fatal(err)
+	}
+	if len(v.getTrustedProxies()) != 1 || !v.getLoginPolicy().requireAdminTOTP {
+		t.Error("settings are not applied")
+	}
+	if name := v.certs.userName("device"); name != "bob" {
+		t.Errorf("device is mapped to %s, want bob", name)
+	}
+}
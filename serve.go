@@ -2,26 +2,23 @@ package vegeta
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
-	"io"
 	"net"
-	"os"
-	"os/signal"
-	"strconv"
-	"strings"
-	"syscall"
+	"net/http"
+	"sync"
+	"time"
 
 	static "github.com/Code-Hex/echo-static"
 	"github.com/Code-Hex/vegeta/internal/model"
+	"github.com/Code-Hex/vegeta/internal/stream"
 	assetfs "github.com/elazarl/go-bindata-assetfs"
-	"golang.org/x/crypto/ssh/terminal"
 	validator "gopkg.in/go-playground/validator.v9"
 
 	"github.com/jinzhu/gorm"
 
 	"github.com/labstack/echo"
 	"github.com/labstack/echo/middleware"
-	"github.com/lestrrat/go-server-starter/listener"
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
 )
@@ -32,14 +29,31 @@ const (
 	msg     = name + " project to collect large amounts of vegetable data using IoT"
 )
 
-var stdout io.Writer = os.Stdout
+// Version is the version of vegeta.
+const Version = version
 
 type Vegeta struct {
-	Options
 	*echo.Echo
 	*zap.Logger
-	DB         *gorm.DB
-	waitSignal chan os.Signal
+	DB *gorm.DB
+
+	config         *Config
+	secret         []byte
+	listener       net.Listener
+	now            func() time.Time
+	ownDB          bool
+	logCore        *logCore
+	mu             sync.RWMutex // guards trustedProxies, login, jwt and reloaded fields of config
+	trustedProxies []*net.IPNet
+	login          loginPolicy
+	jwt            jwtPolicy
+	broker         *stream.Broker
+	metrics        *metrics
+	certs          *certReloader
+	oidc           *oidcProvider
+	httpClient     *http.Client
+	stopJobs       context.CancelFunc
+	jobsDone       sync.WaitGroup
 }
 
 type Validator struct {
@@ -50,144 +64,82 @@ func (v *Validator) Validate(i interface{}) error {
 	return v.validator.Struct(i)
 }
 
-func New() *Vegeta {
-	sigch := make(chan os.Signal)
-	signal.Notify(
-		sigch,
-		syscall.SIGINT,
-		syscall.SIGTERM,
-	)
-	return &Vegeta{
-		waitSignal: sigch,
-		Echo:       echo.New(),
+// New makes a server. MySQL is opened by the config unless WithDB is given,
+// and the logger is made from the config unless WithLogger is given.
+// It does not listen or handle signals. Call Serve, or use Handler.
+func New(opts ...Option) (*Vegeta, error) {
+	v := &Vegeta{
+		Echo:   echo.New(),
+		config: DefaultConfig(),
+		now:    time.Now,
+		broker: stream.NewBroker(),
 	}
-}
-
-func (v *Vegeta) Run() int {
-	defer v.close()
-	if e := v.run(); e != nil {
-		exitCode, err := UnwrapErrors(e)
-		if v.StackTrace {
-			fmt.Fprintf(os.Stderr, "Error:\n  %+v\n", e)
-		} else {
-			if err != nil {
-				fmt.Fprintf(os.Stderr, "Error:\n  %v\n", err)
-			}
-		}
-		return exitCode
+	for _, opt := range opts {
+		opt(v)
 	}
-	return 0
-}
-
-func (v *Vegeta) run() error {
-	if err := v.prepare(); err != nil {
-		return errors.Wrap(err, "Failed to prepare")
+	if err := v.setup(); err != nil {
+		v.Close()
+		return nil, err
 	}
-	return v.serve()
+	return v, nil
 }
 
-func (v *Vegeta) close() {
-	if v.DB != nil {
-		v.DB.Close()
-	}
+// Handler returns the handler which serves all routes.
+// It can be used with httptest.
+func (v *Vegeta) Handler() http.Handler {
+	return v.Echo
 }
 
-func (v *Vegeta) listen() (net.Listener, error) {
-	var li net.Listener
-	if os.Getenv("SERVER_STARTER_PORT") != "" {
-		listeners, err := listener.ListenAll()
-		if err != nil {
-			return nil, errors.Wrap(err, "server-starter error")
-		}
-		if 0 < len(listeners) {
-			li = listeners[0]
-		}
-	}
-
+// Serve accepts connections until Shutdown is called.
+func (v *Vegeta) Serve() error {
+	li := v.listener
 	if li == nil {
 		var err error
-		li, err = net.Listen("tcp", fmt.Sprintf(":%d", v.Port))
+		li, err = net.Listen("tcp", fmt.Sprintf(":%d", v.config.Server.Port))
 		if err != nil {
-			return nil, errors.Wrap(err, "listen error")
+			return errors.Wrap(err, "listen error")
 		}
 	}
-
-	return li, nil
-}
-
-func (v *Vegeta) startServer() {
-	li, err := v.listen()
-	if err != nil {
-		v.Error("Failed to get port for server", zap.Error(err))
-		return
-	}
-	if os.Getenv("SERVER_STARTER_PORT") == "" {
-		fmt.Println("Start Server at", li.Addr().String())
+	if v.certs != nil {
+		li = tls.NewListener(li, v.serverConfig())
 	}
-	if err := v.Server.Serve(li); err != nil {
-		v.Error("Server is stopped", zap.Error(err))
+	v.startJobs()
+	if err := v.Server.Serve(li); err != nil && err != http.ErrServerClosed {
+		return errors.Wrap(err, "Server is stopped")
 	}
+	return nil
 }
 
-func (v *Vegeta) serve() error {
-	ctx := context.Background()
-	go v.startServer()
-	return v.wait(ctx)
-}
-
-func (v *Vegeta) wait(ctx context.Context) error {
-	<-v.waitSignal
-	return v.Shutdown(ctx)
-}
-
-func (v *Vegeta) prepare() error {
-	_, err := parseOptions(&v.Options, os.Args[1:])
-	if err != nil {
-		return errors.Wrap(err, "Failed to parse command line args")
+// Shutdown stops accepting new connections and waits for in-flight
+// requests until ctx is done.
+func (v *Vegeta) Shutdown(ctx context.Context) error {
+	// Streams never finish by themselves.
+	v.broker.Close()
+	if v.stopJobs != nil {
+		v.stopJobs()
+		v.jobsDone.Wait()
 	}
-	if s := os.Getenv("SERVER_STARTER_PORT"); s != "" {
-		port := strings.Split(s, "=")
-		i, err := strconv.ParseInt(port[0], 10, 32)
-		if err != nil {
-			return err
-		}
-		v.Port = int(i)
-	} else {
-		v.Port = v.Options.Port
+	if err := v.Echo.Shutdown(ctx); err != nil {
+		return errors.Wrap(err, "Failed to shutdown gracefully")
 	}
+	v.Info("Server is stopped gracefully")
+	v.Sync()
+	return nil
+}
 
-	if err := v.setup(); err != nil {
-		return err
-	}
-	if v.Migrate {
-		r := v.DB.AutoMigrate(
-			&model.User{},
-			&model.Tag{},
-			&model.Data{},
-		)
-		if err := r.Error; err != nil {
-			return err
-		}
-		users, err := model.GetUsers(v.DB)
-		if err != nil || len(users) == 0 {
-			fmt.Print("管理者ユーザー名を入力してください: ")
-			var name string
-			fmt.Scanln(&name)
-			fmt.Print("管理者パスワードを入力してください: ")
-			password, err := terminal.ReadPassword(int(os.Stdin.Fd()))
-			if err != nil {
-				return err
-			}
-			fmt.Print("\n")
-			if _, err := model.CreateUser(v.DB, name, string(password), true); err != nil {
-				return err
-			}
-		}
-		return makeIgnore()
+// Close closes the database if it is opened by New.
+func (v *Vegeta) Close() error {
+	if v.ownDB && v.DB != nil {
+		return v.DB.Close()
 	}
 	return nil
 }
 
+// Migrate creates tables.
+func (v *Vegeta) Migrate() error {
+	return model.Migrate(v.DB)
+}
+
 //go:generate go-bindata -pkg vegeta -o bindata.go assets/...
 func newAssets(root string) *assetfs.AssetFS {
 	return &assetfs.AssetFS{
@@ -200,6 +152,10 @@ func newAssets(root string) *assetfs.AssetFS {
 
 func (v *Vegeta) setupHandlers() error {
 	v.HTTPErrorHandler = v.ErrorHandler
+	if v.config.Features.Metrics {
+		v.metrics = v.newMetrics()
+	}
+	v.Use(RequestID())
 	v.Use(func(h echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
 			cc, err := v.NewContext(c)
@@ -211,32 +167,17 @@ func (v *Vegeta) setupHandlers() error {
 	})
 	v.Use(v.LogHandler(), middleware.Recover())
 
-	if isProduction() {
+	if v.config.isProduction() {
 		v.Use(static.ServeRoot("/assets", newAssets("assets")))
 	} else {
 		v.Static("/assets", "assets")
 	}
 
 	// Add route for echo
-	v.Validator = &Validator{validator: validator.New()}
+	validate := validator.New()
+	validate.RegisterTagNameFunc(fieldName)
+	v.Validator = &Validator{validator: validate}
 	v.registerRoutes()
 
 	return nil
 }
-
-func parseOptions(opts *Options, argv []string) ([]string, error) {
-	o, err := opts.parse(argv)
-	if err != nil {
-		stdout.Write(opts.usage())
-		return nil, errors.Wrap(err, "invalid command line options")
-	}
-	if opts.Version {
-		fmt.Fprintf(stdout, "%s: %s\n", version, msg)
-		return nil, makeIgnore()
-	}
-	if opts.Help {
-		stdout.Write(opts.usage())
-		return nil, makeIgnore()
-	}
-	return o, nil
-}
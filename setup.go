@@ -1,10 +1,8 @@
 package vegeta
 
 import (
-	"fmt"
 	"os"
 	"path/filepath"
-	"time"
 
 	"github.com/Code-Hex/exit"
 	"github.com/Code-Hex/vegeta/internal/utils"
@@ -15,81 +13,125 @@ import (
 	"go.uber.org/zap/zapcore"
 )
 
-var (
-	LogDir  = "log"
-	LogName = "vegeta_log"
-)
-
 func (v *Vegeta) setup() error {
-	if err := v.setupDatabase(); err != nil {
-		return err
+	if v.secret == nil {
+		v.secret = []byte(v.config.Secret)
 	}
-	err := v.setupLogger(
-		zap.AddCaller(),
-		zap.AddStacktrace(zap.ErrorLevel),
-	)
+	var err error
+	v.jwt, err = v.config.JWT.policy(v.secret)
 	if err != nil {
-		return errors.Wrap(err, "Failed to setup logger")
+		return exit.MakeConfig(err)
+	}
+	if err := v.config.Password.Apply(); err != nil {
+		return exit.MakeConfig(err)
+	}
+	v.trustedProxies, err = parseTrustedProxies(v.config.Server.TrustedProxies)
+	if err != nil {
+		return exit.MakeConfig(err)
+	}
+	v.login = v.config.Login.policy()
+	if v.DB == nil {
+		if err := v.setupDatabase(); err != nil {
+			return err
+		}
+	}
+	if v.Logger == nil {
+		err := v.setupLogger(
+			zap.AddCaller(),
+			zap.AddStacktrace(zap.ErrorLevel),
+		)
+		if err != nil {
+			return errors.Wrap(err, "Failed to setup logger")
+		}
+	}
+	if v.config.TLS.enabled() {
+		v.certs, err = newCertReloader(v.config.TLS)
+		if err != nil {
+			return exit.MakeConfig(err)
+		}
+	}
+	if v.config.OIDC.enabled() {
+		v.oidc = newOIDCProvider(v.config.OIDC, v.httpClient, v.now)
 	}
 	return v.setupHandlers()
 }
 
 func (v *Vegeta) setupDatabase() error {
-	user := os.Getenv("MYSQL_USERNAME")
-	passwd := os.Getenv("MYSQL_PASSWORD")
-	database := os.Getenv("MYSQL_DATABASE")
-	info := fmt.Sprintf(
-		"%s:%s@/%s?charset=utf8&parseTime=True&loc=Local",
-		user,
-		passwd,
-		database,
-	)
-	db, err := gorm.Open("mysql", info)
+	db, err := gorm.Open("mysql", v.config.Database.DSN())
 	if err != nil {
-		return err
+		return exit.MakeUnAvailable(errors.Wrap(err, "Failed to open database"))
 	}
 	v.DB = db
+	v.ownDB = true
 	return nil
 }
 
 func (v *Vegeta) setupLogger(opts ...zap.Option) error {
-	config := genLoggerConfig()
-	enc := zapcore.NewJSONEncoder(config.EncoderConfig)
+	core, err := v.newLogCore(v.config.Log)
+	if err != nil {
+		return err
+	}
+	v.logCore = newSwappableCore(core)
+	v.Logger = zap.New(v.logCore, opts...)
+	return nil
+}
+
+func (v *Vegeta) newLogCore(lc LogConfig) (zapcore.Core, error) {
+	config := v.genLoggerConfig()
+	level := config.Level
+	if lc.Level != "" {
+		if err := level.UnmarshalText([]byte(lc.Level)); err != nil {
+			return nil, exit.MakeConfig(err)
+		}
+	}
+	newEncoder := func() zapcore.Encoder {
+		if lc.Encoding == "console" {
+			return zapcore.NewConsoleEncoder(config.EncoderConfig)
+		}
+		return zapcore.NewJSONEncoder(config.EncoderConfig)
+	}
+
+	var cores []zapcore.Core
+	if lc.toStdout() {
+		cores = append(cores, zapcore.NewCore(newEncoder(), zapcore.Lock(os.Stdout), level))
+	}
+	if lc.toFile() {
+		logf, err := newLogFile(lc)
+		if err != nil {
+			return nil, err
+		}
+		cores = append(cores, zapcore.NewCore(newEncoder(), zapcore.AddSync(logf), level))
+	}
+	return zapcore.NewTee(cores...), nil
+}
 
-	dir := LogDir
-	ok, err := utils.Exists(dir)
+func newLogFile(lc LogConfig) (*rotatelogs.RotateLogs, error) {
+	ok, err := utils.Exists(lc.Dir)
 	if err != nil {
-		return exit.MakeUnAvailable(err)
+		return nil, exit.MakeUnAvailable(err)
 	}
 	if !ok {
-		os.Mkdir(dir, os.ModeDir|os.ModePerm)
+		os.Mkdir(lc.Dir, os.ModeDir|os.ModePerm)
 	}
-	absPath, err := filepath.Abs(dir)
+	absPath, err := filepath.Abs(lc.Dir)
 	if err != nil {
-		return exit.MakeUnAvailable(err)
+		return nil, exit.MakeUnAvailable(err)
 	}
 	logf, err := rotatelogs.New(
-		filepath.Join(absPath, LogName+".%Y%m%d%H%M"),
-		rotatelogs.WithLinkName(filepath.Join(absPath, LogName)),
-		rotatelogs.WithMaxAge(24*time.Hour),
-		rotatelogs.WithRotationTime(time.Hour),
+		filepath.Join(absPath, lc.Name+".%Y%m%d%H%M"),
+		rotatelogs.WithLinkName(filepath.Join(absPath, lc.Name)),
+		rotatelogs.WithMaxAge(lc.MaxAge.Duration),
+		rotatelogs.WithRotationTime(lc.RotationTime.Duration),
 	)
 	if err != nil {
-		return exit.MakeUnAvailable(err)
+		return nil, exit.MakeUnAvailable(err)
 	}
-	core := zapcore.NewCore(enc, zapcore.AddSync(logf), config.Level)
-	v.Logger = zap.New(core, opts...)
-
-	return nil
+	return logf, nil
 }
 
-func genLoggerConfig() zap.Config {
-	if isProduction() {
+func (v *Vegeta) genLoggerConfig() zap.Config {
+	if v.config.isProduction() {
 		return zap.NewProductionConfig()
 	}
 	return zap.NewDevelopmentConfig()
 }
-
-func isProduction() bool {
-	return os.Getenv("STAGE") == "production"
-}
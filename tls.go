@@ -0,0 +1,196 @@
+package vegeta
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// certCheckInterval is the interval to check whether certificate files are changed.
+const certCheckInterval = 10 * time.Second
+
+// certReloader serves certificates which are reloaded when the files are changed.
+type certReloader struct {
+	mu        sync.RWMutex
+	config    TLSConfig
+	cert      *tls.Certificate
+	clientCAs *x509.CertPool
+	modTime   time.Time
+	checkedAt time.Time
+	// gen is increased when the config is replaced, so that a reload of
+	// the previous files does not overwrite certificates of the new ones.
+	gen int
+}
+
+// certSet is certificates which are loaded from files of a config.
+type certSet struct {
+	cert      *tls.Certificate
+	clientCAs *x509.CertPool
+	modTime   time.Time
+}
+
+func newCertReloader(config TLSConfig) (*certReloader, error) {
+	certs, err := loadCerts(config)
+	if err != nil {
+		return nil, err
+	}
+	r := new(certReloader)
+	r.set(config, certs)
+	return r, nil
+}
+
+func loadCerts(config TLSConfig) (*certSet, error) {
+	// Files may be changed while they are read, so the time is taken first.
+	modTime := latestModTime(config)
+	cert, err := tls.LoadX509KeyPair(config.CertFile, config.KeyFile)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to load certificate")
+	}
+	var pool *x509.CertPool
+	if config.ClientCAFile != "" {
+		pem, err := ioutil.ReadFile(config.ClientCAFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "Failed to read client ca file")
+		}
+		pool = x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, errors.Errorf("No certificates in client ca file: %s", config.ClientCAFile)
+		}
+	}
+	return &certSet{cert: &cert, clientCAs: pool, modTime: modTime}, nil
+}
+
+// update replaces file paths and the user mapping along with certificates.
+// Nothing is replaced if certificates of config can not be loaded.
+func (r *certReloader) update(config TLSConfig) error {
+	certs, err := loadCerts(config)
+	if err != nil {
+		return err
+	}
+	r.set(config, certs)
+	return nil
+}
+
+// set replaces the config and its certificates together.
+func (r *certReloader) set(config TLSConfig, certs *certSet) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.config = config
+	r.gen++
+	r.setCerts(certs)
+}
+
+func (r *certReloader) setCerts(certs *certSet) {
+	r.cert = certs.cert
+	r.clientCAs = certs.clientCAs
+	r.modTime = certs.modTime
+	r.checkedAt = time.Now()
+}
+
+// reload reads certificate files. The previous certificates are kept on error.
+func (r *certReloader) reload() error {
+	r.mu.RLock()
+	config, gen := r.config, r.gen
+	r.mu.RUnlock()
+
+	certs, err := loadCerts(config)
+	if err != nil {
+		return err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.gen == gen {
+		r.setCerts(certs)
+	}
+	return nil
+}
+
+func latestModTime(config TLSConfig) time.Time {
+	var latest time.Time
+	for _, f := range []string{config.CertFile, config.KeyFile, config.ClientCAFile} {
+		if f == "" {
+			continue
+		}
+		if fi, err := os.Stat(f); err == nil && fi.ModTime().After(latest) {
+			latest = fi.ModTime()
+		}
+	}
+	return latest
+}
+
+// reloadIfChanged checks files at most once per certCheckInterval.
+func (r *certReloader) reloadIfChanged() error {
+	r.mu.Lock()
+	if time.Since(r.checkedAt) < certCheckInterval {
+		r.mu.Unlock()
+		return nil
+	}
+	r.checkedAt = time.Now()
+	modTime := r.modTime
+	config := r.config
+	r.mu.Unlock()
+
+	if !latestModTime(config).After(modTime) {
+		return nil
+	}
+	return r.reload()
+}
+
+func (r *certReloader) tlsConfig() *tls.Config {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	config := &tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		NextProtos:   []string{"h2", "http/1.1"},
+		Certificates: []tls.Certificate{*r.cert},
+	}
+	if r.clientCAs != nil {
+		// Client certificates are optional. Devices without them use bearer tokens.
+		config.ClientCAs = r.clientCAs
+		config.ClientAuth = tls.VerifyClientCertIfGiven
+	}
+	return config
+}
+
+// serverConfig returns the config for the listener. The config for each
+// connection is made from current certificates.
+func (v *Vegeta) serverConfig() *tls.Config {
+	return &tls.Config{
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			if err := v.certs.reloadIfChanged(); err != nil {
+				v.Error("Failed to reload certificates", zap.Error(err))
+			}
+			return v.certs.tlsConfig(), nil
+		},
+	}
+}
+
+// userName maps the common name of a client certificate to the user name
+// by tls.users. The common name is used as it is if it is not in the map.
+func (r *certReloader) userName(cn string) string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if name, ok := r.config.Users[cn]; ok {
+		return name
+	}
+	return cn
+}
+
+// clientCertUser returns the user name for the verified client certificate.
+func (c *Context) clientCertUser() (string, bool) {
+	state := c.Request().TLS
+	if c.certs == nil || state == nil || len(state.VerifiedChains) == 0 || len(state.VerifiedChains[0]) == 0 {
+		return "", false
+	}
+	cn := state.VerifiedChains[0][0].Subject.CommonName
+	if cn == "" {
+		return "", false
+	}
+	return c.certs.userName(cn), true
+}
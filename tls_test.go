@@ -0,0 +1,309 @@
+package vegeta
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"io/ioutil"
+	"log"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// testCA issues certificates for tests.
+type testCA struct {
+	t    *testing.T
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+func newTestCA(t *testing.T) *testCA {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "vegeta test ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testCA{
+		t:    t,
+		cert: cert,
+		key:  key,
+		pem:  pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+	}
+}
+
+var serialNumber int64 = 1
+
+// issue returns PEM of a certificate and its key. Certificates for servers
+// are valid for 127.0.0.1.
+func (ca *testCA) issue(cn string, server bool) (certPEM, keyPEM []byte) {
+	ca.t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		ca.t.Fatal(err)
+	}
+	serialNumber++
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(serialNumber),
+		Subject:      pkix.Name{CommonName: cn},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	if server {
+		tmpl.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}
+		tmpl.IPAddresses = []net.IP{net.IPv4(127, 0, 0, 1)}
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, &key.PublicKey, ca.key)
+	if err != nil {
+		ca.t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		ca.t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+// writeFile writes the file in dir, and returns the path.
+func writeFile(t *testing.T, dir, name string, b []byte) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	if err := ioutil.WriteFile(path, b, 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+// serverTLSConfig writes a certificate for the server and the CA to dir.
+func (ca *testCA) serverTLSConfig(dir string) TLSConfig {
+	ca.t.Helper()
+	certPEM, keyPEM := ca.issue("127.0.0.1", true)
+	return TLSConfig{
+		CertFile:     writeFile(ca.t, dir, "server.crt", certPEM),
+		KeyFile:      writeFile(ca.t, dir, "server.key", keyPEM),
+		ClientCAFile: writeFile(ca.t, dir, "ca.crt", ca.pem),
+	}
+}
+
+func leafCN(t *testing.T, r *certReloader) string {
+	t.Helper()
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	cert, err := x509.ParseCertificate(r.cert.Certificate[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert.Subject.CommonName
+}
+
+func TestCertReloaderPicksUpRewrittenCert(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCA(t)
+	config := ca.serverTLSConfig(dir)
+	r, err := newCertReloader(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certPEM, keyPEM := ca.issue("renewed", true)
+	writeFile(t, dir, "server.crt", certPEM)
+	writeFile(t, dir, "server.key", keyPEM)
+	later := time.Now().Add(time.Minute)
+	for _, f := range []string{config.CertFile, config.KeyFile} {
+		if err := os.Chtimes(f, later, later); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Files are not checked again until certCheckInterval passes.
+	if err := r.reloadIfChanged(); err != nil {
+		t.Fatal(err)
+	}
+	if cn := leafCN(t, r); cn != "127.0.0.1" {
+		t.Errorf("reloaded before the interval: %s", cn)
+	}
+	r.mu.Lock()
+	r.checkedAt = time.Now().Add(-certCheckInterval)
+	r.mu.Unlock()
+	if err := r.reloadIfChanged(); err != nil {
+		t.Fatal(err)
+	}
+	if cn := leafCN(t, r); cn != "renewed" {
+		t.Errorf("served certificate is %s, want renewed", cn)
+	}
+	if got := r.tlsConfig().Certificates[0].Certificate[0]; string(got) != string(r.cert.Certificate[0]) {
+		t.Error("tls config does not serve the reloaded certificate")
+	}
+}
+
+func TestCertReloaderUpdateKeepsOldOnError(t *testing.T) {
+	dir := t.TempDir()
+	config := newTestCA(t).serverTLSConfig(dir)
+	config.Users = map[string]string{"device": "alice"}
+	r, err := newCertReloader(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bad := config
+	bad.CertFile = filepath.Join(dir, "missing.crt")
+	bad.Users = map[string]string{"device": "bob"}
+	if err := r.update(bad); err == nil {
+		t.Fatal("update by a missing certificate succeeds")
+	}
+	if name := r.userName("device"); name != "alice" {
+		t.Errorf("device is mapped to %s after failed update, want alice", name)
+	}
+	if r.config.CertFile != config.CertFile {
+		t.Errorf("cert file is %s after failed update", r.config.CertFile)
+	}
+	// Files of the kept config are still reloaded.
+	if err := r.reload(); err != nil {
+		t.Errorf("reload after failed update: %v", err)
+	}
+}
+
+// tlsClient returns a client which trusts ca, and presents a client
+// certificate of cn unless cn is empty.
+func (ca *testCA) tlsClient(cn string) *http.Client {
+	ca.t.Helper()
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.cert)
+	config := &tls.Config{RootCAs: roots}
+	if cn != "" {
+		certPEM, keyPEM := ca.issue(cn, false)
+		cert, err := tls.X509KeyPair(certPEM, keyPEM)
+		if err != nil {
+			ca.t.Fatal(err)
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+	return &http.Client{
+		Transport: &http.Transport{TLSClientConfig: config},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+func TestClientCertUser(t *testing.T) {
+	ca := newTestCA(t)
+	config := DefaultConfig()
+	config.TLS = ca.serverTLSConfig(t.TempDir())
+	config.TLS.Users = map[string]string{"sensor-1": "alice"}
+	s := newTestServer(t, WithConfig(config))
+	s.createUser("root", "rootpass1234", true)
+	alice := s.createUser("alice", "password1234", false)
+	bob := s.createUser("bob", "password1234", false)
+	if err := alice.AddTag(s.DB, "room"); err != nil {
+		t.Fatal(err)
+	}
+	if err := bob.AddTag(s.DB, "garden"); err != nil {
+		t.Fatal(err)
+	}
+
+	srv := httptest.NewUnstartedServer(s.V.Handler())
+	srv.TLS = s.V.serverConfig()
+	srv.Config.ErrorLog = log.New(ioutil.Discard, "", 0)
+	srv.StartTLS()
+	defer srv.Close()
+
+	get := func(client *http.Client, path string) (*http.Response, []byte) {
+		t.Helper()
+		resp, err := client.Get(srv.URL + path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		b, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return resp, b
+	}
+
+	tests := []struct {
+		cn   string
+		want string
+	}{
+		{"sensor-1", "room"},
+		// Common names which are not in tls.users are user names.
+		{"bob", "garden"},
+	}
+	for _, tt := range tests {
+		client := ca.tlsClient(tt.cn)
+		resp, body := get(client, "/api/v1/tags")
+		if resp.StatusCode != http.StatusOK {
+			t.Errorf("%s: GET /api/v1/tags: %d %s", tt.cn, resp.StatusCode, body)
+			continue
+		}
+		var result struct {
+			Tags []string `json:"tags"`
+		}
+		if err := json.Unmarshal(body, &result); err != nil {
+			t.Fatal(err)
+		}
+		if len(result.Tags) != 1 || result.Tags[0] != tt.want {
+			t.Errorf("%s: tags are %v, want [%s]", tt.cn, result.Tags, tt.want)
+		}
+
+		// Client certificates are accepted only on routes for API tokens.
+		if resp, body := get(client, "/api/v1/users/me"); resp.StatusCode != http.StatusUnauthorized {
+			t.Errorf("%s: GET /api/v1/users/me: %d %s", tt.cn, resp.StatusCode, body)
+		}
+		resp, _ = get(client, "/mypage")
+		if resp.StatusCode != http.StatusFound || resp.Header.Get("Location") != "/login" {
+			t.Errorf("%s: GET /mypage: %d to %s", tt.cn, resp.StatusCode, resp.Header.Get("Location"))
+		}
+	}
+
+	if resp, body := get(ca.tlsClient("nobody"), "/api/v1/tags"); resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("unknown user: %d %s", resp.StatusCode, body)
+	}
+	if resp, body := get(ca.tlsClient(""), "/api/v1/tags"); resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("without certificate: %d %s", resp.StatusCode, body)
+	}
+	// Certificates of other CAs are rejected in the handshake.
+	client := ca.tlsClient("")
+	certPEM, keyPEM := newTestCA(t).issue("sensor-1", false)
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.Transport.(*http.Transport).TLSClientConfig.Certificates = []tls.Certificate{cert}
+	if resp, err := client.Get(srv.URL + "/api/v1/tags"); err == nil {
+		resp.Body.Close()
+		t.Errorf("certificate of unknown CA is accepted: %d", resp.StatusCode)
+	}
+}
@@ -0,0 +1,152 @@
+package vegeta
+
+import (
+	"bytes"
+	"encoding/base64"
+	"image/png"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/Code-Hex/vegeta/html"
+	"github.com/Code-Hex/vegeta/internal/model"
+	"github.com/Code-Hex/vegeta/internal/session"
+	jwt "github.com/dgrijalva/jwt-go"
+	"github.com/labstack/echo"
+	"github.com/pkg/errors"
+	"github.com/pquerna/otp"
+	"go.uber.org/zap"
+)
+
+const (
+	totpIssuer = "Vegeta"
+	// The user who passed the password is kept in the cookie
+	// until the second factor is verified.
+	totpCookieName = "vegeta-totp"
+	totpAudience   = "totp"
+	totpPendingAge = 5 * time.Minute
+)
+
+// setPendingLogin remembers the user who passed the password.
+func (c *Context) setPendingLogin(user *model.User) error {
+	claims := &jwt.StandardClaims{
+		Subject:   strconv.FormatUint(uint64(user.ID), 10),
+		Audience:  totpAudience,
+		ExpiresAt: c.Now().Add(totpPendingAge).Unix(),
+	}
+	token, err := c.jwt.keys.sign(claims)
+	if err != nil {
+		return errors.Wrap(err, "Failed to sign pending login")
+	}
+	c.SetCookie(&http.Cookie{
+		Name:     totpCookieName,
+		Value:    token,
+		Path:     "/",
+		MaxAge:   int(totpPendingAge / time.Second),
+		Secure:   c.Request().TLS != nil,
+		HttpOnly: true,
+	})
+	return nil
+}
+
+// pendingLogin returns the id of the user who passed the password.
+func (c *Context) pendingLogin() (uint, bool) {
+	cookie, err := c.Cookie(totpCookieName)
+	if err != nil || cookie.Value == "" {
+		return 0, false
+	}
+	claims := new(jwt.StandardClaims)
+	_, err = c.jwt.keys.parse(cookie.Value, claims)
+	if err != nil || !claims.VerifyAudience(totpAudience, true) || !claims.VerifyExpiresAt(c.Now().Unix(), true) {
+		return 0, false
+	}
+	id, err := strconv.ParseUint(claims.Subject, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return uint(id), true
+}
+
+func (c *Context) clearPendingLogin() {
+	c.SetCookie(&http.Cookie{
+		Name:     totpCookieName,
+		Value:    "",
+		Path:     "/",
+		MaxAge:   -1,
+		HttpOnly: true,
+	})
+}
+
+// qrCode returns the QR code of the key as a data URL of PNG.
+func qrCode(key *otp.Key) (string, error) {
+	img, err := key.Image(200, 200)
+	if err != nil {
+		return "", errors.Wrap(err, "Failed to make QR code")
+	}
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return "", errors.Wrap(err, "Failed to encode QR code")
+	}
+	return "data:image/png;base64," + base64.StdEncoding.EncodeToString(buf.Bytes()), nil
+}
+
+func LoginTOTP() echo.HandlerFunc {
+	return call(func(c *Context) error {
+		if _, ok := c.pendingLogin(); !ok {
+			return c.Redirect(http.StatusFound, "/login")
+		}
+		reason := c.QueryParam("error")
+		if reason != "invalid" && reason != "csrf" {
+			reason = ""
+		}
+		html.LoginTOTP(&loginArgs{Args: c.GetUserStatus(), reason: reason}, c.Response())
+		return nil
+	})
+}
+
+// AuthTOTP is the second step of Auth for users who have TOTP.
+func AuthTOTP() echo.HandlerFunc {
+	return call(func(c *Context) error {
+		id, ok := c.pendingLogin()
+		if !ok {
+			return c.Redirect(http.StatusFound, "/login")
+		}
+		user, err := model.LoginSecondFactor(
+			c.DB,
+			c.login.throttle,
+			id,
+			c.FormValue("code"),
+			c.PeerAddr(),
+			c.Request().UserAgent(),
+			c.Now(),
+		)
+		if err != nil {
+			c.Zap.Warn("Failed to verify second factor",
+				zap.Uint("user_id", id),
+				zap.String("ip", c.PeerAddr()),
+				zap.Error(err),
+			)
+			switch errors.Cause(err) {
+			case model.ErrUnauthorized:
+				return c.Redirect(http.StatusFound, "/login/totp?error=invalid")
+			case model.ErrLocked:
+				c.clearPendingLogin()
+				return c.Redirect(http.StatusFound, "/login?error=locked")
+			case model.ErrSuspended:
+				c.clearPendingLogin()
+				return c.Redirect(http.StatusFound, "/login?error=suspended")
+			}
+			c.clearPendingLogin()
+			return c.Redirect(http.StatusFound, "/login?error=invalid")
+		}
+		c.clearPendingLogin()
+		sess := session.Get(c)
+		if err := sess.Login(user); err != nil {
+			return err
+		}
+		if err := sess.VerifySecondFactor(); err != nil {
+			return err
+		}
+		return c.Redirect(http.StatusFound, "/mypage")
+	})
+}
@@ -0,0 +1,110 @@
+package vegeta
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/Code-Hex/vegeta/internal/model"
+	"github.com/pquerna/otp/totp"
+)
+
+// enableTOTP enables TOTP of the user out of any session, and returns
+// the recovery codes.
+func (s *testServer) enableTOTP(u *model.User) []string {
+	s.t.Helper()
+	key, err := u.StartTOTP(s.DB, "vegeta")
+	if err != nil {
+		s.t.Fatal(err)
+	}
+	now := time.Now()
+	code, err := totp.GenerateCode(key.Secret(), now)
+	if err != nil {
+		s.t.Fatal(err)
+	}
+	codes, err := u.EnableTOTP(s.DB, code, now)
+	if err != nil {
+		s.t.Fatal(err)
+	}
+	return codes
+}
+
+func TestAdminNeedsSecondFactorInSession(t *testing.T) {
+	s := newTestServer(t)
+	root := s.createUser("root", "root-password1", true)
+
+	before := s.loggedIn("root", "root-password1")
+	if resp, body := before.api(http.MethodGet, "/api/v1/users", before.pageToken("/mypage/settings"), "", nil); resp.StatusCode != http.StatusOK {
+		t.Fatalf("admin without TOTP: status = %d: %s", resp.StatusCode, body)
+	}
+
+	// The session was made by the password alone.
+	codes := s.enableTOTP(root)
+	resp, body := before.api(http.MethodGet, "/api/v1/users", before.pageToken("/mypage/settings"), "", nil)
+	if resp.StatusCode != http.StatusForbidden {
+		t.Errorf("session without second factor: status = %d, want 403: %s", resp.StatusCode, body)
+	}
+	if resp, _ := before.get("/mypage/admin"); resp.Header.Get("Location") != "/mypage/settings" {
+		t.Errorf("admin page without second factor goes to %q", resp.Header.Get("Location"))
+	}
+
+	after := s.client()
+	after.login("root", "root-password1", "/login/totp")
+	resp, _ = after.postForm("/auth/totp", url.Values{
+		"code":       {codes[0]},
+		"csrf_token": {after.formCSRF("/login/totp")},
+	})
+	if loc := resp.Header.Get("Location"); loc != "/mypage" {
+		t.Fatalf("second factor goes to %q", loc)
+	}
+	if resp, body := after.api(http.MethodGet, "/api/v1/users", after.pageToken("/mypage/settings"), "", nil); resp.StatusCode != http.StatusOK {
+		t.Errorf("session with second factor: status = %d: %s", resp.StatusCode, body)
+	}
+	if resp, _ := after.get("/mypage/admin"); resp.StatusCode != http.StatusOK {
+		t.Errorf("admin page with second factor: status = %d", resp.StatusCode)
+	}
+}
+
+func TestRequireAdminTOTP(t *testing.T) {
+	config := DefaultConfig()
+	config.Login.RequireAdminTOTP = true
+	s := newTestServer(t, WithConfig(config))
+	s.createUser("root", "root-password1", true)
+
+	c := s.loggedIn("root", "root-password1")
+	resp, body := c.api(http.MethodGet, "/api/v1/users", c.pageToken("/mypage/settings"), "", nil)
+	if resp.StatusCode != http.StatusForbidden {
+		t.Errorf("status = %d, want 403: %s", resp.StatusCode, body)
+	}
+}
+
+func TestEnableTOTPVerifiesSession(t *testing.T) {
+	config := DefaultConfig()
+	config.Login.RequireAdminTOTP = true
+	s := newTestServer(t, WithConfig(config))
+	s.createUser("root", "root-password1", true)
+
+	c := s.loggedIn("root", "root-password1")
+	token := c.pageToken("/mypage/settings")
+	resp, body := c.api(http.MethodPost, "/api/v1/users/me/totp", token, c.csrfCookie(), nil)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("start TOTP: status = %d: %s", resp.StatusCode, body)
+	}
+	var key totpKeyJSON
+	if err := json.Unmarshal([]byte(body), &key); err != nil {
+		t.Fatal(err)
+	}
+	code, err := totp.GenerateCode(key.Secret, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, body = c.api(http.MethodPut, "/api/v1/users/me/totp", token, c.csrfCookie(), &totpCode{Code: code})
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("enable TOTP: status = %d: %s", resp.StatusCode, body)
+	}
+	if resp, body := c.api(http.MethodGet, "/api/v1/users", token, "", nil); resp.StatusCode != http.StatusOK {
+		t.Errorf("status = %d after enabling TOTP in the session: %s", resp.StatusCode, body)
+	}
+}